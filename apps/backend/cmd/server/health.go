@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/studyverse/ems-backend/internal/perms"
+	"github.com/studyverse/ems-backend/internal/search"
+)
+
+// healthHandler probes the database and, when configured, search and SpiceDB
+// in parallel. It answers 200 when every probe passes and 503 otherwise, with
+// "ok" or "degraded" per dependency in the JSON body.
+func healthHandler(pool *pgxpool.Pool, searchClient *search.Client, permsClient *perms.Client, timeout time.Duration) http.HandlerFunc {
+	probes := map[string]func(context.Context) error{
+		"database": pool.Ping,
+	}
+	if searchClient != nil {
+		probes["search"] = searchClient.Ping
+	}
+	if permsClient != nil {
+		probes["permissions"] = permsClient.Ping
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+
+		var mu sync.Mutex
+		var wg sync.WaitGroup
+		results := make(map[string]string, len(probes))
+		healthy := true
+		for name, probe := range probes {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				status := "ok"
+				if err := probe(ctx); err != nil {
+					slog.Warn("Health check failed", "dependency", name, "error", err)
+					status = "degraded"
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				results[name] = status
+				if status != "ok" {
+					healthy = false
+				}
+			}()
+		}
+		wg.Wait()
+
+		w.Header().Set("Content-Type", "application/json")
+		if healthy {
+			w.WriteHeader(http.StatusOK)
+		} else {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(results)
+	}
+}
@@ -2,48 +2,91 @@ package main
 
 import (
 	"context"
+	"crypto/subtle"
+	"encoding/json"
 	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
+	"slices"
+	"sync/atomic"
 	"syscall"
 	"time"
 
 	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 
 	"github.com/studyverse/ems-backend/gen/eventsv1/eventsv1connect"
+	"github.com/studyverse/ems-backend/gen/permissionsv1/permissionsv1connect"
 	"github.com/studyverse/ems-backend/gen/searchv1/searchv1connect"
 	"github.com/studyverse/ems-backend/gen/usersv1/usersv1connect"
 	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/clientip"
 	"github.com/studyverse/ems-backend/internal/config"
+	"github.com/studyverse/ems-backend/internal/cors"
 	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/logging"
+	"github.com/studyverse/ems-backend/internal/metrics"
+	"github.com/studyverse/ems-backend/internal/notify"
 	"github.com/studyverse/ems-backend/internal/perms"
+	"github.com/studyverse/ems-backend/internal/ratelimit"
+	"github.com/studyverse/ems-backend/internal/requestid"
 	"github.com/studyverse/ems-backend/internal/search"
 	"github.com/studyverse/ems-backend/internal/services"
+	"github.com/studyverse/ems-backend/internal/stats"
+	"github.com/studyverse/ems-backend/internal/telemetry"
+	"github.com/studyverse/ems-backend/internal/validation"
+	"github.com/studyverse/ems-backend/internal/webhooks"
 )
 
 func main() {
 	// Setup structured logging
 	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
-		Level: slog.LevelInfo,
+		Level: config.LogLevel,
 	}))
 	slog.SetDefault(logger)
 
 	// Load configuration
 	cfg := config.Load()
+	if level, err := config.ParseLogLevel(cfg.LogLevel); err == nil {
+		config.LogLevel.Set(level)
+	} else {
+		slog.Warn("Invalid LOG_LEVEL, keeping info", "logLevel", cfg.LogLevel)
+	}
+	cfg.Validate()
 
 	slog.Info("Starting EMS Backend",
 		"host", cfg.Host,
 		"port", cfg.Port,
 	)
 
+	// Export traces when a collector is configured
+	shutdownTracing, err := telemetry.Init(context.Background(), cfg.OtelEndpoint)
+	if err != nil {
+		slog.Warn("Failed to initialize tracing - traces will not be exported",
+			"endpoint", cfg.OtelEndpoint,
+			"error", err,
+		)
+		cfg.OtelEndpoint = ""
+		shutdownTracing = func(context.Context) error { return nil }
+	} else if cfg.OtelEndpoint != "" {
+		slog.Info("Tracing enabled", "endpoint", cfg.OtelEndpoint)
+	}
+
 	// Initialize Kratos client for authentication
 	kratosClient := auth.NewKratosClient(cfg.KratosPublicURL)
 	slog.Info("Kratos client initialized", "url", cfg.KratosPublicURL)
+	kratosAdminClient := auth.NewKratosAdminClient(cfg.KratosAdminURL)
 
-	// Initialize SpiceDB client for authorization
+	// Initialize SpiceDB client for authorization.
+	// Production should use SpiceDBInsecure=false, SpiceDBSkipVerifyCA=false (verified TLS);
+	// local development sets SPICEDB_INSECURE=true for the plaintext docker-compose SpiceDB.
 	permsClient, err := perms.NewClient(cfg.SpiceDBEndpoint, cfg.SpiceDBPresharedKey, cfg.SpiceDBInsecure, cfg.SpiceDBSkipVerifyCA)
 	if err != nil {
 		slog.Warn("Failed to initialize SpiceDB client - authorization checks will fail",
@@ -71,7 +114,13 @@ func main() {
 
 	// Connect to database
 	ctx := context.Background()
-	pool, err := db.NewPool(ctx, cfg.DatabaseURL)
+	pool, err := db.NewPool(ctx, cfg.DatabaseURL, db.PoolConfig{
+		MaxConns:         cfg.DBMaxConns,
+		MinConns:         cfg.DBMinConns,
+		MaxConnIdleTime:  cfg.DBMaxConnIdleTime,
+		MaxConnLifetime:  cfg.DBMaxConnLifetime,
+		StatementTimeout: cfg.DBStatementTimeout,
+	})
 	if err != nil {
 		slog.Error("Failed to connect to database", "error", err)
 		os.Exit(1)
@@ -83,22 +132,54 @@ func main() {
 	// Initialize queries
 	queries := db.New(pool)
 
+	// Cache validated Kratos sessions so each request doesn't call whoami
+	var sessionCache *auth.SessionCache
+	if cfg.SessionCacheTTL > 0 {
+		sessionCache = auth.NewSessionCache(cfg.SessionCacheTTL)
+	}
+
 	// Initialize services with permsClient for authorization
-	eventsService := services.NewEventsService(queries, pool, permsClient, searchClient)
-	organizationsService := services.NewOrganizationsService(queries, permsClient, searchClient)
-	organizationTypesService := services.NewOrganizationTypesService(queries)
-	tagsService := services.NewTagsService(queries, permsClient, searchClient)
-	eventRegistrationsService := services.NewEventRegistrationsService(queries)
-	eventAttendanceService := services.NewEventAttendanceService(queries)
-	statisticsService := services.NewStatisticsService(queries, pool)
-	usersService := services.NewUsersService(queries, permsClient, searchClient)
-	searchService := services.NewSearchService(searchClient, queries)
+	eventsService := services.NewEventsService(queries, pool, permsClient, searchClient, cfg)
+	webhookDispatcher := webhooks.NewDispatcher(queries)
+	attendanceListener := db.NewListener(pool)
+	organizationsService := services.NewOrganizationsService(queries, pool, permsClient, searchClient, webhookDispatcher, cfg)
+	organizationTypesService := services.NewOrganizationTypesService(queries, searchClient, cfg)
+	tagsService := services.NewTagsService(queries, pool, permsClient, searchClient, cfg)
+	eventRegistrationsService := services.NewEventRegistrationsService(queries, pool, permsClient, searchClient, services.NewCancelLinkSigner(cfg.BaseURL, cfg.RegistrationLinkSecret), notify.NewClient(cfg.NotificationWebhookURL), cfg)
+	eventAttendanceService := services.NewEventAttendanceService(queries, pool, attendanceListener, permsClient, searchClient, cfg)
+	snapshotWorker := stats.NewSnapshotWorker(queries, pool)
+	statisticsService := services.NewStatisticsService(queries, pool, permsClient, snapshotWorker, cfg)
+	usersService := services.NewUsersService(queries, pool, permsClient, searchClient, kratosAdminClient, sessionCache, cfg)
+	searchService := services.NewSearchService(searchClient, queries, permsClient, cfg)
+	exportHandler := services.NewExportHandler(queries, permsClient)
+	webhooksService := services.NewWebhooksService(queries, permsClient, cfg)
+	auditService := services.NewAuditService(queries, permsClient)
+	permissionsService := services.NewPermissionsService(queries, permsClient)
+
+	// Start background workers
+	workerCtx, stopWorkers := context.WithCancel(ctx)
+	defer stopWorkers()
+	go webhookDispatcher.Run(workerCtx)
+	go attendanceListener.Run(workerCtx)
+	if sessionCache != nil {
+		go sessionCache.Run(workerCtx)
+	}
+	go eventRegistrationsService.RunHoldPurger(workerCtx)
+	go organizationsService.RunInquiryLimiterSweeper(workerCtx)
+	go statisticsService.RunAttendanceRateGauge(workerCtx)
+	go snapshotWorker.Run(workerCtx)
+	go metrics.RunPoolStats(workerCtx, pool)
+	var searchMonitor *search.Monitor
+	if searchClient != nil {
+		searchMonitor = search.NewMonitor(searchClient, queries)
+		go searchMonitor.Run(workerCtx)
+	}
 
 	// Setup HTTP mux
 	mux := http.NewServeMux()
 
 	// Register Connect-RPC handlers
-	interceptors := connect.WithInterceptors(loggingInterceptor())
+	interceptors := connect.WithInterceptors(tracingInterceptor(cfg.OtelEndpoint != ""), metricsInterceptor(), loggingInterceptor(), streamingLoggingInterceptor(), validation.NewInterceptor())
 
 	// Events services
 	mux.Handle(eventsv1connect.NewEventsServiceHandler(eventsService, interceptors))
@@ -106,8 +187,11 @@ func main() {
 	mux.Handle(eventsv1connect.NewOrganizationTypesServiceHandler(organizationTypesService, interceptors))
 	mux.Handle(eventsv1connect.NewTagsServiceHandler(tagsService, interceptors))
 	mux.Handle(eventsv1connect.NewEventRegistrationsServiceHandler(eventRegistrationsService, interceptors))
-	mux.Handle(eventsv1connect.NewEventAttendanceServiceHandler(eventAttendanceService, interceptors))
+	attendancePath, attendanceHandler := eventsv1connect.NewEventAttendanceServiceHandler(eventAttendanceService, interceptors)
+	mux.Handle(attendancePath, withoutDeadlines(attendanceHandler, eventsv1connect.EventAttendanceServiceStreamEventAttendanceProcedure))
 	mux.Handle(eventsv1connect.NewStatisticsServiceHandler(statisticsService, interceptors))
+	mux.Handle(eventsv1connect.NewWebhooksServiceHandler(webhooksService, interceptors))
+	mux.Handle(eventsv1connect.NewAuditServiceHandler(auditService, interceptors))
 
 	// Users service
 	mux.Handle(usersv1connect.NewUsersServiceHandler(usersService, interceptors))
@@ -115,29 +199,42 @@ func main() {
 	// Search service
 	mux.Handle(searchv1connect.NewSearchServiceHandler(searchService, interceptors))
 
-	// Health check endpoint
-	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		_, _ = w.Write([]byte("OK"))
-	})
+	// Permissions service
+	mux.Handle(permissionsv1connect.NewPermissionsServiceHandler(permissionsService, interceptors))
+
+	// CSV exports (plain HTTP, streamed)
+	exportHandler.Register(mux)
+
+	// Registration cancellation links from emails
+	eventRegistrationsService.RegisterRoutes(mux)
+
+	// Prometheus metrics
+	mux.Handle("GET /metrics", metrics.Handler())
+
+	// Health check endpoint, probing the database, search and SpiceDB
+	mux.HandleFunc("/health", healthHandler(pool, searchClient, permsClient, cfg.HealthCheckTimeout))
+
+	// Search index document counts compared with the database
+	if searchMonitor != nil {
+		mux.Handle("GET /health/search", searchMonitor)
+	}
+
+	// Admin endpoints are protected by the ADMIN_SECRET shared secret
+	adminSecret := cfg.AdminSecret
+	if adminSecret != "" {
+		// Changes the log level until the next restart
+		mux.Handle("PUT /admin/log-level", requireAdminSecret(adminSecret, setLogLevel))
+		slog.Info("Admin log level endpoint enabled at /admin/log-level")
+	}
 
 	// Admin endpoint to promote users to platform admin/staff
-	// Protected by ADMIN_SECRET environment variable
-	adminSecret := os.Getenv("ADMIN_SECRET")
 	if adminSecret != "" && permsClient != nil {
-		mux.HandleFunc("/admin/promote", func(w http.ResponseWriter, r *http.Request) {
+		mux.Handle("/admin/promote", requireAdminSecret(adminSecret, func(w http.ResponseWriter, r *http.Request) {
 			if r.Method != http.MethodPost {
 				http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 				return
 			}
 
-			// Check admin secret
-			secret := r.Header.Get("X-Admin-Secret")
-			if secret != adminSecret {
-				http.Error(w, "Unauthorized", http.StatusUnauthorized)
-				return
-			}
-
 			// Get user ID and role from query params
 			userID := r.URL.Query().Get("user_id")
 			role := r.URL.Query().Get("role")
@@ -164,22 +261,72 @@ func main() {
 			slog.Info("User promoted", "userID", userID, "role", role)
 			w.WriteHeader(http.StatusOK)
 			_, _ = w.Write([]byte("User " + userID + " promoted to " + role))
-		})
+		}))
 		slog.Info("Admin promotion endpoint enabled at /admin/promote")
+
+		// Debug endpoint listing the relationships stored on a SpiceDB resource
+		mux.Handle("GET /admin/spicedb/relationships", requireAdminSecret(adminSecret, func(w http.ResponseWriter, r *http.Request) {
+			resourceType := r.URL.Query().Get("resource_type")
+			resourceID := r.URL.Query().Get("resource_id")
+			if resourceType == "" || resourceID == "" {
+				http.Error(w, "resource_type and resource_id are required", http.StatusBadRequest)
+				return
+			}
+
+			relationships, err := permsClient.ReadRelationships(r.Context(), resourceType, resourceID)
+			if err != nil {
+				slog.Error("Failed to read relationships", "resourceType", resourceType, "resourceID", resourceID, "error", err)
+				http.Error(w, "Failed to read relationships: "+err.Error(), http.StatusInternalServerError)
+				return
+			}
+
+			type relationshipJSON struct {
+				Relation    string `json:"relation"`
+				SubjectType string `json:"subject_type"`
+				SubjectID   string `json:"subject_id"`
+			}
+			out := make([]relationshipJSON, len(relationships))
+			for i, rel := range relationships {
+				out[i] = relationshipJSON{Relation: rel.Relation, SubjectType: rel.SubjectType, SubjectID: rel.SubjectID}
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(out)
+		}))
+		slog.Info("Admin SpiceDB debug endpoint enabled at /admin/spicedb/relationships")
+
+		// Rebuilds SpiceDB relationships from the database, e.g. after data loss
+		spiceDBSync := services.NewSpiceDBSyncHandler(queries, permsClient)
+		mux.Handle("POST /admin/sync-spicedb", requireAdminSecret(adminSecret, spiceDBSync.ServeHTTP))
+		slog.Info("Admin SpiceDB sync endpoint enabled at /admin/sync-spicedb")
 	}
 
-	// Build middleware chain: CORS -> Auth -> Mux
+	// Build middleware chain: CORS -> Request ID -> Client IP -> Auth -> Rate limit -> Mux
+	// Request ID middleware tags every request for log correlation
+	// Client IP middleware resolves the caller's address behind trusted proxies
 	// Auth middleware extracts Kratos session and injects user ID into context
-	authMiddleware := auth.NewMiddleware(kratosClient)
-	handler := corsMiddleware(cfg.CORSOrigins, authMiddleware(mux))
+	// Rate limiting runs after auth so it can key on the user ID
+	var apiHandler http.Handler = mux
+	if cfg.RateLimitRPS > 0 {
+		apiHandler = ratelimit.New(cfg.RateLimitRPS, cfg.RateLimitBurst).Middleware(mux)
+		slog.Info("Rate limiting enabled", "rps", cfg.RateLimitRPS, "burst", cfg.RateLimitBurst)
+	}
+
+	authMiddleware := auth.NewMiddleware(kratosClient, sessionCache)
+	clientIPs, err := clientip.NewResolver(cfg.TrustedProxies)
+	if err != nil {
+		slog.Error("Invalid TRUSTED_PROXIES", "error", err)
+		os.Exit(1)
+	}
+	handler := cors.Middleware(cfg.CORSOrigins, requestid.Middleware(clientIPs.Middleware(authMiddleware(apiHandler))))
 
 	// Create server with h2c (HTTP/2 cleartext) support for Connect-RPC
 	server := &http.Server{
 		Addr:         cfg.Host + ":" + cfg.Port,
 		Handler:      h2c.NewHandler(handler, &http2.Server{}),
-		ReadTimeout:  30 * time.Second,
-		WriteTimeout: 30 * time.Second,
-		IdleTimeout:  120 * time.Second,
+		ReadTimeout:  cfg.ServerReadTimeout,
+		WriteTimeout: cfg.ServerWriteTimeout,
+		IdleTimeout:  cfg.ServerIdleTimeout,
 	}
 
 	// Graceful shutdown
@@ -196,6 +343,7 @@ func main() {
 
 	<-shutdown
 	slog.Info("Shutting down server...")
+	stopWorkers()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -203,36 +351,49 @@ func main() {
 	if err := server.Shutdown(ctx); err != nil {
 		slog.Error("Server shutdown error", "error", err)
 	}
+	if err := shutdownTracing(ctx); err != nil {
+		slog.Error("Tracing shutdown error", "error", err)
+	}
 
 	slog.Info("Server stopped")
 }
 
-func corsMiddleware(origins []string, next http.Handler) http.Handler {
-	allowedOrigins := make(map[string]bool)
-	for _, origin := range origins {
-		allowedOrigins[origin] = true
-	}
-
+// requireAdminSecret only lets requests carrying the admin shared secret in
+// X-Admin-Secret through. The comparison is constant time, so response
+// timing doesn't leak how much of a guess was right.
+func requireAdminSecret(secret string, next http.HandlerFunc) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		origin := r.Header.Get("Origin")
-
-		// Check if origin is allowed
-		if allowedOrigins[origin] || len(origins) == 0 || (len(origins) == 1 && origins[0] == "*") {
-			w.Header().Set("Access-Control-Allow-Origin", origin)
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("X-Admin-Secret")), []byte(secret)) != 1 {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
 		}
+		next(w, r)
+	})
+}
 
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Accept, Accept-Language, Content-Type, Content-Language, Authorization, Connect-Protocol-Version, Connect-Timeout-Ms, X-Grpc-Timeout, X-User-Agent, X-Session-Token")
-		w.Header().Set("Access-Control-Expose-Headers", "Connect-Content-Encoding, Connect-Timeout-Ms, Grpc-Status, Grpc-Message, Grpc-Status-Details-Bin")
-		w.Header().Set("Access-Control-Max-Age", "86400")
-		w.Header().Set("Access-Control-Allow-Credentials", "true")
+// setLogLevel sets config.LogLevel from the level query parameter
+func setLogLevel(w http.ResponseWriter, r *http.Request) {
+	level, err := config.ParseLogLevel(r.URL.Query().Get("level"))
+	if err != nil {
+		http.Error(w, "level must be 'debug', 'info', 'warn' or 'error'", http.StatusBadRequest)
+		return
+	}
 
-		// Handle preflight requests
-		if r.Method == http.MethodOptions {
-			w.WriteHeader(http.StatusNoContent)
-			return
-		}
+	previous := config.LogLevel.Level()
+	config.LogLevel.Set(level)
+	slog.Warn("Log level changed", "from", previous, "to", level)
+	_, _ = w.Write([]byte("Log level set to " + level.String()))
+}
 
+// withoutDeadlines lifts the server's read and write timeouts for the given
+// procedures, whose streams stay open for as long as the client listens
+func withoutDeadlines(next http.Handler, procedures ...string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if slices.Contains(procedures, r.URL.Path) {
+			rc := http.NewResponseController(w)
+			_ = rc.SetReadDeadline(time.Time{})
+			_ = rc.SetWriteDeadline(time.Time{})
+		}
 		next.ServeHTTP(w, r)
 	})
 }
@@ -246,14 +407,15 @@ func loggingInterceptor() connect.UnaryInterceptorFunc {
 			resp, err := next(ctx, req)
 
 			duration := time.Since(start)
+			logger := logging.WithContext(ctx)
 			if err != nil {
-				slog.Error("RPC failed",
+				logger.Error("RPC failed",
 					"procedure", procedure,
 					"duration", duration,
 					"error", err,
 				)
 			} else {
-				slog.Info("RPC completed",
+				logger.Info("RPC completed",
 					"procedure", procedure,
 					"duration", duration,
 				)
@@ -263,3 +425,190 @@ func loggingInterceptor() connect.UnaryInterceptorFunc {
 		}
 	}
 }
+
+// rpcInterceptor records the ems_rpc_* metrics for unary and streaming RPCs
+type rpcInterceptor struct{}
+
+func metricsInterceptor() connect.Interceptor {
+	return rpcInterceptor{}
+}
+
+func (rpcInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		start := time.Now()
+		resp, err := next(ctx, req)
+		metrics.ObserveRPC(req.Spec().Procedure, rpcStatus(err), time.Since(start))
+		return resp, err
+	}
+}
+
+func (rpcInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (rpcInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		start := time.Now()
+		err := next(ctx, conn)
+		metrics.ObserveRPC(conn.Spec().Procedure, rpcStatus(err), time.Since(start))
+		return err
+	}
+}
+
+// traceInterceptor starts a server span per RPC, named after the procedure
+// and continuing the caller's trace from its traceparent and tracestate
+// headers. The trace context is echoed in the response headers.
+type traceInterceptor struct {
+	enabled bool
+}
+
+// tracingInterceptor passes RPCs through untouched unless enabled
+func tracingInterceptor(enabled bool) connect.Interceptor {
+	return traceInterceptor{enabled: enabled}
+}
+
+func (t traceInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	if !t.enabled {
+		return next
+	}
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+		ctx, span := startRPCSpan(ctx, req.Spec().Procedure, req.Header())
+		start := time.Now()
+
+		resp, err := next(ctx, req)
+
+		endRPCSpan(span, err, time.Since(start))
+		if resp != nil {
+			otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(resp.Header()))
+		}
+		return resp, err
+	}
+}
+
+func (traceInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (t traceInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	if !t.enabled {
+		return next
+	}
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, span := startRPCSpan(ctx, conn.Spec().Procedure, conn.RequestHeader())
+		start := time.Now()
+		// Response headers go out with the first message, so set them up front
+		otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(conn.ResponseHeader()))
+
+		err := next(ctx, conn)
+
+		endRPCSpan(span, err, time.Since(start))
+		return err
+	}
+}
+
+func startRPCSpan(ctx context.Context, procedure string, header http.Header) (context.Context, trace.Span) {
+	ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(header))
+	return telemetry.Tracer().Start(ctx, procedure,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("rpc.system", "connect_rpc"),
+			attribute.String("rpc.procedure", procedure),
+		),
+	)
+}
+
+func endRPCSpan(span trace.Span, err error, duration time.Duration) {
+	span.SetAttributes(
+		attribute.String("rpc.status", rpcStatus(err)),
+		attribute.Int64("rpc.duration_ms", duration.Milliseconds()),
+	)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// rpcStatus is the status label of an RPC result
+func rpcStatus(err error) string {
+	if err == nil {
+		return "ok"
+	}
+	return connect.CodeOf(err).String()
+}
+
+// streamCountersKey is the context key for the message counters of a stream
+type streamCountersKey struct{}
+
+// streamCounters tracks messages on a streaming RPC; updated atomically since
+// handlers may send and receive from different goroutines
+type streamCounters struct {
+	sent     atomic.Int64
+	received atomic.Int64
+}
+
+// streamingInterceptor logs streaming RPCs when they close.
+// Unary RPCs pass through untouched; loggingInterceptor covers those.
+type streamingInterceptor struct{}
+
+func streamingLoggingInterceptor() connect.Interceptor {
+	return streamingInterceptor{}
+}
+
+func (streamingInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return next
+}
+
+func (streamingInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (streamingInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		start := time.Now()
+		counters := &streamCounters{}
+		ctx = context.WithValue(ctx, streamCountersKey{}, counters)
+
+		err := next(ctx, &countingHandlerConn{StreamingHandlerConn: conn, counters: counters})
+
+		logger := logging.WithContext(ctx)
+		attrs := []any{
+			"procedure", conn.Spec().Procedure,
+			"duration", time.Since(start),
+			"messagesSent", counters.sent.Load(),
+			"messagesReceived", counters.received.Load(),
+		}
+		if err != nil {
+			logger.Error("Stream failed", append(attrs, "error", err)...)
+		} else {
+			logger.Info("Stream completed", attrs...)
+		}
+
+		return err
+	}
+}
+
+// countingHandlerConn counts messages passing through a streaming handler
+type countingHandlerConn struct {
+	connect.StreamingHandlerConn
+	counters *streamCounters
+}
+
+func (c *countingHandlerConn) Send(msg any) error {
+	if err := c.StreamingHandlerConn.Send(msg); err != nil {
+		return err
+	}
+	c.counters.sent.Add(1)
+	return nil
+}
+
+func (c *countingHandlerConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	c.counters.received.Add(1)
+	return nil
+}
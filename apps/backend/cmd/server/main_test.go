@@ -0,0 +1,68 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/studyverse/ems-backend/internal/config"
+)
+
+func TestSetLogLevel(t *testing.T) {
+	previous := config.LogLevel.Level()
+	t.Cleanup(func() { config.LogLevel.Set(previous) })
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: config.LogLevel}))
+	handler := requireAdminSecret("secret", setLogLevel)
+
+	put := func(level, secret string) int {
+		req := httptest.NewRequest(http.MethodPut, "/admin/log-level?level="+level, nil)
+		req.Header.Set("X-Admin-Secret", secret)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	config.LogLevel.Set(slog.LevelInfo)
+	if logger.Enabled(context.Background(), slog.LevelDebug) {
+		t.Fatal("debug logs enabled at info")
+	}
+
+	if code := put("DEBUG", "secret"); code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", code)
+	}
+	logger.Debug("now visible")
+	if !bytes.Contains(logs.Bytes(), []byte("now visible")) {
+		t.Error("debug log dropped after switching to debug")
+	}
+
+	if code := put("error", "secret"); code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", code)
+	}
+	if logger.Enabled(context.Background(), slog.LevelWarn) {
+		t.Error("warnings still enabled after switching to error")
+	}
+
+	tests := []struct {
+		name, level, secret string
+		want                int
+	}{
+		{"unknown level", "verbose", "secret", http.StatusBadRequest},
+		{"missing level", "", "secret", http.StatusBadRequest},
+		{"wrong secret", "debug", "guess", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := put(tt.level, tt.secret); code != tt.want {
+				t.Errorf("status = %d, want %d", code, tt.want)
+			}
+			if got := config.LogLevel.Level(); got != slog.LevelError {
+				t.Errorf("level changed to %v by a rejected request", got)
+			}
+		})
+	}
+}
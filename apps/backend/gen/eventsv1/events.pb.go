@@ -28,6 +28,7 @@ const (
 	EventFormat_EVENT_FORMAT_UNSPECIFIED EventFormat = 0
 	EventFormat_EVENT_FORMAT_ONLINE      EventFormat = 1
 	EventFormat_EVENT_FORMAT_OFFLINE     EventFormat = 2
+	EventFormat_EVENT_FORMAT_HYBRID      EventFormat = 3
 )
 
 // Enum value maps for EventFormat.
@@ -36,11 +37,13 @@ var (
 		0: "EVENT_FORMAT_UNSPECIFIED",
 		1: "EVENT_FORMAT_ONLINE",
 		2: "EVENT_FORMAT_OFFLINE",
+		3: "EVENT_FORMAT_HYBRID",
 	}
 	EventFormat_value = map[string]int32{
 		"EVENT_FORMAT_UNSPECIFIED": 0,
 		"EVENT_FORMAT_ONLINE":      1,
 		"EVENT_FORMAT_OFFLINE":     2,
+		"EVENT_FORMAT_HYBRID":      3,
 	}
 )
 
@@ -71,6 +74,58 @@ func (EventFormat) EnumDescriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{0}
 }
 
+type ClubRole int32
+
+const (
+	ClubRole_CLUB_ROLE_UNSPECIFIED ClubRole = 0
+	ClubRole_CLUB_ROLE_PRESIDENT   ClubRole = 1
+	ClubRole_CLUB_ROLE_STAFF       ClubRole = 2
+	ClubRole_CLUB_ROLE_MEMBER      ClubRole = 3
+)
+
+// Enum value maps for ClubRole.
+var (
+	ClubRole_name = map[int32]string{
+		0: "CLUB_ROLE_UNSPECIFIED",
+		1: "CLUB_ROLE_PRESIDENT",
+		2: "CLUB_ROLE_STAFF",
+		3: "CLUB_ROLE_MEMBER",
+	}
+	ClubRole_value = map[string]int32{
+		"CLUB_ROLE_UNSPECIFIED": 0,
+		"CLUB_ROLE_PRESIDENT":   1,
+		"CLUB_ROLE_STAFF":       2,
+		"CLUB_ROLE_MEMBER":      3,
+	}
+)
+
+func (x ClubRole) Enum() *ClubRole {
+	p := new(ClubRole)
+	*p = x
+	return p
+}
+
+func (x ClubRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClubRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_eventsv1_events_proto_enumTypes[1].Descriptor()
+}
+
+func (ClubRole) Type() protoreflect.EnumType {
+	return &file_eventsv1_events_proto_enumTypes[1]
+}
+
+func (x ClubRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClubRole.Descriptor instead.
+func (ClubRole) EnumDescriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{1}
+}
+
 type OrganizationStatus int32
 
 const (
@@ -107,11 +162,11 @@ func (x OrganizationStatus) String() string {
 }
 
 func (OrganizationStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_eventsv1_events_proto_enumTypes[1].Descriptor()
+	return file_eventsv1_events_proto_enumTypes[2].Descriptor()
 }
 
 func (OrganizationStatus) Type() protoreflect.EnumType {
-	return &file_eventsv1_events_proto_enumTypes[1]
+	return &file_eventsv1_events_proto_enumTypes[2]
 }
 
 func (x OrganizationStatus) Number() protoreflect.EnumNumber {
@@ -120,16 +175,72 @@ func (x OrganizationStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use OrganizationStatus.Descriptor instead.
 func (OrganizationStatus) EnumDescriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{1}
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{2}
+}
+
+type InquiryStatus int32
+
+const (
+	InquiryStatus_INQUIRY_STATUS_UNSPECIFIED InquiryStatus = 0
+	InquiryStatus_INQUIRY_STATUS_OPEN        InquiryStatus = 1
+	InquiryStatus_INQUIRY_STATUS_IN_PROGRESS InquiryStatus = 2
+	InquiryStatus_INQUIRY_STATUS_RESOLVED    InquiryStatus = 3
+	InquiryStatus_INQUIRY_STATUS_SPAM        InquiryStatus = 4
+)
+
+// Enum value maps for InquiryStatus.
+var (
+	InquiryStatus_name = map[int32]string{
+		0: "INQUIRY_STATUS_UNSPECIFIED",
+		1: "INQUIRY_STATUS_OPEN",
+		2: "INQUIRY_STATUS_IN_PROGRESS",
+		3: "INQUIRY_STATUS_RESOLVED",
+		4: "INQUIRY_STATUS_SPAM",
+	}
+	InquiryStatus_value = map[string]int32{
+		"INQUIRY_STATUS_UNSPECIFIED": 0,
+		"INQUIRY_STATUS_OPEN":        1,
+		"INQUIRY_STATUS_IN_PROGRESS": 2,
+		"INQUIRY_STATUS_RESOLVED":    3,
+		"INQUIRY_STATUS_SPAM":        4,
+	}
+)
+
+func (x InquiryStatus) Enum() *InquiryStatus {
+	p := new(InquiryStatus)
+	*p = x
+	return p
+}
+
+func (x InquiryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InquiryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_eventsv1_events_proto_enumTypes[3].Descriptor()
+}
+
+func (InquiryStatus) Type() protoreflect.EnumType {
+	return &file_eventsv1_events_proto_enumTypes[3]
+}
+
+func (x InquiryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InquiryStatus.Descriptor instead.
+func (InquiryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{3}
 }
 
 type RegistrationStatus int32
 
 const (
-	RegistrationStatus_REGISTRATION_STATUS_UNSPECIFIED RegistrationStatus = 0
-	RegistrationStatus_REGISTRATION_STATUS_REGISTERED  RegistrationStatus = 1
-	RegistrationStatus_REGISTRATION_STATUS_CANCELLED   RegistrationStatus = 2
-	RegistrationStatus_REGISTRATION_STATUS_WAITLIST    RegistrationStatus = 3
+	RegistrationStatus_REGISTRATION_STATUS_UNSPECIFIED    RegistrationStatus = 0
+	RegistrationStatus_REGISTRATION_STATUS_REGISTERED     RegistrationStatus = 1
+	RegistrationStatus_REGISTRATION_STATUS_CANCELLED      RegistrationStatus = 2
+	RegistrationStatus_REGISTRATION_STATUS_WAITLIST       RegistrationStatus = 3
+	RegistrationStatus_REGISTRATION_STATUS_NOT_REGISTERED RegistrationStatus = 4 // Only reported by GetEventRegistrationStatus
 )
 
 // Enum value maps for RegistrationStatus.
@@ -139,12 +250,14 @@ var (
 		1: "REGISTRATION_STATUS_REGISTERED",
 		2: "REGISTRATION_STATUS_CANCELLED",
 		3: "REGISTRATION_STATUS_WAITLIST",
+		4: "REGISTRATION_STATUS_NOT_REGISTERED",
 	}
 	RegistrationStatus_value = map[string]int32{
-		"REGISTRATION_STATUS_UNSPECIFIED": 0,
-		"REGISTRATION_STATUS_REGISTERED":  1,
-		"REGISTRATION_STATUS_CANCELLED":   2,
-		"REGISTRATION_STATUS_WAITLIST":    3,
+		"REGISTRATION_STATUS_UNSPECIFIED":    0,
+		"REGISTRATION_STATUS_REGISTERED":     1,
+		"REGISTRATION_STATUS_CANCELLED":      2,
+		"REGISTRATION_STATUS_WAITLIST":       3,
+		"REGISTRATION_STATUS_NOT_REGISTERED": 4,
 	}
 )
 
@@ -159,11 +272,11 @@ func (x RegistrationStatus) String() string {
 }
 
 func (RegistrationStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_eventsv1_events_proto_enumTypes[2].Descriptor()
+	return file_eventsv1_events_proto_enumTypes[4].Descriptor()
 }
 
 func (RegistrationStatus) Type() protoreflect.EnumType {
-	return &file_eventsv1_events_proto_enumTypes[2]
+	return &file_eventsv1_events_proto_enumTypes[4]
 }
 
 func (x RegistrationStatus) Number() protoreflect.EnumNumber {
@@ -172,7 +285,7 @@ func (x RegistrationStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RegistrationStatus.Descriptor instead.
 func (RegistrationStatus) EnumDescriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{2}
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{4}
 }
 
 type AttendanceStatus int32
@@ -211,11 +324,11 @@ func (x AttendanceStatus) String() string {
 }
 
 func (AttendanceStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_eventsv1_events_proto_enumTypes[3].Descriptor()
+	return file_eventsv1_events_proto_enumTypes[5].Descriptor()
 }
 
 func (AttendanceStatus) Type() protoreflect.EnumType {
-	return &file_eventsv1_events_proto_enumTypes[3]
+	return &file_eventsv1_events_proto_enumTypes[5]
 }
 
 func (x AttendanceStatus) Number() protoreflect.EnumNumber {
@@ -224,18 +337,273 @@ func (x AttendanceStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AttendanceStatus.Descriptor instead.
 func (AttendanceStatus) EnumDescriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{3}
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{5}
+}
+
+type WebhookDeliveryStatus int32
+
+const (
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED WebhookDeliveryStatus = 0
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING     WebhookDeliveryStatus = 1
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED   WebhookDeliveryStatus = 2
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED      WebhookDeliveryStatus = 3 // Failed, waiting for retry
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD        WebhookDeliveryStatus = 4 // Retries exhausted
+)
+
+// Enum value maps for WebhookDeliveryStatus.
+var (
+	WebhookDeliveryStatus_name = map[int32]string{
+		0: "WEBHOOK_DELIVERY_STATUS_UNSPECIFIED",
+		1: "WEBHOOK_DELIVERY_STATUS_PENDING",
+		2: "WEBHOOK_DELIVERY_STATUS_SUCCEEDED",
+		3: "WEBHOOK_DELIVERY_STATUS_FAILED",
+		4: "WEBHOOK_DELIVERY_STATUS_DEAD",
+	}
+	WebhookDeliveryStatus_value = map[string]int32{
+		"WEBHOOK_DELIVERY_STATUS_UNSPECIFIED": 0,
+		"WEBHOOK_DELIVERY_STATUS_PENDING":     1,
+		"WEBHOOK_DELIVERY_STATUS_SUCCEEDED":   2,
+		"WEBHOOK_DELIVERY_STATUS_FAILED":      3,
+		"WEBHOOK_DELIVERY_STATUS_DEAD":        4,
+	}
+)
+
+func (x WebhookDeliveryStatus) Enum() *WebhookDeliveryStatus {
+	p := new(WebhookDeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x WebhookDeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookDeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_eventsv1_events_proto_enumTypes[6].Descriptor()
+}
+
+func (WebhookDeliveryStatus) Type() protoreflect.EnumType {
+	return &file_eventsv1_events_proto_enumTypes[6]
+}
+
+func (x WebhookDeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookDeliveryStatus.Descriptor instead.
+func (WebhookDeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{6}
+}
+
+// TagSortBy orders ListTags results
+type TagSortBy int32
+
+const (
+	TagSortBy_TAG_SORT_BY_UNSPECIFIED      TagSortBy = 0 // By ID
+	TagSortBy_TAG_SORT_BY_EVENT_COUNT_DESC TagSortBy = 1 // Most used first
+)
+
+// Enum value maps for TagSortBy.
+var (
+	TagSortBy_name = map[int32]string{
+		0: "TAG_SORT_BY_UNSPECIFIED",
+		1: "TAG_SORT_BY_EVENT_COUNT_DESC",
+	}
+	TagSortBy_value = map[string]int32{
+		"TAG_SORT_BY_UNSPECIFIED":      0,
+		"TAG_SORT_BY_EVENT_COUNT_DESC": 1,
+	}
+)
+
+func (x TagSortBy) Enum() *TagSortBy {
+	p := new(TagSortBy)
+	*p = x
+	return p
+}
+
+func (x TagSortBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TagSortBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_eventsv1_events_proto_enumTypes[7].Descriptor()
+}
+
+func (TagSortBy) Type() protoreflect.EnumType {
+	return &file_eventsv1_events_proto_enumTypes[7]
+}
+
+func (x TagSortBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TagSortBy.Descriptor instead.
+func (TagSortBy) EnumDescriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{7}
+}
+
+// Events the user can edit, including club events they didn't create
+// Which of a user's managed events GetManagedEvents returns
+type ManagedEventRole int32
+
+const (
+	ManagedEventRole_MANAGED_EVENT_ROLE_UNSPECIFIED ManagedEventRole = 0 // Every event the user can edit
+	ManagedEventRole_MANAGED_EVENT_ROLE_CREATOR     ManagedEventRole = 1 // Only events the user created
+)
+
+// Enum value maps for ManagedEventRole.
+var (
+	ManagedEventRole_name = map[int32]string{
+		0: "MANAGED_EVENT_ROLE_UNSPECIFIED",
+		1: "MANAGED_EVENT_ROLE_CREATOR",
+	}
+	ManagedEventRole_value = map[string]int32{
+		"MANAGED_EVENT_ROLE_UNSPECIFIED": 0,
+		"MANAGED_EVENT_ROLE_CREATOR":     1,
+	}
+)
+
+func (x ManagedEventRole) Enum() *ManagedEventRole {
+	p := new(ManagedEventRole)
+	*p = x
+	return p
+}
+
+func (x ManagedEventRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ManagedEventRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_eventsv1_events_proto_enumTypes[8].Descriptor()
+}
+
+func (ManagedEventRole) Type() protoreflect.EnumType {
+	return &file_eventsv1_events_proto_enumTypes[8]
+}
+
+func (x ManagedEventRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ManagedEventRole.Descriptor instead.
+func (ManagedEventRole) EnumDescriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{8}
+}
+
+// ClubLeaderboardSortBy orders GetTopPerformingClubs and GetClubLeaderboard results
+type ClubLeaderboardSortBy int32
+
+const (
+	ClubLeaderboardSortBy_CLUB_LEADERBOARD_SORT_BY_UNSPECIFIED              ClubLeaderboardSortBy = 0 // Same as TOTAL_EVENTS_DESC
+	ClubLeaderboardSortBy_CLUB_LEADERBOARD_SORT_BY_TOTAL_EVENTS_DESC        ClubLeaderboardSortBy = 1
+	ClubLeaderboardSortBy_CLUB_LEADERBOARD_SORT_BY_TOTAL_REGISTRATIONS_DESC ClubLeaderboardSortBy = 2
+	ClubLeaderboardSortBy_CLUB_LEADERBOARD_SORT_BY_AVG_ATTENDANCE_RATE_DESC ClubLeaderboardSortBy = 3
+)
+
+// Enum value maps for ClubLeaderboardSortBy.
+var (
+	ClubLeaderboardSortBy_name = map[int32]string{
+		0: "CLUB_LEADERBOARD_SORT_BY_UNSPECIFIED",
+		1: "CLUB_LEADERBOARD_SORT_BY_TOTAL_EVENTS_DESC",
+		2: "CLUB_LEADERBOARD_SORT_BY_TOTAL_REGISTRATIONS_DESC",
+		3: "CLUB_LEADERBOARD_SORT_BY_AVG_ATTENDANCE_RATE_DESC",
+	}
+	ClubLeaderboardSortBy_value = map[string]int32{
+		"CLUB_LEADERBOARD_SORT_BY_UNSPECIFIED":              0,
+		"CLUB_LEADERBOARD_SORT_BY_TOTAL_EVENTS_DESC":        1,
+		"CLUB_LEADERBOARD_SORT_BY_TOTAL_REGISTRATIONS_DESC": 2,
+		"CLUB_LEADERBOARD_SORT_BY_AVG_ATTENDANCE_RATE_DESC": 3,
+	}
+)
+
+func (x ClubLeaderboardSortBy) Enum() *ClubLeaderboardSortBy {
+	p := new(ClubLeaderboardSortBy)
+	*p = x
+	return p
+}
+
+func (x ClubLeaderboardSortBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClubLeaderboardSortBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_eventsv1_events_proto_enumTypes[9].Descriptor()
+}
+
+func (ClubLeaderboardSortBy) Type() protoreflect.EnumType {
+	return &file_eventsv1_events_proto_enumTypes[9]
+}
+
+func (x ClubLeaderboardSortBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClubLeaderboardSortBy.Descriptor instead.
+func (ClubLeaderboardSortBy) EnumDescriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{9}
+}
+
+// TopEventsSortBy orders GetTopPerformingEvents results
+type TopEventsSortBy int32
+
+const (
+	TopEventsSortBy_TOP_EVENTS_SORT_BY_UNSPECIFIED              TopEventsSortBy = 0 // Same as TOTAL_REGISTRATIONS_DESC
+	TopEventsSortBy_TOP_EVENTS_SORT_BY_TOTAL_REGISTRATIONS_DESC TopEventsSortBy = 1
+	TopEventsSortBy_TOP_EVENTS_SORT_BY_ATTENDANCE_RATE_DESC     TopEventsSortBy = 2
+)
+
+// Enum value maps for TopEventsSortBy.
+var (
+	TopEventsSortBy_name = map[int32]string{
+		0: "TOP_EVENTS_SORT_BY_UNSPECIFIED",
+		1: "TOP_EVENTS_SORT_BY_TOTAL_REGISTRATIONS_DESC",
+		2: "TOP_EVENTS_SORT_BY_ATTENDANCE_RATE_DESC",
+	}
+	TopEventsSortBy_value = map[string]int32{
+		"TOP_EVENTS_SORT_BY_UNSPECIFIED":              0,
+		"TOP_EVENTS_SORT_BY_TOTAL_REGISTRATIONS_DESC": 1,
+		"TOP_EVENTS_SORT_BY_ATTENDANCE_RATE_DESC":     2,
+	}
+)
+
+func (x TopEventsSortBy) Enum() *TopEventsSortBy {
+	p := new(TopEventsSortBy)
+	*p = x
+	return p
+}
+
+func (x TopEventsSortBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TopEventsSortBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_eventsv1_events_proto_enumTypes[10].Descriptor()
+}
+
+func (TopEventsSortBy) Type() protoreflect.EnumType {
+	return &file_eventsv1_events_proto_enumTypes[10]
+}
+
+func (x TopEventsSortBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TopEventsSortBy.Descriptor instead.
+func (TopEventsSortBy) EnumDescriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{10}
 }
 
 // Messages
 type OrganizationType struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title             string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	CreatedAt         string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         string                 `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	OrganizationCount int32                  `protobuf:"varint,5,opt,name=organization_count,json=organizationCount,proto3" json:"organization_count,omitempty"` // Set by GetOrganizationType and ListOrganizationTypes
+	TotalEvents       int32                  `protobuf:"varint,6,opt,name=total_events,json=totalEvents,proto3" json:"total_events,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *OrganizationType) Reset() {
@@ -296,6 +664,20 @@ func (x *OrganizationType) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *OrganizationType) GetOrganizationCount() int32 {
+	if x != nil {
+		return x.OrganizationCount
+	}
+	return 0
+}
+
+func (x *OrganizationType) GetTotalEvents() int32 {
+	if x != nil {
+		return x.TotalEvents
+	}
+	return 0
+}
+
 type Organization struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	Id                 int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -313,6 +695,7 @@ type Organization struct {
 	Status             OrganizationStatus     `protobuf:"varint,13,opt,name=status,proto3,enum=events.v1.OrganizationStatus" json:"status,omitempty"`
 	CreatedAt          string                 `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt          string                 `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	MonthlyEventQuota  *int32                 `protobuf:"varint,16,opt,name=monthly_event_quota,json=monthlyEventQuota,proto3,oneof" json:"monthly_event_quota,omitempty"` // Max events per calendar month, unset = unlimited
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -452,14 +835,23 @@ func (x *Organization) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *Organization) GetMonthlyEventQuota() int32 {
+	if x != nil && x.MonthlyEventQuota != nil {
+		return *x.MonthlyEventQuota
+	}
+	return 0
+}
+
 type Tag struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt         string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         string                 `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	OrganizationCount int32                  `protobuf:"varint,5,opt,name=organization_count,json=organizationCount,proto3" json:"organization_count,omitempty"` // Distinct organizations with events using this tag
+	EventCount        int32                  `protobuf:"varint,6,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`                      // Events using this tag
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Tag) Reset() {
@@ -520,6 +912,20 @@ func (x *Tag) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *Tag) GetOrganizationCount() int32 {
+	if x != nil {
+		return x.OrganizationCount
+	}
+	return 0
+}
+
+func (x *Tag) GetEventCount() int32 {
+	if x != nil {
+		return x.EventCount
+	}
+	return 0
+}
+
 type Event struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	Id                 int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -539,6 +945,11 @@ type Event struct {
 	TotalAttendees     int32                  `protobuf:"varint,15,opt,name=total_attendees,json=totalAttendees,proto3" json:"total_attendees,omitempty"`
 	Organization       *Organization          `protobuf:"bytes,16,opt,name=organization,proto3,oneof" json:"organization,omitempty"`
 	Tags               []*Tag                 `protobuf:"bytes,17,rep,name=tags,proto3" json:"tags,omitempty"`
+	Capacity           *int32                 `protobuf:"varint,18,opt,name=capacity,proto3,oneof" json:"capacity,omitempty"`                               // Max registrations, unset = unlimited
+	CreatorUsername    string                 `protobuf:"bytes,19,opt,name=creator_username,json=creatorUsername,proto3" json:"creator_username,omitempty"` // Set by GetEvent and event listings
+	Published          bool                   `protobuf:"varint,20,opt,name=published,proto3" json:"published,omitempty"`                                   // Unpublished events are hidden from listings and search
+	DeletedAt          *string                `protobuf:"bytes,21,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`             // Only set on soft-deleted events
+	Version            int32                  `protobuf:"varint,22,opt,name=version,proto3" json:"version,omitempty"`                                       // Pass back as UpdateEventRequest.expected_version
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -692,22 +1103,58 @@ func (x *Event) GetTags() []*Tag {
 	return nil
 }
 
-type EventRegistration struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	EventId       int32                  `protobuf:"varint,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	UserId        int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Status        RegistrationStatus     `protobuf:"varint,4,opt,name=status,proto3,enum=events.v1.RegistrationStatus" json:"status,omitempty"`
-	RegisteredAt  string                 `protobuf:"bytes,5,opt,name=registered_at,json=registeredAt,proto3" json:"registered_at,omitempty"`
-	CancelledAt   *string                `protobuf:"bytes,6,opt,name=cancelled_at,json=cancelledAt,proto3,oneof" json:"cancelled_at,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Event) GetCapacity() int32 {
+	if x != nil && x.Capacity != nil {
+		return *x.Capacity
+	}
+	return 0
 }
 
-func (x *EventRegistration) Reset() {
-	*x = EventRegistration{}
+func (x *Event) GetCreatorUsername() string {
+	if x != nil {
+		return x.CreatorUsername
+	}
+	return ""
+}
+
+func (x *Event) GetPublished() bool {
+	if x != nil {
+		return x.Published
+	}
+	return false
+}
+
+func (x *Event) GetDeletedAt() string {
+	if x != nil && x.DeletedAt != nil {
+		return *x.DeletedAt
+	}
+	return ""
+}
+
+func (x *Event) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type EventRegistration struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId       int32                  `protobuf:"varint,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        RegistrationStatus     `protobuf:"varint,4,opt,name=status,proto3,enum=events.v1.RegistrationStatus" json:"status,omitempty"`
+	RegisteredAt  string                 `protobuf:"bytes,5,opt,name=registered_at,json=registeredAt,proto3" json:"registered_at,omitempty"`
+	CancelledAt   *string                `protobuf:"bytes,6,opt,name=cancelled_at,json=cancelledAt,proto3,oneof" json:"cancelled_at,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Event         *Event                 `protobuf:"bytes,9,opt,name=event,proto3,oneof" json:"event,omitempty"` // Populated when requested with include_event
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventRegistration) Reset() {
+	*x = EventRegistration{}
 	mi := &file_eventsv1_events_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
@@ -792,6 +1239,13 @@ func (x *EventRegistration) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *EventRegistration) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
 type EventAttendance struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1326,11 +1780,13 @@ func (x *GetOrganizationResponse) GetOrganization() *Organization {
 }
 
 type ListOrganizationsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Page               int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit              int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	StatusFilter       *OrganizationStatus    `protobuf:"varint,3,opt,name=status_filter,json=statusFilter,proto3,enum=events.v1.OrganizationStatus,oneof" json:"status_filter,omitempty"`
+	OrganizationTypeId *int32                 `protobuf:"varint,4,opt,name=organization_type_id,json=organizationTypeId,proto3,oneof" json:"organization_type_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *ListOrganizationsRequest) Reset() {
@@ -1377,6 +1833,20 @@ func (x *ListOrganizationsRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListOrganizationsRequest) GetStatusFilter() OrganizationStatus {
+	if x != nil && x.StatusFilter != nil {
+		return *x.StatusFilter
+	}
+	return OrganizationStatus_ORGANIZATION_STATUS_UNSPECIFIED
+}
+
+func (x *ListOrganizationsRequest) GetOrganizationTypeId() int32 {
+	if x != nil && x.OrganizationTypeId != nil {
+		return *x.OrganizationTypeId
+	}
+	return 0
+}
+
 type ListOrganizationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Organizations []*Organization        `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
@@ -1430,22 +1900,25 @@ func (x *ListOrganizationsResponse) GetTotal() int32 {
 }
 
 type UpdateOrganizationRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title              *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	ImageUrl           *string                `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
-	Description        *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	OrganizationTypeId *int32                 `protobuf:"varint,5,opt,name=organization_type_id,json=organizationTypeId,proto3,oneof" json:"organization_type_id,omitempty"`
-	Instagram          *string                `protobuf:"bytes,6,opt,name=instagram,proto3,oneof" json:"instagram,omitempty"`
-	TelegramChannel    *string                `protobuf:"bytes,7,opt,name=telegram_channel,json=telegramChannel,proto3,oneof" json:"telegram_channel,omitempty"`
-	TelegramChat       *string                `protobuf:"bytes,8,opt,name=telegram_chat,json=telegramChat,proto3,oneof" json:"telegram_chat,omitempty"`
-	Website            *string                `protobuf:"bytes,9,opt,name=website,proto3,oneof" json:"website,omitempty"`
-	Youtube            *string                `protobuf:"bytes,10,opt,name=youtube,proto3,oneof" json:"youtube,omitempty"`
-	Tiktok             *string                `protobuf:"bytes,11,opt,name=tiktok,proto3,oneof" json:"tiktok,omitempty"`
-	Linkedin           *string                `protobuf:"bytes,12,opt,name=linkedin,proto3,oneof" json:"linkedin,omitempty"`
-	Status             *OrganizationStatus    `protobuf:"varint,13,opt,name=status,proto3,enum=events.v1.OrganizationStatus,oneof" json:"status,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Id                     int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title                  *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	ImageUrl               *string                `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
+	Description            *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	OrganizationTypeId     *int32                 `protobuf:"varint,5,opt,name=organization_type_id,json=organizationTypeId,proto3,oneof" json:"organization_type_id,omitempty"`
+	Instagram              *string                `protobuf:"bytes,6,opt,name=instagram,proto3,oneof" json:"instagram,omitempty"`
+	TelegramChannel        *string                `protobuf:"bytes,7,opt,name=telegram_channel,json=telegramChannel,proto3,oneof" json:"telegram_channel,omitempty"`
+	TelegramChat           *string                `protobuf:"bytes,8,opt,name=telegram_chat,json=telegramChat,proto3,oneof" json:"telegram_chat,omitempty"`
+	Website                *string                `protobuf:"bytes,9,opt,name=website,proto3,oneof" json:"website,omitempty"`
+	Youtube                *string                `protobuf:"bytes,10,opt,name=youtube,proto3,oneof" json:"youtube,omitempty"`
+	Tiktok                 *string                `protobuf:"bytes,11,opt,name=tiktok,proto3,oneof" json:"tiktok,omitempty"`
+	Linkedin               *string                `protobuf:"bytes,12,opt,name=linkedin,proto3,oneof" json:"linkedin,omitempty"`
+	Status                 *OrganizationStatus    `protobuf:"varint,13,opt,name=status,proto3,enum=events.v1.OrganizationStatus,oneof" json:"status,omitempty"`
+	MonthlyEventQuota      *int32                 `protobuf:"varint,14,opt,name=monthly_event_quota,json=monthlyEventQuota,proto3,oneof" json:"monthly_event_quota,omitempty"`            // Platform admins only
+	ContactEmail           *string                `protobuf:"bytes,15,opt,name=contact_email,json=contactEmail,proto3,oneof" json:"contact_email,omitempty"`                              // Inquiries are forwarded here
+	ClearMonthlyEventQuota bool                   `protobuf:"varint,16,opt,name=clear_monthly_event_quota,json=clearMonthlyEventQuota,proto3" json:"clear_monthly_event_quota,omitempty"` // Removes the quota, platform admins only
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *UpdateOrganizationRequest) Reset() {
@@ -1569,6 +2042,27 @@ func (x *UpdateOrganizationRequest) GetStatus() OrganizationStatus {
 	return OrganizationStatus_ORGANIZATION_STATUS_UNSPECIFIED
 }
 
+func (x *UpdateOrganizationRequest) GetMonthlyEventQuota() int32 {
+	if x != nil && x.MonthlyEventQuota != nil {
+		return *x.MonthlyEventQuota
+	}
+	return 0
+}
+
+func (x *UpdateOrganizationRequest) GetContactEmail() string {
+	if x != nil && x.ContactEmail != nil {
+		return *x.ContactEmail
+	}
+	return ""
+}
+
+func (x *UpdateOrganizationRequest) GetClearMonthlyEventQuota() bool {
+	if x != nil {
+		return x.ClearMonthlyEventQuota
+	}
+	return false
+}
+
 type UpdateOrganizationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
@@ -1613,27 +2107,27 @@ func (x *UpdateOrganizationResponse) GetOrganization() *Organization {
 	return nil
 }
 
-type DeleteOrganizationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetOrganizationQuotaUsageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId int32                  `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *DeleteOrganizationRequest) Reset() {
-	*x = DeleteOrganizationRequest{}
+func (x *GetOrganizationQuotaUsageRequest) Reset() {
+	*x = GetOrganizationQuotaUsageRequest{}
 	mi := &file_eventsv1_events_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteOrganizationRequest) String() string {
+func (x *GetOrganizationQuotaUsageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteOrganizationRequest) ProtoMessage() {}
+func (*GetOrganizationQuotaUsageRequest) ProtoMessage() {}
 
-func (x *DeleteOrganizationRequest) ProtoReflect() protoreflect.Message {
+func (x *GetOrganizationQuotaUsageRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1645,39 +2139,41 @@ func (x *DeleteOrganizationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteOrganizationRequest.ProtoReflect.Descriptor instead.
-func (*DeleteOrganizationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOrganizationQuotaUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationQuotaUsageRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *DeleteOrganizationRequest) GetId() int32 {
+func (x *GetOrganizationQuotaUsageRequest) GetOrganizationId() int32 {
 	if x != nil {
-		return x.Id
+		return x.OrganizationId
 	}
 	return 0
 }
 
-type DeleteOrganizationResponse struct {
+type GetOrganizationQuotaUsageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Quota         *int32                 `protobuf:"varint,1,opt,name=quota,proto3,oneof" json:"quota,omitempty"`         // Unset when the organization has no quota
+	Used          int32                  `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`                 // Events created this calendar month
+	Remaining     *int32                 `protobuf:"varint,3,opt,name=remaining,proto3,oneof" json:"remaining,omitempty"` // Unset when the organization has no quota
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteOrganizationResponse) Reset() {
-	*x = DeleteOrganizationResponse{}
+func (x *GetOrganizationQuotaUsageResponse) Reset() {
+	*x = GetOrganizationQuotaUsageResponse{}
 	mi := &file_eventsv1_events_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteOrganizationResponse) String() string {
+func (x *GetOrganizationQuotaUsageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteOrganizationResponse) ProtoMessage() {}
+func (*GetOrganizationQuotaUsageResponse) ProtoMessage() {}
 
-func (x *DeleteOrganizationResponse) ProtoReflect() protoreflect.Message {
+func (x *GetOrganizationQuotaUsageResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1689,40 +2185,57 @@ func (x *DeleteOrganizationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteOrganizationResponse.ProtoReflect.Descriptor instead.
-func (*DeleteOrganizationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOrganizationQuotaUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationQuotaUsageResponse) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *DeleteOrganizationResponse) GetSuccess() bool {
+func (x *GetOrganizationQuotaUsageResponse) GetQuota() int32 {
+	if x != nil && x.Quota != nil {
+		return *x.Quota
+	}
+	return 0
+}
+
+func (x *GetOrganizationQuotaUsageResponse) GetUsed() int32 {
 	if x != nil {
-		return x.Success
+		return x.Used
 	}
-	return false
+	return 0
 }
 
-// Organization Type CRUD
-type CreateOrganizationTypeRequest struct {
+func (x *GetOrganizationQuotaUsageResponse) GetRemaining() int32 {
+	if x != nil && x.Remaining != nil {
+		return *x.Remaining
+	}
+	return 0
+}
+
+// A user's role in a club; users with several roles appear once per role
+type OrganizationMember struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateOrganizationTypeRequest) Reset() {
-	*x = CreateOrganizationTypeRequest{}
+func (x *OrganizationMember) Reset() {
+	*x = OrganizationMember{}
 	mi := &file_eventsv1_events_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOrganizationTypeRequest) String() string {
+func (x *OrganizationMember) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrganizationTypeRequest) ProtoMessage() {}
+func (*OrganizationMember) ProtoMessage() {}
 
-func (x *CreateOrganizationTypeRequest) ProtoReflect() protoreflect.Message {
+func (x *OrganizationMember) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1734,39 +2247,62 @@ func (x *CreateOrganizationTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOrganizationTypeRequest.ProtoReflect.Descriptor instead.
-func (*CreateOrganizationTypeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrganizationMember.ProtoReflect.Descriptor instead.
+func (*OrganizationMember) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *CreateOrganizationTypeRequest) GetTitle() string {
+func (x *OrganizationMember) GetUserId() int32 {
 	if x != nil {
-		return x.Title
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *OrganizationMember) GetUsername() string {
+	if x != nil {
+		return x.Username
 	}
 	return ""
 }
 
-type CreateOrganizationTypeResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationType *OrganizationType      `protobuf:"bytes,1,opt,name=organization_type,json=organizationType,proto3" json:"organization_type,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *OrganizationMember) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
 }
 
-func (x *CreateOrganizationTypeResponse) Reset() {
-	*x = CreateOrganizationTypeResponse{}
+func (x *OrganizationMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type GetOrganizationMembersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId int32                  `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Page           int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit          int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetOrganizationMembersRequest) Reset() {
+	*x = GetOrganizationMembersRequest{}
 	mi := &file_eventsv1_events_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOrganizationTypeResponse) String() string {
+func (x *GetOrganizationMembersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrganizationTypeResponse) ProtoMessage() {}
+func (*GetOrganizationMembersRequest) ProtoMessage() {}
 
-func (x *CreateOrganizationTypeResponse) ProtoReflect() protoreflect.Message {
+func (x *GetOrganizationMembersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1778,39 +2314,54 @@ func (x *CreateOrganizationTypeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOrganizationTypeResponse.ProtoReflect.Descriptor instead.
-func (*CreateOrganizationTypeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOrganizationMembersRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationMembersRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *CreateOrganizationTypeResponse) GetOrganizationType() *OrganizationType {
+func (x *GetOrganizationMembersRequest) GetOrganizationId() int32 {
 	if x != nil {
-		return x.OrganizationType
+		return x.OrganizationId
 	}
-	return nil
+	return 0
 }
 
-type GetOrganizationTypeRequest struct {
+func (x *GetOrganizationMembersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetOrganizationMembersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetOrganizationMembersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Members       []*OrganizationMember  `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrganizationTypeRequest) Reset() {
-	*x = GetOrganizationTypeRequest{}
+func (x *GetOrganizationMembersResponse) Reset() {
+	*x = GetOrganizationMembersResponse{}
 	mi := &file_eventsv1_events_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrganizationTypeRequest) String() string {
+func (x *GetOrganizationMembersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrganizationTypeRequest) ProtoMessage() {}
+func (*GetOrganizationMembersResponse) ProtoMessage() {}
 
-func (x *GetOrganizationTypeRequest) ProtoReflect() protoreflect.Message {
+func (x *GetOrganizationMembersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1822,39 +2373,49 @@ func (x *GetOrganizationTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrganizationTypeRequest.ProtoReflect.Descriptor instead.
-func (*GetOrganizationTypeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOrganizationMembersResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationMembersResponse) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetOrganizationTypeRequest) GetId() int32 {
+func (x *GetOrganizationMembersResponse) GetMembers() []*OrganizationMember {
 	if x != nil {
-		return x.Id
+		return x.Members
+	}
+	return nil
+}
+
+func (x *GetOrganizationMembersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
 	}
 	return 0
 }
 
-type GetOrganizationTypeResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationType *OrganizationType      `protobuf:"bytes,1,opt,name=organization_type,json=organizationType,proto3" json:"organization_type,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// Replaces the user's role in the club, in the database and SpiceDB
+type AssignClubRoleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId int32                  `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	UserId         int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role           ClubRole               `protobuf:"varint,3,opt,name=role,proto3,enum=events.v1.ClubRole" json:"role,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetOrganizationTypeResponse) Reset() {
-	*x = GetOrganizationTypeResponse{}
+func (x *AssignClubRoleRequest) Reset() {
+	*x = AssignClubRoleRequest{}
 	mi := &file_eventsv1_events_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrganizationTypeResponse) String() string {
+func (x *AssignClubRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrganizationTypeResponse) ProtoMessage() {}
+func (*AssignClubRoleRequest) ProtoMessage() {}
 
-func (x *GetOrganizationTypeResponse) ProtoReflect() protoreflect.Message {
+func (x *AssignClubRoleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1866,40 +2427,53 @@ func (x *GetOrganizationTypeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrganizationTypeResponse.ProtoReflect.Descriptor instead.
-func (*GetOrganizationTypeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AssignClubRoleRequest.ProtoReflect.Descriptor instead.
+func (*AssignClubRoleRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetOrganizationTypeResponse) GetOrganizationType() *OrganizationType {
+func (x *AssignClubRoleRequest) GetOrganizationId() int32 {
 	if x != nil {
-		return x.OrganizationType
+		return x.OrganizationId
 	}
-	return nil
+	return 0
 }
 
-type ListOrganizationTypesRequest struct {
+func (x *AssignClubRoleRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AssignClubRoleRequest) GetRole() ClubRole {
+	if x != nil {
+		return x.Role
+	}
+	return ClubRole_CLUB_ROLE_UNSPECIFIED
+}
+
+type AssignClubRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Member        *OrganizationMember    `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOrganizationTypesRequest) Reset() {
-	*x = ListOrganizationTypesRequest{}
+func (x *AssignClubRoleResponse) Reset() {
+	*x = AssignClubRoleResponse{}
 	mi := &file_eventsv1_events_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrganizationTypesRequest) String() string {
+func (x *AssignClubRoleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrganizationTypesRequest) ProtoMessage() {}
+func (*AssignClubRoleResponse) ProtoMessage() {}
 
-func (x *ListOrganizationTypesRequest) ProtoReflect() protoreflect.Message {
+func (x *AssignClubRoleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1911,47 +2485,40 @@ func (x *ListOrganizationTypesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrganizationTypesRequest.ProtoReflect.Descriptor instead.
-func (*ListOrganizationTypesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AssignClubRoleResponse.ProtoReflect.Descriptor instead.
+func (*AssignClubRoleResponse) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ListOrganizationTypesRequest) GetPage() int32 {
+func (x *AssignClubRoleResponse) GetMember() *OrganizationMember {
 	if x != nil {
-		return x.Page
+		return x.Member
 	}
-	return 0
+	return nil
 }
 
-func (x *ListOrganizationTypesRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-type ListOrganizationTypesResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationTypes []*OrganizationType    `protobuf:"bytes,1,rep,name=organization_types,json=organizationTypes,proto3" json:"organization_types,omitempty"`
-	Total             int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type RemoveClubMemberRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId int32                  `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	UserId         int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListOrganizationTypesResponse) Reset() {
-	*x = ListOrganizationTypesResponse{}
+func (x *RemoveClubMemberRequest) Reset() {
+	*x = RemoveClubMemberRequest{}
 	mi := &file_eventsv1_events_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrganizationTypesResponse) String() string {
+func (x *RemoveClubMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrganizationTypesResponse) ProtoMessage() {}
+func (*RemoveClubMemberRequest) ProtoMessage() {}
 
-func (x *ListOrganizationTypesResponse) ProtoReflect() protoreflect.Message {
+func (x *RemoveClubMemberRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1963,47 +2530,46 @@ func (x *ListOrganizationTypesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrganizationTypesResponse.ProtoReflect.Descriptor instead.
-func (*ListOrganizationTypesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveClubMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveClubMemberRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ListOrganizationTypesResponse) GetOrganizationTypes() []*OrganizationType {
+func (x *RemoveClubMemberRequest) GetOrganizationId() int32 {
 	if x != nil {
-		return x.OrganizationTypes
+		return x.OrganizationId
 	}
-	return nil
+	return 0
 }
 
-func (x *ListOrganizationTypesResponse) GetTotal() int32 {
+func (x *RemoveClubMemberRequest) GetUserId() int32 {
 	if x != nil {
-		return x.Total
+		return x.UserId
 	}
 	return 0
 }
 
-type UpdateOrganizationTypeRequest struct {
+type RemoveClubMemberResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateOrganizationTypeRequest) Reset() {
-	*x = UpdateOrganizationTypeRequest{}
+func (x *RemoveClubMemberResponse) Reset() {
+	*x = RemoveClubMemberResponse{}
 	mi := &file_eventsv1_events_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOrganizationTypeRequest) String() string {
+func (x *RemoveClubMemberResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrganizationTypeRequest) ProtoMessage() {}
+func (*RemoveClubMemberResponse) ProtoMessage() {}
 
-func (x *UpdateOrganizationTypeRequest) ProtoReflect() protoreflect.Message {
+func (x *RemoveClubMemberResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2015,46 +2581,46 @@ func (x *UpdateOrganizationTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOrganizationTypeRequest.ProtoReflect.Descriptor instead.
-func (*UpdateOrganizationTypeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveClubMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveClubMemberResponse) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *UpdateOrganizationTypeRequest) GetId() int32 {
+func (x *RemoveClubMemberResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *UpdateOrganizationTypeRequest) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-type UpdateOrganizationTypeResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationType *OrganizationType      `protobuf:"bytes,1,opt,name=organization_type,json=organizationType,proto3" json:"organization_type,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type OrganizationInquiry struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId int32                  `protobuf:"varint,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	SenderEmail    string                 `protobuf:"bytes,3,opt,name=sender_email,json=senderEmail,proto3" json:"sender_email,omitempty"`
+	SenderName     string                 `protobuf:"bytes,4,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`
+	Subject        string                 `protobuf:"bytes,5,opt,name=subject,proto3" json:"subject,omitempty"`
+	Message        string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	Status         InquiryStatus          `protobuf:"varint,7,opt,name=status,proto3,enum=events.v1.InquiryStatus" json:"status,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateOrganizationTypeResponse) Reset() {
-	*x = UpdateOrganizationTypeResponse{}
+func (x *OrganizationInquiry) Reset() {
+	*x = OrganizationInquiry{}
 	mi := &file_eventsv1_events_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOrganizationTypeResponse) String() string {
+func (x *OrganizationInquiry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrganizationTypeResponse) ProtoMessage() {}
+func (*OrganizationInquiry) ProtoMessage() {}
 
-func (x *UpdateOrganizationTypeResponse) ProtoReflect() protoreflect.Message {
+func (x *OrganizationInquiry) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2066,39 +2632,93 @@ func (x *UpdateOrganizationTypeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOrganizationTypeResponse.ProtoReflect.Descriptor instead.
-func (*UpdateOrganizationTypeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrganizationInquiry.ProtoReflect.Descriptor instead.
+func (*OrganizationInquiry) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *UpdateOrganizationTypeResponse) GetOrganizationType() *OrganizationType {
+func (x *OrganizationInquiry) GetId() int32 {
 	if x != nil {
-		return x.OrganizationType
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type DeleteOrganizationTypeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *OrganizationInquiry) GetOrganizationId() int32 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
 }
 
-func (x *DeleteOrganizationTypeRequest) Reset() {
-	*x = DeleteOrganizationTypeRequest{}
+func (x *OrganizationInquiry) GetSenderEmail() string {
+	if x != nil {
+		return x.SenderEmail
+	}
+	return ""
+}
+
+func (x *OrganizationInquiry) GetSenderName() string {
+	if x != nil {
+		return x.SenderName
+	}
+	return ""
+}
+
+func (x *OrganizationInquiry) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *OrganizationInquiry) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *OrganizationInquiry) GetStatus() InquiryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InquiryStatus_INQUIRY_STATUS_UNSPECIFIED
+}
+
+func (x *OrganizationInquiry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// Public contact form, no authentication required
+type SubmitOrganizationInquiryRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId int32                  `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	SenderEmail    string                 `protobuf:"bytes,2,opt,name=sender_email,json=senderEmail,proto3" json:"sender_email,omitempty"`
+	SenderName     string                 `protobuf:"bytes,3,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`
+	Subject        string                 `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	Message        string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"` // Max 2000 characters
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubmitOrganizationInquiryRequest) Reset() {
+	*x = SubmitOrganizationInquiryRequest{}
 	mi := &file_eventsv1_events_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteOrganizationTypeRequest) String() string {
+func (x *SubmitOrganizationInquiryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteOrganizationTypeRequest) ProtoMessage() {}
+func (*SubmitOrganizationInquiryRequest) ProtoMessage() {}
 
-func (x *DeleteOrganizationTypeRequest) ProtoReflect() protoreflect.Message {
+func (x *SubmitOrganizationInquiryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2110,39 +2730,67 @@ func (x *DeleteOrganizationTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteOrganizationTypeRequest.ProtoReflect.Descriptor instead.
-func (*DeleteOrganizationTypeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubmitOrganizationInquiryRequest.ProtoReflect.Descriptor instead.
+func (*SubmitOrganizationInquiryRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *DeleteOrganizationTypeRequest) GetId() int32 {
+func (x *SubmitOrganizationInquiryRequest) GetOrganizationId() int32 {
 	if x != nil {
-		return x.Id
+		return x.OrganizationId
 	}
 	return 0
 }
 
-type DeleteOrganizationTypeResponse struct {
+func (x *SubmitOrganizationInquiryRequest) GetSenderEmail() string {
+	if x != nil {
+		return x.SenderEmail
+	}
+	return ""
+}
+
+func (x *SubmitOrganizationInquiryRequest) GetSenderName() string {
+	if x != nil {
+		return x.SenderName
+	}
+	return ""
+}
+
+func (x *SubmitOrganizationInquiryRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *SubmitOrganizationInquiryRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SubmitOrganizationInquiryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	InquiryId     int32                  `protobuf:"varint,1,opt,name=inquiry_id,json=inquiryId,proto3" json:"inquiry_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteOrganizationTypeResponse) Reset() {
-	*x = DeleteOrganizationTypeResponse{}
+func (x *SubmitOrganizationInquiryResponse) Reset() {
+	*x = SubmitOrganizationInquiryResponse{}
 	mi := &file_eventsv1_events_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteOrganizationTypeResponse) String() string {
+func (x *SubmitOrganizationInquiryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteOrganizationTypeResponse) ProtoMessage() {}
+func (*SubmitOrganizationInquiryResponse) ProtoMessage() {}
 
-func (x *DeleteOrganizationTypeResponse) ProtoReflect() protoreflect.Message {
+func (x *SubmitOrganizationInquiryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2154,49 +2802,41 @@ func (x *DeleteOrganizationTypeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteOrganizationTypeResponse.ProtoReflect.Descriptor instead.
-func (*DeleteOrganizationTypeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubmitOrganizationInquiryResponse.ProtoReflect.Descriptor instead.
+func (*SubmitOrganizationInquiryResponse) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *DeleteOrganizationTypeResponse) GetSuccess() bool {
+func (x *SubmitOrganizationInquiryResponse) GetInquiryId() int32 {
 	if x != nil {
-		return x.Success
+		return x.InquiryId
 	}
-	return false
+	return 0
 }
 
-// Event CRUD
-type CreateEventRequest struct {
+type ListOrganizationInquiriesRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	Title          string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description    string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	ImageUrl       *string                `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
-	UserId         int32                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	OrganizationId int32                  `protobuf:"varint,5,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	Location       string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
-	StartTime      string                 `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime        string                 `protobuf:"bytes,8,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	Format         EventFormat            `protobuf:"varint,9,opt,name=format,proto3,enum=events.v1.EventFormat" json:"format,omitempty"`
-	TagIds         []int32                `protobuf:"varint,10,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	OrganizationId int32                  `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Page           int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit          int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CreateEventRequest) Reset() {
-	*x = CreateEventRequest{}
+func (x *ListOrganizationInquiriesRequest) Reset() {
+	*x = ListOrganizationInquiriesRequest{}
 	mi := &file_eventsv1_events_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEventRequest) String() string {
+func (x *ListOrganizationInquiriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEventRequest) ProtoMessage() {}
+func (*ListOrganizationInquiriesRequest) ProtoMessage() {}
 
-func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
+func (x *ListOrganizationInquiriesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2208,103 +2848,107 @@ func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEventRequest.ProtoReflect.Descriptor instead.
-func (*CreateEventRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListOrganizationInquiriesRequest.ProtoReflect.Descriptor instead.
+func (*ListOrganizationInquiriesRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *CreateEventRequest) GetTitle() string {
+func (x *ListOrganizationInquiriesRequest) GetOrganizationId() int32 {
 	if x != nil {
-		return x.Title
+		return x.OrganizationId
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateEventRequest) GetDescription() string {
+func (x *ListOrganizationInquiriesRequest) GetPage() int32 {
 	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *CreateEventRequest) GetImageUrl() string {
-	if x != nil && x.ImageUrl != nil {
-		return *x.ImageUrl
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateEventRequest) GetUserId() int32 {
+func (x *ListOrganizationInquiriesRequest) GetLimit() int32 {
 	if x != nil {
-		return x.UserId
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *CreateEventRequest) GetOrganizationId() int32 {
-	if x != nil {
-		return x.OrganizationId
-	}
-	return 0
+type ListOrganizationInquiriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Inquiries     []*OrganizationInquiry `protobuf:"bytes,1,rep,name=inquiries,proto3" json:"inquiries,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateEventRequest) GetLocation() string {
-	if x != nil {
-		return x.Location
-	}
-	return ""
+func (x *ListOrganizationInquiriesResponse) Reset() {
+	*x = ListOrganizationInquiriesResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEventRequest) GetStartTime() string {
-	if x != nil {
-		return x.StartTime
-	}
-	return ""
+func (x *ListOrganizationInquiriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateEventRequest) GetEndTime() string {
+func (*ListOrganizationInquiriesResponse) ProtoMessage() {}
+
+func (x *ListOrganizationInquiriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[29]
 	if x != nil {
-		return x.EndTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateEventRequest) GetFormat() EventFormat {
+// Deprecated: Use ListOrganizationInquiriesResponse.ProtoReflect.Descriptor instead.
+func (*ListOrganizationInquiriesResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListOrganizationInquiriesResponse) GetInquiries() []*OrganizationInquiry {
 	if x != nil {
-		return x.Format
+		return x.Inquiries
 	}
-	return EventFormat_EVENT_FORMAT_UNSPECIFIED
+	return nil
 }
 
-func (x *CreateEventRequest) GetTagIds() []int32 {
+func (x *ListOrganizationInquiriesResponse) GetTotal() int32 {
 	if x != nil {
-		return x.TagIds
+		return x.Total
 	}
-	return nil
+	return 0
 }
 
-type CreateEventResponse struct {
+type UpdateInquiryStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	InquiryId     int32                  `protobuf:"varint,1,opt,name=inquiry_id,json=inquiryId,proto3" json:"inquiry_id,omitempty"`
+	Status        InquiryStatus          `protobuf:"varint,2,opt,name=status,proto3,enum=events.v1.InquiryStatus" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateEventResponse) Reset() {
-	*x = CreateEventResponse{}
-	mi := &file_eventsv1_events_proto_msgTypes[29]
+func (x *UpdateInquiryStatusRequest) Reset() {
+	*x = UpdateInquiryStatusRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEventResponse) String() string {
+func (x *UpdateInquiryStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEventResponse) ProtoMessage() {}
+func (*UpdateInquiryStatusRequest) ProtoMessage() {}
 
-func (x *CreateEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[29]
+func (x *UpdateInquiryStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2315,83 +2959,46 @@ func (x *CreateEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEventResponse.ProtoReflect.Descriptor instead.
-func (*CreateEventResponse) Descriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *CreateEventResponse) GetEvent() *Event {
-	if x != nil {
-		return x.Event
-	}
-	return nil
-}
-
-type GetEventRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetEventRequest) Reset() {
-	*x = GetEventRequest{}
-	mi := &file_eventsv1_events_proto_msgTypes[30]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetEventRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use UpdateInquiryStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInquiryStatusRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{30}
 }
 
-func (*GetEventRequest) ProtoMessage() {}
-
-func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[30]
+func (x *UpdateInquiryStatusRequest) GetInquiryId() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.InquiryId
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetEventRequest.ProtoReflect.Descriptor instead.
-func (*GetEventRequest) Descriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{30}
+	return 0
 }
 
-func (x *GetEventRequest) GetId() int32 {
+func (x *UpdateInquiryStatusRequest) GetStatus() InquiryStatus {
 	if x != nil {
-		return x.Id
+		return x.Status
 	}
-	return 0
+	return InquiryStatus_INQUIRY_STATUS_UNSPECIFIED
 }
 
-type GetEventResponse struct {
+type UpdateInquiryStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Inquiry       *OrganizationInquiry   `protobuf:"bytes,1,opt,name=inquiry,proto3" json:"inquiry,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEventResponse) Reset() {
-	*x = GetEventResponse{}
+func (x *UpdateInquiryStatusResponse) Reset() {
+	*x = UpdateInquiryStatusResponse{}
 	mi := &file_eventsv1_events_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventResponse) String() string {
+func (x *UpdateInquiryStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventResponse) ProtoMessage() {}
+func (*UpdateInquiryStatusResponse) ProtoMessage() {}
 
-func (x *GetEventResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateInquiryStatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2403,43 +3010,42 @@ func (x *GetEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventResponse.ProtoReflect.Descriptor instead.
-func (*GetEventResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateInquiryStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateInquiryStatusResponse) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetEventResponse) GetEvent() *Event {
+func (x *UpdateInquiryStatusResponse) GetInquiry() *OrganizationInquiry {
 	if x != nil {
-		return x.Event
+		return x.Inquiry
 	}
 	return nil
 }
 
-type ListEventsRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Page           int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit          int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	UserId         *int32                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
-	OrganizationId *int32                 `protobuf:"varint,4,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"`
-	TagIds         []int32                `protobuf:"varint,5,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Moves every event of the source organization to the target and archives
+// the source
+type MergeOrganizationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      int32                  `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	TargetId      int32                  `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEventsRequest) Reset() {
-	*x = ListEventsRequest{}
+func (x *MergeOrganizationsRequest) Reset() {
+	*x = MergeOrganizationsRequest{}
 	mi := &file_eventsv1_events_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEventsRequest) String() string {
+func (x *MergeOrganizationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEventsRequest) ProtoMessage() {}
+func (*MergeOrganizationsRequest) ProtoMessage() {}
 
-func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
+func (x *MergeOrganizationsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2451,68 +3057,48 @@ func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
-func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MergeOrganizationsRequest.ProtoReflect.Descriptor instead.
+func (*MergeOrganizationsRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ListEventsRequest) GetPage() int32 {
+func (x *MergeOrganizationsRequest) GetSourceId() int32 {
 	if x != nil {
-		return x.Page
+		return x.SourceId
 	}
 	return 0
 }
 
-func (x *ListEventsRequest) GetLimit() int32 {
+func (x *MergeOrganizationsRequest) GetTargetId() int32 {
 	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *ListEventsRequest) GetUserId() int32 {
-	if x != nil && x.UserId != nil {
-		return *x.UserId
-	}
-	return 0
-}
-
-func (x *ListEventsRequest) GetOrganizationId() int32 {
-	if x != nil && x.OrganizationId != nil {
-		return *x.OrganizationId
+		return x.TargetId
 	}
 	return 0
 }
 
-func (x *ListEventsRequest) GetTagIds() []int32 {
-	if x != nil {
-		return x.TagIds
-	}
-	return nil
-}
-
-type ListEventsResponse struct {
+type MergeOrganizationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	ArchivedOrg   *Organization          `protobuf:"bytes,1,opt,name=archived_org,json=archivedOrg,proto3" json:"archived_org,omitempty"`
+	TargetOrg     *Organization          `protobuf:"bytes,2,opt,name=target_org,json=targetOrg,proto3" json:"target_org,omitempty"`
+	EventsMoved   int32                  `protobuf:"varint,3,opt,name=events_moved,json=eventsMoved,proto3" json:"events_moved,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEventsResponse) Reset() {
-	*x = ListEventsResponse{}
+func (x *MergeOrganizationsResponse) Reset() {
+	*x = MergeOrganizationsResponse{}
 	mi := &file_eventsv1_events_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEventsResponse) String() string {
+func (x *MergeOrganizationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEventsResponse) ProtoMessage() {}
+func (*MergeOrganizationsResponse) ProtoMessage() {}
 
-func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
+func (x *MergeOrganizationsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2524,56 +3110,53 @@ func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
-func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MergeOrganizationsResponse.ProtoReflect.Descriptor instead.
+func (*MergeOrganizationsResponse) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ListEventsResponse) GetEvents() []*Event {
+func (x *MergeOrganizationsResponse) GetArchivedOrg() *Organization {
 	if x != nil {
-		return x.Events
+		return x.ArchivedOrg
 	}
 	return nil
 }
 
-func (x *ListEventsResponse) GetTotal() int32 {
+func (x *MergeOrganizationsResponse) GetTargetOrg() *Organization {
 	if x != nil {
-		return x.Total
+		return x.TargetOrg
+	}
+	return nil
+}
+
+func (x *MergeOrganizationsResponse) GetEventsMoved() int32 {
+	if x != nil {
+		return x.EventsMoved
 	}
 	return 0
 }
 
-type UpdateEventRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title          *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Description    *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	ImageUrl       *string                `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
-	UserId         *int32                 `protobuf:"varint,5,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
-	OrganizationId *int32                 `protobuf:"varint,6,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"`
-	Location       *string                `protobuf:"bytes,7,opt,name=location,proto3,oneof" json:"location,omitempty"`
-	StartTime      *string                `protobuf:"bytes,8,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
-	EndTime        *string                `protobuf:"bytes,9,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
-	Format         *EventFormat           `protobuf:"varint,10,opt,name=format,proto3,enum=events.v1.EventFormat,oneof" json:"format,omitempty"`
-	TagIds         []int32                `protobuf:"varint,11,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type DeleteOrganizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEventRequest) Reset() {
-	*x = UpdateEventRequest{}
+func (x *DeleteOrganizationRequest) Reset() {
+	*x = DeleteOrganizationRequest{}
 	mi := &file_eventsv1_events_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEventRequest) String() string {
+func (x *DeleteOrganizationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEventRequest) ProtoMessage() {}
+func (*DeleteOrganizationRequest) ProtoMessage() {}
 
-func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteOrganizationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2585,110 +3168,85 @@ func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEventRequest.ProtoReflect.Descriptor instead.
-func (*UpdateEventRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOrganizationRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *UpdateEventRequest) GetId() int32 {
+func (x *DeleteOrganizationRequest) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *UpdateEventRequest) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
-	}
-	return ""
-}
-
-func (x *UpdateEventRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
-	}
-	return ""
-}
-
-func (x *UpdateEventRequest) GetImageUrl() string {
-	if x != nil && x.ImageUrl != nil {
-		return *x.ImageUrl
-	}
-	return ""
-}
-
-func (x *UpdateEventRequest) GetUserId() int32 {
-	if x != nil && x.UserId != nil {
-		return *x.UserId
-	}
-	return 0
+type DeleteOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEventRequest) GetOrganizationId() int32 {
-	if x != nil && x.OrganizationId != nil {
-		return *x.OrganizationId
-	}
-	return 0
+func (x *DeleteOrganizationResponse) Reset() {
+	*x = DeleteOrganizationResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEventRequest) GetLocation() string {
-	if x != nil && x.Location != nil {
-		return *x.Location
-	}
-	return ""
+func (x *DeleteOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateEventRequest) GetStartTime() string {
-	if x != nil && x.StartTime != nil {
-		return *x.StartTime
-	}
-	return ""
-}
+func (*DeleteOrganizationResponse) ProtoMessage() {}
 
-func (x *UpdateEventRequest) GetEndTime() string {
-	if x != nil && x.EndTime != nil {
-		return *x.EndTime
+func (x *DeleteOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateEventRequest) GetFormat() EventFormat {
-	if x != nil && x.Format != nil {
-		return *x.Format
-	}
-	return EventFormat_EVENT_FORMAT_UNSPECIFIED
+// Deprecated: Use DeleteOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *UpdateEventRequest) GetTagIds() []int32 {
+func (x *DeleteOrganizationResponse) GetSuccess() bool {
 	if x != nil {
-		return x.TagIds
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type UpdateEventResponse struct {
+// Organization Type CRUD
+type CreateOrganizationTypeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEventResponse) Reset() {
-	*x = UpdateEventResponse{}
-	mi := &file_eventsv1_events_proto_msgTypes[35]
+func (x *CreateOrganizationTypeRequest) Reset() {
+	*x = CreateOrganizationTypeRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEventResponse) String() string {
+func (x *CreateOrganizationTypeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEventResponse) ProtoMessage() {}
+func (*CreateOrganizationTypeRequest) ProtoMessage() {}
 
-func (x *UpdateEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[35]
+func (x *CreateOrganizationTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2699,83 +3257,39 @@ func (x *UpdateEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEventResponse.ProtoReflect.Descriptor instead.
-func (*UpdateEventResponse) Descriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{35}
-}
-
-func (x *UpdateEventResponse) GetEvent() *Event {
-	if x != nil {
-		return x.Event
-	}
-	return nil
-}
-
-type DeleteEventRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeleteEventRequest) Reset() {
-	*x = DeleteEventRequest{}
-	mi := &file_eventsv1_events_proto_msgTypes[36]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DeleteEventRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DeleteEventRequest) ProtoMessage() {}
-
-func (x *DeleteEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[36]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DeleteEventRequest.ProtoReflect.Descriptor instead.
-func (*DeleteEventRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateOrganizationTypeRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationTypeRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *DeleteEventRequest) GetId() int32 {
+func (x *CreateOrganizationTypeRequest) GetTitle() string {
 	if x != nil {
-		return x.Id
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-type DeleteEventResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CreateOrganizationTypeResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationType *OrganizationType      `protobuf:"bytes,1,opt,name=organization_type,json=organizationType,proto3" json:"organization_type,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *DeleteEventResponse) Reset() {
-	*x = DeleteEventResponse{}
+func (x *CreateOrganizationTypeResponse) Reset() {
+	*x = CreateOrganizationTypeResponse{}
 	mi := &file_eventsv1_events_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEventResponse) String() string {
+func (x *CreateOrganizationTypeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEventResponse) ProtoMessage() {}
+func (*CreateOrganizationTypeResponse) ProtoMessage() {}
 
-func (x *DeleteEventResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateOrganizationTypeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2787,40 +3301,39 @@ func (x *DeleteEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEventResponse.ProtoReflect.Descriptor instead.
-func (*DeleteEventResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateOrganizationTypeResponse.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationTypeResponse) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *DeleteEventResponse) GetSuccess() bool {
+func (x *CreateOrganizationTypeResponse) GetOrganizationType() *OrganizationType {
 	if x != nil {
-		return x.Success
+		return x.OrganizationType
 	}
-	return false
+	return nil
 }
 
-// Tag CRUD
-type CreateTagRequest struct {
+type GetOrganizationTypeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTagRequest) Reset() {
-	*x = CreateTagRequest{}
+func (x *GetOrganizationTypeRequest) Reset() {
+	*x = GetOrganizationTypeRequest{}
 	mi := &file_eventsv1_events_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTagRequest) String() string {
+func (x *GetOrganizationTypeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTagRequest) ProtoMessage() {}
+func (*GetOrganizationTypeRequest) ProtoMessage() {}
 
-func (x *CreateTagRequest) ProtoReflect() protoreflect.Message {
+func (x *GetOrganizationTypeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2832,39 +3345,39 @@ func (x *CreateTagRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTagRequest.ProtoReflect.Descriptor instead.
-func (*CreateTagRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOrganizationTypeRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationTypeRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *CreateTagRequest) GetName() string {
+func (x *GetOrganizationTypeRequest) GetId() int32 {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type CreateTagResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tag           *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetOrganizationTypeResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationType *OrganizationType      `protobuf:"bytes,1,opt,name=organization_type,json=organizationType,proto3" json:"organization_type,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *CreateTagResponse) Reset() {
-	*x = CreateTagResponse{}
+func (x *GetOrganizationTypeResponse) Reset() {
+	*x = GetOrganizationTypeResponse{}
 	mi := &file_eventsv1_events_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTagResponse) String() string {
+func (x *GetOrganizationTypeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTagResponse) ProtoMessage() {}
+func (*GetOrganizationTypeResponse) ProtoMessage() {}
 
-func (x *CreateTagResponse) ProtoReflect() protoreflect.Message {
+func (x *GetOrganizationTypeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2876,39 +3389,40 @@ func (x *CreateTagResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTagResponse.ProtoReflect.Descriptor instead.
-func (*CreateTagResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOrganizationTypeResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationTypeResponse) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *CreateTagResponse) GetTag() *Tag {
+func (x *GetOrganizationTypeResponse) GetOrganizationType() *OrganizationType {
 	if x != nil {
-		return x.Tag
+		return x.OrganizationType
 	}
 	return nil
 }
 
-type GetTagRequest struct {
+type ListOrganizationTypesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTagRequest) Reset() {
-	*x = GetTagRequest{}
+func (x *ListOrganizationTypesRequest) Reset() {
+	*x = ListOrganizationTypesRequest{}
 	mi := &file_eventsv1_events_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTagRequest) String() string {
+func (x *ListOrganizationTypesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTagRequest) ProtoMessage() {}
+func (*ListOrganizationTypesRequest) ProtoMessage() {}
 
-func (x *GetTagRequest) ProtoReflect() protoreflect.Message {
+func (x *ListOrganizationTypesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2920,39 +3434,47 @@ func (x *GetTagRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTagRequest.ProtoReflect.Descriptor instead.
-func (*GetTagRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListOrganizationTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListOrganizationTypesRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetTagRequest) GetId() int32 {
+func (x *ListOrganizationTypesRequest) GetPage() int32 {
 	if x != nil {
-		return x.Id
+		return x.Page
 	}
 	return 0
 }
 
-type GetTagResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tag           *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListOrganizationTypesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
 }
 
-func (x *GetTagResponse) Reset() {
-	*x = GetTagResponse{}
+type ListOrganizationTypesResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationTypes []*OrganizationType    `protobuf:"bytes,1,rep,name=organization_types,json=organizationTypes,proto3" json:"organization_types,omitempty"`
+	Total             int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListOrganizationTypesResponse) Reset() {
+	*x = ListOrganizationTypesResponse{}
 	mi := &file_eventsv1_events_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTagResponse) String() string {
+func (x *ListOrganizationTypesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTagResponse) ProtoMessage() {}
+func (*ListOrganizationTypesResponse) ProtoMessage() {}
 
-func (x *GetTagResponse) ProtoReflect() protoreflect.Message {
+func (x *ListOrganizationTypesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2964,40 +3486,47 @@ func (x *GetTagResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTagResponse.ProtoReflect.Descriptor instead.
-func (*GetTagResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListOrganizationTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListOrganizationTypesResponse) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetTagResponse) GetTag() *Tag {
+func (x *ListOrganizationTypesResponse) GetOrganizationTypes() []*OrganizationType {
 	if x != nil {
-		return x.Tag
+		return x.OrganizationTypes
 	}
 	return nil
 }
 
-type ListTagsRequest struct {
+func (x *ListOrganizationTypesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type UpdateOrganizationTypeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTagsRequest) Reset() {
-	*x = ListTagsRequest{}
+func (x *UpdateOrganizationTypeRequest) Reset() {
+	*x = UpdateOrganizationTypeRequest{}
 	mi := &file_eventsv1_events_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTagsRequest) String() string {
+func (x *UpdateOrganizationTypeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTagsRequest) ProtoMessage() {}
+func (*UpdateOrganizationTypeRequest) ProtoMessage() {}
 
-func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateOrganizationTypeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3009,47 +3538,46 @@ func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
-func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateOrganizationTypeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrganizationTypeRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ListTagsRequest) GetPage() int32 {
+func (x *UpdateOrganizationTypeRequest) GetId() int32 {
 	if x != nil {
-		return x.Page
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ListTagsRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
+func (x *UpdateOrganizationTypeRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
 	}
-	return 0
+	return ""
 }
 
-type ListTagsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpdateOrganizationTypeResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationType *OrganizationType      `protobuf:"bytes,1,opt,name=organization_type,json=organizationType,proto3" json:"organization_type,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ListTagsResponse) Reset() {
-	*x = ListTagsResponse{}
+func (x *UpdateOrganizationTypeResponse) Reset() {
+	*x = UpdateOrganizationTypeResponse{}
 	mi := &file_eventsv1_events_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTagsResponse) String() string {
+func (x *UpdateOrganizationTypeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTagsResponse) ProtoMessage() {}
+func (*UpdateOrganizationTypeResponse) ProtoMessage() {}
 
-func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateOrganizationTypeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3061,47 +3589,39 @@ func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
-func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateOrganizationTypeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOrganizationTypeResponse) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *ListTagsResponse) GetTags() []*Tag {
+func (x *UpdateOrganizationTypeResponse) GetOrganizationType() *OrganizationType {
 	if x != nil {
-		return x.Tags
+		return x.OrganizationType
 	}
 	return nil
 }
 
-func (x *ListTagsResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-type UpdateTagRequest struct {
+type DeleteOrganizationTypeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTagRequest) Reset() {
-	*x = UpdateTagRequest{}
+func (x *DeleteOrganizationTypeRequest) Reset() {
+	*x = DeleteOrganizationTypeRequest{}
 	mi := &file_eventsv1_events_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTagRequest) String() string {
+func (x *DeleteOrganizationTypeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTagRequest) ProtoMessage() {}
+func (*DeleteOrganizationTypeRequest) ProtoMessage() {}
 
-func (x *UpdateTagRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteOrganizationTypeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3113,46 +3633,39 @@ func (x *UpdateTagRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTagRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTagRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteOrganizationTypeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOrganizationTypeRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *UpdateTagRequest) GetId() int32 {
+func (x *DeleteOrganizationTypeRequest) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *UpdateTagRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
-}
-
-type UpdateTagResponse struct {
+type DeleteOrganizationTypeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tag           *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTagResponse) Reset() {
-	*x = UpdateTagResponse{}
+func (x *DeleteOrganizationTypeResponse) Reset() {
+	*x = DeleteOrganizationTypeResponse{}
 	mi := &file_eventsv1_events_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTagResponse) String() string {
+func (x *DeleteOrganizationTypeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTagResponse) ProtoMessage() {}
+func (*DeleteOrganizationTypeResponse) ProtoMessage() {}
 
-func (x *UpdateTagResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteOrganizationTypeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3164,39 +3677,50 @@ func (x *UpdateTagResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTagResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTagResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteOrganizationTypeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteOrganizationTypeResponse) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *UpdateTagResponse) GetTag() *Tag {
+func (x *DeleteOrganizationTypeResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Tag
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type DeleteTagRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Event CRUD
+type CreateEventRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Title          string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ImageUrl       *string                `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
+	UserId         int32                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrganizationId int32                  `protobuf:"varint,5,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Location       string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	StartTime      string                 `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime        string                 `protobuf:"bytes,8,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Format         EventFormat            `protobuf:"varint,9,opt,name=format,proto3,enum=events.v1.EventFormat" json:"format,omitempty"`
+	TagIds         []int32                `protobuf:"varint,10,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	Capacity       *int32                 `protobuf:"varint,11,opt,name=capacity,proto3,oneof" json:"capacity,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *DeleteTagRequest) Reset() {
-	*x = DeleteTagRequest{}
+func (x *CreateEventRequest) Reset() {
+	*x = CreateEventRequest{}
 	mi := &file_eventsv1_events_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTagRequest) String() string {
+func (x *CreateEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTagRequest) ProtoMessage() {}
+func (*CreateEventRequest) ProtoMessage() {}
 
-func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3208,39 +3732,109 @@ func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTagRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateEventRequest.ProtoReflect.Descriptor instead.
+func (*CreateEventRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *DeleteTagRequest) GetId() int32 {
+func (x *CreateEventRequest) GetTitle() string {
 	if x != nil {
-		return x.Id
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetImageUrl() string {
+	if x != nil && x.ImageUrl != nil {
+		return *x.ImageUrl
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
 	}
 	return 0
 }
 
-type DeleteTagResponse struct {
+func (x *CreateEventRequest) GetOrganizationId() int32 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *CreateEventRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetFormat() EventFormat {
+	if x != nil {
+		return x.Format
+	}
+	return EventFormat_EVENT_FORMAT_UNSPECIFIED
+}
+
+func (x *CreateEventRequest) GetTagIds() []int32 {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *CreateEventRequest) GetCapacity() int32 {
+	if x != nil && x.Capacity != nil {
+		return *x.Capacity
+	}
+	return 0
+}
+
+type CreateEventResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTagResponse) Reset() {
-	*x = DeleteTagResponse{}
+func (x *CreateEventResponse) Reset() {
+	*x = CreateEventResponse{}
 	mi := &file_eventsv1_events_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTagResponse) String() string {
+func (x *CreateEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTagResponse) ProtoMessage() {}
+func (*CreateEventResponse) ProtoMessage() {}
 
-func (x *DeleteTagResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateEventResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3252,40 +3846,42 @@ func (x *DeleteTagResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTagResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTagResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateEventResponse.ProtoReflect.Descriptor instead.
+func (*CreateEventResponse) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *DeleteTagResponse) GetSuccess() bool {
+func (x *CreateEventResponse) GetEvent() *Event {
 	if x != nil {
-		return x.Success
+		return x.Event
 	}
-	return false
+	return nil
 }
 
-// Additional request/response messages for custom methods
-type GetPublishableOrganizationsRequest struct {
+// BulkCreateEventsRequest imports up to 500 events in one transaction.
+// Invalid rows fail the whole batch with field violations such as
+// "events[3].start_time".
+type BulkCreateEventsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Events        []*CreateEventRequest  `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPublishableOrganizationsRequest) Reset() {
-	*x = GetPublishableOrganizationsRequest{}
+func (x *BulkCreateEventsRequest) Reset() {
+	*x = BulkCreateEventsRequest{}
 	mi := &file_eventsv1_events_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPublishableOrganizationsRequest) String() string {
+func (x *BulkCreateEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPublishableOrganizationsRequest) ProtoMessage() {}
+func (*BulkCreateEventsRequest) ProtoMessage() {}
 
-func (x *GetPublishableOrganizationsRequest) ProtoReflect() protoreflect.Message {
+func (x *BulkCreateEventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3297,39 +3893,39 @@ func (x *GetPublishableOrganizationsRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPublishableOrganizationsRequest.ProtoReflect.Descriptor instead.
-func (*GetPublishableOrganizationsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BulkCreateEventsRequest.ProtoReflect.Descriptor instead.
+func (*BulkCreateEventsRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *GetPublishableOrganizationsRequest) GetUserId() int32 {
+func (x *BulkCreateEventsRequest) GetEvents() []*CreateEventRequest {
 	if x != nil {
-		return x.UserId
+		return x.Events
 	}
-	return 0
+	return nil
 }
 
-type GetPublishableOrganizationsResponse struct {
+type BulkCreateEventsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Organizations []*Organization        `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
+	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPublishableOrganizationsResponse) Reset() {
-	*x = GetPublishableOrganizationsResponse{}
+func (x *BulkCreateEventsResponse) Reset() {
+	*x = BulkCreateEventsResponse{}
 	mi := &file_eventsv1_events_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPublishableOrganizationsResponse) String() string {
+func (x *BulkCreateEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPublishableOrganizationsResponse) ProtoMessage() {}
+func (*BulkCreateEventsResponse) ProtoMessage() {}
 
-func (x *GetPublishableOrganizationsResponse) ProtoReflect() protoreflect.Message {
+func (x *BulkCreateEventsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3341,39 +3937,43 @@ func (x *GetPublishableOrganizationsResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPublishableOrganizationsResponse.ProtoReflect.Descriptor instead.
-func (*GetPublishableOrganizationsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BulkCreateEventsResponse.ProtoReflect.Descriptor instead.
+func (*BulkCreateEventsResponse) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *GetPublishableOrganizationsResponse) GetOrganizations() []*Organization {
+func (x *BulkCreateEventsResponse) GetEvents() []*Event {
 	if x != nil {
-		return x.Organizations
+		return x.Events
 	}
 	return nil
 }
 
-type GetUserOrganizationsRequest struct {
+// Copies an event's details and tags into a new event at the given times.
+// Registrations and attendance are not copied.
+type DuplicateEventRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SourceEventId int32                  `protobuf:"varint,1,opt,name=source_event_id,json=sourceEventId,proto3" json:"source_event_id,omitempty"`
+	NewStartTime  string                 `protobuf:"bytes,2,opt,name=new_start_time,json=newStartTime,proto3" json:"new_start_time,omitempty"` // RFC3339
+	NewEndTime    string                 `protobuf:"bytes,3,opt,name=new_end_time,json=newEndTime,proto3" json:"new_end_time,omitempty"`       // RFC3339
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserOrganizationsRequest) Reset() {
-	*x = GetUserOrganizationsRequest{}
+func (x *DuplicateEventRequest) Reset() {
+	*x = DuplicateEventRequest{}
 	mi := &file_eventsv1_events_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserOrganizationsRequest) String() string {
+func (x *DuplicateEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserOrganizationsRequest) ProtoMessage() {}
+func (*DuplicateEventRequest) ProtoMessage() {}
 
-func (x *GetUserOrganizationsRequest) ProtoReflect() protoreflect.Message {
+func (x *DuplicateEventRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3385,39 +3985,53 @@ func (x *GetUserOrganizationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserOrganizationsRequest.ProtoReflect.Descriptor instead.
-func (*GetUserOrganizationsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DuplicateEventRequest.ProtoReflect.Descriptor instead.
+func (*DuplicateEventRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *GetUserOrganizationsRequest) GetUserId() int32 {
+func (x *DuplicateEventRequest) GetSourceEventId() int32 {
 	if x != nil {
-		return x.UserId
+		return x.SourceEventId
 	}
 	return 0
 }
 
-type GetUserOrganizationsResponse struct {
+func (x *DuplicateEventRequest) GetNewStartTime() string {
+	if x != nil {
+		return x.NewStartTime
+	}
+	return ""
+}
+
+func (x *DuplicateEventRequest) GetNewEndTime() string {
+	if x != nil {
+		return x.NewEndTime
+	}
+	return ""
+}
+
+type DuplicateEventResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Organizations []*Organization        `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
+	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserOrganizationsResponse) Reset() {
-	*x = GetUserOrganizationsResponse{}
+func (x *DuplicateEventResponse) Reset() {
+	*x = DuplicateEventResponse{}
 	mi := &file_eventsv1_events_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserOrganizationsResponse) String() string {
+func (x *DuplicateEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserOrganizationsResponse) ProtoMessage() {}
+func (*DuplicateEventResponse) ProtoMessage() {}
 
-func (x *GetUserOrganizationsResponse) ProtoReflect() protoreflect.Message {
+func (x *DuplicateEventResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3429,39 +4043,39 @@ func (x *GetUserOrganizationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserOrganizationsResponse.ProtoReflect.Descriptor instead.
-func (*GetUserOrganizationsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DuplicateEventResponse.ProtoReflect.Descriptor instead.
+func (*DuplicateEventResponse) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GetUserOrganizationsResponse) GetOrganizations() []*Organization {
+func (x *DuplicateEventResponse) GetEvent() *Event {
 	if x != nil {
-		return x.Organizations
+		return x.Event
 	}
 	return nil
 }
 
-type GetEventsByTagIdRequest struct {
+type GetEventRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TagId         int32                  `protobuf:"varint,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEventsByTagIdRequest) Reset() {
-	*x = GetEventsByTagIdRequest{}
+func (x *GetEventRequest) Reset() {
+	*x = GetEventRequest{}
 	mi := &file_eventsv1_events_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventsByTagIdRequest) String() string {
+func (x *GetEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventsByTagIdRequest) ProtoMessage() {}
+func (*GetEventRequest) ProtoMessage() {}
 
-func (x *GetEventsByTagIdRequest) ProtoReflect() protoreflect.Message {
+func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3473,39 +4087,41 @@ func (x *GetEventsByTagIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventsByTagIdRequest.ProtoReflect.Descriptor instead.
-func (*GetEventsByTagIdRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetEventRequest.ProtoReflect.Descriptor instead.
+func (*GetEventRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *GetEventsByTagIdRequest) GetTagId() int32 {
+func (x *GetEventRequest) GetId() int32 {
 	if x != nil {
-		return x.TagId
+		return x.Id
 	}
 	return 0
 }
 
-type GetEventsByTagIdResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetEventResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Event              *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	CallerRegistration *EventRegistration     `protobuf:"bytes,2,opt,name=caller_registration,json=callerRegistration,proto3,oneof" json:"caller_registration,omitempty"` // Unset when not logged in or not registered
+	CallerAttendance   *EventAttendance       `protobuf:"bytes,3,opt,name=caller_attendance,json=callerAttendance,proto3,oneof" json:"caller_attendance,omitempty"`       // Unset when not checked in
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *GetEventsByTagIdResponse) Reset() {
-	*x = GetEventsByTagIdResponse{}
+func (x *GetEventResponse) Reset() {
+	*x = GetEventResponse{}
 	mi := &file_eventsv1_events_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventsByTagIdResponse) String() string {
+func (x *GetEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventsByTagIdResponse) ProtoMessage() {}
+func (*GetEventResponse) ProtoMessage() {}
 
-func (x *GetEventsByTagIdResponse) ProtoReflect() protoreflect.Message {
+func (x *GetEventResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3517,39 +4133,59 @@ func (x *GetEventsByTagIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventsByTagIdResponse.ProtoReflect.Descriptor instead.
-func (*GetEventsByTagIdResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetEventResponse.ProtoReflect.Descriptor instead.
+func (*GetEventResponse) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *GetEventsByTagIdResponse) GetEvents() []*Event {
+func (x *GetEventResponse) GetEvent() *Event {
 	if x != nil {
-		return x.Events
+		return x.Event
 	}
 	return nil
 }
 
-type GetUserSubscribedEventsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetEventResponse) GetCallerRegistration() *EventRegistration {
+	if x != nil {
+		return x.CallerRegistration
+	}
+	return nil
 }
 
-func (x *GetUserSubscribedEventsRequest) Reset() {
-	*x = GetUserSubscribedEventsRequest{}
+func (x *GetEventResponse) GetCallerAttendance() *EventAttendance {
+	if x != nil {
+		return x.CallerAttendance
+	}
+	return nil
+}
+
+type ListEventsRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Page               int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit              int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	UserId             *int32                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	OrganizationId     *int32                 `protobuf:"varint,4,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"`
+	TagIds             []int32                `protobuf:"varint,5,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	IncludeUnpublished bool                   `protobuf:"varint,6,opt,name=include_unpublished,json=includeUnpublished,proto3" json:"include_unpublished,omitempty"` // Requires manage_clubs on the platform
+	Cursor             *string                `protobuf:"bytes,7,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`                                              // Set to page by start time instead of page: empty for the first page, then next_cursor
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListEventsRequest) Reset() {
+	*x = ListEventsRequest{}
 	mi := &file_eventsv1_events_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserSubscribedEventsRequest) String() string {
+func (x *ListEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserSubscribedEventsRequest) ProtoMessage() {}
+func (*ListEventsRequest) ProtoMessage() {}
 
-func (x *GetUserSubscribedEventsRequest) ProtoReflect() protoreflect.Message {
+func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3561,39 +4197,83 @@ func (x *GetUserSubscribedEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserSubscribedEventsRequest.ProtoReflect.Descriptor instead.
-func (*GetUserSubscribedEventsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GetUserSubscribedEventsRequest) GetUserId() int32 {
+func (x *ListEventsRequest) GetPage() int32 {
 	if x != nil {
-		return x.UserId
+		return x.Page
 	}
 	return 0
 }
 
-type GetUserSubscribedEventsResponse struct {
+func (x *ListEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetUserId() int32 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetOrganizationId() int32 {
+	if x != nil && x.OrganizationId != nil {
+		return *x.OrganizationId
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetTagIds() []int32 {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetIncludeUnpublished() bool {
+	if x != nil {
+		return x.IncludeUnpublished
+	}
+	return false
+}
+
+func (x *ListEventsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type ListEventsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	NextCursor    *string                `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"` // Only in cursor mode, unset on the last page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserSubscribedEventsResponse) Reset() {
-	*x = GetUserSubscribedEventsResponse{}
+func (x *ListEventsResponse) Reset() {
+	*x = ListEventsResponse{}
 	mi := &file_eventsv1_events_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserSubscribedEventsResponse) String() string {
+func (x *ListEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserSubscribedEventsResponse) ProtoMessage() {}
+func (*ListEventsResponse) ProtoMessage() {}
 
-func (x *GetUserSubscribedEventsResponse) ProtoReflect() protoreflect.Message {
+func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3605,42 +4285,67 @@ func (x *GetUserSubscribedEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserSubscribedEventsResponse.ProtoReflect.Descriptor instead.
-func (*GetUserSubscribedEventsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventsResponse) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetUserSubscribedEventsResponse) GetEvents() []*Event {
+func (x *ListEventsResponse) GetEvents() []*Event {
 	if x != nil {
 		return x.Events
 	}
 	return nil
 }
 
-type ListEventsForAdminRequest struct {
+func (x *ListEventsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListEventsResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+type UpdateEventRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	Page           int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit          int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	UserId         *int32                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
-	OrganizationId *int32                 `protobuf:"varint,4,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description    *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	ImageUrl       *string                `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
+	UserId         *int32                 `protobuf:"varint,5,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	OrganizationId *int32                 `protobuf:"varint,6,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"`
+	Location       *string                `protobuf:"bytes,7,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	StartTime      *string                `protobuf:"bytes,8,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	EndTime        *string                `protobuf:"bytes,9,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	Format         *EventFormat           `protobuf:"varint,10,opt,name=format,proto3,enum=events.v1.EventFormat,oneof" json:"format,omitempty"`
+	TagIds         []int32                `protobuf:"varint,11,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	Capacity       *int32                 `protobuf:"varint,12,opt,name=capacity,proto3,oneof" json:"capacity,omitempty"`
+	// Event.version the edit is based on. When set and the event has changed
+	// since, the update fails with ABORTED.
+	ExpectedVersion *int32 `protobuf:"varint,13,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ListEventsForAdminRequest) Reset() {
-	*x = ListEventsForAdminRequest{}
+func (x *UpdateEventRequest) Reset() {
+	*x = UpdateEventRequest{}
 	mi := &file_eventsv1_events_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEventsForAdminRequest) String() string {
+func (x *UpdateEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEventsForAdminRequest) ProtoMessage() {}
+func (*UpdateEventRequest) ProtoMessage() {}
 
-func (x *ListEventsForAdminRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3652,62 +4357,124 @@ func (x *ListEventsForAdminRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEventsForAdminRequest.ProtoReflect.Descriptor instead.
-func (*ListEventsForAdminRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateEventRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEventRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *ListEventsForAdminRequest) GetPage() int32 {
+func (x *UpdateEventRequest) GetId() int32 {
 	if x != nil {
-		return x.Page
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ListEventsForAdminRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
+func (x *UpdateEventRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
 	}
-	return 0
+	return ""
 }
 
-func (x *ListEventsForAdminRequest) GetUserId() int32 {
+func (x *UpdateEventRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetImageUrl() string {
+	if x != nil && x.ImageUrl != nil {
+		return *x.ImageUrl
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetUserId() int32 {
 	if x != nil && x.UserId != nil {
 		return *x.UserId
 	}
 	return 0
 }
 
-func (x *ListEventsForAdminRequest) GetOrganizationId() int32 {
+func (x *UpdateEventRequest) GetOrganizationId() int32 {
 	if x != nil && x.OrganizationId != nil {
 		return *x.OrganizationId
 	}
 	return 0
 }
 
-type ListEventsForAdminResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpdateEventRequest) GetLocation() string {
+	if x != nil && x.Location != nil {
+		return *x.Location
+	}
+	return ""
 }
 
-func (x *ListEventsForAdminResponse) Reset() {
-	*x = ListEventsForAdminResponse{}
-	mi := &file_eventsv1_events_proto_msgTypes[57]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UpdateEventRequest) GetStartTime() string {
+	if x != nil && x.StartTime != nil {
+		return *x.StartTime
+	}
+	return ""
 }
 
-func (x *ListEventsForAdminResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UpdateEventRequest) GetEndTime() string {
+	if x != nil && x.EndTime != nil {
+		return *x.EndTime
+	}
+	return ""
 }
 
-func (*ListEventsForAdminResponse) ProtoMessage() {}
+func (x *UpdateEventRequest) GetFormat() EventFormat {
+	if x != nil && x.Format != nil {
+		return *x.Format
+	}
+	return EventFormat_EVENT_FORMAT_UNSPECIFIED
+}
 
-func (x *ListEventsForAdminResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[57]
+func (x *UpdateEventRequest) GetTagIds() []int32 {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetCapacity() int32 {
+	if x != nil && x.Capacity != nil {
+		return *x.Capacity
+	}
+	return 0
+}
+
+func (x *UpdateEventRequest) GetExpectedVersion() int32 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+type UpdateEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEventResponse) Reset() {
+	*x = UpdateEventResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventResponse) ProtoMessage() {}
+
+func (x *UpdateEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3718,48 +4485,39 @@ func (x *ListEventsForAdminResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEventsForAdminResponse.ProtoReflect.Descriptor instead.
-func (*ListEventsForAdminResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateEventResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEventResponse) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *ListEventsForAdminResponse) GetEvents() []*Event {
+func (x *UpdateEventResponse) GetEvent() *Event {
 	if x != nil {
-		return x.Events
+		return x.Event
 	}
 	return nil
 }
 
-func (x *ListEventsForAdminResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-// Event Registration messages
-type RegisterForEventRequest struct {
+type DeleteEventRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       int32                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisterForEventRequest) Reset() {
-	*x = RegisterForEventRequest{}
+func (x *DeleteEventRequest) Reset() {
+	*x = DeleteEventRequest{}
 	mi := &file_eventsv1_events_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterForEventRequest) String() string {
+func (x *DeleteEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterForEventRequest) ProtoMessage() {}
+func (*DeleteEventRequest) ProtoMessage() {}
 
-func (x *RegisterForEventRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteEventRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3771,46 +4529,39 @@ func (x *RegisterForEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterForEventRequest.ProtoReflect.Descriptor instead.
-func (*RegisterForEventRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteEventRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEventRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *RegisterForEventRequest) GetEventId() int32 {
-	if x != nil {
-		return x.EventId
-	}
-	return 0
-}
-
-func (x *RegisterForEventRequest) GetUserId() int32 {
+func (x *DeleteEventRequest) GetId() int32 {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return 0
 }
 
-type RegisterForEventResponse struct {
+type DeleteEventResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Registration  *EventRegistration     `protobuf:"bytes,1,opt,name=registration,proto3" json:"registration,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisterForEventResponse) Reset() {
-	*x = RegisterForEventResponse{}
+func (x *DeleteEventResponse) Reset() {
+	*x = DeleteEventResponse{}
 	mi := &file_eventsv1_events_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterForEventResponse) String() string {
+func (x *DeleteEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterForEventResponse) ProtoMessage() {}
+func (*DeleteEventResponse) ProtoMessage() {}
 
-func (x *RegisterForEventResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteEventResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3822,39 +4573,40 @@ func (x *RegisterForEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterForEventResponse.ProtoReflect.Descriptor instead.
-func (*RegisterForEventResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteEventResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEventResponse) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *RegisterForEventResponse) GetRegistration() *EventRegistration {
+func (x *DeleteEventResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Registration
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type CancelRegistrationRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	RegistrationId int32                  `protobuf:"varint,1,opt,name=registration_id,json=registrationId,proto3" json:"registration_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Restore a soft-deleted event (platform admins)
+type RestoreEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelRegistrationRequest) Reset() {
-	*x = CancelRegistrationRequest{}
+func (x *RestoreEventRequest) Reset() {
+	*x = RestoreEventRequest{}
 	mi := &file_eventsv1_events_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelRegistrationRequest) String() string {
+func (x *RestoreEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelRegistrationRequest) ProtoMessage() {}
+func (*RestoreEventRequest) ProtoMessage() {}
 
-func (x *CancelRegistrationRequest) ProtoReflect() protoreflect.Message {
+func (x *RestoreEventRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3866,39 +4618,39 @@ func (x *CancelRegistrationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelRegistrationRequest.ProtoReflect.Descriptor instead.
-func (*CancelRegistrationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreEventRequest.ProtoReflect.Descriptor instead.
+func (*RestoreEventRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *CancelRegistrationRequest) GetRegistrationId() int32 {
+func (x *RestoreEventRequest) GetId() int32 {
 	if x != nil {
-		return x.RegistrationId
+		return x.Id
 	}
 	return 0
 }
 
-type CancelRegistrationResponse struct {
+type RestoreEventResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelRegistrationResponse) Reset() {
-	*x = CancelRegistrationResponse{}
+func (x *RestoreEventResponse) Reset() {
+	*x = RestoreEventResponse{}
 	mi := &file_eventsv1_events_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelRegistrationResponse) String() string {
+func (x *RestoreEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelRegistrationResponse) ProtoMessage() {}
+func (*RestoreEventResponse) ProtoMessage() {}
 
-func (x *CancelRegistrationResponse) ProtoReflect() protoreflect.Message {
+func (x *RestoreEventResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3910,41 +4662,41 @@ func (x *CancelRegistrationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelRegistrationResponse.ProtoReflect.Descriptor instead.
-func (*CancelRegistrationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreEventResponse.ProtoReflect.Descriptor instead.
+func (*RestoreEventResponse) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *CancelRegistrationResponse) GetSuccess() bool {
+func (x *RestoreEventResponse) GetEvent() *Event {
 	if x != nil {
-		return x.Success
+		return x.Event
 	}
-	return false
+	return nil
 }
 
-type GetEventRegistrationsRequest struct {
+// Soft-deleted events, most recently deleted first (platform admins)
+type ListDeletedEventsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       int32                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	Page          *int32                 `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
-	Limit         *int32                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEventRegistrationsRequest) Reset() {
-	*x = GetEventRegistrationsRequest{}
+func (x *ListDeletedEventsRequest) Reset() {
+	*x = ListDeletedEventsRequest{}
 	mi := &file_eventsv1_events_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventRegistrationsRequest) String() string {
+func (x *ListDeletedEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventRegistrationsRequest) ProtoMessage() {}
+func (*ListDeletedEventsRequest) ProtoMessage() {}
 
-func (x *GetEventRegistrationsRequest) ProtoReflect() protoreflect.Message {
+func (x *ListDeletedEventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3956,54 +4708,47 @@ func (x *GetEventRegistrationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventRegistrationsRequest.ProtoReflect.Descriptor instead.
-func (*GetEventRegistrationsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDeletedEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedEventsRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *GetEventRegistrationsRequest) GetEventId() int32 {
+func (x *ListDeletedEventsRequest) GetPage() int32 {
 	if x != nil {
-		return x.EventId
-	}
-	return 0
-}
-
-func (x *GetEventRegistrationsRequest) GetPage() int32 {
-	if x != nil && x.Page != nil {
-		return *x.Page
+		return x.Page
 	}
 	return 0
 }
 
-func (x *GetEventRegistrationsRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
+func (x *ListDeletedEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
 	}
 	return 0
 }
 
-type GetEventRegistrationsResponse struct {
+type ListDeletedEventsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Registrations []*EventRegistration   `protobuf:"bytes,1,rep,name=registrations,proto3" json:"registrations,omitempty"`
+	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEventRegistrationsResponse) Reset() {
-	*x = GetEventRegistrationsResponse{}
+func (x *ListDeletedEventsResponse) Reset() {
+	*x = ListDeletedEventsResponse{}
 	mi := &file_eventsv1_events_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventRegistrationsResponse) String() string {
+func (x *ListDeletedEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventRegistrationsResponse) ProtoMessage() {}
+func (*ListDeletedEventsResponse) ProtoMessage() {}
 
-func (x *GetEventRegistrationsResponse) ProtoReflect() protoreflect.Message {
+func (x *ListDeletedEventsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4015,46 +4760,47 @@ func (x *GetEventRegistrationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventRegistrationsResponse.ProtoReflect.Descriptor instead.
-func (*GetEventRegistrationsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDeletedEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedEventsResponse) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *GetEventRegistrationsResponse) GetRegistrations() []*EventRegistration {
+func (x *ListDeletedEventsResponse) GetEvents() []*Event {
 	if x != nil {
-		return x.Registrations
+		return x.Events
 	}
 	return nil
 }
 
-func (x *GetEventRegistrationsResponse) GetTotal() int32 {
+func (x *ListDeletedEventsResponse) GetTotal() int32 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-type GetUserRegistrationsRequest struct {
+type ToggleEventVisibilityRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EventId       int32                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Published     bool                   `protobuf:"varint,2,opt,name=published,proto3" json:"published,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserRegistrationsRequest) Reset() {
-	*x = GetUserRegistrationsRequest{}
+func (x *ToggleEventVisibilityRequest) Reset() {
+	*x = ToggleEventVisibilityRequest{}
 	mi := &file_eventsv1_events_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserRegistrationsRequest) String() string {
+func (x *ToggleEventVisibilityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserRegistrationsRequest) ProtoMessage() {}
+func (*ToggleEventVisibilityRequest) ProtoMessage() {}
 
-func (x *GetUserRegistrationsRequest) ProtoReflect() protoreflect.Message {
+func (x *ToggleEventVisibilityRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4066,39 +4812,46 @@ func (x *GetUserRegistrationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserRegistrationsRequest.ProtoReflect.Descriptor instead.
-func (*GetUserRegistrationsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ToggleEventVisibilityRequest.ProtoReflect.Descriptor instead.
+func (*ToggleEventVisibilityRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *GetUserRegistrationsRequest) GetUserId() int32 {
+func (x *ToggleEventVisibilityRequest) GetEventId() int32 {
 	if x != nil {
-		return x.UserId
+		return x.EventId
 	}
 	return 0
 }
 
-type GetUserRegistrationsResponse struct {
+func (x *ToggleEventVisibilityRequest) GetPublished() bool {
+	if x != nil {
+		return x.Published
+	}
+	return false
+}
+
+type ToggleEventVisibilityResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Registrations []*EventRegistration   `protobuf:"bytes,1,rep,name=registrations,proto3" json:"registrations,omitempty"`
+	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserRegistrationsResponse) Reset() {
-	*x = GetUserRegistrationsResponse{}
+func (x *ToggleEventVisibilityResponse) Reset() {
+	*x = ToggleEventVisibilityResponse{}
 	mi := &file_eventsv1_events_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserRegistrationsResponse) String() string {
+func (x *ToggleEventVisibilityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserRegistrationsResponse) ProtoMessage() {}
+func (*ToggleEventVisibilityResponse) ProtoMessage() {}
 
-func (x *GetUserRegistrationsResponse) ProtoReflect() protoreflect.Message {
+func (x *ToggleEventVisibilityResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4110,42 +4863,40 @@ func (x *GetUserRegistrationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserRegistrationsResponse.ProtoReflect.Descriptor instead.
-func (*GetUserRegistrationsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ToggleEventVisibilityResponse.ProtoReflect.Descriptor instead.
+func (*ToggleEventVisibilityResponse) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *GetUserRegistrationsResponse) GetRegistrations() []*EventRegistration {
+func (x *ToggleEventVisibilityResponse) GetEvent() *Event {
 	if x != nil {
-		return x.Registrations
+		return x.Event
 	}
 	return nil
 }
 
-// Attendance messages
-type CheckInAttendeeRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	RegistrationId int32                  `protobuf:"varint,1,opt,name=registration_id,json=registrationId,proto3" json:"registration_id,omitempty"`
-	CheckedInBy    int32                  `protobuf:"varint,2,opt,name=checked_in_by,json=checkedInBy,proto3" json:"checked_in_by,omitempty"`
-	Notes          *string                `protobuf:"bytes,3,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Tag CRUD
+type CreateTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckInAttendeeRequest) Reset() {
-	*x = CheckInAttendeeRequest{}
+func (x *CreateTagRequest) Reset() {
+	*x = CreateTagRequest{}
 	mi := &file_eventsv1_events_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckInAttendeeRequest) String() string {
+func (x *CreateTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckInAttendeeRequest) ProtoMessage() {}
+func (*CreateTagRequest) ProtoMessage() {}
 
-func (x *CheckInAttendeeRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateTagRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4157,53 +4908,39 @@ func (x *CheckInAttendeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckInAttendeeRequest.ProtoReflect.Descriptor instead.
-func (*CheckInAttendeeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateTagRequest.ProtoReflect.Descriptor instead.
+func (*CreateTagRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *CheckInAttendeeRequest) GetRegistrationId() int32 {
-	if x != nil {
-		return x.RegistrationId
-	}
-	return 0
-}
-
-func (x *CheckInAttendeeRequest) GetCheckedInBy() int32 {
+func (x *CreateTagRequest) GetName() string {
 	if x != nil {
-		return x.CheckedInBy
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *CheckInAttendeeRequest) GetNotes() string {
-	if x != nil && x.Notes != nil {
-		return *x.Notes
-	}
-	return ""
-}
-
-type CheckInAttendeeResponse struct {
+type CreateTagResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Attendance    *EventAttendance       `protobuf:"bytes,1,opt,name=attendance,proto3" json:"attendance,omitempty"`
+	Tag           *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckInAttendeeResponse) Reset() {
-	*x = CheckInAttendeeResponse{}
+func (x *CreateTagResponse) Reset() {
+	*x = CreateTagResponse{}
 	mi := &file_eventsv1_events_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckInAttendeeResponse) String() string {
+func (x *CreateTagResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckInAttendeeResponse) ProtoMessage() {}
+func (*CreateTagResponse) ProtoMessage() {}
 
-func (x *CheckInAttendeeResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateTagResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4215,41 +4952,39 @@ func (x *CheckInAttendeeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckInAttendeeResponse.ProtoReflect.Descriptor instead.
-func (*CheckInAttendeeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateTagResponse.ProtoReflect.Descriptor instead.
+func (*CreateTagResponse) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *CheckInAttendeeResponse) GetAttendance() *EventAttendance {
+func (x *CreateTagResponse) GetTag() *Tag {
 	if x != nil {
-		return x.Attendance
+		return x.Tag
 	}
 	return nil
 }
 
-type MarkAttendanceRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	RegistrationId int32                  `protobuf:"varint,1,opt,name=registration_id,json=registrationId,proto3" json:"registration_id,omitempty"`
-	Status         AttendanceStatus       `protobuf:"varint,2,opt,name=status,proto3,enum=events.v1.AttendanceStatus" json:"status,omitempty"`
-	Notes          *string                `protobuf:"bytes,3,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MarkAttendanceRequest) Reset() {
-	*x = MarkAttendanceRequest{}
+func (x *GetTagRequest) Reset() {
+	*x = GetTagRequest{}
 	mi := &file_eventsv1_events_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MarkAttendanceRequest) String() string {
+func (x *GetTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarkAttendanceRequest) ProtoMessage() {}
+func (*GetTagRequest) ProtoMessage() {}
 
-func (x *MarkAttendanceRequest) ProtoReflect() protoreflect.Message {
+func (x *GetTagRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4261,53 +4996,39 @@ func (x *MarkAttendanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarkAttendanceRequest.ProtoReflect.Descriptor instead.
-func (*MarkAttendanceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTagRequest.ProtoReflect.Descriptor instead.
+func (*GetTagRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *MarkAttendanceRequest) GetRegistrationId() int32 {
+func (x *GetTagRequest) GetId() int32 {
 	if x != nil {
-		return x.RegistrationId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *MarkAttendanceRequest) GetStatus() AttendanceStatus {
-	if x != nil {
-		return x.Status
-	}
-	return AttendanceStatus_ATTENDANCE_STATUS_UNSPECIFIED
-}
-
-func (x *MarkAttendanceRequest) GetNotes() string {
-	if x != nil && x.Notes != nil {
-		return *x.Notes
-	}
-	return ""
-}
-
-type MarkAttendanceResponse struct {
+type GetTagResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Attendance    *EventAttendance       `protobuf:"bytes,1,opt,name=attendance,proto3" json:"attendance,omitempty"`
+	Tag           *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MarkAttendanceResponse) Reset() {
-	*x = MarkAttendanceResponse{}
+func (x *GetTagResponse) Reset() {
+	*x = GetTagResponse{}
 	mi := &file_eventsv1_events_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MarkAttendanceResponse) String() string {
+func (x *GetTagResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarkAttendanceResponse) ProtoMessage() {}
+func (*GetTagResponse) ProtoMessage() {}
 
-func (x *MarkAttendanceResponse) ProtoReflect() protoreflect.Message {
+func (x *GetTagResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4319,39 +5040,42 @@ func (x *MarkAttendanceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarkAttendanceResponse.ProtoReflect.Descriptor instead.
-func (*MarkAttendanceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTagResponse.ProtoReflect.Descriptor instead.
+func (*GetTagResponse) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *MarkAttendanceResponse) GetAttendance() *EventAttendance {
+func (x *GetTagResponse) GetTag() *Tag {
 	if x != nil {
-		return x.Attendance
+		return x.Tag
 	}
 	return nil
 }
 
-type GetEventAttendanceRequest struct {
+type ListTagsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       int32                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	SortBy        TagSortBy              `protobuf:"varint,3,opt,name=sort_by,json=sortBy,proto3,enum=events.v1.TagSortBy" json:"sort_by,omitempty"`
+	MinEventCount *int32                 `protobuf:"varint,4,opt,name=min_event_count,json=minEventCount,proto3,oneof" json:"min_event_count,omitempty"` // Only tags used by at least this many events
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEventAttendanceRequest) Reset() {
-	*x = GetEventAttendanceRequest{}
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
 	mi := &file_eventsv1_events_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventAttendanceRequest) String() string {
+func (x *ListTagsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventAttendanceRequest) ProtoMessage() {}
+func (*ListTagsRequest) ProtoMessage() {}
 
-func (x *GetEventAttendanceRequest) ProtoReflect() protoreflect.Message {
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4363,42 +5087,61 @@ func (x *GetEventAttendanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventAttendanceRequest.ProtoReflect.Descriptor instead.
-func (*GetEventAttendanceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *GetEventAttendanceRequest) GetEventId() int32 {
+func (x *ListTagsRequest) GetPage() int32 {
 	if x != nil {
-		return x.EventId
+		return x.Page
 	}
 	return 0
 }
 
-type GetEventAttendanceResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Attendance      []*EventAttendance     `protobuf:"bytes,1,rep,name=attendance,proto3" json:"attendance,omitempty"`
-	TotalRegistered int32                  `protobuf:"varint,2,opt,name=total_registered,json=totalRegistered,proto3" json:"total_registered,omitempty"`
-	TotalAttended   int32                  `protobuf:"varint,3,opt,name=total_attended,json=totalAttended,proto3" json:"total_attended,omitempty"`
-	TotalNoShow     int32                  `protobuf:"varint,4,opt,name=total_no_show,json=totalNoShow,proto3" json:"total_no_show,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *ListTagsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
 }
 
-func (x *GetEventAttendanceResponse) Reset() {
-	*x = GetEventAttendanceResponse{}
+func (x *ListTagsRequest) GetSortBy() TagSortBy {
+	if x != nil {
+		return x.SortBy
+	}
+	return TagSortBy_TAG_SORT_BY_UNSPECIFIED
+}
+
+func (x *ListTagsRequest) GetMinEventCount() int32 {
+	if x != nil && x.MinEventCount != nil {
+		return *x.MinEventCount
+	}
+	return 0
+}
+
+type ListTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
 	mi := &file_eventsv1_events_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventAttendanceResponse) String() string {
+func (x *ListTagsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventAttendanceResponse) ProtoMessage() {}
+func (*ListTagsResponse) ProtoMessage() {}
 
-func (x *GetEventAttendanceResponse) ProtoReflect() protoreflect.Message {
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4410,60 +5153,47 @@ func (x *GetEventAttendanceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventAttendanceResponse.ProtoReflect.Descriptor instead.
-func (*GetEventAttendanceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *GetEventAttendanceResponse) GetAttendance() []*EventAttendance {
+func (x *ListTagsResponse) GetTags() []*Tag {
 	if x != nil {
-		return x.Attendance
+		return x.Tags
 	}
 	return nil
 }
 
-func (x *GetEventAttendanceResponse) GetTotalRegistered() int32 {
-	if x != nil {
-		return x.TotalRegistered
-	}
-	return 0
-}
-
-func (x *GetEventAttendanceResponse) GetTotalAttended() int32 {
-	if x != nil {
-		return x.TotalAttended
-	}
-	return 0
-}
-
-func (x *GetEventAttendanceResponse) GetTotalNoShow() int32 {
+func (x *ListTagsResponse) GetTotal() int32 {
 	if x != nil {
-		return x.TotalNoShow
+		return x.Total
 	}
 	return 0
 }
 
-// Statistics messages
-type GetDashboardStatisticsRequest struct {
+type UpdateTagRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDashboardStatisticsRequest) Reset() {
-	*x = GetDashboardStatisticsRequest{}
+func (x *UpdateTagRequest) Reset() {
+	*x = UpdateTagRequest{}
 	mi := &file_eventsv1_events_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDashboardStatisticsRequest) String() string {
+func (x *UpdateTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDashboardStatisticsRequest) ProtoMessage() {}
+func (*UpdateTagRequest) ProtoMessage() {}
 
-func (x *GetDashboardStatisticsRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateTagRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4475,32 +5205,46 @@ func (x *GetDashboardStatisticsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDashboardStatisticsRequest.ProtoReflect.Descriptor instead.
-func (*GetDashboardStatisticsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateTagRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTagRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{72}
 }
 
-type GetDashboardStatisticsResponse struct {
+func (x *UpdateTagRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateTagRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type UpdateTagResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Statistics    *EventStatistics       `protobuf:"bytes,1,opt,name=statistics,proto3" json:"statistics,omitempty"`
+	Tag           *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDashboardStatisticsResponse) Reset() {
-	*x = GetDashboardStatisticsResponse{}
+func (x *UpdateTagResponse) Reset() {
+	*x = UpdateTagResponse{}
 	mi := &file_eventsv1_events_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDashboardStatisticsResponse) String() string {
+func (x *UpdateTagResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDashboardStatisticsResponse) ProtoMessage() {}
+func (*UpdateTagResponse) ProtoMessage() {}
 
-func (x *GetDashboardStatisticsResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateTagResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4512,39 +5256,39 @@ func (x *GetDashboardStatisticsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDashboardStatisticsResponse.ProtoReflect.Descriptor instead.
-func (*GetDashboardStatisticsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateTagResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTagResponse) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *GetDashboardStatisticsResponse) GetStatistics() *EventStatistics {
+func (x *UpdateTagResponse) GetTag() *Tag {
 	if x != nil {
-		return x.Statistics
+		return x.Tag
 	}
 	return nil
 }
 
-type GetEventStatisticsRequest struct {
+type DeleteTagRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       int32                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEventStatisticsRequest) Reset() {
-	*x = GetEventStatisticsRequest{}
+func (x *DeleteTagRequest) Reset() {
+	*x = DeleteTagRequest{}
 	mi := &file_eventsv1_events_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventStatisticsRequest) String() string {
+func (x *DeleteTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventStatisticsRequest) ProtoMessage() {}
+func (*DeleteTagRequest) ProtoMessage() {}
 
-func (x *GetEventStatisticsRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4556,43 +5300,39 @@ func (x *GetEventStatisticsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventStatisticsRequest.ProtoReflect.Descriptor instead.
-func (*GetEventStatisticsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *GetEventStatisticsRequest) GetEventId() int32 {
+func (x *DeleteTagRequest) GetId() int32 {
 	if x != nil {
-		return x.EventId
+		return x.Id
 	}
 	return 0
 }
 
-type GetEventStatisticsResponse struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	TotalRegistrations int32                  `protobuf:"varint,1,opt,name=total_registrations,json=totalRegistrations,proto3" json:"total_registrations,omitempty"`
-	TotalAttendees     int32                  `protobuf:"varint,2,opt,name=total_attendees,json=totalAttendees,proto3" json:"total_attendees,omitempty"`
-	CheckedIn          int32                  `protobuf:"varint,3,opt,name=checked_in,json=checkedIn,proto3" json:"checked_in,omitempty"`
-	NoShow             int32                  `protobuf:"varint,4,opt,name=no_show,json=noShow,proto3" json:"no_show,omitempty"`
-	AttendanceRate     float64                `protobuf:"fixed64,5,opt,name=attendance_rate,json=attendanceRate,proto3" json:"attendance_rate,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type DeleteTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEventStatisticsResponse) Reset() {
-	*x = GetEventStatisticsResponse{}
+func (x *DeleteTagResponse) Reset() {
+	*x = DeleteTagResponse{}
 	mi := &file_eventsv1_events_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventStatisticsResponse) String() string {
+func (x *DeleteTagResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventStatisticsResponse) ProtoMessage() {}
+func (*DeleteTagResponse) ProtoMessage() {}
 
-func (x *GetEventStatisticsResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteTagResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4604,69 +5344,41 @@ func (x *GetEventStatisticsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventStatisticsResponse.ProtoReflect.Descriptor instead.
-func (*GetEventStatisticsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteTagResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTagResponse) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *GetEventStatisticsResponse) GetTotalRegistrations() int32 {
-	if x != nil {
-		return x.TotalRegistrations
-	}
-	return 0
-}
-
-func (x *GetEventStatisticsResponse) GetTotalAttendees() int32 {
-	if x != nil {
-		return x.TotalAttendees
-	}
-	return 0
-}
-
-func (x *GetEventStatisticsResponse) GetCheckedIn() int32 {
-	if x != nil {
-		return x.CheckedIn
-	}
-	return 0
-}
-
-func (x *GetEventStatisticsResponse) GetNoShow() int32 {
-	if x != nil {
-		return x.NoShow
-	}
-	return 0
-}
-
-func (x *GetEventStatisticsResponse) GetAttendanceRate() float64 {
+func (x *DeleteTagResponse) GetSuccess() bool {
 	if x != nil {
-		return x.AttendanceRate
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-type TagDistribution struct {
+// Moves every event from the source tag to the target tag, then deletes the source
+type MergeTagsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TagId         int32                  `protobuf:"varint,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
-	TagName       string                 `protobuf:"bytes,2,opt,name=tag_name,json=tagName,proto3" json:"tag_name,omitempty"`
-	EventCount    int32                  `protobuf:"varint,3,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`
+	SourceTagId   int32                  `protobuf:"varint,1,opt,name=source_tag_id,json=sourceTagId,proto3" json:"source_tag_id,omitempty"`
+	TargetTagId   int32                  `protobuf:"varint,2,opt,name=target_tag_id,json=targetTagId,proto3" json:"target_tag_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TagDistribution) Reset() {
-	*x = TagDistribution{}
+func (x *MergeTagsRequest) Reset() {
+	*x = MergeTagsRequest{}
 	mi := &file_eventsv1_events_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TagDistribution) String() string {
+func (x *MergeTagsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TagDistribution) ProtoMessage() {}
+func (*MergeTagsRequest) ProtoMessage() {}
 
-func (x *TagDistribution) ProtoReflect() protoreflect.Message {
+func (x *MergeTagsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4678,54 +5390,47 @@ func (x *TagDistribution) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TagDistribution.ProtoReflect.Descriptor instead.
-func (*TagDistribution) Descriptor() ([]byte, []int) {
+// Deprecated: Use MergeTagsRequest.ProtoReflect.Descriptor instead.
+func (*MergeTagsRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *TagDistribution) GetTagId() int32 {
+func (x *MergeTagsRequest) GetSourceTagId() int32 {
 	if x != nil {
-		return x.TagId
+		return x.SourceTagId
 	}
 	return 0
 }
 
-func (x *TagDistribution) GetTagName() string {
-	if x != nil {
-		return x.TagName
-	}
-	return ""
-}
-
-func (x *TagDistribution) GetEventCount() int32 {
+func (x *MergeTagsRequest) GetTargetTagId() int32 {
 	if x != nil {
-		return x.EventCount
+		return x.TargetTagId
 	}
 	return 0
 }
 
-type GetEventTagsDistributionByMonthRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
-	Month         int32                  `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type MergeTagsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TargetTag      *Tag                   `protobuf:"bytes,1,opt,name=target_tag,json=targetTag,proto3" json:"target_tag,omitempty"`
+	EventsAffected int32                  `protobuf:"varint,2,opt,name=events_affected,json=eventsAffected,proto3" json:"events_affected,omitempty"` // Events that had the source tag
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetEventTagsDistributionByMonthRequest) Reset() {
-	*x = GetEventTagsDistributionByMonthRequest{}
+func (x *MergeTagsResponse) Reset() {
+	*x = MergeTagsResponse{}
 	mi := &file_eventsv1_events_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventTagsDistributionByMonthRequest) String() string {
+func (x *MergeTagsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventTagsDistributionByMonthRequest) ProtoMessage() {}
+func (*MergeTagsResponse) ProtoMessage() {}
 
-func (x *GetEventTagsDistributionByMonthRequest) ProtoReflect() protoreflect.Message {
+func (x *MergeTagsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4737,47 +5442,47 @@ func (x *GetEventTagsDistributionByMonthRequest) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventTagsDistributionByMonthRequest.ProtoReflect.Descriptor instead.
-func (*GetEventTagsDistributionByMonthRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MergeTagsResponse.ProtoReflect.Descriptor instead.
+func (*MergeTagsResponse) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *GetEventTagsDistributionByMonthRequest) GetYear() int32 {
+func (x *MergeTagsResponse) GetTargetTag() *Tag {
 	if x != nil {
-		return x.Year
+		return x.TargetTag
 	}
-	return 0
+	return nil
 }
 
-func (x *GetEventTagsDistributionByMonthRequest) GetMonth() int32 {
+func (x *MergeTagsResponse) GetEventsAffected() int32 {
 	if x != nil {
-		return x.Month
+		return x.EventsAffected
 	}
 	return 0
 }
 
-type GetEventTagsDistributionByMonthResponse struct {
+// Additional request/response messages for custom methods
+type GetPublishableOrganizationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tags          []*TagDistribution     `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
-	TotalEvents   int32                  `protobuf:"varint,2,opt,name=total_events,json=totalEvents,proto3" json:"total_events,omitempty"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEventTagsDistributionByMonthResponse) Reset() {
-	*x = GetEventTagsDistributionByMonthResponse{}
+func (x *GetPublishableOrganizationsRequest) Reset() {
+	*x = GetPublishableOrganizationsRequest{}
 	mi := &file_eventsv1_events_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventTagsDistributionByMonthResponse) String() string {
+func (x *GetPublishableOrganizationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventTagsDistributionByMonthResponse) ProtoMessage() {}
+func (*GetPublishableOrganizationsRequest) ProtoMessage() {}
 
-func (x *GetEventTagsDistributionByMonthResponse) ProtoReflect() protoreflect.Message {
+func (x *GetPublishableOrganizationsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4789,48 +5494,39 @@ func (x *GetEventTagsDistributionByMonthResponse) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventTagsDistributionByMonthResponse.ProtoReflect.Descriptor instead.
-func (*GetEventTagsDistributionByMonthResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPublishableOrganizationsRequest.ProtoReflect.Descriptor instead.
+func (*GetPublishableOrganizationsRequest) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *GetEventTagsDistributionByMonthResponse) GetTags() []*TagDistribution {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
-}
-
-func (x *GetEventTagsDistributionByMonthResponse) GetTotalEvents() int32 {
+func (x *GetPublishableOrganizationsRequest) GetUserId() int32 {
 	if x != nil {
-		return x.TotalEvents
+		return x.UserId
 	}
 	return 0
 }
 
-type EventActivity struct {
+type GetPublishableOrganizationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
-	Level         int32                  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
+	Organizations []*Organization        `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventActivity) Reset() {
-	*x = EventActivity{}
+func (x *GetPublishableOrganizationsResponse) Reset() {
+	*x = GetPublishableOrganizationsResponse{}
 	mi := &file_eventsv1_events_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventActivity) String() string {
+func (x *GetPublishableOrganizationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventActivity) ProtoMessage() {}
+func (*GetPublishableOrganizationsResponse) ProtoMessage() {}
 
-func (x *EventActivity) ProtoReflect() protoreflect.Message {
+func (x *GetPublishableOrganizationsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_eventsv1_events_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4842,54 +5538,84 @@ func (x *EventActivity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventActivity.ProtoReflect.Descriptor instead.
-func (*EventActivity) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPublishableOrganizationsResponse.ProtoReflect.Descriptor instead.
+func (*GetPublishableOrganizationsResponse) Descriptor() ([]byte, []int) {
 	return file_eventsv1_events_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *EventActivity) GetDate() string {
+func (x *GetPublishableOrganizationsResponse) GetOrganizations() []*Organization {
 	if x != nil {
-		return x.Date
+		return x.Organizations
 	}
-	return ""
+	return nil
 }
 
-func (x *EventActivity) GetCount() int32 {
+type GetUserOrganizationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserOrganizationsRequest) Reset() {
+	*x = GetUserOrganizationsRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserOrganizationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserOrganizationsRequest) ProtoMessage() {}
+
+func (x *GetUserOrganizationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[80]
 	if x != nil {
-		return x.Count
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *EventActivity) GetLevel() int32 {
+// Deprecated: Use GetUserOrganizationsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserOrganizationsRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetUserOrganizationsRequest) GetUserId() int32 {
 	if x != nil {
-		return x.Level
+		return x.UserId
 	}
 	return 0
 }
 
-type GetEventActivityByYearRequest struct {
+type GetUserOrganizationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Organizations []*Organization        `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEventActivityByYearRequest) Reset() {
-	*x = GetEventActivityByYearRequest{}
-	mi := &file_eventsv1_events_proto_msgTypes[80]
+func (x *GetUserOrganizationsResponse) Reset() {
+	*x = GetUserOrganizationsResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventActivityByYearRequest) String() string {
+func (x *GetUserOrganizationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventActivityByYearRequest) ProtoMessage() {}
+func (*GetUserOrganizationsResponse) ProtoMessage() {}
 
-func (x *GetEventActivityByYearRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[80]
+func (x *GetUserOrganizationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4900,41 +5626,40 @@ func (x *GetEventActivityByYearRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventActivityByYearRequest.ProtoReflect.Descriptor instead.
-func (*GetEventActivityByYearRequest) Descriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use GetUserOrganizationsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserOrganizationsResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *GetEventActivityByYearRequest) GetYear() int32 {
+func (x *GetUserOrganizationsResponse) GetOrganizations() []*Organization {
 	if x != nil {
-		return x.Year
+		return x.Organizations
 	}
-	return 0
+	return nil
 }
 
-type GetEventActivityByYearResponse struct {
+type GetEventsByTagIdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Activities    []*EventActivity       `protobuf:"bytes,1,rep,name=activities,proto3" json:"activities,omitempty"`
-	TotalEvents   int32                  `protobuf:"varint,2,opt,name=total_events,json=totalEvents,proto3" json:"total_events,omitempty"`
+	TagId         int32                  `protobuf:"varint,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEventActivityByYearResponse) Reset() {
-	*x = GetEventActivityByYearResponse{}
-	mi := &file_eventsv1_events_proto_msgTypes[81]
+func (x *GetEventsByTagIdRequest) Reset() {
+	*x = GetEventsByTagIdRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventActivityByYearResponse) String() string {
+func (x *GetEventsByTagIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventActivityByYearResponse) ProtoMessage() {}
+func (*GetEventsByTagIdRequest) ProtoMessage() {}
 
-func (x *GetEventActivityByYearResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[81]
+func (x *GetEventsByTagIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4942,52 +5667,4628 @@ func (x *GetEventActivityByYearResponse) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventsByTagIdRequest.ProtoReflect.Descriptor instead.
+func (*GetEventsByTagIdRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetEventsByTagIdRequest) GetTagId() int32 {
+	if x != nil {
+		return x.TagId
+	}
+	return 0
+}
+
+type GetEventsByTagIdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventsByTagIdResponse) Reset() {
+	*x = GetEventsByTagIdResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventsByTagIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventsByTagIdResponse) ProtoMessage() {}
+
+func (x *GetEventsByTagIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventsByTagIdResponse.ProtoReflect.Descriptor instead.
+func (*GetEventsByTagIdResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetEventsByTagIdResponse) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// Returns events that carry every one of the given tags
+type GetEventsByAllTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TagIds        []int32                `protobuf:"varint,1,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"` // 1 to 10 tag IDs
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventsByAllTagsRequest) Reset() {
+	*x = GetEventsByAllTagsRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventsByAllTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventsByAllTagsRequest) ProtoMessage() {}
+
+func (x *GetEventsByAllTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventsByAllTagsRequest.ProtoReflect.Descriptor instead.
+func (*GetEventsByAllTagsRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetEventsByAllTagsRequest) GetTagIds() []int32 {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *GetEventsByAllTagsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetEventsByAllTagsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetEventsByAllTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventsByAllTagsResponse) Reset() {
+	*x = GetEventsByAllTagsResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventsByAllTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventsByAllTagsResponse) ProtoMessage() {}
+
+func (x *GetEventsByAllTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventsByAllTagsResponse.ProtoReflect.Descriptor instead.
+func (*GetEventsByAllTagsResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetEventsByAllTagsResponse) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GetEventsByAllTagsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetManagedEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Local user ID, 0 = the caller
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	RoleFilter    ManagedEventRole       `protobuf:"varint,4,opt,name=role_filter,json=roleFilter,proto3,enum=events.v1.ManagedEventRole" json:"role_filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetManagedEventsRequest) Reset() {
+	*x = GetManagedEventsRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetManagedEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetManagedEventsRequest) ProtoMessage() {}
+
+func (x *GetManagedEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetManagedEventsRequest.ProtoReflect.Descriptor instead.
+func (*GetManagedEventsRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetManagedEventsRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetManagedEventsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetManagedEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetManagedEventsRequest) GetRoleFilter() ManagedEventRole {
+	if x != nil {
+		return x.RoleFilter
+	}
+	return ManagedEventRole_MANAGED_EVENT_ROLE_UNSPECIFIED
+}
+
+type GetManagedEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetManagedEventsResponse) Reset() {
+	*x = GetManagedEventsResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetManagedEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetManagedEventsResponse) ProtoMessage() {}
+
+func (x *GetManagedEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetManagedEventsResponse.ProtoReflect.Descriptor instead.
+func (*GetManagedEventsResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetManagedEventsResponse) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GetManagedEventsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetEventFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        *string                `protobuf:"bytes,1,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"` // next_cursor of the previous page, unset for the first page
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventFeedRequest) Reset() {
+	*x = GetEventFeedRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventFeedRequest) ProtoMessage() {}
+
+func (x *GetEventFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetEventFeedRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetEventFeedRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *GetEventFeedRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type FeedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"` // "followed_org" (one of the user's clubs) or "recommended" (matches the user's top tags)
+	Score         float64                `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedEvent) Reset() {
+	*x = FeedEvent{}
+	mi := &file_eventsv1_events_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedEvent) ProtoMessage() {}
+
+func (x *FeedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedEvent.ProtoReflect.Descriptor instead.
+func (*FeedEvent) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *FeedEvent) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *FeedEvent) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *FeedEvent) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type GetEventFeedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*FeedEvent           `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextCursor    *string                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"` // Unset on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventFeedResponse) Reset() {
+	*x = GetEventFeedResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventFeedResponse) ProtoMessage() {}
+
+func (x *GetEventFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetEventFeedResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetEventFeedResponse) GetEvents() []*FeedEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GetEventFeedResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+// Just enough of an event to render it in a calendar cell
+type EventSummary struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	StartTime      string                 `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	Format         EventFormat            `protobuf:"varint,4,opt,name=format,proto3,enum=events.v1.EventFormat" json:"format,omitempty"`
+	OrganizationId int32                  `protobuf:"varint,5,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EventSummary) Reset() {
+	*x = EventSummary{}
+	mi := &file_eventsv1_events_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSummary) ProtoMessage() {}
+
+func (x *EventSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventSummary.ProtoReflect.Descriptor instead.
+func (*EventSummary) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *EventSummary) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EventSummary) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *EventSummary) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *EventSummary) GetFormat() EventFormat {
+	if x != nil {
+		return x.Format
+	}
+	return EventFormat_EVENT_FORMAT_UNSPECIFIED
+}
+
+func (x *EventSummary) GetOrganizationId() int32 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+type GetEventCalendarRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Year           int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month          int32                  `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`                                               // 1-12
+	OrganizationId *int32                 `protobuf:"varint,3,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"` // Only this club's events
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetEventCalendarRequest) Reset() {
+	*x = GetEventCalendarRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventCalendarRequest) ProtoMessage() {}
+
+func (x *GetEventCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventCalendarRequest.ProtoReflect.Descriptor instead.
+func (*GetEventCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetEventCalendarRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *GetEventCalendarRequest) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *GetEventCalendarRequest) GetOrganizationId() int32 {
+	if x != nil && x.OrganizationId != nil {
+		return *x.OrganizationId
+	}
+	return 0
+}
+
+type CalendarDay struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD
+	EventCount    int32                  `protobuf:"varint,2,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`
+	Events        []*EventSummary        `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"` // The first 5 by start time
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarDay) Reset() {
+	*x = CalendarDay{}
+	mi := &file_eventsv1_events_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarDay) ProtoMessage() {}
+
+func (x *CalendarDay) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarDay.ProtoReflect.Descriptor instead.
+func (*CalendarDay) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *CalendarDay) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *CalendarDay) GetEventCount() int32 {
+	if x != nil {
+		return x.EventCount
+	}
+	return 0
+}
+
+func (x *CalendarDay) GetEvents() []*EventSummary {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// Only days with at least one published event starting on them, in order
+type GetEventCalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          []*CalendarDay         `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventCalendarResponse) Reset() {
+	*x = GetEventCalendarResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventCalendarResponse) ProtoMessage() {}
+
+func (x *GetEventCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventCalendarResponse.ProtoReflect.Descriptor instead.
+func (*GetEventCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetEventCalendarResponse) GetDays() []*CalendarDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+type GetUserSubscribedEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"` // 0 returns all events
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserSubscribedEventsRequest) Reset() {
+	*x = GetUserSubscribedEventsRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserSubscribedEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserSubscribedEventsRequest) ProtoMessage() {}
+
+func (x *GetUserSubscribedEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserSubscribedEventsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserSubscribedEventsRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GetUserSubscribedEventsRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUserSubscribedEventsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetUserSubscribedEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetUserSubscribedEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserSubscribedEventsResponse) Reset() {
+	*x = GetUserSubscribedEventsResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserSubscribedEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserSubscribedEventsResponse) ProtoMessage() {}
+
+func (x *GetUserSubscribedEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserSubscribedEventsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserSubscribedEventsResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetUserSubscribedEventsResponse) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GetUserSubscribedEventsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ListEventsForAdminRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Page           int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit          int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	UserId         *int32                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	OrganizationId *int32                 `protobuf:"varint,4,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"`
+	Cursor         *string                `protobuf:"bytes,5,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"` // Same as ListEventsRequest.cursor
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListEventsForAdminRequest) Reset() {
+	*x = ListEventsForAdminRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsForAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsForAdminRequest) ProtoMessage() {}
+
+func (x *ListEventsForAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsForAdminRequest.ProtoReflect.Descriptor instead.
+func (*ListEventsForAdminRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ListEventsForAdminRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListEventsForAdminRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListEventsForAdminRequest) GetUserId() int32 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *ListEventsForAdminRequest) GetOrganizationId() int32 {
+	if x != nil && x.OrganizationId != nil {
+		return *x.OrganizationId
+	}
+	return 0
+}
+
+func (x *ListEventsForAdminRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type ListEventsForAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	NextCursor    *string                `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsForAdminResponse) Reset() {
+	*x = ListEventsForAdminResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsForAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsForAdminResponse) ProtoMessage() {}
+
+func (x *ListEventsForAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsForAdminResponse.ProtoReflect.Descriptor instead.
+func (*ListEventsForAdminResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ListEventsForAdminResponse) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListEventsForAdminResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListEventsForAdminResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+// Event Registration messages
+type RegisterForEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int32                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterForEventRequest) Reset() {
+	*x = RegisterForEventRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterForEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterForEventRequest) ProtoMessage() {}
+
+func (x *RegisterForEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterForEventRequest.ProtoReflect.Descriptor instead.
+func (*RegisterForEventRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *RegisterForEventRequest) GetEventId() int32 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *RegisterForEventRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type RegisterForEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Registration  *EventRegistration     `protobuf:"bytes,1,opt,name=registration,proto3" json:"registration,omitempty"`                  // Status WAITLIST when the event is full
+	CancelUrl     *string                `protobuf:"bytes,2,opt,name=cancel_url,json=cancelUrl,proto3,oneof" json:"cancel_url,omitempty"` // Single-use self-service cancellation link for the confirmation email
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterForEventResponse) Reset() {
+	*x = RegisterForEventResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterForEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterForEventResponse) ProtoMessage() {}
+
+func (x *RegisterForEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterForEventResponse.ProtoReflect.Descriptor instead.
+func (*RegisterForEventResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *RegisterForEventResponse) GetRegistration() *EventRegistration {
+	if x != nil {
+		return x.Registration
+	}
+	return nil
+}
+
+func (x *RegisterForEventResponse) GetCancelUrl() string {
+	if x != nil && x.CancelUrl != nil {
+		return *x.CancelUrl
+	}
+	return ""
+}
+
+type CancelRegistrationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RegistrationId int32                  `protobuf:"varint,1,opt,name=registration_id,json=registrationId,proto3" json:"registration_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CancelRegistrationRequest) Reset() {
+	*x = CancelRegistrationRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRegistrationRequest) ProtoMessage() {}
+
+func (x *CancelRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*CancelRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *CancelRegistrationRequest) GetRegistrationId() int32 {
+	if x != nil {
+		return x.RegistrationId
+	}
+	return 0
+}
+
+type CancelRegistrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelRegistrationResponse) Reset() {
+	*x = CancelRegistrationResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelRegistrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRegistrationResponse) ProtoMessage() {}
+
+func (x *CancelRegistrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRegistrationResponse.ProtoReflect.Descriptor instead.
+func (*CancelRegistrationResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *CancelRegistrationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetEventRegistrationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int32                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Page          *int32                 `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	Limit         *int32                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventRegistrationsRequest) Reset() {
+	*x = GetEventRegistrationsRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventRegistrationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventRegistrationsRequest) ProtoMessage() {}
+
+func (x *GetEventRegistrationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventRegistrationsRequest.ProtoReflect.Descriptor instead.
+func (*GetEventRegistrationsRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetEventRegistrationsRequest) GetEventId() int32 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *GetEventRegistrationsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *GetEventRegistrationsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type GetEventRegistrationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Registrations []*EventRegistration   `protobuf:"bytes,1,rep,name=registrations,proto3" json:"registrations,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventRegistrationsResponse) Reset() {
+	*x = GetEventRegistrationsResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventRegistrationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventRegistrationsResponse) ProtoMessage() {}
+
+func (x *GetEventRegistrationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventRegistrationsResponse.ProtoReflect.Descriptor instead.
+func (*GetEventRegistrationsResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GetEventRegistrationsResponse) GetRegistrations() []*EventRegistration {
+	if x != nil {
+		return x.Registrations
+	}
+	return nil
+}
+
+func (x *GetEventRegistrationsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetUserRegistrationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                                           // 0 returns all registrations
+	Status        *RegistrationStatus    `protobuf:"varint,4,opt,name=status,proto3,enum=events.v1.RegistrationStatus,oneof" json:"status,omitempty"` // Registered, cancelled or waitlist only
+	IncludeEvent  bool                   `protobuf:"varint,5,opt,name=include_event,json=includeEvent,proto3" json:"include_event,omitempty"`         // Populate registrations[].event
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRegistrationsRequest) Reset() {
+	*x = GetUserRegistrationsRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRegistrationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRegistrationsRequest) ProtoMessage() {}
+
+func (x *GetUserRegistrationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRegistrationsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRegistrationsRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GetUserRegistrationsRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUserRegistrationsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetUserRegistrationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetUserRegistrationsRequest) GetStatus() RegistrationStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return RegistrationStatus_REGISTRATION_STATUS_UNSPECIFIED
+}
+
+func (x *GetUserRegistrationsRequest) GetIncludeEvent() bool {
+	if x != nil {
+		return x.IncludeEvent
+	}
+	return false
+}
+
+type GetUserRegistrationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Registrations []*EventRegistration   `protobuf:"bytes,1,rep,name=registrations,proto3" json:"registrations,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRegistrationsResponse) Reset() {
+	*x = GetUserRegistrationsResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRegistrationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRegistrationsResponse) ProtoMessage() {}
+
+func (x *GetUserRegistrationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRegistrationsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserRegistrationsResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetUserRegistrationsResponse) GetRegistrations() []*EventRegistration {
+	if x != nil {
+		return x.Registrations
+	}
+	return nil
+}
+
+func (x *GetUserRegistrationsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// A temporary reservation of one seat, released when it expires
+type RegistrationHold struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId       int32                  `protobuf:"varint,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegistrationHold) Reset() {
+	*x = RegistrationHold{}
+	mi := &file_eventsv1_events_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegistrationHold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistrationHold) ProtoMessage() {}
+
+func (x *RegistrationHold) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistrationHold.ProtoReflect.Descriptor instead.
+func (*RegistrationHold) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *RegistrationHold) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RegistrationHold) GetEventId() int32 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *RegistrationHold) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RegistrationHold) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *RegistrationHold) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type HoldEventRegistrationRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	EventId             int32                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId              int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                          // Optional, holds are always for the caller
+	HoldDurationSeconds int32                  `protobuf:"varint,3,opt,name=hold_duration_seconds,json=holdDurationSeconds,proto3" json:"hold_duration_seconds,omitempty"` // Defaults to 300, capped at 3600
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *HoldEventRegistrationRequest) Reset() {
+	*x = HoldEventRegistrationRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldEventRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldEventRegistrationRequest) ProtoMessage() {}
+
+func (x *HoldEventRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldEventRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*HoldEventRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *HoldEventRegistrationRequest) GetEventId() int32 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *HoldEventRegistrationRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *HoldEventRegistrationRequest) GetHoldDurationSeconds() int32 {
+	if x != nil {
+		return x.HoldDurationSeconds
+	}
+	return 0
+}
+
+type HoldEventRegistrationResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Hold           *RegistrationHold      `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	AvailableSlots int32                  `protobuf:"varint,2,opt,name=available_slots,json=availableSlots,proto3" json:"available_slots,omitempty"` // Remaining after this hold, -1 when unlimited
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *HoldEventRegistrationResponse) Reset() {
+	*x = HoldEventRegistrationResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldEventRegistrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldEventRegistrationResponse) ProtoMessage() {}
+
+func (x *HoldEventRegistrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldEventRegistrationResponse.ProtoReflect.Descriptor instead.
+func (*HoldEventRegistrationResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *HoldEventRegistrationResponse) GetHold() *RegistrationHold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+func (x *HoldEventRegistrationResponse) GetAvailableSlots() int32 {
+	if x != nil {
+		return x.AvailableSlots
+	}
+	return 0
+}
+
+type ConfirmRegistrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HoldId        int32                  `protobuf:"varint,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmRegistrationRequest) Reset() {
+	*x = ConfirmRegistrationRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmRegistrationRequest) ProtoMessage() {}
+
+func (x *ConfirmRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ConfirmRegistrationRequest) GetHoldId() int32 {
+	if x != nil {
+		return x.HoldId
+	}
+	return 0
+}
+
+type ConfirmRegistrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Registration  *EventRegistration     `protobuf:"bytes,1,opt,name=registration,proto3" json:"registration,omitempty"`
+	CancelUrl     *string                `protobuf:"bytes,2,opt,name=cancel_url,json=cancelUrl,proto3,oneof" json:"cancel_url,omitempty"` // Single-use self-service cancellation link for the confirmation email
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmRegistrationResponse) Reset() {
+	*x = ConfirmRegistrationResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmRegistrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmRegistrationResponse) ProtoMessage() {}
+
+func (x *ConfirmRegistrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmRegistrationResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmRegistrationResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ConfirmRegistrationResponse) GetRegistration() *EventRegistration {
+	if x != nil {
+		return x.Registration
+	}
+	return nil
+}
+
+func (x *ConfirmRegistrationResponse) GetCancelUrl() string {
+	if x != nil && x.CancelUrl != nil {
+		return *x.CancelUrl
+	}
+	return ""
+}
+
+// Emails an announcement to everyone registered for an event
+type NotifyRegistrantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int32                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Subject       string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	DryRun        bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // Only list the recipients, send nothing
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotifyRegistrantsRequest) Reset() {
+	*x = NotifyRegistrantsRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyRegistrantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyRegistrantsRequest) ProtoMessage() {}
+
+func (x *NotifyRegistrantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyRegistrantsRequest.ProtoReflect.Descriptor instead.
+func (*NotifyRegistrantsRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *NotifyRegistrantsRequest) GetEventId() int32 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *NotifyRegistrantsRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *NotifyRegistrantsRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *NotifyRegistrantsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type NotifyRegistrantsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmailsSent    int32                  `protobuf:"varint,1,opt,name=emails_sent,json=emailsSent,proto3" json:"emails_sent,omitempty"` // On dry_run, the number of recipients
+	Errors        []string               `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`                            // One entry per batch the relay rejected
+	Recipients    []string               `protobuf:"bytes,3,rep,name=recipients,proto3" json:"recipients,omitempty"`                    // Set on dry_run only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotifyRegistrantsResponse) Reset() {
+	*x = NotifyRegistrantsResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyRegistrantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyRegistrantsResponse) ProtoMessage() {}
+
+func (x *NotifyRegistrantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyRegistrantsResponse.ProtoReflect.Descriptor instead.
+func (*NotifyRegistrantsResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *NotifyRegistrantsResponse) GetEmailsSent() int32 {
+	if x != nil {
+		return x.EmailsSent
+	}
+	return 0
+}
+
+func (x *NotifyRegistrantsResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *NotifyRegistrantsResponse) GetRecipients() []string {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+type GetEventRegistrationStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int32                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventRegistrationStatusRequest) Reset() {
+	*x = GetEventRegistrationStatusRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventRegistrationStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventRegistrationStatusRequest) ProtoMessage() {}
+
+func (x *GetEventRegistrationStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventRegistrationStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetEventRegistrationStatusRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *GetEventRegistrationStatusRequest) GetEventId() int32 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *GetEventRegistrationStatusRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetEventRegistrationStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        RegistrationStatus     `protobuf:"varint,1,opt,name=status,proto3,enum=events.v1.RegistrationStatus" json:"status,omitempty"`
+	Registration  *EventRegistration     `protobuf:"bytes,2,opt,name=registration,proto3,oneof" json:"registration,omitempty"` // Unset when NOT_REGISTERED
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventRegistrationStatusResponse) Reset() {
+	*x = GetEventRegistrationStatusResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventRegistrationStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventRegistrationStatusResponse) ProtoMessage() {}
+
+func (x *GetEventRegistrationStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventRegistrationStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetEventRegistrationStatusResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *GetEventRegistrationStatusResponse) GetStatus() RegistrationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RegistrationStatus_REGISTRATION_STATUS_UNSPECIFIED
+}
+
+func (x *GetEventRegistrationStatusResponse) GetRegistration() *EventRegistration {
+	if x != nil {
+		return x.Registration
+	}
+	return nil
+}
+
+// Attendance messages
+type CheckInAttendeeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RegistrationId int32                  `protobuf:"varint,1,opt,name=registration_id,json=registrationId,proto3" json:"registration_id,omitempty"`
+	CheckedInBy    int32                  `protobuf:"varint,2,opt,name=checked_in_by,json=checkedInBy,proto3" json:"checked_in_by,omitempty"`
+	Notes          *string                `protobuf:"bytes,3,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CheckInAttendeeRequest) Reset() {
+	*x = CheckInAttendeeRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInAttendeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInAttendeeRequest) ProtoMessage() {}
+
+func (x *CheckInAttendeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInAttendeeRequest.ProtoReflect.Descriptor instead.
+func (*CheckInAttendeeRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *CheckInAttendeeRequest) GetRegistrationId() int32 {
+	if x != nil {
+		return x.RegistrationId
+	}
+	return 0
+}
+
+func (x *CheckInAttendeeRequest) GetCheckedInBy() int32 {
+	if x != nil {
+		return x.CheckedInBy
+	}
+	return 0
+}
+
+func (x *CheckInAttendeeRequest) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+type CheckInAttendeeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attendance    *EventAttendance       `protobuf:"bytes,1,opt,name=attendance,proto3" json:"attendance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInAttendeeResponse) Reset() {
+	*x = CheckInAttendeeResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInAttendeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInAttendeeResponse) ProtoMessage() {}
+
+func (x *CheckInAttendeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInAttendeeResponse.ProtoReflect.Descriptor instead.
+func (*CheckInAttendeeResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *CheckInAttendeeResponse) GetAttendance() *EventAttendance {
+	if x != nil {
+		return x.Attendance
+	}
+	return nil
+}
+
+type BulkCheckInRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RegistrationIds []int32                `protobuf:"varint,1,rep,packed,name=registration_ids,json=registrationIds,proto3" json:"registration_ids,omitempty"`
+	CheckedInBy     int32                  `protobuf:"varint,2,opt,name=checked_in_by,json=checkedInBy,proto3" json:"checked_in_by,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BulkCheckInRequest) Reset() {
+	*x = BulkCheckInRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCheckInRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCheckInRequest) ProtoMessage() {}
+
+func (x *BulkCheckInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCheckInRequest.ProtoReflect.Descriptor instead.
+func (*BulkCheckInRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *BulkCheckInRequest) GetRegistrationIds() []int32 {
+	if x != nil {
+		return x.RegistrationIds
+	}
+	return nil
+}
+
+func (x *BulkCheckInRequest) GetCheckedInBy() int32 {
+	if x != nil {
+		return x.CheckedInBy
+	}
+	return 0
+}
+
+type BulkCheckInFailure struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RegistrationId int32                  `protobuf:"varint,1,opt,name=registration_id,json=registrationId,proto3" json:"registration_id,omitempty"`
+	Reason         string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BulkCheckInFailure) Reset() {
+	*x = BulkCheckInFailure{}
+	mi := &file_eventsv1_events_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCheckInFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCheckInFailure) ProtoMessage() {}
+
+func (x *BulkCheckInFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCheckInFailure.ProtoReflect.Descriptor instead.
+func (*BulkCheckInFailure) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *BulkCheckInFailure) GetRegistrationId() int32 {
+	if x != nil {
+		return x.RegistrationId
+	}
+	return 0
+}
+
+func (x *BulkCheckInFailure) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type BulkCheckInResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Succeeded     []int32                `protobuf:"varint,1,rep,packed,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        []*BulkCheckInFailure  `protobuf:"bytes,2,rep,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCheckInResponse) Reset() {
+	*x = BulkCheckInResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCheckInResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCheckInResponse) ProtoMessage() {}
+
+func (x *BulkCheckInResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCheckInResponse.ProtoReflect.Descriptor instead.
+func (*BulkCheckInResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *BulkCheckInResponse) GetSucceeded() []int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return nil
+}
+
+func (x *BulkCheckInResponse) GetFailed() []*BulkCheckInFailure {
+	if x != nil {
+		return x.Failed
+	}
+	return nil
+}
+
+type MarkAttendanceRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RegistrationId int32                  `protobuf:"varint,1,opt,name=registration_id,json=registrationId,proto3" json:"registration_id,omitempty"`
+	Status         AttendanceStatus       `protobuf:"varint,2,opt,name=status,proto3,enum=events.v1.AttendanceStatus" json:"status,omitempty"`
+	Notes          *string                `protobuf:"bytes,3,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MarkAttendanceRequest) Reset() {
+	*x = MarkAttendanceRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAttendanceRequest) ProtoMessage() {}
+
+func (x *MarkAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*MarkAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *MarkAttendanceRequest) GetRegistrationId() int32 {
+	if x != nil {
+		return x.RegistrationId
+	}
+	return 0
+}
+
+func (x *MarkAttendanceRequest) GetStatus() AttendanceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AttendanceStatus_ATTENDANCE_STATUS_UNSPECIFIED
+}
+
+func (x *MarkAttendanceRequest) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+type MarkAttendanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attendance    *EventAttendance       `protobuf:"bytes,1,opt,name=attendance,proto3" json:"attendance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAttendanceResponse) Reset() {
+	*x = MarkAttendanceResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAttendanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAttendanceResponse) ProtoMessage() {}
+
+func (x *MarkAttendanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAttendanceResponse.ProtoReflect.Descriptor instead.
+func (*MarkAttendanceResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *MarkAttendanceResponse) GetAttendance() *EventAttendance {
+	if x != nil {
+		return x.Attendance
+	}
+	return nil
+}
+
+type GetEventAttendanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int32                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventAttendanceRequest) Reset() {
+	*x = GetEventAttendanceRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventAttendanceRequest) ProtoMessage() {}
+
+func (x *GetEventAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*GetEventAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *GetEventAttendanceRequest) GetEventId() int32 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+type GetEventAttendanceResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Attendance      []*EventAttendance     `protobuf:"bytes,1,rep,name=attendance,proto3" json:"attendance,omitempty"`
+	TotalRegistered int32                  `protobuf:"varint,2,opt,name=total_registered,json=totalRegistered,proto3" json:"total_registered,omitempty"`
+	TotalAttended   int32                  `protobuf:"varint,3,opt,name=total_attended,json=totalAttended,proto3" json:"total_attended,omitempty"`
+	TotalNoShow     int32                  `protobuf:"varint,4,opt,name=total_no_show,json=totalNoShow,proto3" json:"total_no_show,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetEventAttendanceResponse) Reset() {
+	*x = GetEventAttendanceResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventAttendanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventAttendanceResponse) ProtoMessage() {}
+
+func (x *GetEventAttendanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventAttendanceResponse.ProtoReflect.Descriptor instead.
+func (*GetEventAttendanceResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *GetEventAttendanceResponse) GetAttendance() []*EventAttendance {
+	if x != nil {
+		return x.Attendance
+	}
+	return nil
+}
+
+func (x *GetEventAttendanceResponse) GetTotalRegistered() int32 {
+	if x != nil {
+		return x.TotalRegistered
+	}
+	return 0
+}
+
+func (x *GetEventAttendanceResponse) GetTotalAttended() int32 {
+	if x != nil {
+		return x.TotalAttended
+	}
+	return 0
+}
+
+func (x *GetEventAttendanceResponse) GetTotalNoShow() int32 {
+	if x != nil {
+		return x.TotalNoShow
+	}
+	return 0
+}
+
+// Live feed of an event's check-ins and attendance changes, starting from
+// when the stream opens. Use GetEventAttendance for the current state.
+type StreamEventAttendanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int32                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEventAttendanceRequest) Reset() {
+	*x = StreamEventAttendanceRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEventAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventAttendanceRequest) ProtoMessage() {}
+
+func (x *StreamEventAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*StreamEventAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *StreamEventAttendanceRequest) GetEventId() int32 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+type StreamEventAttendanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attendance    *EventAttendance       `protobuf:"bytes,1,opt,name=attendance,proto3" json:"attendance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEventAttendanceResponse) Reset() {
+	*x = StreamEventAttendanceResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEventAttendanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventAttendanceResponse) ProtoMessage() {}
+
+func (x *StreamEventAttendanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventAttendanceResponse.ProtoReflect.Descriptor instead.
+func (*StreamEventAttendanceResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *StreamEventAttendanceResponse) GetAttendance() *EventAttendance {
+	if x != nil {
+		return x.Attendance
+	}
+	return nil
+}
+
+type GetUserAttendanceHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        *string                `protobuf:"bytes,4,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"` // Set to page by cursor instead of page: empty for the first page, then next_cursor
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserAttendanceHistoryRequest) Reset() {
+	*x = GetUserAttendanceHistoryRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserAttendanceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserAttendanceHistoryRequest) ProtoMessage() {}
+
+func (x *GetUserAttendanceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserAttendanceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetUserAttendanceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GetUserAttendanceHistoryRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUserAttendanceHistoryRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetUserAttendanceHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetUserAttendanceHistoryRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+// An event the user attended, with their check-in details
+type AttendedEventSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	CheckedInAt   *string                `protobuf:"bytes,2,opt,name=checked_in_at,json=checkedInAt,proto3,oneof" json:"checked_in_at,omitempty"` // Unset when marked attended without a check-in
+	Notes         *string                `protobuf:"bytes,3,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendedEventSummary) Reset() {
+	*x = AttendedEventSummary{}
+	mi := &file_eventsv1_events_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendedEventSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendedEventSummary) ProtoMessage() {}
+
+func (x *AttendedEventSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendedEventSummary.ProtoReflect.Descriptor instead.
+func (*AttendedEventSummary) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *AttendedEventSummary) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *AttendedEventSummary) GetCheckedInAt() string {
+	if x != nil && x.CheckedInAt != nil {
+		return *x.CheckedInAt
+	}
+	return ""
+}
+
+func (x *AttendedEventSummary) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+type UserAttendanceHistoryResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Events        []*AttendedEventSummary `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"` // Newest event first
+	Total         int32                   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	NextCursor    *string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"` // Only in cursor mode, unset on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserAttendanceHistoryResponse) Reset() {
+	*x = UserAttendanceHistoryResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserAttendanceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserAttendanceHistoryResponse) ProtoMessage() {}
+
+func (x *UserAttendanceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserAttendanceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*UserAttendanceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *UserAttendanceHistoryResponse) GetEvents() []*AttendedEventSummary {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *UserAttendanceHistoryResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *UserAttendanceHistoryResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+type ExportEventAttendanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int32                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportEventAttendanceRequest) Reset() {
+	*x = ExportEventAttendanceRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportEventAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportEventAttendanceRequest) ProtoMessage() {}
+
+func (x *ExportEventAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportEventAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*ExportEventAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *ExportEventAttendanceRequest) GetEventId() int32 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+// Part of a CSV attendance export. Chunks split at arbitrary byte offsets,
+// so concatenate them in order before parsing.
+type AttendanceExportChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"` // At most 64 KiB
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendanceExportChunk) Reset() {
+	*x = AttendanceExportChunk{}
+	mi := &file_eventsv1_events_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceExportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceExportChunk) ProtoMessage() {}
+
+func (x *AttendanceExportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceExportChunk.ProtoReflect.Descriptor instead.
+func (*AttendanceExportChunk) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *AttendanceExportChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Statistics messages
+type GetDashboardStatisticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ForceRefresh  bool                   `protobuf:"varint,1,opt,name=force_refresh,json=forceRefresh,proto3" json:"force_refresh,omitempty"` // Recompute instead of reading the snapshot
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDashboardStatisticsRequest) Reset() {
+	*x = GetDashboardStatisticsRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDashboardStatisticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDashboardStatisticsRequest) ProtoMessage() {}
+
+func (x *GetDashboardStatisticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDashboardStatisticsRequest.ProtoReflect.Descriptor instead.
+func (*GetDashboardStatisticsRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *GetDashboardStatisticsRequest) GetForceRefresh() bool {
+	if x != nil {
+		return x.ForceRefresh
+	}
+	return false
+}
+
+type GetDashboardStatisticsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statistics    *EventStatistics       `protobuf:"bytes,1,opt,name=statistics,proto3" json:"statistics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDashboardStatisticsResponse) Reset() {
+	*x = GetDashboardStatisticsResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDashboardStatisticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDashboardStatisticsResponse) ProtoMessage() {}
+
+func (x *GetDashboardStatisticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDashboardStatisticsResponse.ProtoReflect.Descriptor instead.
+func (*GetDashboardStatisticsResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *GetDashboardStatisticsResponse) GetStatistics() *EventStatistics {
+	if x != nil {
+		return x.Statistics
+	}
+	return nil
+}
+
+type GetEventStatisticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       int32                  `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventStatisticsRequest) Reset() {
+	*x = GetEventStatisticsRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventStatisticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventStatisticsRequest) ProtoMessage() {}
+
+func (x *GetEventStatisticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventStatisticsRequest.ProtoReflect.Descriptor instead.
+func (*GetEventStatisticsRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *GetEventStatisticsRequest) GetEventId() int32 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+type GetEventStatisticsResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TotalRegistrations int32                  `protobuf:"varint,1,opt,name=total_registrations,json=totalRegistrations,proto3" json:"total_registrations,omitempty"`
+	TotalAttendees     int32                  `protobuf:"varint,2,opt,name=total_attendees,json=totalAttendees,proto3" json:"total_attendees,omitempty"`
+	CheckedIn          int32                  `protobuf:"varint,3,opt,name=checked_in,json=checkedIn,proto3" json:"checked_in,omitempty"`
+	NoShow             int32                  `protobuf:"varint,4,opt,name=no_show,json=noShow,proto3" json:"no_show,omitempty"`
+	AttendanceRate     float64                `protobuf:"fixed64,5,opt,name=attendance_rate,json=attendanceRate,proto3" json:"attendance_rate,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetEventStatisticsResponse) Reset() {
+	*x = GetEventStatisticsResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventStatisticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventStatisticsResponse) ProtoMessage() {}
+
+func (x *GetEventStatisticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventStatisticsResponse.ProtoReflect.Descriptor instead.
+func (*GetEventStatisticsResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *GetEventStatisticsResponse) GetTotalRegistrations() int32 {
+	if x != nil {
+		return x.TotalRegistrations
+	}
+	return 0
+}
+
+func (x *GetEventStatisticsResponse) GetTotalAttendees() int32 {
+	if x != nil {
+		return x.TotalAttendees
+	}
+	return 0
+}
+
+func (x *GetEventStatisticsResponse) GetCheckedIn() int32 {
+	if x != nil {
+		return x.CheckedIn
+	}
+	return 0
+}
+
+func (x *GetEventStatisticsResponse) GetNoShow() int32 {
+	if x != nil {
+		return x.NoShow
+	}
+	return 0
+}
+
+func (x *GetEventStatisticsResponse) GetAttendanceRate() float64 {
+	if x != nil {
+		return x.AttendanceRate
+	}
+	return 0
+}
+
+type TagDistribution struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TagId         int32                  `protobuf:"varint,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	TagName       string                 `protobuf:"bytes,2,opt,name=tag_name,json=tagName,proto3" json:"tag_name,omitempty"`
+	EventCount    int32                  `protobuf:"varint,3,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagDistribution) Reset() {
+	*x = TagDistribution{}
+	mi := &file_eventsv1_events_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagDistribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagDistribution) ProtoMessage() {}
+
+func (x *TagDistribution) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagDistribution.ProtoReflect.Descriptor instead.
+func (*TagDistribution) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *TagDistribution) GetTagId() int32 {
+	if x != nil {
+		return x.TagId
+	}
+	return 0
+}
+
+func (x *TagDistribution) GetTagName() string {
+	if x != nil {
+		return x.TagName
+	}
+	return ""
+}
+
+func (x *TagDistribution) GetEventCount() int32 {
+	if x != nil {
+		return x.EventCount
+	}
+	return 0
+}
+
+type GetEventTagsDistributionByMonthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month         int32                  `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventTagsDistributionByMonthRequest) Reset() {
+	*x = GetEventTagsDistributionByMonthRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventTagsDistributionByMonthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventTagsDistributionByMonthRequest) ProtoMessage() {}
+
+func (x *GetEventTagsDistributionByMonthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventTagsDistributionByMonthRequest.ProtoReflect.Descriptor instead.
+func (*GetEventTagsDistributionByMonthRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *GetEventTagsDistributionByMonthRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *GetEventTagsDistributionByMonthRequest) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+type GetEventTagsDistributionByMonthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*TagDistribution     `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	TotalEvents   int32                  `protobuf:"varint,2,opt,name=total_events,json=totalEvents,proto3" json:"total_events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventTagsDistributionByMonthResponse) Reset() {
+	*x = GetEventTagsDistributionByMonthResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventTagsDistributionByMonthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventTagsDistributionByMonthResponse) ProtoMessage() {}
+
+func (x *GetEventTagsDistributionByMonthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventTagsDistributionByMonthResponse.ProtoReflect.Descriptor instead.
+func (*GetEventTagsDistributionByMonthResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *GetEventTagsDistributionByMonthResponse) GetTags() []*TagDistribution {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *GetEventTagsDistributionByMonthResponse) GetTotalEvents() int32 {
+	if x != nil {
+		return x.TotalEvents
+	}
+	return 0
+}
+
+type EventActivity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Level         int32                  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventActivity) Reset() {
+	*x = EventActivity{}
+	mi := &file_eventsv1_events_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventActivity) ProtoMessage() {}
+
+func (x *EventActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventActivity.ProtoReflect.Descriptor instead.
+func (*EventActivity) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *EventActivity) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *EventActivity) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *EventActivity) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+type GetEventActivityByYearRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventActivityByYearRequest) Reset() {
+	*x = GetEventActivityByYearRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventActivityByYearRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventActivityByYearRequest) ProtoMessage() {}
+
+func (x *GetEventActivityByYearRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventActivityByYearRequest.ProtoReflect.Descriptor instead.
+func (*GetEventActivityByYearRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *GetEventActivityByYearRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+type GetEventActivityByYearResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Activities    []*EventActivity       `protobuf:"bytes,1,rep,name=activities,proto3" json:"activities,omitempty"`
+	TotalEvents   int32                  `protobuf:"varint,2,opt,name=total_events,json=totalEvents,proto3" json:"total_events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventActivityByYearResponse) Reset() {
+	*x = GetEventActivityByYearResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventActivityByYearResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventActivityByYearResponse) ProtoMessage() {}
+
+func (x *GetEventActivityByYearResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventActivityByYearResponse.ProtoReflect.Descriptor instead.
+func (*GetEventActivityByYearResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *GetEventActivityByYearResponse) GetActivities() []*EventActivity {
+	if x != nil {
+		return x.Activities
+	}
+	return nil
+}
+
+func (x *GetEventActivityByYearResponse) GetTotalEvents() int32 {
+	if x != nil {
+		return x.TotalEvents
+	}
+	return 0
+}
+
+type EventStatsSummary struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TotalEvents        int32                  `protobuf:"varint,1,opt,name=total_events,json=totalEvents,proto3" json:"total_events,omitempty"`
+	TotalRegistrations int32                  `protobuf:"varint,2,opt,name=total_registrations,json=totalRegistrations,proto3" json:"total_registrations,omitempty"`
+	TotalAttendees     int32                  `protobuf:"varint,3,opt,name=total_attendees,json=totalAttendees,proto3" json:"total_attendees,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *EventStatsSummary) Reset() {
+	*x = EventStatsSummary{}
+	mi := &file_eventsv1_events_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventStatsSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventStatsSummary) ProtoMessage() {}
+
+func (x *EventStatsSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventStatsSummary.ProtoReflect.Descriptor instead.
+func (*EventStatsSummary) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *EventStatsSummary) GetTotalEvents() int32 {
+	if x != nil {
+		return x.TotalEvents
+	}
+	return 0
+}
+
+func (x *EventStatsSummary) GetTotalRegistrations() int32 {
+	if x != nil {
+		return x.TotalRegistrations
+	}
+	return 0
+}
+
+func (x *EventStatsSummary) GetTotalAttendees() int32 {
+	if x != nil {
+		return x.TotalAttendees
+	}
+	return 0
+}
+
+type GetOverallStatisticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ForceRefresh  bool                   `protobuf:"varint,1,opt,name=force_refresh,json=forceRefresh,proto3" json:"force_refresh,omitempty"` // Recompute instead of reading the snapshot
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOverallStatisticsRequest) Reset() {
+	*x = GetOverallStatisticsRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOverallStatisticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOverallStatisticsRequest) ProtoMessage() {}
+
+func (x *GetOverallStatisticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOverallStatisticsRequest.ProtoReflect.Descriptor instead.
+func (*GetOverallStatisticsRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *GetOverallStatisticsRequest) GetForceRefresh() bool {
+	if x != nil {
+		return x.ForceRefresh
+	}
+	return false
+}
+
+type GetOverallStatisticsResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	TotalEvents            int32                  `protobuf:"varint,1,opt,name=total_events,json=totalEvents,proto3" json:"total_events,omitempty"`
+	TotalUsers             int32                  `protobuf:"varint,2,opt,name=total_users,json=totalUsers,proto3" json:"total_users,omitempty"`
+	TotalOrganizations     int32                  `protobuf:"varint,3,opt,name=total_organizations,json=totalOrganizations,proto3" json:"total_organizations,omitempty"`
+	TotalRegistrations     int32                  `protobuf:"varint,4,opt,name=total_registrations,json=totalRegistrations,proto3" json:"total_registrations,omitempty"`
+	UpcomingEvents         int32                  `protobuf:"varint,5,opt,name=upcoming_events,json=upcomingEvents,proto3" json:"upcoming_events,omitempty"`
+	AverageAttendanceRate  float64                `protobuf:"fixed64,6,opt,name=average_attendance_rate,json=averageAttendanceRate,proto3" json:"average_attendance_rate,omitempty"`
+	EventsThisMonth        int32                  `protobuf:"varint,7,opt,name=events_this_month,json=eventsThisMonth,proto3" json:"events_this_month,omitempty"`
+	RegistrationsThisMonth int32                  `protobuf:"varint,8,opt,name=registrations_this_month,json=registrationsThisMonth,proto3" json:"registrations_this_month,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetOverallStatisticsResponse) Reset() {
+	*x = GetOverallStatisticsResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOverallStatisticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOverallStatisticsResponse) ProtoMessage() {}
+
+func (x *GetOverallStatisticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOverallStatisticsResponse.ProtoReflect.Descriptor instead.
+func (*GetOverallStatisticsResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *GetOverallStatisticsResponse) GetTotalEvents() int32 {
+	if x != nil {
+		return x.TotalEvents
+	}
+	return 0
+}
+
+func (x *GetOverallStatisticsResponse) GetTotalUsers() int32 {
+	if x != nil {
+		return x.TotalUsers
+	}
+	return 0
+}
+
+func (x *GetOverallStatisticsResponse) GetTotalOrganizations() int32 {
+	if x != nil {
+		return x.TotalOrganizations
+	}
+	return 0
+}
+
+func (x *GetOverallStatisticsResponse) GetTotalRegistrations() int32 {
+	if x != nil {
+		return x.TotalRegistrations
+	}
+	return 0
+}
+
+func (x *GetOverallStatisticsResponse) GetUpcomingEvents() int32 {
+	if x != nil {
+		return x.UpcomingEvents
+	}
+	return 0
+}
+
+func (x *GetOverallStatisticsResponse) GetAverageAttendanceRate() float64 {
+	if x != nil {
+		return x.AverageAttendanceRate
+	}
+	return 0
+}
+
+func (x *GetOverallStatisticsResponse) GetEventsThisMonth() int32 {
+	if x != nil {
+		return x.EventsThisMonth
+	}
+	return 0
+}
+
+func (x *GetOverallStatisticsResponse) GetRegistrationsThisMonth() int32 {
+	if x != nil {
+		return x.RegistrationsThisMonth
+	}
+	return 0
+}
+
+type EventTrend struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Date              string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	EventCount        int32                  `protobuf:"varint,2,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`
+	RegistrationCount int32                  `protobuf:"varint,3,opt,name=registration_count,json=registrationCount,proto3" json:"registration_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *EventTrend) Reset() {
+	*x = EventTrend{}
+	mi := &file_eventsv1_events_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventTrend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventTrend) ProtoMessage() {}
+
+func (x *EventTrend) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventTrend.ProtoReflect.Descriptor instead.
+func (*EventTrend) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *EventTrend) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *EventTrend) GetEventCount() int32 {
+	if x != nil {
+		return x.EventCount
+	}
+	return 0
+}
+
+func (x *EventTrend) GetRegistrationCount() int32 {
+	if x != nil {
+		return x.RegistrationCount
+	}
+	return 0
+}
+
+type GetEventTrendsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"` // Number of days to look back (default 90)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventTrendsRequest) Reset() {
+	*x = GetEventTrendsRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventTrendsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventTrendsRequest) ProtoMessage() {}
+
+func (x *GetEventTrendsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventTrendsRequest.ProtoReflect.Descriptor instead.
+func (*GetEventTrendsRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *GetEventTrendsRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type GetEventTrendsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trends        []*EventTrend          `protobuf:"bytes,1,rep,name=trends,proto3" json:"trends,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventTrendsResponse) Reset() {
+	*x = GetEventTrendsResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventTrendsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventTrendsResponse) ProtoMessage() {}
+
+func (x *GetEventTrendsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventTrendsResponse.ProtoReflect.Descriptor instead.
+func (*GetEventTrendsResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *GetEventTrendsResponse) GetTrends() []*EventTrend {
+	if x != nil {
+		return x.Trends
+	}
+	return nil
+}
+
+type GetEventCountByFormatRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId *int32                 `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"`
+	StartDate      *string                `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"` // RFC3339, events starting at or after
+	EndDate        *string                `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`       // RFC3339, events starting at or before
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetEventCountByFormatRequest) Reset() {
+	*x = GetEventCountByFormatRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventCountByFormatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventCountByFormatRequest) ProtoMessage() {}
+
+func (x *GetEventCountByFormatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventCountByFormatRequest.ProtoReflect.Descriptor instead.
+func (*GetEventCountByFormatRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *GetEventCountByFormatRequest) GetOrganizationId() int32 {
+	if x != nil && x.OrganizationId != nil {
+		return *x.OrganizationId
+	}
+	return 0
+}
+
+func (x *GetEventCountByFormatRequest) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
+}
+
+func (x *GetEventCountByFormatRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+type GetEventCountByFormatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OnlineCount   int32                  `protobuf:"varint,1,opt,name=online_count,json=onlineCount,proto3" json:"online_count,omitempty"`
+	OfflineCount  int32                  `protobuf:"varint,2,opt,name=offline_count,json=offlineCount,proto3" json:"offline_count,omitempty"`
+	HybridCount   int32                  `protobuf:"varint,3,opt,name=hybrid_count,json=hybridCount,proto3" json:"hybrid_count,omitempty"`
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventCountByFormatResponse) Reset() {
+	*x = GetEventCountByFormatResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventCountByFormatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventCountByFormatResponse) ProtoMessage() {}
+
+func (x *GetEventCountByFormatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventCountByFormatResponse.ProtoReflect.Descriptor instead.
+func (*GetEventCountByFormatResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *GetEventCountByFormatResponse) GetOnlineCount() int32 {
+	if x != nil {
+		return x.OnlineCount
+	}
+	return 0
+}
+
+func (x *GetEventCountByFormatResponse) GetOfflineCount() int32 {
+	if x != nil {
+		return x.OfflineCount
+	}
+	return 0
+}
+
+func (x *GetEventCountByFormatResponse) GetHybridCount() int32 {
+	if x != nil {
+		return x.HybridCount
+	}
+	return 0
+}
+
+func (x *GetEventCountByFormatResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ClubLeaderboard struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId        int32                  `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	OrganizationTitle     string                 `protobuf:"bytes,2,opt,name=organization_title,json=organizationTitle,proto3" json:"organization_title,omitempty"`
+	OrganizationImage     *string                `protobuf:"bytes,3,opt,name=organization_image,json=organizationImage,proto3,oneof" json:"organization_image,omitempty"`
+	TotalEvents           int32                  `protobuf:"varint,4,opt,name=total_events,json=totalEvents,proto3" json:"total_events,omitempty"`
+	TotalRegistrations    int32                  `protobuf:"varint,5,opt,name=total_registrations,json=totalRegistrations,proto3" json:"total_registrations,omitempty"`
+	TotalAttendees        int32                  `protobuf:"varint,6,opt,name=total_attendees,json=totalAttendees,proto3" json:"total_attendees,omitempty"`
+	AverageAttendanceRate float64                `protobuf:"fixed64,7,opt,name=average_attendance_rate,json=averageAttendanceRate,proto3" json:"average_attendance_rate,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ClubLeaderboard) Reset() {
+	*x = ClubLeaderboard{}
+	mi := &file_eventsv1_events_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClubLeaderboard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClubLeaderboard) ProtoMessage() {}
+
+func (x *ClubLeaderboard) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClubLeaderboard.ProtoReflect.Descriptor instead.
+func (*ClubLeaderboard) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *ClubLeaderboard) GetOrganizationId() int32 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *ClubLeaderboard) GetOrganizationTitle() string {
+	if x != nil {
+		return x.OrganizationTitle
+	}
+	return ""
+}
+
+func (x *ClubLeaderboard) GetOrganizationImage() string {
+	if x != nil && x.OrganizationImage != nil {
+		return *x.OrganizationImage
+	}
+	return ""
+}
+
+func (x *ClubLeaderboard) GetTotalEvents() int32 {
+	if x != nil {
+		return x.TotalEvents
+	}
+	return 0
+}
+
+func (x *ClubLeaderboard) GetTotalRegistrations() int32 {
+	if x != nil {
+		return x.TotalRegistrations
+	}
+	return 0
+}
+
+func (x *ClubLeaderboard) GetTotalAttendees() int32 {
+	if x != nil {
+		return x.TotalAttendees
+	}
+	return 0
+}
+
+func (x *ClubLeaderboard) GetAverageAttendanceRate() float64 {
+	if x != nil {
+		return x.AverageAttendanceRate
+	}
+	return 0
+}
+
+type GetTopPerformingClubsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // Number of clubs to return (default 10)
+	Days          int32                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`   // Time period to consider (default 90)
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`   // Page number (default 1)
+	SortBy        ClubLeaderboardSortBy  `protobuf:"varint,4,opt,name=sort_by,json=sortBy,proto3,enum=events.v1.ClubLeaderboardSortBy" json:"sort_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopPerformingClubsRequest) Reset() {
+	*x = GetTopPerformingClubsRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopPerformingClubsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopPerformingClubsRequest) ProtoMessage() {}
+
+func (x *GetTopPerformingClubsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopPerformingClubsRequest.ProtoReflect.Descriptor instead.
+func (*GetTopPerformingClubsRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *GetTopPerformingClubsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetTopPerformingClubsRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GetTopPerformingClubsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetTopPerformingClubsRequest) GetSortBy() ClubLeaderboardSortBy {
+	if x != nil {
+		return x.SortBy
+	}
+	return ClubLeaderboardSortBy_CLUB_LEADERBOARD_SORT_BY_UNSPECIFIED
+}
+
+// Deprecated: use GetClubLeaderboard, which pages by cursor
+type GetTopPerformingClubsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in eventsv1/events.proto.
+	Clubs []*ClubLeaderboard `protobuf:"bytes,1,rep,name=clubs,proto3" json:"clubs,omitempty"`
+	// Deprecated: Marked as deprecated in eventsv1/events.proto.
+	Total         int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // Clubs with events in the period
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopPerformingClubsResponse) Reset() {
+	*x = GetTopPerformingClubsResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopPerformingClubsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopPerformingClubsResponse) ProtoMessage() {}
+
+func (x *GetTopPerformingClubsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopPerformingClubsResponse.ProtoReflect.Descriptor instead.
+func (*GetTopPerformingClubsResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{152}
+}
+
+// Deprecated: Marked as deprecated in eventsv1/events.proto.
+func (x *GetTopPerformingClubsResponse) GetClubs() []*ClubLeaderboard {
+	if x != nil {
+		return x.Clubs
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in eventsv1/events.proto.
+func (x *GetTopPerformingClubsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetClubLeaderboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`    // Time period to consider (default 90)
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`  // Clubs per page (default 10)
+	Cursor        string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"` // next_cursor of the previous page, empty for the first
+	SortBy        ClubLeaderboardSortBy  `protobuf:"varint,4,opt,name=sort_by,json=sortBy,proto3,enum=events.v1.ClubLeaderboardSortBy" json:"sort_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClubLeaderboardRequest) Reset() {
+	*x = GetClubLeaderboardRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClubLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClubLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetClubLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClubLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetClubLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *GetClubLeaderboardRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GetClubLeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetClubLeaderboardRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetClubLeaderboardRequest) GetSortBy() ClubLeaderboardSortBy {
+	if x != nil {
+		return x.SortBy
+	}
+	return ClubLeaderboardSortBy_CLUB_LEADERBOARD_SORT_BY_UNSPECIFIED
+}
+
+type ClubLeaderboardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Clubs         []*ClubLeaderboard     `protobuf:"bytes,1,rep,name=clubs,proto3" json:"clubs,omitempty"`
+	NextCursor    *string                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"` // Unset on the last page
+	TotalClubs    int32                  `protobuf:"varint,3,opt,name=total_clubs,json=totalClubs,proto3" json:"total_clubs,omitempty"`      // Clubs with events in the period
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClubLeaderboardResponse) Reset() {
+	*x = ClubLeaderboardResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClubLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClubLeaderboardResponse) ProtoMessage() {}
+
+func (x *ClubLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClubLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*ClubLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *ClubLeaderboardResponse) GetClubs() []*ClubLeaderboard {
+	if x != nil {
+		return x.Clubs
+	}
+	return nil
+}
+
+func (x *ClubLeaderboardResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+func (x *ClubLeaderboardResponse) GetTotalClubs() int32 {
+	if x != nil {
+		return x.TotalClubs
+	}
+	return 0
+}
+
+type GetUserEngagementLevelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserEngagementLevelsRequest) Reset() {
+	*x = GetUserEngagementLevelsRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserEngagementLevelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserEngagementLevelsRequest) ProtoMessage() {}
+
+func (x *GetUserEngagementLevelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserEngagementLevelsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserEngagementLevelsRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{155}
+}
+
+type UserEngagementLevel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Level         string                 `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"` // "active_users", "registered_for_events", "attended_events", "repeat_attendees"
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Percentage    float64                `protobuf:"fixed64,3,opt,name=percentage,proto3" json:"percentage,omitempty"` // Percentage relative to active users
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserEngagementLevel) Reset() {
+	*x = UserEngagementLevel{}
+	mi := &file_eventsv1_events_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserEngagementLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserEngagementLevel) ProtoMessage() {}
+
+func (x *UserEngagementLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserEngagementLevel.ProtoReflect.Descriptor instead.
+func (*UserEngagementLevel) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *UserEngagementLevel) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *UserEngagementLevel) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *UserEngagementLevel) GetPercentage() float64 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+type GetUserEngagementLevelsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Levels          []*UserEngagementLevel `protobuf:"bytes,1,rep,name=levels,proto3" json:"levels,omitempty"`
+	TotalUsers      int32                  `protobuf:"varint,2,opt,name=total_users,json=totalUsers,proto3" json:"total_users,omitempty"`
+	TrendMessage    string                 `protobuf:"bytes,3,opt,name=trend_message,json=trendMessage,proto3" json:"trend_message,omitempty"`             // e.g., "Trending up by 5.2% this month"
+	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                                   // e.g., "Showing engagement metrics for active users"
+	IsPositiveTrend bool                   `protobuf:"varint,5,opt,name=is_positive_trend,json=isPositiveTrend,proto3" json:"is_positive_trend,omitempty"` // Whether the trend is positive or negative
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetUserEngagementLevelsResponse) Reset() {
+	*x = GetUserEngagementLevelsResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserEngagementLevelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserEngagementLevelsResponse) ProtoMessage() {}
+
+func (x *GetUserEngagementLevelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserEngagementLevelsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserEngagementLevelsResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *GetUserEngagementLevelsResponse) GetLevels() []*UserEngagementLevel {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+func (x *GetUserEngagementLevelsResponse) GetTotalUsers() int32 {
+	if x != nil {
+		return x.TotalUsers
+	}
+	return 0
+}
+
+func (x *GetUserEngagementLevelsResponse) GetTrendMessage() string {
+	if x != nil {
+		return x.TrendMessage
+	}
+	return ""
+}
+
+func (x *GetUserEngagementLevelsResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GetUserEngagementLevelsResponse) GetIsPositiveTrend() bool {
+	if x != nil {
+		return x.IsPositiveTrend
+	}
+	return false
+}
+
+// Top Performing Events
+type TopPerformingEvent struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title              string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	ImageUrl           *string                `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
+	Organization       *Organization          `protobuf:"bytes,4,opt,name=organization,proto3,oneof" json:"organization,omitempty"`
+	StartTime          string                 `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	TotalRegistrations int32                  `protobuf:"varint,6,opt,name=total_registrations,json=totalRegistrations,proto3" json:"total_registrations,omitempty"`
+	TotalAttendees     int32                  `protobuf:"varint,7,opt,name=total_attendees,json=totalAttendees,proto3" json:"total_attendees,omitempty"`
+	AttendanceRate     float64                `protobuf:"fixed64,8,opt,name=attendance_rate,json=attendanceRate,proto3" json:"attendance_rate,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TopPerformingEvent) Reset() {
+	*x = TopPerformingEvent{}
+	mi := &file_eventsv1_events_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopPerformingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopPerformingEvent) ProtoMessage() {}
+
+func (x *TopPerformingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopPerformingEvent.ProtoReflect.Descriptor instead.
+func (*TopPerformingEvent) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *TopPerformingEvent) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TopPerformingEvent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TopPerformingEvent) GetImageUrl() string {
+	if x != nil && x.ImageUrl != nil {
+		return *x.ImageUrl
+	}
+	return ""
+}
+
+func (x *TopPerformingEvent) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *TopPerformingEvent) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *TopPerformingEvent) GetTotalRegistrations() int32 {
+	if x != nil {
+		return x.TotalRegistrations
+	}
+	return 0
+}
+
+func (x *TopPerformingEvent) GetTotalAttendees() int32 {
+	if x != nil {
+		return x.TotalAttendees
+	}
+	return 0
+}
+
+func (x *TopPerformingEvent) GetAttendanceRate() float64 {
+	if x != nil {
+		return x.AttendanceRate
+	}
+	return 0
+}
+
+type GetTopPerformingEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // Number of events to return (default 10)
+	Days          int32                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`   // Time period to consider (default 90)
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`   // Page number (default 1)
+	SortBy        TopEventsSortBy        `protobuf:"varint,4,opt,name=sort_by,json=sortBy,proto3,enum=events.v1.TopEventsSortBy" json:"sort_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopPerformingEventsRequest) Reset() {
+	*x = GetTopPerformingEventsRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopPerformingEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopPerformingEventsRequest) ProtoMessage() {}
+
+func (x *GetTopPerformingEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopPerformingEventsRequest.ProtoReflect.Descriptor instead.
+func (*GetTopPerformingEventsRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *GetTopPerformingEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetTopPerformingEventsRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GetTopPerformingEventsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetTopPerformingEventsRequest) GetSortBy() TopEventsSortBy {
+	if x != nil {
+		return x.SortBy
+	}
+	return TopEventsSortBy_TOP_EVENTS_SORT_BY_UNSPECIFIED
+}
+
+type GetTopPerformingEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*TopPerformingEvent  `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // Events in the period
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopPerformingEventsResponse) Reset() {
+	*x = GetTopPerformingEventsResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopPerformingEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopPerformingEventsResponse) ProtoMessage() {}
+
+func (x *GetTopPerformingEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopPerformingEventsResponse.ProtoReflect.Descriptor instead.
+func (*GetTopPerformingEventsResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *GetTopPerformingEventsResponse) GetEvents() []*TopPerformingEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GetTopPerformingEventsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Low Registration Events
+type LowRegistrationEvent struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title               string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	ImageUrl            *string                `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
+	Organization        *Organization          `protobuf:"bytes,4,opt,name=organization,proto3,oneof" json:"organization,omitempty"`
+	StartTime           string                 `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	Capacity            int32                  `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	TotalRegistrations  int32                  `protobuf:"varint,7,opt,name=total_registrations,json=totalRegistrations,proto3" json:"total_registrations,omitempty"`
+	CapacityUtilization float64                `protobuf:"fixed64,8,opt,name=capacity_utilization,json=capacityUtilization,proto3" json:"capacity_utilization,omitempty"`
+	DaysUntilEvent      int32                  `protobuf:"varint,9,opt,name=days_until_event,json=daysUntilEvent,proto3" json:"days_until_event,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *LowRegistrationEvent) Reset() {
+	*x = LowRegistrationEvent{}
+	mi := &file_eventsv1_events_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LowRegistrationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LowRegistrationEvent) ProtoMessage() {}
+
+func (x *LowRegistrationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LowRegistrationEvent.ProtoReflect.Descriptor instead.
+func (*LowRegistrationEvent) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *LowRegistrationEvent) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LowRegistrationEvent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LowRegistrationEvent) GetImageUrl() string {
+	if x != nil && x.ImageUrl != nil {
+		return *x.ImageUrl
+	}
+	return ""
+}
+
+func (x *LowRegistrationEvent) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *LowRegistrationEvent) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *LowRegistrationEvent) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
 }
 
-// Deprecated: Use GetEventActivityByYearResponse.ProtoReflect.Descriptor instead.
-func (*GetEventActivityByYearResponse) Descriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{81}
+func (x *LowRegistrationEvent) GetTotalRegistrations() int32 {
+	if x != nil {
+		return x.TotalRegistrations
+	}
+	return 0
 }
 
-func (x *GetEventActivityByYearResponse) GetActivities() []*EventActivity {
+func (x *LowRegistrationEvent) GetCapacityUtilization() float64 {
 	if x != nil {
-		return x.Activities
+		return x.CapacityUtilization
 	}
-	return nil
+	return 0
 }
 
-func (x *GetEventActivityByYearResponse) GetTotalEvents() int32 {
+func (x *LowRegistrationEvent) GetDaysUntilEvent() int32 {
 	if x != nil {
-		return x.TotalEvents
+		return x.DaysUntilEvent
 	}
 	return 0
 }
 
-type EventStatsSummary struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	TotalEvents        int32                  `protobuf:"varint,1,opt,name=total_events,json=totalEvents,proto3" json:"total_events,omitempty"`
-	TotalRegistrations int32                  `protobuf:"varint,2,opt,name=total_registrations,json=totalRegistrations,proto3" json:"total_registrations,omitempty"`
-	TotalAttendees     int32                  `protobuf:"varint,3,opt,name=total_attendees,json=totalAttendees,proto3" json:"total_attendees,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type GetLowRegistrationEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Threshold     int32                  `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`                  // Capacity utilization threshold percentage (default 50)
+	DaysAhead     int32                  `protobuf:"varint,2,opt,name=days_ahead,json=daysAhead,proto3" json:"days_ahead,omitempty"` // Only include events within this many days (default 30)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventStatsSummary) Reset() {
-	*x = EventStatsSummary{}
-	mi := &file_eventsv1_events_proto_msgTypes[82]
+func (x *GetLowRegistrationEventsRequest) Reset() {
+	*x = GetLowRegistrationEventsRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventStatsSummary) String() string {
+func (x *GetLowRegistrationEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventStatsSummary) ProtoMessage() {}
+func (*GetLowRegistrationEventsRequest) ProtoMessage() {}
 
-func (x *EventStatsSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[82]
+func (x *GetLowRegistrationEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4998,53 +10299,47 @@ func (x *EventStatsSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventStatsSummary.ProtoReflect.Descriptor instead.
-func (*EventStatsSummary) Descriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{82}
-}
-
-func (x *EventStatsSummary) GetTotalEvents() int32 {
-	if x != nil {
-		return x.TotalEvents
-	}
-	return 0
+// Deprecated: Use GetLowRegistrationEventsRequest.ProtoReflect.Descriptor instead.
+func (*GetLowRegistrationEventsRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *EventStatsSummary) GetTotalRegistrations() int32 {
+func (x *GetLowRegistrationEventsRequest) GetThreshold() int32 {
 	if x != nil {
-		return x.TotalRegistrations
+		return x.Threshold
 	}
 	return 0
 }
 
-func (x *EventStatsSummary) GetTotalAttendees() int32 {
+func (x *GetLowRegistrationEventsRequest) GetDaysAhead() int32 {
 	if x != nil {
-		return x.TotalAttendees
+		return x.DaysAhead
 	}
 	return 0
 }
 
-type GetOverallStatisticsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type GetLowRegistrationEventsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Events        []*LowRegistrationEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOverallStatisticsRequest) Reset() {
-	*x = GetOverallStatisticsRequest{}
-	mi := &file_eventsv1_events_proto_msgTypes[83]
+func (x *GetLowRegistrationEventsResponse) Reset() {
+	*x = GetLowRegistrationEventsResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOverallStatisticsRequest) String() string {
+func (x *GetLowRegistrationEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOverallStatisticsRequest) ProtoMessage() {}
+func (*GetLowRegistrationEventsResponse) ProtoMessage() {}
 
-func (x *GetOverallStatisticsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[83]
+func (x *GetLowRegistrationEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5055,40 +10350,48 @@ func (x *GetOverallStatisticsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOverallStatisticsRequest.ProtoReflect.Descriptor instead.
-func (*GetOverallStatisticsRequest) Descriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{83}
+// Deprecated: Use GetLowRegistrationEventsResponse.ProtoReflect.Descriptor instead.
+func (*GetLowRegistrationEventsResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{163}
 }
 
-type GetOverallStatisticsResponse struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	TotalEvents            int32                  `protobuf:"varint,1,opt,name=total_events,json=totalEvents,proto3" json:"total_events,omitempty"`
-	TotalUsers             int32                  `protobuf:"varint,2,opt,name=total_users,json=totalUsers,proto3" json:"total_users,omitempty"`
-	TotalOrganizations     int32                  `protobuf:"varint,3,opt,name=total_organizations,json=totalOrganizations,proto3" json:"total_organizations,omitempty"`
-	TotalRegistrations     int32                  `protobuf:"varint,4,opt,name=total_registrations,json=totalRegistrations,proto3" json:"total_registrations,omitempty"`
-	UpcomingEvents         int32                  `protobuf:"varint,5,opt,name=upcoming_events,json=upcomingEvents,proto3" json:"upcoming_events,omitempty"`
-	AverageAttendanceRate  float64                `protobuf:"fixed64,6,opt,name=average_attendance_rate,json=averageAttendanceRate,proto3" json:"average_attendance_rate,omitempty"`
-	EventsThisMonth        int32                  `protobuf:"varint,7,opt,name=events_this_month,json=eventsThisMonth,proto3" json:"events_this_month,omitempty"`
-	RegistrationsThisMonth int32                  `protobuf:"varint,8,opt,name=registrations_this_month,json=registrationsThisMonth,proto3" json:"registrations_this_month,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+func (x *GetLowRegistrationEventsResponse) GetEvents() []*LowRegistrationEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
 }
 
-func (x *GetOverallStatisticsResponse) Reset() {
-	*x = GetOverallStatisticsResponse{}
-	mi := &file_eventsv1_events_proto_msgTypes[84]
+// Organization Activity
+type OrganizationActivity struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title             string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	ImageUrl          *string                `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
+	EventsThisMonth   int32                  `protobuf:"varint,4,opt,name=events_this_month,json=eventsThisMonth,proto3" json:"events_this_month,omitempty"`
+	EventsLastMonth   int32                  `protobuf:"varint,5,opt,name=events_last_month,json=eventsLastMonth,proto3" json:"events_last_month,omitempty"`
+	TotalEvents       int32                  `protobuf:"varint,6,opt,name=total_events,json=totalEvents,proto3" json:"total_events,omitempty"`
+	AverageAttendance float64                `protobuf:"fixed64,7,opt,name=average_attendance,json=averageAttendance,proto3" json:"average_attendance,omitempty"`
+	GrowthRate        float64                `protobuf:"fixed64,8,opt,name=growth_rate,json=growthRate,proto3" json:"growth_rate,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *OrganizationActivity) Reset() {
+	*x = OrganizationActivity{}
+	mi := &file_eventsv1_events_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOverallStatisticsResponse) String() string {
+func (x *OrganizationActivity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOverallStatisticsResponse) ProtoMessage() {}
+func (*OrganizationActivity) ProtoMessage() {}
 
-func (x *GetOverallStatisticsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[84]
+func (x *OrganizationActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5099,91 +10402,89 @@ func (x *GetOverallStatisticsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOverallStatisticsResponse.ProtoReflect.Descriptor instead.
-func (*GetOverallStatisticsResponse) Descriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{84}
+// Deprecated: Use OrganizationActivity.ProtoReflect.Descriptor instead.
+func (*OrganizationActivity) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{164}
 }
 
-func (x *GetOverallStatisticsResponse) GetTotalEvents() int32 {
+func (x *OrganizationActivity) GetId() int32 {
 	if x != nil {
-		return x.TotalEvents
+		return x.Id
 	}
 	return 0
 }
 
-func (x *GetOverallStatisticsResponse) GetTotalUsers() int32 {
+func (x *OrganizationActivity) GetTitle() string {
 	if x != nil {
-		return x.TotalUsers
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOverallStatisticsResponse) GetTotalOrganizations() int32 {
-	if x != nil {
-		return x.TotalOrganizations
+func (x *OrganizationActivity) GetImageUrl() string {
+	if x != nil && x.ImageUrl != nil {
+		return *x.ImageUrl
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOverallStatisticsResponse) GetTotalRegistrations() int32 {
+func (x *OrganizationActivity) GetEventsThisMonth() int32 {
 	if x != nil {
-		return x.TotalRegistrations
+		return x.EventsThisMonth
 	}
 	return 0
 }
 
-func (x *GetOverallStatisticsResponse) GetUpcomingEvents() int32 {
+func (x *OrganizationActivity) GetEventsLastMonth() int32 {
 	if x != nil {
-		return x.UpcomingEvents
+		return x.EventsLastMonth
 	}
 	return 0
 }
 
-func (x *GetOverallStatisticsResponse) GetAverageAttendanceRate() float64 {
+func (x *OrganizationActivity) GetTotalEvents() int32 {
 	if x != nil {
-		return x.AverageAttendanceRate
+		return x.TotalEvents
 	}
 	return 0
 }
 
-func (x *GetOverallStatisticsResponse) GetEventsThisMonth() int32 {
+func (x *OrganizationActivity) GetAverageAttendance() float64 {
 	if x != nil {
-		return x.EventsThisMonth
+		return x.AverageAttendance
 	}
 	return 0
 }
 
-func (x *GetOverallStatisticsResponse) GetRegistrationsThisMonth() int32 {
+func (x *OrganizationActivity) GetGrowthRate() float64 {
 	if x != nil {
-		return x.RegistrationsThisMonth
+		return x.GrowthRate
 	}
 	return 0
 }
 
-type EventTrend struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Date              string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	EventCount        int32                  `protobuf:"varint,2,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`
-	RegistrationCount int32                  `protobuf:"varint,3,opt,name=registration_count,json=registrationCount,proto3" json:"registration_count,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type GetOrganizationActivityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // Number of organizations to return (default 20)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventTrend) Reset() {
-	*x = EventTrend{}
-	mi := &file_eventsv1_events_proto_msgTypes[85]
+func (x *GetOrganizationActivityRequest) Reset() {
+	*x = GetOrganizationActivityRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventTrend) String() string {
+func (x *GetOrganizationActivityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventTrend) ProtoMessage() {}
+func (*GetOrganizationActivityRequest) ProtoMessage() {}
 
-func (x *EventTrend) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[85]
+func (x *GetOrganizationActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5194,54 +10495,40 @@ func (x *EventTrend) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventTrend.ProtoReflect.Descriptor instead.
-func (*EventTrend) Descriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{85}
-}
-
-func (x *EventTrend) GetDate() string {
-	if x != nil {
-		return x.Date
-	}
-	return ""
-}
-
-func (x *EventTrend) GetEventCount() int32 {
-	if x != nil {
-		return x.EventCount
-	}
-	return 0
+// Deprecated: Use GetOrganizationActivityRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationActivityRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{165}
 }
 
-func (x *EventTrend) GetRegistrationCount() int32 {
+func (x *GetOrganizationActivityRequest) GetLimit() int32 {
 	if x != nil {
-		return x.RegistrationCount
+		return x.Limit
 	}
 	return 0
 }
 
-type GetEventTrendsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"` // Number of days to look back (default 90)
+type GetOrganizationActivityResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Organizations []*OrganizationActivity `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEventTrendsRequest) Reset() {
-	*x = GetEventTrendsRequest{}
-	mi := &file_eventsv1_events_proto_msgTypes[86]
+func (x *GetOrganizationActivityResponse) Reset() {
+	*x = GetOrganizationActivityResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventTrendsRequest) String() string {
+func (x *GetOrganizationActivityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventTrendsRequest) ProtoMessage() {}
+func (*GetOrganizationActivityResponse) ProtoMessage() {}
 
-func (x *GetEventTrendsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[86]
+func (x *GetOrganizationActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5252,40 +10539,41 @@ func (x *GetEventTrendsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventTrendsRequest.ProtoReflect.Descriptor instead.
-func (*GetEventTrendsRequest) Descriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use GetOrganizationActivityResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationActivityResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{166}
 }
 
-func (x *GetEventTrendsRequest) GetDays() int32 {
+func (x *GetOrganizationActivityResponse) GetOrganizations() []*OrganizationActivity {
 	if x != nil {
-		return x.Days
+		return x.Organizations
 	}
-	return 0
+	return nil
 }
 
-type GetEventTrendsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Trends        []*EventTrend          `protobuf:"bytes,1,rep,name=trends,proto3" json:"trends,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetStatisticsForOrganizationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId int32                  `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Days           int32                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"` // Number of days to look back (default 90)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetEventTrendsResponse) Reset() {
-	*x = GetEventTrendsResponse{}
-	mi := &file_eventsv1_events_proto_msgTypes[87]
+func (x *GetStatisticsForOrganizationRequest) Reset() {
+	*x = GetStatisticsForOrganizationRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventTrendsResponse) String() string {
+func (x *GetStatisticsForOrganizationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventTrendsResponse) ProtoMessage() {}
+func (*GetStatisticsForOrganizationRequest) ProtoMessage() {}
 
-func (x *GetEventTrendsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[87]
+func (x *GetStatisticsForOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5296,46 +10584,53 @@ func (x *GetEventTrendsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventTrendsResponse.ProtoReflect.Descriptor instead.
-func (*GetEventTrendsResponse) Descriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{87}
+// Deprecated: Use GetStatisticsForOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*GetStatisticsForOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{167}
 }
 
-func (x *GetEventTrendsResponse) GetTrends() []*EventTrend {
+func (x *GetStatisticsForOrganizationRequest) GetOrganizationId() int32 {
 	if x != nil {
-		return x.Trends
+		return x.OrganizationId
 	}
-	return nil
+	return 0
 }
 
-type ClubLeaderboard struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	OrganizationId        int32                  `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	OrganizationTitle     string                 `protobuf:"bytes,2,opt,name=organization_title,json=organizationTitle,proto3" json:"organization_title,omitempty"`
-	OrganizationImage     *string                `protobuf:"bytes,3,opt,name=organization_image,json=organizationImage,proto3,oneof" json:"organization_image,omitempty"`
-	TotalEvents           int32                  `protobuf:"varint,4,opt,name=total_events,json=totalEvents,proto3" json:"total_events,omitempty"`
-	TotalRegistrations    int32                  `protobuf:"varint,5,opt,name=total_registrations,json=totalRegistrations,proto3" json:"total_registrations,omitempty"`
-	TotalAttendees        int32                  `protobuf:"varint,6,opt,name=total_attendees,json=totalAttendees,proto3" json:"total_attendees,omitempty"`
-	AverageAttendanceRate float64                `protobuf:"fixed64,7,opt,name=average_attendance_rate,json=averageAttendanceRate,proto3" json:"average_attendance_rate,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+func (x *GetStatisticsForOrganizationRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
 }
 
-func (x *ClubLeaderboard) Reset() {
-	*x = ClubLeaderboard{}
-	mi := &file_eventsv1_events_proto_msgTypes[88]
+type OrganizationStatisticsResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId     int32                  `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	TotalEvents        int32                  `protobuf:"varint,2,opt,name=total_events,json=totalEvents,proto3" json:"total_events,omitempty"` // Events starting within the window
+	TotalRegistrations int32                  `protobuf:"varint,3,opt,name=total_registrations,json=totalRegistrations,proto3" json:"total_registrations,omitempty"`
+	TotalAttendees     int32                  `protobuf:"varint,4,opt,name=total_attendees,json=totalAttendees,proto3" json:"total_attendees,omitempty"`
+	AttendanceRate     float64                `protobuf:"fixed64,5,opt,name=attendance_rate,json=attendanceRate,proto3" json:"attendance_rate,omitempty"` // Attendees per registration, in percent
+	TopTags            []*TagDistribution     `protobuf:"bytes,6,rep,name=top_tags,json=topTags,proto3" json:"top_tags,omitempty"`                        // Most used tags, at most 5
+	Trends             []*EventTrend          `protobuf:"bytes,7,rep,name=trends,proto3" json:"trends,omitempty"`                                         // Per day, days without events are omitted
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *OrganizationStatisticsResponse) Reset() {
+	*x = OrganizationStatisticsResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClubLeaderboard) String() string {
+func (x *OrganizationStatisticsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClubLeaderboard) ProtoMessage() {}
+func (*OrganizationStatisticsResponse) ProtoMessage() {}
 
-func (x *ClubLeaderboard) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[88]
+func (x *OrganizationStatisticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5346,83 +10641,83 @@ func (x *ClubLeaderboard) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClubLeaderboard.ProtoReflect.Descriptor instead.
-func (*ClubLeaderboard) Descriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use OrganizationStatisticsResponse.ProtoReflect.Descriptor instead.
+func (*OrganizationStatisticsResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{168}
 }
 
-func (x *ClubLeaderboard) GetOrganizationId() int32 {
+func (x *OrganizationStatisticsResponse) GetOrganizationId() int32 {
 	if x != nil {
 		return x.OrganizationId
 	}
 	return 0
 }
 
-func (x *ClubLeaderboard) GetOrganizationTitle() string {
+func (x *OrganizationStatisticsResponse) GetTotalEvents() int32 {
 	if x != nil {
-		return x.OrganizationTitle
+		return x.TotalEvents
 	}
-	return ""
+	return 0
 }
 
-func (x *ClubLeaderboard) GetOrganizationImage() string {
-	if x != nil && x.OrganizationImage != nil {
-		return *x.OrganizationImage
+func (x *OrganizationStatisticsResponse) GetTotalRegistrations() int32 {
+	if x != nil {
+		return x.TotalRegistrations
 	}
-	return ""
+	return 0
 }
 
-func (x *ClubLeaderboard) GetTotalEvents() int32 {
+func (x *OrganizationStatisticsResponse) GetTotalAttendees() int32 {
 	if x != nil {
-		return x.TotalEvents
+		return x.TotalAttendees
 	}
 	return 0
 }
 
-func (x *ClubLeaderboard) GetTotalRegistrations() int32 {
+func (x *OrganizationStatisticsResponse) GetAttendanceRate() float64 {
 	if x != nil {
-		return x.TotalRegistrations
+		return x.AttendanceRate
 	}
 	return 0
 }
 
-func (x *ClubLeaderboard) GetTotalAttendees() int32 {
+func (x *OrganizationStatisticsResponse) GetTopTags() []*TagDistribution {
 	if x != nil {
-		return x.TotalAttendees
+		return x.TopTags
 	}
-	return 0
+	return nil
 }
 
-func (x *ClubLeaderboard) GetAverageAttendanceRate() float64 {
+func (x *OrganizationStatisticsResponse) GetTrends() []*EventTrend {
 	if x != nil {
-		return x.AverageAttendanceRate
+		return x.Trends
 	}
-	return 0
+	return nil
 }
 
-type GetTopPerformingClubsRequest struct {
+type GetEventImageUploadUrlRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // Number of clubs to return (default 10)
-	Days          int32                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`   // Time period to consider (default 90)
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTopPerformingClubsRequest) Reset() {
-	*x = GetTopPerformingClubsRequest{}
-	mi := &file_eventsv1_events_proto_msgTypes[89]
+func (x *GetEventImageUploadUrlRequest) Reset() {
+	*x = GetEventImageUploadUrlRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTopPerformingClubsRequest) String() string {
+func (x *GetEventImageUploadUrlRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTopPerformingClubsRequest) ProtoMessage() {}
+func (*GetEventImageUploadUrlRequest) ProtoMessage() {}
 
-func (x *GetTopPerformingClubsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[89]
+func (x *GetEventImageUploadUrlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5433,47 +10728,49 @@ func (x *GetTopPerformingClubsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTopPerformingClubsRequest.ProtoReflect.Descriptor instead.
-func (*GetTopPerformingClubsRequest) Descriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{89}
+// Deprecated: Use GetEventImageUploadUrlRequest.ProtoReflect.Descriptor instead.
+func (*GetEventImageUploadUrlRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{169}
 }
 
-func (x *GetTopPerformingClubsRequest) GetLimit() int32 {
+func (x *GetEventImageUploadUrlRequest) GetFilename() string {
 	if x != nil {
-		return x.Limit
+		return x.Filename
 	}
-	return 0
+	return ""
 }
 
-func (x *GetTopPerformingClubsRequest) GetDays() int32 {
+func (x *GetEventImageUploadUrlRequest) GetContentType() string {
 	if x != nil {
-		return x.Days
+		return x.ContentType
 	}
-	return 0
+	return ""
 }
 
-type GetTopPerformingClubsResponse struct {
+type GetEventImageUploadUrlResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Clubs         []*ClubLeaderboard     `protobuf:"bytes,1,rep,name=clubs,proto3" json:"clubs,omitempty"`
+	UploadUrl     string                 `protobuf:"bytes,1,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`
+	PublicUrl     string                 `protobuf:"bytes,2,opt,name=public_url,json=publicUrl,proto3" json:"public_url,omitempty"`
+	ObjectKey     string                 `protobuf:"bytes,3,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTopPerformingClubsResponse) Reset() {
-	*x = GetTopPerformingClubsResponse{}
-	mi := &file_eventsv1_events_proto_msgTypes[90]
+func (x *GetEventImageUploadUrlResponse) Reset() {
+	*x = GetEventImageUploadUrlResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTopPerformingClubsResponse) String() string {
+func (x *GetEventImageUploadUrlResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTopPerformingClubsResponse) ProtoMessage() {}
+func (*GetEventImageUploadUrlResponse) ProtoMessage() {}
 
-func (x *GetTopPerformingClubsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[90]
+func (x *GetEventImageUploadUrlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5484,39 +10781,60 @@ func (x *GetTopPerformingClubsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTopPerformingClubsResponse.ProtoReflect.Descriptor instead.
-func (*GetTopPerformingClubsResponse) Descriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{90}
+// Deprecated: Use GetEventImageUploadUrlResponse.ProtoReflect.Descriptor instead.
+func (*GetEventImageUploadUrlResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{170}
 }
 
-func (x *GetTopPerformingClubsResponse) GetClubs() []*ClubLeaderboard {
+func (x *GetEventImageUploadUrlResponse) GetUploadUrl() string {
 	if x != nil {
-		return x.Clubs
+		return x.UploadUrl
 	}
-	return nil
+	return ""
 }
 
-type GetUserEngagementLevelsRequest struct {
+func (x *GetEventImageUploadUrlResponse) GetPublicUrl() string {
+	if x != nil {
+		return x.PublicUrl
+	}
+	return ""
+}
+
+func (x *GetEventImageUploadUrlResponse) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+// Webhook messages
+type Webhook struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"` // Empty = all event types
+	IsActive      bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserEngagementLevelsRequest) Reset() {
-	*x = GetUserEngagementLevelsRequest{}
-	mi := &file_eventsv1_events_proto_msgTypes[91]
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_eventsv1_events_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserEngagementLevelsRequest) String() string {
+func (x *Webhook) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserEngagementLevelsRequest) ProtoMessage() {}
+func (*Webhook) ProtoMessage() {}
 
-func (x *GetUserEngagementLevelsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[91]
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5527,97 +10845,86 @@ func (x *GetUserEngagementLevelsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserEngagementLevelsRequest.ProtoReflect.Descriptor instead.
-func (*GetUserEngagementLevelsRequest) Descriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{91}
-}
-
-type UserEngagementLevel struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Level         string                 `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"` // "active_users", "registered_for_events", "attended_events", "repeat_attendees"
-	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
-	Percentage    float64                `protobuf:"fixed64,3,opt,name=percentage,proto3" json:"percentage,omitempty"` // Percentage relative to active users
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UserEngagementLevel) Reset() {
-	*x = UserEngagementLevel{}
-	mi := &file_eventsv1_events_proto_msgTypes[92]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{171}
 }
 
-func (x *UserEngagementLevel) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Webhook) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
 }
 
-func (*UserEngagementLevel) ProtoMessage() {}
-
-func (x *UserEngagementLevel) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[92]
+func (x *Webhook) GetUrl() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Url
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UserEngagementLevel.ProtoReflect.Descriptor instead.
-func (*UserEngagementLevel) Descriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{92}
+func (x *Webhook) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
 }
 
-func (x *UserEngagementLevel) GetLevel() string {
+func (x *Webhook) GetIsActive() bool {
 	if x != nil {
-		return x.Level
+		return x.IsActive
 	}
-	return ""
+	return false
 }
 
-func (x *UserEngagementLevel) GetCount() int32 {
+func (x *Webhook) GetCreatedAt() string {
 	if x != nil {
-		return x.Count
+		return x.CreatedAt
 	}
-	return 0
+	return ""
 }
 
-func (x *UserEngagementLevel) GetPercentage() float64 {
+func (x *Webhook) GetUpdatedAt() string {
 	if x != nil {
-		return x.Percentage
+		return x.UpdatedAt
 	}
-	return 0
+	return ""
 }
 
-type GetUserEngagementLevelsResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Levels          []*UserEngagementLevel `protobuf:"bytes,1,rep,name=levels,proto3" json:"levels,omitempty"`
-	TotalUsers      int32                  `protobuf:"varint,2,opt,name=total_users,json=totalUsers,proto3" json:"total_users,omitempty"`
-	TrendMessage    string                 `protobuf:"bytes,3,opt,name=trend_message,json=trendMessage,proto3" json:"trend_message,omitempty"`             // e.g., "Trending up by 5.2% this month"
-	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                                   // e.g., "Showing engagement metrics for active users"
-	IsPositiveTrend bool                   `protobuf:"varint,5,opt,name=is_positive_trend,json=isPositiveTrend,proto3" json:"is_positive_trend,omitempty"` // Whether the trend is positive or negative
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type WebhookDelivery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WebhookId     int32                  `protobuf:"varint,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	EventType     string                 `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	PayloadHash   string                 `protobuf:"bytes,4,opt,name=payload_hash,json=payloadHash,proto3" json:"payload_hash,omitempty"`
+	Attempt       int32                  `protobuf:"varint,5,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	Status        WebhookDeliveryStatus  `protobuf:"varint,6,opt,name=status,proto3,enum=events.v1.WebhookDeliveryStatus" json:"status,omitempty"`
+	HttpStatus    *int32                 `protobuf:"varint,7,opt,name=http_status,json=httpStatus,proto3,oneof" json:"http_status,omitempty"`
+	ResponseBody  *string                `protobuf:"bytes,8,opt,name=response_body,json=responseBody,proto3,oneof" json:"response_body,omitempty"` // Truncated to 1KB
+	AttemptedAt   *string                `protobuf:"bytes,9,opt,name=attempted_at,json=attemptedAt,proto3,oneof" json:"attempted_at,omitempty"`
+	NextRetryAt   *string                `protobuf:"bytes,10,opt,name=next_retry_at,json=nextRetryAt,proto3,oneof" json:"next_retry_at,omitempty"`
+	Succeeded     bool                   `protobuf:"varint,11,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserEngagementLevelsResponse) Reset() {
-	*x = GetUserEngagementLevelsResponse{}
-	mi := &file_eventsv1_events_proto_msgTypes[93]
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_eventsv1_events_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserEngagementLevelsResponse) String() string {
+func (x *WebhookDelivery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserEngagementLevelsResponse) ProtoMessage() {}
+func (*WebhookDelivery) ProtoMessage() {}
 
-func (x *GetUserEngagementLevelsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[93]
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5628,76 +10935,118 @@ func (x *GetUserEngagementLevelsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserEngagementLevelsResponse.ProtoReflect.Descriptor instead.
-func (*GetUserEngagementLevelsResponse) Descriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *WebhookDelivery) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetWebhookId() int32 {
+	if x != nil {
+		return x.WebhookId
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
 }
 
-func (x *GetUserEngagementLevelsResponse) GetLevels() []*UserEngagementLevel {
+func (x *WebhookDelivery) GetPayloadHash() string {
 	if x != nil {
-		return x.Levels
+		return x.PayloadHash
 	}
-	return nil
+	return ""
 }
 
-func (x *GetUserEngagementLevelsResponse) GetTotalUsers() int32 {
+func (x *WebhookDelivery) GetAttempt() int32 {
 	if x != nil {
-		return x.TotalUsers
+		return x.Attempt
 	}
 	return 0
 }
 
-func (x *GetUserEngagementLevelsResponse) GetTrendMessage() string {
+func (x *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
 	if x != nil {
-		return x.TrendMessage
+		return x.Status
+	}
+	return WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetHttpStatus() int32 {
+	if x != nil && x.HttpStatus != nil {
+		return *x.HttpStatus
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetResponseBody() string {
+	if x != nil && x.ResponseBody != nil {
+		return *x.ResponseBody
 	}
 	return ""
 }
 
-func (x *GetUserEngagementLevelsResponse) GetDescription() string {
-	if x != nil {
-		return x.Description
+func (x *WebhookDelivery) GetAttemptedAt() string {
+	if x != nil && x.AttemptedAt != nil {
+		return *x.AttemptedAt
 	}
 	return ""
 }
 
-func (x *GetUserEngagementLevelsResponse) GetIsPositiveTrend() bool {
+func (x *WebhookDelivery) GetNextRetryAt() string {
+	if x != nil && x.NextRetryAt != nil {
+		return *x.NextRetryAt
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetSucceeded() bool {
 	if x != nil {
-		return x.IsPositiveTrend
+		return x.Succeeded
 	}
 	return false
 }
 
-// Top Performing Events
-type TopPerformingEvent struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title              string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	ImageUrl           *string                `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
-	Organization       *Organization          `protobuf:"bytes,4,opt,name=organization,proto3,oneof" json:"organization,omitempty"`
-	StartTime          string                 `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	TotalRegistrations int32                  `protobuf:"varint,6,opt,name=total_registrations,json=totalRegistrations,proto3" json:"total_registrations,omitempty"`
-	TotalAttendees     int32                  `protobuf:"varint,7,opt,name=total_attendees,json=totalAttendees,proto3" json:"total_attendees,omitempty"`
-	AttendanceRate     float64                `protobuf:"fixed64,8,opt,name=attendance_rate,json=attendanceRate,proto3" json:"attendance_rate,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *WebhookDelivery) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
 }
 
-func (x *TopPerformingEvent) Reset() {
-	*x = TopPerformingEvent{}
-	mi := &file_eventsv1_events_proto_msgTypes[94]
+type CreateWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,2,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TopPerformingEvent) String() string {
+func (x *CreateWebhookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TopPerformingEvent) ProtoMessage() {}
+func (*CreateWebhookRequest) ProtoMessage() {}
 
-func (x *TopPerformingEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[94]
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5708,90 +11057,100 @@ func (x *TopPerformingEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TopPerformingEvent.ProtoReflect.Descriptor instead.
-func (*TopPerformingEvent) Descriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{94}
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{173}
 }
 
-func (x *TopPerformingEvent) GetId() int32 {
+func (x *CreateWebhookRequest) GetUrl() string {
 	if x != nil {
-		return x.Id
+		return x.Url
 	}
-	return 0
+	return ""
 }
 
-func (x *TopPerformingEvent) GetTitle() string {
+func (x *CreateWebhookRequest) GetEventTypes() []string {
 	if x != nil {
-		return x.Title
+		return x.EventTypes
 	}
-	return ""
+	return nil
 }
 
-func (x *TopPerformingEvent) GetImageUrl() string {
-	if x != nil && x.ImageUrl != nil {
-		return *x.ImageUrl
-	}
-	return ""
+type CreateWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"` // HMAC signing secret, only returned once
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TopPerformingEvent) GetOrganization() *Organization {
-	if x != nil {
-		return x.Organization
-	}
-	return nil
+func (x *CreateWebhookResponse) Reset() {
+	*x = CreateWebhookResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *TopPerformingEvent) GetStartTime() string {
-	if x != nil {
-		return x.StartTime
-	}
-	return ""
+func (x *CreateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *TopPerformingEvent) GetTotalRegistrations() int32 {
+func (*CreateWebhookResponse) ProtoMessage() {}
+
+func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[174]
 	if x != nil {
-		return x.TotalRegistrations
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *TopPerformingEvent) GetTotalAttendees() int32 {
+// Deprecated: Use CreateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *CreateWebhookResponse) GetWebhook() *Webhook {
 	if x != nil {
-		return x.TotalAttendees
+		return x.Webhook
 	}
-	return 0
+	return nil
 }
 
-func (x *TopPerformingEvent) GetAttendanceRate() float64 {
+func (x *CreateWebhookResponse) GetSecret() string {
 	if x != nil {
-		return x.AttendanceRate
+		return x.Secret
 	}
-	return 0
+	return ""
 }
 
-type GetTopPerformingEventsRequest struct {
+type ListWebhooksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // Number of events to return (default 10)
-	Days          int32                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`   // Time period to consider (default 90)
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTopPerformingEventsRequest) Reset() {
-	*x = GetTopPerformingEventsRequest{}
-	mi := &file_eventsv1_events_proto_msgTypes[95]
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTopPerformingEventsRequest) String() string {
+func (x *ListWebhooksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTopPerformingEventsRequest) ProtoMessage() {}
+func (*ListWebhooksRequest) ProtoMessage() {}
 
-func (x *GetTopPerformingEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[95]
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5802,47 +11161,48 @@ func (x *GetTopPerformingEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTopPerformingEventsRequest.ProtoReflect.Descriptor instead.
-func (*GetTopPerformingEventsRequest) Descriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{95}
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{175}
 }
 
-func (x *GetTopPerformingEventsRequest) GetLimit() int32 {
+func (x *ListWebhooksRequest) GetPage() int32 {
 	if x != nil {
-		return x.Limit
+		return x.Page
 	}
 	return 0
 }
 
-func (x *GetTopPerformingEventsRequest) GetDays() int32 {
+func (x *ListWebhooksRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Days
+		return x.Limit
 	}
 	return 0
 }
 
-type GetTopPerformingEventsResponse struct {
+type ListWebhooksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        []*TopPerformingEvent  `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTopPerformingEventsResponse) Reset() {
-	*x = GetTopPerformingEventsResponse{}
-	mi := &file_eventsv1_events_proto_msgTypes[96]
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[176]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTopPerformingEventsResponse) String() string {
+func (x *ListWebhooksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTopPerformingEventsResponse) ProtoMessage() {}
+func (*ListWebhooksResponse) ProtoMessage() {}
 
-func (x *GetTopPerformingEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[96]
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[176]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5853,49 +11213,47 @@ func (x *GetTopPerformingEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTopPerformingEventsResponse.ProtoReflect.Descriptor instead.
-func (*GetTopPerformingEventsResponse) Descriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{96}
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{176}
 }
 
-func (x *GetTopPerformingEventsResponse) GetEvents() []*TopPerformingEvent {
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
 	if x != nil {
-		return x.Events
+		return x.Webhooks
 	}
 	return nil
 }
 
-// Low Registration Events
-type LowRegistrationEvent struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Id                  int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title               string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	ImageUrl            *string                `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
-	Organization        *Organization          `protobuf:"bytes,4,opt,name=organization,proto3,oneof" json:"organization,omitempty"`
-	StartTime           string                 `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	Capacity            int32                  `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	TotalRegistrations  int32                  `protobuf:"varint,7,opt,name=total_registrations,json=totalRegistrations,proto3" json:"total_registrations,omitempty"`
-	CapacityUtilization float64                `protobuf:"fixed64,8,opt,name=capacity_utilization,json=capacityUtilization,proto3" json:"capacity_utilization,omitempty"`
-	DaysUntilEvent      int32                  `protobuf:"varint,9,opt,name=days_until_event,json=daysUntilEvent,proto3" json:"days_until_event,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *ListWebhooksResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
 }
 
-func (x *LowRegistrationEvent) Reset() {
-	*x = LowRegistrationEvent{}
-	mi := &file_eventsv1_events_proto_msgTypes[97]
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[177]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LowRegistrationEvent) String() string {
+func (x *DeleteWebhookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LowRegistrationEvent) ProtoMessage() {}
+func (*DeleteWebhookRequest) ProtoMessage() {}
 
-func (x *LowRegistrationEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[97]
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[177]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5906,97 +11264,86 @@ func (x *LowRegistrationEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LowRegistrationEvent.ProtoReflect.Descriptor instead.
-func (*LowRegistrationEvent) Descriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{177}
 }
 
-func (x *LowRegistrationEvent) GetId() int32 {
+func (x *DeleteWebhookRequest) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *LowRegistrationEvent) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *LowRegistrationEvent) GetImageUrl() string {
-	if x != nil && x.ImageUrl != nil {
-		return *x.ImageUrl
-	}
-	return ""
+type DeleteWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LowRegistrationEvent) GetOrganization() *Organization {
-	if x != nil {
-		return x.Organization
-	}
-	return nil
+func (x *DeleteWebhookResponse) Reset() {
+	*x = DeleteWebhookResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *LowRegistrationEvent) GetStartTime() string {
-	if x != nil {
-		return x.StartTime
-	}
-	return ""
+func (x *DeleteWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *LowRegistrationEvent) GetCapacity() int32 {
-	if x != nil {
-		return x.Capacity
-	}
-	return 0
-}
+func (*DeleteWebhookResponse) ProtoMessage() {}
 
-func (x *LowRegistrationEvent) GetTotalRegistrations() int32 {
+func (x *DeleteWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[178]
 	if x != nil {
-		return x.TotalRegistrations
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *LowRegistrationEvent) GetCapacityUtilization() float64 {
-	if x != nil {
-		return x.CapacityUtilization
-	}
-	return 0
+// Deprecated: Use DeleteWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{178}
 }
 
-func (x *LowRegistrationEvent) GetDaysUntilEvent() int32 {
+func (x *DeleteWebhookResponse) GetSuccess() bool {
 	if x != nil {
-		return x.DaysUntilEvent
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-type GetLowRegistrationEventsRequest struct {
+type GetWebhookDeliveriesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Threshold     int32                  `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`                  // Capacity utilization threshold percentage (default 50)
-	DaysAhead     int32                  `protobuf:"varint,2,opt,name=days_ahead,json=daysAhead,proto3" json:"days_ahead,omitempty"` // Only include events within this many days (default 30)
+	WebhookId     int32                  `protobuf:"varint,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLowRegistrationEventsRequest) Reset() {
-	*x = GetLowRegistrationEventsRequest{}
-	mi := &file_eventsv1_events_proto_msgTypes[98]
+func (x *GetWebhookDeliveriesRequest) Reset() {
+	*x = GetWebhookDeliveriesRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[179]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLowRegistrationEventsRequest) String() string {
+func (x *GetWebhookDeliveriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLowRegistrationEventsRequest) ProtoMessage() {}
+func (*GetWebhookDeliveriesRequest) ProtoMessage() {}
 
-func (x *GetLowRegistrationEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[98]
+func (x *GetWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[179]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6007,47 +11354,55 @@ func (x *GetLowRegistrationEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLowRegistrationEventsRequest.ProtoReflect.Descriptor instead.
-func (*GetLowRegistrationEventsRequest) Descriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{98}
+// Deprecated: Use GetWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*GetWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{179}
 }
 
-func (x *GetLowRegistrationEventsRequest) GetThreshold() int32 {
+func (x *GetWebhookDeliveriesRequest) GetWebhookId() int32 {
 	if x != nil {
-		return x.Threshold
+		return x.WebhookId
 	}
 	return 0
 }
 
-func (x *GetLowRegistrationEventsRequest) GetDaysAhead() int32 {
+func (x *GetWebhookDeliveriesRequest) GetPage() int32 {
 	if x != nil {
-		return x.DaysAhead
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetWebhookDeliveriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
 	}
 	return 0
 }
 
-type GetLowRegistrationEventsResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Events        []*LowRegistrationEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+type GetWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLowRegistrationEventsResponse) Reset() {
-	*x = GetLowRegistrationEventsResponse{}
-	mi := &file_eventsv1_events_proto_msgTypes[99]
+func (x *GetWebhookDeliveriesResponse) Reset() {
+	*x = GetWebhookDeliveriesResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[180]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLowRegistrationEventsResponse) String() string {
+func (x *GetWebhookDeliveriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLowRegistrationEventsResponse) ProtoMessage() {}
+func (*GetWebhookDeliveriesResponse) ProtoMessage() {}
 
-func (x *GetLowRegistrationEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[99]
+func (x *GetWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[180]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6058,48 +11413,47 @@ func (x *GetLowRegistrationEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLowRegistrationEventsResponse.ProtoReflect.Descriptor instead.
-func (*GetLowRegistrationEventsResponse) Descriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{99}
+// Deprecated: Use GetWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*GetWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{180}
 }
 
-func (x *GetLowRegistrationEventsResponse) GetEvents() []*LowRegistrationEvent {
+func (x *GetWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
 	if x != nil {
-		return x.Events
+		return x.Deliveries
 	}
 	return nil
 }
 
-// Organization Activity
-type OrganizationActivity struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Id                int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title             string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	ImageUrl          *string                `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
-	EventsThisMonth   int32                  `protobuf:"varint,4,opt,name=events_this_month,json=eventsThisMonth,proto3" json:"events_this_month,omitempty"`
-	EventsLastMonth   int32                  `protobuf:"varint,5,opt,name=events_last_month,json=eventsLastMonth,proto3" json:"events_last_month,omitempty"`
-	TotalEvents       int32                  `protobuf:"varint,6,opt,name=total_events,json=totalEvents,proto3" json:"total_events,omitempty"`
-	AverageAttendance float64                `protobuf:"fixed64,7,opt,name=average_attendance,json=averageAttendance,proto3" json:"average_attendance,omitempty"`
-	GrowthRate        float64                `protobuf:"fixed64,8,opt,name=growth_rate,json=growthRate,proto3" json:"growth_rate,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *GetWebhookDeliveriesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
 }
 
-func (x *OrganizationActivity) Reset() {
-	*x = OrganizationActivity{}
-	mi := &file_eventsv1_events_proto_msgTypes[100]
+type RetryWebhookDeliveryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryId    int32                  `protobuf:"varint,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryWebhookDeliveryRequest) Reset() {
+	*x = RetryWebhookDeliveryRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[181]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrganizationActivity) String() string {
+func (x *RetryWebhookDeliveryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrganizationActivity) ProtoMessage() {}
+func (*RetryWebhookDeliveryRequest) ProtoMessage() {}
 
-func (x *OrganizationActivity) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[100]
+func (x *RetryWebhookDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6110,89 +11464,91 @@ func (x *OrganizationActivity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrganizationActivity.ProtoReflect.Descriptor instead.
-func (*OrganizationActivity) Descriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{100}
+// Deprecated: Use RetryWebhookDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*RetryWebhookDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{181}
 }
 
-func (x *OrganizationActivity) GetId() int32 {
+func (x *RetryWebhookDeliveryRequest) GetDeliveryId() int32 {
 	if x != nil {
-		return x.Id
+		return x.DeliveryId
 	}
 	return 0
 }
 
-func (x *OrganizationActivity) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+type RetryWebhookDeliveryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivery      *WebhookDelivery       `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrganizationActivity) GetImageUrl() string {
-	if x != nil && x.ImageUrl != nil {
-		return *x.ImageUrl
-	}
-	return ""
+func (x *RetryWebhookDeliveryResponse) Reset() {
+	*x = RetryWebhookDeliveryResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[182]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrganizationActivity) GetEventsThisMonth() int32 {
-	if x != nil {
-		return x.EventsThisMonth
-	}
-	return 0
+func (x *RetryWebhookDeliveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *OrganizationActivity) GetEventsLastMonth() int32 {
-	if x != nil {
-		return x.EventsLastMonth
-	}
-	return 0
-}
+func (*RetryWebhookDeliveryResponse) ProtoMessage() {}
 
-func (x *OrganizationActivity) GetTotalEvents() int32 {
+func (x *RetryWebhookDeliveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[182]
 	if x != nil {
-		return x.TotalEvents
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *OrganizationActivity) GetAverageAttendance() float64 {
-	if x != nil {
-		return x.AverageAttendance
-	}
-	return 0
+// Deprecated: Use RetryWebhookDeliveryResponse.ProtoReflect.Descriptor instead.
+func (*RetryWebhookDeliveryResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{182}
 }
 
-func (x *OrganizationActivity) GetGrowthRate() float64 {
+func (x *RetryWebhookDeliveryResponse) GetDelivery() *WebhookDelivery {
 	if x != nil {
-		return x.GrowthRate
+		return x.Delivery
 	}
-	return 0
+	return nil
 }
 
-type GetOrganizationActivityRequest struct {
+// Audit messages
+type AuditLogEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // Number of organizations to return (default 20)
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActorKratosId *string                `protobuf:"bytes,2,opt,name=actor_kratos_id,json=actorKratosId,proto3,oneof" json:"actor_kratos_id,omitempty"` // Unset when the call was not authenticated
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`                                            // RPC name, e.g. "DeleteOrganization"
+	ResourceType  string                 `protobuf:"bytes,4,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceId    string                 `protobuf:"bytes,5,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Payload       string                 `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"` // The request as protojson, with secrets removed
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrganizationActivityRequest) Reset() {
-	*x = GetOrganizationActivityRequest{}
-	mi := &file_eventsv1_events_proto_msgTypes[101]
+func (x *AuditLogEntry) Reset() {
+	*x = AuditLogEntry{}
+	mi := &file_eventsv1_events_proto_msgTypes[183]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrganizationActivityRequest) String() string {
+func (x *AuditLogEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrganizationActivityRequest) ProtoMessage() {}
+func (*AuditLogEntry) ProtoMessage() {}
 
-func (x *GetOrganizationActivityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[101]
+func (x *AuditLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[183]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6203,85 +11559,85 @@ func (x *GetOrganizationActivityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrganizationActivityRequest.ProtoReflect.Descriptor instead.
-func (*GetOrganizationActivityRequest) Descriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{101}
+// Deprecated: Use AuditLogEntry.ProtoReflect.Descriptor instead.
+func (*AuditLogEntry) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{183}
 }
 
-func (x *GetOrganizationActivityRequest) GetLimit() int32 {
+func (x *AuditLogEntry) GetId() int64 {
 	if x != nil {
-		return x.Limit
+		return x.Id
 	}
 	return 0
 }
 
-type GetOrganizationActivityResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Organizations []*OrganizationActivity `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AuditLogEntry) GetActorKratosId() string {
+	if x != nil && x.ActorKratosId != nil {
+		return *x.ActorKratosId
+	}
+	return ""
 }
 
-func (x *GetOrganizationActivityResponse) Reset() {
-	*x = GetOrganizationActivityResponse{}
-	mi := &file_eventsv1_events_proto_msgTypes[102]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AuditLogEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
 }
 
-func (x *GetOrganizationActivityResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AuditLogEntry) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
 }
 
-func (*GetOrganizationActivityResponse) ProtoMessage() {}
-
-func (x *GetOrganizationActivityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[102]
+func (x *AuditLogEntry) GetResourceId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ResourceId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetOrganizationActivityResponse.ProtoReflect.Descriptor instead.
-func (*GetOrganizationActivityResponse) Descriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{102}
+func (x *AuditLogEntry) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
 }
 
-func (x *GetOrganizationActivityResponse) GetOrganizations() []*OrganizationActivity {
+func (x *AuditLogEntry) GetCreatedAt() string {
 	if x != nil {
-		return x.Organizations
+		return x.CreatedAt
 	}
-	return nil
+	return ""
 }
 
-type GetEventImageUploadUrlRequest struct {
+type ListAuditLogsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
-	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	ResourceType  string                 `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"` // Empty = all resource types
+	ResourceId    string                 `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`       // Empty = all resources
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEventImageUploadUrlRequest) Reset() {
-	*x = GetEventImageUploadUrlRequest{}
-	mi := &file_eventsv1_events_proto_msgTypes[103]
+func (x *ListAuditLogsRequest) Reset() {
+	*x = ListAuditLogsRequest{}
+	mi := &file_eventsv1_events_proto_msgTypes[184]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventImageUploadUrlRequest) String() string {
+func (x *ListAuditLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventImageUploadUrlRequest) ProtoMessage() {}
+func (*ListAuditLogsRequest) ProtoMessage() {}
 
-func (x *GetEventImageUploadUrlRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[103]
+func (x *ListAuditLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[184]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6292,49 +11648,62 @@ func (x *GetEventImageUploadUrlRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventImageUploadUrlRequest.ProtoReflect.Descriptor instead.
-func (*GetEventImageUploadUrlRequest) Descriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{103}
+// Deprecated: Use ListAuditLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditLogsRequest) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{184}
 }
 
-func (x *GetEventImageUploadUrlRequest) GetFilename() string {
+func (x *ListAuditLogsRequest) GetResourceType() string {
 	if x != nil {
-		return x.Filename
+		return x.ResourceType
 	}
 	return ""
 }
 
-func (x *GetEventImageUploadUrlRequest) GetContentType() string {
+func (x *ListAuditLogsRequest) GetResourceId() string {
 	if x != nil {
-		return x.ContentType
+		return x.ResourceId
 	}
 	return ""
 }
 
-type GetEventImageUploadUrlResponse struct {
+func (x *ListAuditLogsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAuditLogsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListAuditLogsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UploadUrl     string                 `protobuf:"bytes,1,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`
-	PublicUrl     string                 `protobuf:"bytes,2,opt,name=public_url,json=publicUrl,proto3" json:"public_url,omitempty"`
-	ObjectKey     string                 `protobuf:"bytes,3,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	Entries       []*AuditLogEntry       `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEventImageUploadUrlResponse) Reset() {
-	*x = GetEventImageUploadUrlResponse{}
-	mi := &file_eventsv1_events_proto_msgTypes[104]
+func (x *ListAuditLogsResponse) Reset() {
+	*x = ListAuditLogsResponse{}
+	mi := &file_eventsv1_events_proto_msgTypes[185]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventImageUploadUrlResponse) String() string {
+func (x *ListAuditLogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventImageUploadUrlResponse) ProtoMessage() {}
+func (*ListAuditLogsResponse) ProtoMessage() {}
 
-func (x *GetEventImageUploadUrlResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_eventsv1_events_proto_msgTypes[104]
+func (x *ListAuditLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eventsv1_events_proto_msgTypes[185]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6345,44 +11714,39 @@ func (x *GetEventImageUploadUrlResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventImageUploadUrlResponse.ProtoReflect.Descriptor instead.
-func (*GetEventImageUploadUrlResponse) Descriptor() ([]byte, []int) {
-	return file_eventsv1_events_proto_rawDescGZIP(), []int{104}
-}
-
-func (x *GetEventImageUploadUrlResponse) GetUploadUrl() string {
-	if x != nil {
-		return x.UploadUrl
-	}
-	return ""
+// Deprecated: Use ListAuditLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditLogsResponse) Descriptor() ([]byte, []int) {
+	return file_eventsv1_events_proto_rawDescGZIP(), []int{185}
 }
 
-func (x *GetEventImageUploadUrlResponse) GetPublicUrl() string {
+func (x *ListAuditLogsResponse) GetEntries() []*AuditLogEntry {
 	if x != nil {
-		return x.PublicUrl
+		return x.Entries
 	}
-	return ""
+	return nil
 }
 
-func (x *GetEventImageUploadUrlResponse) GetObjectKey() string {
+func (x *ListAuditLogsResponse) GetTotal() int32 {
 	if x != nil {
-		return x.ObjectKey
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
 var File_eventsv1_events_proto protoreflect.FileDescriptor
 
 const file_eventsv1_events_proto_rawDesc = "" +
 	"\n" +
-	"\x15eventsv1/events.proto\x12\tevents.v1\"v\n" +
+	"\x15eventsv1/events.proto\x12\tevents.v1\"\xc8\x01\n" +
 	"\x10OrganizationType\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\x03 \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x04 \x01(\tR\tupdatedAt\"\xa0\x05\n" +
+	"updated_at\x18\x04 \x01(\tR\tupdatedAt\x12-\n" +
+	"\x12organization_count\x18\x05 \x01(\x05R\x11organizationCount\x12!\n" +
+	"\ftotal_events\x18\x06 \x01(\x05R\vtotalEvents\"\xed\x05\n" +
 	"\fOrganization\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -6401,7 +11765,8 @@ const file_eventsv1_events_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x0e \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x0f \x01(\tR\tupdatedAtB\f\n" +
+	"updated_at\x18\x0f \x01(\tR\tupdatedAt\x123\n" +
+	"\x13monthly_event_quota\x18\x10 \x01(\x05H\tR\x11monthlyEventQuota\x88\x01\x01B\f\n" +
 	"\n" +
 	"_image_urlB\x0e\n" +
 	"\f_descriptionB\f\n" +
@@ -6414,14 +11779,18 @@ const file_eventsv1_events_proto_rawDesc = "" +
 	"\n" +
 	"\b_youtubeB\t\n" +
 	"\a_tiktokB\v\n" +
-	"\t_linkedin\"g\n" +
+	"\t_linkedinB\x16\n" +
+	"\x14_monthly_event_quota\"\xb7\x01\n" +
 	"\x03Tag\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\x03 \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x04 \x01(\tR\tupdatedAt\"\xef\x04\n" +
+	"updated_at\x18\x04 \x01(\tR\tupdatedAt\x12-\n" +
+	"\x12organization_count\x18\x05 \x01(\x05R\x11organizationCount\x12\x1f\n" +
+	"\vevent_count\x18\x06 \x01(\x05R\n" +
+	"eventCount\"\xb3\x06\n" +
 	"\x05Event\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -6443,10 +11812,18 @@ const file_eventsv1_events_proto_rawDesc = "" +
 	"\x13total_registrations\x18\x0e \x01(\x05R\x12totalRegistrations\x12'\n" +
 	"\x0ftotal_attendees\x18\x0f \x01(\x05R\x0etotalAttendees\x12@\n" +
 	"\forganization\x18\x10 \x01(\v2\x17.events.v1.OrganizationH\x01R\forganization\x88\x01\x01\x12\"\n" +
-	"\x04tags\x18\x11 \x03(\v2\x0e.events.v1.TagR\x04tagsB\f\n" +
+	"\x04tags\x18\x11 \x03(\v2\x0e.events.v1.TagR\x04tags\x12\x1f\n" +
+	"\bcapacity\x18\x12 \x01(\x05H\x02R\bcapacity\x88\x01\x01\x12)\n" +
+	"\x10creator_username\x18\x13 \x01(\tR\x0fcreatorUsername\x12\x1c\n" +
+	"\tpublished\x18\x14 \x01(\bR\tpublished\x12\"\n" +
+	"\n" +
+	"deleted_at\x18\x15 \x01(\tH\x03R\tdeletedAt\x88\x01\x01\x12\x18\n" +
+	"\aversion\x18\x16 \x01(\x05R\aversionB\f\n" +
 	"\n" +
 	"_image_urlB\x0f\n" +
-	"\r_organization\"\xaa\x02\n" +
+	"\r_organizationB\v\n" +
+	"\t_capacityB\r\n" +
+	"\v_deleted_at\"\xe1\x02\n" +
 	"\x11EventRegistration\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
 	"\bevent_id\x18\x02 \x01(\x05R\aeventId\x12\x17\n" +
@@ -6457,8 +11834,10 @@ const file_eventsv1_events_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\tR\tupdatedAtB\x0f\n" +
-	"\r_cancelled_at\"\xd8\x02\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\x12+\n" +
+	"\x05event\x18\t \x01(\v2\x10.events.v1.EventH\x01R\x05event\x88\x01\x01B\x0f\n" +
+	"\r_cancelled_atB\b\n" +
+	"\x06_event\"\xd8\x02\n" +
 	"\x0fEventAttendance\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12'\n" +
 	"\x0fregistration_id\x18\x02 \x01(\x05R\x0eregistrationId\x123\n" +
@@ -6523,13 +11902,17 @@ const file_eventsv1_events_proto_rawDesc = "" +
 	"\x16GetOrganizationRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"V\n" +
 	"\x17GetOrganizationResponse\x12;\n" +
-	"\forganization\x18\x01 \x01(\v2\x17.events.v1.OrganizationR\forganization\"D\n" +
+	"\forganization\x18\x01 \x01(\v2\x17.events.v1.OrganizationR\forganization\"\xef\x01\n" +
 	"\x18ListOrganizationsRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\"p\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12G\n" +
+	"\rstatus_filter\x18\x03 \x01(\x0e2\x1d.events.v1.OrganizationStatusH\x00R\fstatusFilter\x88\x01\x01\x125\n" +
+	"\x14organization_type_id\x18\x04 \x01(\x05H\x01R\x12organizationTypeId\x88\x01\x01B\x10\n" +
+	"\x0e_status_filterB\x17\n" +
+	"\x15_organization_type_id\"p\n" +
 	"\x19ListOrganizationsResponse\x12=\n" +
 	"\rorganizations\x18\x01 \x03(\v2\x17.events.v1.OrganizationR\rorganizations\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"\xac\x05\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xf0\x06\n" +
 	"\x19UpdateOrganizationRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12 \n" +
@@ -6545,7 +11928,10 @@ const file_eventsv1_events_proto_rawDesc = "" +
 	"\x06tiktok\x18\v \x01(\tH\tR\x06tiktok\x88\x01\x01\x12\x1f\n" +
 	"\blinkedin\x18\f \x01(\tH\n" +
 	"R\blinkedin\x88\x01\x01\x12:\n" +
-	"\x06status\x18\r \x01(\x0e2\x1d.events.v1.OrganizationStatusH\vR\x06status\x88\x01\x01B\b\n" +
+	"\x06status\x18\r \x01(\x0e2\x1d.events.v1.OrganizationStatusH\vR\x06status\x88\x01\x01\x123\n" +
+	"\x13monthly_event_quota\x18\x0e \x01(\x05H\fR\x11monthlyEventQuota\x88\x01\x01\x12(\n" +
+	"\rcontact_email\x18\x0f \x01(\tH\rR\fcontactEmail\x88\x01\x01\x129\n" +
+	"\x19clear_monthly_event_quota\x18\x10 \x01(\bR\x16clearMonthlyEventQuotaB\b\n" +
 	"\x06_titleB\f\n" +
 	"\n" +
 	"_image_urlB\x0e\n" +
@@ -6561,9 +11947,85 @@ const file_eventsv1_events_proto_rawDesc = "" +
 	"\b_youtubeB\t\n" +
 	"\a_tiktokB\v\n" +
 	"\t_linkedinB\t\n" +
-	"\a_status\"Y\n" +
+	"\a_statusB\x16\n" +
+	"\x14_monthly_event_quotaB\x10\n" +
+	"\x0e_contact_email\"Y\n" +
 	"\x1aUpdateOrganizationResponse\x12;\n" +
-	"\forganization\x18\x01 \x01(\v2\x17.events.v1.OrganizationR\forganization\"+\n" +
+	"\forganization\x18\x01 \x01(\v2\x17.events.v1.OrganizationR\forganization\"K\n" +
+	" GetOrganizationQuotaUsageRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\x05R\x0eorganizationId\"\x8d\x01\n" +
+	"!GetOrganizationQuotaUsageResponse\x12\x19\n" +
+	"\x05quota\x18\x01 \x01(\x05H\x00R\x05quota\x88\x01\x01\x12\x12\n" +
+	"\x04used\x18\x02 \x01(\x05R\x04used\x12!\n" +
+	"\tremaining\x18\x03 \x01(\x05H\x01R\tremaining\x88\x01\x01B\b\n" +
+	"\x06_quotaB\f\n" +
+	"\n" +
+	"_remaining\"s\n" +
+	"\x12OrganizationMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\"r\n" +
+	"\x1dGetOrganizationMembersRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\x05R\x0eorganizationId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"o\n" +
+	"\x1eGetOrganizationMembersResponse\x127\n" +
+	"\amembers\x18\x01 \x03(\v2\x1d.events.v1.OrganizationMemberR\amembers\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x82\x01\n" +
+	"\x15AssignClubRoleRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\x05R\x0eorganizationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12'\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x13.events.v1.ClubRoleR\x04role\"O\n" +
+	"\x16AssignClubRoleResponse\x125\n" +
+	"\x06member\x18\x01 \x01(\v2\x1d.events.v1.OrganizationMemberR\x06member\"[\n" +
+	"\x17RemoveClubMemberRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\x05R\x0eorganizationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"4\n" +
+	"\x18RemoveClubMemberResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x97\x02\n" +
+	"\x13OrganizationInquiry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\x05R\x0eorganizationId\x12!\n" +
+	"\fsender_email\x18\x03 \x01(\tR\vsenderEmail\x12\x1f\n" +
+	"\vsender_name\x18\x04 \x01(\tR\n" +
+	"senderName\x12\x18\n" +
+	"\asubject\x18\x05 \x01(\tR\asubject\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\x120\n" +
+	"\x06status\x18\a \x01(\x0e2\x18.events.v1.InquiryStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\"\xc3\x01\n" +
+	" SubmitOrganizationInquiryRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\x05R\x0eorganizationId\x12!\n" +
+	"\fsender_email\x18\x02 \x01(\tR\vsenderEmail\x12\x1f\n" +
+	"\vsender_name\x18\x03 \x01(\tR\n" +
+	"senderName\x12\x18\n" +
+	"\asubject\x18\x04 \x01(\tR\asubject\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"B\n" +
+	"!SubmitOrganizationInquiryResponse\x12\x1d\n" +
+	"\n" +
+	"inquiry_id\x18\x01 \x01(\x05R\tinquiryId\"u\n" +
+	" ListOrganizationInquiriesRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\x05R\x0eorganizationId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"w\n" +
+	"!ListOrganizationInquiriesResponse\x12<\n" +
+	"\tinquiries\x18\x01 \x03(\v2\x1e.events.v1.OrganizationInquiryR\tinquiries\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"m\n" +
+	"\x1aUpdateInquiryStatusRequest\x12\x1d\n" +
+	"\n" +
+	"inquiry_id\x18\x01 \x01(\x05R\tinquiryId\x120\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x18.events.v1.InquiryStatusR\x06status\"W\n" +
+	"\x1bUpdateInquiryStatusResponse\x128\n" +
+	"\ainquiry\x18\x01 \x01(\v2\x1e.events.v1.OrganizationInquiryR\ainquiry\"U\n" +
+	"\x19MergeOrganizationsRequest\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\x05R\bsourceId\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\x05R\btargetId\"\xb3\x01\n" +
+	"\x1aMergeOrganizationsResponse\x12:\n" +
+	"\farchived_org\x18\x01 \x01(\v2\x17.events.v1.OrganizationR\varchivedOrg\x126\n" +
+	"\n" +
+	"target_org\x18\x02 \x01(\v2\x17.events.v1.OrganizationR\ttargetOrg\x12!\n" +
+	"\fevents_moved\x18\x03 \x01(\x05R\veventsMoved\"+\n" +
 	"\x19DeleteOrganizationRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"6\n" +
 	"\x1aDeleteOrganizationResponse\x12\x18\n" +
@@ -6591,7 +12053,7 @@ const file_eventsv1_events_proto_rawDesc = "" +
 	"\x1dDeleteOrganizationTypeRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\":\n" +
 	"\x1eDeleteOrganizationTypeResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xdd\x02\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x8b\x03\n" +
 	"\x12CreateEventRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12 \n" +
@@ -6604,27 +12066,50 @@ const file_eventsv1_events_proto_rawDesc = "" +
 	"\bend_time\x18\b \x01(\tR\aendTime\x12.\n" +
 	"\x06format\x18\t \x01(\x0e2\x16.events.v1.EventFormatR\x06format\x12\x17\n" +
 	"\atag_ids\x18\n" +
-	" \x03(\x05R\x06tagIdsB\f\n" +
+	" \x03(\x05R\x06tagIds\x12\x1f\n" +
+	"\bcapacity\x18\v \x01(\x05H\x01R\bcapacity\x88\x01\x01B\f\n" +
 	"\n" +
-	"_image_url\"=\n" +
+	"_image_urlB\v\n" +
+	"\t_capacity\"=\n" +
 	"\x13CreateEventResponse\x12&\n" +
+	"\x05event\x18\x01 \x01(\v2\x10.events.v1.EventR\x05event\"P\n" +
+	"\x17BulkCreateEventsRequest\x125\n" +
+	"\x06events\x18\x01 \x03(\v2\x1d.events.v1.CreateEventRequestR\x06events\"D\n" +
+	"\x18BulkCreateEventsResponse\x12(\n" +
+	"\x06events\x18\x01 \x03(\v2\x10.events.v1.EventR\x06events\"\x87\x01\n" +
+	"\x15DuplicateEventRequest\x12&\n" +
+	"\x0fsource_event_id\x18\x01 \x01(\x05R\rsourceEventId\x12$\n" +
+	"\x0enew_start_time\x18\x02 \x01(\tR\fnewStartTime\x12 \n" +
+	"\fnew_end_time\x18\x03 \x01(\tR\n" +
+	"newEndTime\"@\n" +
+	"\x16DuplicateEventResponse\x12&\n" +
 	"\x05event\x18\x01 \x01(\v2\x10.events.v1.EventR\x05event\"!\n" +
 	"\x0fGetEventRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\":\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x8a\x02\n" +
 	"\x10GetEventResponse\x12&\n" +
-	"\x05event\x18\x01 \x01(\v2\x10.events.v1.EventR\x05event\"\xc2\x01\n" +
+	"\x05event\x18\x01 \x01(\v2\x10.events.v1.EventR\x05event\x12R\n" +
+	"\x13caller_registration\x18\x02 \x01(\v2\x1c.events.v1.EventRegistrationH\x00R\x12callerRegistration\x88\x01\x01\x12L\n" +
+	"\x11caller_attendance\x18\x03 \x01(\v2\x1a.events.v1.EventAttendanceH\x01R\x10callerAttendance\x88\x01\x01B\x16\n" +
+	"\x14_caller_registrationB\x14\n" +
+	"\x12_caller_attendance\"\x9b\x02\n" +
 	"\x11ListEventsRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1c\n" +
 	"\auser_id\x18\x03 \x01(\x05H\x00R\x06userId\x88\x01\x01\x12,\n" +
 	"\x0forganization_id\x18\x04 \x01(\x05H\x01R\x0eorganizationId\x88\x01\x01\x12\x17\n" +
-	"\atag_ids\x18\x05 \x03(\x05R\x06tagIdsB\n" +
+	"\atag_ids\x18\x05 \x03(\x05R\x06tagIds\x12/\n" +
+	"\x13include_unpublished\x18\x06 \x01(\bR\x12includeUnpublished\x12\x1b\n" +
+	"\x06cursor\x18\a \x01(\tH\x02R\x06cursor\x88\x01\x01B\n" +
 	"\n" +
 	"\b_user_idB\x12\n" +
-	"\x10_organization_id\"T\n" +
+	"\x10_organization_idB\t\n" +
+	"\a_cursor\"\x8a\x01\n" +
 	"\x12ListEventsResponse\x12(\n" +
 	"\x06events\x18\x01 \x03(\v2\x10.events.v1.EventR\x06events\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"\x83\x04\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12$\n" +
+	"\vnext_cursor\x18\x03 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01B\x0e\n" +
+	"\f_next_cursor\"\xf6\x04\n" +
 	"\x12UpdateEventRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12%\n" +
@@ -6638,7 +12123,10 @@ const file_eventsv1_events_proto_rawDesc = "" +
 	"\bend_time\x18\t \x01(\tH\aR\aendTime\x88\x01\x01\x123\n" +
 	"\x06format\x18\n" +
 	" \x01(\x0e2\x16.events.v1.EventFormatH\bR\x06format\x88\x01\x01\x12\x17\n" +
-	"\atag_ids\x18\v \x03(\x05R\x06tagIdsB\b\n" +
+	"\atag_ids\x18\v \x03(\x05R\x06tagIds\x12\x1f\n" +
+	"\bcapacity\x18\f \x01(\x05H\tR\bcapacity\x88\x01\x01\x12.\n" +
+	"\x10expected_version\x18\r \x01(\x05H\n" +
+	"R\x0fexpectedVersion\x88\x01\x01B\b\n" +
 	"\x06_titleB\x0e\n" +
 	"\f_descriptionB\f\n" +
 	"\n" +
@@ -6649,13 +12137,30 @@ const file_eventsv1_events_proto_rawDesc = "" +
 	"\t_locationB\r\n" +
 	"\v_start_timeB\v\n" +
 	"\t_end_timeB\t\n" +
-	"\a_format\"=\n" +
+	"\a_formatB\v\n" +
+	"\t_capacityB\x13\n" +
+	"\x11_expected_version\"=\n" +
 	"\x13UpdateEventResponse\x12&\n" +
 	"\x05event\x18\x01 \x01(\v2\x10.events.v1.EventR\x05event\"$\n" +
 	"\x12DeleteEventRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"/\n" +
 	"\x13DeleteEventResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"&\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"%\n" +
+	"\x13RestoreEventRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\">\n" +
+	"\x14RestoreEventResponse\x12&\n" +
+	"\x05event\x18\x01 \x01(\v2\x10.events.v1.EventR\x05event\"D\n" +
+	"\x18ListDeletedEventsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"[\n" +
+	"\x19ListDeletedEventsResponse\x12(\n" +
+	"\x06events\x18\x01 \x03(\v2\x10.events.v1.EventR\x06events\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"W\n" +
+	"\x1cToggleEventVisibilityRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x05R\aeventId\x12\x1c\n" +
+	"\tpublished\x18\x02 \x01(\bR\tpublished\"G\n" +
+	"\x1dToggleEventVisibilityResponse\x12&\n" +
+	"\x05event\x18\x01 \x01(\v2\x10.events.v1.EventR\x05event\"&\n" +
 	"\x10CreateTagRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"5\n" +
 	"\x11CreateTagResponse\x12 \n" +
@@ -6663,10 +12168,13 @@ const file_eventsv1_events_proto_rawDesc = "" +
 	"\rGetTagRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"2\n" +
 	"\x0eGetTagResponse\x12 \n" +
-	"\x03tag\x18\x01 \x01(\v2\x0e.events.v1.TagR\x03tag\";\n" +
+	"\x03tag\x18\x01 \x01(\v2\x0e.events.v1.TagR\x03tag\"\xab\x01\n" +
 	"\x0fListTagsRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\"L\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12-\n" +
+	"\asort_by\x18\x03 \x01(\x0e2\x14.events.v1.TagSortByR\x06sortBy\x12+\n" +
+	"\x0fmin_event_count\x18\x04 \x01(\x05H\x00R\rminEventCount\x88\x01\x01B\x12\n" +
+	"\x10_min_event_count\"L\n" +
 	"\x10ListTagsResponse\x12\"\n" +
 	"\x04tags\x18\x01 \x03(\v2\x0e.events.v1.TagR\x04tags\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\"D\n" +
@@ -6679,7 +12187,14 @@ const file_eventsv1_events_proto_rawDesc = "" +
 	"\x10DeleteTagRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"-\n" +
 	"\x11DeleteTagResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"=\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"Z\n" +
+	"\x10MergeTagsRequest\x12\"\n" +
+	"\rsource_tag_id\x18\x01 \x01(\x05R\vsourceTagId\x12\"\n" +
+	"\rtarget_tag_id\x18\x02 \x01(\x05R\vtargetTagId\"k\n" +
+	"\x11MergeTagsResponse\x12-\n" +
+	"\n" +
+	"target_tag\x18\x01 \x01(\v2\x0e.events.v1.TagR\ttargetTag\x12'\n" +
+	"\x0fevents_affected\x18\x02 \x01(\x05R\x0eeventsAffected\"=\n" +
 	"\"GetPublishableOrganizationsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\"d\n" +
 	"#GetPublishableOrganizationsResponse\x12=\n" +
@@ -6691,27 +12206,86 @@ const file_eventsv1_events_proto_rawDesc = "" +
 	"\x17GetEventsByTagIdRequest\x12\x15\n" +
 	"\x06tag_id\x18\x01 \x01(\x05R\x05tagId\"D\n" +
 	"\x18GetEventsByTagIdResponse\x12(\n" +
-	"\x06events\x18\x01 \x03(\v2\x10.events.v1.EventR\x06events\"9\n" +
+	"\x06events\x18\x01 \x03(\v2\x10.events.v1.EventR\x06events\"^\n" +
+	"\x19GetEventsByAllTagsRequest\x12\x17\n" +
+	"\atag_ids\x18\x01 \x03(\x05R\x06tagIds\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\\\n" +
+	"\x1aGetEventsByAllTagsResponse\x12(\n" +
+	"\x06events\x18\x01 \x03(\v2\x10.events.v1.EventR\x06events\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x9a\x01\n" +
+	"\x17GetManagedEventsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12<\n" +
+	"\vrole_filter\x18\x04 \x01(\x0e2\x1b.events.v1.ManagedEventRoleR\n" +
+	"roleFilter\"Z\n" +
+	"\x18GetManagedEventsResponse\x12(\n" +
+	"\x06events\x18\x01 \x03(\v2\x10.events.v1.EventR\x06events\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"S\n" +
+	"\x13GetEventFeedRequest\x12\x1b\n" +
+	"\x06cursor\x18\x01 \x01(\tH\x00R\x06cursor\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limitB\t\n" +
+	"\a_cursor\"a\n" +
+	"\tFeedEvent\x12&\n" +
+	"\x05event\x18\x01 \x01(\v2\x10.events.v1.EventR\x05event\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x01R\x05score\"z\n" +
+	"\x14GetEventFeedResponse\x12,\n" +
+	"\x06events\x18\x01 \x03(\v2\x14.events.v1.FeedEventR\x06events\x12$\n" +
+	"\vnext_cursor\x18\x02 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01B\x0e\n" +
+	"\f_next_cursor\"\xac\x01\n" +
+	"\fEventSummary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\tR\tstartTime\x12.\n" +
+	"\x06format\x18\x04 \x01(\x0e2\x16.events.v1.EventFormatR\x06format\x12'\n" +
+	"\x0forganization_id\x18\x05 \x01(\x05R\x0eorganizationId\"\x85\x01\n" +
+	"\x17GetEventCalendarRequest\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\x05R\x05month\x12,\n" +
+	"\x0forganization_id\x18\x03 \x01(\x05H\x00R\x0eorganizationId\x88\x01\x01B\x12\n" +
+	"\x10_organization_id\"s\n" +
+	"\vCalendarDay\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1f\n" +
+	"\vevent_count\x18\x02 \x01(\x05R\n" +
+	"eventCount\x12/\n" +
+	"\x06events\x18\x03 \x03(\v2\x17.events.v1.EventSummaryR\x06events\"F\n" +
+	"\x18GetEventCalendarResponse\x12*\n" +
+	"\x04days\x18\x01 \x03(\v2\x16.events.v1.CalendarDayR\x04days\"c\n" +
 	"\x1eGetUserSubscribedEventsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x05R\x06userId\"K\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"a\n" +
 	"\x1fGetUserSubscribedEventsResponse\x12(\n" +
-	"\x06events\x18\x01 \x03(\v2\x10.events.v1.EventR\x06events\"\xb1\x01\n" +
+	"\x06events\x18\x01 \x03(\v2\x10.events.v1.EventR\x06events\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xd9\x01\n" +
 	"\x19ListEventsForAdminRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1c\n" +
 	"\auser_id\x18\x03 \x01(\x05H\x00R\x06userId\x88\x01\x01\x12,\n" +
-	"\x0forganization_id\x18\x04 \x01(\x05H\x01R\x0eorganizationId\x88\x01\x01B\n" +
+	"\x0forganization_id\x18\x04 \x01(\x05H\x01R\x0eorganizationId\x88\x01\x01\x12\x1b\n" +
+	"\x06cursor\x18\x05 \x01(\tH\x02R\x06cursor\x88\x01\x01B\n" +
 	"\n" +
 	"\b_user_idB\x12\n" +
-	"\x10_organization_id\"\\\n" +
+	"\x10_organization_idB\t\n" +
+	"\a_cursor\"\x92\x01\n" +
 	"\x1aListEventsForAdminResponse\x12(\n" +
 	"\x06events\x18\x01 \x03(\v2\x10.events.v1.EventR\x06events\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"M\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12$\n" +
+	"\vnext_cursor\x18\x03 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01B\x0e\n" +
+	"\f_next_cursor\"M\n" +
 	"\x17RegisterForEventRequest\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\x05R\aeventId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x05R\x06userId\"\\\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"\x8f\x01\n" +
 	"\x18RegisterForEventResponse\x12@\n" +
-	"\fregistration\x18\x01 \x01(\v2\x1c.events.v1.EventRegistrationR\fregistration\"D\n" +
+	"\fregistration\x18\x01 \x01(\v2\x1c.events.v1.EventRegistrationR\fregistration\x12\"\n" +
+	"\n" +
+	"cancel_url\x18\x02 \x01(\tH\x00R\tcancelUrl\x88\x01\x01B\r\n" +
+	"\v_cancel_url\"D\n" +
 	"\x19CancelRegistrationRequest\x12'\n" +
 	"\x0fregistration_id\x18\x01 \x01(\x05R\x0eregistrationId\"6\n" +
 	"\x1aCancelRegistrationResponse\x12\x18\n" +
@@ -6724,11 +12298,58 @@ const file_eventsv1_events_proto_rawDesc = "" +
 	"\x06_limit\"y\n" +
 	"\x1dGetEventRegistrationsResponse\x12B\n" +
 	"\rregistrations\x18\x01 \x03(\v2\x1c.events.v1.EventRegistrationR\rregistrations\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"6\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xcc\x01\n" +
 	"\x1bGetUserRegistrationsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x05R\x06userId\"b\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12:\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1d.events.v1.RegistrationStatusH\x00R\x06status\x88\x01\x01\x12#\n" +
+	"\rinclude_event\x18\x05 \x01(\bR\fincludeEventB\t\n" +
+	"\a_status\"x\n" +
 	"\x1cGetUserRegistrationsResponse\x12B\n" +
-	"\rregistrations\x18\x01 \x03(\v2\x1c.events.v1.EventRegistrationR\rregistrations\"\x8a\x01\n" +
+	"\rregistrations\x18\x01 \x03(\v2\x1c.events.v1.EventRegistrationR\rregistrations\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x94\x01\n" +
+	"\x10RegistrationHold\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\x05R\aeventId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x05R\x06userId\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\tR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"\x86\x01\n" +
+	"\x1cHoldEventRegistrationRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x05R\aeventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x122\n" +
+	"\x15hold_duration_seconds\x18\x03 \x01(\x05R\x13holdDurationSeconds\"y\n" +
+	"\x1dHoldEventRegistrationResponse\x12/\n" +
+	"\x04hold\x18\x01 \x01(\v2\x1b.events.v1.RegistrationHoldR\x04hold\x12'\n" +
+	"\x0favailable_slots\x18\x02 \x01(\x05R\x0eavailableSlots\"5\n" +
+	"\x1aConfirmRegistrationRequest\x12\x17\n" +
+	"\ahold_id\x18\x01 \x01(\x05R\x06holdId\"\x92\x01\n" +
+	"\x1bConfirmRegistrationResponse\x12@\n" +
+	"\fregistration\x18\x01 \x01(\v2\x1c.events.v1.EventRegistrationR\fregistration\x12\"\n" +
+	"\n" +
+	"cancel_url\x18\x02 \x01(\tH\x00R\tcancelUrl\x88\x01\x01B\r\n" +
+	"\v_cancel_url\"|\n" +
+	"\x18NotifyRegistrantsRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x05R\aeventId\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"t\n" +
+	"\x19NotifyRegistrantsResponse\x12\x1f\n" +
+	"\vemails_sent\x18\x01 \x01(\x05R\n" +
+	"emailsSent\x12\x16\n" +
+	"\x06errors\x18\x02 \x03(\tR\x06errors\x12\x1e\n" +
+	"\n" +
+	"recipients\x18\x03 \x03(\tR\n" +
+	"recipients\"W\n" +
+	"!GetEventRegistrationStatusRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x05R\aeventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"\xb3\x01\n" +
+	"\"GetEventRegistrationStatusResponse\x125\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1d.events.v1.RegistrationStatusR\x06status\x12E\n" +
+	"\fregistration\x18\x02 \x01(\v2\x1c.events.v1.EventRegistrationH\x00R\fregistration\x88\x01\x01B\x0f\n" +
+	"\r_registration\"\x8a\x01\n" +
 	"\x16CheckInAttendeeRequest\x12'\n" +
 	"\x0fregistration_id\x18\x01 \x01(\x05R\x0eregistrationId\x12\"\n" +
 	"\rchecked_in_by\x18\x02 \x01(\x05R\vcheckedInBy\x12\x19\n" +
@@ -6737,7 +12358,16 @@ const file_eventsv1_events_proto_rawDesc = "" +
 	"\x17CheckInAttendeeResponse\x12:\n" +
 	"\n" +
 	"attendance\x18\x01 \x01(\v2\x1a.events.v1.EventAttendanceR\n" +
-	"attendance\"\x9a\x01\n" +
+	"attendance\"c\n" +
+	"\x12BulkCheckInRequest\x12)\n" +
+	"\x10registration_ids\x18\x01 \x03(\x05R\x0fregistrationIds\x12\"\n" +
+	"\rchecked_in_by\x18\x02 \x01(\x05R\vcheckedInBy\"U\n" +
+	"\x12BulkCheckInFailure\x12'\n" +
+	"\x0fregistration_id\x18\x01 \x01(\x05R\x0eregistrationId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"j\n" +
+	"\x13BulkCheckInResponse\x12\x1c\n" +
+	"\tsucceeded\x18\x01 \x03(\x05R\tsucceeded\x125\n" +
+	"\x06failed\x18\x02 \x03(\v2\x1d.events.v1.BulkCheckInFailureR\x06failed\"\x9a\x01\n" +
 	"\x15MarkAttendanceRequest\x12'\n" +
 	"\x0fregistration_id\x18\x01 \x01(\x05R\x0eregistrationId\x123\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x1b.events.v1.AttendanceStatusR\x06status\x12\x19\n" +
@@ -6755,8 +12385,37 @@ const file_eventsv1_events_proto_rawDesc = "" +
 	"attendance\x12)\n" +
 	"\x10total_registered\x18\x02 \x01(\x05R\x0ftotalRegistered\x12%\n" +
 	"\x0etotal_attended\x18\x03 \x01(\x05R\rtotalAttended\x12\"\n" +
-	"\rtotal_no_show\x18\x04 \x01(\x05R\vtotalNoShow\"\x1f\n" +
-	"\x1dGetDashboardStatisticsRequest\"\\\n" +
+	"\rtotal_no_show\x18\x04 \x01(\x05R\vtotalNoShow\"9\n" +
+	"\x1cStreamEventAttendanceRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x05R\aeventId\"[\n" +
+	"\x1dStreamEventAttendanceResponse\x12:\n" +
+	"\n" +
+	"attendance\x18\x01 \x01(\v2\x1a.events.v1.EventAttendanceR\n" +
+	"attendance\"\x8c\x01\n" +
+	"\x1fGetUserAttendanceHistoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1b\n" +
+	"\x06cursor\x18\x04 \x01(\tH\x00R\x06cursor\x88\x01\x01B\t\n" +
+	"\a_cursor\"\x9e\x01\n" +
+	"\x14AttendedEventSummary\x12&\n" +
+	"\x05event\x18\x01 \x01(\v2\x10.events.v1.EventR\x05event\x12'\n" +
+	"\rchecked_in_at\x18\x02 \x01(\tH\x00R\vcheckedInAt\x88\x01\x01\x12\x19\n" +
+	"\x05notes\x18\x03 \x01(\tH\x01R\x05notes\x88\x01\x01B\x10\n" +
+	"\x0e_checked_in_atB\b\n" +
+	"\x06_notes\"\xa4\x01\n" +
+	"\x1dUserAttendanceHistoryResponse\x127\n" +
+	"\x06events\x18\x01 \x03(\v2\x1f.events.v1.AttendedEventSummaryR\x06events\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12$\n" +
+	"\vnext_cursor\x18\x03 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01B\x0e\n" +
+	"\f_next_cursor\"9\n" +
+	"\x1cExportEventAttendanceRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x05R\aeventId\"+\n" +
+	"\x15AttendanceExportChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"D\n" +
+	"\x1dGetDashboardStatisticsRequest\x12#\n" +
+	"\rforce_refresh\x18\x01 \x01(\bR\fforceRefresh\"\\\n" +
 	"\x1eGetDashboardStatisticsResponse\x12:\n" +
 	"\n" +
 	"statistics\x18\x01 \x01(\v2\x1a.events.v1.EventStatisticsR\n" +
@@ -6795,8 +12454,9 @@ const file_eventsv1_events_proto_rawDesc = "" +
 	"\x11EventStatsSummary\x12!\n" +
 	"\ftotal_events\x18\x01 \x01(\x05R\vtotalEvents\x12/\n" +
 	"\x13total_registrations\x18\x02 \x01(\x05R\x12totalRegistrations\x12'\n" +
-	"\x0ftotal_attendees\x18\x03 \x01(\x05R\x0etotalAttendees\"\x1d\n" +
-	"\x1bGetOverallStatisticsRequest\"\x8b\x03\n" +
+	"\x0ftotal_attendees\x18\x03 \x01(\x05R\x0etotalAttendees\"B\n" +
+	"\x1bGetOverallStatisticsRequest\x12#\n" +
+	"\rforce_refresh\x18\x01 \x01(\bR\fforceRefresh\"\x8b\x03\n" +
 	"\x1cGetOverallStatisticsResponse\x12!\n" +
 	"\ftotal_events\x18\x01 \x01(\x05R\vtotalEvents\x12\x1f\n" +
 	"\vtotal_users\x18\x02 \x01(\x05R\n" +
@@ -6816,7 +12476,20 @@ const file_eventsv1_events_proto_rawDesc = "" +
 	"\x15GetEventTrendsRequest\x12\x12\n" +
 	"\x04days\x18\x01 \x01(\x05R\x04days\"G\n" +
 	"\x16GetEventTrendsResponse\x12-\n" +
-	"\x06trends\x18\x01 \x03(\v2\x15.events.v1.EventTrendR\x06trends\"\xe9\x02\n" +
+	"\x06trends\x18\x01 \x03(\v2\x15.events.v1.EventTrendR\x06trends\"\xc0\x01\n" +
+	"\x1cGetEventCountByFormatRequest\x12,\n" +
+	"\x0forganization_id\x18\x01 \x01(\x05H\x00R\x0eorganizationId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tH\x01R\tstartDate\x88\x01\x01\x12\x1e\n" +
+	"\bend_date\x18\x03 \x01(\tH\x02R\aendDate\x88\x01\x01B\x12\n" +
+	"\x10_organization_idB\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_date\"\xa0\x01\n" +
+	"\x1dGetEventCountByFormatResponse\x12!\n" +
+	"\fonline_count\x18\x01 \x01(\x05R\vonlineCount\x12#\n" +
+	"\roffline_count\x18\x02 \x01(\x05R\fofflineCount\x12!\n" +
+	"\fhybrid_count\x18\x03 \x01(\x05R\vhybridCount\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\"\xe9\x02\n" +
 	"\x0fClubLeaderboard\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\x05R\x0eorganizationId\x12-\n" +
 	"\x12organization_title\x18\x02 \x01(\tR\x11organizationTitle\x122\n" +
@@ -6825,12 +12498,27 @@ const file_eventsv1_events_proto_rawDesc = "" +
 	"\x13total_registrations\x18\x05 \x01(\x05R\x12totalRegistrations\x12'\n" +
 	"\x0ftotal_attendees\x18\x06 \x01(\x05R\x0etotalAttendees\x126\n" +
 	"\x17average_attendance_rate\x18\a \x01(\x01R\x15averageAttendanceRateB\x15\n" +
-	"\x13_organization_image\"H\n" +
+	"\x13_organization_image\"\x97\x01\n" +
 	"\x1cGetTopPerformingClubsRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x12\n" +
-	"\x04days\x18\x02 \x01(\x05R\x04days\"Q\n" +
-	"\x1dGetTopPerformingClubsResponse\x120\n" +
-	"\x05clubs\x18\x01 \x03(\v2\x1a.events.v1.ClubLeaderboardR\x05clubs\" \n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x129\n" +
+	"\asort_by\x18\x04 \x01(\x0e2 .events.v1.ClubLeaderboardSortByR\x06sortBy\"o\n" +
+	"\x1dGetTopPerformingClubsResponse\x124\n" +
+	"\x05clubs\x18\x01 \x03(\v2\x1a.events.v1.ClubLeaderboardB\x02\x18\x01R\x05clubs\x12\x18\n" +
+	"\x05total\x18\x02 \x01(\x05B\x02\x18\x01R\x05total\"\x98\x01\n" +
+	"\x19GetClubLeaderboardRequest\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\x129\n" +
+	"\asort_by\x18\x04 \x01(\x0e2 .events.v1.ClubLeaderboardSortByR\x06sortBy\"\xa2\x01\n" +
+	"\x17ClubLeaderboardResponse\x120\n" +
+	"\x05clubs\x18\x01 \x03(\v2\x1a.events.v1.ClubLeaderboardR\x05clubs\x12$\n" +
+	"\vnext_cursor\x18\x02 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01\x12\x1f\n" +
+	"\vtotal_clubs\x18\x03 \x01(\x05R\n" +
+	"totalClubsB\x0e\n" +
+	"\f_next_cursor\" \n" +
 	"\x1eGetUserEngagementLevelsRequest\"a\n" +
 	"\x13UserEngagementLevel\x12\x14\n" +
 	"\x05level\x18\x01 \x01(\tR\x05level\x12\x14\n" +
@@ -6857,12 +12545,15 @@ const file_eventsv1_events_proto_rawDesc = "" +
 	"\x0fattendance_rate\x18\b \x01(\x01R\x0eattendanceRateB\f\n" +
 	"\n" +
 	"_image_urlB\x0f\n" +
-	"\r_organization\"I\n" +
+	"\r_organization\"\x92\x01\n" +
 	"\x1dGetTopPerformingEventsRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x12\n" +
-	"\x04days\x18\x02 \x01(\x05R\x04days\"W\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x123\n" +
+	"\asort_by\x18\x04 \x01(\x0e2\x1a.events.v1.TopEventsSortByR\x06sortBy\"m\n" +
 	"\x1eGetTopPerformingEventsResponse\x125\n" +
-	"\x06events\x18\x01 \x03(\v2\x1d.events.v1.TopPerformingEventR\x06events\"\x88\x03\n" +
+	"\x06events\x18\x01 \x03(\v2\x1d.events.v1.TopPerformingEventR\x06events\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x88\x03\n" +
 	"\x14LowRegistrationEvent\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -6898,7 +12589,18 @@ const file_eventsv1_events_proto_rawDesc = "" +
 	"\x1eGetOrganizationActivityRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\"h\n" +
 	"\x1fGetOrganizationActivityResponse\x12E\n" +
-	"\rorganizations\x18\x01 \x03(\v2\x1f.events.v1.OrganizationActivityR\rorganizations\"^\n" +
+	"\rorganizations\x18\x01 \x03(\v2\x1f.events.v1.OrganizationActivityR\rorganizations\"b\n" +
+	"#GetStatisticsForOrganizationRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\x05R\x0eorganizationId\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\"\xd5\x02\n" +
+	"\x1eOrganizationStatisticsResponse\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\x05R\x0eorganizationId\x12!\n" +
+	"\ftotal_events\x18\x02 \x01(\x05R\vtotalEvents\x12/\n" +
+	"\x13total_registrations\x18\x03 \x01(\x05R\x12totalRegistrations\x12'\n" +
+	"\x0ftotal_attendees\x18\x04 \x01(\x05R\x0etotalAttendees\x12'\n" +
+	"\x0fattendance_rate\x18\x05 \x01(\x01R\x0eattendanceRate\x125\n" +
+	"\btop_tags\x18\x06 \x03(\v2\x1a.events.v1.TagDistributionR\atopTags\x12-\n" +
+	"\x06trends\x18\a \x03(\v2\x15.events.v1.EventTrendR\x06trends\"^\n" +
 	"\x1dGetEventImageUploadUrlRequest\x12\x1a\n" +
 	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
 	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\"}\n" +
@@ -6908,66 +12610,210 @@ const file_eventsv1_events_proto_rawDesc = "" +
 	"\n" +
 	"public_url\x18\x02 \x01(\tR\tpublicUrl\x12\x1d\n" +
 	"\n" +
-	"object_key\x18\x03 \x01(\tR\tobjectKey*^\n" +
+	"object_key\x18\x03 \x01(\tR\tobjectKey\"\xa7\x01\n" +
+	"\aWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\x12\x1b\n" +
+	"\tis_active\x18\x04 \x01(\bR\bisActive\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\"\xf9\x03\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x02 \x01(\x05R\twebhookId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\tR\teventType\x12!\n" +
+	"\fpayload_hash\x18\x04 \x01(\tR\vpayloadHash\x12\x18\n" +
+	"\aattempt\x18\x05 \x01(\x05R\aattempt\x128\n" +
+	"\x06status\x18\x06 \x01(\x0e2 .events.v1.WebhookDeliveryStatusR\x06status\x12$\n" +
+	"\vhttp_status\x18\a \x01(\x05H\x00R\n" +
+	"httpStatus\x88\x01\x01\x12(\n" +
+	"\rresponse_body\x18\b \x01(\tH\x01R\fresponseBody\x88\x01\x01\x12&\n" +
+	"\fattempted_at\x18\t \x01(\tH\x02R\vattemptedAt\x88\x01\x01\x12'\n" +
+	"\rnext_retry_at\x18\n" +
+	" \x01(\tH\x03R\vnextRetryAt\x88\x01\x01\x12\x1c\n" +
+	"\tsucceeded\x18\v \x01(\bR\tsucceeded\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\tR\tcreatedAtB\x0e\n" +
+	"\f_http_statusB\x10\n" +
+	"\x0e_response_bodyB\x0f\n" +
+	"\r_attempted_atB\x10\n" +
+	"\x0e_next_retry_at\"I\n" +
+	"\x14CreateWebhookRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x02 \x03(\tR\n" +
+	"eventTypes\"]\n" +
+	"\x15CreateWebhookResponse\x12,\n" +
+	"\awebhook\x18\x01 \x01(\v2\x12.events.v1.WebhookR\awebhook\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"?\n" +
+	"\x13ListWebhooksRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\\\n" +
+	"\x14ListWebhooksResponse\x12.\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x12.events.v1.WebhookR\bwebhooks\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"&\n" +
+	"\x14DeleteWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"1\n" +
+	"\x15DeleteWebhookResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"f\n" +
+	"\x1bGetWebhookDeliveriesRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\x05R\twebhookId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"p\n" +
+	"\x1cGetWebhookDeliveriesResponse\x12:\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x1a.events.v1.WebhookDeliveryR\n" +
+	"deliveries\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\">\n" +
+	"\x1bRetryWebhookDeliveryRequest\x12\x1f\n" +
+	"\vdelivery_id\x18\x01 \x01(\x05R\n" +
+	"deliveryId\"V\n" +
+	"\x1cRetryWebhookDeliveryResponse\x126\n" +
+	"\bdelivery\x18\x01 \x01(\v2\x1a.events.v1.WebhookDeliveryR\bdelivery\"\xf7\x01\n" +
+	"\rAuditLogEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12+\n" +
+	"\x0factor_kratos_id\x18\x02 \x01(\tH\x00R\ractorKratosId\x88\x01\x01\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12#\n" +
+	"\rresource_type\x18\x04 \x01(\tR\fresourceType\x12\x1f\n" +
+	"\vresource_id\x18\x05 \x01(\tR\n" +
+	"resourceId\x12\x18\n" +
+	"\apayload\x18\x06 \x01(\tR\apayload\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAtB\x12\n" +
+	"\x10_actor_kratos_id\"\x86\x01\n" +
+	"\x14ListAuditLogsRequest\x12#\n" +
+	"\rresource_type\x18\x01 \x01(\tR\fresourceType\x12\x1f\n" +
+	"\vresource_id\x18\x02 \x01(\tR\n" +
+	"resourceId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"a\n" +
+	"\x15ListAuditLogsResponse\x122\n" +
+	"\aentries\x18\x01 \x03(\v2\x18.events.v1.AuditLogEntryR\aentries\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total*w\n" +
 	"\vEventFormat\x12\x1c\n" +
 	"\x18EVENT_FORMAT_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13EVENT_FORMAT_ONLINE\x10\x01\x12\x18\n" +
-	"\x14EVENT_FORMAT_OFFLINE\x10\x02*\x9b\x01\n" +
+	"\x14EVENT_FORMAT_OFFLINE\x10\x02\x12\x17\n" +
+	"\x13EVENT_FORMAT_HYBRID\x10\x03*i\n" +
+	"\bClubRole\x12\x19\n" +
+	"\x15CLUB_ROLE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13CLUB_ROLE_PRESIDENT\x10\x01\x12\x13\n" +
+	"\x0fCLUB_ROLE_STAFF\x10\x02\x12\x14\n" +
+	"\x10CLUB_ROLE_MEMBER\x10\x03*\x9b\x01\n" +
 	"\x12OrganizationStatus\x12#\n" +
 	"\x1fORGANIZATION_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
 	"\x1aORGANIZATION_STATUS_ACTIVE\x10\x01\x12 \n" +
 	"\x1cORGANIZATION_STATUS_ARCHIVED\x10\x02\x12\x1e\n" +
-	"\x1aORGANIZATION_STATUS_FROZEN\x10\x03*\xa2\x01\n" +
+	"\x1aORGANIZATION_STATUS_FROZEN\x10\x03*\x9e\x01\n" +
+	"\rInquiryStatus\x12\x1e\n" +
+	"\x1aINQUIRY_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13INQUIRY_STATUS_OPEN\x10\x01\x12\x1e\n" +
+	"\x1aINQUIRY_STATUS_IN_PROGRESS\x10\x02\x12\x1b\n" +
+	"\x17INQUIRY_STATUS_RESOLVED\x10\x03\x12\x17\n" +
+	"\x13INQUIRY_STATUS_SPAM\x10\x04*\xca\x01\n" +
 	"\x12RegistrationStatus\x12#\n" +
 	"\x1fREGISTRATION_STATUS_UNSPECIFIED\x10\x00\x12\"\n" +
 	"\x1eREGISTRATION_STATUS_REGISTERED\x10\x01\x12!\n" +
 	"\x1dREGISTRATION_STATUS_CANCELLED\x10\x02\x12 \n" +
-	"\x1cREGISTRATION_STATUS_WAITLIST\x10\x03*\x96\x01\n" +
+	"\x1cREGISTRATION_STATUS_WAITLIST\x10\x03\x12&\n" +
+	"\"REGISTRATION_STATUS_NOT_REGISTERED\x10\x04*\x96\x01\n" +
 	"\x10AttendanceStatus\x12!\n" +
 	"\x1dATTENDANCE_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
 	"\x1aATTENDANCE_STATUS_ATTENDED\x10\x01\x12\x1d\n" +
 	"\x19ATTENDANCE_STATUS_NO_SHOW\x10\x02\x12 \n" +
-	"\x1cATTENDANCE_STATUS_CHECKED_IN\x10\x032\xe0\x05\n" +
+	"\x1cATTENDANCE_STATUS_CHECKED_IN\x10\x03*\xd2\x01\n" +
+	"\x15WebhookDeliveryStatus\x12'\n" +
+	"#WEBHOOK_DELIVERY_STATUS_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fWEBHOOK_DELIVERY_STATUS_PENDING\x10\x01\x12%\n" +
+	"!WEBHOOK_DELIVERY_STATUS_SUCCEEDED\x10\x02\x12\"\n" +
+	"\x1eWEBHOOK_DELIVERY_STATUS_FAILED\x10\x03\x12 \n" +
+	"\x1cWEBHOOK_DELIVERY_STATUS_DEAD\x10\x04*J\n" +
+	"\tTagSortBy\x12\x1b\n" +
+	"\x17TAG_SORT_BY_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cTAG_SORT_BY_EVENT_COUNT_DESC\x10\x01*V\n" +
+	"\x10ManagedEventRole\x12\"\n" +
+	"\x1eMANAGED_EVENT_ROLE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aMANAGED_EVENT_ROLE_CREATOR\x10\x01*\xdf\x01\n" +
+	"\x15ClubLeaderboardSortBy\x12(\n" +
+	"$CLUB_LEADERBOARD_SORT_BY_UNSPECIFIED\x10\x00\x12.\n" +
+	"*CLUB_LEADERBOARD_SORT_BY_TOTAL_EVENTS_DESC\x10\x01\x125\n" +
+	"1CLUB_LEADERBOARD_SORT_BY_TOTAL_REGISTRATIONS_DESC\x10\x02\x125\n" +
+	"1CLUB_LEADERBOARD_SORT_BY_AVG_ATTENDANCE_RATE_DESC\x10\x03*\x93\x01\n" +
+	"\x0fTopEventsSortBy\x12\"\n" +
+	"\x1eTOP_EVENTS_SORT_BY_UNSPECIFIED\x10\x00\x12/\n" +
+	"+TOP_EVENTS_SORT_BY_TOTAL_REGISTRATIONS_DESC\x10\x01\x12+\n" +
+	"'TOP_EVENTS_SORT_BY_ATTENDANCE_RATE_DESC\x10\x022\xb4\f\n" +
 	"\x14OrganizationsService\x12a\n" +
 	"\x12CreateOrganization\x12$.events.v1.CreateOrganizationRequest\x1a%.events.v1.CreateOrganizationResponse\x12X\n" +
 	"\x0fGetOrganization\x12!.events.v1.GetOrganizationRequest\x1a\".events.v1.GetOrganizationResponse\x12^\n" +
 	"\x11ListOrganizations\x12#.events.v1.ListOrganizationsRequest\x1a$.events.v1.ListOrganizationsResponse\x12a\n" +
 	"\x12UpdateOrganization\x12$.events.v1.UpdateOrganizationRequest\x1a%.events.v1.UpdateOrganizationResponse\x12a\n" +
-	"\x12DeleteOrganization\x12$.events.v1.DeleteOrganizationRequest\x1a%.events.v1.DeleteOrganizationResponse\x12|\n" +
+	"\x12DeleteOrganization\x12$.events.v1.DeleteOrganizationRequest\x1a%.events.v1.DeleteOrganizationResponse\x12a\n" +
+	"\x12MergeOrganizations\x12$.events.v1.MergeOrganizationsRequest\x1a%.events.v1.MergeOrganizationsResponse\x12|\n" +
 	"\x1bGetPublishableOrganizations\x12-.events.v1.GetPublishableOrganizationsRequest\x1a..events.v1.GetPublishableOrganizationsResponse\x12g\n" +
-	"\x14GetUserOrganizations\x12&.events.v1.GetUserOrganizationsRequest\x1a'.events.v1.GetUserOrganizationsResponse2\xb9\x04\n" +
+	"\x14GetUserOrganizations\x12&.events.v1.GetUserOrganizationsRequest\x1a'.events.v1.GetUserOrganizationsResponse\x12v\n" +
+	"\x19GetOrganizationQuotaUsage\x12+.events.v1.GetOrganizationQuotaUsageRequest\x1a,.events.v1.GetOrganizationQuotaUsageResponse\x12m\n" +
+	"\x16GetOrganizationMembers\x12(.events.v1.GetOrganizationMembersRequest\x1a).events.v1.GetOrganizationMembersResponse\x12U\n" +
+	"\x0eAssignClubRole\x12 .events.v1.AssignClubRoleRequest\x1a!.events.v1.AssignClubRoleResponse\x12[\n" +
+	"\x10RemoveClubMember\x12\".events.v1.RemoveClubMemberRequest\x1a#.events.v1.RemoveClubMemberResponse\x12v\n" +
+	"\x19SubmitOrganizationInquiry\x12+.events.v1.SubmitOrganizationInquiryRequest\x1a,.events.v1.SubmitOrganizationInquiryResponse\x12v\n" +
+	"\x19ListOrganizationInquiries\x12+.events.v1.ListOrganizationInquiriesRequest\x1a,.events.v1.ListOrganizationInquiriesResponse\x12d\n" +
+	"\x13UpdateInquiryStatus\x12%.events.v1.UpdateInquiryStatusRequest\x1a&.events.v1.UpdateInquiryStatusResponse2\xb9\x04\n" +
 	"\x18OrganizationTypesService\x12m\n" +
 	"\x16CreateOrganizationType\x12(.events.v1.CreateOrganizationTypeRequest\x1a).events.v1.CreateOrganizationTypeResponse\x12d\n" +
 	"\x13GetOrganizationType\x12%.events.v1.GetOrganizationTypeRequest\x1a&.events.v1.GetOrganizationTypeResponse\x12j\n" +
 	"\x15ListOrganizationTypes\x12'.events.v1.ListOrganizationTypesRequest\x1a(.events.v1.ListOrganizationTypesResponse\x12m\n" +
 	"\x16UpdateOrganizationType\x12(.events.v1.UpdateOrganizationTypeRequest\x1a).events.v1.UpdateOrganizationTypeResponse\x12m\n" +
-	"\x16DeleteOrganizationType\x12(.events.v1.DeleteOrganizationTypeRequest\x1a).events.v1.DeleteOrganizationTypeResponse2\xaa\x06\n" +
+	"\x16DeleteOrganizationType\x12(.events.v1.DeleteOrganizationTypeRequest\x1a).events.v1.DeleteOrganizationTypeResponse2\xe9\f\n" +
 	"\rEventsService\x12L\n" +
-	"\vCreateEvent\x12\x1d.events.v1.CreateEventRequest\x1a\x1e.events.v1.CreateEventResponse\x12C\n" +
+	"\vCreateEvent\x12\x1d.events.v1.CreateEventRequest\x1a\x1e.events.v1.CreateEventResponse\x12[\n" +
+	"\x10BulkCreateEvents\x12\".events.v1.BulkCreateEventsRequest\x1a#.events.v1.BulkCreateEventsResponse\x12U\n" +
+	"\x0eDuplicateEvent\x12 .events.v1.DuplicateEventRequest\x1a!.events.v1.DuplicateEventResponse\x12C\n" +
 	"\bGetEvent\x12\x1a.events.v1.GetEventRequest\x1a\x1b.events.v1.GetEventResponse\x12I\n" +
 	"\n" +
 	"ListEvents\x12\x1c.events.v1.ListEventsRequest\x1a\x1d.events.v1.ListEventsResponse\x12a\n" +
 	"\x12ListEventsForAdmin\x12$.events.v1.ListEventsForAdminRequest\x1a%.events.v1.ListEventsForAdminResponse\x12L\n" +
 	"\vUpdateEvent\x12\x1d.events.v1.UpdateEventRequest\x1a\x1e.events.v1.UpdateEventResponse\x12L\n" +
-	"\vDeleteEvent\x12\x1d.events.v1.DeleteEventRequest\x1a\x1e.events.v1.DeleteEventResponse\x12[\n" +
-	"\x10GetEventsByTagId\x12\".events.v1.GetEventsByTagIdRequest\x1a#.events.v1.GetEventsByTagIdResponse\x12p\n" +
-	"\x17GetUserSubscribedEvents\x12).events.v1.GetUserSubscribedEventsRequest\x1a*.events.v1.GetUserSubscribedEventsResponse\x12m\n" +
-	"\x16GetEventImageUploadUrl\x12(.events.v1.GetEventImageUploadUrlRequest\x1a).events.v1.GetEventImageUploadUrlResponse2\xe9\x02\n" +
+	"\vDeleteEvent\x12\x1d.events.v1.DeleteEventRequest\x1a\x1e.events.v1.DeleteEventResponse\x12O\n" +
+	"\fRestoreEvent\x12\x1e.events.v1.RestoreEventRequest\x1a\x1f.events.v1.RestoreEventResponse\x12^\n" +
+	"\x11ListDeletedEvents\x12#.events.v1.ListDeletedEventsRequest\x1a$.events.v1.ListDeletedEventsResponse\x12j\n" +
+	"\x15ToggleEventVisibility\x12'.events.v1.ToggleEventVisibilityRequest\x1a(.events.v1.ToggleEventVisibilityResponse\x12[\n" +
+	"\x10GetEventsByTagId\x12\".events.v1.GetEventsByTagIdRequest\x1a#.events.v1.GetEventsByTagIdResponse\x12a\n" +
+	"\x12GetEventsByAllTags\x12$.events.v1.GetEventsByAllTagsRequest\x1a%.events.v1.GetEventsByAllTagsResponse\x12p\n" +
+	"\x17GetUserSubscribedEvents\x12).events.v1.GetUserSubscribedEventsRequest\x1a*.events.v1.GetUserSubscribedEventsResponse\x12[\n" +
+	"\x10GetManagedEvents\x12\".events.v1.GetManagedEventsRequest\x1a#.events.v1.GetManagedEventsResponse\x12O\n" +
+	"\fGetEventFeed\x12\x1e.events.v1.GetEventFeedRequest\x1a\x1f.events.v1.GetEventFeedResponse\x12[\n" +
+	"\x10GetEventCalendar\x12\".events.v1.GetEventCalendarRequest\x1a#.events.v1.GetEventCalendarResponse\x12m\n" +
+	"\x16GetEventImageUploadUrl\x12(.events.v1.GetEventImageUploadUrlRequest\x1a).events.v1.GetEventImageUploadUrlResponse2\xb1\x03\n" +
 	"\vTagsService\x12F\n" +
 	"\tCreateTag\x12\x1b.events.v1.CreateTagRequest\x1a\x1c.events.v1.CreateTagResponse\x12=\n" +
 	"\x06GetTag\x12\x18.events.v1.GetTagRequest\x1a\x19.events.v1.GetTagResponse\x12C\n" +
 	"\bListTags\x12\x1a.events.v1.ListTagsRequest\x1a\x1b.events.v1.ListTagsResponse\x12F\n" +
 	"\tUpdateTag\x12\x1b.events.v1.UpdateTagRequest\x1a\x1c.events.v1.UpdateTagResponse\x12F\n" +
-	"\tDeleteTag\x12\x1b.events.v1.DeleteTagRequest\x1a\x1c.events.v1.DeleteTagResponse2\xb0\x03\n" +
+	"\tDeleteTag\x12\x1b.events.v1.DeleteTagRequest\x1a\x1c.events.v1.DeleteTagResponse\x12F\n" +
+	"\tMergeTags\x12\x1b.events.v1.MergeTagsRequest\x1a\x1c.events.v1.MergeTagsResponse2\xdd\x06\n" +
 	"\x19EventRegistrationsService\x12[\n" +
 	"\x10RegisterForEvent\x12\".events.v1.RegisterForEventRequest\x1a#.events.v1.RegisterForEventResponse\x12a\n" +
 	"\x12CancelRegistration\x12$.events.v1.CancelRegistrationRequest\x1a%.events.v1.CancelRegistrationResponse\x12j\n" +
 	"\x15GetEventRegistrations\x12'.events.v1.GetEventRegistrationsRequest\x1a(.events.v1.GetEventRegistrationsResponse\x12g\n" +
-	"\x14GetUserRegistrations\x12&.events.v1.GetUserRegistrationsRequest\x1a'.events.v1.GetUserRegistrationsResponse2\xac\x02\n" +
+	"\x14GetUserRegistrations\x12&.events.v1.GetUserRegistrationsRequest\x1a'.events.v1.GetUserRegistrationsResponse\x12j\n" +
+	"\x15HoldEventRegistration\x12'.events.v1.HoldEventRegistrationRequest\x1a(.events.v1.HoldEventRegistrationResponse\x12d\n" +
+	"\x13ConfirmRegistration\x12%.events.v1.ConfirmRegistrationRequest\x1a&.events.v1.ConfirmRegistrationResponse\x12^\n" +
+	"\x11NotifyRegistrants\x12#.events.v1.NotifyRegistrantsRequest\x1a$.events.v1.NotifyRegistrantsResponse\x12y\n" +
+	"\x1aGetEventRegistrationStatus\x12,.events.v1.GetEventRegistrationStatusRequest\x1a-.events.v1.GetEventRegistrationStatusResponse2\xc0\x05\n" +
 	"\x16EventAttendanceService\x12X\n" +
-	"\x0fCheckInAttendee\x12!.events.v1.CheckInAttendeeRequest\x1a\".events.v1.CheckInAttendeeResponse\x12U\n" +
+	"\x0fCheckInAttendee\x12!.events.v1.CheckInAttendeeRequest\x1a\".events.v1.CheckInAttendeeResponse\x12L\n" +
+	"\vBulkCheckIn\x12\x1d.events.v1.BulkCheckInRequest\x1a\x1e.events.v1.BulkCheckInResponse\x12U\n" +
 	"\x0eMarkAttendance\x12 .events.v1.MarkAttendanceRequest\x1a!.events.v1.MarkAttendanceResponse\x12a\n" +
-	"\x12GetEventAttendance\x12$.events.v1.GetEventAttendanceRequest\x1a%.events.v1.GetEventAttendanceResponse2\xd3\t\n" +
+	"\x12GetEventAttendance\x12$.events.v1.GetEventAttendanceRequest\x1a%.events.v1.GetEventAttendanceResponse\x12l\n" +
+	"\x15StreamEventAttendance\x12'.events.v1.StreamEventAttendanceRequest\x1a(.events.v1.StreamEventAttendanceResponse0\x01\x12d\n" +
+	"\x15ExportEventAttendance\x12'.events.v1.ExportEventAttendanceRequest\x1a .events.v1.AttendanceExportChunk0\x01\x12p\n" +
+	"\x18GetUserAttendanceHistory\x12*.events.v1.GetUserAttendanceHistoryRequest\x1a(.events.v1.UserAttendanceHistoryResponse2\x9a\f\n" +
 	"\x11StatisticsService\x12m\n" +
 	"\x16GetDashboardStatistics\x12(.events.v1.GetDashboardStatisticsRequest\x1a).events.v1.GetDashboardStatisticsResponse\x12a\n" +
 	"\x12GetEventStatistics\x12$.events.v1.GetEventStatisticsRequest\x1a%.events.v1.GetEventStatisticsResponse\x12\x88\x01\n" +
@@ -6975,11 +12821,22 @@ const file_eventsv1_events_proto_rawDesc = "" +
 	"\x16GetEventActivityByYear\x12(.events.v1.GetEventActivityByYearRequest\x1a).events.v1.GetEventActivityByYearResponse\x12g\n" +
 	"\x14GetOverallStatistics\x12&.events.v1.GetOverallStatisticsRequest\x1a'.events.v1.GetOverallStatisticsResponse\x12U\n" +
 	"\x0eGetEventTrends\x12 .events.v1.GetEventTrendsRequest\x1a!.events.v1.GetEventTrendsResponse\x12j\n" +
-	"\x15GetTopPerformingClubs\x12'.events.v1.GetTopPerformingClubsRequest\x1a(.events.v1.GetTopPerformingClubsResponse\x12p\n" +
+	"\x15GetEventCountByFormat\x12'.events.v1.GetEventCountByFormatRequest\x1a(.events.v1.GetEventCountByFormatResponse\x12j\n" +
+	"\x15GetTopPerformingClubs\x12'.events.v1.GetTopPerformingClubsRequest\x1a(.events.v1.GetTopPerformingClubsResponse\x12^\n" +
+	"\x12GetClubLeaderboard\x12$.events.v1.GetClubLeaderboardRequest\x1a\".events.v1.ClubLeaderboardResponse\x12p\n" +
 	"\x17GetUserEngagementLevels\x12).events.v1.GetUserEngagementLevelsRequest\x1a*.events.v1.GetUserEngagementLevelsResponse\x12m\n" +
 	"\x16GetTopPerformingEvents\x12(.events.v1.GetTopPerformingEventsRequest\x1a).events.v1.GetTopPerformingEventsResponse\x12s\n" +
 	"\x18GetLowRegistrationEvents\x12*.events.v1.GetLowRegistrationEventsRequest\x1a+.events.v1.GetLowRegistrationEventsResponse\x12p\n" +
-	"\x17GetOrganizationActivity\x12).events.v1.GetOrganizationActivityRequest\x1a*.events.v1.GetOrganizationActivityResponseB\x9a\x01\n" +
+	"\x17GetOrganizationActivity\x12).events.v1.GetOrganizationActivityRequest\x1a*.events.v1.GetOrganizationActivityResponse\x12y\n" +
+	"\x1cGetStatisticsForOrganization\x12..events.v1.GetStatisticsForOrganizationRequest\x1a).events.v1.OrganizationStatisticsResponse2\xdc\x03\n" +
+	"\x0fWebhooksService\x12R\n" +
+	"\rCreateWebhook\x12\x1f.events.v1.CreateWebhookRequest\x1a .events.v1.CreateWebhookResponse\x12O\n" +
+	"\fListWebhooks\x12\x1e.events.v1.ListWebhooksRequest\x1a\x1f.events.v1.ListWebhooksResponse\x12R\n" +
+	"\rDeleteWebhook\x12\x1f.events.v1.DeleteWebhookRequest\x1a .events.v1.DeleteWebhookResponse\x12g\n" +
+	"\x14GetWebhookDeliveries\x12&.events.v1.GetWebhookDeliveriesRequest\x1a'.events.v1.GetWebhookDeliveriesResponse\x12g\n" +
+	"\x14RetryWebhookDelivery\x12&.events.v1.RetryWebhookDeliveryRequest\x1a'.events.v1.RetryWebhookDeliveryResponse2b\n" +
+	"\fAuditService\x12R\n" +
+	"\rListAuditLogs\x12\x1f.events.v1.ListAuditLogsRequest\x1a .events.v1.ListAuditLogsResponseB\x9a\x01\n" +
 	"\rcom.events.v1B\vEventsProtoP\x01Z7github.com/studyverse/ems-backend/gen/eventsv1;eventsv1\xa2\x02\x03EXX\xaa\x02\tEvents.V1\xca\x02\tEvents\\V1\xe2\x02\x15Events\\V1\\GPBMetadata\xea\x02\n" +
 	"Events::V1b\x06proto3"
 
@@ -6995,263 +12852,471 @@ func file_eventsv1_events_proto_rawDescGZIP() []byte {
 	return file_eventsv1_events_proto_rawDescData
 }
 
-var file_eventsv1_events_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_eventsv1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 105)
+var file_eventsv1_events_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_eventsv1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 186)
 var file_eventsv1_events_proto_goTypes = []any{
 	(EventFormat)(0),                                // 0: events.v1.EventFormat
-	(OrganizationStatus)(0),                         // 1: events.v1.OrganizationStatus
-	(RegistrationStatus)(0),                         // 2: events.v1.RegistrationStatus
-	(AttendanceStatus)(0),                           // 3: events.v1.AttendanceStatus
-	(*OrganizationType)(nil),                        // 4: events.v1.OrganizationType
-	(*Organization)(nil),                            // 5: events.v1.Organization
-	(*Tag)(nil),                                     // 6: events.v1.Tag
-	(*Event)(nil),                                   // 7: events.v1.Event
-	(*EventRegistration)(nil),                       // 8: events.v1.EventRegistration
-	(*EventAttendance)(nil),                         // 9: events.v1.EventAttendance
-	(*EventStatistics)(nil),                         // 10: events.v1.EventStatistics
-	(*EventStats)(nil),                              // 11: events.v1.EventStats
-	(*CreateOrganizationRequest)(nil),               // 12: events.v1.CreateOrganizationRequest
-	(*CreateOrganizationResponse)(nil),              // 13: events.v1.CreateOrganizationResponse
-	(*GetOrganizationRequest)(nil),                  // 14: events.v1.GetOrganizationRequest
-	(*GetOrganizationResponse)(nil),                 // 15: events.v1.GetOrganizationResponse
-	(*ListOrganizationsRequest)(nil),                // 16: events.v1.ListOrganizationsRequest
-	(*ListOrganizationsResponse)(nil),               // 17: events.v1.ListOrganizationsResponse
-	(*UpdateOrganizationRequest)(nil),               // 18: events.v1.UpdateOrganizationRequest
-	(*UpdateOrganizationResponse)(nil),              // 19: events.v1.UpdateOrganizationResponse
-	(*DeleteOrganizationRequest)(nil),               // 20: events.v1.DeleteOrganizationRequest
-	(*DeleteOrganizationResponse)(nil),              // 21: events.v1.DeleteOrganizationResponse
-	(*CreateOrganizationTypeRequest)(nil),           // 22: events.v1.CreateOrganizationTypeRequest
-	(*CreateOrganizationTypeResponse)(nil),          // 23: events.v1.CreateOrganizationTypeResponse
-	(*GetOrganizationTypeRequest)(nil),              // 24: events.v1.GetOrganizationTypeRequest
-	(*GetOrganizationTypeResponse)(nil),             // 25: events.v1.GetOrganizationTypeResponse
-	(*ListOrganizationTypesRequest)(nil),            // 26: events.v1.ListOrganizationTypesRequest
-	(*ListOrganizationTypesResponse)(nil),           // 27: events.v1.ListOrganizationTypesResponse
-	(*UpdateOrganizationTypeRequest)(nil),           // 28: events.v1.UpdateOrganizationTypeRequest
-	(*UpdateOrganizationTypeResponse)(nil),          // 29: events.v1.UpdateOrganizationTypeResponse
-	(*DeleteOrganizationTypeRequest)(nil),           // 30: events.v1.DeleteOrganizationTypeRequest
-	(*DeleteOrganizationTypeResponse)(nil),          // 31: events.v1.DeleteOrganizationTypeResponse
-	(*CreateEventRequest)(nil),                      // 32: events.v1.CreateEventRequest
-	(*CreateEventResponse)(nil),                     // 33: events.v1.CreateEventResponse
-	(*GetEventRequest)(nil),                         // 34: events.v1.GetEventRequest
-	(*GetEventResponse)(nil),                        // 35: events.v1.GetEventResponse
-	(*ListEventsRequest)(nil),                       // 36: events.v1.ListEventsRequest
-	(*ListEventsResponse)(nil),                      // 37: events.v1.ListEventsResponse
-	(*UpdateEventRequest)(nil),                      // 38: events.v1.UpdateEventRequest
-	(*UpdateEventResponse)(nil),                     // 39: events.v1.UpdateEventResponse
-	(*DeleteEventRequest)(nil),                      // 40: events.v1.DeleteEventRequest
-	(*DeleteEventResponse)(nil),                     // 41: events.v1.DeleteEventResponse
-	(*CreateTagRequest)(nil),                        // 42: events.v1.CreateTagRequest
-	(*CreateTagResponse)(nil),                       // 43: events.v1.CreateTagResponse
-	(*GetTagRequest)(nil),                           // 44: events.v1.GetTagRequest
-	(*GetTagResponse)(nil),                          // 45: events.v1.GetTagResponse
-	(*ListTagsRequest)(nil),                         // 46: events.v1.ListTagsRequest
-	(*ListTagsResponse)(nil),                        // 47: events.v1.ListTagsResponse
-	(*UpdateTagRequest)(nil),                        // 48: events.v1.UpdateTagRequest
-	(*UpdateTagResponse)(nil),                       // 49: events.v1.UpdateTagResponse
-	(*DeleteTagRequest)(nil),                        // 50: events.v1.DeleteTagRequest
-	(*DeleteTagResponse)(nil),                       // 51: events.v1.DeleteTagResponse
-	(*GetPublishableOrganizationsRequest)(nil),      // 52: events.v1.GetPublishableOrganizationsRequest
-	(*GetPublishableOrganizationsResponse)(nil),     // 53: events.v1.GetPublishableOrganizationsResponse
-	(*GetUserOrganizationsRequest)(nil),             // 54: events.v1.GetUserOrganizationsRequest
-	(*GetUserOrganizationsResponse)(nil),            // 55: events.v1.GetUserOrganizationsResponse
-	(*GetEventsByTagIdRequest)(nil),                 // 56: events.v1.GetEventsByTagIdRequest
-	(*GetEventsByTagIdResponse)(nil),                // 57: events.v1.GetEventsByTagIdResponse
-	(*GetUserSubscribedEventsRequest)(nil),          // 58: events.v1.GetUserSubscribedEventsRequest
-	(*GetUserSubscribedEventsResponse)(nil),         // 59: events.v1.GetUserSubscribedEventsResponse
-	(*ListEventsForAdminRequest)(nil),               // 60: events.v1.ListEventsForAdminRequest
-	(*ListEventsForAdminResponse)(nil),              // 61: events.v1.ListEventsForAdminResponse
-	(*RegisterForEventRequest)(nil),                 // 62: events.v1.RegisterForEventRequest
-	(*RegisterForEventResponse)(nil),                // 63: events.v1.RegisterForEventResponse
-	(*CancelRegistrationRequest)(nil),               // 64: events.v1.CancelRegistrationRequest
-	(*CancelRegistrationResponse)(nil),              // 65: events.v1.CancelRegistrationResponse
-	(*GetEventRegistrationsRequest)(nil),            // 66: events.v1.GetEventRegistrationsRequest
-	(*GetEventRegistrationsResponse)(nil),           // 67: events.v1.GetEventRegistrationsResponse
-	(*GetUserRegistrationsRequest)(nil),             // 68: events.v1.GetUserRegistrationsRequest
-	(*GetUserRegistrationsResponse)(nil),            // 69: events.v1.GetUserRegistrationsResponse
-	(*CheckInAttendeeRequest)(nil),                  // 70: events.v1.CheckInAttendeeRequest
-	(*CheckInAttendeeResponse)(nil),                 // 71: events.v1.CheckInAttendeeResponse
-	(*MarkAttendanceRequest)(nil),                   // 72: events.v1.MarkAttendanceRequest
-	(*MarkAttendanceResponse)(nil),                  // 73: events.v1.MarkAttendanceResponse
-	(*GetEventAttendanceRequest)(nil),               // 74: events.v1.GetEventAttendanceRequest
-	(*GetEventAttendanceResponse)(nil),              // 75: events.v1.GetEventAttendanceResponse
-	(*GetDashboardStatisticsRequest)(nil),           // 76: events.v1.GetDashboardStatisticsRequest
-	(*GetDashboardStatisticsResponse)(nil),          // 77: events.v1.GetDashboardStatisticsResponse
-	(*GetEventStatisticsRequest)(nil),               // 78: events.v1.GetEventStatisticsRequest
-	(*GetEventStatisticsResponse)(nil),              // 79: events.v1.GetEventStatisticsResponse
-	(*TagDistribution)(nil),                         // 80: events.v1.TagDistribution
-	(*GetEventTagsDistributionByMonthRequest)(nil),  // 81: events.v1.GetEventTagsDistributionByMonthRequest
-	(*GetEventTagsDistributionByMonthResponse)(nil), // 82: events.v1.GetEventTagsDistributionByMonthResponse
-	(*EventActivity)(nil),                           // 83: events.v1.EventActivity
-	(*GetEventActivityByYearRequest)(nil),           // 84: events.v1.GetEventActivityByYearRequest
-	(*GetEventActivityByYearResponse)(nil),          // 85: events.v1.GetEventActivityByYearResponse
-	(*EventStatsSummary)(nil),                       // 86: events.v1.EventStatsSummary
-	(*GetOverallStatisticsRequest)(nil),             // 87: events.v1.GetOverallStatisticsRequest
-	(*GetOverallStatisticsResponse)(nil),            // 88: events.v1.GetOverallStatisticsResponse
-	(*EventTrend)(nil),                              // 89: events.v1.EventTrend
-	(*GetEventTrendsRequest)(nil),                   // 90: events.v1.GetEventTrendsRequest
-	(*GetEventTrendsResponse)(nil),                  // 91: events.v1.GetEventTrendsResponse
-	(*ClubLeaderboard)(nil),                         // 92: events.v1.ClubLeaderboard
-	(*GetTopPerformingClubsRequest)(nil),            // 93: events.v1.GetTopPerformingClubsRequest
-	(*GetTopPerformingClubsResponse)(nil),           // 94: events.v1.GetTopPerformingClubsResponse
-	(*GetUserEngagementLevelsRequest)(nil),          // 95: events.v1.GetUserEngagementLevelsRequest
-	(*UserEngagementLevel)(nil),                     // 96: events.v1.UserEngagementLevel
-	(*GetUserEngagementLevelsResponse)(nil),         // 97: events.v1.GetUserEngagementLevelsResponse
-	(*TopPerformingEvent)(nil),                      // 98: events.v1.TopPerformingEvent
-	(*GetTopPerformingEventsRequest)(nil),           // 99: events.v1.GetTopPerformingEventsRequest
-	(*GetTopPerformingEventsResponse)(nil),          // 100: events.v1.GetTopPerformingEventsResponse
-	(*LowRegistrationEvent)(nil),                    // 101: events.v1.LowRegistrationEvent
-	(*GetLowRegistrationEventsRequest)(nil),         // 102: events.v1.GetLowRegistrationEventsRequest
-	(*GetLowRegistrationEventsResponse)(nil),        // 103: events.v1.GetLowRegistrationEventsResponse
-	(*OrganizationActivity)(nil),                    // 104: events.v1.OrganizationActivity
-	(*GetOrganizationActivityRequest)(nil),          // 105: events.v1.GetOrganizationActivityRequest
-	(*GetOrganizationActivityResponse)(nil),         // 106: events.v1.GetOrganizationActivityResponse
-	(*GetEventImageUploadUrlRequest)(nil),           // 107: events.v1.GetEventImageUploadUrlRequest
-	(*GetEventImageUploadUrlResponse)(nil),          // 108: events.v1.GetEventImageUploadUrlResponse
+	(ClubRole)(0),                                   // 1: events.v1.ClubRole
+	(OrganizationStatus)(0),                         // 2: events.v1.OrganizationStatus
+	(InquiryStatus)(0),                              // 3: events.v1.InquiryStatus
+	(RegistrationStatus)(0),                         // 4: events.v1.RegistrationStatus
+	(AttendanceStatus)(0),                           // 5: events.v1.AttendanceStatus
+	(WebhookDeliveryStatus)(0),                      // 6: events.v1.WebhookDeliveryStatus
+	(TagSortBy)(0),                                  // 7: events.v1.TagSortBy
+	(ManagedEventRole)(0),                           // 8: events.v1.ManagedEventRole
+	(ClubLeaderboardSortBy)(0),                      // 9: events.v1.ClubLeaderboardSortBy
+	(TopEventsSortBy)(0),                            // 10: events.v1.TopEventsSortBy
+	(*OrganizationType)(nil),                        // 11: events.v1.OrganizationType
+	(*Organization)(nil),                            // 12: events.v1.Organization
+	(*Tag)(nil),                                     // 13: events.v1.Tag
+	(*Event)(nil),                                   // 14: events.v1.Event
+	(*EventRegistration)(nil),                       // 15: events.v1.EventRegistration
+	(*EventAttendance)(nil),                         // 16: events.v1.EventAttendance
+	(*EventStatistics)(nil),                         // 17: events.v1.EventStatistics
+	(*EventStats)(nil),                              // 18: events.v1.EventStats
+	(*CreateOrganizationRequest)(nil),               // 19: events.v1.CreateOrganizationRequest
+	(*CreateOrganizationResponse)(nil),              // 20: events.v1.CreateOrganizationResponse
+	(*GetOrganizationRequest)(nil),                  // 21: events.v1.GetOrganizationRequest
+	(*GetOrganizationResponse)(nil),                 // 22: events.v1.GetOrganizationResponse
+	(*ListOrganizationsRequest)(nil),                // 23: events.v1.ListOrganizationsRequest
+	(*ListOrganizationsResponse)(nil),               // 24: events.v1.ListOrganizationsResponse
+	(*UpdateOrganizationRequest)(nil),               // 25: events.v1.UpdateOrganizationRequest
+	(*UpdateOrganizationResponse)(nil),              // 26: events.v1.UpdateOrganizationResponse
+	(*GetOrganizationQuotaUsageRequest)(nil),        // 27: events.v1.GetOrganizationQuotaUsageRequest
+	(*GetOrganizationQuotaUsageResponse)(nil),       // 28: events.v1.GetOrganizationQuotaUsageResponse
+	(*OrganizationMember)(nil),                      // 29: events.v1.OrganizationMember
+	(*GetOrganizationMembersRequest)(nil),           // 30: events.v1.GetOrganizationMembersRequest
+	(*GetOrganizationMembersResponse)(nil),          // 31: events.v1.GetOrganizationMembersResponse
+	(*AssignClubRoleRequest)(nil),                   // 32: events.v1.AssignClubRoleRequest
+	(*AssignClubRoleResponse)(nil),                  // 33: events.v1.AssignClubRoleResponse
+	(*RemoveClubMemberRequest)(nil),                 // 34: events.v1.RemoveClubMemberRequest
+	(*RemoveClubMemberResponse)(nil),                // 35: events.v1.RemoveClubMemberResponse
+	(*OrganizationInquiry)(nil),                     // 36: events.v1.OrganizationInquiry
+	(*SubmitOrganizationInquiryRequest)(nil),        // 37: events.v1.SubmitOrganizationInquiryRequest
+	(*SubmitOrganizationInquiryResponse)(nil),       // 38: events.v1.SubmitOrganizationInquiryResponse
+	(*ListOrganizationInquiriesRequest)(nil),        // 39: events.v1.ListOrganizationInquiriesRequest
+	(*ListOrganizationInquiriesResponse)(nil),       // 40: events.v1.ListOrganizationInquiriesResponse
+	(*UpdateInquiryStatusRequest)(nil),              // 41: events.v1.UpdateInquiryStatusRequest
+	(*UpdateInquiryStatusResponse)(nil),             // 42: events.v1.UpdateInquiryStatusResponse
+	(*MergeOrganizationsRequest)(nil),               // 43: events.v1.MergeOrganizationsRequest
+	(*MergeOrganizationsResponse)(nil),              // 44: events.v1.MergeOrganizationsResponse
+	(*DeleteOrganizationRequest)(nil),               // 45: events.v1.DeleteOrganizationRequest
+	(*DeleteOrganizationResponse)(nil),              // 46: events.v1.DeleteOrganizationResponse
+	(*CreateOrganizationTypeRequest)(nil),           // 47: events.v1.CreateOrganizationTypeRequest
+	(*CreateOrganizationTypeResponse)(nil),          // 48: events.v1.CreateOrganizationTypeResponse
+	(*GetOrganizationTypeRequest)(nil),              // 49: events.v1.GetOrganizationTypeRequest
+	(*GetOrganizationTypeResponse)(nil),             // 50: events.v1.GetOrganizationTypeResponse
+	(*ListOrganizationTypesRequest)(nil),            // 51: events.v1.ListOrganizationTypesRequest
+	(*ListOrganizationTypesResponse)(nil),           // 52: events.v1.ListOrganizationTypesResponse
+	(*UpdateOrganizationTypeRequest)(nil),           // 53: events.v1.UpdateOrganizationTypeRequest
+	(*UpdateOrganizationTypeResponse)(nil),          // 54: events.v1.UpdateOrganizationTypeResponse
+	(*DeleteOrganizationTypeRequest)(nil),           // 55: events.v1.DeleteOrganizationTypeRequest
+	(*DeleteOrganizationTypeResponse)(nil),          // 56: events.v1.DeleteOrganizationTypeResponse
+	(*CreateEventRequest)(nil),                      // 57: events.v1.CreateEventRequest
+	(*CreateEventResponse)(nil),                     // 58: events.v1.CreateEventResponse
+	(*BulkCreateEventsRequest)(nil),                 // 59: events.v1.BulkCreateEventsRequest
+	(*BulkCreateEventsResponse)(nil),                // 60: events.v1.BulkCreateEventsResponse
+	(*DuplicateEventRequest)(nil),                   // 61: events.v1.DuplicateEventRequest
+	(*DuplicateEventResponse)(nil),                  // 62: events.v1.DuplicateEventResponse
+	(*GetEventRequest)(nil),                         // 63: events.v1.GetEventRequest
+	(*GetEventResponse)(nil),                        // 64: events.v1.GetEventResponse
+	(*ListEventsRequest)(nil),                       // 65: events.v1.ListEventsRequest
+	(*ListEventsResponse)(nil),                      // 66: events.v1.ListEventsResponse
+	(*UpdateEventRequest)(nil),                      // 67: events.v1.UpdateEventRequest
+	(*UpdateEventResponse)(nil),                     // 68: events.v1.UpdateEventResponse
+	(*DeleteEventRequest)(nil),                      // 69: events.v1.DeleteEventRequest
+	(*DeleteEventResponse)(nil),                     // 70: events.v1.DeleteEventResponse
+	(*RestoreEventRequest)(nil),                     // 71: events.v1.RestoreEventRequest
+	(*RestoreEventResponse)(nil),                    // 72: events.v1.RestoreEventResponse
+	(*ListDeletedEventsRequest)(nil),                // 73: events.v1.ListDeletedEventsRequest
+	(*ListDeletedEventsResponse)(nil),               // 74: events.v1.ListDeletedEventsResponse
+	(*ToggleEventVisibilityRequest)(nil),            // 75: events.v1.ToggleEventVisibilityRequest
+	(*ToggleEventVisibilityResponse)(nil),           // 76: events.v1.ToggleEventVisibilityResponse
+	(*CreateTagRequest)(nil),                        // 77: events.v1.CreateTagRequest
+	(*CreateTagResponse)(nil),                       // 78: events.v1.CreateTagResponse
+	(*GetTagRequest)(nil),                           // 79: events.v1.GetTagRequest
+	(*GetTagResponse)(nil),                          // 80: events.v1.GetTagResponse
+	(*ListTagsRequest)(nil),                         // 81: events.v1.ListTagsRequest
+	(*ListTagsResponse)(nil),                        // 82: events.v1.ListTagsResponse
+	(*UpdateTagRequest)(nil),                        // 83: events.v1.UpdateTagRequest
+	(*UpdateTagResponse)(nil),                       // 84: events.v1.UpdateTagResponse
+	(*DeleteTagRequest)(nil),                        // 85: events.v1.DeleteTagRequest
+	(*DeleteTagResponse)(nil),                       // 86: events.v1.DeleteTagResponse
+	(*MergeTagsRequest)(nil),                        // 87: events.v1.MergeTagsRequest
+	(*MergeTagsResponse)(nil),                       // 88: events.v1.MergeTagsResponse
+	(*GetPublishableOrganizationsRequest)(nil),      // 89: events.v1.GetPublishableOrganizationsRequest
+	(*GetPublishableOrganizationsResponse)(nil),     // 90: events.v1.GetPublishableOrganizationsResponse
+	(*GetUserOrganizationsRequest)(nil),             // 91: events.v1.GetUserOrganizationsRequest
+	(*GetUserOrganizationsResponse)(nil),            // 92: events.v1.GetUserOrganizationsResponse
+	(*GetEventsByTagIdRequest)(nil),                 // 93: events.v1.GetEventsByTagIdRequest
+	(*GetEventsByTagIdResponse)(nil),                // 94: events.v1.GetEventsByTagIdResponse
+	(*GetEventsByAllTagsRequest)(nil),               // 95: events.v1.GetEventsByAllTagsRequest
+	(*GetEventsByAllTagsResponse)(nil),              // 96: events.v1.GetEventsByAllTagsResponse
+	(*GetManagedEventsRequest)(nil),                 // 97: events.v1.GetManagedEventsRequest
+	(*GetManagedEventsResponse)(nil),                // 98: events.v1.GetManagedEventsResponse
+	(*GetEventFeedRequest)(nil),                     // 99: events.v1.GetEventFeedRequest
+	(*FeedEvent)(nil),                               // 100: events.v1.FeedEvent
+	(*GetEventFeedResponse)(nil),                    // 101: events.v1.GetEventFeedResponse
+	(*EventSummary)(nil),                            // 102: events.v1.EventSummary
+	(*GetEventCalendarRequest)(nil),                 // 103: events.v1.GetEventCalendarRequest
+	(*CalendarDay)(nil),                             // 104: events.v1.CalendarDay
+	(*GetEventCalendarResponse)(nil),                // 105: events.v1.GetEventCalendarResponse
+	(*GetUserSubscribedEventsRequest)(nil),          // 106: events.v1.GetUserSubscribedEventsRequest
+	(*GetUserSubscribedEventsResponse)(nil),         // 107: events.v1.GetUserSubscribedEventsResponse
+	(*ListEventsForAdminRequest)(nil),               // 108: events.v1.ListEventsForAdminRequest
+	(*ListEventsForAdminResponse)(nil),              // 109: events.v1.ListEventsForAdminResponse
+	(*RegisterForEventRequest)(nil),                 // 110: events.v1.RegisterForEventRequest
+	(*RegisterForEventResponse)(nil),                // 111: events.v1.RegisterForEventResponse
+	(*CancelRegistrationRequest)(nil),               // 112: events.v1.CancelRegistrationRequest
+	(*CancelRegistrationResponse)(nil),              // 113: events.v1.CancelRegistrationResponse
+	(*GetEventRegistrationsRequest)(nil),            // 114: events.v1.GetEventRegistrationsRequest
+	(*GetEventRegistrationsResponse)(nil),           // 115: events.v1.GetEventRegistrationsResponse
+	(*GetUserRegistrationsRequest)(nil),             // 116: events.v1.GetUserRegistrationsRequest
+	(*GetUserRegistrationsResponse)(nil),            // 117: events.v1.GetUserRegistrationsResponse
+	(*RegistrationHold)(nil),                        // 118: events.v1.RegistrationHold
+	(*HoldEventRegistrationRequest)(nil),            // 119: events.v1.HoldEventRegistrationRequest
+	(*HoldEventRegistrationResponse)(nil),           // 120: events.v1.HoldEventRegistrationResponse
+	(*ConfirmRegistrationRequest)(nil),              // 121: events.v1.ConfirmRegistrationRequest
+	(*ConfirmRegistrationResponse)(nil),             // 122: events.v1.ConfirmRegistrationResponse
+	(*NotifyRegistrantsRequest)(nil),                // 123: events.v1.NotifyRegistrantsRequest
+	(*NotifyRegistrantsResponse)(nil),               // 124: events.v1.NotifyRegistrantsResponse
+	(*GetEventRegistrationStatusRequest)(nil),       // 125: events.v1.GetEventRegistrationStatusRequest
+	(*GetEventRegistrationStatusResponse)(nil),      // 126: events.v1.GetEventRegistrationStatusResponse
+	(*CheckInAttendeeRequest)(nil),                  // 127: events.v1.CheckInAttendeeRequest
+	(*CheckInAttendeeResponse)(nil),                 // 128: events.v1.CheckInAttendeeResponse
+	(*BulkCheckInRequest)(nil),                      // 129: events.v1.BulkCheckInRequest
+	(*BulkCheckInFailure)(nil),                      // 130: events.v1.BulkCheckInFailure
+	(*BulkCheckInResponse)(nil),                     // 131: events.v1.BulkCheckInResponse
+	(*MarkAttendanceRequest)(nil),                   // 132: events.v1.MarkAttendanceRequest
+	(*MarkAttendanceResponse)(nil),                  // 133: events.v1.MarkAttendanceResponse
+	(*GetEventAttendanceRequest)(nil),               // 134: events.v1.GetEventAttendanceRequest
+	(*GetEventAttendanceResponse)(nil),              // 135: events.v1.GetEventAttendanceResponse
+	(*StreamEventAttendanceRequest)(nil),            // 136: events.v1.StreamEventAttendanceRequest
+	(*StreamEventAttendanceResponse)(nil),           // 137: events.v1.StreamEventAttendanceResponse
+	(*GetUserAttendanceHistoryRequest)(nil),         // 138: events.v1.GetUserAttendanceHistoryRequest
+	(*AttendedEventSummary)(nil),                    // 139: events.v1.AttendedEventSummary
+	(*UserAttendanceHistoryResponse)(nil),           // 140: events.v1.UserAttendanceHistoryResponse
+	(*ExportEventAttendanceRequest)(nil),            // 141: events.v1.ExportEventAttendanceRequest
+	(*AttendanceExportChunk)(nil),                   // 142: events.v1.AttendanceExportChunk
+	(*GetDashboardStatisticsRequest)(nil),           // 143: events.v1.GetDashboardStatisticsRequest
+	(*GetDashboardStatisticsResponse)(nil),          // 144: events.v1.GetDashboardStatisticsResponse
+	(*GetEventStatisticsRequest)(nil),               // 145: events.v1.GetEventStatisticsRequest
+	(*GetEventStatisticsResponse)(nil),              // 146: events.v1.GetEventStatisticsResponse
+	(*TagDistribution)(nil),                         // 147: events.v1.TagDistribution
+	(*GetEventTagsDistributionByMonthRequest)(nil),  // 148: events.v1.GetEventTagsDistributionByMonthRequest
+	(*GetEventTagsDistributionByMonthResponse)(nil), // 149: events.v1.GetEventTagsDistributionByMonthResponse
+	(*EventActivity)(nil),                           // 150: events.v1.EventActivity
+	(*GetEventActivityByYearRequest)(nil),           // 151: events.v1.GetEventActivityByYearRequest
+	(*GetEventActivityByYearResponse)(nil),          // 152: events.v1.GetEventActivityByYearResponse
+	(*EventStatsSummary)(nil),                       // 153: events.v1.EventStatsSummary
+	(*GetOverallStatisticsRequest)(nil),             // 154: events.v1.GetOverallStatisticsRequest
+	(*GetOverallStatisticsResponse)(nil),            // 155: events.v1.GetOverallStatisticsResponse
+	(*EventTrend)(nil),                              // 156: events.v1.EventTrend
+	(*GetEventTrendsRequest)(nil),                   // 157: events.v1.GetEventTrendsRequest
+	(*GetEventTrendsResponse)(nil),                  // 158: events.v1.GetEventTrendsResponse
+	(*GetEventCountByFormatRequest)(nil),            // 159: events.v1.GetEventCountByFormatRequest
+	(*GetEventCountByFormatResponse)(nil),           // 160: events.v1.GetEventCountByFormatResponse
+	(*ClubLeaderboard)(nil),                         // 161: events.v1.ClubLeaderboard
+	(*GetTopPerformingClubsRequest)(nil),            // 162: events.v1.GetTopPerformingClubsRequest
+	(*GetTopPerformingClubsResponse)(nil),           // 163: events.v1.GetTopPerformingClubsResponse
+	(*GetClubLeaderboardRequest)(nil),               // 164: events.v1.GetClubLeaderboardRequest
+	(*ClubLeaderboardResponse)(nil),                 // 165: events.v1.ClubLeaderboardResponse
+	(*GetUserEngagementLevelsRequest)(nil),          // 166: events.v1.GetUserEngagementLevelsRequest
+	(*UserEngagementLevel)(nil),                     // 167: events.v1.UserEngagementLevel
+	(*GetUserEngagementLevelsResponse)(nil),         // 168: events.v1.GetUserEngagementLevelsResponse
+	(*TopPerformingEvent)(nil),                      // 169: events.v1.TopPerformingEvent
+	(*GetTopPerformingEventsRequest)(nil),           // 170: events.v1.GetTopPerformingEventsRequest
+	(*GetTopPerformingEventsResponse)(nil),          // 171: events.v1.GetTopPerformingEventsResponse
+	(*LowRegistrationEvent)(nil),                    // 172: events.v1.LowRegistrationEvent
+	(*GetLowRegistrationEventsRequest)(nil),         // 173: events.v1.GetLowRegistrationEventsRequest
+	(*GetLowRegistrationEventsResponse)(nil),        // 174: events.v1.GetLowRegistrationEventsResponse
+	(*OrganizationActivity)(nil),                    // 175: events.v1.OrganizationActivity
+	(*GetOrganizationActivityRequest)(nil),          // 176: events.v1.GetOrganizationActivityRequest
+	(*GetOrganizationActivityResponse)(nil),         // 177: events.v1.GetOrganizationActivityResponse
+	(*GetStatisticsForOrganizationRequest)(nil),     // 178: events.v1.GetStatisticsForOrganizationRequest
+	(*OrganizationStatisticsResponse)(nil),          // 179: events.v1.OrganizationStatisticsResponse
+	(*GetEventImageUploadUrlRequest)(nil),           // 180: events.v1.GetEventImageUploadUrlRequest
+	(*GetEventImageUploadUrlResponse)(nil),          // 181: events.v1.GetEventImageUploadUrlResponse
+	(*Webhook)(nil),                                 // 182: events.v1.Webhook
+	(*WebhookDelivery)(nil),                         // 183: events.v1.WebhookDelivery
+	(*CreateWebhookRequest)(nil),                    // 184: events.v1.CreateWebhookRequest
+	(*CreateWebhookResponse)(nil),                   // 185: events.v1.CreateWebhookResponse
+	(*ListWebhooksRequest)(nil),                     // 186: events.v1.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),                    // 187: events.v1.ListWebhooksResponse
+	(*DeleteWebhookRequest)(nil),                    // 188: events.v1.DeleteWebhookRequest
+	(*DeleteWebhookResponse)(nil),                   // 189: events.v1.DeleteWebhookResponse
+	(*GetWebhookDeliveriesRequest)(nil),             // 190: events.v1.GetWebhookDeliveriesRequest
+	(*GetWebhookDeliveriesResponse)(nil),            // 191: events.v1.GetWebhookDeliveriesResponse
+	(*RetryWebhookDeliveryRequest)(nil),             // 192: events.v1.RetryWebhookDeliveryRequest
+	(*RetryWebhookDeliveryResponse)(nil),            // 193: events.v1.RetryWebhookDeliveryResponse
+	(*AuditLogEntry)(nil),                           // 194: events.v1.AuditLogEntry
+	(*ListAuditLogsRequest)(nil),                    // 195: events.v1.ListAuditLogsRequest
+	(*ListAuditLogsResponse)(nil),                   // 196: events.v1.ListAuditLogsResponse
 }
 var file_eventsv1_events_proto_depIdxs = []int32{
-	1,   // 0: events.v1.Organization.status:type_name -> events.v1.OrganizationStatus
+	2,   // 0: events.v1.Organization.status:type_name -> events.v1.OrganizationStatus
 	0,   // 1: events.v1.Event.format:type_name -> events.v1.EventFormat
-	5,   // 2: events.v1.Event.organization:type_name -> events.v1.Organization
-	6,   // 3: events.v1.Event.tags:type_name -> events.v1.Tag
-	2,   // 4: events.v1.EventRegistration.status:type_name -> events.v1.RegistrationStatus
-	3,   // 5: events.v1.EventAttendance.status:type_name -> events.v1.AttendanceStatus
-	11,  // 6: events.v1.EventStatistics.recent_events:type_name -> events.v1.EventStats
-	1,   // 7: events.v1.CreateOrganizationRequest.status:type_name -> events.v1.OrganizationStatus
-	5,   // 8: events.v1.CreateOrganizationResponse.organization:type_name -> events.v1.Organization
-	5,   // 9: events.v1.GetOrganizationResponse.organization:type_name -> events.v1.Organization
-	5,   // 10: events.v1.ListOrganizationsResponse.organizations:type_name -> events.v1.Organization
-	1,   // 11: events.v1.UpdateOrganizationRequest.status:type_name -> events.v1.OrganizationStatus
-	5,   // 12: events.v1.UpdateOrganizationResponse.organization:type_name -> events.v1.Organization
-	4,   // 13: events.v1.CreateOrganizationTypeResponse.organization_type:type_name -> events.v1.OrganizationType
-	4,   // 14: events.v1.GetOrganizationTypeResponse.organization_type:type_name -> events.v1.OrganizationType
-	4,   // 15: events.v1.ListOrganizationTypesResponse.organization_types:type_name -> events.v1.OrganizationType
-	4,   // 16: events.v1.UpdateOrganizationTypeResponse.organization_type:type_name -> events.v1.OrganizationType
-	0,   // 17: events.v1.CreateEventRequest.format:type_name -> events.v1.EventFormat
-	7,   // 18: events.v1.CreateEventResponse.event:type_name -> events.v1.Event
-	7,   // 19: events.v1.GetEventResponse.event:type_name -> events.v1.Event
-	7,   // 20: events.v1.ListEventsResponse.events:type_name -> events.v1.Event
-	0,   // 21: events.v1.UpdateEventRequest.format:type_name -> events.v1.EventFormat
-	7,   // 22: events.v1.UpdateEventResponse.event:type_name -> events.v1.Event
-	6,   // 23: events.v1.CreateTagResponse.tag:type_name -> events.v1.Tag
-	6,   // 24: events.v1.GetTagResponse.tag:type_name -> events.v1.Tag
-	6,   // 25: events.v1.ListTagsResponse.tags:type_name -> events.v1.Tag
-	6,   // 26: events.v1.UpdateTagResponse.tag:type_name -> events.v1.Tag
-	5,   // 27: events.v1.GetPublishableOrganizationsResponse.organizations:type_name -> events.v1.Organization
-	5,   // 28: events.v1.GetUserOrganizationsResponse.organizations:type_name -> events.v1.Organization
-	7,   // 29: events.v1.GetEventsByTagIdResponse.events:type_name -> events.v1.Event
-	7,   // 30: events.v1.GetUserSubscribedEventsResponse.events:type_name -> events.v1.Event
-	7,   // 31: events.v1.ListEventsForAdminResponse.events:type_name -> events.v1.Event
-	8,   // 32: events.v1.RegisterForEventResponse.registration:type_name -> events.v1.EventRegistration
-	8,   // 33: events.v1.GetEventRegistrationsResponse.registrations:type_name -> events.v1.EventRegistration
-	8,   // 34: events.v1.GetUserRegistrationsResponse.registrations:type_name -> events.v1.EventRegistration
-	9,   // 35: events.v1.CheckInAttendeeResponse.attendance:type_name -> events.v1.EventAttendance
-	3,   // 36: events.v1.MarkAttendanceRequest.status:type_name -> events.v1.AttendanceStatus
-	9,   // 37: events.v1.MarkAttendanceResponse.attendance:type_name -> events.v1.EventAttendance
-	9,   // 38: events.v1.GetEventAttendanceResponse.attendance:type_name -> events.v1.EventAttendance
-	10,  // 39: events.v1.GetDashboardStatisticsResponse.statistics:type_name -> events.v1.EventStatistics
-	80,  // 40: events.v1.GetEventTagsDistributionByMonthResponse.tags:type_name -> events.v1.TagDistribution
-	83,  // 41: events.v1.GetEventActivityByYearResponse.activities:type_name -> events.v1.EventActivity
-	89,  // 42: events.v1.GetEventTrendsResponse.trends:type_name -> events.v1.EventTrend
-	92,  // 43: events.v1.GetTopPerformingClubsResponse.clubs:type_name -> events.v1.ClubLeaderboard
-	96,  // 44: events.v1.GetUserEngagementLevelsResponse.levels:type_name -> events.v1.UserEngagementLevel
-	5,   // 45: events.v1.TopPerformingEvent.organization:type_name -> events.v1.Organization
-	98,  // 46: events.v1.GetTopPerformingEventsResponse.events:type_name -> events.v1.TopPerformingEvent
-	5,   // 47: events.v1.LowRegistrationEvent.organization:type_name -> events.v1.Organization
-	101, // 48: events.v1.GetLowRegistrationEventsResponse.events:type_name -> events.v1.LowRegistrationEvent
-	104, // 49: events.v1.GetOrganizationActivityResponse.organizations:type_name -> events.v1.OrganizationActivity
-	12,  // 50: events.v1.OrganizationsService.CreateOrganization:input_type -> events.v1.CreateOrganizationRequest
-	14,  // 51: events.v1.OrganizationsService.GetOrganization:input_type -> events.v1.GetOrganizationRequest
-	16,  // 52: events.v1.OrganizationsService.ListOrganizations:input_type -> events.v1.ListOrganizationsRequest
-	18,  // 53: events.v1.OrganizationsService.UpdateOrganization:input_type -> events.v1.UpdateOrganizationRequest
-	20,  // 54: events.v1.OrganizationsService.DeleteOrganization:input_type -> events.v1.DeleteOrganizationRequest
-	52,  // 55: events.v1.OrganizationsService.GetPublishableOrganizations:input_type -> events.v1.GetPublishableOrganizationsRequest
-	54,  // 56: events.v1.OrganizationsService.GetUserOrganizations:input_type -> events.v1.GetUserOrganizationsRequest
-	22,  // 57: events.v1.OrganizationTypesService.CreateOrganizationType:input_type -> events.v1.CreateOrganizationTypeRequest
-	24,  // 58: events.v1.OrganizationTypesService.GetOrganizationType:input_type -> events.v1.GetOrganizationTypeRequest
-	26,  // 59: events.v1.OrganizationTypesService.ListOrganizationTypes:input_type -> events.v1.ListOrganizationTypesRequest
-	28,  // 60: events.v1.OrganizationTypesService.UpdateOrganizationType:input_type -> events.v1.UpdateOrganizationTypeRequest
-	30,  // 61: events.v1.OrganizationTypesService.DeleteOrganizationType:input_type -> events.v1.DeleteOrganizationTypeRequest
-	32,  // 62: events.v1.EventsService.CreateEvent:input_type -> events.v1.CreateEventRequest
-	34,  // 63: events.v1.EventsService.GetEvent:input_type -> events.v1.GetEventRequest
-	36,  // 64: events.v1.EventsService.ListEvents:input_type -> events.v1.ListEventsRequest
-	60,  // 65: events.v1.EventsService.ListEventsForAdmin:input_type -> events.v1.ListEventsForAdminRequest
-	38,  // 66: events.v1.EventsService.UpdateEvent:input_type -> events.v1.UpdateEventRequest
-	40,  // 67: events.v1.EventsService.DeleteEvent:input_type -> events.v1.DeleteEventRequest
-	56,  // 68: events.v1.EventsService.GetEventsByTagId:input_type -> events.v1.GetEventsByTagIdRequest
-	58,  // 69: events.v1.EventsService.GetUserSubscribedEvents:input_type -> events.v1.GetUserSubscribedEventsRequest
-	107, // 70: events.v1.EventsService.GetEventImageUploadUrl:input_type -> events.v1.GetEventImageUploadUrlRequest
-	42,  // 71: events.v1.TagsService.CreateTag:input_type -> events.v1.CreateTagRequest
-	44,  // 72: events.v1.TagsService.GetTag:input_type -> events.v1.GetTagRequest
-	46,  // 73: events.v1.TagsService.ListTags:input_type -> events.v1.ListTagsRequest
-	48,  // 74: events.v1.TagsService.UpdateTag:input_type -> events.v1.UpdateTagRequest
-	50,  // 75: events.v1.TagsService.DeleteTag:input_type -> events.v1.DeleteTagRequest
-	62,  // 76: events.v1.EventRegistrationsService.RegisterForEvent:input_type -> events.v1.RegisterForEventRequest
-	64,  // 77: events.v1.EventRegistrationsService.CancelRegistration:input_type -> events.v1.CancelRegistrationRequest
-	66,  // 78: events.v1.EventRegistrationsService.GetEventRegistrations:input_type -> events.v1.GetEventRegistrationsRequest
-	68,  // 79: events.v1.EventRegistrationsService.GetUserRegistrations:input_type -> events.v1.GetUserRegistrationsRequest
-	70,  // 80: events.v1.EventAttendanceService.CheckInAttendee:input_type -> events.v1.CheckInAttendeeRequest
-	72,  // 81: events.v1.EventAttendanceService.MarkAttendance:input_type -> events.v1.MarkAttendanceRequest
-	74,  // 82: events.v1.EventAttendanceService.GetEventAttendance:input_type -> events.v1.GetEventAttendanceRequest
-	76,  // 83: events.v1.StatisticsService.GetDashboardStatistics:input_type -> events.v1.GetDashboardStatisticsRequest
-	78,  // 84: events.v1.StatisticsService.GetEventStatistics:input_type -> events.v1.GetEventStatisticsRequest
-	81,  // 85: events.v1.StatisticsService.GetEventTagsDistributionByMonth:input_type -> events.v1.GetEventTagsDistributionByMonthRequest
-	84,  // 86: events.v1.StatisticsService.GetEventActivityByYear:input_type -> events.v1.GetEventActivityByYearRequest
-	87,  // 87: events.v1.StatisticsService.GetOverallStatistics:input_type -> events.v1.GetOverallStatisticsRequest
-	90,  // 88: events.v1.StatisticsService.GetEventTrends:input_type -> events.v1.GetEventTrendsRequest
-	93,  // 89: events.v1.StatisticsService.GetTopPerformingClubs:input_type -> events.v1.GetTopPerformingClubsRequest
-	95,  // 90: events.v1.StatisticsService.GetUserEngagementLevels:input_type -> events.v1.GetUserEngagementLevelsRequest
-	99,  // 91: events.v1.StatisticsService.GetTopPerformingEvents:input_type -> events.v1.GetTopPerformingEventsRequest
-	102, // 92: events.v1.StatisticsService.GetLowRegistrationEvents:input_type -> events.v1.GetLowRegistrationEventsRequest
-	105, // 93: events.v1.StatisticsService.GetOrganizationActivity:input_type -> events.v1.GetOrganizationActivityRequest
-	13,  // 94: events.v1.OrganizationsService.CreateOrganization:output_type -> events.v1.CreateOrganizationResponse
-	15,  // 95: events.v1.OrganizationsService.GetOrganization:output_type -> events.v1.GetOrganizationResponse
-	17,  // 96: events.v1.OrganizationsService.ListOrganizations:output_type -> events.v1.ListOrganizationsResponse
-	19,  // 97: events.v1.OrganizationsService.UpdateOrganization:output_type -> events.v1.UpdateOrganizationResponse
-	21,  // 98: events.v1.OrganizationsService.DeleteOrganization:output_type -> events.v1.DeleteOrganizationResponse
-	53,  // 99: events.v1.OrganizationsService.GetPublishableOrganizations:output_type -> events.v1.GetPublishableOrganizationsResponse
-	55,  // 100: events.v1.OrganizationsService.GetUserOrganizations:output_type -> events.v1.GetUserOrganizationsResponse
-	23,  // 101: events.v1.OrganizationTypesService.CreateOrganizationType:output_type -> events.v1.CreateOrganizationTypeResponse
-	25,  // 102: events.v1.OrganizationTypesService.GetOrganizationType:output_type -> events.v1.GetOrganizationTypeResponse
-	27,  // 103: events.v1.OrganizationTypesService.ListOrganizationTypes:output_type -> events.v1.ListOrganizationTypesResponse
-	29,  // 104: events.v1.OrganizationTypesService.UpdateOrganizationType:output_type -> events.v1.UpdateOrganizationTypeResponse
-	31,  // 105: events.v1.OrganizationTypesService.DeleteOrganizationType:output_type -> events.v1.DeleteOrganizationTypeResponse
-	33,  // 106: events.v1.EventsService.CreateEvent:output_type -> events.v1.CreateEventResponse
-	35,  // 107: events.v1.EventsService.GetEvent:output_type -> events.v1.GetEventResponse
-	37,  // 108: events.v1.EventsService.ListEvents:output_type -> events.v1.ListEventsResponse
-	61,  // 109: events.v1.EventsService.ListEventsForAdmin:output_type -> events.v1.ListEventsForAdminResponse
-	39,  // 110: events.v1.EventsService.UpdateEvent:output_type -> events.v1.UpdateEventResponse
-	41,  // 111: events.v1.EventsService.DeleteEvent:output_type -> events.v1.DeleteEventResponse
-	57,  // 112: events.v1.EventsService.GetEventsByTagId:output_type -> events.v1.GetEventsByTagIdResponse
-	59,  // 113: events.v1.EventsService.GetUserSubscribedEvents:output_type -> events.v1.GetUserSubscribedEventsResponse
-	108, // 114: events.v1.EventsService.GetEventImageUploadUrl:output_type -> events.v1.GetEventImageUploadUrlResponse
-	43,  // 115: events.v1.TagsService.CreateTag:output_type -> events.v1.CreateTagResponse
-	45,  // 116: events.v1.TagsService.GetTag:output_type -> events.v1.GetTagResponse
-	47,  // 117: events.v1.TagsService.ListTags:output_type -> events.v1.ListTagsResponse
-	49,  // 118: events.v1.TagsService.UpdateTag:output_type -> events.v1.UpdateTagResponse
-	51,  // 119: events.v1.TagsService.DeleteTag:output_type -> events.v1.DeleteTagResponse
-	63,  // 120: events.v1.EventRegistrationsService.RegisterForEvent:output_type -> events.v1.RegisterForEventResponse
-	65,  // 121: events.v1.EventRegistrationsService.CancelRegistration:output_type -> events.v1.CancelRegistrationResponse
-	67,  // 122: events.v1.EventRegistrationsService.GetEventRegistrations:output_type -> events.v1.GetEventRegistrationsResponse
-	69,  // 123: events.v1.EventRegistrationsService.GetUserRegistrations:output_type -> events.v1.GetUserRegistrationsResponse
-	71,  // 124: events.v1.EventAttendanceService.CheckInAttendee:output_type -> events.v1.CheckInAttendeeResponse
-	73,  // 125: events.v1.EventAttendanceService.MarkAttendance:output_type -> events.v1.MarkAttendanceResponse
-	75,  // 126: events.v1.EventAttendanceService.GetEventAttendance:output_type -> events.v1.GetEventAttendanceResponse
-	77,  // 127: events.v1.StatisticsService.GetDashboardStatistics:output_type -> events.v1.GetDashboardStatisticsResponse
-	79,  // 128: events.v1.StatisticsService.GetEventStatistics:output_type -> events.v1.GetEventStatisticsResponse
-	82,  // 129: events.v1.StatisticsService.GetEventTagsDistributionByMonth:output_type -> events.v1.GetEventTagsDistributionByMonthResponse
-	85,  // 130: events.v1.StatisticsService.GetEventActivityByYear:output_type -> events.v1.GetEventActivityByYearResponse
-	88,  // 131: events.v1.StatisticsService.GetOverallStatistics:output_type -> events.v1.GetOverallStatisticsResponse
-	91,  // 132: events.v1.StatisticsService.GetEventTrends:output_type -> events.v1.GetEventTrendsResponse
-	94,  // 133: events.v1.StatisticsService.GetTopPerformingClubs:output_type -> events.v1.GetTopPerformingClubsResponse
-	97,  // 134: events.v1.StatisticsService.GetUserEngagementLevels:output_type -> events.v1.GetUserEngagementLevelsResponse
-	100, // 135: events.v1.StatisticsService.GetTopPerformingEvents:output_type -> events.v1.GetTopPerformingEventsResponse
-	103, // 136: events.v1.StatisticsService.GetLowRegistrationEvents:output_type -> events.v1.GetLowRegistrationEventsResponse
-	106, // 137: events.v1.StatisticsService.GetOrganizationActivity:output_type -> events.v1.GetOrganizationActivityResponse
-	94,  // [94:138] is the sub-list for method output_type
-	50,  // [50:94] is the sub-list for method input_type
-	50,  // [50:50] is the sub-list for extension type_name
-	50,  // [50:50] is the sub-list for extension extendee
-	0,   // [0:50] is the sub-list for field type_name
+	12,  // 2: events.v1.Event.organization:type_name -> events.v1.Organization
+	13,  // 3: events.v1.Event.tags:type_name -> events.v1.Tag
+	4,   // 4: events.v1.EventRegistration.status:type_name -> events.v1.RegistrationStatus
+	14,  // 5: events.v1.EventRegistration.event:type_name -> events.v1.Event
+	5,   // 6: events.v1.EventAttendance.status:type_name -> events.v1.AttendanceStatus
+	18,  // 7: events.v1.EventStatistics.recent_events:type_name -> events.v1.EventStats
+	2,   // 8: events.v1.CreateOrganizationRequest.status:type_name -> events.v1.OrganizationStatus
+	12,  // 9: events.v1.CreateOrganizationResponse.organization:type_name -> events.v1.Organization
+	12,  // 10: events.v1.GetOrganizationResponse.organization:type_name -> events.v1.Organization
+	2,   // 11: events.v1.ListOrganizationsRequest.status_filter:type_name -> events.v1.OrganizationStatus
+	12,  // 12: events.v1.ListOrganizationsResponse.organizations:type_name -> events.v1.Organization
+	2,   // 13: events.v1.UpdateOrganizationRequest.status:type_name -> events.v1.OrganizationStatus
+	12,  // 14: events.v1.UpdateOrganizationResponse.organization:type_name -> events.v1.Organization
+	29,  // 15: events.v1.GetOrganizationMembersResponse.members:type_name -> events.v1.OrganizationMember
+	1,   // 16: events.v1.AssignClubRoleRequest.role:type_name -> events.v1.ClubRole
+	29,  // 17: events.v1.AssignClubRoleResponse.member:type_name -> events.v1.OrganizationMember
+	3,   // 18: events.v1.OrganizationInquiry.status:type_name -> events.v1.InquiryStatus
+	36,  // 19: events.v1.ListOrganizationInquiriesResponse.inquiries:type_name -> events.v1.OrganizationInquiry
+	3,   // 20: events.v1.UpdateInquiryStatusRequest.status:type_name -> events.v1.InquiryStatus
+	36,  // 21: events.v1.UpdateInquiryStatusResponse.inquiry:type_name -> events.v1.OrganizationInquiry
+	12,  // 22: events.v1.MergeOrganizationsResponse.archived_org:type_name -> events.v1.Organization
+	12,  // 23: events.v1.MergeOrganizationsResponse.target_org:type_name -> events.v1.Organization
+	11,  // 24: events.v1.CreateOrganizationTypeResponse.organization_type:type_name -> events.v1.OrganizationType
+	11,  // 25: events.v1.GetOrganizationTypeResponse.organization_type:type_name -> events.v1.OrganizationType
+	11,  // 26: events.v1.ListOrganizationTypesResponse.organization_types:type_name -> events.v1.OrganizationType
+	11,  // 27: events.v1.UpdateOrganizationTypeResponse.organization_type:type_name -> events.v1.OrganizationType
+	0,   // 28: events.v1.CreateEventRequest.format:type_name -> events.v1.EventFormat
+	14,  // 29: events.v1.CreateEventResponse.event:type_name -> events.v1.Event
+	57,  // 30: events.v1.BulkCreateEventsRequest.events:type_name -> events.v1.CreateEventRequest
+	14,  // 31: events.v1.BulkCreateEventsResponse.events:type_name -> events.v1.Event
+	14,  // 32: events.v1.DuplicateEventResponse.event:type_name -> events.v1.Event
+	14,  // 33: events.v1.GetEventResponse.event:type_name -> events.v1.Event
+	15,  // 34: events.v1.GetEventResponse.caller_registration:type_name -> events.v1.EventRegistration
+	16,  // 35: events.v1.GetEventResponse.caller_attendance:type_name -> events.v1.EventAttendance
+	14,  // 36: events.v1.ListEventsResponse.events:type_name -> events.v1.Event
+	0,   // 37: events.v1.UpdateEventRequest.format:type_name -> events.v1.EventFormat
+	14,  // 38: events.v1.UpdateEventResponse.event:type_name -> events.v1.Event
+	14,  // 39: events.v1.RestoreEventResponse.event:type_name -> events.v1.Event
+	14,  // 40: events.v1.ListDeletedEventsResponse.events:type_name -> events.v1.Event
+	14,  // 41: events.v1.ToggleEventVisibilityResponse.event:type_name -> events.v1.Event
+	13,  // 42: events.v1.CreateTagResponse.tag:type_name -> events.v1.Tag
+	13,  // 43: events.v1.GetTagResponse.tag:type_name -> events.v1.Tag
+	7,   // 44: events.v1.ListTagsRequest.sort_by:type_name -> events.v1.TagSortBy
+	13,  // 45: events.v1.ListTagsResponse.tags:type_name -> events.v1.Tag
+	13,  // 46: events.v1.UpdateTagResponse.tag:type_name -> events.v1.Tag
+	13,  // 47: events.v1.MergeTagsResponse.target_tag:type_name -> events.v1.Tag
+	12,  // 48: events.v1.GetPublishableOrganizationsResponse.organizations:type_name -> events.v1.Organization
+	12,  // 49: events.v1.GetUserOrganizationsResponse.organizations:type_name -> events.v1.Organization
+	14,  // 50: events.v1.GetEventsByTagIdResponse.events:type_name -> events.v1.Event
+	14,  // 51: events.v1.GetEventsByAllTagsResponse.events:type_name -> events.v1.Event
+	8,   // 52: events.v1.GetManagedEventsRequest.role_filter:type_name -> events.v1.ManagedEventRole
+	14,  // 53: events.v1.GetManagedEventsResponse.events:type_name -> events.v1.Event
+	14,  // 54: events.v1.FeedEvent.event:type_name -> events.v1.Event
+	100, // 55: events.v1.GetEventFeedResponse.events:type_name -> events.v1.FeedEvent
+	0,   // 56: events.v1.EventSummary.format:type_name -> events.v1.EventFormat
+	102, // 57: events.v1.CalendarDay.events:type_name -> events.v1.EventSummary
+	104, // 58: events.v1.GetEventCalendarResponse.days:type_name -> events.v1.CalendarDay
+	14,  // 59: events.v1.GetUserSubscribedEventsResponse.events:type_name -> events.v1.Event
+	14,  // 60: events.v1.ListEventsForAdminResponse.events:type_name -> events.v1.Event
+	15,  // 61: events.v1.RegisterForEventResponse.registration:type_name -> events.v1.EventRegistration
+	15,  // 62: events.v1.GetEventRegistrationsResponse.registrations:type_name -> events.v1.EventRegistration
+	4,   // 63: events.v1.GetUserRegistrationsRequest.status:type_name -> events.v1.RegistrationStatus
+	15,  // 64: events.v1.GetUserRegistrationsResponse.registrations:type_name -> events.v1.EventRegistration
+	118, // 65: events.v1.HoldEventRegistrationResponse.hold:type_name -> events.v1.RegistrationHold
+	15,  // 66: events.v1.ConfirmRegistrationResponse.registration:type_name -> events.v1.EventRegistration
+	4,   // 67: events.v1.GetEventRegistrationStatusResponse.status:type_name -> events.v1.RegistrationStatus
+	15,  // 68: events.v1.GetEventRegistrationStatusResponse.registration:type_name -> events.v1.EventRegistration
+	16,  // 69: events.v1.CheckInAttendeeResponse.attendance:type_name -> events.v1.EventAttendance
+	130, // 70: events.v1.BulkCheckInResponse.failed:type_name -> events.v1.BulkCheckInFailure
+	5,   // 71: events.v1.MarkAttendanceRequest.status:type_name -> events.v1.AttendanceStatus
+	16,  // 72: events.v1.MarkAttendanceResponse.attendance:type_name -> events.v1.EventAttendance
+	16,  // 73: events.v1.GetEventAttendanceResponse.attendance:type_name -> events.v1.EventAttendance
+	16,  // 74: events.v1.StreamEventAttendanceResponse.attendance:type_name -> events.v1.EventAttendance
+	14,  // 75: events.v1.AttendedEventSummary.event:type_name -> events.v1.Event
+	139, // 76: events.v1.UserAttendanceHistoryResponse.events:type_name -> events.v1.AttendedEventSummary
+	17,  // 77: events.v1.GetDashboardStatisticsResponse.statistics:type_name -> events.v1.EventStatistics
+	147, // 78: events.v1.GetEventTagsDistributionByMonthResponse.tags:type_name -> events.v1.TagDistribution
+	150, // 79: events.v1.GetEventActivityByYearResponse.activities:type_name -> events.v1.EventActivity
+	156, // 80: events.v1.GetEventTrendsResponse.trends:type_name -> events.v1.EventTrend
+	9,   // 81: events.v1.GetTopPerformingClubsRequest.sort_by:type_name -> events.v1.ClubLeaderboardSortBy
+	161, // 82: events.v1.GetTopPerformingClubsResponse.clubs:type_name -> events.v1.ClubLeaderboard
+	9,   // 83: events.v1.GetClubLeaderboardRequest.sort_by:type_name -> events.v1.ClubLeaderboardSortBy
+	161, // 84: events.v1.ClubLeaderboardResponse.clubs:type_name -> events.v1.ClubLeaderboard
+	167, // 85: events.v1.GetUserEngagementLevelsResponse.levels:type_name -> events.v1.UserEngagementLevel
+	12,  // 86: events.v1.TopPerformingEvent.organization:type_name -> events.v1.Organization
+	10,  // 87: events.v1.GetTopPerformingEventsRequest.sort_by:type_name -> events.v1.TopEventsSortBy
+	169, // 88: events.v1.GetTopPerformingEventsResponse.events:type_name -> events.v1.TopPerformingEvent
+	12,  // 89: events.v1.LowRegistrationEvent.organization:type_name -> events.v1.Organization
+	172, // 90: events.v1.GetLowRegistrationEventsResponse.events:type_name -> events.v1.LowRegistrationEvent
+	175, // 91: events.v1.GetOrganizationActivityResponse.organizations:type_name -> events.v1.OrganizationActivity
+	147, // 92: events.v1.OrganizationStatisticsResponse.top_tags:type_name -> events.v1.TagDistribution
+	156, // 93: events.v1.OrganizationStatisticsResponse.trends:type_name -> events.v1.EventTrend
+	6,   // 94: events.v1.WebhookDelivery.status:type_name -> events.v1.WebhookDeliveryStatus
+	182, // 95: events.v1.CreateWebhookResponse.webhook:type_name -> events.v1.Webhook
+	182, // 96: events.v1.ListWebhooksResponse.webhooks:type_name -> events.v1.Webhook
+	183, // 97: events.v1.GetWebhookDeliveriesResponse.deliveries:type_name -> events.v1.WebhookDelivery
+	183, // 98: events.v1.RetryWebhookDeliveryResponse.delivery:type_name -> events.v1.WebhookDelivery
+	194, // 99: events.v1.ListAuditLogsResponse.entries:type_name -> events.v1.AuditLogEntry
+	19,  // 100: events.v1.OrganizationsService.CreateOrganization:input_type -> events.v1.CreateOrganizationRequest
+	21,  // 101: events.v1.OrganizationsService.GetOrganization:input_type -> events.v1.GetOrganizationRequest
+	23,  // 102: events.v1.OrganizationsService.ListOrganizations:input_type -> events.v1.ListOrganizationsRequest
+	25,  // 103: events.v1.OrganizationsService.UpdateOrganization:input_type -> events.v1.UpdateOrganizationRequest
+	45,  // 104: events.v1.OrganizationsService.DeleteOrganization:input_type -> events.v1.DeleteOrganizationRequest
+	43,  // 105: events.v1.OrganizationsService.MergeOrganizations:input_type -> events.v1.MergeOrganizationsRequest
+	89,  // 106: events.v1.OrganizationsService.GetPublishableOrganizations:input_type -> events.v1.GetPublishableOrganizationsRequest
+	91,  // 107: events.v1.OrganizationsService.GetUserOrganizations:input_type -> events.v1.GetUserOrganizationsRequest
+	27,  // 108: events.v1.OrganizationsService.GetOrganizationQuotaUsage:input_type -> events.v1.GetOrganizationQuotaUsageRequest
+	30,  // 109: events.v1.OrganizationsService.GetOrganizationMembers:input_type -> events.v1.GetOrganizationMembersRequest
+	32,  // 110: events.v1.OrganizationsService.AssignClubRole:input_type -> events.v1.AssignClubRoleRequest
+	34,  // 111: events.v1.OrganizationsService.RemoveClubMember:input_type -> events.v1.RemoveClubMemberRequest
+	37,  // 112: events.v1.OrganizationsService.SubmitOrganizationInquiry:input_type -> events.v1.SubmitOrganizationInquiryRequest
+	39,  // 113: events.v1.OrganizationsService.ListOrganizationInquiries:input_type -> events.v1.ListOrganizationInquiriesRequest
+	41,  // 114: events.v1.OrganizationsService.UpdateInquiryStatus:input_type -> events.v1.UpdateInquiryStatusRequest
+	47,  // 115: events.v1.OrganizationTypesService.CreateOrganizationType:input_type -> events.v1.CreateOrganizationTypeRequest
+	49,  // 116: events.v1.OrganizationTypesService.GetOrganizationType:input_type -> events.v1.GetOrganizationTypeRequest
+	51,  // 117: events.v1.OrganizationTypesService.ListOrganizationTypes:input_type -> events.v1.ListOrganizationTypesRequest
+	53,  // 118: events.v1.OrganizationTypesService.UpdateOrganizationType:input_type -> events.v1.UpdateOrganizationTypeRequest
+	55,  // 119: events.v1.OrganizationTypesService.DeleteOrganizationType:input_type -> events.v1.DeleteOrganizationTypeRequest
+	57,  // 120: events.v1.EventsService.CreateEvent:input_type -> events.v1.CreateEventRequest
+	59,  // 121: events.v1.EventsService.BulkCreateEvents:input_type -> events.v1.BulkCreateEventsRequest
+	61,  // 122: events.v1.EventsService.DuplicateEvent:input_type -> events.v1.DuplicateEventRequest
+	63,  // 123: events.v1.EventsService.GetEvent:input_type -> events.v1.GetEventRequest
+	65,  // 124: events.v1.EventsService.ListEvents:input_type -> events.v1.ListEventsRequest
+	108, // 125: events.v1.EventsService.ListEventsForAdmin:input_type -> events.v1.ListEventsForAdminRequest
+	67,  // 126: events.v1.EventsService.UpdateEvent:input_type -> events.v1.UpdateEventRequest
+	69,  // 127: events.v1.EventsService.DeleteEvent:input_type -> events.v1.DeleteEventRequest
+	71,  // 128: events.v1.EventsService.RestoreEvent:input_type -> events.v1.RestoreEventRequest
+	73,  // 129: events.v1.EventsService.ListDeletedEvents:input_type -> events.v1.ListDeletedEventsRequest
+	75,  // 130: events.v1.EventsService.ToggleEventVisibility:input_type -> events.v1.ToggleEventVisibilityRequest
+	93,  // 131: events.v1.EventsService.GetEventsByTagId:input_type -> events.v1.GetEventsByTagIdRequest
+	95,  // 132: events.v1.EventsService.GetEventsByAllTags:input_type -> events.v1.GetEventsByAllTagsRequest
+	106, // 133: events.v1.EventsService.GetUserSubscribedEvents:input_type -> events.v1.GetUserSubscribedEventsRequest
+	97,  // 134: events.v1.EventsService.GetManagedEvents:input_type -> events.v1.GetManagedEventsRequest
+	99,  // 135: events.v1.EventsService.GetEventFeed:input_type -> events.v1.GetEventFeedRequest
+	103, // 136: events.v1.EventsService.GetEventCalendar:input_type -> events.v1.GetEventCalendarRequest
+	180, // 137: events.v1.EventsService.GetEventImageUploadUrl:input_type -> events.v1.GetEventImageUploadUrlRequest
+	77,  // 138: events.v1.TagsService.CreateTag:input_type -> events.v1.CreateTagRequest
+	79,  // 139: events.v1.TagsService.GetTag:input_type -> events.v1.GetTagRequest
+	81,  // 140: events.v1.TagsService.ListTags:input_type -> events.v1.ListTagsRequest
+	83,  // 141: events.v1.TagsService.UpdateTag:input_type -> events.v1.UpdateTagRequest
+	85,  // 142: events.v1.TagsService.DeleteTag:input_type -> events.v1.DeleteTagRequest
+	87,  // 143: events.v1.TagsService.MergeTags:input_type -> events.v1.MergeTagsRequest
+	110, // 144: events.v1.EventRegistrationsService.RegisterForEvent:input_type -> events.v1.RegisterForEventRequest
+	112, // 145: events.v1.EventRegistrationsService.CancelRegistration:input_type -> events.v1.CancelRegistrationRequest
+	114, // 146: events.v1.EventRegistrationsService.GetEventRegistrations:input_type -> events.v1.GetEventRegistrationsRequest
+	116, // 147: events.v1.EventRegistrationsService.GetUserRegistrations:input_type -> events.v1.GetUserRegistrationsRequest
+	119, // 148: events.v1.EventRegistrationsService.HoldEventRegistration:input_type -> events.v1.HoldEventRegistrationRequest
+	121, // 149: events.v1.EventRegistrationsService.ConfirmRegistration:input_type -> events.v1.ConfirmRegistrationRequest
+	123, // 150: events.v1.EventRegistrationsService.NotifyRegistrants:input_type -> events.v1.NotifyRegistrantsRequest
+	125, // 151: events.v1.EventRegistrationsService.GetEventRegistrationStatus:input_type -> events.v1.GetEventRegistrationStatusRequest
+	127, // 152: events.v1.EventAttendanceService.CheckInAttendee:input_type -> events.v1.CheckInAttendeeRequest
+	129, // 153: events.v1.EventAttendanceService.BulkCheckIn:input_type -> events.v1.BulkCheckInRequest
+	132, // 154: events.v1.EventAttendanceService.MarkAttendance:input_type -> events.v1.MarkAttendanceRequest
+	134, // 155: events.v1.EventAttendanceService.GetEventAttendance:input_type -> events.v1.GetEventAttendanceRequest
+	136, // 156: events.v1.EventAttendanceService.StreamEventAttendance:input_type -> events.v1.StreamEventAttendanceRequest
+	141, // 157: events.v1.EventAttendanceService.ExportEventAttendance:input_type -> events.v1.ExportEventAttendanceRequest
+	138, // 158: events.v1.EventAttendanceService.GetUserAttendanceHistory:input_type -> events.v1.GetUserAttendanceHistoryRequest
+	143, // 159: events.v1.StatisticsService.GetDashboardStatistics:input_type -> events.v1.GetDashboardStatisticsRequest
+	145, // 160: events.v1.StatisticsService.GetEventStatistics:input_type -> events.v1.GetEventStatisticsRequest
+	148, // 161: events.v1.StatisticsService.GetEventTagsDistributionByMonth:input_type -> events.v1.GetEventTagsDistributionByMonthRequest
+	151, // 162: events.v1.StatisticsService.GetEventActivityByYear:input_type -> events.v1.GetEventActivityByYearRequest
+	154, // 163: events.v1.StatisticsService.GetOverallStatistics:input_type -> events.v1.GetOverallStatisticsRequest
+	157, // 164: events.v1.StatisticsService.GetEventTrends:input_type -> events.v1.GetEventTrendsRequest
+	159, // 165: events.v1.StatisticsService.GetEventCountByFormat:input_type -> events.v1.GetEventCountByFormatRequest
+	162, // 166: events.v1.StatisticsService.GetTopPerformingClubs:input_type -> events.v1.GetTopPerformingClubsRequest
+	164, // 167: events.v1.StatisticsService.GetClubLeaderboard:input_type -> events.v1.GetClubLeaderboardRequest
+	166, // 168: events.v1.StatisticsService.GetUserEngagementLevels:input_type -> events.v1.GetUserEngagementLevelsRequest
+	170, // 169: events.v1.StatisticsService.GetTopPerformingEvents:input_type -> events.v1.GetTopPerformingEventsRequest
+	173, // 170: events.v1.StatisticsService.GetLowRegistrationEvents:input_type -> events.v1.GetLowRegistrationEventsRequest
+	176, // 171: events.v1.StatisticsService.GetOrganizationActivity:input_type -> events.v1.GetOrganizationActivityRequest
+	178, // 172: events.v1.StatisticsService.GetStatisticsForOrganization:input_type -> events.v1.GetStatisticsForOrganizationRequest
+	184, // 173: events.v1.WebhooksService.CreateWebhook:input_type -> events.v1.CreateWebhookRequest
+	186, // 174: events.v1.WebhooksService.ListWebhooks:input_type -> events.v1.ListWebhooksRequest
+	188, // 175: events.v1.WebhooksService.DeleteWebhook:input_type -> events.v1.DeleteWebhookRequest
+	190, // 176: events.v1.WebhooksService.GetWebhookDeliveries:input_type -> events.v1.GetWebhookDeliveriesRequest
+	192, // 177: events.v1.WebhooksService.RetryWebhookDelivery:input_type -> events.v1.RetryWebhookDeliveryRequest
+	195, // 178: events.v1.AuditService.ListAuditLogs:input_type -> events.v1.ListAuditLogsRequest
+	20,  // 179: events.v1.OrganizationsService.CreateOrganization:output_type -> events.v1.CreateOrganizationResponse
+	22,  // 180: events.v1.OrganizationsService.GetOrganization:output_type -> events.v1.GetOrganizationResponse
+	24,  // 181: events.v1.OrganizationsService.ListOrganizations:output_type -> events.v1.ListOrganizationsResponse
+	26,  // 182: events.v1.OrganizationsService.UpdateOrganization:output_type -> events.v1.UpdateOrganizationResponse
+	46,  // 183: events.v1.OrganizationsService.DeleteOrganization:output_type -> events.v1.DeleteOrganizationResponse
+	44,  // 184: events.v1.OrganizationsService.MergeOrganizations:output_type -> events.v1.MergeOrganizationsResponse
+	90,  // 185: events.v1.OrganizationsService.GetPublishableOrganizations:output_type -> events.v1.GetPublishableOrganizationsResponse
+	92,  // 186: events.v1.OrganizationsService.GetUserOrganizations:output_type -> events.v1.GetUserOrganizationsResponse
+	28,  // 187: events.v1.OrganizationsService.GetOrganizationQuotaUsage:output_type -> events.v1.GetOrganizationQuotaUsageResponse
+	31,  // 188: events.v1.OrganizationsService.GetOrganizationMembers:output_type -> events.v1.GetOrganizationMembersResponse
+	33,  // 189: events.v1.OrganizationsService.AssignClubRole:output_type -> events.v1.AssignClubRoleResponse
+	35,  // 190: events.v1.OrganizationsService.RemoveClubMember:output_type -> events.v1.RemoveClubMemberResponse
+	38,  // 191: events.v1.OrganizationsService.SubmitOrganizationInquiry:output_type -> events.v1.SubmitOrganizationInquiryResponse
+	40,  // 192: events.v1.OrganizationsService.ListOrganizationInquiries:output_type -> events.v1.ListOrganizationInquiriesResponse
+	42,  // 193: events.v1.OrganizationsService.UpdateInquiryStatus:output_type -> events.v1.UpdateInquiryStatusResponse
+	48,  // 194: events.v1.OrganizationTypesService.CreateOrganizationType:output_type -> events.v1.CreateOrganizationTypeResponse
+	50,  // 195: events.v1.OrganizationTypesService.GetOrganizationType:output_type -> events.v1.GetOrganizationTypeResponse
+	52,  // 196: events.v1.OrganizationTypesService.ListOrganizationTypes:output_type -> events.v1.ListOrganizationTypesResponse
+	54,  // 197: events.v1.OrganizationTypesService.UpdateOrganizationType:output_type -> events.v1.UpdateOrganizationTypeResponse
+	56,  // 198: events.v1.OrganizationTypesService.DeleteOrganizationType:output_type -> events.v1.DeleteOrganizationTypeResponse
+	58,  // 199: events.v1.EventsService.CreateEvent:output_type -> events.v1.CreateEventResponse
+	60,  // 200: events.v1.EventsService.BulkCreateEvents:output_type -> events.v1.BulkCreateEventsResponse
+	62,  // 201: events.v1.EventsService.DuplicateEvent:output_type -> events.v1.DuplicateEventResponse
+	64,  // 202: events.v1.EventsService.GetEvent:output_type -> events.v1.GetEventResponse
+	66,  // 203: events.v1.EventsService.ListEvents:output_type -> events.v1.ListEventsResponse
+	109, // 204: events.v1.EventsService.ListEventsForAdmin:output_type -> events.v1.ListEventsForAdminResponse
+	68,  // 205: events.v1.EventsService.UpdateEvent:output_type -> events.v1.UpdateEventResponse
+	70,  // 206: events.v1.EventsService.DeleteEvent:output_type -> events.v1.DeleteEventResponse
+	72,  // 207: events.v1.EventsService.RestoreEvent:output_type -> events.v1.RestoreEventResponse
+	74,  // 208: events.v1.EventsService.ListDeletedEvents:output_type -> events.v1.ListDeletedEventsResponse
+	76,  // 209: events.v1.EventsService.ToggleEventVisibility:output_type -> events.v1.ToggleEventVisibilityResponse
+	94,  // 210: events.v1.EventsService.GetEventsByTagId:output_type -> events.v1.GetEventsByTagIdResponse
+	96,  // 211: events.v1.EventsService.GetEventsByAllTags:output_type -> events.v1.GetEventsByAllTagsResponse
+	107, // 212: events.v1.EventsService.GetUserSubscribedEvents:output_type -> events.v1.GetUserSubscribedEventsResponse
+	98,  // 213: events.v1.EventsService.GetManagedEvents:output_type -> events.v1.GetManagedEventsResponse
+	101, // 214: events.v1.EventsService.GetEventFeed:output_type -> events.v1.GetEventFeedResponse
+	105, // 215: events.v1.EventsService.GetEventCalendar:output_type -> events.v1.GetEventCalendarResponse
+	181, // 216: events.v1.EventsService.GetEventImageUploadUrl:output_type -> events.v1.GetEventImageUploadUrlResponse
+	78,  // 217: events.v1.TagsService.CreateTag:output_type -> events.v1.CreateTagResponse
+	80,  // 218: events.v1.TagsService.GetTag:output_type -> events.v1.GetTagResponse
+	82,  // 219: events.v1.TagsService.ListTags:output_type -> events.v1.ListTagsResponse
+	84,  // 220: events.v1.TagsService.UpdateTag:output_type -> events.v1.UpdateTagResponse
+	86,  // 221: events.v1.TagsService.DeleteTag:output_type -> events.v1.DeleteTagResponse
+	88,  // 222: events.v1.TagsService.MergeTags:output_type -> events.v1.MergeTagsResponse
+	111, // 223: events.v1.EventRegistrationsService.RegisterForEvent:output_type -> events.v1.RegisterForEventResponse
+	113, // 224: events.v1.EventRegistrationsService.CancelRegistration:output_type -> events.v1.CancelRegistrationResponse
+	115, // 225: events.v1.EventRegistrationsService.GetEventRegistrations:output_type -> events.v1.GetEventRegistrationsResponse
+	117, // 226: events.v1.EventRegistrationsService.GetUserRegistrations:output_type -> events.v1.GetUserRegistrationsResponse
+	120, // 227: events.v1.EventRegistrationsService.HoldEventRegistration:output_type -> events.v1.HoldEventRegistrationResponse
+	122, // 228: events.v1.EventRegistrationsService.ConfirmRegistration:output_type -> events.v1.ConfirmRegistrationResponse
+	124, // 229: events.v1.EventRegistrationsService.NotifyRegistrants:output_type -> events.v1.NotifyRegistrantsResponse
+	126, // 230: events.v1.EventRegistrationsService.GetEventRegistrationStatus:output_type -> events.v1.GetEventRegistrationStatusResponse
+	128, // 231: events.v1.EventAttendanceService.CheckInAttendee:output_type -> events.v1.CheckInAttendeeResponse
+	131, // 232: events.v1.EventAttendanceService.BulkCheckIn:output_type -> events.v1.BulkCheckInResponse
+	133, // 233: events.v1.EventAttendanceService.MarkAttendance:output_type -> events.v1.MarkAttendanceResponse
+	135, // 234: events.v1.EventAttendanceService.GetEventAttendance:output_type -> events.v1.GetEventAttendanceResponse
+	137, // 235: events.v1.EventAttendanceService.StreamEventAttendance:output_type -> events.v1.StreamEventAttendanceResponse
+	142, // 236: events.v1.EventAttendanceService.ExportEventAttendance:output_type -> events.v1.AttendanceExportChunk
+	140, // 237: events.v1.EventAttendanceService.GetUserAttendanceHistory:output_type -> events.v1.UserAttendanceHistoryResponse
+	144, // 238: events.v1.StatisticsService.GetDashboardStatistics:output_type -> events.v1.GetDashboardStatisticsResponse
+	146, // 239: events.v1.StatisticsService.GetEventStatistics:output_type -> events.v1.GetEventStatisticsResponse
+	149, // 240: events.v1.StatisticsService.GetEventTagsDistributionByMonth:output_type -> events.v1.GetEventTagsDistributionByMonthResponse
+	152, // 241: events.v1.StatisticsService.GetEventActivityByYear:output_type -> events.v1.GetEventActivityByYearResponse
+	155, // 242: events.v1.StatisticsService.GetOverallStatistics:output_type -> events.v1.GetOverallStatisticsResponse
+	158, // 243: events.v1.StatisticsService.GetEventTrends:output_type -> events.v1.GetEventTrendsResponse
+	160, // 244: events.v1.StatisticsService.GetEventCountByFormat:output_type -> events.v1.GetEventCountByFormatResponse
+	163, // 245: events.v1.StatisticsService.GetTopPerformingClubs:output_type -> events.v1.GetTopPerformingClubsResponse
+	165, // 246: events.v1.StatisticsService.GetClubLeaderboard:output_type -> events.v1.ClubLeaderboardResponse
+	168, // 247: events.v1.StatisticsService.GetUserEngagementLevels:output_type -> events.v1.GetUserEngagementLevelsResponse
+	171, // 248: events.v1.StatisticsService.GetTopPerformingEvents:output_type -> events.v1.GetTopPerformingEventsResponse
+	174, // 249: events.v1.StatisticsService.GetLowRegistrationEvents:output_type -> events.v1.GetLowRegistrationEventsResponse
+	177, // 250: events.v1.StatisticsService.GetOrganizationActivity:output_type -> events.v1.GetOrganizationActivityResponse
+	179, // 251: events.v1.StatisticsService.GetStatisticsForOrganization:output_type -> events.v1.OrganizationStatisticsResponse
+	185, // 252: events.v1.WebhooksService.CreateWebhook:output_type -> events.v1.CreateWebhookResponse
+	187, // 253: events.v1.WebhooksService.ListWebhooks:output_type -> events.v1.ListWebhooksResponse
+	189, // 254: events.v1.WebhooksService.DeleteWebhook:output_type -> events.v1.DeleteWebhookResponse
+	191, // 255: events.v1.WebhooksService.GetWebhookDeliveries:output_type -> events.v1.GetWebhookDeliveriesResponse
+	193, // 256: events.v1.WebhooksService.RetryWebhookDelivery:output_type -> events.v1.RetryWebhookDeliveryResponse
+	196, // 257: events.v1.AuditService.ListAuditLogs:output_type -> events.v1.ListAuditLogsResponse
+	179, // [179:258] is the sub-list for method output_type
+	100, // [100:179] is the sub-list for method input_type
+	100, // [100:100] is the sub-list for extension type_name
+	100, // [100:100] is the sub-list for extension extendee
+	0,   // [0:100] is the sub-list for field type_name
 }
 
 func init() { file_eventsv1_events_proto_init() }
@@ -7264,29 +13329,49 @@ func file_eventsv1_events_proto_init() {
 	file_eventsv1_events_proto_msgTypes[4].OneofWrappers = []any{}
 	file_eventsv1_events_proto_msgTypes[5].OneofWrappers = []any{}
 	file_eventsv1_events_proto_msgTypes[8].OneofWrappers = []any{}
+	file_eventsv1_events_proto_msgTypes[12].OneofWrappers = []any{}
 	file_eventsv1_events_proto_msgTypes[14].OneofWrappers = []any{}
-	file_eventsv1_events_proto_msgTypes[24].OneofWrappers = []any{}
-	file_eventsv1_events_proto_msgTypes[28].OneofWrappers = []any{}
-	file_eventsv1_events_proto_msgTypes[32].OneofWrappers = []any{}
-	file_eventsv1_events_proto_msgTypes[34].OneofWrappers = []any{}
-	file_eventsv1_events_proto_msgTypes[44].OneofWrappers = []any{}
+	file_eventsv1_events_proto_msgTypes[17].OneofWrappers = []any{}
+	file_eventsv1_events_proto_msgTypes[42].OneofWrappers = []any{}
+	file_eventsv1_events_proto_msgTypes[46].OneofWrappers = []any{}
+	file_eventsv1_events_proto_msgTypes[53].OneofWrappers = []any{}
+	file_eventsv1_events_proto_msgTypes[54].OneofWrappers = []any{}
+	file_eventsv1_events_proto_msgTypes[55].OneofWrappers = []any{}
 	file_eventsv1_events_proto_msgTypes[56].OneofWrappers = []any{}
-	file_eventsv1_events_proto_msgTypes[62].OneofWrappers = []any{}
-	file_eventsv1_events_proto_msgTypes[66].OneofWrappers = []any{}
-	file_eventsv1_events_proto_msgTypes[68].OneofWrappers = []any{}
+	file_eventsv1_events_proto_msgTypes[70].OneofWrappers = []any{}
+	file_eventsv1_events_proto_msgTypes[72].OneofWrappers = []any{}
 	file_eventsv1_events_proto_msgTypes[88].OneofWrappers = []any{}
-	file_eventsv1_events_proto_msgTypes[94].OneofWrappers = []any{}
+	file_eventsv1_events_proto_msgTypes[90].OneofWrappers = []any{}
+	file_eventsv1_events_proto_msgTypes[92].OneofWrappers = []any{}
 	file_eventsv1_events_proto_msgTypes[97].OneofWrappers = []any{}
+	file_eventsv1_events_proto_msgTypes[98].OneofWrappers = []any{}
 	file_eventsv1_events_proto_msgTypes[100].OneofWrappers = []any{}
+	file_eventsv1_events_proto_msgTypes[103].OneofWrappers = []any{}
+	file_eventsv1_events_proto_msgTypes[105].OneofWrappers = []any{}
+	file_eventsv1_events_proto_msgTypes[111].OneofWrappers = []any{}
+	file_eventsv1_events_proto_msgTypes[115].OneofWrappers = []any{}
+	file_eventsv1_events_proto_msgTypes[116].OneofWrappers = []any{}
+	file_eventsv1_events_proto_msgTypes[121].OneofWrappers = []any{}
+	file_eventsv1_events_proto_msgTypes[127].OneofWrappers = []any{}
+	file_eventsv1_events_proto_msgTypes[128].OneofWrappers = []any{}
+	file_eventsv1_events_proto_msgTypes[129].OneofWrappers = []any{}
+	file_eventsv1_events_proto_msgTypes[148].OneofWrappers = []any{}
+	file_eventsv1_events_proto_msgTypes[150].OneofWrappers = []any{}
+	file_eventsv1_events_proto_msgTypes[154].OneofWrappers = []any{}
+	file_eventsv1_events_proto_msgTypes[158].OneofWrappers = []any{}
+	file_eventsv1_events_proto_msgTypes[161].OneofWrappers = []any{}
+	file_eventsv1_events_proto_msgTypes[164].OneofWrappers = []any{}
+	file_eventsv1_events_proto_msgTypes[172].OneofWrappers = []any{}
+	file_eventsv1_events_proto_msgTypes[183].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_eventsv1_events_proto_rawDesc), len(file_eventsv1_events_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   105,
+			NumEnums:      11,
+			NumMessages:   186,
 			NumExtensions: 0,
-			NumServices:   7,
+			NumServices:   9,
 		},
 		GoTypes:           file_eventsv1_events_proto_goTypes,
 		DependencyIndexes: file_eventsv1_events_proto_depIdxs,
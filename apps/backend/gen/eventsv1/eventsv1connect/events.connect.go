@@ -36,6 +36,10 @@ const (
 	EventAttendanceServiceName = "events.v1.EventAttendanceService"
 	// StatisticsServiceName is the fully-qualified name of the StatisticsService service.
 	StatisticsServiceName = "events.v1.StatisticsService"
+	// WebhooksServiceName is the fully-qualified name of the WebhooksService service.
+	WebhooksServiceName = "events.v1.WebhooksService"
+	// AuditServiceName is the fully-qualified name of the AuditService service.
+	AuditServiceName = "events.v1.AuditService"
 )
 
 // These constants are the fully-qualified names of the RPCs defined in this package. They're
@@ -61,12 +65,36 @@ const (
 	// OrganizationsServiceDeleteOrganizationProcedure is the fully-qualified name of the
 	// OrganizationsService's DeleteOrganization RPC.
 	OrganizationsServiceDeleteOrganizationProcedure = "/events.v1.OrganizationsService/DeleteOrganization"
+	// OrganizationsServiceMergeOrganizationsProcedure is the fully-qualified name of the
+	// OrganizationsService's MergeOrganizations RPC.
+	OrganizationsServiceMergeOrganizationsProcedure = "/events.v1.OrganizationsService/MergeOrganizations"
 	// OrganizationsServiceGetPublishableOrganizationsProcedure is the fully-qualified name of the
 	// OrganizationsService's GetPublishableOrganizations RPC.
 	OrganizationsServiceGetPublishableOrganizationsProcedure = "/events.v1.OrganizationsService/GetPublishableOrganizations"
 	// OrganizationsServiceGetUserOrganizationsProcedure is the fully-qualified name of the
 	// OrganizationsService's GetUserOrganizations RPC.
 	OrganizationsServiceGetUserOrganizationsProcedure = "/events.v1.OrganizationsService/GetUserOrganizations"
+	// OrganizationsServiceGetOrganizationQuotaUsageProcedure is the fully-qualified name of the
+	// OrganizationsService's GetOrganizationQuotaUsage RPC.
+	OrganizationsServiceGetOrganizationQuotaUsageProcedure = "/events.v1.OrganizationsService/GetOrganizationQuotaUsage"
+	// OrganizationsServiceGetOrganizationMembersProcedure is the fully-qualified name of the
+	// OrganizationsService's GetOrganizationMembers RPC.
+	OrganizationsServiceGetOrganizationMembersProcedure = "/events.v1.OrganizationsService/GetOrganizationMembers"
+	// OrganizationsServiceAssignClubRoleProcedure is the fully-qualified name of the
+	// OrganizationsService's AssignClubRole RPC.
+	OrganizationsServiceAssignClubRoleProcedure = "/events.v1.OrganizationsService/AssignClubRole"
+	// OrganizationsServiceRemoveClubMemberProcedure is the fully-qualified name of the
+	// OrganizationsService's RemoveClubMember RPC.
+	OrganizationsServiceRemoveClubMemberProcedure = "/events.v1.OrganizationsService/RemoveClubMember"
+	// OrganizationsServiceSubmitOrganizationInquiryProcedure is the fully-qualified name of the
+	// OrganizationsService's SubmitOrganizationInquiry RPC.
+	OrganizationsServiceSubmitOrganizationInquiryProcedure = "/events.v1.OrganizationsService/SubmitOrganizationInquiry"
+	// OrganizationsServiceListOrganizationInquiriesProcedure is the fully-qualified name of the
+	// OrganizationsService's ListOrganizationInquiries RPC.
+	OrganizationsServiceListOrganizationInquiriesProcedure = "/events.v1.OrganizationsService/ListOrganizationInquiries"
+	// OrganizationsServiceUpdateInquiryStatusProcedure is the fully-qualified name of the
+	// OrganizationsService's UpdateInquiryStatus RPC.
+	OrganizationsServiceUpdateInquiryStatusProcedure = "/events.v1.OrganizationsService/UpdateInquiryStatus"
 	// OrganizationTypesServiceCreateOrganizationTypeProcedure is the fully-qualified name of the
 	// OrganizationTypesService's CreateOrganizationType RPC.
 	OrganizationTypesServiceCreateOrganizationTypeProcedure = "/events.v1.OrganizationTypesService/CreateOrganizationType"
@@ -85,6 +113,12 @@ const (
 	// EventsServiceCreateEventProcedure is the fully-qualified name of the EventsService's CreateEvent
 	// RPC.
 	EventsServiceCreateEventProcedure = "/events.v1.EventsService/CreateEvent"
+	// EventsServiceBulkCreateEventsProcedure is the fully-qualified name of the EventsService's
+	// BulkCreateEvents RPC.
+	EventsServiceBulkCreateEventsProcedure = "/events.v1.EventsService/BulkCreateEvents"
+	// EventsServiceDuplicateEventProcedure is the fully-qualified name of the EventsService's
+	// DuplicateEvent RPC.
+	EventsServiceDuplicateEventProcedure = "/events.v1.EventsService/DuplicateEvent"
 	// EventsServiceGetEventProcedure is the fully-qualified name of the EventsService's GetEvent RPC.
 	EventsServiceGetEventProcedure = "/events.v1.EventsService/GetEvent"
 	// EventsServiceListEventsProcedure is the fully-qualified name of the EventsService's ListEvents
@@ -99,12 +133,33 @@ const (
 	// EventsServiceDeleteEventProcedure is the fully-qualified name of the EventsService's DeleteEvent
 	// RPC.
 	EventsServiceDeleteEventProcedure = "/events.v1.EventsService/DeleteEvent"
+	// EventsServiceRestoreEventProcedure is the fully-qualified name of the EventsService's
+	// RestoreEvent RPC.
+	EventsServiceRestoreEventProcedure = "/events.v1.EventsService/RestoreEvent"
+	// EventsServiceListDeletedEventsProcedure is the fully-qualified name of the EventsService's
+	// ListDeletedEvents RPC.
+	EventsServiceListDeletedEventsProcedure = "/events.v1.EventsService/ListDeletedEvents"
+	// EventsServiceToggleEventVisibilityProcedure is the fully-qualified name of the EventsService's
+	// ToggleEventVisibility RPC.
+	EventsServiceToggleEventVisibilityProcedure = "/events.v1.EventsService/ToggleEventVisibility"
 	// EventsServiceGetEventsByTagIdProcedure is the fully-qualified name of the EventsService's
 	// GetEventsByTagId RPC.
 	EventsServiceGetEventsByTagIdProcedure = "/events.v1.EventsService/GetEventsByTagId"
+	// EventsServiceGetEventsByAllTagsProcedure is the fully-qualified name of the EventsService's
+	// GetEventsByAllTags RPC.
+	EventsServiceGetEventsByAllTagsProcedure = "/events.v1.EventsService/GetEventsByAllTags"
 	// EventsServiceGetUserSubscribedEventsProcedure is the fully-qualified name of the EventsService's
 	// GetUserSubscribedEvents RPC.
 	EventsServiceGetUserSubscribedEventsProcedure = "/events.v1.EventsService/GetUserSubscribedEvents"
+	// EventsServiceGetManagedEventsProcedure is the fully-qualified name of the EventsService's
+	// GetManagedEvents RPC.
+	EventsServiceGetManagedEventsProcedure = "/events.v1.EventsService/GetManagedEvents"
+	// EventsServiceGetEventFeedProcedure is the fully-qualified name of the EventsService's
+	// GetEventFeed RPC.
+	EventsServiceGetEventFeedProcedure = "/events.v1.EventsService/GetEventFeed"
+	// EventsServiceGetEventCalendarProcedure is the fully-qualified name of the EventsService's
+	// GetEventCalendar RPC.
+	EventsServiceGetEventCalendarProcedure = "/events.v1.EventsService/GetEventCalendar"
 	// EventsServiceGetEventImageUploadUrlProcedure is the fully-qualified name of the EventsService's
 	// GetEventImageUploadUrl RPC.
 	EventsServiceGetEventImageUploadUrlProcedure = "/events.v1.EventsService/GetEventImageUploadUrl"
@@ -118,6 +173,8 @@ const (
 	TagsServiceUpdateTagProcedure = "/events.v1.TagsService/UpdateTag"
 	// TagsServiceDeleteTagProcedure is the fully-qualified name of the TagsService's DeleteTag RPC.
 	TagsServiceDeleteTagProcedure = "/events.v1.TagsService/DeleteTag"
+	// TagsServiceMergeTagsProcedure is the fully-qualified name of the TagsService's MergeTags RPC.
+	TagsServiceMergeTagsProcedure = "/events.v1.TagsService/MergeTags"
 	// EventRegistrationsServiceRegisterForEventProcedure is the fully-qualified name of the
 	// EventRegistrationsService's RegisterForEvent RPC.
 	EventRegistrationsServiceRegisterForEventProcedure = "/events.v1.EventRegistrationsService/RegisterForEvent"
@@ -130,15 +187,39 @@ const (
 	// EventRegistrationsServiceGetUserRegistrationsProcedure is the fully-qualified name of the
 	// EventRegistrationsService's GetUserRegistrations RPC.
 	EventRegistrationsServiceGetUserRegistrationsProcedure = "/events.v1.EventRegistrationsService/GetUserRegistrations"
+	// EventRegistrationsServiceHoldEventRegistrationProcedure is the fully-qualified name of the
+	// EventRegistrationsService's HoldEventRegistration RPC.
+	EventRegistrationsServiceHoldEventRegistrationProcedure = "/events.v1.EventRegistrationsService/HoldEventRegistration"
+	// EventRegistrationsServiceConfirmRegistrationProcedure is the fully-qualified name of the
+	// EventRegistrationsService's ConfirmRegistration RPC.
+	EventRegistrationsServiceConfirmRegistrationProcedure = "/events.v1.EventRegistrationsService/ConfirmRegistration"
+	// EventRegistrationsServiceNotifyRegistrantsProcedure is the fully-qualified name of the
+	// EventRegistrationsService's NotifyRegistrants RPC.
+	EventRegistrationsServiceNotifyRegistrantsProcedure = "/events.v1.EventRegistrationsService/NotifyRegistrants"
+	// EventRegistrationsServiceGetEventRegistrationStatusProcedure is the fully-qualified name of the
+	// EventRegistrationsService's GetEventRegistrationStatus RPC.
+	EventRegistrationsServiceGetEventRegistrationStatusProcedure = "/events.v1.EventRegistrationsService/GetEventRegistrationStatus"
 	// EventAttendanceServiceCheckInAttendeeProcedure is the fully-qualified name of the
 	// EventAttendanceService's CheckInAttendee RPC.
 	EventAttendanceServiceCheckInAttendeeProcedure = "/events.v1.EventAttendanceService/CheckInAttendee"
+	// EventAttendanceServiceBulkCheckInProcedure is the fully-qualified name of the
+	// EventAttendanceService's BulkCheckIn RPC.
+	EventAttendanceServiceBulkCheckInProcedure = "/events.v1.EventAttendanceService/BulkCheckIn"
 	// EventAttendanceServiceMarkAttendanceProcedure is the fully-qualified name of the
 	// EventAttendanceService's MarkAttendance RPC.
 	EventAttendanceServiceMarkAttendanceProcedure = "/events.v1.EventAttendanceService/MarkAttendance"
 	// EventAttendanceServiceGetEventAttendanceProcedure is the fully-qualified name of the
 	// EventAttendanceService's GetEventAttendance RPC.
 	EventAttendanceServiceGetEventAttendanceProcedure = "/events.v1.EventAttendanceService/GetEventAttendance"
+	// EventAttendanceServiceStreamEventAttendanceProcedure is the fully-qualified name of the
+	// EventAttendanceService's StreamEventAttendance RPC.
+	EventAttendanceServiceStreamEventAttendanceProcedure = "/events.v1.EventAttendanceService/StreamEventAttendance"
+	// EventAttendanceServiceExportEventAttendanceProcedure is the fully-qualified name of the
+	// EventAttendanceService's ExportEventAttendance RPC.
+	EventAttendanceServiceExportEventAttendanceProcedure = "/events.v1.EventAttendanceService/ExportEventAttendance"
+	// EventAttendanceServiceGetUserAttendanceHistoryProcedure is the fully-qualified name of the
+	// EventAttendanceService's GetUserAttendanceHistory RPC.
+	EventAttendanceServiceGetUserAttendanceHistoryProcedure = "/events.v1.EventAttendanceService/GetUserAttendanceHistory"
 	// StatisticsServiceGetDashboardStatisticsProcedure is the fully-qualified name of the
 	// StatisticsService's GetDashboardStatistics RPC.
 	StatisticsServiceGetDashboardStatisticsProcedure = "/events.v1.StatisticsService/GetDashboardStatistics"
@@ -157,9 +238,15 @@ const (
 	// StatisticsServiceGetEventTrendsProcedure is the fully-qualified name of the StatisticsService's
 	// GetEventTrends RPC.
 	StatisticsServiceGetEventTrendsProcedure = "/events.v1.StatisticsService/GetEventTrends"
+	// StatisticsServiceGetEventCountByFormatProcedure is the fully-qualified name of the
+	// StatisticsService's GetEventCountByFormat RPC.
+	StatisticsServiceGetEventCountByFormatProcedure = "/events.v1.StatisticsService/GetEventCountByFormat"
 	// StatisticsServiceGetTopPerformingClubsProcedure is the fully-qualified name of the
 	// StatisticsService's GetTopPerformingClubs RPC.
 	StatisticsServiceGetTopPerformingClubsProcedure = "/events.v1.StatisticsService/GetTopPerformingClubs"
+	// StatisticsServiceGetClubLeaderboardProcedure is the fully-qualified name of the
+	// StatisticsService's GetClubLeaderboard RPC.
+	StatisticsServiceGetClubLeaderboardProcedure = "/events.v1.StatisticsService/GetClubLeaderboard"
 	// StatisticsServiceGetUserEngagementLevelsProcedure is the fully-qualified name of the
 	// StatisticsService's GetUserEngagementLevels RPC.
 	StatisticsServiceGetUserEngagementLevelsProcedure = "/events.v1.StatisticsService/GetUserEngagementLevels"
@@ -172,6 +259,27 @@ const (
 	// StatisticsServiceGetOrganizationActivityProcedure is the fully-qualified name of the
 	// StatisticsService's GetOrganizationActivity RPC.
 	StatisticsServiceGetOrganizationActivityProcedure = "/events.v1.StatisticsService/GetOrganizationActivity"
+	// StatisticsServiceGetStatisticsForOrganizationProcedure is the fully-qualified name of the
+	// StatisticsService's GetStatisticsForOrganization RPC.
+	StatisticsServiceGetStatisticsForOrganizationProcedure = "/events.v1.StatisticsService/GetStatisticsForOrganization"
+	// WebhooksServiceCreateWebhookProcedure is the fully-qualified name of the WebhooksService's
+	// CreateWebhook RPC.
+	WebhooksServiceCreateWebhookProcedure = "/events.v1.WebhooksService/CreateWebhook"
+	// WebhooksServiceListWebhooksProcedure is the fully-qualified name of the WebhooksService's
+	// ListWebhooks RPC.
+	WebhooksServiceListWebhooksProcedure = "/events.v1.WebhooksService/ListWebhooks"
+	// WebhooksServiceDeleteWebhookProcedure is the fully-qualified name of the WebhooksService's
+	// DeleteWebhook RPC.
+	WebhooksServiceDeleteWebhookProcedure = "/events.v1.WebhooksService/DeleteWebhook"
+	// WebhooksServiceGetWebhookDeliveriesProcedure is the fully-qualified name of the WebhooksService's
+	// GetWebhookDeliveries RPC.
+	WebhooksServiceGetWebhookDeliveriesProcedure = "/events.v1.WebhooksService/GetWebhookDeliveries"
+	// WebhooksServiceRetryWebhookDeliveryProcedure is the fully-qualified name of the WebhooksService's
+	// RetryWebhookDelivery RPC.
+	WebhooksServiceRetryWebhookDeliveryProcedure = "/events.v1.WebhooksService/RetryWebhookDelivery"
+	// AuditServiceListAuditLogsProcedure is the fully-qualified name of the AuditService's
+	// ListAuditLogs RPC.
+	AuditServiceListAuditLogsProcedure = "/events.v1.AuditService/ListAuditLogs"
 )
 
 // OrganizationsServiceClient is a client for the events.v1.OrganizationsService service.
@@ -181,8 +289,16 @@ type OrganizationsServiceClient interface {
 	ListOrganizations(context.Context, *connect.Request[eventsv1.ListOrganizationsRequest]) (*connect.Response[eventsv1.ListOrganizationsResponse], error)
 	UpdateOrganization(context.Context, *connect.Request[eventsv1.UpdateOrganizationRequest]) (*connect.Response[eventsv1.UpdateOrganizationResponse], error)
 	DeleteOrganization(context.Context, *connect.Request[eventsv1.DeleteOrganizationRequest]) (*connect.Response[eventsv1.DeleteOrganizationResponse], error)
+	MergeOrganizations(context.Context, *connect.Request[eventsv1.MergeOrganizationsRequest]) (*connect.Response[eventsv1.MergeOrganizationsResponse], error)
 	GetPublishableOrganizations(context.Context, *connect.Request[eventsv1.GetPublishableOrganizationsRequest]) (*connect.Response[eventsv1.GetPublishableOrganizationsResponse], error)
 	GetUserOrganizations(context.Context, *connect.Request[eventsv1.GetUserOrganizationsRequest]) (*connect.Response[eventsv1.GetUserOrganizationsResponse], error)
+	GetOrganizationQuotaUsage(context.Context, *connect.Request[eventsv1.GetOrganizationQuotaUsageRequest]) (*connect.Response[eventsv1.GetOrganizationQuotaUsageResponse], error)
+	GetOrganizationMembers(context.Context, *connect.Request[eventsv1.GetOrganizationMembersRequest]) (*connect.Response[eventsv1.GetOrganizationMembersResponse], error)
+	AssignClubRole(context.Context, *connect.Request[eventsv1.AssignClubRoleRequest]) (*connect.Response[eventsv1.AssignClubRoleResponse], error)
+	RemoveClubMember(context.Context, *connect.Request[eventsv1.RemoveClubMemberRequest]) (*connect.Response[eventsv1.RemoveClubMemberResponse], error)
+	SubmitOrganizationInquiry(context.Context, *connect.Request[eventsv1.SubmitOrganizationInquiryRequest]) (*connect.Response[eventsv1.SubmitOrganizationInquiryResponse], error)
+	ListOrganizationInquiries(context.Context, *connect.Request[eventsv1.ListOrganizationInquiriesRequest]) (*connect.Response[eventsv1.ListOrganizationInquiriesResponse], error)
+	UpdateInquiryStatus(context.Context, *connect.Request[eventsv1.UpdateInquiryStatusRequest]) (*connect.Response[eventsv1.UpdateInquiryStatusResponse], error)
 }
 
 // NewOrganizationsServiceClient constructs a client for the events.v1.OrganizationsService service.
@@ -226,6 +342,12 @@ func NewOrganizationsServiceClient(httpClient connect.HTTPClient, baseURL string
 			connect.WithSchema(organizationsServiceMethods.ByName("DeleteOrganization")),
 			connect.WithClientOptions(opts...),
 		),
+		mergeOrganizations: connect.NewClient[eventsv1.MergeOrganizationsRequest, eventsv1.MergeOrganizationsResponse](
+			httpClient,
+			baseURL+OrganizationsServiceMergeOrganizationsProcedure,
+			connect.WithSchema(organizationsServiceMethods.ByName("MergeOrganizations")),
+			connect.WithClientOptions(opts...),
+		),
 		getPublishableOrganizations: connect.NewClient[eventsv1.GetPublishableOrganizationsRequest, eventsv1.GetPublishableOrganizationsResponse](
 			httpClient,
 			baseURL+OrganizationsServiceGetPublishableOrganizationsProcedure,
@@ -238,6 +360,48 @@ func NewOrganizationsServiceClient(httpClient connect.HTTPClient, baseURL string
 			connect.WithSchema(organizationsServiceMethods.ByName("GetUserOrganizations")),
 			connect.WithClientOptions(opts...),
 		),
+		getOrganizationQuotaUsage: connect.NewClient[eventsv1.GetOrganizationQuotaUsageRequest, eventsv1.GetOrganizationQuotaUsageResponse](
+			httpClient,
+			baseURL+OrganizationsServiceGetOrganizationQuotaUsageProcedure,
+			connect.WithSchema(organizationsServiceMethods.ByName("GetOrganizationQuotaUsage")),
+			connect.WithClientOptions(opts...),
+		),
+		getOrganizationMembers: connect.NewClient[eventsv1.GetOrganizationMembersRequest, eventsv1.GetOrganizationMembersResponse](
+			httpClient,
+			baseURL+OrganizationsServiceGetOrganizationMembersProcedure,
+			connect.WithSchema(organizationsServiceMethods.ByName("GetOrganizationMembers")),
+			connect.WithClientOptions(opts...),
+		),
+		assignClubRole: connect.NewClient[eventsv1.AssignClubRoleRequest, eventsv1.AssignClubRoleResponse](
+			httpClient,
+			baseURL+OrganizationsServiceAssignClubRoleProcedure,
+			connect.WithSchema(organizationsServiceMethods.ByName("AssignClubRole")),
+			connect.WithClientOptions(opts...),
+		),
+		removeClubMember: connect.NewClient[eventsv1.RemoveClubMemberRequest, eventsv1.RemoveClubMemberResponse](
+			httpClient,
+			baseURL+OrganizationsServiceRemoveClubMemberProcedure,
+			connect.WithSchema(organizationsServiceMethods.ByName("RemoveClubMember")),
+			connect.WithClientOptions(opts...),
+		),
+		submitOrganizationInquiry: connect.NewClient[eventsv1.SubmitOrganizationInquiryRequest, eventsv1.SubmitOrganizationInquiryResponse](
+			httpClient,
+			baseURL+OrganizationsServiceSubmitOrganizationInquiryProcedure,
+			connect.WithSchema(organizationsServiceMethods.ByName("SubmitOrganizationInquiry")),
+			connect.WithClientOptions(opts...),
+		),
+		listOrganizationInquiries: connect.NewClient[eventsv1.ListOrganizationInquiriesRequest, eventsv1.ListOrganizationInquiriesResponse](
+			httpClient,
+			baseURL+OrganizationsServiceListOrganizationInquiriesProcedure,
+			connect.WithSchema(organizationsServiceMethods.ByName("ListOrganizationInquiries")),
+			connect.WithClientOptions(opts...),
+		),
+		updateInquiryStatus: connect.NewClient[eventsv1.UpdateInquiryStatusRequest, eventsv1.UpdateInquiryStatusResponse](
+			httpClient,
+			baseURL+OrganizationsServiceUpdateInquiryStatusProcedure,
+			connect.WithSchema(organizationsServiceMethods.ByName("UpdateInquiryStatus")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -248,8 +412,16 @@ type organizationsServiceClient struct {
 	listOrganizations           *connect.Client[eventsv1.ListOrganizationsRequest, eventsv1.ListOrganizationsResponse]
 	updateOrganization          *connect.Client[eventsv1.UpdateOrganizationRequest, eventsv1.UpdateOrganizationResponse]
 	deleteOrganization          *connect.Client[eventsv1.DeleteOrganizationRequest, eventsv1.DeleteOrganizationResponse]
+	mergeOrganizations          *connect.Client[eventsv1.MergeOrganizationsRequest, eventsv1.MergeOrganizationsResponse]
 	getPublishableOrganizations *connect.Client[eventsv1.GetPublishableOrganizationsRequest, eventsv1.GetPublishableOrganizationsResponse]
 	getUserOrganizations        *connect.Client[eventsv1.GetUserOrganizationsRequest, eventsv1.GetUserOrganizationsResponse]
+	getOrganizationQuotaUsage   *connect.Client[eventsv1.GetOrganizationQuotaUsageRequest, eventsv1.GetOrganizationQuotaUsageResponse]
+	getOrganizationMembers      *connect.Client[eventsv1.GetOrganizationMembersRequest, eventsv1.GetOrganizationMembersResponse]
+	assignClubRole              *connect.Client[eventsv1.AssignClubRoleRequest, eventsv1.AssignClubRoleResponse]
+	removeClubMember            *connect.Client[eventsv1.RemoveClubMemberRequest, eventsv1.RemoveClubMemberResponse]
+	submitOrganizationInquiry   *connect.Client[eventsv1.SubmitOrganizationInquiryRequest, eventsv1.SubmitOrganizationInquiryResponse]
+	listOrganizationInquiries   *connect.Client[eventsv1.ListOrganizationInquiriesRequest, eventsv1.ListOrganizationInquiriesResponse]
+	updateInquiryStatus         *connect.Client[eventsv1.UpdateInquiryStatusRequest, eventsv1.UpdateInquiryStatusResponse]
 }
 
 // CreateOrganization calls events.v1.OrganizationsService.CreateOrganization.
@@ -277,6 +449,11 @@ func (c *organizationsServiceClient) DeleteOrganization(ctx context.Context, req
 	return c.deleteOrganization.CallUnary(ctx, req)
 }
 
+// MergeOrganizations calls events.v1.OrganizationsService.MergeOrganizations.
+func (c *organizationsServiceClient) MergeOrganizations(ctx context.Context, req *connect.Request[eventsv1.MergeOrganizationsRequest]) (*connect.Response[eventsv1.MergeOrganizationsResponse], error) {
+	return c.mergeOrganizations.CallUnary(ctx, req)
+}
+
 // GetPublishableOrganizations calls events.v1.OrganizationsService.GetPublishableOrganizations.
 func (c *organizationsServiceClient) GetPublishableOrganizations(ctx context.Context, req *connect.Request[eventsv1.GetPublishableOrganizationsRequest]) (*connect.Response[eventsv1.GetPublishableOrganizationsResponse], error) {
 	return c.getPublishableOrganizations.CallUnary(ctx, req)
@@ -287,6 +464,41 @@ func (c *organizationsServiceClient) GetUserOrganizations(ctx context.Context, r
 	return c.getUserOrganizations.CallUnary(ctx, req)
 }
 
+// GetOrganizationQuotaUsage calls events.v1.OrganizationsService.GetOrganizationQuotaUsage.
+func (c *organizationsServiceClient) GetOrganizationQuotaUsage(ctx context.Context, req *connect.Request[eventsv1.GetOrganizationQuotaUsageRequest]) (*connect.Response[eventsv1.GetOrganizationQuotaUsageResponse], error) {
+	return c.getOrganizationQuotaUsage.CallUnary(ctx, req)
+}
+
+// GetOrganizationMembers calls events.v1.OrganizationsService.GetOrganizationMembers.
+func (c *organizationsServiceClient) GetOrganizationMembers(ctx context.Context, req *connect.Request[eventsv1.GetOrganizationMembersRequest]) (*connect.Response[eventsv1.GetOrganizationMembersResponse], error) {
+	return c.getOrganizationMembers.CallUnary(ctx, req)
+}
+
+// AssignClubRole calls events.v1.OrganizationsService.AssignClubRole.
+func (c *organizationsServiceClient) AssignClubRole(ctx context.Context, req *connect.Request[eventsv1.AssignClubRoleRequest]) (*connect.Response[eventsv1.AssignClubRoleResponse], error) {
+	return c.assignClubRole.CallUnary(ctx, req)
+}
+
+// RemoveClubMember calls events.v1.OrganizationsService.RemoveClubMember.
+func (c *organizationsServiceClient) RemoveClubMember(ctx context.Context, req *connect.Request[eventsv1.RemoveClubMemberRequest]) (*connect.Response[eventsv1.RemoveClubMemberResponse], error) {
+	return c.removeClubMember.CallUnary(ctx, req)
+}
+
+// SubmitOrganizationInquiry calls events.v1.OrganizationsService.SubmitOrganizationInquiry.
+func (c *organizationsServiceClient) SubmitOrganizationInquiry(ctx context.Context, req *connect.Request[eventsv1.SubmitOrganizationInquiryRequest]) (*connect.Response[eventsv1.SubmitOrganizationInquiryResponse], error) {
+	return c.submitOrganizationInquiry.CallUnary(ctx, req)
+}
+
+// ListOrganizationInquiries calls events.v1.OrganizationsService.ListOrganizationInquiries.
+func (c *organizationsServiceClient) ListOrganizationInquiries(ctx context.Context, req *connect.Request[eventsv1.ListOrganizationInquiriesRequest]) (*connect.Response[eventsv1.ListOrganizationInquiriesResponse], error) {
+	return c.listOrganizationInquiries.CallUnary(ctx, req)
+}
+
+// UpdateInquiryStatus calls events.v1.OrganizationsService.UpdateInquiryStatus.
+func (c *organizationsServiceClient) UpdateInquiryStatus(ctx context.Context, req *connect.Request[eventsv1.UpdateInquiryStatusRequest]) (*connect.Response[eventsv1.UpdateInquiryStatusResponse], error) {
+	return c.updateInquiryStatus.CallUnary(ctx, req)
+}
+
 // OrganizationsServiceHandler is an implementation of the events.v1.OrganizationsService service.
 type OrganizationsServiceHandler interface {
 	CreateOrganization(context.Context, *connect.Request[eventsv1.CreateOrganizationRequest]) (*connect.Response[eventsv1.CreateOrganizationResponse], error)
@@ -294,8 +506,16 @@ type OrganizationsServiceHandler interface {
 	ListOrganizations(context.Context, *connect.Request[eventsv1.ListOrganizationsRequest]) (*connect.Response[eventsv1.ListOrganizationsResponse], error)
 	UpdateOrganization(context.Context, *connect.Request[eventsv1.UpdateOrganizationRequest]) (*connect.Response[eventsv1.UpdateOrganizationResponse], error)
 	DeleteOrganization(context.Context, *connect.Request[eventsv1.DeleteOrganizationRequest]) (*connect.Response[eventsv1.DeleteOrganizationResponse], error)
+	MergeOrganizations(context.Context, *connect.Request[eventsv1.MergeOrganizationsRequest]) (*connect.Response[eventsv1.MergeOrganizationsResponse], error)
 	GetPublishableOrganizations(context.Context, *connect.Request[eventsv1.GetPublishableOrganizationsRequest]) (*connect.Response[eventsv1.GetPublishableOrganizationsResponse], error)
 	GetUserOrganizations(context.Context, *connect.Request[eventsv1.GetUserOrganizationsRequest]) (*connect.Response[eventsv1.GetUserOrganizationsResponse], error)
+	GetOrganizationQuotaUsage(context.Context, *connect.Request[eventsv1.GetOrganizationQuotaUsageRequest]) (*connect.Response[eventsv1.GetOrganizationQuotaUsageResponse], error)
+	GetOrganizationMembers(context.Context, *connect.Request[eventsv1.GetOrganizationMembersRequest]) (*connect.Response[eventsv1.GetOrganizationMembersResponse], error)
+	AssignClubRole(context.Context, *connect.Request[eventsv1.AssignClubRoleRequest]) (*connect.Response[eventsv1.AssignClubRoleResponse], error)
+	RemoveClubMember(context.Context, *connect.Request[eventsv1.RemoveClubMemberRequest]) (*connect.Response[eventsv1.RemoveClubMemberResponse], error)
+	SubmitOrganizationInquiry(context.Context, *connect.Request[eventsv1.SubmitOrganizationInquiryRequest]) (*connect.Response[eventsv1.SubmitOrganizationInquiryResponse], error)
+	ListOrganizationInquiries(context.Context, *connect.Request[eventsv1.ListOrganizationInquiriesRequest]) (*connect.Response[eventsv1.ListOrganizationInquiriesResponse], error)
+	UpdateInquiryStatus(context.Context, *connect.Request[eventsv1.UpdateInquiryStatusRequest]) (*connect.Response[eventsv1.UpdateInquiryStatusResponse], error)
 }
 
 // NewOrganizationsServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -335,6 +555,12 @@ func NewOrganizationsServiceHandler(svc OrganizationsServiceHandler, opts ...con
 		connect.WithSchema(organizationsServiceMethods.ByName("DeleteOrganization")),
 		connect.WithHandlerOptions(opts...),
 	)
+	organizationsServiceMergeOrganizationsHandler := connect.NewUnaryHandler(
+		OrganizationsServiceMergeOrganizationsProcedure,
+		svc.MergeOrganizations,
+		connect.WithSchema(organizationsServiceMethods.ByName("MergeOrganizations")),
+		connect.WithHandlerOptions(opts...),
+	)
 	organizationsServiceGetPublishableOrganizationsHandler := connect.NewUnaryHandler(
 		OrganizationsServiceGetPublishableOrganizationsProcedure,
 		svc.GetPublishableOrganizations,
@@ -347,6 +573,48 @@ func NewOrganizationsServiceHandler(svc OrganizationsServiceHandler, opts ...con
 		connect.WithSchema(organizationsServiceMethods.ByName("GetUserOrganizations")),
 		connect.WithHandlerOptions(opts...),
 	)
+	organizationsServiceGetOrganizationQuotaUsageHandler := connect.NewUnaryHandler(
+		OrganizationsServiceGetOrganizationQuotaUsageProcedure,
+		svc.GetOrganizationQuotaUsage,
+		connect.WithSchema(organizationsServiceMethods.ByName("GetOrganizationQuotaUsage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationsServiceGetOrganizationMembersHandler := connect.NewUnaryHandler(
+		OrganizationsServiceGetOrganizationMembersProcedure,
+		svc.GetOrganizationMembers,
+		connect.WithSchema(organizationsServiceMethods.ByName("GetOrganizationMembers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationsServiceAssignClubRoleHandler := connect.NewUnaryHandler(
+		OrganizationsServiceAssignClubRoleProcedure,
+		svc.AssignClubRole,
+		connect.WithSchema(organizationsServiceMethods.ByName("AssignClubRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationsServiceRemoveClubMemberHandler := connect.NewUnaryHandler(
+		OrganizationsServiceRemoveClubMemberProcedure,
+		svc.RemoveClubMember,
+		connect.WithSchema(organizationsServiceMethods.ByName("RemoveClubMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationsServiceSubmitOrganizationInquiryHandler := connect.NewUnaryHandler(
+		OrganizationsServiceSubmitOrganizationInquiryProcedure,
+		svc.SubmitOrganizationInquiry,
+		connect.WithSchema(organizationsServiceMethods.ByName("SubmitOrganizationInquiry")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationsServiceListOrganizationInquiriesHandler := connect.NewUnaryHandler(
+		OrganizationsServiceListOrganizationInquiriesProcedure,
+		svc.ListOrganizationInquiries,
+		connect.WithSchema(organizationsServiceMethods.ByName("ListOrganizationInquiries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationsServiceUpdateInquiryStatusHandler := connect.NewUnaryHandler(
+		OrganizationsServiceUpdateInquiryStatusProcedure,
+		svc.UpdateInquiryStatus,
+		connect.WithSchema(organizationsServiceMethods.ByName("UpdateInquiryStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/events.v1.OrganizationsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case OrganizationsServiceCreateOrganizationProcedure:
@@ -359,10 +627,26 @@ func NewOrganizationsServiceHandler(svc OrganizationsServiceHandler, opts ...con
 			organizationsServiceUpdateOrganizationHandler.ServeHTTP(w, r)
 		case OrganizationsServiceDeleteOrganizationProcedure:
 			organizationsServiceDeleteOrganizationHandler.ServeHTTP(w, r)
+		case OrganizationsServiceMergeOrganizationsProcedure:
+			organizationsServiceMergeOrganizationsHandler.ServeHTTP(w, r)
 		case OrganizationsServiceGetPublishableOrganizationsProcedure:
 			organizationsServiceGetPublishableOrganizationsHandler.ServeHTTP(w, r)
 		case OrganizationsServiceGetUserOrganizationsProcedure:
 			organizationsServiceGetUserOrganizationsHandler.ServeHTTP(w, r)
+		case OrganizationsServiceGetOrganizationQuotaUsageProcedure:
+			organizationsServiceGetOrganizationQuotaUsageHandler.ServeHTTP(w, r)
+		case OrganizationsServiceGetOrganizationMembersProcedure:
+			organizationsServiceGetOrganizationMembersHandler.ServeHTTP(w, r)
+		case OrganizationsServiceAssignClubRoleProcedure:
+			organizationsServiceAssignClubRoleHandler.ServeHTTP(w, r)
+		case OrganizationsServiceRemoveClubMemberProcedure:
+			organizationsServiceRemoveClubMemberHandler.ServeHTTP(w, r)
+		case OrganizationsServiceSubmitOrganizationInquiryProcedure:
+			organizationsServiceSubmitOrganizationInquiryHandler.ServeHTTP(w, r)
+		case OrganizationsServiceListOrganizationInquiriesProcedure:
+			organizationsServiceListOrganizationInquiriesHandler.ServeHTTP(w, r)
+		case OrganizationsServiceUpdateInquiryStatusProcedure:
+			organizationsServiceUpdateInquiryStatusHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -392,6 +676,10 @@ func (UnimplementedOrganizationsServiceHandler) DeleteOrganization(context.Conte
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.OrganizationsService.DeleteOrganization is not implemented"))
 }
 
+func (UnimplementedOrganizationsServiceHandler) MergeOrganizations(context.Context, *connect.Request[eventsv1.MergeOrganizationsRequest]) (*connect.Response[eventsv1.MergeOrganizationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.OrganizationsService.MergeOrganizations is not implemented"))
+}
+
 func (UnimplementedOrganizationsServiceHandler) GetPublishableOrganizations(context.Context, *connect.Request[eventsv1.GetPublishableOrganizationsRequest]) (*connect.Response[eventsv1.GetPublishableOrganizationsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.OrganizationsService.GetPublishableOrganizations is not implemented"))
 }
@@ -400,6 +688,34 @@ func (UnimplementedOrganizationsServiceHandler) GetUserOrganizations(context.Con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.OrganizationsService.GetUserOrganizations is not implemented"))
 }
 
+func (UnimplementedOrganizationsServiceHandler) GetOrganizationQuotaUsage(context.Context, *connect.Request[eventsv1.GetOrganizationQuotaUsageRequest]) (*connect.Response[eventsv1.GetOrganizationQuotaUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.OrganizationsService.GetOrganizationQuotaUsage is not implemented"))
+}
+
+func (UnimplementedOrganizationsServiceHandler) GetOrganizationMembers(context.Context, *connect.Request[eventsv1.GetOrganizationMembersRequest]) (*connect.Response[eventsv1.GetOrganizationMembersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.OrganizationsService.GetOrganizationMembers is not implemented"))
+}
+
+func (UnimplementedOrganizationsServiceHandler) AssignClubRole(context.Context, *connect.Request[eventsv1.AssignClubRoleRequest]) (*connect.Response[eventsv1.AssignClubRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.OrganizationsService.AssignClubRole is not implemented"))
+}
+
+func (UnimplementedOrganizationsServiceHandler) RemoveClubMember(context.Context, *connect.Request[eventsv1.RemoveClubMemberRequest]) (*connect.Response[eventsv1.RemoveClubMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.OrganizationsService.RemoveClubMember is not implemented"))
+}
+
+func (UnimplementedOrganizationsServiceHandler) SubmitOrganizationInquiry(context.Context, *connect.Request[eventsv1.SubmitOrganizationInquiryRequest]) (*connect.Response[eventsv1.SubmitOrganizationInquiryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.OrganizationsService.SubmitOrganizationInquiry is not implemented"))
+}
+
+func (UnimplementedOrganizationsServiceHandler) ListOrganizationInquiries(context.Context, *connect.Request[eventsv1.ListOrganizationInquiriesRequest]) (*connect.Response[eventsv1.ListOrganizationInquiriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.OrganizationsService.ListOrganizationInquiries is not implemented"))
+}
+
+func (UnimplementedOrganizationsServiceHandler) UpdateInquiryStatus(context.Context, *connect.Request[eventsv1.UpdateInquiryStatusRequest]) (*connect.Response[eventsv1.UpdateInquiryStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.OrganizationsService.UpdateInquiryStatus is not implemented"))
+}
+
 // OrganizationTypesServiceClient is a client for the events.v1.OrganizationTypesService service.
 type OrganizationTypesServiceClient interface {
 	CreateOrganizationType(context.Context, *connect.Request[eventsv1.CreateOrganizationTypeRequest]) (*connect.Response[eventsv1.CreateOrganizationTypeResponse], error)
@@ -578,13 +894,22 @@ func (UnimplementedOrganizationTypesServiceHandler) DeleteOrganizationType(conte
 // EventsServiceClient is a client for the events.v1.EventsService service.
 type EventsServiceClient interface {
 	CreateEvent(context.Context, *connect.Request[eventsv1.CreateEventRequest]) (*connect.Response[eventsv1.CreateEventResponse], error)
+	BulkCreateEvents(context.Context, *connect.Request[eventsv1.BulkCreateEventsRequest]) (*connect.Response[eventsv1.BulkCreateEventsResponse], error)
+	DuplicateEvent(context.Context, *connect.Request[eventsv1.DuplicateEventRequest]) (*connect.Response[eventsv1.DuplicateEventResponse], error)
 	GetEvent(context.Context, *connect.Request[eventsv1.GetEventRequest]) (*connect.Response[eventsv1.GetEventResponse], error)
 	ListEvents(context.Context, *connect.Request[eventsv1.ListEventsRequest]) (*connect.Response[eventsv1.ListEventsResponse], error)
 	ListEventsForAdmin(context.Context, *connect.Request[eventsv1.ListEventsForAdminRequest]) (*connect.Response[eventsv1.ListEventsForAdminResponse], error)
 	UpdateEvent(context.Context, *connect.Request[eventsv1.UpdateEventRequest]) (*connect.Response[eventsv1.UpdateEventResponse], error)
 	DeleteEvent(context.Context, *connect.Request[eventsv1.DeleteEventRequest]) (*connect.Response[eventsv1.DeleteEventResponse], error)
+	RestoreEvent(context.Context, *connect.Request[eventsv1.RestoreEventRequest]) (*connect.Response[eventsv1.RestoreEventResponse], error)
+	ListDeletedEvents(context.Context, *connect.Request[eventsv1.ListDeletedEventsRequest]) (*connect.Response[eventsv1.ListDeletedEventsResponse], error)
+	ToggleEventVisibility(context.Context, *connect.Request[eventsv1.ToggleEventVisibilityRequest]) (*connect.Response[eventsv1.ToggleEventVisibilityResponse], error)
 	GetEventsByTagId(context.Context, *connect.Request[eventsv1.GetEventsByTagIdRequest]) (*connect.Response[eventsv1.GetEventsByTagIdResponse], error)
+	GetEventsByAllTags(context.Context, *connect.Request[eventsv1.GetEventsByAllTagsRequest]) (*connect.Response[eventsv1.GetEventsByAllTagsResponse], error)
 	GetUserSubscribedEvents(context.Context, *connect.Request[eventsv1.GetUserSubscribedEventsRequest]) (*connect.Response[eventsv1.GetUserSubscribedEventsResponse], error)
+	GetManagedEvents(context.Context, *connect.Request[eventsv1.GetManagedEventsRequest]) (*connect.Response[eventsv1.GetManagedEventsResponse], error)
+	GetEventFeed(context.Context, *connect.Request[eventsv1.GetEventFeedRequest]) (*connect.Response[eventsv1.GetEventFeedResponse], error)
+	GetEventCalendar(context.Context, *connect.Request[eventsv1.GetEventCalendarRequest]) (*connect.Response[eventsv1.GetEventCalendarResponse], error)
 	GetEventImageUploadUrl(context.Context, *connect.Request[eventsv1.GetEventImageUploadUrlRequest]) (*connect.Response[eventsv1.GetEventImageUploadUrlResponse], error)
 }
 
@@ -605,6 +930,18 @@ func NewEventsServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(eventsServiceMethods.ByName("CreateEvent")),
 			connect.WithClientOptions(opts...),
 		),
+		bulkCreateEvents: connect.NewClient[eventsv1.BulkCreateEventsRequest, eventsv1.BulkCreateEventsResponse](
+			httpClient,
+			baseURL+EventsServiceBulkCreateEventsProcedure,
+			connect.WithSchema(eventsServiceMethods.ByName("BulkCreateEvents")),
+			connect.WithClientOptions(opts...),
+		),
+		duplicateEvent: connect.NewClient[eventsv1.DuplicateEventRequest, eventsv1.DuplicateEventResponse](
+			httpClient,
+			baseURL+EventsServiceDuplicateEventProcedure,
+			connect.WithSchema(eventsServiceMethods.ByName("DuplicateEvent")),
+			connect.WithClientOptions(opts...),
+		),
 		getEvent: connect.NewClient[eventsv1.GetEventRequest, eventsv1.GetEventResponse](
 			httpClient,
 			baseURL+EventsServiceGetEventProcedure,
@@ -635,18 +972,60 @@ func NewEventsServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(eventsServiceMethods.ByName("DeleteEvent")),
 			connect.WithClientOptions(opts...),
 		),
+		restoreEvent: connect.NewClient[eventsv1.RestoreEventRequest, eventsv1.RestoreEventResponse](
+			httpClient,
+			baseURL+EventsServiceRestoreEventProcedure,
+			connect.WithSchema(eventsServiceMethods.ByName("RestoreEvent")),
+			connect.WithClientOptions(opts...),
+		),
+		listDeletedEvents: connect.NewClient[eventsv1.ListDeletedEventsRequest, eventsv1.ListDeletedEventsResponse](
+			httpClient,
+			baseURL+EventsServiceListDeletedEventsProcedure,
+			connect.WithSchema(eventsServiceMethods.ByName("ListDeletedEvents")),
+			connect.WithClientOptions(opts...),
+		),
+		toggleEventVisibility: connect.NewClient[eventsv1.ToggleEventVisibilityRequest, eventsv1.ToggleEventVisibilityResponse](
+			httpClient,
+			baseURL+EventsServiceToggleEventVisibilityProcedure,
+			connect.WithSchema(eventsServiceMethods.ByName("ToggleEventVisibility")),
+			connect.WithClientOptions(opts...),
+		),
 		getEventsByTagId: connect.NewClient[eventsv1.GetEventsByTagIdRequest, eventsv1.GetEventsByTagIdResponse](
 			httpClient,
 			baseURL+EventsServiceGetEventsByTagIdProcedure,
 			connect.WithSchema(eventsServiceMethods.ByName("GetEventsByTagId")),
 			connect.WithClientOptions(opts...),
 		),
+		getEventsByAllTags: connect.NewClient[eventsv1.GetEventsByAllTagsRequest, eventsv1.GetEventsByAllTagsResponse](
+			httpClient,
+			baseURL+EventsServiceGetEventsByAllTagsProcedure,
+			connect.WithSchema(eventsServiceMethods.ByName("GetEventsByAllTags")),
+			connect.WithClientOptions(opts...),
+		),
 		getUserSubscribedEvents: connect.NewClient[eventsv1.GetUserSubscribedEventsRequest, eventsv1.GetUserSubscribedEventsResponse](
 			httpClient,
 			baseURL+EventsServiceGetUserSubscribedEventsProcedure,
 			connect.WithSchema(eventsServiceMethods.ByName("GetUserSubscribedEvents")),
 			connect.WithClientOptions(opts...),
 		),
+		getManagedEvents: connect.NewClient[eventsv1.GetManagedEventsRequest, eventsv1.GetManagedEventsResponse](
+			httpClient,
+			baseURL+EventsServiceGetManagedEventsProcedure,
+			connect.WithSchema(eventsServiceMethods.ByName("GetManagedEvents")),
+			connect.WithClientOptions(opts...),
+		),
+		getEventFeed: connect.NewClient[eventsv1.GetEventFeedRequest, eventsv1.GetEventFeedResponse](
+			httpClient,
+			baseURL+EventsServiceGetEventFeedProcedure,
+			connect.WithSchema(eventsServiceMethods.ByName("GetEventFeed")),
+			connect.WithClientOptions(opts...),
+		),
+		getEventCalendar: connect.NewClient[eventsv1.GetEventCalendarRequest, eventsv1.GetEventCalendarResponse](
+			httpClient,
+			baseURL+EventsServiceGetEventCalendarProcedure,
+			connect.WithSchema(eventsServiceMethods.ByName("GetEventCalendar")),
+			connect.WithClientOptions(opts...),
+		),
 		getEventImageUploadUrl: connect.NewClient[eventsv1.GetEventImageUploadUrlRequest, eventsv1.GetEventImageUploadUrlResponse](
 			httpClient,
 			baseURL+EventsServiceGetEventImageUploadUrlProcedure,
@@ -659,13 +1038,22 @@ func NewEventsServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 // eventsServiceClient implements EventsServiceClient.
 type eventsServiceClient struct {
 	createEvent             *connect.Client[eventsv1.CreateEventRequest, eventsv1.CreateEventResponse]
+	bulkCreateEvents        *connect.Client[eventsv1.BulkCreateEventsRequest, eventsv1.BulkCreateEventsResponse]
+	duplicateEvent          *connect.Client[eventsv1.DuplicateEventRequest, eventsv1.DuplicateEventResponse]
 	getEvent                *connect.Client[eventsv1.GetEventRequest, eventsv1.GetEventResponse]
 	listEvents              *connect.Client[eventsv1.ListEventsRequest, eventsv1.ListEventsResponse]
 	listEventsForAdmin      *connect.Client[eventsv1.ListEventsForAdminRequest, eventsv1.ListEventsForAdminResponse]
 	updateEvent             *connect.Client[eventsv1.UpdateEventRequest, eventsv1.UpdateEventResponse]
 	deleteEvent             *connect.Client[eventsv1.DeleteEventRequest, eventsv1.DeleteEventResponse]
+	restoreEvent            *connect.Client[eventsv1.RestoreEventRequest, eventsv1.RestoreEventResponse]
+	listDeletedEvents       *connect.Client[eventsv1.ListDeletedEventsRequest, eventsv1.ListDeletedEventsResponse]
+	toggleEventVisibility   *connect.Client[eventsv1.ToggleEventVisibilityRequest, eventsv1.ToggleEventVisibilityResponse]
 	getEventsByTagId        *connect.Client[eventsv1.GetEventsByTagIdRequest, eventsv1.GetEventsByTagIdResponse]
+	getEventsByAllTags      *connect.Client[eventsv1.GetEventsByAllTagsRequest, eventsv1.GetEventsByAllTagsResponse]
 	getUserSubscribedEvents *connect.Client[eventsv1.GetUserSubscribedEventsRequest, eventsv1.GetUserSubscribedEventsResponse]
+	getManagedEvents        *connect.Client[eventsv1.GetManagedEventsRequest, eventsv1.GetManagedEventsResponse]
+	getEventFeed            *connect.Client[eventsv1.GetEventFeedRequest, eventsv1.GetEventFeedResponse]
+	getEventCalendar        *connect.Client[eventsv1.GetEventCalendarRequest, eventsv1.GetEventCalendarResponse]
 	getEventImageUploadUrl  *connect.Client[eventsv1.GetEventImageUploadUrlRequest, eventsv1.GetEventImageUploadUrlResponse]
 }
 
@@ -674,6 +1062,16 @@ func (c *eventsServiceClient) CreateEvent(ctx context.Context, req *connect.Requ
 	return c.createEvent.CallUnary(ctx, req)
 }
 
+// BulkCreateEvents calls events.v1.EventsService.BulkCreateEvents.
+func (c *eventsServiceClient) BulkCreateEvents(ctx context.Context, req *connect.Request[eventsv1.BulkCreateEventsRequest]) (*connect.Response[eventsv1.BulkCreateEventsResponse], error) {
+	return c.bulkCreateEvents.CallUnary(ctx, req)
+}
+
+// DuplicateEvent calls events.v1.EventsService.DuplicateEvent.
+func (c *eventsServiceClient) DuplicateEvent(ctx context.Context, req *connect.Request[eventsv1.DuplicateEventRequest]) (*connect.Response[eventsv1.DuplicateEventResponse], error) {
+	return c.duplicateEvent.CallUnary(ctx, req)
+}
+
 // GetEvent calls events.v1.EventsService.GetEvent.
 func (c *eventsServiceClient) GetEvent(ctx context.Context, req *connect.Request[eventsv1.GetEventRequest]) (*connect.Response[eventsv1.GetEventResponse], error) {
 	return c.getEvent.CallUnary(ctx, req)
@@ -699,16 +1097,51 @@ func (c *eventsServiceClient) DeleteEvent(ctx context.Context, req *connect.Requ
 	return c.deleteEvent.CallUnary(ctx, req)
 }
 
+// RestoreEvent calls events.v1.EventsService.RestoreEvent.
+func (c *eventsServiceClient) RestoreEvent(ctx context.Context, req *connect.Request[eventsv1.RestoreEventRequest]) (*connect.Response[eventsv1.RestoreEventResponse], error) {
+	return c.restoreEvent.CallUnary(ctx, req)
+}
+
+// ListDeletedEvents calls events.v1.EventsService.ListDeletedEvents.
+func (c *eventsServiceClient) ListDeletedEvents(ctx context.Context, req *connect.Request[eventsv1.ListDeletedEventsRequest]) (*connect.Response[eventsv1.ListDeletedEventsResponse], error) {
+	return c.listDeletedEvents.CallUnary(ctx, req)
+}
+
+// ToggleEventVisibility calls events.v1.EventsService.ToggleEventVisibility.
+func (c *eventsServiceClient) ToggleEventVisibility(ctx context.Context, req *connect.Request[eventsv1.ToggleEventVisibilityRequest]) (*connect.Response[eventsv1.ToggleEventVisibilityResponse], error) {
+	return c.toggleEventVisibility.CallUnary(ctx, req)
+}
+
 // GetEventsByTagId calls events.v1.EventsService.GetEventsByTagId.
 func (c *eventsServiceClient) GetEventsByTagId(ctx context.Context, req *connect.Request[eventsv1.GetEventsByTagIdRequest]) (*connect.Response[eventsv1.GetEventsByTagIdResponse], error) {
 	return c.getEventsByTagId.CallUnary(ctx, req)
 }
 
+// GetEventsByAllTags calls events.v1.EventsService.GetEventsByAllTags.
+func (c *eventsServiceClient) GetEventsByAllTags(ctx context.Context, req *connect.Request[eventsv1.GetEventsByAllTagsRequest]) (*connect.Response[eventsv1.GetEventsByAllTagsResponse], error) {
+	return c.getEventsByAllTags.CallUnary(ctx, req)
+}
+
 // GetUserSubscribedEvents calls events.v1.EventsService.GetUserSubscribedEvents.
 func (c *eventsServiceClient) GetUserSubscribedEvents(ctx context.Context, req *connect.Request[eventsv1.GetUserSubscribedEventsRequest]) (*connect.Response[eventsv1.GetUserSubscribedEventsResponse], error) {
 	return c.getUserSubscribedEvents.CallUnary(ctx, req)
 }
 
+// GetManagedEvents calls events.v1.EventsService.GetManagedEvents.
+func (c *eventsServiceClient) GetManagedEvents(ctx context.Context, req *connect.Request[eventsv1.GetManagedEventsRequest]) (*connect.Response[eventsv1.GetManagedEventsResponse], error) {
+	return c.getManagedEvents.CallUnary(ctx, req)
+}
+
+// GetEventFeed calls events.v1.EventsService.GetEventFeed.
+func (c *eventsServiceClient) GetEventFeed(ctx context.Context, req *connect.Request[eventsv1.GetEventFeedRequest]) (*connect.Response[eventsv1.GetEventFeedResponse], error) {
+	return c.getEventFeed.CallUnary(ctx, req)
+}
+
+// GetEventCalendar calls events.v1.EventsService.GetEventCalendar.
+func (c *eventsServiceClient) GetEventCalendar(ctx context.Context, req *connect.Request[eventsv1.GetEventCalendarRequest]) (*connect.Response[eventsv1.GetEventCalendarResponse], error) {
+	return c.getEventCalendar.CallUnary(ctx, req)
+}
+
 // GetEventImageUploadUrl calls events.v1.EventsService.GetEventImageUploadUrl.
 func (c *eventsServiceClient) GetEventImageUploadUrl(ctx context.Context, req *connect.Request[eventsv1.GetEventImageUploadUrlRequest]) (*connect.Response[eventsv1.GetEventImageUploadUrlResponse], error) {
 	return c.getEventImageUploadUrl.CallUnary(ctx, req)
@@ -717,13 +1150,22 @@ func (c *eventsServiceClient) GetEventImageUploadUrl(ctx context.Context, req *c
 // EventsServiceHandler is an implementation of the events.v1.EventsService service.
 type EventsServiceHandler interface {
 	CreateEvent(context.Context, *connect.Request[eventsv1.CreateEventRequest]) (*connect.Response[eventsv1.CreateEventResponse], error)
+	BulkCreateEvents(context.Context, *connect.Request[eventsv1.BulkCreateEventsRequest]) (*connect.Response[eventsv1.BulkCreateEventsResponse], error)
+	DuplicateEvent(context.Context, *connect.Request[eventsv1.DuplicateEventRequest]) (*connect.Response[eventsv1.DuplicateEventResponse], error)
 	GetEvent(context.Context, *connect.Request[eventsv1.GetEventRequest]) (*connect.Response[eventsv1.GetEventResponse], error)
 	ListEvents(context.Context, *connect.Request[eventsv1.ListEventsRequest]) (*connect.Response[eventsv1.ListEventsResponse], error)
 	ListEventsForAdmin(context.Context, *connect.Request[eventsv1.ListEventsForAdminRequest]) (*connect.Response[eventsv1.ListEventsForAdminResponse], error)
 	UpdateEvent(context.Context, *connect.Request[eventsv1.UpdateEventRequest]) (*connect.Response[eventsv1.UpdateEventResponse], error)
 	DeleteEvent(context.Context, *connect.Request[eventsv1.DeleteEventRequest]) (*connect.Response[eventsv1.DeleteEventResponse], error)
+	RestoreEvent(context.Context, *connect.Request[eventsv1.RestoreEventRequest]) (*connect.Response[eventsv1.RestoreEventResponse], error)
+	ListDeletedEvents(context.Context, *connect.Request[eventsv1.ListDeletedEventsRequest]) (*connect.Response[eventsv1.ListDeletedEventsResponse], error)
+	ToggleEventVisibility(context.Context, *connect.Request[eventsv1.ToggleEventVisibilityRequest]) (*connect.Response[eventsv1.ToggleEventVisibilityResponse], error)
 	GetEventsByTagId(context.Context, *connect.Request[eventsv1.GetEventsByTagIdRequest]) (*connect.Response[eventsv1.GetEventsByTagIdResponse], error)
+	GetEventsByAllTags(context.Context, *connect.Request[eventsv1.GetEventsByAllTagsRequest]) (*connect.Response[eventsv1.GetEventsByAllTagsResponse], error)
 	GetUserSubscribedEvents(context.Context, *connect.Request[eventsv1.GetUserSubscribedEventsRequest]) (*connect.Response[eventsv1.GetUserSubscribedEventsResponse], error)
+	GetManagedEvents(context.Context, *connect.Request[eventsv1.GetManagedEventsRequest]) (*connect.Response[eventsv1.GetManagedEventsResponse], error)
+	GetEventFeed(context.Context, *connect.Request[eventsv1.GetEventFeedRequest]) (*connect.Response[eventsv1.GetEventFeedResponse], error)
+	GetEventCalendar(context.Context, *connect.Request[eventsv1.GetEventCalendarRequest]) (*connect.Response[eventsv1.GetEventCalendarResponse], error)
 	GetEventImageUploadUrl(context.Context, *connect.Request[eventsv1.GetEventImageUploadUrlRequest]) (*connect.Response[eventsv1.GetEventImageUploadUrlResponse], error)
 }
 
@@ -740,6 +1182,18 @@ func NewEventsServiceHandler(svc EventsServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(eventsServiceMethods.ByName("CreateEvent")),
 		connect.WithHandlerOptions(opts...),
 	)
+	eventsServiceBulkCreateEventsHandler := connect.NewUnaryHandler(
+		EventsServiceBulkCreateEventsProcedure,
+		svc.BulkCreateEvents,
+		connect.WithSchema(eventsServiceMethods.ByName("BulkCreateEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	eventsServiceDuplicateEventHandler := connect.NewUnaryHandler(
+		EventsServiceDuplicateEventProcedure,
+		svc.DuplicateEvent,
+		connect.WithSchema(eventsServiceMethods.ByName("DuplicateEvent")),
+		connect.WithHandlerOptions(opts...),
+	)
 	eventsServiceGetEventHandler := connect.NewUnaryHandler(
 		EventsServiceGetEventProcedure,
 		svc.GetEvent,
@@ -770,18 +1224,60 @@ func NewEventsServiceHandler(svc EventsServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(eventsServiceMethods.ByName("DeleteEvent")),
 		connect.WithHandlerOptions(opts...),
 	)
+	eventsServiceRestoreEventHandler := connect.NewUnaryHandler(
+		EventsServiceRestoreEventProcedure,
+		svc.RestoreEvent,
+		connect.WithSchema(eventsServiceMethods.ByName("RestoreEvent")),
+		connect.WithHandlerOptions(opts...),
+	)
+	eventsServiceListDeletedEventsHandler := connect.NewUnaryHandler(
+		EventsServiceListDeletedEventsProcedure,
+		svc.ListDeletedEvents,
+		connect.WithSchema(eventsServiceMethods.ByName("ListDeletedEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	eventsServiceToggleEventVisibilityHandler := connect.NewUnaryHandler(
+		EventsServiceToggleEventVisibilityProcedure,
+		svc.ToggleEventVisibility,
+		connect.WithSchema(eventsServiceMethods.ByName("ToggleEventVisibility")),
+		connect.WithHandlerOptions(opts...),
+	)
 	eventsServiceGetEventsByTagIdHandler := connect.NewUnaryHandler(
 		EventsServiceGetEventsByTagIdProcedure,
 		svc.GetEventsByTagId,
 		connect.WithSchema(eventsServiceMethods.ByName("GetEventsByTagId")),
 		connect.WithHandlerOptions(opts...),
 	)
+	eventsServiceGetEventsByAllTagsHandler := connect.NewUnaryHandler(
+		EventsServiceGetEventsByAllTagsProcedure,
+		svc.GetEventsByAllTags,
+		connect.WithSchema(eventsServiceMethods.ByName("GetEventsByAllTags")),
+		connect.WithHandlerOptions(opts...),
+	)
 	eventsServiceGetUserSubscribedEventsHandler := connect.NewUnaryHandler(
 		EventsServiceGetUserSubscribedEventsProcedure,
 		svc.GetUserSubscribedEvents,
 		connect.WithSchema(eventsServiceMethods.ByName("GetUserSubscribedEvents")),
 		connect.WithHandlerOptions(opts...),
 	)
+	eventsServiceGetManagedEventsHandler := connect.NewUnaryHandler(
+		EventsServiceGetManagedEventsProcedure,
+		svc.GetManagedEvents,
+		connect.WithSchema(eventsServiceMethods.ByName("GetManagedEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	eventsServiceGetEventFeedHandler := connect.NewUnaryHandler(
+		EventsServiceGetEventFeedProcedure,
+		svc.GetEventFeed,
+		connect.WithSchema(eventsServiceMethods.ByName("GetEventFeed")),
+		connect.WithHandlerOptions(opts...),
+	)
+	eventsServiceGetEventCalendarHandler := connect.NewUnaryHandler(
+		EventsServiceGetEventCalendarProcedure,
+		svc.GetEventCalendar,
+		connect.WithSchema(eventsServiceMethods.ByName("GetEventCalendar")),
+		connect.WithHandlerOptions(opts...),
+	)
 	eventsServiceGetEventImageUploadUrlHandler := connect.NewUnaryHandler(
 		EventsServiceGetEventImageUploadUrlProcedure,
 		svc.GetEventImageUploadUrl,
@@ -792,6 +1288,10 @@ func NewEventsServiceHandler(svc EventsServiceHandler, opts ...connect.HandlerOp
 		switch r.URL.Path {
 		case EventsServiceCreateEventProcedure:
 			eventsServiceCreateEventHandler.ServeHTTP(w, r)
+		case EventsServiceBulkCreateEventsProcedure:
+			eventsServiceBulkCreateEventsHandler.ServeHTTP(w, r)
+		case EventsServiceDuplicateEventProcedure:
+			eventsServiceDuplicateEventHandler.ServeHTTP(w, r)
 		case EventsServiceGetEventProcedure:
 			eventsServiceGetEventHandler.ServeHTTP(w, r)
 		case EventsServiceListEventsProcedure:
@@ -802,10 +1302,24 @@ func NewEventsServiceHandler(svc EventsServiceHandler, opts ...connect.HandlerOp
 			eventsServiceUpdateEventHandler.ServeHTTP(w, r)
 		case EventsServiceDeleteEventProcedure:
 			eventsServiceDeleteEventHandler.ServeHTTP(w, r)
+		case EventsServiceRestoreEventProcedure:
+			eventsServiceRestoreEventHandler.ServeHTTP(w, r)
+		case EventsServiceListDeletedEventsProcedure:
+			eventsServiceListDeletedEventsHandler.ServeHTTP(w, r)
+		case EventsServiceToggleEventVisibilityProcedure:
+			eventsServiceToggleEventVisibilityHandler.ServeHTTP(w, r)
 		case EventsServiceGetEventsByTagIdProcedure:
 			eventsServiceGetEventsByTagIdHandler.ServeHTTP(w, r)
+		case EventsServiceGetEventsByAllTagsProcedure:
+			eventsServiceGetEventsByAllTagsHandler.ServeHTTP(w, r)
 		case EventsServiceGetUserSubscribedEventsProcedure:
 			eventsServiceGetUserSubscribedEventsHandler.ServeHTTP(w, r)
+		case EventsServiceGetManagedEventsProcedure:
+			eventsServiceGetManagedEventsHandler.ServeHTTP(w, r)
+		case EventsServiceGetEventFeedProcedure:
+			eventsServiceGetEventFeedHandler.ServeHTTP(w, r)
+		case EventsServiceGetEventCalendarProcedure:
+			eventsServiceGetEventCalendarHandler.ServeHTTP(w, r)
 		case EventsServiceGetEventImageUploadUrlProcedure:
 			eventsServiceGetEventImageUploadUrlHandler.ServeHTTP(w, r)
 		default:
@@ -821,6 +1335,14 @@ func (UnimplementedEventsServiceHandler) CreateEvent(context.Context, *connect.R
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.EventsService.CreateEvent is not implemented"))
 }
 
+func (UnimplementedEventsServiceHandler) BulkCreateEvents(context.Context, *connect.Request[eventsv1.BulkCreateEventsRequest]) (*connect.Response[eventsv1.BulkCreateEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.EventsService.BulkCreateEvents is not implemented"))
+}
+
+func (UnimplementedEventsServiceHandler) DuplicateEvent(context.Context, *connect.Request[eventsv1.DuplicateEventRequest]) (*connect.Response[eventsv1.DuplicateEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.EventsService.DuplicateEvent is not implemented"))
+}
+
 func (UnimplementedEventsServiceHandler) GetEvent(context.Context, *connect.Request[eventsv1.GetEventRequest]) (*connect.Response[eventsv1.GetEventResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.EventsService.GetEvent is not implemented"))
 }
@@ -841,14 +1363,42 @@ func (UnimplementedEventsServiceHandler) DeleteEvent(context.Context, *connect.R
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.EventsService.DeleteEvent is not implemented"))
 }
 
+func (UnimplementedEventsServiceHandler) RestoreEvent(context.Context, *connect.Request[eventsv1.RestoreEventRequest]) (*connect.Response[eventsv1.RestoreEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.EventsService.RestoreEvent is not implemented"))
+}
+
+func (UnimplementedEventsServiceHandler) ListDeletedEvents(context.Context, *connect.Request[eventsv1.ListDeletedEventsRequest]) (*connect.Response[eventsv1.ListDeletedEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.EventsService.ListDeletedEvents is not implemented"))
+}
+
+func (UnimplementedEventsServiceHandler) ToggleEventVisibility(context.Context, *connect.Request[eventsv1.ToggleEventVisibilityRequest]) (*connect.Response[eventsv1.ToggleEventVisibilityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.EventsService.ToggleEventVisibility is not implemented"))
+}
+
 func (UnimplementedEventsServiceHandler) GetEventsByTagId(context.Context, *connect.Request[eventsv1.GetEventsByTagIdRequest]) (*connect.Response[eventsv1.GetEventsByTagIdResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.EventsService.GetEventsByTagId is not implemented"))
 }
 
+func (UnimplementedEventsServiceHandler) GetEventsByAllTags(context.Context, *connect.Request[eventsv1.GetEventsByAllTagsRequest]) (*connect.Response[eventsv1.GetEventsByAllTagsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.EventsService.GetEventsByAllTags is not implemented"))
+}
+
 func (UnimplementedEventsServiceHandler) GetUserSubscribedEvents(context.Context, *connect.Request[eventsv1.GetUserSubscribedEventsRequest]) (*connect.Response[eventsv1.GetUserSubscribedEventsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.EventsService.GetUserSubscribedEvents is not implemented"))
 }
 
+func (UnimplementedEventsServiceHandler) GetManagedEvents(context.Context, *connect.Request[eventsv1.GetManagedEventsRequest]) (*connect.Response[eventsv1.GetManagedEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.EventsService.GetManagedEvents is not implemented"))
+}
+
+func (UnimplementedEventsServiceHandler) GetEventFeed(context.Context, *connect.Request[eventsv1.GetEventFeedRequest]) (*connect.Response[eventsv1.GetEventFeedResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.EventsService.GetEventFeed is not implemented"))
+}
+
+func (UnimplementedEventsServiceHandler) GetEventCalendar(context.Context, *connect.Request[eventsv1.GetEventCalendarRequest]) (*connect.Response[eventsv1.GetEventCalendarResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.EventsService.GetEventCalendar is not implemented"))
+}
+
 func (UnimplementedEventsServiceHandler) GetEventImageUploadUrl(context.Context, *connect.Request[eventsv1.GetEventImageUploadUrlRequest]) (*connect.Response[eventsv1.GetEventImageUploadUrlResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.EventsService.GetEventImageUploadUrl is not implemented"))
 }
@@ -860,6 +1410,7 @@ type TagsServiceClient interface {
 	ListTags(context.Context, *connect.Request[eventsv1.ListTagsRequest]) (*connect.Response[eventsv1.ListTagsResponse], error)
 	UpdateTag(context.Context, *connect.Request[eventsv1.UpdateTagRequest]) (*connect.Response[eventsv1.UpdateTagResponse], error)
 	DeleteTag(context.Context, *connect.Request[eventsv1.DeleteTagRequest]) (*connect.Response[eventsv1.DeleteTagResponse], error)
+	MergeTags(context.Context, *connect.Request[eventsv1.MergeTagsRequest]) (*connect.Response[eventsv1.MergeTagsResponse], error)
 }
 
 // NewTagsServiceClient constructs a client for the events.v1.TagsService service. By default, it
@@ -903,6 +1454,12 @@ func NewTagsServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(tagsServiceMethods.ByName("DeleteTag")),
 			connect.WithClientOptions(opts...),
 		),
+		mergeTags: connect.NewClient[eventsv1.MergeTagsRequest, eventsv1.MergeTagsResponse](
+			httpClient,
+			baseURL+TagsServiceMergeTagsProcedure,
+			connect.WithSchema(tagsServiceMethods.ByName("MergeTags")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -913,6 +1470,7 @@ type tagsServiceClient struct {
 	listTags  *connect.Client[eventsv1.ListTagsRequest, eventsv1.ListTagsResponse]
 	updateTag *connect.Client[eventsv1.UpdateTagRequest, eventsv1.UpdateTagResponse]
 	deleteTag *connect.Client[eventsv1.DeleteTagRequest, eventsv1.DeleteTagResponse]
+	mergeTags *connect.Client[eventsv1.MergeTagsRequest, eventsv1.MergeTagsResponse]
 }
 
 // CreateTag calls events.v1.TagsService.CreateTag.
@@ -940,6 +1498,11 @@ func (c *tagsServiceClient) DeleteTag(ctx context.Context, req *connect.Request[
 	return c.deleteTag.CallUnary(ctx, req)
 }
 
+// MergeTags calls events.v1.TagsService.MergeTags.
+func (c *tagsServiceClient) MergeTags(ctx context.Context, req *connect.Request[eventsv1.MergeTagsRequest]) (*connect.Response[eventsv1.MergeTagsResponse], error) {
+	return c.mergeTags.CallUnary(ctx, req)
+}
+
 // TagsServiceHandler is an implementation of the events.v1.TagsService service.
 type TagsServiceHandler interface {
 	CreateTag(context.Context, *connect.Request[eventsv1.CreateTagRequest]) (*connect.Response[eventsv1.CreateTagResponse], error)
@@ -947,6 +1510,7 @@ type TagsServiceHandler interface {
 	ListTags(context.Context, *connect.Request[eventsv1.ListTagsRequest]) (*connect.Response[eventsv1.ListTagsResponse], error)
 	UpdateTag(context.Context, *connect.Request[eventsv1.UpdateTagRequest]) (*connect.Response[eventsv1.UpdateTagResponse], error)
 	DeleteTag(context.Context, *connect.Request[eventsv1.DeleteTagRequest]) (*connect.Response[eventsv1.DeleteTagResponse], error)
+	MergeTags(context.Context, *connect.Request[eventsv1.MergeTagsRequest]) (*connect.Response[eventsv1.MergeTagsResponse], error)
 }
 
 // NewTagsServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -986,6 +1550,12 @@ func NewTagsServiceHandler(svc TagsServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(tagsServiceMethods.ByName("DeleteTag")),
 		connect.WithHandlerOptions(opts...),
 	)
+	tagsServiceMergeTagsHandler := connect.NewUnaryHandler(
+		TagsServiceMergeTagsProcedure,
+		svc.MergeTags,
+		connect.WithSchema(tagsServiceMethods.ByName("MergeTags")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/events.v1.TagsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TagsServiceCreateTagProcedure:
@@ -998,6 +1568,8 @@ func NewTagsServiceHandler(svc TagsServiceHandler, opts ...connect.HandlerOption
 			tagsServiceUpdateTagHandler.ServeHTTP(w, r)
 		case TagsServiceDeleteTagProcedure:
 			tagsServiceDeleteTagHandler.ServeHTTP(w, r)
+		case TagsServiceMergeTagsProcedure:
+			tagsServiceMergeTagsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -1027,12 +1599,20 @@ func (UnimplementedTagsServiceHandler) DeleteTag(context.Context, *connect.Reque
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.TagsService.DeleteTag is not implemented"))
 }
 
+func (UnimplementedTagsServiceHandler) MergeTags(context.Context, *connect.Request[eventsv1.MergeTagsRequest]) (*connect.Response[eventsv1.MergeTagsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.TagsService.MergeTags is not implemented"))
+}
+
 // EventRegistrationsServiceClient is a client for the events.v1.EventRegistrationsService service.
 type EventRegistrationsServiceClient interface {
 	RegisterForEvent(context.Context, *connect.Request[eventsv1.RegisterForEventRequest]) (*connect.Response[eventsv1.RegisterForEventResponse], error)
 	CancelRegistration(context.Context, *connect.Request[eventsv1.CancelRegistrationRequest]) (*connect.Response[eventsv1.CancelRegistrationResponse], error)
 	GetEventRegistrations(context.Context, *connect.Request[eventsv1.GetEventRegistrationsRequest]) (*connect.Response[eventsv1.GetEventRegistrationsResponse], error)
 	GetUserRegistrations(context.Context, *connect.Request[eventsv1.GetUserRegistrationsRequest]) (*connect.Response[eventsv1.GetUserRegistrationsResponse], error)
+	HoldEventRegistration(context.Context, *connect.Request[eventsv1.HoldEventRegistrationRequest]) (*connect.Response[eventsv1.HoldEventRegistrationResponse], error)
+	ConfirmRegistration(context.Context, *connect.Request[eventsv1.ConfirmRegistrationRequest]) (*connect.Response[eventsv1.ConfirmRegistrationResponse], error)
+	NotifyRegistrants(context.Context, *connect.Request[eventsv1.NotifyRegistrantsRequest]) (*connect.Response[eventsv1.NotifyRegistrantsResponse], error)
+	GetEventRegistrationStatus(context.Context, *connect.Request[eventsv1.GetEventRegistrationStatusRequest]) (*connect.Response[eventsv1.GetEventRegistrationStatusResponse], error)
 }
 
 // NewEventRegistrationsServiceClient constructs a client for the
@@ -1070,15 +1650,43 @@ func NewEventRegistrationsServiceClient(httpClient connect.HTTPClient, baseURL s
 			connect.WithSchema(eventRegistrationsServiceMethods.ByName("GetUserRegistrations")),
 			connect.WithClientOptions(opts...),
 		),
+		holdEventRegistration: connect.NewClient[eventsv1.HoldEventRegistrationRequest, eventsv1.HoldEventRegistrationResponse](
+			httpClient,
+			baseURL+EventRegistrationsServiceHoldEventRegistrationProcedure,
+			connect.WithSchema(eventRegistrationsServiceMethods.ByName("HoldEventRegistration")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmRegistration: connect.NewClient[eventsv1.ConfirmRegistrationRequest, eventsv1.ConfirmRegistrationResponse](
+			httpClient,
+			baseURL+EventRegistrationsServiceConfirmRegistrationProcedure,
+			connect.WithSchema(eventRegistrationsServiceMethods.ByName("ConfirmRegistration")),
+			connect.WithClientOptions(opts...),
+		),
+		notifyRegistrants: connect.NewClient[eventsv1.NotifyRegistrantsRequest, eventsv1.NotifyRegistrantsResponse](
+			httpClient,
+			baseURL+EventRegistrationsServiceNotifyRegistrantsProcedure,
+			connect.WithSchema(eventRegistrationsServiceMethods.ByName("NotifyRegistrants")),
+			connect.WithClientOptions(opts...),
+		),
+		getEventRegistrationStatus: connect.NewClient[eventsv1.GetEventRegistrationStatusRequest, eventsv1.GetEventRegistrationStatusResponse](
+			httpClient,
+			baseURL+EventRegistrationsServiceGetEventRegistrationStatusProcedure,
+			connect.WithSchema(eventRegistrationsServiceMethods.ByName("GetEventRegistrationStatus")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // eventRegistrationsServiceClient implements EventRegistrationsServiceClient.
 type eventRegistrationsServiceClient struct {
-	registerForEvent      *connect.Client[eventsv1.RegisterForEventRequest, eventsv1.RegisterForEventResponse]
-	cancelRegistration    *connect.Client[eventsv1.CancelRegistrationRequest, eventsv1.CancelRegistrationResponse]
-	getEventRegistrations *connect.Client[eventsv1.GetEventRegistrationsRequest, eventsv1.GetEventRegistrationsResponse]
-	getUserRegistrations  *connect.Client[eventsv1.GetUserRegistrationsRequest, eventsv1.GetUserRegistrationsResponse]
+	registerForEvent           *connect.Client[eventsv1.RegisterForEventRequest, eventsv1.RegisterForEventResponse]
+	cancelRegistration         *connect.Client[eventsv1.CancelRegistrationRequest, eventsv1.CancelRegistrationResponse]
+	getEventRegistrations      *connect.Client[eventsv1.GetEventRegistrationsRequest, eventsv1.GetEventRegistrationsResponse]
+	getUserRegistrations       *connect.Client[eventsv1.GetUserRegistrationsRequest, eventsv1.GetUserRegistrationsResponse]
+	holdEventRegistration      *connect.Client[eventsv1.HoldEventRegistrationRequest, eventsv1.HoldEventRegistrationResponse]
+	confirmRegistration        *connect.Client[eventsv1.ConfirmRegistrationRequest, eventsv1.ConfirmRegistrationResponse]
+	notifyRegistrants          *connect.Client[eventsv1.NotifyRegistrantsRequest, eventsv1.NotifyRegistrantsResponse]
+	getEventRegistrationStatus *connect.Client[eventsv1.GetEventRegistrationStatusRequest, eventsv1.GetEventRegistrationStatusResponse]
 }
 
 // RegisterForEvent calls events.v1.EventRegistrationsService.RegisterForEvent.
@@ -1101,6 +1709,26 @@ func (c *eventRegistrationsServiceClient) GetUserRegistrations(ctx context.Conte
 	return c.getUserRegistrations.CallUnary(ctx, req)
 }
 
+// HoldEventRegistration calls events.v1.EventRegistrationsService.HoldEventRegistration.
+func (c *eventRegistrationsServiceClient) HoldEventRegistration(ctx context.Context, req *connect.Request[eventsv1.HoldEventRegistrationRequest]) (*connect.Response[eventsv1.HoldEventRegistrationResponse], error) {
+	return c.holdEventRegistration.CallUnary(ctx, req)
+}
+
+// ConfirmRegistration calls events.v1.EventRegistrationsService.ConfirmRegistration.
+func (c *eventRegistrationsServiceClient) ConfirmRegistration(ctx context.Context, req *connect.Request[eventsv1.ConfirmRegistrationRequest]) (*connect.Response[eventsv1.ConfirmRegistrationResponse], error) {
+	return c.confirmRegistration.CallUnary(ctx, req)
+}
+
+// NotifyRegistrants calls events.v1.EventRegistrationsService.NotifyRegistrants.
+func (c *eventRegistrationsServiceClient) NotifyRegistrants(ctx context.Context, req *connect.Request[eventsv1.NotifyRegistrantsRequest]) (*connect.Response[eventsv1.NotifyRegistrantsResponse], error) {
+	return c.notifyRegistrants.CallUnary(ctx, req)
+}
+
+// GetEventRegistrationStatus calls events.v1.EventRegistrationsService.GetEventRegistrationStatus.
+func (c *eventRegistrationsServiceClient) GetEventRegistrationStatus(ctx context.Context, req *connect.Request[eventsv1.GetEventRegistrationStatusRequest]) (*connect.Response[eventsv1.GetEventRegistrationStatusResponse], error) {
+	return c.getEventRegistrationStatus.CallUnary(ctx, req)
+}
+
 // EventRegistrationsServiceHandler is an implementation of the events.v1.EventRegistrationsService
 // service.
 type EventRegistrationsServiceHandler interface {
@@ -1108,6 +1736,10 @@ type EventRegistrationsServiceHandler interface {
 	CancelRegistration(context.Context, *connect.Request[eventsv1.CancelRegistrationRequest]) (*connect.Response[eventsv1.CancelRegistrationResponse], error)
 	GetEventRegistrations(context.Context, *connect.Request[eventsv1.GetEventRegistrationsRequest]) (*connect.Response[eventsv1.GetEventRegistrationsResponse], error)
 	GetUserRegistrations(context.Context, *connect.Request[eventsv1.GetUserRegistrationsRequest]) (*connect.Response[eventsv1.GetUserRegistrationsResponse], error)
+	HoldEventRegistration(context.Context, *connect.Request[eventsv1.HoldEventRegistrationRequest]) (*connect.Response[eventsv1.HoldEventRegistrationResponse], error)
+	ConfirmRegistration(context.Context, *connect.Request[eventsv1.ConfirmRegistrationRequest]) (*connect.Response[eventsv1.ConfirmRegistrationResponse], error)
+	NotifyRegistrants(context.Context, *connect.Request[eventsv1.NotifyRegistrantsRequest]) (*connect.Response[eventsv1.NotifyRegistrantsResponse], error)
+	GetEventRegistrationStatus(context.Context, *connect.Request[eventsv1.GetEventRegistrationStatusRequest]) (*connect.Response[eventsv1.GetEventRegistrationStatusResponse], error)
 }
 
 // NewEventRegistrationsServiceHandler builds an HTTP handler from the service implementation. It
@@ -1141,6 +1773,30 @@ func NewEventRegistrationsServiceHandler(svc EventRegistrationsServiceHandler, o
 		connect.WithSchema(eventRegistrationsServiceMethods.ByName("GetUserRegistrations")),
 		connect.WithHandlerOptions(opts...),
 	)
+	eventRegistrationsServiceHoldEventRegistrationHandler := connect.NewUnaryHandler(
+		EventRegistrationsServiceHoldEventRegistrationProcedure,
+		svc.HoldEventRegistration,
+		connect.WithSchema(eventRegistrationsServiceMethods.ByName("HoldEventRegistration")),
+		connect.WithHandlerOptions(opts...),
+	)
+	eventRegistrationsServiceConfirmRegistrationHandler := connect.NewUnaryHandler(
+		EventRegistrationsServiceConfirmRegistrationProcedure,
+		svc.ConfirmRegistration,
+		connect.WithSchema(eventRegistrationsServiceMethods.ByName("ConfirmRegistration")),
+		connect.WithHandlerOptions(opts...),
+	)
+	eventRegistrationsServiceNotifyRegistrantsHandler := connect.NewUnaryHandler(
+		EventRegistrationsServiceNotifyRegistrantsProcedure,
+		svc.NotifyRegistrants,
+		connect.WithSchema(eventRegistrationsServiceMethods.ByName("NotifyRegistrants")),
+		connect.WithHandlerOptions(opts...),
+	)
+	eventRegistrationsServiceGetEventRegistrationStatusHandler := connect.NewUnaryHandler(
+		EventRegistrationsServiceGetEventRegistrationStatusProcedure,
+		svc.GetEventRegistrationStatus,
+		connect.WithSchema(eventRegistrationsServiceMethods.ByName("GetEventRegistrationStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/events.v1.EventRegistrationsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case EventRegistrationsServiceRegisterForEventProcedure:
@@ -1151,6 +1807,14 @@ func NewEventRegistrationsServiceHandler(svc EventRegistrationsServiceHandler, o
 			eventRegistrationsServiceGetEventRegistrationsHandler.ServeHTTP(w, r)
 		case EventRegistrationsServiceGetUserRegistrationsProcedure:
 			eventRegistrationsServiceGetUserRegistrationsHandler.ServeHTTP(w, r)
+		case EventRegistrationsServiceHoldEventRegistrationProcedure:
+			eventRegistrationsServiceHoldEventRegistrationHandler.ServeHTTP(w, r)
+		case EventRegistrationsServiceConfirmRegistrationProcedure:
+			eventRegistrationsServiceConfirmRegistrationHandler.ServeHTTP(w, r)
+		case EventRegistrationsServiceNotifyRegistrantsProcedure:
+			eventRegistrationsServiceNotifyRegistrantsHandler.ServeHTTP(w, r)
+		case EventRegistrationsServiceGetEventRegistrationStatusProcedure:
+			eventRegistrationsServiceGetEventRegistrationStatusHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -1176,11 +1840,31 @@ func (UnimplementedEventRegistrationsServiceHandler) GetUserRegistrations(contex
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.EventRegistrationsService.GetUserRegistrations is not implemented"))
 }
 
+func (UnimplementedEventRegistrationsServiceHandler) HoldEventRegistration(context.Context, *connect.Request[eventsv1.HoldEventRegistrationRequest]) (*connect.Response[eventsv1.HoldEventRegistrationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.EventRegistrationsService.HoldEventRegistration is not implemented"))
+}
+
+func (UnimplementedEventRegistrationsServiceHandler) ConfirmRegistration(context.Context, *connect.Request[eventsv1.ConfirmRegistrationRequest]) (*connect.Response[eventsv1.ConfirmRegistrationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.EventRegistrationsService.ConfirmRegistration is not implemented"))
+}
+
+func (UnimplementedEventRegistrationsServiceHandler) NotifyRegistrants(context.Context, *connect.Request[eventsv1.NotifyRegistrantsRequest]) (*connect.Response[eventsv1.NotifyRegistrantsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.EventRegistrationsService.NotifyRegistrants is not implemented"))
+}
+
+func (UnimplementedEventRegistrationsServiceHandler) GetEventRegistrationStatus(context.Context, *connect.Request[eventsv1.GetEventRegistrationStatusRequest]) (*connect.Response[eventsv1.GetEventRegistrationStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.EventRegistrationsService.GetEventRegistrationStatus is not implemented"))
+}
+
 // EventAttendanceServiceClient is a client for the events.v1.EventAttendanceService service.
 type EventAttendanceServiceClient interface {
 	CheckInAttendee(context.Context, *connect.Request[eventsv1.CheckInAttendeeRequest]) (*connect.Response[eventsv1.CheckInAttendeeResponse], error)
+	BulkCheckIn(context.Context, *connect.Request[eventsv1.BulkCheckInRequest]) (*connect.Response[eventsv1.BulkCheckInResponse], error)
 	MarkAttendance(context.Context, *connect.Request[eventsv1.MarkAttendanceRequest]) (*connect.Response[eventsv1.MarkAttendanceResponse], error)
 	GetEventAttendance(context.Context, *connect.Request[eventsv1.GetEventAttendanceRequest]) (*connect.Response[eventsv1.GetEventAttendanceResponse], error)
+	StreamEventAttendance(context.Context, *connect.Request[eventsv1.StreamEventAttendanceRequest]) (*connect.ServerStreamForClient[eventsv1.StreamEventAttendanceResponse], error)
+	ExportEventAttendance(context.Context, *connect.Request[eventsv1.ExportEventAttendanceRequest]) (*connect.ServerStreamForClient[eventsv1.AttendanceExportChunk], error)
+	GetUserAttendanceHistory(context.Context, *connect.Request[eventsv1.GetUserAttendanceHistoryRequest]) (*connect.Response[eventsv1.UserAttendanceHistoryResponse], error)
 }
 
 // NewEventAttendanceServiceClient constructs a client for the events.v1.EventAttendanceService
@@ -1200,6 +1884,12 @@ func NewEventAttendanceServiceClient(httpClient connect.HTTPClient, baseURL stri
 			connect.WithSchema(eventAttendanceServiceMethods.ByName("CheckInAttendee")),
 			connect.WithClientOptions(opts...),
 		),
+		bulkCheckIn: connect.NewClient[eventsv1.BulkCheckInRequest, eventsv1.BulkCheckInResponse](
+			httpClient,
+			baseURL+EventAttendanceServiceBulkCheckInProcedure,
+			connect.WithSchema(eventAttendanceServiceMethods.ByName("BulkCheckIn")),
+			connect.WithClientOptions(opts...),
+		),
 		markAttendance: connect.NewClient[eventsv1.MarkAttendanceRequest, eventsv1.MarkAttendanceResponse](
 			httpClient,
 			baseURL+EventAttendanceServiceMarkAttendanceProcedure,
@@ -1212,14 +1902,36 @@ func NewEventAttendanceServiceClient(httpClient connect.HTTPClient, baseURL stri
 			connect.WithSchema(eventAttendanceServiceMethods.ByName("GetEventAttendance")),
 			connect.WithClientOptions(opts...),
 		),
+		streamEventAttendance: connect.NewClient[eventsv1.StreamEventAttendanceRequest, eventsv1.StreamEventAttendanceResponse](
+			httpClient,
+			baseURL+EventAttendanceServiceStreamEventAttendanceProcedure,
+			connect.WithSchema(eventAttendanceServiceMethods.ByName("StreamEventAttendance")),
+			connect.WithClientOptions(opts...),
+		),
+		exportEventAttendance: connect.NewClient[eventsv1.ExportEventAttendanceRequest, eventsv1.AttendanceExportChunk](
+			httpClient,
+			baseURL+EventAttendanceServiceExportEventAttendanceProcedure,
+			connect.WithSchema(eventAttendanceServiceMethods.ByName("ExportEventAttendance")),
+			connect.WithClientOptions(opts...),
+		),
+		getUserAttendanceHistory: connect.NewClient[eventsv1.GetUserAttendanceHistoryRequest, eventsv1.UserAttendanceHistoryResponse](
+			httpClient,
+			baseURL+EventAttendanceServiceGetUserAttendanceHistoryProcedure,
+			connect.WithSchema(eventAttendanceServiceMethods.ByName("GetUserAttendanceHistory")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // eventAttendanceServiceClient implements EventAttendanceServiceClient.
 type eventAttendanceServiceClient struct {
-	checkInAttendee    *connect.Client[eventsv1.CheckInAttendeeRequest, eventsv1.CheckInAttendeeResponse]
-	markAttendance     *connect.Client[eventsv1.MarkAttendanceRequest, eventsv1.MarkAttendanceResponse]
-	getEventAttendance *connect.Client[eventsv1.GetEventAttendanceRequest, eventsv1.GetEventAttendanceResponse]
+	checkInAttendee          *connect.Client[eventsv1.CheckInAttendeeRequest, eventsv1.CheckInAttendeeResponse]
+	bulkCheckIn              *connect.Client[eventsv1.BulkCheckInRequest, eventsv1.BulkCheckInResponse]
+	markAttendance           *connect.Client[eventsv1.MarkAttendanceRequest, eventsv1.MarkAttendanceResponse]
+	getEventAttendance       *connect.Client[eventsv1.GetEventAttendanceRequest, eventsv1.GetEventAttendanceResponse]
+	streamEventAttendance    *connect.Client[eventsv1.StreamEventAttendanceRequest, eventsv1.StreamEventAttendanceResponse]
+	exportEventAttendance    *connect.Client[eventsv1.ExportEventAttendanceRequest, eventsv1.AttendanceExportChunk]
+	getUserAttendanceHistory *connect.Client[eventsv1.GetUserAttendanceHistoryRequest, eventsv1.UserAttendanceHistoryResponse]
 }
 
 // CheckInAttendee calls events.v1.EventAttendanceService.CheckInAttendee.
@@ -1227,6 +1939,11 @@ func (c *eventAttendanceServiceClient) CheckInAttendee(ctx context.Context, req
 	return c.checkInAttendee.CallUnary(ctx, req)
 }
 
+// BulkCheckIn calls events.v1.EventAttendanceService.BulkCheckIn.
+func (c *eventAttendanceServiceClient) BulkCheckIn(ctx context.Context, req *connect.Request[eventsv1.BulkCheckInRequest]) (*connect.Response[eventsv1.BulkCheckInResponse], error) {
+	return c.bulkCheckIn.CallUnary(ctx, req)
+}
+
 // MarkAttendance calls events.v1.EventAttendanceService.MarkAttendance.
 func (c *eventAttendanceServiceClient) MarkAttendance(ctx context.Context, req *connect.Request[eventsv1.MarkAttendanceRequest]) (*connect.Response[eventsv1.MarkAttendanceResponse], error) {
 	return c.markAttendance.CallUnary(ctx, req)
@@ -1237,12 +1954,31 @@ func (c *eventAttendanceServiceClient) GetEventAttendance(ctx context.Context, r
 	return c.getEventAttendance.CallUnary(ctx, req)
 }
 
+// StreamEventAttendance calls events.v1.EventAttendanceService.StreamEventAttendance.
+func (c *eventAttendanceServiceClient) StreamEventAttendance(ctx context.Context, req *connect.Request[eventsv1.StreamEventAttendanceRequest]) (*connect.ServerStreamForClient[eventsv1.StreamEventAttendanceResponse], error) {
+	return c.streamEventAttendance.CallServerStream(ctx, req)
+}
+
+// ExportEventAttendance calls events.v1.EventAttendanceService.ExportEventAttendance.
+func (c *eventAttendanceServiceClient) ExportEventAttendance(ctx context.Context, req *connect.Request[eventsv1.ExportEventAttendanceRequest]) (*connect.ServerStreamForClient[eventsv1.AttendanceExportChunk], error) {
+	return c.exportEventAttendance.CallServerStream(ctx, req)
+}
+
+// GetUserAttendanceHistory calls events.v1.EventAttendanceService.GetUserAttendanceHistory.
+func (c *eventAttendanceServiceClient) GetUserAttendanceHistory(ctx context.Context, req *connect.Request[eventsv1.GetUserAttendanceHistoryRequest]) (*connect.Response[eventsv1.UserAttendanceHistoryResponse], error) {
+	return c.getUserAttendanceHistory.CallUnary(ctx, req)
+}
+
 // EventAttendanceServiceHandler is an implementation of the events.v1.EventAttendanceService
 // service.
 type EventAttendanceServiceHandler interface {
 	CheckInAttendee(context.Context, *connect.Request[eventsv1.CheckInAttendeeRequest]) (*connect.Response[eventsv1.CheckInAttendeeResponse], error)
+	BulkCheckIn(context.Context, *connect.Request[eventsv1.BulkCheckInRequest]) (*connect.Response[eventsv1.BulkCheckInResponse], error)
 	MarkAttendance(context.Context, *connect.Request[eventsv1.MarkAttendanceRequest]) (*connect.Response[eventsv1.MarkAttendanceResponse], error)
 	GetEventAttendance(context.Context, *connect.Request[eventsv1.GetEventAttendanceRequest]) (*connect.Response[eventsv1.GetEventAttendanceResponse], error)
+	StreamEventAttendance(context.Context, *connect.Request[eventsv1.StreamEventAttendanceRequest], *connect.ServerStream[eventsv1.StreamEventAttendanceResponse]) error
+	ExportEventAttendance(context.Context, *connect.Request[eventsv1.ExportEventAttendanceRequest], *connect.ServerStream[eventsv1.AttendanceExportChunk]) error
+	GetUserAttendanceHistory(context.Context, *connect.Request[eventsv1.GetUserAttendanceHistoryRequest]) (*connect.Response[eventsv1.UserAttendanceHistoryResponse], error)
 }
 
 // NewEventAttendanceServiceHandler builds an HTTP handler from the service implementation. It
@@ -1258,6 +1994,12 @@ func NewEventAttendanceServiceHandler(svc EventAttendanceServiceHandler, opts ..
 		connect.WithSchema(eventAttendanceServiceMethods.ByName("CheckInAttendee")),
 		connect.WithHandlerOptions(opts...),
 	)
+	eventAttendanceServiceBulkCheckInHandler := connect.NewUnaryHandler(
+		EventAttendanceServiceBulkCheckInProcedure,
+		svc.BulkCheckIn,
+		connect.WithSchema(eventAttendanceServiceMethods.ByName("BulkCheckIn")),
+		connect.WithHandlerOptions(opts...),
+	)
 	eventAttendanceServiceMarkAttendanceHandler := connect.NewUnaryHandler(
 		EventAttendanceServiceMarkAttendanceProcedure,
 		svc.MarkAttendance,
@@ -1270,14 +2012,40 @@ func NewEventAttendanceServiceHandler(svc EventAttendanceServiceHandler, opts ..
 		connect.WithSchema(eventAttendanceServiceMethods.ByName("GetEventAttendance")),
 		connect.WithHandlerOptions(opts...),
 	)
+	eventAttendanceServiceStreamEventAttendanceHandler := connect.NewServerStreamHandler(
+		EventAttendanceServiceStreamEventAttendanceProcedure,
+		svc.StreamEventAttendance,
+		connect.WithSchema(eventAttendanceServiceMethods.ByName("StreamEventAttendance")),
+		connect.WithHandlerOptions(opts...),
+	)
+	eventAttendanceServiceExportEventAttendanceHandler := connect.NewServerStreamHandler(
+		EventAttendanceServiceExportEventAttendanceProcedure,
+		svc.ExportEventAttendance,
+		connect.WithSchema(eventAttendanceServiceMethods.ByName("ExportEventAttendance")),
+		connect.WithHandlerOptions(opts...),
+	)
+	eventAttendanceServiceGetUserAttendanceHistoryHandler := connect.NewUnaryHandler(
+		EventAttendanceServiceGetUserAttendanceHistoryProcedure,
+		svc.GetUserAttendanceHistory,
+		connect.WithSchema(eventAttendanceServiceMethods.ByName("GetUserAttendanceHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/events.v1.EventAttendanceService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case EventAttendanceServiceCheckInAttendeeProcedure:
 			eventAttendanceServiceCheckInAttendeeHandler.ServeHTTP(w, r)
+		case EventAttendanceServiceBulkCheckInProcedure:
+			eventAttendanceServiceBulkCheckInHandler.ServeHTTP(w, r)
 		case EventAttendanceServiceMarkAttendanceProcedure:
 			eventAttendanceServiceMarkAttendanceHandler.ServeHTTP(w, r)
 		case EventAttendanceServiceGetEventAttendanceProcedure:
 			eventAttendanceServiceGetEventAttendanceHandler.ServeHTTP(w, r)
+		case EventAttendanceServiceStreamEventAttendanceProcedure:
+			eventAttendanceServiceStreamEventAttendanceHandler.ServeHTTP(w, r)
+		case EventAttendanceServiceExportEventAttendanceProcedure:
+			eventAttendanceServiceExportEventAttendanceHandler.ServeHTTP(w, r)
+		case EventAttendanceServiceGetUserAttendanceHistoryProcedure:
+			eventAttendanceServiceGetUserAttendanceHistoryHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -1291,6 +2059,10 @@ func (UnimplementedEventAttendanceServiceHandler) CheckInAttendee(context.Contex
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.EventAttendanceService.CheckInAttendee is not implemented"))
 }
 
+func (UnimplementedEventAttendanceServiceHandler) BulkCheckIn(context.Context, *connect.Request[eventsv1.BulkCheckInRequest]) (*connect.Response[eventsv1.BulkCheckInResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.EventAttendanceService.BulkCheckIn is not implemented"))
+}
+
 func (UnimplementedEventAttendanceServiceHandler) MarkAttendance(context.Context, *connect.Request[eventsv1.MarkAttendanceRequest]) (*connect.Response[eventsv1.MarkAttendanceResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.EventAttendanceService.MarkAttendance is not implemented"))
 }
@@ -1299,6 +2071,18 @@ func (UnimplementedEventAttendanceServiceHandler) GetEventAttendance(context.Con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.EventAttendanceService.GetEventAttendance is not implemented"))
 }
 
+func (UnimplementedEventAttendanceServiceHandler) StreamEventAttendance(context.Context, *connect.Request[eventsv1.StreamEventAttendanceRequest], *connect.ServerStream[eventsv1.StreamEventAttendanceResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.EventAttendanceService.StreamEventAttendance is not implemented"))
+}
+
+func (UnimplementedEventAttendanceServiceHandler) ExportEventAttendance(context.Context, *connect.Request[eventsv1.ExportEventAttendanceRequest], *connect.ServerStream[eventsv1.AttendanceExportChunk]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.EventAttendanceService.ExportEventAttendance is not implemented"))
+}
+
+func (UnimplementedEventAttendanceServiceHandler) GetUserAttendanceHistory(context.Context, *connect.Request[eventsv1.GetUserAttendanceHistoryRequest]) (*connect.Response[eventsv1.UserAttendanceHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.EventAttendanceService.GetUserAttendanceHistory is not implemented"))
+}
+
 // StatisticsServiceClient is a client for the events.v1.StatisticsService service.
 type StatisticsServiceClient interface {
 	GetDashboardStatistics(context.Context, *connect.Request[eventsv1.GetDashboardStatisticsRequest]) (*connect.Response[eventsv1.GetDashboardStatisticsResponse], error)
@@ -1307,11 +2091,14 @@ type StatisticsServiceClient interface {
 	GetEventActivityByYear(context.Context, *connect.Request[eventsv1.GetEventActivityByYearRequest]) (*connect.Response[eventsv1.GetEventActivityByYearResponse], error)
 	GetOverallStatistics(context.Context, *connect.Request[eventsv1.GetOverallStatisticsRequest]) (*connect.Response[eventsv1.GetOverallStatisticsResponse], error)
 	GetEventTrends(context.Context, *connect.Request[eventsv1.GetEventTrendsRequest]) (*connect.Response[eventsv1.GetEventTrendsResponse], error)
+	GetEventCountByFormat(context.Context, *connect.Request[eventsv1.GetEventCountByFormatRequest]) (*connect.Response[eventsv1.GetEventCountByFormatResponse], error)
 	GetTopPerformingClubs(context.Context, *connect.Request[eventsv1.GetTopPerformingClubsRequest]) (*connect.Response[eventsv1.GetTopPerformingClubsResponse], error)
+	GetClubLeaderboard(context.Context, *connect.Request[eventsv1.GetClubLeaderboardRequest]) (*connect.Response[eventsv1.ClubLeaderboardResponse], error)
 	GetUserEngagementLevels(context.Context, *connect.Request[eventsv1.GetUserEngagementLevelsRequest]) (*connect.Response[eventsv1.GetUserEngagementLevelsResponse], error)
 	GetTopPerformingEvents(context.Context, *connect.Request[eventsv1.GetTopPerformingEventsRequest]) (*connect.Response[eventsv1.GetTopPerformingEventsResponse], error)
 	GetLowRegistrationEvents(context.Context, *connect.Request[eventsv1.GetLowRegistrationEventsRequest]) (*connect.Response[eventsv1.GetLowRegistrationEventsResponse], error)
 	GetOrganizationActivity(context.Context, *connect.Request[eventsv1.GetOrganizationActivityRequest]) (*connect.Response[eventsv1.GetOrganizationActivityResponse], error)
+	GetStatisticsForOrganization(context.Context, *connect.Request[eventsv1.GetStatisticsForOrganizationRequest]) (*connect.Response[eventsv1.OrganizationStatisticsResponse], error)
 }
 
 // NewStatisticsServiceClient constructs a client for the events.v1.StatisticsService service. By
@@ -1361,12 +2148,24 @@ func NewStatisticsServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(statisticsServiceMethods.ByName("GetEventTrends")),
 			connect.WithClientOptions(opts...),
 		),
+		getEventCountByFormat: connect.NewClient[eventsv1.GetEventCountByFormatRequest, eventsv1.GetEventCountByFormatResponse](
+			httpClient,
+			baseURL+StatisticsServiceGetEventCountByFormatProcedure,
+			connect.WithSchema(statisticsServiceMethods.ByName("GetEventCountByFormat")),
+			connect.WithClientOptions(opts...),
+		),
 		getTopPerformingClubs: connect.NewClient[eventsv1.GetTopPerformingClubsRequest, eventsv1.GetTopPerformingClubsResponse](
 			httpClient,
 			baseURL+StatisticsServiceGetTopPerformingClubsProcedure,
 			connect.WithSchema(statisticsServiceMethods.ByName("GetTopPerformingClubs")),
 			connect.WithClientOptions(opts...),
 		),
+		getClubLeaderboard: connect.NewClient[eventsv1.GetClubLeaderboardRequest, eventsv1.ClubLeaderboardResponse](
+			httpClient,
+			baseURL+StatisticsServiceGetClubLeaderboardProcedure,
+			connect.WithSchema(statisticsServiceMethods.ByName("GetClubLeaderboard")),
+			connect.WithClientOptions(opts...),
+		),
 		getUserEngagementLevels: connect.NewClient[eventsv1.GetUserEngagementLevelsRequest, eventsv1.GetUserEngagementLevelsResponse](
 			httpClient,
 			baseURL+StatisticsServiceGetUserEngagementLevelsProcedure,
@@ -1391,6 +2190,12 @@ func NewStatisticsServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(statisticsServiceMethods.ByName("GetOrganizationActivity")),
 			connect.WithClientOptions(opts...),
 		),
+		getStatisticsForOrganization: connect.NewClient[eventsv1.GetStatisticsForOrganizationRequest, eventsv1.OrganizationStatisticsResponse](
+			httpClient,
+			baseURL+StatisticsServiceGetStatisticsForOrganizationProcedure,
+			connect.WithSchema(statisticsServiceMethods.ByName("GetStatisticsForOrganization")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -1402,11 +2207,14 @@ type statisticsServiceClient struct {
 	getEventActivityByYear          *connect.Client[eventsv1.GetEventActivityByYearRequest, eventsv1.GetEventActivityByYearResponse]
 	getOverallStatistics            *connect.Client[eventsv1.GetOverallStatisticsRequest, eventsv1.GetOverallStatisticsResponse]
 	getEventTrends                  *connect.Client[eventsv1.GetEventTrendsRequest, eventsv1.GetEventTrendsResponse]
+	getEventCountByFormat           *connect.Client[eventsv1.GetEventCountByFormatRequest, eventsv1.GetEventCountByFormatResponse]
 	getTopPerformingClubs           *connect.Client[eventsv1.GetTopPerformingClubsRequest, eventsv1.GetTopPerformingClubsResponse]
+	getClubLeaderboard              *connect.Client[eventsv1.GetClubLeaderboardRequest, eventsv1.ClubLeaderboardResponse]
 	getUserEngagementLevels         *connect.Client[eventsv1.GetUserEngagementLevelsRequest, eventsv1.GetUserEngagementLevelsResponse]
 	getTopPerformingEvents          *connect.Client[eventsv1.GetTopPerformingEventsRequest, eventsv1.GetTopPerformingEventsResponse]
 	getLowRegistrationEvents        *connect.Client[eventsv1.GetLowRegistrationEventsRequest, eventsv1.GetLowRegistrationEventsResponse]
 	getOrganizationActivity         *connect.Client[eventsv1.GetOrganizationActivityRequest, eventsv1.GetOrganizationActivityResponse]
+	getStatisticsForOrganization    *connect.Client[eventsv1.GetStatisticsForOrganizationRequest, eventsv1.OrganizationStatisticsResponse]
 }
 
 // GetDashboardStatistics calls events.v1.StatisticsService.GetDashboardStatistics.
@@ -1440,11 +2248,21 @@ func (c *statisticsServiceClient) GetEventTrends(ctx context.Context, req *conne
 	return c.getEventTrends.CallUnary(ctx, req)
 }
 
+// GetEventCountByFormat calls events.v1.StatisticsService.GetEventCountByFormat.
+func (c *statisticsServiceClient) GetEventCountByFormat(ctx context.Context, req *connect.Request[eventsv1.GetEventCountByFormatRequest]) (*connect.Response[eventsv1.GetEventCountByFormatResponse], error) {
+	return c.getEventCountByFormat.CallUnary(ctx, req)
+}
+
 // GetTopPerformingClubs calls events.v1.StatisticsService.GetTopPerformingClubs.
 func (c *statisticsServiceClient) GetTopPerformingClubs(ctx context.Context, req *connect.Request[eventsv1.GetTopPerformingClubsRequest]) (*connect.Response[eventsv1.GetTopPerformingClubsResponse], error) {
 	return c.getTopPerformingClubs.CallUnary(ctx, req)
 }
 
+// GetClubLeaderboard calls events.v1.StatisticsService.GetClubLeaderboard.
+func (c *statisticsServiceClient) GetClubLeaderboard(ctx context.Context, req *connect.Request[eventsv1.GetClubLeaderboardRequest]) (*connect.Response[eventsv1.ClubLeaderboardResponse], error) {
+	return c.getClubLeaderboard.CallUnary(ctx, req)
+}
+
 // GetUserEngagementLevels calls events.v1.StatisticsService.GetUserEngagementLevels.
 func (c *statisticsServiceClient) GetUserEngagementLevels(ctx context.Context, req *connect.Request[eventsv1.GetUserEngagementLevelsRequest]) (*connect.Response[eventsv1.GetUserEngagementLevelsResponse], error) {
 	return c.getUserEngagementLevels.CallUnary(ctx, req)
@@ -1465,6 +2283,11 @@ func (c *statisticsServiceClient) GetOrganizationActivity(ctx context.Context, r
 	return c.getOrganizationActivity.CallUnary(ctx, req)
 }
 
+// GetStatisticsForOrganization calls events.v1.StatisticsService.GetStatisticsForOrganization.
+func (c *statisticsServiceClient) GetStatisticsForOrganization(ctx context.Context, req *connect.Request[eventsv1.GetStatisticsForOrganizationRequest]) (*connect.Response[eventsv1.OrganizationStatisticsResponse], error) {
+	return c.getStatisticsForOrganization.CallUnary(ctx, req)
+}
+
 // StatisticsServiceHandler is an implementation of the events.v1.StatisticsService service.
 type StatisticsServiceHandler interface {
 	GetDashboardStatistics(context.Context, *connect.Request[eventsv1.GetDashboardStatisticsRequest]) (*connect.Response[eventsv1.GetDashboardStatisticsResponse], error)
@@ -1473,11 +2296,14 @@ type StatisticsServiceHandler interface {
 	GetEventActivityByYear(context.Context, *connect.Request[eventsv1.GetEventActivityByYearRequest]) (*connect.Response[eventsv1.GetEventActivityByYearResponse], error)
 	GetOverallStatistics(context.Context, *connect.Request[eventsv1.GetOverallStatisticsRequest]) (*connect.Response[eventsv1.GetOverallStatisticsResponse], error)
 	GetEventTrends(context.Context, *connect.Request[eventsv1.GetEventTrendsRequest]) (*connect.Response[eventsv1.GetEventTrendsResponse], error)
+	GetEventCountByFormat(context.Context, *connect.Request[eventsv1.GetEventCountByFormatRequest]) (*connect.Response[eventsv1.GetEventCountByFormatResponse], error)
 	GetTopPerformingClubs(context.Context, *connect.Request[eventsv1.GetTopPerformingClubsRequest]) (*connect.Response[eventsv1.GetTopPerformingClubsResponse], error)
+	GetClubLeaderboard(context.Context, *connect.Request[eventsv1.GetClubLeaderboardRequest]) (*connect.Response[eventsv1.ClubLeaderboardResponse], error)
 	GetUserEngagementLevels(context.Context, *connect.Request[eventsv1.GetUserEngagementLevelsRequest]) (*connect.Response[eventsv1.GetUserEngagementLevelsResponse], error)
 	GetTopPerformingEvents(context.Context, *connect.Request[eventsv1.GetTopPerformingEventsRequest]) (*connect.Response[eventsv1.GetTopPerformingEventsResponse], error)
 	GetLowRegistrationEvents(context.Context, *connect.Request[eventsv1.GetLowRegistrationEventsRequest]) (*connect.Response[eventsv1.GetLowRegistrationEventsResponse], error)
 	GetOrganizationActivity(context.Context, *connect.Request[eventsv1.GetOrganizationActivityRequest]) (*connect.Response[eventsv1.GetOrganizationActivityResponse], error)
+	GetStatisticsForOrganization(context.Context, *connect.Request[eventsv1.GetStatisticsForOrganizationRequest]) (*connect.Response[eventsv1.OrganizationStatisticsResponse], error)
 }
 
 // NewStatisticsServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -1523,12 +2349,24 @@ func NewStatisticsServiceHandler(svc StatisticsServiceHandler, opts ...connect.H
 		connect.WithSchema(statisticsServiceMethods.ByName("GetEventTrends")),
 		connect.WithHandlerOptions(opts...),
 	)
+	statisticsServiceGetEventCountByFormatHandler := connect.NewUnaryHandler(
+		StatisticsServiceGetEventCountByFormatProcedure,
+		svc.GetEventCountByFormat,
+		connect.WithSchema(statisticsServiceMethods.ByName("GetEventCountByFormat")),
+		connect.WithHandlerOptions(opts...),
+	)
 	statisticsServiceGetTopPerformingClubsHandler := connect.NewUnaryHandler(
 		StatisticsServiceGetTopPerformingClubsProcedure,
 		svc.GetTopPerformingClubs,
 		connect.WithSchema(statisticsServiceMethods.ByName("GetTopPerformingClubs")),
 		connect.WithHandlerOptions(opts...),
 	)
+	statisticsServiceGetClubLeaderboardHandler := connect.NewUnaryHandler(
+		StatisticsServiceGetClubLeaderboardProcedure,
+		svc.GetClubLeaderboard,
+		connect.WithSchema(statisticsServiceMethods.ByName("GetClubLeaderboard")),
+		connect.WithHandlerOptions(opts...),
+	)
 	statisticsServiceGetUserEngagementLevelsHandler := connect.NewUnaryHandler(
 		StatisticsServiceGetUserEngagementLevelsProcedure,
 		svc.GetUserEngagementLevels,
@@ -1553,6 +2391,12 @@ func NewStatisticsServiceHandler(svc StatisticsServiceHandler, opts ...connect.H
 		connect.WithSchema(statisticsServiceMethods.ByName("GetOrganizationActivity")),
 		connect.WithHandlerOptions(opts...),
 	)
+	statisticsServiceGetStatisticsForOrganizationHandler := connect.NewUnaryHandler(
+		StatisticsServiceGetStatisticsForOrganizationProcedure,
+		svc.GetStatisticsForOrganization,
+		connect.WithSchema(statisticsServiceMethods.ByName("GetStatisticsForOrganization")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/events.v1.StatisticsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case StatisticsServiceGetDashboardStatisticsProcedure:
@@ -1567,8 +2411,12 @@ func NewStatisticsServiceHandler(svc StatisticsServiceHandler, opts ...connect.H
 			statisticsServiceGetOverallStatisticsHandler.ServeHTTP(w, r)
 		case StatisticsServiceGetEventTrendsProcedure:
 			statisticsServiceGetEventTrendsHandler.ServeHTTP(w, r)
+		case StatisticsServiceGetEventCountByFormatProcedure:
+			statisticsServiceGetEventCountByFormatHandler.ServeHTTP(w, r)
 		case StatisticsServiceGetTopPerformingClubsProcedure:
 			statisticsServiceGetTopPerformingClubsHandler.ServeHTTP(w, r)
+		case StatisticsServiceGetClubLeaderboardProcedure:
+			statisticsServiceGetClubLeaderboardHandler.ServeHTTP(w, r)
 		case StatisticsServiceGetUserEngagementLevelsProcedure:
 			statisticsServiceGetUserEngagementLevelsHandler.ServeHTTP(w, r)
 		case StatisticsServiceGetTopPerformingEventsProcedure:
@@ -1577,6 +2425,8 @@ func NewStatisticsServiceHandler(svc StatisticsServiceHandler, opts ...connect.H
 			statisticsServiceGetLowRegistrationEventsHandler.ServeHTTP(w, r)
 		case StatisticsServiceGetOrganizationActivityProcedure:
 			statisticsServiceGetOrganizationActivityHandler.ServeHTTP(w, r)
+		case StatisticsServiceGetStatisticsForOrganizationProcedure:
+			statisticsServiceGetStatisticsForOrganizationHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -1610,10 +2460,18 @@ func (UnimplementedStatisticsServiceHandler) GetEventTrends(context.Context, *co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.StatisticsService.GetEventTrends is not implemented"))
 }
 
+func (UnimplementedStatisticsServiceHandler) GetEventCountByFormat(context.Context, *connect.Request[eventsv1.GetEventCountByFormatRequest]) (*connect.Response[eventsv1.GetEventCountByFormatResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.StatisticsService.GetEventCountByFormat is not implemented"))
+}
+
 func (UnimplementedStatisticsServiceHandler) GetTopPerformingClubs(context.Context, *connect.Request[eventsv1.GetTopPerformingClubsRequest]) (*connect.Response[eventsv1.GetTopPerformingClubsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.StatisticsService.GetTopPerformingClubs is not implemented"))
 }
 
+func (UnimplementedStatisticsServiceHandler) GetClubLeaderboard(context.Context, *connect.Request[eventsv1.GetClubLeaderboardRequest]) (*connect.Response[eventsv1.ClubLeaderboardResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.StatisticsService.GetClubLeaderboard is not implemented"))
+}
+
 func (UnimplementedStatisticsServiceHandler) GetUserEngagementLevels(context.Context, *connect.Request[eventsv1.GetUserEngagementLevelsRequest]) (*connect.Response[eventsv1.GetUserEngagementLevelsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.StatisticsService.GetUserEngagementLevels is not implemented"))
 }
@@ -1629,3 +2487,251 @@ func (UnimplementedStatisticsServiceHandler) GetLowRegistrationEvents(context.Co
 func (UnimplementedStatisticsServiceHandler) GetOrganizationActivity(context.Context, *connect.Request[eventsv1.GetOrganizationActivityRequest]) (*connect.Response[eventsv1.GetOrganizationActivityResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.StatisticsService.GetOrganizationActivity is not implemented"))
 }
+
+func (UnimplementedStatisticsServiceHandler) GetStatisticsForOrganization(context.Context, *connect.Request[eventsv1.GetStatisticsForOrganizationRequest]) (*connect.Response[eventsv1.OrganizationStatisticsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.StatisticsService.GetStatisticsForOrganization is not implemented"))
+}
+
+// WebhooksServiceClient is a client for the events.v1.WebhooksService service.
+type WebhooksServiceClient interface {
+	CreateWebhook(context.Context, *connect.Request[eventsv1.CreateWebhookRequest]) (*connect.Response[eventsv1.CreateWebhookResponse], error)
+	ListWebhooks(context.Context, *connect.Request[eventsv1.ListWebhooksRequest]) (*connect.Response[eventsv1.ListWebhooksResponse], error)
+	DeleteWebhook(context.Context, *connect.Request[eventsv1.DeleteWebhookRequest]) (*connect.Response[eventsv1.DeleteWebhookResponse], error)
+	GetWebhookDeliveries(context.Context, *connect.Request[eventsv1.GetWebhookDeliveriesRequest]) (*connect.Response[eventsv1.GetWebhookDeliveriesResponse], error)
+	RetryWebhookDelivery(context.Context, *connect.Request[eventsv1.RetryWebhookDeliveryRequest]) (*connect.Response[eventsv1.RetryWebhookDeliveryResponse], error)
+}
+
+// NewWebhooksServiceClient constructs a client for the events.v1.WebhooksService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewWebhooksServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) WebhooksServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	webhooksServiceMethods := eventsv1.File_eventsv1_events_proto.Services().ByName("WebhooksService").Methods()
+	return &webhooksServiceClient{
+		createWebhook: connect.NewClient[eventsv1.CreateWebhookRequest, eventsv1.CreateWebhookResponse](
+			httpClient,
+			baseURL+WebhooksServiceCreateWebhookProcedure,
+			connect.WithSchema(webhooksServiceMethods.ByName("CreateWebhook")),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhooks: connect.NewClient[eventsv1.ListWebhooksRequest, eventsv1.ListWebhooksResponse](
+			httpClient,
+			baseURL+WebhooksServiceListWebhooksProcedure,
+			connect.WithSchema(webhooksServiceMethods.ByName("ListWebhooks")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWebhook: connect.NewClient[eventsv1.DeleteWebhookRequest, eventsv1.DeleteWebhookResponse](
+			httpClient,
+			baseURL+WebhooksServiceDeleteWebhookProcedure,
+			connect.WithSchema(webhooksServiceMethods.ByName("DeleteWebhook")),
+			connect.WithClientOptions(opts...),
+		),
+		getWebhookDeliveries: connect.NewClient[eventsv1.GetWebhookDeliveriesRequest, eventsv1.GetWebhookDeliveriesResponse](
+			httpClient,
+			baseURL+WebhooksServiceGetWebhookDeliveriesProcedure,
+			connect.WithSchema(webhooksServiceMethods.ByName("GetWebhookDeliveries")),
+			connect.WithClientOptions(opts...),
+		),
+		retryWebhookDelivery: connect.NewClient[eventsv1.RetryWebhookDeliveryRequest, eventsv1.RetryWebhookDeliveryResponse](
+			httpClient,
+			baseURL+WebhooksServiceRetryWebhookDeliveryProcedure,
+			connect.WithSchema(webhooksServiceMethods.ByName("RetryWebhookDelivery")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// webhooksServiceClient implements WebhooksServiceClient.
+type webhooksServiceClient struct {
+	createWebhook        *connect.Client[eventsv1.CreateWebhookRequest, eventsv1.CreateWebhookResponse]
+	listWebhooks         *connect.Client[eventsv1.ListWebhooksRequest, eventsv1.ListWebhooksResponse]
+	deleteWebhook        *connect.Client[eventsv1.DeleteWebhookRequest, eventsv1.DeleteWebhookResponse]
+	getWebhookDeliveries *connect.Client[eventsv1.GetWebhookDeliveriesRequest, eventsv1.GetWebhookDeliveriesResponse]
+	retryWebhookDelivery *connect.Client[eventsv1.RetryWebhookDeliveryRequest, eventsv1.RetryWebhookDeliveryResponse]
+}
+
+// CreateWebhook calls events.v1.WebhooksService.CreateWebhook.
+func (c *webhooksServiceClient) CreateWebhook(ctx context.Context, req *connect.Request[eventsv1.CreateWebhookRequest]) (*connect.Response[eventsv1.CreateWebhookResponse], error) {
+	return c.createWebhook.CallUnary(ctx, req)
+}
+
+// ListWebhooks calls events.v1.WebhooksService.ListWebhooks.
+func (c *webhooksServiceClient) ListWebhooks(ctx context.Context, req *connect.Request[eventsv1.ListWebhooksRequest]) (*connect.Response[eventsv1.ListWebhooksResponse], error) {
+	return c.listWebhooks.CallUnary(ctx, req)
+}
+
+// DeleteWebhook calls events.v1.WebhooksService.DeleteWebhook.
+func (c *webhooksServiceClient) DeleteWebhook(ctx context.Context, req *connect.Request[eventsv1.DeleteWebhookRequest]) (*connect.Response[eventsv1.DeleteWebhookResponse], error) {
+	return c.deleteWebhook.CallUnary(ctx, req)
+}
+
+// GetWebhookDeliveries calls events.v1.WebhooksService.GetWebhookDeliveries.
+func (c *webhooksServiceClient) GetWebhookDeliveries(ctx context.Context, req *connect.Request[eventsv1.GetWebhookDeliveriesRequest]) (*connect.Response[eventsv1.GetWebhookDeliveriesResponse], error) {
+	return c.getWebhookDeliveries.CallUnary(ctx, req)
+}
+
+// RetryWebhookDelivery calls events.v1.WebhooksService.RetryWebhookDelivery.
+func (c *webhooksServiceClient) RetryWebhookDelivery(ctx context.Context, req *connect.Request[eventsv1.RetryWebhookDeliveryRequest]) (*connect.Response[eventsv1.RetryWebhookDeliveryResponse], error) {
+	return c.retryWebhookDelivery.CallUnary(ctx, req)
+}
+
+// WebhooksServiceHandler is an implementation of the events.v1.WebhooksService service.
+type WebhooksServiceHandler interface {
+	CreateWebhook(context.Context, *connect.Request[eventsv1.CreateWebhookRequest]) (*connect.Response[eventsv1.CreateWebhookResponse], error)
+	ListWebhooks(context.Context, *connect.Request[eventsv1.ListWebhooksRequest]) (*connect.Response[eventsv1.ListWebhooksResponse], error)
+	DeleteWebhook(context.Context, *connect.Request[eventsv1.DeleteWebhookRequest]) (*connect.Response[eventsv1.DeleteWebhookResponse], error)
+	GetWebhookDeliveries(context.Context, *connect.Request[eventsv1.GetWebhookDeliveriesRequest]) (*connect.Response[eventsv1.GetWebhookDeliveriesResponse], error)
+	RetryWebhookDelivery(context.Context, *connect.Request[eventsv1.RetryWebhookDeliveryRequest]) (*connect.Response[eventsv1.RetryWebhookDeliveryResponse], error)
+}
+
+// NewWebhooksServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewWebhooksServiceHandler(svc WebhooksServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	webhooksServiceMethods := eventsv1.File_eventsv1_events_proto.Services().ByName("WebhooksService").Methods()
+	webhooksServiceCreateWebhookHandler := connect.NewUnaryHandler(
+		WebhooksServiceCreateWebhookProcedure,
+		svc.CreateWebhook,
+		connect.WithSchema(webhooksServiceMethods.ByName("CreateWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhooksServiceListWebhooksHandler := connect.NewUnaryHandler(
+		WebhooksServiceListWebhooksProcedure,
+		svc.ListWebhooks,
+		connect.WithSchema(webhooksServiceMethods.ByName("ListWebhooks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhooksServiceDeleteWebhookHandler := connect.NewUnaryHandler(
+		WebhooksServiceDeleteWebhookProcedure,
+		svc.DeleteWebhook,
+		connect.WithSchema(webhooksServiceMethods.ByName("DeleteWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhooksServiceGetWebhookDeliveriesHandler := connect.NewUnaryHandler(
+		WebhooksServiceGetWebhookDeliveriesProcedure,
+		svc.GetWebhookDeliveries,
+		connect.WithSchema(webhooksServiceMethods.ByName("GetWebhookDeliveries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhooksServiceRetryWebhookDeliveryHandler := connect.NewUnaryHandler(
+		WebhooksServiceRetryWebhookDeliveryProcedure,
+		svc.RetryWebhookDelivery,
+		connect.WithSchema(webhooksServiceMethods.ByName("RetryWebhookDelivery")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/events.v1.WebhooksService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case WebhooksServiceCreateWebhookProcedure:
+			webhooksServiceCreateWebhookHandler.ServeHTTP(w, r)
+		case WebhooksServiceListWebhooksProcedure:
+			webhooksServiceListWebhooksHandler.ServeHTTP(w, r)
+		case WebhooksServiceDeleteWebhookProcedure:
+			webhooksServiceDeleteWebhookHandler.ServeHTTP(w, r)
+		case WebhooksServiceGetWebhookDeliveriesProcedure:
+			webhooksServiceGetWebhookDeliveriesHandler.ServeHTTP(w, r)
+		case WebhooksServiceRetryWebhookDeliveryProcedure:
+			webhooksServiceRetryWebhookDeliveryHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedWebhooksServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedWebhooksServiceHandler struct{}
+
+func (UnimplementedWebhooksServiceHandler) CreateWebhook(context.Context, *connect.Request[eventsv1.CreateWebhookRequest]) (*connect.Response[eventsv1.CreateWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.WebhooksService.CreateWebhook is not implemented"))
+}
+
+func (UnimplementedWebhooksServiceHandler) ListWebhooks(context.Context, *connect.Request[eventsv1.ListWebhooksRequest]) (*connect.Response[eventsv1.ListWebhooksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.WebhooksService.ListWebhooks is not implemented"))
+}
+
+func (UnimplementedWebhooksServiceHandler) DeleteWebhook(context.Context, *connect.Request[eventsv1.DeleteWebhookRequest]) (*connect.Response[eventsv1.DeleteWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.WebhooksService.DeleteWebhook is not implemented"))
+}
+
+func (UnimplementedWebhooksServiceHandler) GetWebhookDeliveries(context.Context, *connect.Request[eventsv1.GetWebhookDeliveriesRequest]) (*connect.Response[eventsv1.GetWebhookDeliveriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.WebhooksService.GetWebhookDeliveries is not implemented"))
+}
+
+func (UnimplementedWebhooksServiceHandler) RetryWebhookDelivery(context.Context, *connect.Request[eventsv1.RetryWebhookDeliveryRequest]) (*connect.Response[eventsv1.RetryWebhookDeliveryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.WebhooksService.RetryWebhookDelivery is not implemented"))
+}
+
+// AuditServiceClient is a client for the events.v1.AuditService service.
+type AuditServiceClient interface {
+	ListAuditLogs(context.Context, *connect.Request[eventsv1.ListAuditLogsRequest]) (*connect.Response[eventsv1.ListAuditLogsResponse], error)
+}
+
+// NewAuditServiceClient constructs a client for the events.v1.AuditService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAuditServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AuditServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	auditServiceMethods := eventsv1.File_eventsv1_events_proto.Services().ByName("AuditService").Methods()
+	return &auditServiceClient{
+		listAuditLogs: connect.NewClient[eventsv1.ListAuditLogsRequest, eventsv1.ListAuditLogsResponse](
+			httpClient,
+			baseURL+AuditServiceListAuditLogsProcedure,
+			connect.WithSchema(auditServiceMethods.ByName("ListAuditLogs")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// auditServiceClient implements AuditServiceClient.
+type auditServiceClient struct {
+	listAuditLogs *connect.Client[eventsv1.ListAuditLogsRequest, eventsv1.ListAuditLogsResponse]
+}
+
+// ListAuditLogs calls events.v1.AuditService.ListAuditLogs.
+func (c *auditServiceClient) ListAuditLogs(ctx context.Context, req *connect.Request[eventsv1.ListAuditLogsRequest]) (*connect.Response[eventsv1.ListAuditLogsResponse], error) {
+	return c.listAuditLogs.CallUnary(ctx, req)
+}
+
+// AuditServiceHandler is an implementation of the events.v1.AuditService service.
+type AuditServiceHandler interface {
+	ListAuditLogs(context.Context, *connect.Request[eventsv1.ListAuditLogsRequest]) (*connect.Response[eventsv1.ListAuditLogsResponse], error)
+}
+
+// NewAuditServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAuditServiceHandler(svc AuditServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	auditServiceMethods := eventsv1.File_eventsv1_events_proto.Services().ByName("AuditService").Methods()
+	auditServiceListAuditLogsHandler := connect.NewUnaryHandler(
+		AuditServiceListAuditLogsProcedure,
+		svc.ListAuditLogs,
+		connect.WithSchema(auditServiceMethods.ByName("ListAuditLogs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/events.v1.AuditService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AuditServiceListAuditLogsProcedure:
+			auditServiceListAuditLogsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAuditServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAuditServiceHandler struct{}
+
+func (UnimplementedAuditServiceHandler) ListAuditLogs(context.Context, *connect.Request[eventsv1.ListAuditLogsRequest]) (*connect.Response[eventsv1.ListAuditLogsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("events.v1.AuditService.ListAuditLogs is not implemented"))
+}
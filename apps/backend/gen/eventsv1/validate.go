@@ -0,0 +1,348 @@
+// Hand-written, not generated: request checks run by validation.NewInterceptor.
+
+package eventsv1
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/studyverse/ems-backend/internal/validation"
+)
+
+const (
+	maxTagNameLength           = 100
+	maxOrganizationTitleLength = 200
+	maxBulkCreateEvents        = 500
+	maxInquiryFieldLength      = 200
+	maxInquiryMessageLength    = 2000
+	maxNotificationSubject     = 200
+	maxBulkCheckIns            = 1000
+	minCalendarYear            = 2000
+	maxCalendarYear            = 2100
+)
+
+func (x *CreateEventRequest) Validate() error {
+	var errs validation.Errors
+
+	errs.NonEmpty("title", x.GetTitle())
+	errs.PositiveID("organization_id", x.GetOrganizationId())
+
+	start, startErr := time.Parse(time.RFC3339, x.GetStartTime())
+	if startErr != nil {
+		errs.Add("start_time", "must be an RFC 3339 timestamp")
+	}
+	end, endErr := time.Parse(time.RFC3339, x.GetEndTime())
+	switch {
+	case endErr != nil:
+		errs.Add("end_time", "must be an RFC 3339 timestamp")
+	case !end.After(time.Now()):
+		errs.Add("end_time", "must be in the future")
+	case startErr == nil && !start.Before(end):
+		errs.Add("start_time", "must be before end_time")
+	}
+
+	return errs.Err()
+}
+
+func (x *DuplicateEventRequest) Validate() error {
+	var errs validation.Errors
+
+	errs.PositiveID("source_event_id", x.GetSourceEventId())
+
+	start, startErr := time.Parse(time.RFC3339, x.GetNewStartTime())
+	if startErr != nil {
+		errs.Add("new_start_time", "must be an RFC 3339 timestamp")
+	}
+	end, endErr := time.Parse(time.RFC3339, x.GetNewEndTime())
+	switch {
+	case endErr != nil:
+		errs.Add("new_end_time", "must be an RFC 3339 timestamp")
+	case !end.After(time.Now()):
+		errs.Add("new_end_time", "must be in the future")
+	case startErr == nil && !start.Before(end):
+		errs.Add("new_start_time", "must be before new_end_time")
+	}
+
+	return errs.Err()
+}
+
+func (x *UpdateEventRequest) Validate() error {
+	var errs validation.Errors
+
+	errs.PositiveID("id", x.GetId())
+	if x.Title != nil {
+		errs.NonEmpty("title", x.GetTitle())
+	}
+	if x.OrganizationId != nil {
+		errs.PositiveID("organization_id", x.GetOrganizationId())
+	}
+
+	var start, end time.Time
+	var startErr, endErr error
+	if x.StartTime != nil {
+		if start, startErr = time.Parse(time.RFC3339, x.GetStartTime()); startErr != nil {
+			errs.Add("start_time", "must be an RFC 3339 timestamp")
+		}
+	}
+	if x.EndTime != nil {
+		if end, endErr = time.Parse(time.RFC3339, x.GetEndTime()); endErr != nil {
+			errs.Add("end_time", "must be an RFC 3339 timestamp")
+		}
+	}
+	if x.StartTime != nil && x.EndTime != nil && startErr == nil && endErr == nil && !start.Before(end) {
+		errs.Add("start_time", "must be before end_time")
+	}
+
+	return errs.Err()
+}
+
+// Validate reports every invalid row at once, prefixing the row's violations
+// with its position, e.g. "events[3].start_time"
+func (x *BulkCreateEventsRequest) Validate() error {
+	var errs validation.Errors
+
+	switch n := len(x.GetEvents()); {
+	case n == 0:
+		errs.Add("events", "must not be empty")
+	case n > maxBulkCreateEvents:
+		errs.Add("events", fmt.Sprintf("must contain at most %d events", maxBulkCreateEvents))
+	}
+
+	for i, event := range x.GetEvents() {
+		prefix := fmt.Sprintf("events[%d].", i)
+		rowErrs, _ := event.Validate().(validation.Errors)
+		for _, v := range rowErrs {
+			errs.Add(prefix+v.Field, v.Description)
+		}
+		if event.Capacity != nil && event.GetCapacity() < 0 {
+			errs.Add(prefix+"capacity", "must not be negative")
+		}
+	}
+
+	return errs.Err()
+}
+
+func (x *BulkCheckInRequest) Validate() error {
+	var errs validation.Errors
+
+	switch n := len(x.GetRegistrationIds()); {
+	case n == 0:
+		errs.Add("registration_ids", "must not be empty")
+	case n > maxBulkCheckIns:
+		errs.Add("registration_ids", fmt.Sprintf("must contain at most %d IDs", maxBulkCheckIns))
+	}
+	errs.PositiveID("checked_in_by", x.GetCheckedInBy())
+
+	return errs.Err()
+}
+
+func (x *GetEventCalendarRequest) Validate() error {
+	var errs validation.Errors
+
+	if x.GetYear() < minCalendarYear || x.GetYear() > maxCalendarYear {
+		errs.Add("year", fmt.Sprintf("must be between %d and %d", minCalendarYear, maxCalendarYear))
+	}
+	if x.GetMonth() < 1 || x.GetMonth() > 12 {
+		errs.Add("month", "must be between 1 and 12")
+	}
+	if x.OrganizationId != nil {
+		errs.PositiveID("organization_id", x.GetOrganizationId())
+	}
+
+	return errs.Err()
+}
+
+func (x *GetEventCountByFormatRequest) Validate() error {
+	var errs validation.Errors
+
+	if x.OrganizationId != nil {
+		errs.PositiveID("organization_id", x.GetOrganizationId())
+	}
+
+	var start, end time.Time
+	var startErr, endErr error
+	if x.StartDate != nil {
+		if start, startErr = time.Parse(time.RFC3339, x.GetStartDate()); startErr != nil {
+			errs.Add("start_date", "must be an RFC 3339 timestamp")
+		}
+	}
+	if x.EndDate != nil {
+		if end, endErr = time.Parse(time.RFC3339, x.GetEndDate()); endErr != nil {
+			errs.Add("end_date", "must be an RFC 3339 timestamp")
+		}
+	}
+	if x.StartDate != nil && x.EndDate != nil && startErr == nil && endErr == nil && end.Before(start) {
+		errs.Add("end_date", "must not be before start_date")
+	}
+
+	return errs.Err()
+}
+
+func (x *CreateTagRequest) Validate() error {
+	var errs validation.Errors
+
+	if errs.NonEmpty("name", x.GetName()) {
+		errs.MaxLength("name", strings.TrimSpace(x.GetName()), maxTagNameLength)
+	}
+
+	return errs.Err()
+}
+
+func (x *UpdateTagRequest) Validate() error {
+	var errs validation.Errors
+
+	errs.PositiveID("id", x.GetId())
+	if x.Name != nil && errs.NonEmpty("name", x.GetName()) {
+		errs.MaxLength("name", strings.TrimSpace(x.GetName()), maxTagNameLength)
+	}
+
+	return errs.Err()
+}
+
+func (x *MergeTagsRequest) Validate() error {
+	var errs validation.Errors
+
+	errs.PositiveID("source_tag_id", x.GetSourceTagId())
+	if errs.PositiveID("target_tag_id", x.GetTargetTagId()) && x.GetTargetTagId() == x.GetSourceTagId() {
+		errs.Add("target_tag_id", "must differ from source_tag_id")
+	}
+
+	return errs.Err()
+}
+
+func (x *MergeOrganizationsRequest) Validate() error {
+	var errs validation.Errors
+
+	errs.PositiveID("source_id", x.GetSourceId())
+	if errs.PositiveID("target_id", x.GetTargetId()) && x.GetTargetId() == x.GetSourceId() {
+		errs.Add("target_id", "must differ from source_id")
+	}
+
+	return errs.Err()
+}
+
+func (x *CreateOrganizationRequest) Validate() error {
+	var errs validation.Errors
+
+	if errs.NonEmpty("title", x.GetTitle()) {
+		errs.MaxLength("title", strings.TrimSpace(x.GetTitle()), maxOrganizationTitleLength)
+	}
+
+	return errs.Err()
+}
+
+func (x *ListOrganizationsRequest) Validate() error {
+	var errs validation.Errors
+
+	if x.OrganizationTypeId != nil {
+		errs.PositiveID("organization_type_id", x.GetOrganizationTypeId())
+	}
+
+	return errs.Err()
+}
+
+func (x *UpdateOrganizationRequest) Validate() error {
+	var errs validation.Errors
+
+	errs.PositiveID("id", x.GetId())
+	if x.Title != nil && errs.NonEmpty("title", x.GetTitle()) {
+		errs.MaxLength("title", strings.TrimSpace(x.GetTitle()), maxOrganizationTitleLength)
+	}
+	if x.OrganizationTypeId != nil {
+		errs.PositiveID("organization_type_id", x.GetOrganizationTypeId())
+	}
+	if x.GetClearMonthlyEventQuota() && x.MonthlyEventQuota != nil {
+		errs.Add("clear_monthly_event_quota", "must not be combined with monthly_event_quota")
+	}
+
+	return errs.Err()
+}
+
+func (x *SubmitOrganizationInquiryRequest) Validate() error {
+	var errs validation.Errors
+
+	errs.PositiveID("organization_id", x.GetOrganizationId())
+	errs.Email("sender_email", strings.TrimSpace(x.GetSenderEmail()))
+	if errs.NonEmpty("sender_name", x.GetSenderName()) {
+		errs.MaxLength("sender_name", strings.TrimSpace(x.GetSenderName()), maxInquiryFieldLength)
+	}
+	if errs.NonEmpty("subject", x.GetSubject()) {
+		errs.MaxLength("subject", strings.TrimSpace(x.GetSubject()), maxInquiryFieldLength)
+	}
+	if errs.NonEmpty("message", x.GetMessage()) {
+		errs.MaxLength("message", strings.TrimSpace(x.GetMessage()), maxInquiryMessageLength)
+	}
+
+	return errs.Err()
+}
+
+func (x *CreateOrganizationTypeRequest) Validate() error {
+	var errs validation.Errors
+
+	if errs.NonEmpty("title", x.GetTitle()) {
+		errs.MaxLength("title", strings.TrimSpace(x.GetTitle()), maxOrganizationTitleLength)
+	}
+
+	return errs.Err()
+}
+
+func (x *UpdateOrganizationTypeRequest) Validate() error {
+	var errs validation.Errors
+
+	errs.PositiveID("id", x.GetId())
+	if x.Title != nil && errs.NonEmpty("title", x.GetTitle()) {
+		errs.MaxLength("title", strings.TrimSpace(x.GetTitle()), maxOrganizationTitleLength)
+	}
+
+	return errs.Err()
+}
+
+func (x *GetStatisticsForOrganizationRequest) Validate() error {
+	var errs validation.Errors
+
+	errs.PositiveID("organization_id", x.GetOrganizationId())
+	if x.GetDays() < 0 {
+		errs.Add("days", "must not be negative")
+	}
+
+	return errs.Err()
+}
+
+func (x *NotifyRegistrantsRequest) Validate() error {
+	var errs validation.Errors
+
+	errs.PositiveID("event_id", x.GetEventId())
+	if errs.NonEmpty("subject", x.GetSubject()) {
+		errs.MaxLength("subject", x.GetSubject(), maxNotificationSubject)
+	}
+	errs.NonEmpty("body", x.GetBody())
+
+	return errs.Err()
+}
+
+func (x *GetEventRegistrationStatusRequest) Validate() error {
+	var errs validation.Errors
+
+	errs.PositiveID("event_id", x.GetEventId())
+	errs.PositiveID("user_id", x.GetUserId())
+
+	return errs.Err()
+}
+
+func (x *GetUserRegistrationsRequest) Validate() error {
+	var errs validation.Errors
+
+	errs.PositiveID("user_id", x.GetUserId())
+	if x.Status != nil {
+		switch x.GetStatus() {
+		case RegistrationStatus_REGISTRATION_STATUS_REGISTERED,
+			RegistrationStatus_REGISTRATION_STATUS_CANCELLED,
+			RegistrationStatus_REGISTRATION_STATUS_WAITLIST:
+		default:
+			errs.Add("status", "must be registered, cancelled or waitlist")
+		}
+	}
+
+	return errs.Err()
+}
@@ -0,0 +1,118 @@
+package eventsv1
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/studyverse/ems-backend/internal/validation"
+	"google.golang.org/protobuf/proto"
+)
+
+// violatedFields lists the fields err reports, nil when err is nil
+func violatedFields(t *testing.T, err error) []string {
+	t.Helper()
+	if err == nil {
+		return nil
+	}
+	errs, ok := err.(validation.Errors)
+	if !ok {
+		t.Fatalf("Validate() returned %T, want validation.Errors", err)
+	}
+	fields := make([]string, len(errs))
+	for i, v := range errs {
+		fields[i] = v.Field
+	}
+	return fields
+}
+
+func TestValidate(t *testing.T) {
+	start := time.Now().Add(24 * time.Hour).Format(time.RFC3339)
+	end := time.Now().Add(26 * time.Hour).Format(time.RFC3339)
+	past := time.Now().Add(-time.Hour).Format(time.RFC3339)
+	validEvent := func() *CreateEventRequest {
+		return &CreateEventRequest{Title: "Hackathon", OrganizationId: 1, StartTime: start, EndTime: end}
+	}
+	tooManyEvents := make([]*CreateEventRequest, maxBulkCreateEvents+1)
+	for i := range tooManyEvents {
+		tooManyEvents[i] = validEvent()
+	}
+
+	tests := []struct {
+		name string
+		req  validation.Validator
+		want []string
+	}{
+		{"create event", validEvent(), nil},
+		{"create event without title or club", &CreateEventRequest{Title: " ", StartTime: start, EndTime: end}, []string{"title", "organization_id"}},
+		{"create event with bad timestamps", &CreateEventRequest{Title: "x", OrganizationId: 1, StartTime: "tomorrow", EndTime: "later"}, []string{"start_time", "end_time"}},
+		{"create event in the past", &CreateEventRequest{Title: "x", OrganizationId: 1, StartTime: past, EndTime: past}, []string{"end_time"}},
+		{"create event ending before it starts", &CreateEventRequest{Title: "x", OrganizationId: 1, StartTime: end, EndTime: start}, []string{"start_time"}},
+
+		{"duplicate event", &DuplicateEventRequest{SourceEventId: 1, NewStartTime: start, NewEndTime: end}, nil},
+		{"duplicate event ending before it starts", &DuplicateEventRequest{SourceEventId: 0, NewStartTime: end, NewEndTime: start}, []string{"source_event_id", "new_start_time"}},
+
+		{"update event with only an ID", &UpdateEventRequest{Id: 1}, nil},
+		{"update event clearing the title", &UpdateEventRequest{Id: 1, Title: proto.String("")}, []string{"title"}},
+		{"update event with reversed times", &UpdateEventRequest{Id: 1, StartTime: proto.String(end), EndTime: proto.String(start)}, []string{"start_time"}},
+		{"update event with a bad club", &UpdateEventRequest{Id: 0, OrganizationId: proto.Int32(-1)}, []string{"id", "organization_id"}},
+
+		{"bulk create", &BulkCreateEventsRequest{Events: []*CreateEventRequest{validEvent()}}, nil},
+		{"bulk create with nothing", &BulkCreateEventsRequest{}, []string{"events"}},
+		{"bulk create with too many", &BulkCreateEventsRequest{Events: tooManyEvents}, []string{"events"}},
+		{"bulk create prefixes row fields", &BulkCreateEventsRequest{Events: []*CreateEventRequest{
+			validEvent(),
+			{Title: "", OrganizationId: 1, StartTime: start, EndTime: end, Capacity: proto.Int32(-1)},
+		}}, []string{"events[1].title", "events[1].capacity"}},
+
+		{"bulk check-in", &BulkCheckInRequest{RegistrationIds: []int32{1, 2}, CheckedInBy: 3}, nil},
+		{"bulk check-in with nothing", &BulkCheckInRequest{}, []string{"registration_ids", "checked_in_by"}},
+		{"bulk check-in with too many", &BulkCheckInRequest{RegistrationIds: make([]int32, maxBulkCheckIns+1), CheckedInBy: 3}, []string{"registration_ids"}},
+
+		{"calendar", &GetEventCalendarRequest{Year: 2026, Month: 10}, nil},
+		{"calendar out of range", &GetEventCalendarRequest{Year: 1999, Month: 13, OrganizationId: proto.Int32(0)}, []string{"year", "month", "organization_id"}},
+
+		{"count by format", &GetEventCountByFormatRequest{StartDate: proto.String(start), EndDate: proto.String(end)}, nil},
+		{"count by format with reversed dates", &GetEventCountByFormatRequest{StartDate: proto.String(end), EndDate: proto.String(start)}, []string{"end_date"}},
+
+		{"create tag", &CreateTagRequest{Name: "ai"}, nil},
+		{"create tag too long", &CreateTagRequest{Name: strings.Repeat("a", maxTagNameLength+1)}, []string{"name"}},
+		{"create tag padded to the limit", &CreateTagRequest{Name: " " + strings.Repeat("a", maxTagNameLength) + " "}, nil},
+		{"update tag clearing the name", &UpdateTagRequest{Id: 1, Name: proto.String(" ")}, []string{"name"}},
+
+		{"merge tags", &MergeTagsRequest{SourceTagId: 1, TargetTagId: 2}, nil},
+		{"merge a tag into itself", &MergeTagsRequest{SourceTagId: 1, TargetTagId: 1}, []string{"target_tag_id"}},
+		{"merge a club into itself", &MergeOrganizationsRequest{SourceId: 1, TargetId: 1}, []string{"target_id"}},
+
+		{"create club", &CreateOrganizationRequest{Title: "Chess club"}, nil},
+		{"create club without a title", &CreateOrganizationRequest{}, []string{"title"}},
+		{"list clubs by a bad type", &ListOrganizationsRequest{OrganizationTypeId: proto.Int32(0)}, []string{"organization_type_id"}},
+		{"update club quota and clear it", &UpdateOrganizationRequest{Id: 1, MonthlyEventQuota: proto.Int32(3), ClearMonthlyEventQuota: true}, []string{"clear_monthly_event_quota"}},
+
+		{"inquiry", &SubmitOrganizationInquiryRequest{OrganizationId: 1, SenderEmail: " student@example.com ", SenderName: "Aru", Subject: "Hi", Message: "Can I join?"}, nil},
+		{"inquiry with nothing", &SubmitOrganizationInquiryRequest{}, []string{"organization_id", "sender_email", "sender_name", "subject", "message"}},
+		{"inquiry message too long", &SubmitOrganizationInquiryRequest{OrganizationId: 1, SenderEmail: "a@b.kz", SenderName: "A", Subject: "S", Message: strings.Repeat("м", maxInquiryMessageLength+1)}, []string{"message"}},
+
+		{"club type without a title", &CreateOrganizationTypeRequest{}, []string{"title"}},
+		{"update club type", &UpdateOrganizationTypeRequest{Id: 1, Title: proto.String("Sports")}, nil},
+		{"club statistics with negative days", &GetStatisticsForOrganizationRequest{OrganizationId: 1, Days: -1}, []string{"days"}},
+
+		{"notify registrants", &NotifyRegistrantsRequest{EventId: 1, Subject: "Moved", Body: "Room 101"}, nil},
+		{"notify registrants with nothing", &NotifyRegistrantsRequest{}, []string{"event_id", "subject", "body"}},
+
+		{"registration status", &GetEventRegistrationStatusRequest{EventId: 1, UserId: 2}, nil},
+		{"registration status without IDs", &GetEventRegistrationStatusRequest{}, []string{"event_id", "user_id"}},
+
+		{"user registrations by status", &GetUserRegistrationsRequest{UserId: 1, Status: RegistrationStatus_REGISTRATION_STATUS_WAITLIST.Enum()}, nil},
+		{"user registrations by an unfiltered status", &GetUserRegistrationsRequest{UserId: 1, Status: RegistrationStatus_REGISTRATION_STATUS_UNSPECIFIED.Enum()}, []string{"status"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := violatedFields(t, tt.req.Validate()); !slices.Equal(got, tt.want) {
+				t.Errorf("Validate() violations = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,374 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: permissionsv1/permissions.proto
+
+package permissionsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Computed permissions of a user, for showing or hiding UI
+type UserPermissions struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsAdmin        bool                   `protobuf:"varint,2,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`                               // manage_system on the platform
+	IsGlobalStaff  bool                   `protobuf:"varint,3,opt,name=is_global_staff,json=isGlobalStaff,proto3" json:"is_global_staff,omitempty"`           // manage_clubs on the platform (includes admins)
+	ManagedClubIds []int32                `protobuf:"varint,4,rep,packed,name=managed_club_ids,json=managedClubIds,proto3" json:"managed_club_ids,omitempty"` // Clubs the user has manage_settings on
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UserPermissions) Reset() {
+	*x = UserPermissions{}
+	mi := &file_permissionsv1_permissions_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserPermissions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPermissions) ProtoMessage() {}
+
+func (x *UserPermissions) ProtoReflect() protoreflect.Message {
+	mi := &file_permissionsv1_permissions_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPermissions.ProtoReflect.Descriptor instead.
+func (*UserPermissions) Descriptor() ([]byte, []int) {
+	return file_permissionsv1_permissions_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UserPermissions) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserPermissions) GetIsAdmin() bool {
+	if x != nil {
+		return x.IsAdmin
+	}
+	return false
+}
+
+func (x *UserPermissions) GetIsGlobalStaff() bool {
+	if x != nil {
+		return x.IsGlobalStaff
+	}
+	return false
+}
+
+func (x *UserPermissions) GetManagedClubIds() []int32 {
+	if x != nil {
+		return x.ManagedClubIds
+	}
+	return nil
+}
+
+// Defaults to the authenticated user. Other users' permissions need manage_system.
+type GetUserPermissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *int32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserPermissionsRequest) Reset() {
+	*x = GetUserPermissionsRequest{}
+	mi := &file_permissionsv1_permissions_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserPermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPermissionsRequest) ProtoMessage() {}
+
+func (x *GetUserPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permissionsv1_permissions_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserPermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_permissionsv1_permissions_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetUserPermissionsRequest) GetUserId() int32 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+type GetUserPermissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Permissions   *UserPermissions       `protobuf:"bytes,1,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserPermissionsResponse) Reset() {
+	*x = GetUserPermissionsResponse{}
+	mi := &file_permissionsv1_permissions_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserPermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPermissionsResponse) ProtoMessage() {}
+
+func (x *GetUserPermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permissionsv1_permissions_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPermissionsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserPermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_permissionsv1_permissions_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetUserPermissionsResponse) GetPermissions() *UserPermissions {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+// Checks a single SpiceDB permission for the authenticated user,
+// e.g. resource_type "club", resource_id "12", permission "create_event"
+type CheckPermissionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceType  string                 `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceId    string                 `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Permission    string                 `protobuf:"bytes,3,opt,name=permission,proto3" json:"permission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPermissionRequest) Reset() {
+	*x = CheckPermissionRequest{}
+	mi := &file_permissionsv1_permissions_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPermissionRequest) ProtoMessage() {}
+
+func (x *CheckPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permissionsv1_permissions_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPermissionRequest.ProtoReflect.Descriptor instead.
+func (*CheckPermissionRequest) Descriptor() ([]byte, []int) {
+	return file_permissionsv1_permissions_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CheckPermissionRequest) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *CheckPermissionRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *CheckPermissionRequest) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+type CheckPermissionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"` // Always false for anonymous requests
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPermissionResponse) Reset() {
+	*x = CheckPermissionResponse{}
+	mi := &file_permissionsv1_permissions_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPermissionResponse) ProtoMessage() {}
+
+func (x *CheckPermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permissionsv1_permissions_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPermissionResponse.ProtoReflect.Descriptor instead.
+func (*CheckPermissionResponse) Descriptor() ([]byte, []int) {
+	return file_permissionsv1_permissions_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CheckPermissionResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+var File_permissionsv1_permissions_proto protoreflect.FileDescriptor
+
+const file_permissionsv1_permissions_proto_rawDesc = "" +
+	"\n" +
+	"\x1fpermissionsv1/permissions.proto\x12\x0epermissions.v1\"\x97\x01\n" +
+	"\x0fUserPermissions\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x19\n" +
+	"\bis_admin\x18\x02 \x01(\bR\aisAdmin\x12&\n" +
+	"\x0fis_global_staff\x18\x03 \x01(\bR\risGlobalStaff\x12(\n" +
+	"\x10managed_club_ids\x18\x04 \x03(\x05R\x0emanagedClubIds\"E\n" +
+	"\x19GetUserPermissionsRequest\x12\x1c\n" +
+	"\auser_id\x18\x01 \x01(\x05H\x00R\x06userId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_id\"_\n" +
+	"\x1aGetUserPermissionsResponse\x12A\n" +
+	"\vpermissions\x18\x01 \x01(\v2\x1f.permissions.v1.UserPermissionsR\vpermissions\"~\n" +
+	"\x16CheckPermissionRequest\x12#\n" +
+	"\rresource_type\x18\x01 \x01(\tR\fresourceType\x12\x1f\n" +
+	"\vresource_id\x18\x02 \x01(\tR\n" +
+	"resourceId\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x03 \x01(\tR\n" +
+	"permission\"3\n" +
+	"\x17CheckPermissionResponse\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed2\xe5\x01\n" +
+	"\x12PermissionsService\x12k\n" +
+	"\x12GetUserPermissions\x12).permissions.v1.GetUserPermissionsRequest\x1a*.permissions.v1.GetUserPermissionsResponse\x12b\n" +
+	"\x0fCheckPermission\x12&.permissions.v1.CheckPermissionRequest\x1a'.permissions.v1.CheckPermissionResponseB\xc2\x01\n" +
+	"\x12com.permissions.v1B\x10PermissionsProtoP\x01ZAgithub.com/studyverse/ems-backend/gen/permissionsv1;permissionsv1\xa2\x02\x03PXX\xaa\x02\x0ePermissions.V1\xca\x02\x0ePermissions\\V1\xe2\x02\x1aPermissions\\V1\\GPBMetadata\xea\x02\x0fPermissions::V1b\x06proto3"
+
+var (
+	file_permissionsv1_permissions_proto_rawDescOnce sync.Once
+	file_permissionsv1_permissions_proto_rawDescData []byte
+)
+
+func file_permissionsv1_permissions_proto_rawDescGZIP() []byte {
+	file_permissionsv1_permissions_proto_rawDescOnce.Do(func() {
+		file_permissionsv1_permissions_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_permissionsv1_permissions_proto_rawDesc), len(file_permissionsv1_permissions_proto_rawDesc)))
+	})
+	return file_permissionsv1_permissions_proto_rawDescData
+}
+
+var file_permissionsv1_permissions_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_permissionsv1_permissions_proto_goTypes = []any{
+	(*UserPermissions)(nil),            // 0: permissions.v1.UserPermissions
+	(*GetUserPermissionsRequest)(nil),  // 1: permissions.v1.GetUserPermissionsRequest
+	(*GetUserPermissionsResponse)(nil), // 2: permissions.v1.GetUserPermissionsResponse
+	(*CheckPermissionRequest)(nil),     // 3: permissions.v1.CheckPermissionRequest
+	(*CheckPermissionResponse)(nil),    // 4: permissions.v1.CheckPermissionResponse
+}
+var file_permissionsv1_permissions_proto_depIdxs = []int32{
+	0, // 0: permissions.v1.GetUserPermissionsResponse.permissions:type_name -> permissions.v1.UserPermissions
+	1, // 1: permissions.v1.PermissionsService.GetUserPermissions:input_type -> permissions.v1.GetUserPermissionsRequest
+	3, // 2: permissions.v1.PermissionsService.CheckPermission:input_type -> permissions.v1.CheckPermissionRequest
+	2, // 3: permissions.v1.PermissionsService.GetUserPermissions:output_type -> permissions.v1.GetUserPermissionsResponse
+	4, // 4: permissions.v1.PermissionsService.CheckPermission:output_type -> permissions.v1.CheckPermissionResponse
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_permissionsv1_permissions_proto_init() }
+func file_permissionsv1_permissions_proto_init() {
+	if File_permissionsv1_permissions_proto != nil {
+		return
+	}
+	file_permissionsv1_permissions_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_permissionsv1_permissions_proto_rawDesc), len(file_permissionsv1_permissions_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_permissionsv1_permissions_proto_goTypes,
+		DependencyIndexes: file_permissionsv1_permissions_proto_depIdxs,
+		MessageInfos:      file_permissionsv1_permissions_proto_msgTypes,
+	}.Build()
+	File_permissionsv1_permissions_proto = out.File
+	file_permissionsv1_permissions_proto_goTypes = nil
+	file_permissionsv1_permissions_proto_depIdxs = nil
+}
@@ -0,0 +1,138 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: permissionsv1/permissions.proto
+
+package permissionsv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	permissionsv1 "github.com/studyverse/ems-backend/gen/permissionsv1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// PermissionsServiceName is the fully-qualified name of the PermissionsService service.
+	PermissionsServiceName = "permissions.v1.PermissionsService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// PermissionsServiceGetUserPermissionsProcedure is the fully-qualified name of the
+	// PermissionsService's GetUserPermissions RPC.
+	PermissionsServiceGetUserPermissionsProcedure = "/permissions.v1.PermissionsService/GetUserPermissions"
+	// PermissionsServiceCheckPermissionProcedure is the fully-qualified name of the
+	// PermissionsService's CheckPermission RPC.
+	PermissionsServiceCheckPermissionProcedure = "/permissions.v1.PermissionsService/CheckPermission"
+)
+
+// PermissionsServiceClient is a client for the permissions.v1.PermissionsService service.
+type PermissionsServiceClient interface {
+	GetUserPermissions(context.Context, *connect.Request[permissionsv1.GetUserPermissionsRequest]) (*connect.Response[permissionsv1.GetUserPermissionsResponse], error)
+	CheckPermission(context.Context, *connect.Request[permissionsv1.CheckPermissionRequest]) (*connect.Response[permissionsv1.CheckPermissionResponse], error)
+}
+
+// NewPermissionsServiceClient constructs a client for the permissions.v1.PermissionsService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPermissionsServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PermissionsServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	permissionsServiceMethods := permissionsv1.File_permissionsv1_permissions_proto.Services().ByName("PermissionsService").Methods()
+	return &permissionsServiceClient{
+		getUserPermissions: connect.NewClient[permissionsv1.GetUserPermissionsRequest, permissionsv1.GetUserPermissionsResponse](
+			httpClient,
+			baseURL+PermissionsServiceGetUserPermissionsProcedure,
+			connect.WithSchema(permissionsServiceMethods.ByName("GetUserPermissions")),
+			connect.WithClientOptions(opts...),
+		),
+		checkPermission: connect.NewClient[permissionsv1.CheckPermissionRequest, permissionsv1.CheckPermissionResponse](
+			httpClient,
+			baseURL+PermissionsServiceCheckPermissionProcedure,
+			connect.WithSchema(permissionsServiceMethods.ByName("CheckPermission")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// permissionsServiceClient implements PermissionsServiceClient.
+type permissionsServiceClient struct {
+	getUserPermissions *connect.Client[permissionsv1.GetUserPermissionsRequest, permissionsv1.GetUserPermissionsResponse]
+	checkPermission    *connect.Client[permissionsv1.CheckPermissionRequest, permissionsv1.CheckPermissionResponse]
+}
+
+// GetUserPermissions calls permissions.v1.PermissionsService.GetUserPermissions.
+func (c *permissionsServiceClient) GetUserPermissions(ctx context.Context, req *connect.Request[permissionsv1.GetUserPermissionsRequest]) (*connect.Response[permissionsv1.GetUserPermissionsResponse], error) {
+	return c.getUserPermissions.CallUnary(ctx, req)
+}
+
+// CheckPermission calls permissions.v1.PermissionsService.CheckPermission.
+func (c *permissionsServiceClient) CheckPermission(ctx context.Context, req *connect.Request[permissionsv1.CheckPermissionRequest]) (*connect.Response[permissionsv1.CheckPermissionResponse], error) {
+	return c.checkPermission.CallUnary(ctx, req)
+}
+
+// PermissionsServiceHandler is an implementation of the permissions.v1.PermissionsService service.
+type PermissionsServiceHandler interface {
+	GetUserPermissions(context.Context, *connect.Request[permissionsv1.GetUserPermissionsRequest]) (*connect.Response[permissionsv1.GetUserPermissionsResponse], error)
+	CheckPermission(context.Context, *connect.Request[permissionsv1.CheckPermissionRequest]) (*connect.Response[permissionsv1.CheckPermissionResponse], error)
+}
+
+// NewPermissionsServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPermissionsServiceHandler(svc PermissionsServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	permissionsServiceMethods := permissionsv1.File_permissionsv1_permissions_proto.Services().ByName("PermissionsService").Methods()
+	permissionsServiceGetUserPermissionsHandler := connect.NewUnaryHandler(
+		PermissionsServiceGetUserPermissionsProcedure,
+		svc.GetUserPermissions,
+		connect.WithSchema(permissionsServiceMethods.ByName("GetUserPermissions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	permissionsServiceCheckPermissionHandler := connect.NewUnaryHandler(
+		PermissionsServiceCheckPermissionProcedure,
+		svc.CheckPermission,
+		connect.WithSchema(permissionsServiceMethods.ByName("CheckPermission")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/permissions.v1.PermissionsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PermissionsServiceGetUserPermissionsProcedure:
+			permissionsServiceGetUserPermissionsHandler.ServeHTTP(w, r)
+		case PermissionsServiceCheckPermissionProcedure:
+			permissionsServiceCheckPermissionHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPermissionsServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPermissionsServiceHandler struct{}
+
+func (UnimplementedPermissionsServiceHandler) GetUserPermissions(context.Context, *connect.Request[permissionsv1.GetUserPermissionsRequest]) (*connect.Response[permissionsv1.GetUserPermissionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("permissions.v1.PermissionsService.GetUserPermissions is not implemented"))
+}
+
+func (UnimplementedPermissionsServiceHandler) CheckPermission(context.Context, *connect.Request[permissionsv1.CheckPermissionRequest]) (*connect.Response[permissionsv1.CheckPermissionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("permissions.v1.PermissionsService.CheckPermission is not implemented"))
+}
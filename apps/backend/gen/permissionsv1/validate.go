@@ -0,0 +1,23 @@
+// Hand-written, not generated: request checks run by validation.NewInterceptor.
+
+package permissionsv1
+
+import (
+	"github.com/studyverse/ems-backend/internal/validation"
+)
+
+func (x *CheckPermissionRequest) Validate() error {
+	var errs validation.Errors
+
+	if x.GetResourceType() == "" {
+		errs.Add("resource_type", "must not be empty")
+	}
+	if x.GetResourceId() == "" {
+		errs.Add("resource_id", "must not be empty")
+	}
+	if x.GetPermission() == "" {
+		errs.Add("permission", "must not be empty")
+	}
+
+	return errs.Err()
+}
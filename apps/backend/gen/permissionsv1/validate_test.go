@@ -0,0 +1,44 @@
+package permissionsv1
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/studyverse/ems-backend/internal/validation"
+)
+
+// violatedFields lists the fields err reports, nil when err is nil
+func violatedFields(t *testing.T, err error) []string {
+	t.Helper()
+	if err == nil {
+		return nil
+	}
+	errs, ok := err.(validation.Errors)
+	if !ok {
+		t.Fatalf("Validate() returned %T, want validation.Errors", err)
+	}
+	fields := make([]string, len(errs))
+	for i, v := range errs {
+		fields[i] = v.Field
+	}
+	return fields
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		req  validation.Validator
+		want []string
+	}{
+		{"check permission", &CheckPermissionRequest{ResourceType: "event", ResourceId: "1", Permission: "edit"}, nil},
+		{"check permission with nothing", &CheckPermissionRequest{}, []string{"resource_type", "resource_id", "permission"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := violatedFields(t, tt.req.Validate()); !slices.Equal(got, tt.want) {
+				t.Errorf("Validate() violations = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
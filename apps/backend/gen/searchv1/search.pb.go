@@ -7,6 +7,8 @@
 package searchv1
 
 import (
+	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
+	usersv1 "github.com/studyverse/ems-backend/gen/usersv1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -25,11 +27,12 @@ const (
 type SearchResultType int32
 
 const (
-	SearchResultType_SEARCH_RESULT_TYPE_UNSPECIFIED  SearchResultType = 0
-	SearchResultType_SEARCH_RESULT_TYPE_EVENT        SearchResultType = 1
-	SearchResultType_SEARCH_RESULT_TYPE_ORGANIZATION SearchResultType = 2
-	SearchResultType_SEARCH_RESULT_TYPE_USER         SearchResultType = 3
-	SearchResultType_SEARCH_RESULT_TYPE_TAG          SearchResultType = 4
+	SearchResultType_SEARCH_RESULT_TYPE_UNSPECIFIED       SearchResultType = 0
+	SearchResultType_SEARCH_RESULT_TYPE_EVENT             SearchResultType = 1
+	SearchResultType_SEARCH_RESULT_TYPE_ORGANIZATION      SearchResultType = 2
+	SearchResultType_SEARCH_RESULT_TYPE_USER              SearchResultType = 3
+	SearchResultType_SEARCH_RESULT_TYPE_TAG               SearchResultType = 4
+	SearchResultType_SEARCH_RESULT_TYPE_ORGANIZATION_TYPE SearchResultType = 5
 )
 
 // Enum value maps for SearchResultType.
@@ -40,13 +43,15 @@ var (
 		2: "SEARCH_RESULT_TYPE_ORGANIZATION",
 		3: "SEARCH_RESULT_TYPE_USER",
 		4: "SEARCH_RESULT_TYPE_TAG",
+		5: "SEARCH_RESULT_TYPE_ORGANIZATION_TYPE",
 	}
 	SearchResultType_value = map[string]int32{
-		"SEARCH_RESULT_TYPE_UNSPECIFIED":  0,
-		"SEARCH_RESULT_TYPE_EVENT":        1,
-		"SEARCH_RESULT_TYPE_ORGANIZATION": 2,
-		"SEARCH_RESULT_TYPE_USER":         3,
-		"SEARCH_RESULT_TYPE_TAG":          4,
+		"SEARCH_RESULT_TYPE_UNSPECIFIED":       0,
+		"SEARCH_RESULT_TYPE_EVENT":             1,
+		"SEARCH_RESULT_TYPE_ORGANIZATION":      2,
+		"SEARCH_RESULT_TYPE_USER":              3,
+		"SEARCH_RESULT_TYPE_TAG":               4,
+		"SEARCH_RESULT_TYPE_ORGANIZATION_TYPE": 5,
 	}
 )
 
@@ -77,6 +82,103 @@ func (SearchResultType) EnumDescriptor() ([]byte, []int) {
 	return file_searchv1_search_proto_rawDescGZIP(), []int{0}
 }
 
+// TagFilterMode controls how multiple tag_ids are combined
+type TagFilterMode int32
+
+const (
+	TagFilterMode_TAG_FILTER_MODE_UNSPECIFIED TagFilterMode = 0 // Same as ANY
+	TagFilterMode_TAG_FILTER_MODE_ANY         TagFilterMode = 1 // Event has at least one of the tags
+	TagFilterMode_TAG_FILTER_MODE_ALL         TagFilterMode = 2 // Event has every tag
+)
+
+// Enum value maps for TagFilterMode.
+var (
+	TagFilterMode_name = map[int32]string{
+		0: "TAG_FILTER_MODE_UNSPECIFIED",
+		1: "TAG_FILTER_MODE_ANY",
+		2: "TAG_FILTER_MODE_ALL",
+	}
+	TagFilterMode_value = map[string]int32{
+		"TAG_FILTER_MODE_UNSPECIFIED": 0,
+		"TAG_FILTER_MODE_ANY":         1,
+		"TAG_FILTER_MODE_ALL":         2,
+	}
+)
+
+func (x TagFilterMode) Enum() *TagFilterMode {
+	p := new(TagFilterMode)
+	*p = x
+	return p
+}
+
+func (x TagFilterMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TagFilterMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_searchv1_search_proto_enumTypes[1].Descriptor()
+}
+
+func (TagFilterMode) Type() protoreflect.EnumType {
+	return &file_searchv1_search_proto_enumTypes[1]
+}
+
+func (x TagFilterMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TagFilterMode.Descriptor instead.
+func (TagFilterMode) EnumDescriptor() ([]byte, []int) {
+	return file_searchv1_search_proto_rawDescGZIP(), []int{1}
+}
+
+// EventSortBy orders SearchEvents results
+type EventSortBy int32
+
+const (
+	EventSortBy_EVENT_SORT_BY_UNSPECIFIED          EventSortBy = 0 // Relevance
+	EventSortBy_EVENT_SORT_BY_ATTENDANCE_RATE_DESC EventSortBy = 1 // Highest attendance rate first
+)
+
+// Enum value maps for EventSortBy.
+var (
+	EventSortBy_name = map[int32]string{
+		0: "EVENT_SORT_BY_UNSPECIFIED",
+		1: "EVENT_SORT_BY_ATTENDANCE_RATE_DESC",
+	}
+	EventSortBy_value = map[string]int32{
+		"EVENT_SORT_BY_UNSPECIFIED":          0,
+		"EVENT_SORT_BY_ATTENDANCE_RATE_DESC": 1,
+	}
+)
+
+func (x EventSortBy) Enum() *EventSortBy {
+	p := new(EventSortBy)
+	*p = x
+	return p
+}
+
+func (x EventSortBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventSortBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_searchv1_search_proto_enumTypes[2].Descriptor()
+}
+
+func (EventSortBy) Type() protoreflect.EnumType {
+	return &file_searchv1_search_proto_enumTypes[2]
+}
+
+func (x EventSortBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventSortBy.Descriptor instead.
+func (EventSortBy) EnumDescriptor() ([]byte, []int) {
+	return file_searchv1_search_proto_rawDescGZIP(), []int{2}
+}
+
 // SearchResult represents a single search result item
 type SearchResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -154,19 +256,81 @@ func (x *SearchResult) GetImageUrl() string {
 	return ""
 }
 
+// IndexResult groups the hits of a single index in a global search
+type IndexResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IndexName     string                 `protobuf:"bytes,1,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"` // events, organizations, users or tags
+	HitCount      int64                  `protobuf:"varint,2,opt,name=hit_count,json=hitCount,proto3" json:"hit_count,omitempty"`   // Estimated total hits in this index
+	Results       []*SearchResult        `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IndexResult) Reset() {
+	*x = IndexResult{}
+	mi := &file_searchv1_search_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IndexResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexResult) ProtoMessage() {}
+
+func (x *IndexResult) ProtoReflect() protoreflect.Message {
+	mi := &file_searchv1_search_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexResult.ProtoReflect.Descriptor instead.
+func (*IndexResult) Descriptor() ([]byte, []int) {
+	return file_searchv1_search_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IndexResult) GetIndexName() string {
+	if x != nil {
+		return x.IndexName
+	}
+	return ""
+}
+
+func (x *IndexResult) GetHitCount() int64 {
+	if x != nil {
+		return x.HitCount
+	}
+	return 0
+}
+
+func (x *IndexResult) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 // GlobalSearchRequest is the request for global search across all entities
 type GlobalSearchRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                                        // Maximum results per type (default 10)
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                                        // Legacy: superseded by limit_per_index
 	Types         []SearchResultType     `protobuf:"varint,3,rep,packed,name=types,proto3,enum=search.v1.SearchResultType" json:"types,omitempty"` // Optional: filter by specific types
+	LimitPerIndex int32                  `protobuf:"varint,4,opt,name=limit_per_index,json=limitPerIndex,proto3" json:"limit_per_index,omitempty"` // Maximum results per index (default 3)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GlobalSearchRequest) Reset() {
 	*x = GlobalSearchRequest{}
-	mi := &file_searchv1_search_proto_msgTypes[1]
+	mi := &file_searchv1_search_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -178,7 +342,7 @@ func (x *GlobalSearchRequest) String() string {
 func (*GlobalSearchRequest) ProtoMessage() {}
 
 func (x *GlobalSearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_searchv1_search_proto_msgTypes[1]
+	mi := &file_searchv1_search_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -191,7 +355,7 @@ func (x *GlobalSearchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GlobalSearchRequest.ProtoReflect.Descriptor instead.
 func (*GlobalSearchRequest) Descriptor() ([]byte, []int) {
-	return file_searchv1_search_proto_rawDescGZIP(), []int{1}
+	return file_searchv1_search_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GlobalSearchRequest) GetQuery() string {
@@ -215,20 +379,29 @@ func (x *GlobalSearchRequest) GetTypes() []SearchResultType {
 	return nil
 }
 
+func (x *GlobalSearchRequest) GetLimitPerIndex() int32 {
+	if x != nil {
+		return x.LimitPerIndex
+	}
+	return 0
+}
+
 // GlobalSearchResponse contains the search results
 type GlobalSearchResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Results          []*SearchResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
-	TotalHits        int64                  `protobuf:"varint,2,opt,name=total_hits,json=totalHits,proto3" json:"total_hits,omitempty"`
-	ProcessingTimeMs int64                  `protobuf:"varint,3,opt,name=processing_time_ms,json=processingTimeMs,proto3" json:"processing_time_ms,omitempty"`
-	Query            string                 `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in searchv1/search.proto.
+	Results          []*SearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // Use index_results
+	TotalHits        int64           `protobuf:"varint,2,opt,name=total_hits,json=totalHits,proto3" json:"total_hits,omitempty"`
+	ProcessingTimeMs int64           `protobuf:"varint,3,opt,name=processing_time_ms,json=processingTimeMs,proto3" json:"processing_time_ms,omitempty"`
+	Query            string          `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	IndexResults     []*IndexResult  `protobuf:"bytes,5,rep,name=index_results,json=indexResults,proto3" json:"index_results,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *GlobalSearchResponse) Reset() {
 	*x = GlobalSearchResponse{}
-	mi := &file_searchv1_search_proto_msgTypes[2]
+	mi := &file_searchv1_search_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -240,7 +413,7 @@ func (x *GlobalSearchResponse) String() string {
 func (*GlobalSearchResponse) ProtoMessage() {}
 
 func (x *GlobalSearchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_searchv1_search_proto_msgTypes[2]
+	mi := &file_searchv1_search_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -253,9 +426,10 @@ func (x *GlobalSearchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GlobalSearchResponse.ProtoReflect.Descriptor instead.
 func (*GlobalSearchResponse) Descriptor() ([]byte, []int) {
-	return file_searchv1_search_proto_rawDescGZIP(), []int{2}
+	return file_searchv1_search_proto_rawDescGZIP(), []int{3}
 }
 
+// Deprecated: Marked as deprecated in searchv1/search.proto.
 func (x *GlobalSearchResponse) GetResults() []*SearchResult {
 	if x != nil {
 		return x.Results
@@ -284,20 +458,33 @@ func (x *GlobalSearchResponse) GetQuery() string {
 	return ""
 }
 
+func (x *GlobalSearchResponse) GetIndexResults() []*IndexResult {
+	if x != nil {
+		return x.IndexResults
+	}
+	return nil
+}
+
 // SearchEventsRequest is for searching only events
 type SearchEventsRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Query          string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Limit          int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	OrganizationId *int32                 `protobuf:"varint,3,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"`
-	TagIds         []int32                `protobuf:"varint,4,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Query              string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit              int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	OrganizationId     *int32                 `protobuf:"varint,3,opt,name=organization_id,json=organizationId,proto3,oneof" json:"organization_id,omitempty"`
+	TagIds             []int32                `protobuf:"varint,4,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	OrganizationTypeId *int32                 `protobuf:"varint,5,opt,name=organization_type_id,json=organizationTypeId,proto3,oneof" json:"organization_type_id,omitempty"`
+	TagFilterMode      TagFilterMode          `protobuf:"varint,6,opt,name=tag_filter_mode,json=tagFilterMode,proto3,enum=search.v1.TagFilterMode" json:"tag_filter_mode,omitempty"`
+	SortBy             EventSortBy            `protobuf:"varint,7,opt,name=sort_by,json=sortBy,proto3,enum=search.v1.EventSortBy" json:"sort_by,omitempty"`
+	StartAfter         *string                `protobuf:"bytes,8,opt,name=start_after,json=startAfter,proto3,oneof" json:"start_after,omitempty"` // RFC3339, events starting at or after
+	EndBefore          *string                `protobuf:"bytes,9,opt,name=end_before,json=endBefore,proto3,oneof" json:"end_before,omitempty"`    // RFC3339, events ending at or before
+	Format             *eventsv1.EventFormat  `protobuf:"varint,10,opt,name=format,proto3,enum=events.v1.EventFormat,oneof" json:"format,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *SearchEventsRequest) Reset() {
 	*x = SearchEventsRequest{}
-	mi := &file_searchv1_search_proto_msgTypes[3]
+	mi := &file_searchv1_search_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -309,7 +496,7 @@ func (x *SearchEventsRequest) String() string {
 func (*SearchEventsRequest) ProtoMessage() {}
 
 func (x *SearchEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_searchv1_search_proto_msgTypes[3]
+	mi := &file_searchv1_search_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -322,7 +509,7 @@ func (x *SearchEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchEventsRequest.ProtoReflect.Descriptor instead.
 func (*SearchEventsRequest) Descriptor() ([]byte, []int) {
-	return file_searchv1_search_proto_rawDescGZIP(), []int{3}
+	return file_searchv1_search_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SearchEventsRequest) GetQuery() string {
@@ -353,6 +540,48 @@ func (x *SearchEventsRequest) GetTagIds() []int32 {
 	return nil
 }
 
+func (x *SearchEventsRequest) GetOrganizationTypeId() int32 {
+	if x != nil && x.OrganizationTypeId != nil {
+		return *x.OrganizationTypeId
+	}
+	return 0
+}
+
+func (x *SearchEventsRequest) GetTagFilterMode() TagFilterMode {
+	if x != nil {
+		return x.TagFilterMode
+	}
+	return TagFilterMode_TAG_FILTER_MODE_UNSPECIFIED
+}
+
+func (x *SearchEventsRequest) GetSortBy() EventSortBy {
+	if x != nil {
+		return x.SortBy
+	}
+	return EventSortBy_EVENT_SORT_BY_UNSPECIFIED
+}
+
+func (x *SearchEventsRequest) GetStartAfter() string {
+	if x != nil && x.StartAfter != nil {
+		return *x.StartAfter
+	}
+	return ""
+}
+
+func (x *SearchEventsRequest) GetEndBefore() string {
+	if x != nil && x.EndBefore != nil {
+		return *x.EndBefore
+	}
+	return ""
+}
+
+func (x *SearchEventsRequest) GetFormat() eventsv1.EventFormat {
+	if x != nil && x.Format != nil {
+		return *x.Format
+	}
+	return eventsv1.EventFormat(0)
+}
+
 // SearchEventsResponse contains event search results
 type SearchEventsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -364,7 +593,7 @@ type SearchEventsResponse struct {
 
 func (x *SearchEventsResponse) Reset() {
 	*x = SearchEventsResponse{}
-	mi := &file_searchv1_search_proto_msgTypes[4]
+	mi := &file_searchv1_search_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -376,7 +605,7 @@ func (x *SearchEventsResponse) String() string {
 func (*SearchEventsResponse) ProtoMessage() {}
 
 func (x *SearchEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_searchv1_search_proto_msgTypes[4]
+	mi := &file_searchv1_search_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -389,7 +618,7 @@ func (x *SearchEventsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchEventsResponse.ProtoReflect.Descriptor instead.
 func (*SearchEventsResponse) Descriptor() ([]byte, []int) {
-	return file_searchv1_search_proto_rawDescGZIP(), []int{4}
+	return file_searchv1_search_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SearchEventsResponse) GetResults() []*SearchResult {
@@ -406,29 +635,32 @@ func (x *SearchEventsResponse) GetTotalHits() int64 {
 	return 0
 }
 
-// ReindexRequest triggers a full reindex of all data
-type ReindexRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Indexes       []string               `protobuf:"bytes,1,rep,name=indexes,proto3" json:"indexes,omitempty"` // Optional: specific indexes to reindex, empty = all
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// SearchOrganizationsRequest is for searching only organizations
+type SearchOrganizationsRequest struct {
+	state              protoimpl.MessageState       `protogen:"open.v1"`
+	Query              string                       `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit              int32                        `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	OrganizationTypeId *int32                       `protobuf:"varint,3,opt,name=organization_type_id,json=organizationTypeId,proto3,oneof" json:"organization_type_id,omitempty"`
+	Status             *eventsv1.OrganizationStatus `protobuf:"varint,4,opt,name=status,proto3,enum=events.v1.OrganizationStatus,oneof" json:"status,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *ReindexRequest) Reset() {
-	*x = ReindexRequest{}
-	mi := &file_searchv1_search_proto_msgTypes[5]
+func (x *SearchOrganizationsRequest) Reset() {
+	*x = SearchOrganizationsRequest{}
+	mi := &file_searchv1_search_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReindexRequest) String() string {
+func (x *SearchOrganizationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReindexRequest) ProtoMessage() {}
+func (*SearchOrganizationsRequest) ProtoMessage() {}
 
-func (x *ReindexRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_searchv1_search_proto_msgTypes[5]
+func (x *SearchOrganizationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_searchv1_search_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -439,46 +671,64 @@ func (x *ReindexRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReindexRequest.ProtoReflect.Descriptor instead.
-func (*ReindexRequest) Descriptor() ([]byte, []int) {
-	return file_searchv1_search_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use SearchOrganizationsRequest.ProtoReflect.Descriptor instead.
+func (*SearchOrganizationsRequest) Descriptor() ([]byte, []int) {
+	return file_searchv1_search_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ReindexRequest) GetIndexes() []string {
+func (x *SearchOrganizationsRequest) GetQuery() string {
 	if x != nil {
-		return x.Indexes
+		return x.Query
 	}
-	return nil
+	return ""
 }
 
-// ReindexResponse contains the reindex status
-type ReindexResponse struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Success              bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message              string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	EventsIndexed        int32                  `protobuf:"varint,3,opt,name=events_indexed,json=eventsIndexed,proto3" json:"events_indexed,omitempty"`
-	OrganizationsIndexed int32                  `protobuf:"varint,4,opt,name=organizations_indexed,json=organizationsIndexed,proto3" json:"organizations_indexed,omitempty"`
-	UsersIndexed         int32                  `protobuf:"varint,5,opt,name=users_indexed,json=usersIndexed,proto3" json:"users_indexed,omitempty"`
-	TagsIndexed          int32                  `protobuf:"varint,6,opt,name=tags_indexed,json=tagsIndexed,proto3" json:"tags_indexed,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+func (x *SearchOrganizationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
 }
 
-func (x *ReindexResponse) Reset() {
-	*x = ReindexResponse{}
-	mi := &file_searchv1_search_proto_msgTypes[6]
+func (x *SearchOrganizationsRequest) GetOrganizationTypeId() int32 {
+	if x != nil && x.OrganizationTypeId != nil {
+		return *x.OrganizationTypeId
+	}
+	return 0
+}
+
+func (x *SearchOrganizationsRequest) GetStatus() eventsv1.OrganizationStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return eventsv1.OrganizationStatus(0)
+}
+
+// SearchOrganizationsResponse contains organization search results
+type SearchOrganizationsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Results          []*SearchResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	TotalHits        int64                  `protobuf:"varint,2,opt,name=total_hits,json=totalHits,proto3" json:"total_hits,omitempty"`
+	ProcessingTimeMs int64                  `protobuf:"varint,3,opt,name=processing_time_ms,json=processingTimeMs,proto3" json:"processing_time_ms,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SearchOrganizationsResponse) Reset() {
+	*x = SearchOrganizationsResponse{}
+	mi := &file_searchv1_search_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReindexResponse) String() string {
+func (x *SearchOrganizationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReindexResponse) ProtoMessage() {}
+func (*SearchOrganizationsResponse) ProtoMessage() {}
 
-func (x *ReindexResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_searchv1_search_proto_msgTypes[6]
+func (x *SearchOrganizationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_searchv1_search_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -489,58 +739,678 @@ func (x *ReindexResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReindexResponse.ProtoReflect.Descriptor instead.
-func (*ReindexResponse) Descriptor() ([]byte, []int) {
-	return file_searchv1_search_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use SearchOrganizationsResponse.ProtoReflect.Descriptor instead.
+func (*SearchOrganizationsResponse) Descriptor() ([]byte, []int) {
+	return file_searchv1_search_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ReindexResponse) GetSuccess() bool {
+func (x *SearchOrganizationsResponse) GetResults() []*SearchResult {
 	if x != nil {
-		return x.Success
+		return x.Results
 	}
-	return false
+	return nil
 }
 
-func (x *ReindexResponse) GetMessage() string {
+func (x *SearchOrganizationsResponse) GetTotalHits() int64 {
 	if x != nil {
-		return x.Message
+		return x.TotalHits
 	}
-	return ""
+	return 0
 }
 
-func (x *ReindexResponse) GetEventsIndexed() int32 {
+func (x *SearchOrganizationsResponse) GetProcessingTimeMs() int64 {
 	if x != nil {
-		return x.EventsIndexed
+		return x.ProcessingTimeMs
 	}
 	return 0
 }
 
-func (x *ReindexResponse) GetOrganizationsIndexed() int32 {
-	if x != nil {
-		return x.OrganizationsIndexed
-	}
-	return 0
+// SearchOrganizationTypesRequest is for searching only organization types
+type SearchOrganizationTypesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReindexResponse) GetUsersIndexed() int32 {
-	if x != nil {
-		return x.UsersIndexed
-	}
-	return 0
+func (x *SearchOrganizationTypesRequest) Reset() {
+	*x = SearchOrganizationTypesRequest{}
+	mi := &file_searchv1_search_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReindexResponse) GetTagsIndexed() int32 {
+func (x *SearchOrganizationTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchOrganizationTypesRequest) ProtoMessage() {}
+
+func (x *SearchOrganizationTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_searchv1_search_proto_msgTypes[8]
 	if x != nil {
-		return x.TagsIndexed
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-var File_searchv1_search_proto protoreflect.FileDescriptor
+// Deprecated: Use SearchOrganizationTypesRequest.ProtoReflect.Descriptor instead.
+func (*SearchOrganizationTypesRequest) Descriptor() ([]byte, []int) {
+	return file_searchv1_search_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SearchOrganizationTypesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchOrganizationTypesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// SearchOrganizationTypesResponse contains organization type search results
+type SearchOrganizationTypesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*SearchResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	TotalHits     int64                  `protobuf:"varint,2,opt,name=total_hits,json=totalHits,proto3" json:"total_hits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchOrganizationTypesResponse) Reset() {
+	*x = SearchOrganizationTypesResponse{}
+	mi := &file_searchv1_search_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchOrganizationTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchOrganizationTypesResponse) ProtoMessage() {}
+
+func (x *SearchOrganizationTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_searchv1_search_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchOrganizationTypesResponse.ProtoReflect.Descriptor instead.
+func (*SearchOrganizationTypesResponse) Descriptor() ([]byte, []int) {
+	return file_searchv1_search_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SearchOrganizationTypesResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchOrganizationTypesResponse) GetTotalHits() int64 {
+	if x != nil {
+		return x.TotalHits
+	}
+	return 0
+}
+
+// SearchMemberEventsRequest is for searching events of the caller's clubs.
+// An empty query matches every event of those clubs.
+type SearchMemberEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMemberEventsRequest) Reset() {
+	*x = SearchMemberEventsRequest{}
+	mi := &file_searchv1_search_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMemberEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMemberEventsRequest) ProtoMessage() {}
+
+func (x *SearchMemberEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_searchv1_search_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMemberEventsRequest.ProtoReflect.Descriptor instead.
+func (*SearchMemberEventsRequest) Descriptor() ([]byte, []int) {
+	return file_searchv1_search_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SearchMemberEventsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchMemberEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// SearchMemberEventsResponse contains event search results
+type SearchMemberEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*SearchResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	TotalHits     int64                  `protobuf:"varint,2,opt,name=total_hits,json=totalHits,proto3" json:"total_hits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMemberEventsResponse) Reset() {
+	*x = SearchMemberEventsResponse{}
+	mi := &file_searchv1_search_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMemberEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMemberEventsResponse) ProtoMessage() {}
+
+func (x *SearchMemberEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_searchv1_search_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMemberEventsResponse.ProtoReflect.Descriptor instead.
+func (*SearchMemberEventsResponse) Descriptor() ([]byte, []int) {
+	return file_searchv1_search_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SearchMemberEventsResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchMemberEventsResponse) GetTotalHits() int64 {
+	if x != nil {
+		return x.TotalHits
+	}
+	return 0
+}
+
+// SearchUsersRequest searches users, optionally narrowed to a platform role
+// or the members of a club. Only the best 200 matches are considered.
+type SearchUsersRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Query              string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	PlatformRoleFilter *usersv1.PlatformRole  `protobuf:"varint,2,opt,name=platform_role_filter,json=platformRoleFilter,proto3,enum=users.v1.PlatformRole,oneof" json:"platform_role_filter,omitempty"` // STAFF or ADMIN
+	OrgIdFilter        *int32                 `protobuf:"varint,3,opt,name=org_id_filter,json=orgIdFilter,proto3,oneof" json:"org_id_filter,omitempty"`
+	Page               int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Limit              int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SearchUsersRequest) Reset() {
+	*x = SearchUsersRequest{}
+	mi := &file_searchv1_search_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersRequest) ProtoMessage() {}
+
+func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_searchv1_search_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
+func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
+	return file_searchv1_search_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SearchUsersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetPlatformRoleFilter() usersv1.PlatformRole {
+	if x != nil && x.PlatformRoleFilter != nil {
+		return *x.PlatformRoleFilter
+	}
+	return usersv1.PlatformRole(0)
+}
+
+func (x *SearchUsersRequest) GetOrgIdFilter() int32 {
+	if x != nil && x.OrgIdFilter != nil {
+		return *x.OrgIdFilter
+	}
+	return 0
+}
+
+func (x *SearchUsersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// SearchUsersResponse contains matching users with their platform role
+type SearchUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*usersv1.User        `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // Matches after filtering, at most 200
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersResponse) Reset() {
+	*x = SearchUsersResponse{}
+	mi := &file_searchv1_search_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersResponse) ProtoMessage() {}
+
+func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_searchv1_search_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
+func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
+	return file_searchv1_search_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SearchUsersResponse) GetUsers() []*usersv1.User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *SearchUsersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// SuggestTagsForEventRequest asks for tags that fit an event title
+type SuggestTagsForEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Defaults to 5
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestTagsForEventRequest) Reset() {
+	*x = SuggestTagsForEventRequest{}
+	mi := &file_searchv1_search_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestTagsForEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestTagsForEventRequest) ProtoMessage() {}
+
+func (x *SuggestTagsForEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_searchv1_search_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestTagsForEventRequest.ProtoReflect.Descriptor instead.
+func (*SuggestTagsForEventRequest) Descriptor() ([]byte, []int) {
+	return file_searchv1_search_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SuggestTagsForEventRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SuggestTagsForEventRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// TagSuggestion is a tag ranked by how well it fits the title
+type TagSuggestion struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TagId           int32                  `protobuf:"varint,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ConfidenceScore float64                `protobuf:"fixed64,3,opt,name=confidence_score,json=confidenceScore,proto3" json:"confidence_score,omitempty"` // 0-1
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TagSuggestion) Reset() {
+	*x = TagSuggestion{}
+	mi := &file_searchv1_search_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagSuggestion) ProtoMessage() {}
+
+func (x *TagSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_searchv1_search_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagSuggestion.ProtoReflect.Descriptor instead.
+func (*TagSuggestion) Descriptor() ([]byte, []int) {
+	return file_searchv1_search_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TagSuggestion) GetTagId() int32 {
+	if x != nil {
+		return x.TagId
+	}
+	return 0
+}
+
+func (x *TagSuggestion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TagSuggestion) GetConfidenceScore() float64 {
+	if x != nil {
+		return x.ConfidenceScore
+	}
+	return 0
+}
+
+// SuggestTagsForEventResponse contains suggestions, best first
+type SuggestTagsForEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*TagSuggestion       `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestTagsForEventResponse) Reset() {
+	*x = SuggestTagsForEventResponse{}
+	mi := &file_searchv1_search_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestTagsForEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestTagsForEventResponse) ProtoMessage() {}
+
+func (x *SuggestTagsForEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_searchv1_search_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestTagsForEventResponse.ProtoReflect.Descriptor instead.
+func (*SuggestTagsForEventResponse) Descriptor() ([]byte, []int) {
+	return file_searchv1_search_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SuggestTagsForEventResponse) GetSuggestions() []*TagSuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+// ReindexRequest triggers a full reindex of all data
+type ReindexRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Indexes       []string               `protobuf:"bytes,1,rep,name=indexes,proto3" json:"indexes,omitempty"` // Optional: specific indexes to reindex, empty = all
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReindexRequest) Reset() {
+	*x = ReindexRequest{}
+	mi := &file_searchv1_search_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReindexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReindexRequest) ProtoMessage() {}
+
+func (x *ReindexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_searchv1_search_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReindexRequest.ProtoReflect.Descriptor instead.
+func (*ReindexRequest) Descriptor() ([]byte, []int) {
+	return file_searchv1_search_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReindexRequest) GetIndexes() []string {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+// ReindexResponse contains the reindex status
+type ReindexResponse struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Success                  bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message                  string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	EventsIndexed            int32                  `protobuf:"varint,3,opt,name=events_indexed,json=eventsIndexed,proto3" json:"events_indexed,omitempty"`
+	OrganizationsIndexed     int32                  `protobuf:"varint,4,opt,name=organizations_indexed,json=organizationsIndexed,proto3" json:"organizations_indexed,omitempty"`
+	UsersIndexed             int32                  `protobuf:"varint,5,opt,name=users_indexed,json=usersIndexed,proto3" json:"users_indexed,omitempty"`
+	TagsIndexed              int32                  `protobuf:"varint,6,opt,name=tags_indexed,json=tagsIndexed,proto3" json:"tags_indexed,omitempty"`
+	OrganizationTypesIndexed int32                  `protobuf:"varint,7,opt,name=organization_types_indexed,json=organizationTypesIndexed,proto3" json:"organization_types_indexed,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ReindexResponse) Reset() {
+	*x = ReindexResponse{}
+	mi := &file_searchv1_search_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReindexResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReindexResponse) ProtoMessage() {}
+
+func (x *ReindexResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_searchv1_search_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReindexResponse.ProtoReflect.Descriptor instead.
+func (*ReindexResponse) Descriptor() ([]byte, []int) {
+	return file_searchv1_search_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReindexResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReindexResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReindexResponse) GetEventsIndexed() int32 {
+	if x != nil {
+		return x.EventsIndexed
+	}
+	return 0
+}
+
+func (x *ReindexResponse) GetOrganizationsIndexed() int32 {
+	if x != nil {
+		return x.OrganizationsIndexed
+	}
+	return 0
+}
+
+func (x *ReindexResponse) GetUsersIndexed() int32 {
+	if x != nil {
+		return x.UsersIndexed
+	}
+	return 0
+}
+
+func (x *ReindexResponse) GetTagsIndexed() int32 {
+	if x != nil {
+		return x.TagsIndexed
+	}
+	return 0
+}
+
+func (x *ReindexResponse) GetOrganizationTypesIndexed() int32 {
+	if x != nil {
+		return x.OrganizationTypesIndexed
+	}
+	return 0
+}
+
+var File_searchv1_search_proto protoreflect.FileDescriptor
 
 const file_searchv1_search_proto_rawDesc = "" +
 	"\n" +
-	"\x15searchv1/search.proto\x12\tsearch.v1\"\xcc\x01\n" +
+	"\x15searchv1/search.proto\x12\tsearch.v1\x1a\x15eventsv1/events.proto\x1a\x13usersv1/users.proto\"\xcc\x01\n" +
 	"\fSearchResult\x12/\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x1b.search.v1.SearchResultTypeR\x04type\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\x05R\x02id\x12\x14\n" +
@@ -549,45 +1419,125 @@ const file_searchv1_search_proto_rawDesc = "" +
 	"\timage_url\x18\x05 \x01(\tH\x01R\bimageUrl\x88\x01\x01B\x0e\n" +
 	"\f_descriptionB\f\n" +
 	"\n" +
-	"_image_url\"t\n" +
+	"_image_url\"|\n" +
+	"\vIndexResult\x12\x1d\n" +
+	"\n" +
+	"index_name\x18\x01 \x01(\tR\tindexName\x12\x1b\n" +
+	"\thit_count\x18\x02 \x01(\x03R\bhitCount\x121\n" +
+	"\aresults\x18\x03 \x03(\v2\x17.search.v1.SearchResultR\aresults\"\x9c\x01\n" +
 	"\x13GlobalSearchRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x121\n" +
-	"\x05types\x18\x03 \x03(\x0e2\x1b.search.v1.SearchResultTypeR\x05types\"\xac\x01\n" +
-	"\x14GlobalSearchResponse\x121\n" +
-	"\aresults\x18\x01 \x03(\v2\x17.search.v1.SearchResultR\aresults\x12\x1d\n" +
+	"\x05types\x18\x03 \x03(\x0e2\x1b.search.v1.SearchResultTypeR\x05types\x12&\n" +
+	"\x0flimit_per_index\x18\x04 \x01(\x05R\rlimitPerIndex\"\xed\x01\n" +
+	"\x14GlobalSearchResponse\x125\n" +
+	"\aresults\x18\x01 \x03(\v2\x17.search.v1.SearchResultB\x02\x18\x01R\aresults\x12\x1d\n" +
 	"\n" +
 	"total_hits\x18\x02 \x01(\x03R\ttotalHits\x12,\n" +
 	"\x12processing_time_ms\x18\x03 \x01(\x03R\x10processingTimeMs\x12\x14\n" +
-	"\x05query\x18\x04 \x01(\tR\x05query\"\x9c\x01\n" +
+	"\x05query\x18\x04 \x01(\tR\x05query\x12;\n" +
+	"\rindex_results\x18\x05 \x03(\v2\x16.search.v1.IndexResultR\findexResults\"\x88\x04\n" +
 	"\x13SearchEventsRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12,\n" +
 	"\x0forganization_id\x18\x03 \x01(\x05H\x00R\x0eorganizationId\x88\x01\x01\x12\x17\n" +
-	"\atag_ids\x18\x04 \x03(\x05R\x06tagIdsB\x12\n" +
-	"\x10_organization_id\"h\n" +
+	"\atag_ids\x18\x04 \x03(\x05R\x06tagIds\x125\n" +
+	"\x14organization_type_id\x18\x05 \x01(\x05H\x01R\x12organizationTypeId\x88\x01\x01\x12@\n" +
+	"\x0ftag_filter_mode\x18\x06 \x01(\x0e2\x18.search.v1.TagFilterModeR\rtagFilterMode\x12/\n" +
+	"\asort_by\x18\a \x01(\x0e2\x16.search.v1.EventSortByR\x06sortBy\x12$\n" +
+	"\vstart_after\x18\b \x01(\tH\x02R\n" +
+	"startAfter\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"end_before\x18\t \x01(\tH\x03R\tendBefore\x88\x01\x01\x123\n" +
+	"\x06format\x18\n" +
+	" \x01(\x0e2\x16.events.v1.EventFormatH\x04R\x06format\x88\x01\x01B\x12\n" +
+	"\x10_organization_idB\x17\n" +
+	"\x15_organization_type_idB\x0e\n" +
+	"\f_start_afterB\r\n" +
+	"\v_end_beforeB\t\n" +
+	"\a_format\"h\n" +
 	"\x14SearchEventsResponse\x121\n" +
 	"\aresults\x18\x01 \x03(\v2\x17.search.v1.SearchResultR\aresults\x12\x1d\n" +
 	"\n" +
-	"total_hits\x18\x02 \x01(\x03R\ttotalHits\"*\n" +
+	"total_hits\x18\x02 \x01(\x03R\ttotalHits\"\xdf\x01\n" +
+	"\x1aSearchOrganizationsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x125\n" +
+	"\x14organization_type_id\x18\x03 \x01(\x05H\x00R\x12organizationTypeId\x88\x01\x01\x12:\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1d.events.v1.OrganizationStatusH\x01R\x06status\x88\x01\x01B\x17\n" +
+	"\x15_organization_type_idB\t\n" +
+	"\a_status\"\x9d\x01\n" +
+	"\x1bSearchOrganizationsResponse\x121\n" +
+	"\aresults\x18\x01 \x03(\v2\x17.search.v1.SearchResultR\aresults\x12\x1d\n" +
+	"\n" +
+	"total_hits\x18\x02 \x01(\x03R\ttotalHits\x12,\n" +
+	"\x12processing_time_ms\x18\x03 \x01(\x03R\x10processingTimeMs\"L\n" +
+	"\x1eSearchOrganizationTypesRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"s\n" +
+	"\x1fSearchOrganizationTypesResponse\x121\n" +
+	"\aresults\x18\x01 \x03(\v2\x17.search.v1.SearchResultR\aresults\x12\x1d\n" +
+	"\n" +
+	"total_hits\x18\x02 \x01(\x03R\ttotalHits\"G\n" +
+	"\x19SearchMemberEventsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"n\n" +
+	"\x1aSearchMemberEventsResponse\x121\n" +
+	"\aresults\x18\x01 \x03(\v2\x17.search.v1.SearchResultR\aresults\x12\x1d\n" +
+	"\n" +
+	"total_hits\x18\x02 \x01(\x03R\ttotalHits\"\xf7\x01\n" +
+	"\x12SearchUsersRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12M\n" +
+	"\x14platform_role_filter\x18\x02 \x01(\x0e2\x16.users.v1.PlatformRoleH\x00R\x12platformRoleFilter\x88\x01\x01\x12'\n" +
+	"\rorg_id_filter\x18\x03 \x01(\x05H\x01R\vorgIdFilter\x88\x01\x01\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limitB\x17\n" +
+	"\x15_platform_role_filterB\x10\n" +
+	"\x0e_org_id_filter\"Q\n" +
+	"\x13SearchUsersResponse\x12$\n" +
+	"\x05users\x18\x01 \x03(\v2\x0e.users.v1.UserR\x05users\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"H\n" +
+	"\x1aSuggestTagsForEventRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"e\n" +
+	"\rTagSuggestion\x12\x15\n" +
+	"\x06tag_id\x18\x01 \x01(\x05R\x05tagId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12)\n" +
+	"\x10confidence_score\x18\x03 \x01(\x01R\x0fconfidenceScore\"Y\n" +
+	"\x1bSuggestTagsForEventResponse\x12:\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x18.search.v1.TagSuggestionR\vsuggestions\"*\n" +
 	"\x0eReindexRequest\x12\x18\n" +
-	"\aindexes\x18\x01 \x03(\tR\aindexes\"\xe9\x01\n" +
+	"\aindexes\x18\x01 \x03(\tR\aindexes\"\xa7\x02\n" +
 	"\x0fReindexResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12%\n" +
 	"\x0eevents_indexed\x18\x03 \x01(\x05R\reventsIndexed\x123\n" +
 	"\x15organizations_indexed\x18\x04 \x01(\x05R\x14organizationsIndexed\x12#\n" +
 	"\rusers_indexed\x18\x05 \x01(\x05R\fusersIndexed\x12!\n" +
-	"\ftags_indexed\x18\x06 \x01(\x05R\vtagsIndexed*\xb2\x01\n" +
+	"\ftags_indexed\x18\x06 \x01(\x05R\vtagsIndexed\x12<\n" +
+	"\x1aorganization_types_indexed\x18\a \x01(\x05R\x18organizationTypesIndexed*\xdc\x01\n" +
 	"\x10SearchResultType\x12\"\n" +
 	"\x1eSEARCH_RESULT_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18SEARCH_RESULT_TYPE_EVENT\x10\x01\x12#\n" +
 	"\x1fSEARCH_RESULT_TYPE_ORGANIZATION\x10\x02\x12\x1b\n" +
 	"\x17SEARCH_RESULT_TYPE_USER\x10\x03\x12\x1a\n" +
-	"\x16SEARCH_RESULT_TYPE_TAG\x10\x042\xf3\x01\n" +
+	"\x16SEARCH_RESULT_TYPE_TAG\x10\x04\x12(\n" +
+	"$SEARCH_RESULT_TYPE_ORGANIZATION_TYPE\x10\x05*b\n" +
+	"\rTagFilterMode\x12\x1f\n" +
+	"\x1bTAG_FILTER_MODE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13TAG_FILTER_MODE_ANY\x10\x01\x12\x17\n" +
+	"\x13TAG_FILTER_MODE_ALL\x10\x02*T\n" +
+	"\vEventSortBy\x12\x1d\n" +
+	"\x19EVENT_SORT_BY_UNSPECIFIED\x10\x00\x12&\n" +
+	"\"EVENT_SORT_BY_ATTENDANCE_RATE_DESC\x10\x012\xe2\x05\n" +
 	"\rSearchService\x12O\n" +
 	"\fGlobalSearch\x12\x1e.search.v1.GlobalSearchRequest\x1a\x1f.search.v1.GlobalSearchResponse\x12O\n" +
-	"\fSearchEvents\x12\x1e.search.v1.SearchEventsRequest\x1a\x1f.search.v1.SearchEventsResponse\x12@\n" +
+	"\fSearchEvents\x12\x1e.search.v1.SearchEventsRequest\x1a\x1f.search.v1.SearchEventsResponse\x12d\n" +
+	"\x13SearchOrganizations\x12%.search.v1.SearchOrganizationsRequest\x1a&.search.v1.SearchOrganizationsResponse\x12p\n" +
+	"\x17SearchOrganizationTypes\x12).search.v1.SearchOrganizationTypesRequest\x1a*.search.v1.SearchOrganizationTypesResponse\x12a\n" +
+	"\x12SearchMemberEvents\x12$.search.v1.SearchMemberEventsRequest\x1a%.search.v1.SearchMemberEventsResponse\x12L\n" +
+	"\vSearchUsers\x12\x1d.search.v1.SearchUsersRequest\x1a\x1e.search.v1.SearchUsersResponse\x12d\n" +
+	"\x13SuggestTagsForEvent\x12%.search.v1.SuggestTagsForEventRequest\x1a&.search.v1.SuggestTagsForEventResponse\x12@\n" +
 	"\aReindex\x12\x19.search.v1.ReindexRequest\x1a\x1a.search.v1.ReindexResponseB\x9a\x01\n" +
 	"\rcom.search.v1B\vSearchProtoP\x01Z7github.com/studyverse/ems-backend/gen/searchv1;searchv1\xa2\x02\x03SXX\xaa\x02\tSearch.V1\xca\x02\tSearch\\V1\xe2\x02\x15Search\\V1\\GPBMetadata\xea\x02\n" +
 	"Search::V1b\x06proto3"
@@ -604,34 +1554,74 @@ func file_searchv1_search_proto_rawDescGZIP() []byte {
 	return file_searchv1_search_proto_rawDescData
 }
 
-var file_searchv1_search_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_searchv1_search_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_searchv1_search_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_searchv1_search_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_searchv1_search_proto_goTypes = []any{
-	(SearchResultType)(0),        // 0: search.v1.SearchResultType
-	(*SearchResult)(nil),         // 1: search.v1.SearchResult
-	(*GlobalSearchRequest)(nil),  // 2: search.v1.GlobalSearchRequest
-	(*GlobalSearchResponse)(nil), // 3: search.v1.GlobalSearchResponse
-	(*SearchEventsRequest)(nil),  // 4: search.v1.SearchEventsRequest
-	(*SearchEventsResponse)(nil), // 5: search.v1.SearchEventsResponse
-	(*ReindexRequest)(nil),       // 6: search.v1.ReindexRequest
-	(*ReindexResponse)(nil),      // 7: search.v1.ReindexResponse
+	(SearchResultType)(0),                   // 0: search.v1.SearchResultType
+	(TagFilterMode)(0),                      // 1: search.v1.TagFilterMode
+	(EventSortBy)(0),                        // 2: search.v1.EventSortBy
+	(*SearchResult)(nil),                    // 3: search.v1.SearchResult
+	(*IndexResult)(nil),                     // 4: search.v1.IndexResult
+	(*GlobalSearchRequest)(nil),             // 5: search.v1.GlobalSearchRequest
+	(*GlobalSearchResponse)(nil),            // 6: search.v1.GlobalSearchResponse
+	(*SearchEventsRequest)(nil),             // 7: search.v1.SearchEventsRequest
+	(*SearchEventsResponse)(nil),            // 8: search.v1.SearchEventsResponse
+	(*SearchOrganizationsRequest)(nil),      // 9: search.v1.SearchOrganizationsRequest
+	(*SearchOrganizationsResponse)(nil),     // 10: search.v1.SearchOrganizationsResponse
+	(*SearchOrganizationTypesRequest)(nil),  // 11: search.v1.SearchOrganizationTypesRequest
+	(*SearchOrganizationTypesResponse)(nil), // 12: search.v1.SearchOrganizationTypesResponse
+	(*SearchMemberEventsRequest)(nil),       // 13: search.v1.SearchMemberEventsRequest
+	(*SearchMemberEventsResponse)(nil),      // 14: search.v1.SearchMemberEventsResponse
+	(*SearchUsersRequest)(nil),              // 15: search.v1.SearchUsersRequest
+	(*SearchUsersResponse)(nil),             // 16: search.v1.SearchUsersResponse
+	(*SuggestTagsForEventRequest)(nil),      // 17: search.v1.SuggestTagsForEventRequest
+	(*TagSuggestion)(nil),                   // 18: search.v1.TagSuggestion
+	(*SuggestTagsForEventResponse)(nil),     // 19: search.v1.SuggestTagsForEventResponse
+	(*ReindexRequest)(nil),                  // 20: search.v1.ReindexRequest
+	(*ReindexResponse)(nil),                 // 21: search.v1.ReindexResponse
+	(eventsv1.EventFormat)(0),               // 22: events.v1.EventFormat
+	(eventsv1.OrganizationStatus)(0),        // 23: events.v1.OrganizationStatus
+	(usersv1.PlatformRole)(0),               // 24: users.v1.PlatformRole
+	(*usersv1.User)(nil),                    // 25: users.v1.User
 }
 var file_searchv1_search_proto_depIdxs = []int32{
-	0, // 0: search.v1.SearchResult.type:type_name -> search.v1.SearchResultType
-	0, // 1: search.v1.GlobalSearchRequest.types:type_name -> search.v1.SearchResultType
-	1, // 2: search.v1.GlobalSearchResponse.results:type_name -> search.v1.SearchResult
-	1, // 3: search.v1.SearchEventsResponse.results:type_name -> search.v1.SearchResult
-	2, // 4: search.v1.SearchService.GlobalSearch:input_type -> search.v1.GlobalSearchRequest
-	4, // 5: search.v1.SearchService.SearchEvents:input_type -> search.v1.SearchEventsRequest
-	6, // 6: search.v1.SearchService.Reindex:input_type -> search.v1.ReindexRequest
-	3, // 7: search.v1.SearchService.GlobalSearch:output_type -> search.v1.GlobalSearchResponse
-	5, // 8: search.v1.SearchService.SearchEvents:output_type -> search.v1.SearchEventsResponse
-	7, // 9: search.v1.SearchService.Reindex:output_type -> search.v1.ReindexResponse
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	0,  // 0: search.v1.SearchResult.type:type_name -> search.v1.SearchResultType
+	3,  // 1: search.v1.IndexResult.results:type_name -> search.v1.SearchResult
+	0,  // 2: search.v1.GlobalSearchRequest.types:type_name -> search.v1.SearchResultType
+	3,  // 3: search.v1.GlobalSearchResponse.results:type_name -> search.v1.SearchResult
+	4,  // 4: search.v1.GlobalSearchResponse.index_results:type_name -> search.v1.IndexResult
+	1,  // 5: search.v1.SearchEventsRequest.tag_filter_mode:type_name -> search.v1.TagFilterMode
+	2,  // 6: search.v1.SearchEventsRequest.sort_by:type_name -> search.v1.EventSortBy
+	22, // 7: search.v1.SearchEventsRequest.format:type_name -> events.v1.EventFormat
+	3,  // 8: search.v1.SearchEventsResponse.results:type_name -> search.v1.SearchResult
+	23, // 9: search.v1.SearchOrganizationsRequest.status:type_name -> events.v1.OrganizationStatus
+	3,  // 10: search.v1.SearchOrganizationsResponse.results:type_name -> search.v1.SearchResult
+	3,  // 11: search.v1.SearchOrganizationTypesResponse.results:type_name -> search.v1.SearchResult
+	3,  // 12: search.v1.SearchMemberEventsResponse.results:type_name -> search.v1.SearchResult
+	24, // 13: search.v1.SearchUsersRequest.platform_role_filter:type_name -> users.v1.PlatformRole
+	25, // 14: search.v1.SearchUsersResponse.users:type_name -> users.v1.User
+	18, // 15: search.v1.SuggestTagsForEventResponse.suggestions:type_name -> search.v1.TagSuggestion
+	5,  // 16: search.v1.SearchService.GlobalSearch:input_type -> search.v1.GlobalSearchRequest
+	7,  // 17: search.v1.SearchService.SearchEvents:input_type -> search.v1.SearchEventsRequest
+	9,  // 18: search.v1.SearchService.SearchOrganizations:input_type -> search.v1.SearchOrganizationsRequest
+	11, // 19: search.v1.SearchService.SearchOrganizationTypes:input_type -> search.v1.SearchOrganizationTypesRequest
+	13, // 20: search.v1.SearchService.SearchMemberEvents:input_type -> search.v1.SearchMemberEventsRequest
+	15, // 21: search.v1.SearchService.SearchUsers:input_type -> search.v1.SearchUsersRequest
+	17, // 22: search.v1.SearchService.SuggestTagsForEvent:input_type -> search.v1.SuggestTagsForEventRequest
+	20, // 23: search.v1.SearchService.Reindex:input_type -> search.v1.ReindexRequest
+	6,  // 24: search.v1.SearchService.GlobalSearch:output_type -> search.v1.GlobalSearchResponse
+	8,  // 25: search.v1.SearchService.SearchEvents:output_type -> search.v1.SearchEventsResponse
+	10, // 26: search.v1.SearchService.SearchOrganizations:output_type -> search.v1.SearchOrganizationsResponse
+	12, // 27: search.v1.SearchService.SearchOrganizationTypes:output_type -> search.v1.SearchOrganizationTypesResponse
+	14, // 28: search.v1.SearchService.SearchMemberEvents:output_type -> search.v1.SearchMemberEventsResponse
+	16, // 29: search.v1.SearchService.SearchUsers:output_type -> search.v1.SearchUsersResponse
+	19, // 30: search.v1.SearchService.SuggestTagsForEvent:output_type -> search.v1.SuggestTagsForEventResponse
+	21, // 31: search.v1.SearchService.Reindex:output_type -> search.v1.ReindexResponse
+	24, // [24:32] is the sub-list for method output_type
+	16, // [16:24] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_searchv1_search_proto_init() }
@@ -640,14 +1630,16 @@ func file_searchv1_search_proto_init() {
 		return
 	}
 	file_searchv1_search_proto_msgTypes[0].OneofWrappers = []any{}
-	file_searchv1_search_proto_msgTypes[3].OneofWrappers = []any{}
+	file_searchv1_search_proto_msgTypes[4].OneofWrappers = []any{}
+	file_searchv1_search_proto_msgTypes[6].OneofWrappers = []any{}
+	file_searchv1_search_proto_msgTypes[12].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_searchv1_search_proto_rawDesc), len(file_searchv1_search_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   7,
+			NumEnums:      3,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -39,6 +39,21 @@ const (
 	// SearchServiceSearchEventsProcedure is the fully-qualified name of the SearchService's
 	// SearchEvents RPC.
 	SearchServiceSearchEventsProcedure = "/search.v1.SearchService/SearchEvents"
+	// SearchServiceSearchOrganizationsProcedure is the fully-qualified name of the SearchService's
+	// SearchOrganizations RPC.
+	SearchServiceSearchOrganizationsProcedure = "/search.v1.SearchService/SearchOrganizations"
+	// SearchServiceSearchOrganizationTypesProcedure is the fully-qualified name of the SearchService's
+	// SearchOrganizationTypes RPC.
+	SearchServiceSearchOrganizationTypesProcedure = "/search.v1.SearchService/SearchOrganizationTypes"
+	// SearchServiceSearchMemberEventsProcedure is the fully-qualified name of the SearchService's
+	// SearchMemberEvents RPC.
+	SearchServiceSearchMemberEventsProcedure = "/search.v1.SearchService/SearchMemberEvents"
+	// SearchServiceSearchUsersProcedure is the fully-qualified name of the SearchService's SearchUsers
+	// RPC.
+	SearchServiceSearchUsersProcedure = "/search.v1.SearchService/SearchUsers"
+	// SearchServiceSuggestTagsForEventProcedure is the fully-qualified name of the SearchService's
+	// SuggestTagsForEvent RPC.
+	SearchServiceSuggestTagsForEventProcedure = "/search.v1.SearchService/SuggestTagsForEvent"
 	// SearchServiceReindexProcedure is the fully-qualified name of the SearchService's Reindex RPC.
 	SearchServiceReindexProcedure = "/search.v1.SearchService/Reindex"
 )
@@ -49,6 +64,16 @@ type SearchServiceClient interface {
 	GlobalSearch(context.Context, *connect.Request[searchv1.GlobalSearchRequest]) (*connect.Response[searchv1.GlobalSearchResponse], error)
 	// SearchEvents searches only events with optional filters
 	SearchEvents(context.Context, *connect.Request[searchv1.SearchEventsRequest]) (*connect.Response[searchv1.SearchEventsResponse], error)
+	// SearchOrganizations searches only organizations with type and status filters
+	SearchOrganizations(context.Context, *connect.Request[searchv1.SearchOrganizationsRequest]) (*connect.Response[searchv1.SearchOrganizationsResponse], error)
+	// SearchOrganizationTypes searches only organization types
+	SearchOrganizationTypes(context.Context, *connect.Request[searchv1.SearchOrganizationTypesRequest]) (*connect.Response[searchv1.SearchOrganizationTypesResponse], error)
+	// SearchMemberEvents searches events of the clubs the caller belongs to
+	SearchMemberEvents(context.Context, *connect.Request[searchv1.SearchMemberEventsRequest]) (*connect.Response[searchv1.SearchMemberEventsResponse], error)
+	// SearchUsers searches users with role and club filters (admin only)
+	SearchUsers(context.Context, *connect.Request[searchv1.SearchUsersRequest]) (*connect.Response[searchv1.SearchUsersResponse], error)
+	// SuggestTagsForEvent suggests tags used by events with similar titles
+	SuggestTagsForEvent(context.Context, *connect.Request[searchv1.SuggestTagsForEventRequest]) (*connect.Response[searchv1.SuggestTagsForEventResponse], error)
 	// Reindex triggers a full reindex of the search engine
 	Reindex(context.Context, *connect.Request[searchv1.ReindexRequest]) (*connect.Response[searchv1.ReindexResponse], error)
 }
@@ -76,6 +101,36 @@ func NewSearchServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(searchServiceMethods.ByName("SearchEvents")),
 			connect.WithClientOptions(opts...),
 		),
+		searchOrganizations: connect.NewClient[searchv1.SearchOrganizationsRequest, searchv1.SearchOrganizationsResponse](
+			httpClient,
+			baseURL+SearchServiceSearchOrganizationsProcedure,
+			connect.WithSchema(searchServiceMethods.ByName("SearchOrganizations")),
+			connect.WithClientOptions(opts...),
+		),
+		searchOrganizationTypes: connect.NewClient[searchv1.SearchOrganizationTypesRequest, searchv1.SearchOrganizationTypesResponse](
+			httpClient,
+			baseURL+SearchServiceSearchOrganizationTypesProcedure,
+			connect.WithSchema(searchServiceMethods.ByName("SearchOrganizationTypes")),
+			connect.WithClientOptions(opts...),
+		),
+		searchMemberEvents: connect.NewClient[searchv1.SearchMemberEventsRequest, searchv1.SearchMemberEventsResponse](
+			httpClient,
+			baseURL+SearchServiceSearchMemberEventsProcedure,
+			connect.WithSchema(searchServiceMethods.ByName("SearchMemberEvents")),
+			connect.WithClientOptions(opts...),
+		),
+		searchUsers: connect.NewClient[searchv1.SearchUsersRequest, searchv1.SearchUsersResponse](
+			httpClient,
+			baseURL+SearchServiceSearchUsersProcedure,
+			connect.WithSchema(searchServiceMethods.ByName("SearchUsers")),
+			connect.WithClientOptions(opts...),
+		),
+		suggestTagsForEvent: connect.NewClient[searchv1.SuggestTagsForEventRequest, searchv1.SuggestTagsForEventResponse](
+			httpClient,
+			baseURL+SearchServiceSuggestTagsForEventProcedure,
+			connect.WithSchema(searchServiceMethods.ByName("SuggestTagsForEvent")),
+			connect.WithClientOptions(opts...),
+		),
 		reindex: connect.NewClient[searchv1.ReindexRequest, searchv1.ReindexResponse](
 			httpClient,
 			baseURL+SearchServiceReindexProcedure,
@@ -87,9 +142,14 @@ func NewSearchServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 
 // searchServiceClient implements SearchServiceClient.
 type searchServiceClient struct {
-	globalSearch *connect.Client[searchv1.GlobalSearchRequest, searchv1.GlobalSearchResponse]
-	searchEvents *connect.Client[searchv1.SearchEventsRequest, searchv1.SearchEventsResponse]
-	reindex      *connect.Client[searchv1.ReindexRequest, searchv1.ReindexResponse]
+	globalSearch            *connect.Client[searchv1.GlobalSearchRequest, searchv1.GlobalSearchResponse]
+	searchEvents            *connect.Client[searchv1.SearchEventsRequest, searchv1.SearchEventsResponse]
+	searchOrganizations     *connect.Client[searchv1.SearchOrganizationsRequest, searchv1.SearchOrganizationsResponse]
+	searchOrganizationTypes *connect.Client[searchv1.SearchOrganizationTypesRequest, searchv1.SearchOrganizationTypesResponse]
+	searchMemberEvents      *connect.Client[searchv1.SearchMemberEventsRequest, searchv1.SearchMemberEventsResponse]
+	searchUsers             *connect.Client[searchv1.SearchUsersRequest, searchv1.SearchUsersResponse]
+	suggestTagsForEvent     *connect.Client[searchv1.SuggestTagsForEventRequest, searchv1.SuggestTagsForEventResponse]
+	reindex                 *connect.Client[searchv1.ReindexRequest, searchv1.ReindexResponse]
 }
 
 // GlobalSearch calls search.v1.SearchService.GlobalSearch.
@@ -102,6 +162,31 @@ func (c *searchServiceClient) SearchEvents(ctx context.Context, req *connect.Req
 	return c.searchEvents.CallUnary(ctx, req)
 }
 
+// SearchOrganizations calls search.v1.SearchService.SearchOrganizations.
+func (c *searchServiceClient) SearchOrganizations(ctx context.Context, req *connect.Request[searchv1.SearchOrganizationsRequest]) (*connect.Response[searchv1.SearchOrganizationsResponse], error) {
+	return c.searchOrganizations.CallUnary(ctx, req)
+}
+
+// SearchOrganizationTypes calls search.v1.SearchService.SearchOrganizationTypes.
+func (c *searchServiceClient) SearchOrganizationTypes(ctx context.Context, req *connect.Request[searchv1.SearchOrganizationTypesRequest]) (*connect.Response[searchv1.SearchOrganizationTypesResponse], error) {
+	return c.searchOrganizationTypes.CallUnary(ctx, req)
+}
+
+// SearchMemberEvents calls search.v1.SearchService.SearchMemberEvents.
+func (c *searchServiceClient) SearchMemberEvents(ctx context.Context, req *connect.Request[searchv1.SearchMemberEventsRequest]) (*connect.Response[searchv1.SearchMemberEventsResponse], error) {
+	return c.searchMemberEvents.CallUnary(ctx, req)
+}
+
+// SearchUsers calls search.v1.SearchService.SearchUsers.
+func (c *searchServiceClient) SearchUsers(ctx context.Context, req *connect.Request[searchv1.SearchUsersRequest]) (*connect.Response[searchv1.SearchUsersResponse], error) {
+	return c.searchUsers.CallUnary(ctx, req)
+}
+
+// SuggestTagsForEvent calls search.v1.SearchService.SuggestTagsForEvent.
+func (c *searchServiceClient) SuggestTagsForEvent(ctx context.Context, req *connect.Request[searchv1.SuggestTagsForEventRequest]) (*connect.Response[searchv1.SuggestTagsForEventResponse], error) {
+	return c.suggestTagsForEvent.CallUnary(ctx, req)
+}
+
 // Reindex calls search.v1.SearchService.Reindex.
 func (c *searchServiceClient) Reindex(ctx context.Context, req *connect.Request[searchv1.ReindexRequest]) (*connect.Response[searchv1.ReindexResponse], error) {
 	return c.reindex.CallUnary(ctx, req)
@@ -113,6 +198,16 @@ type SearchServiceHandler interface {
 	GlobalSearch(context.Context, *connect.Request[searchv1.GlobalSearchRequest]) (*connect.Response[searchv1.GlobalSearchResponse], error)
 	// SearchEvents searches only events with optional filters
 	SearchEvents(context.Context, *connect.Request[searchv1.SearchEventsRequest]) (*connect.Response[searchv1.SearchEventsResponse], error)
+	// SearchOrganizations searches only organizations with type and status filters
+	SearchOrganizations(context.Context, *connect.Request[searchv1.SearchOrganizationsRequest]) (*connect.Response[searchv1.SearchOrganizationsResponse], error)
+	// SearchOrganizationTypes searches only organization types
+	SearchOrganizationTypes(context.Context, *connect.Request[searchv1.SearchOrganizationTypesRequest]) (*connect.Response[searchv1.SearchOrganizationTypesResponse], error)
+	// SearchMemberEvents searches events of the clubs the caller belongs to
+	SearchMemberEvents(context.Context, *connect.Request[searchv1.SearchMemberEventsRequest]) (*connect.Response[searchv1.SearchMemberEventsResponse], error)
+	// SearchUsers searches users with role and club filters (admin only)
+	SearchUsers(context.Context, *connect.Request[searchv1.SearchUsersRequest]) (*connect.Response[searchv1.SearchUsersResponse], error)
+	// SuggestTagsForEvent suggests tags used by events with similar titles
+	SuggestTagsForEvent(context.Context, *connect.Request[searchv1.SuggestTagsForEventRequest]) (*connect.Response[searchv1.SuggestTagsForEventResponse], error)
 	// Reindex triggers a full reindex of the search engine
 	Reindex(context.Context, *connect.Request[searchv1.ReindexRequest]) (*connect.Response[searchv1.ReindexResponse], error)
 }
@@ -136,6 +231,36 @@ func NewSearchServiceHandler(svc SearchServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(searchServiceMethods.ByName("SearchEvents")),
 		connect.WithHandlerOptions(opts...),
 	)
+	searchServiceSearchOrganizationsHandler := connect.NewUnaryHandler(
+		SearchServiceSearchOrganizationsProcedure,
+		svc.SearchOrganizations,
+		connect.WithSchema(searchServiceMethods.ByName("SearchOrganizations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	searchServiceSearchOrganizationTypesHandler := connect.NewUnaryHandler(
+		SearchServiceSearchOrganizationTypesProcedure,
+		svc.SearchOrganizationTypes,
+		connect.WithSchema(searchServiceMethods.ByName("SearchOrganizationTypes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	searchServiceSearchMemberEventsHandler := connect.NewUnaryHandler(
+		SearchServiceSearchMemberEventsProcedure,
+		svc.SearchMemberEvents,
+		connect.WithSchema(searchServiceMethods.ByName("SearchMemberEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	searchServiceSearchUsersHandler := connect.NewUnaryHandler(
+		SearchServiceSearchUsersProcedure,
+		svc.SearchUsers,
+		connect.WithSchema(searchServiceMethods.ByName("SearchUsers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	searchServiceSuggestTagsForEventHandler := connect.NewUnaryHandler(
+		SearchServiceSuggestTagsForEventProcedure,
+		svc.SuggestTagsForEvent,
+		connect.WithSchema(searchServiceMethods.ByName("SuggestTagsForEvent")),
+		connect.WithHandlerOptions(opts...),
+	)
 	searchServiceReindexHandler := connect.NewUnaryHandler(
 		SearchServiceReindexProcedure,
 		svc.Reindex,
@@ -148,6 +273,16 @@ func NewSearchServiceHandler(svc SearchServiceHandler, opts ...connect.HandlerOp
 			searchServiceGlobalSearchHandler.ServeHTTP(w, r)
 		case SearchServiceSearchEventsProcedure:
 			searchServiceSearchEventsHandler.ServeHTTP(w, r)
+		case SearchServiceSearchOrganizationsProcedure:
+			searchServiceSearchOrganizationsHandler.ServeHTTP(w, r)
+		case SearchServiceSearchOrganizationTypesProcedure:
+			searchServiceSearchOrganizationTypesHandler.ServeHTTP(w, r)
+		case SearchServiceSearchMemberEventsProcedure:
+			searchServiceSearchMemberEventsHandler.ServeHTTP(w, r)
+		case SearchServiceSearchUsersProcedure:
+			searchServiceSearchUsersHandler.ServeHTTP(w, r)
+		case SearchServiceSuggestTagsForEventProcedure:
+			searchServiceSuggestTagsForEventHandler.ServeHTTP(w, r)
 		case SearchServiceReindexProcedure:
 			searchServiceReindexHandler.ServeHTTP(w, r)
 		default:
@@ -167,6 +302,26 @@ func (UnimplementedSearchServiceHandler) SearchEvents(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("search.v1.SearchService.SearchEvents is not implemented"))
 }
 
+func (UnimplementedSearchServiceHandler) SearchOrganizations(context.Context, *connect.Request[searchv1.SearchOrganizationsRequest]) (*connect.Response[searchv1.SearchOrganizationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("search.v1.SearchService.SearchOrganizations is not implemented"))
+}
+
+func (UnimplementedSearchServiceHandler) SearchOrganizationTypes(context.Context, *connect.Request[searchv1.SearchOrganizationTypesRequest]) (*connect.Response[searchv1.SearchOrganizationTypesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("search.v1.SearchService.SearchOrganizationTypes is not implemented"))
+}
+
+func (UnimplementedSearchServiceHandler) SearchMemberEvents(context.Context, *connect.Request[searchv1.SearchMemberEventsRequest]) (*connect.Response[searchv1.SearchMemberEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("search.v1.SearchService.SearchMemberEvents is not implemented"))
+}
+
+func (UnimplementedSearchServiceHandler) SearchUsers(context.Context, *connect.Request[searchv1.SearchUsersRequest]) (*connect.Response[searchv1.SearchUsersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("search.v1.SearchService.SearchUsers is not implemented"))
+}
+
+func (UnimplementedSearchServiceHandler) SuggestTagsForEvent(context.Context, *connect.Request[searchv1.SuggestTagsForEventRequest]) (*connect.Response[searchv1.SuggestTagsForEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("search.v1.SearchService.SuggestTagsForEvent is not implemented"))
+}
+
 func (UnimplementedSearchServiceHandler) Reindex(context.Context, *connect.Request[searchv1.ReindexRequest]) (*connect.Response[searchv1.ReindexResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("search.v1.SearchService.Reindex is not implemented"))
 }
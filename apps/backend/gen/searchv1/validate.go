@@ -0,0 +1,41 @@
+// Hand-written, not generated: request checks run by validation.NewInterceptor.
+
+package searchv1
+
+import (
+	"time"
+
+	"github.com/studyverse/ems-backend/internal/validation"
+)
+
+func (x *SearchEventsRequest) Validate() error {
+	var errs validation.Errors
+
+	var start, end time.Time
+	var startErr, endErr error
+	if x.StartAfter != nil {
+		if start, startErr = time.Parse(time.RFC3339, x.GetStartAfter()); startErr != nil {
+			errs.Add("start_after", "must be an RFC 3339 timestamp")
+		}
+	}
+	if x.EndBefore != nil {
+		if end, endErr = time.Parse(time.RFC3339, x.GetEndBefore()); endErr != nil {
+			errs.Add("end_before", "must be an RFC 3339 timestamp")
+		}
+	}
+	if x.StartAfter != nil && x.EndBefore != nil && startErr == nil && endErr == nil && end.Before(start) {
+		errs.Add("end_before", "must not be before start_after")
+	}
+
+	return errs.Err()
+}
+
+func (x *SearchOrganizationsRequest) Validate() error {
+	var errs validation.Errors
+
+	if x.OrganizationTypeId != nil {
+		errs.PositiveID("organization_type_id", x.GetOrganizationTypeId())
+	}
+
+	return errs.Err()
+}
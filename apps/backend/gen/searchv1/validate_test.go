@@ -0,0 +1,50 @@
+package searchv1
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/studyverse/ems-backend/internal/validation"
+	"google.golang.org/protobuf/proto"
+)
+
+// violatedFields lists the fields err reports, nil when err is nil
+func violatedFields(t *testing.T, err error) []string {
+	t.Helper()
+	if err == nil {
+		return nil
+	}
+	errs, ok := err.(validation.Errors)
+	if !ok {
+		t.Fatalf("Validate() returned %T, want validation.Errors", err)
+	}
+	fields := make([]string, len(errs))
+	for i, v := range errs {
+		fields[i] = v.Field
+	}
+	return fields
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		req  validation.Validator
+		want []string
+	}{
+		{"search events", &SearchEventsRequest{Query: "chess"}, nil},
+		{"search events in a range", &SearchEventsRequest{StartAfter: proto.String("2026-10-01T00:00:00Z"), EndBefore: proto.String("2026-11-01T00:00:00Z")}, nil},
+		{"search events with a bad date", &SearchEventsRequest{StartAfter: proto.String("October")}, []string{"start_after"}},
+		{"search events in a reversed range", &SearchEventsRequest{StartAfter: proto.String("2026-11-01T00:00:00Z"), EndBefore: proto.String("2026-10-01T00:00:00Z")}, []string{"end_before"}},
+
+		{"search clubs", &SearchOrganizationsRequest{OrganizationTypeId: proto.Int32(1)}, nil},
+		{"search clubs by a bad type", &SearchOrganizationsRequest{OrganizationTypeId: proto.Int32(0)}, []string{"organization_type_id"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := violatedFields(t, tt.req.Validate()); !slices.Equal(got, tt.want) {
+				t.Errorf("Validate() violations = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
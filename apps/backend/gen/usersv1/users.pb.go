@@ -75,6 +75,65 @@ func (PlatformRole) EnumDescriptor() ([]byte, []int) {
 }
 
 // Messages
+// Kinds of notifications a user can opt out of
+type NotificationType int32
+
+const (
+	NotificationType_NOTIFICATION_TYPE_UNSPECIFIED               NotificationType = 0
+	NotificationType_NOTIFICATION_TYPE_REGISTRATION_CONFIRMATION NotificationType = 1
+	NotificationType_NOTIFICATION_TYPE_EVENT_REMINDER            NotificationType = 2
+	NotificationType_NOTIFICATION_TYPE_EVENT_CANCELLATION        NotificationType = 3
+	NotificationType_NOTIFICATION_TYPE_WAITLIST_PROMOTED         NotificationType = 4
+	NotificationType_NOTIFICATION_TYPE_PLATFORM_ANNOUNCEMENT     NotificationType = 5
+)
+
+// Enum value maps for NotificationType.
+var (
+	NotificationType_name = map[int32]string{
+		0: "NOTIFICATION_TYPE_UNSPECIFIED",
+		1: "NOTIFICATION_TYPE_REGISTRATION_CONFIRMATION",
+		2: "NOTIFICATION_TYPE_EVENT_REMINDER",
+		3: "NOTIFICATION_TYPE_EVENT_CANCELLATION",
+		4: "NOTIFICATION_TYPE_WAITLIST_PROMOTED",
+		5: "NOTIFICATION_TYPE_PLATFORM_ANNOUNCEMENT",
+	}
+	NotificationType_value = map[string]int32{
+		"NOTIFICATION_TYPE_UNSPECIFIED":               0,
+		"NOTIFICATION_TYPE_REGISTRATION_CONFIRMATION": 1,
+		"NOTIFICATION_TYPE_EVENT_REMINDER":            2,
+		"NOTIFICATION_TYPE_EVENT_CANCELLATION":        3,
+		"NOTIFICATION_TYPE_WAITLIST_PROMOTED":         4,
+		"NOTIFICATION_TYPE_PLATFORM_ANNOUNCEMENT":     5,
+	}
+)
+
+func (x NotificationType) Enum() *NotificationType {
+	p := new(NotificationType)
+	*p = x
+	return p
+}
+
+func (x NotificationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_usersv1_users_proto_enumTypes[1].Descriptor()
+}
+
+func (NotificationType) Type() protoreflect.EnumType {
+	return &file_usersv1_users_proto_enumTypes[1]
+}
+
+func (x NotificationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationType.Descriptor instead.
+func (NotificationType) EnumDescriptor() ([]byte, []int) {
+	return file_usersv1_users_proto_rawDescGZIP(), []int{1}
+}
+
 type User struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -941,29 +1000,27 @@ func (x *DeleteUserResponse) GetSuccess() bool {
 	return false
 }
 
-type UpdatePasswordRequest struct {
+// The authenticated user's profile and permissions in one call
+type WhoAmIRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	OldPassword   string                 `protobuf:"bytes,2,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
-	NewPassword   string                 `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePasswordRequest) Reset() {
-	*x = UpdatePasswordRequest{}
+func (x *WhoAmIRequest) Reset() {
+	*x = WhoAmIRequest{}
 	mi := &file_usersv1_users_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePasswordRequest) String() string {
+func (x *WhoAmIRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePasswordRequest) ProtoMessage() {}
+func (*WhoAmIRequest) ProtoMessage() {}
 
-func (x *UpdatePasswordRequest) ProtoReflect() protoreflect.Message {
+func (x *WhoAmIRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_usersv1_users_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -975,53 +1032,34 @@ func (x *UpdatePasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePasswordRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePasswordRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WhoAmIRequest.ProtoReflect.Descriptor instead.
+func (*WhoAmIRequest) Descriptor() ([]byte, []int) {
 	return file_usersv1_users_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *UpdatePasswordRequest) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *UpdatePasswordRequest) GetOldPassword() string {
-	if x != nil {
-		return x.OldPassword
-	}
-	return ""
-}
-
-func (x *UpdatePasswordRequest) GetNewPassword() string {
-	if x != nil {
-		return x.NewPassword
-	}
-	return ""
-}
-
-type UpdatePasswordResponse struct {
+type WhoAmIResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	PlatformRole  PlatformRole           `protobuf:"varint,2,opt,name=platform_role,json=platformRole,proto3,enum=users.v1.PlatformRole" json:"platform_role,omitempty"`
+	ManagedClubs  []int32                `protobuf:"varint,3,rep,packed,name=managed_clubs,json=managedClubs,proto3" json:"managed_clubs,omitempty"` // Clubs the user has manage_settings on
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePasswordResponse) Reset() {
-	*x = UpdatePasswordResponse{}
+func (x *WhoAmIResponse) Reset() {
+	*x = WhoAmIResponse{}
 	mi := &file_usersv1_users_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePasswordResponse) String() string {
+func (x *WhoAmIResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePasswordResponse) ProtoMessage() {}
+func (*WhoAmIResponse) ProtoMessage() {}
 
-func (x *UpdatePasswordResponse) ProtoReflect() protoreflect.Message {
+func (x *WhoAmIResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_usersv1_users_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1033,41 +1071,54 @@ func (x *UpdatePasswordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePasswordResponse.ProtoReflect.Descriptor instead.
-func (*UpdatePasswordResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WhoAmIResponse.ProtoReflect.Descriptor instead.
+func (*WhoAmIResponse) Descriptor() ([]byte, []int) {
 	return file_usersv1_users_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *UpdatePasswordResponse) GetSuccess() bool {
+func (x *WhoAmIResponse) GetUser() *User {
 	if x != nil {
-		return x.Success
+		return x.User
 	}
-	return false
+	return nil
 }
 
-// Assign/remove platform role for a user
-type AssignPlatformRoleRequest struct {
+func (x *WhoAmIResponse) GetPlatformRole() PlatformRole {
+	if x != nil {
+		return x.PlatformRole
+	}
+	return PlatformRole_PLATFORM_ROLE_UNSPECIFIED
+}
+
+func (x *WhoAmIResponse) GetManagedClubs() []int32 {
+	if x != nil {
+		return x.ManagedClubs
+	}
+	return nil
+}
+
+// Delete a user along with all of their SpiceDB relationships (platform admins)
+type DeleteUserAndCleanupRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Role          PlatformRole           `protobuf:"varint,2,opt,name=role,proto3,enum=users.v1.PlatformRole" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AssignPlatformRoleRequest) Reset() {
-	*x = AssignPlatformRoleRequest{}
+func (x *DeleteUserAndCleanupRequest) Reset() {
+	*x = DeleteUserAndCleanupRequest{}
 	mi := &file_usersv1_users_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AssignPlatformRoleRequest) String() string {
+func (x *DeleteUserAndCleanupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignPlatformRoleRequest) ProtoMessage() {}
+func (*DeleteUserAndCleanupRequest) ProtoMessage() {}
 
-func (x *AssignPlatformRoleRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteUserAndCleanupRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_usersv1_users_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1079,46 +1130,39 @@ func (x *AssignPlatformRoleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignPlatformRoleRequest.ProtoReflect.Descriptor instead.
-func (*AssignPlatformRoleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteUserAndCleanupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserAndCleanupRequest) Descriptor() ([]byte, []int) {
 	return file_usersv1_users_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *AssignPlatformRoleRequest) GetUserId() int32 {
+func (x *DeleteUserAndCleanupRequest) GetUserId() int32 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *AssignPlatformRoleRequest) GetRole() PlatformRole {
-	if x != nil {
-		return x.Role
-	}
-	return PlatformRole_PLATFORM_ROLE_UNSPECIFIED
-}
-
-type AssignPlatformRoleResponse struct {
+type DeleteUserAndCleanupResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AssignPlatformRoleResponse) Reset() {
-	*x = AssignPlatformRoleResponse{}
+func (x *DeleteUserAndCleanupResponse) Reset() {
+	*x = DeleteUserAndCleanupResponse{}
 	mi := &file_usersv1_users_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AssignPlatformRoleResponse) String() string {
+func (x *DeleteUserAndCleanupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignPlatformRoleResponse) ProtoMessage() {}
+func (*DeleteUserAndCleanupResponse) ProtoMessage() {}
 
-func (x *AssignPlatformRoleResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteUserAndCleanupResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_usersv1_users_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1130,41 +1174,41 @@ func (x *AssignPlatformRoleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignPlatformRoleResponse.ProtoReflect.Descriptor instead.
-func (*AssignPlatformRoleResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteUserAndCleanupResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserAndCleanupResponse) Descriptor() ([]byte, []int) {
 	return file_usersv1_users_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *AssignPlatformRoleResponse) GetUser() *User {
+func (x *DeleteUserAndCleanupResponse) GetSuccess() bool {
 	if x != nil {
-		return x.User
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-// Pre-register a user by email with a role (role applied on first sign-up)
-type PreRegisterUserRequest struct {
+type UpdatePasswordRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	PlatformRole  PlatformRole           `protobuf:"varint,2,opt,name=platform_role,json=platformRole,proto3,enum=users.v1.PlatformRole" json:"platform_role,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OldPassword   string                 `protobuf:"bytes,2,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PreRegisterUserRequest) Reset() {
-	*x = PreRegisterUserRequest{}
+func (x *UpdatePasswordRequest) Reset() {
+	*x = UpdatePasswordRequest{}
 	mi := &file_usersv1_users_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PreRegisterUserRequest) String() string {
+func (x *UpdatePasswordRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PreRegisterUserRequest) ProtoMessage() {}
+func (*UpdatePasswordRequest) ProtoMessage() {}
 
-func (x *PreRegisterUserRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdatePasswordRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_usersv1_users_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1176,46 +1220,53 @@ func (x *PreRegisterUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PreRegisterUserRequest.ProtoReflect.Descriptor instead.
-func (*PreRegisterUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdatePasswordRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePasswordRequest) Descriptor() ([]byte, []int) {
 	return file_usersv1_users_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *PreRegisterUserRequest) GetEmail() string {
+func (x *UpdatePasswordRequest) GetId() int32 {
 	if x != nil {
-		return x.Email
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdatePasswordRequest) GetOldPassword() string {
+	if x != nil {
+		return x.OldPassword
 	}
 	return ""
 }
 
-func (x *PreRegisterUserRequest) GetPlatformRole() PlatformRole {
+func (x *UpdatePasswordRequest) GetNewPassword() string {
 	if x != nil {
-		return x.PlatformRole
+		return x.NewPassword
 	}
-	return PlatformRole_PLATFORM_ROLE_UNSPECIFIED
+	return ""
 }
 
-type PreRegisterUserResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	PreRegisteredUser *PreRegisteredUser     `protobuf:"bytes,1,opt,name=pre_registered_user,json=preRegisteredUser,proto3" json:"pre_registered_user,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type UpdatePasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PreRegisterUserResponse) Reset() {
-	*x = PreRegisterUserResponse{}
+func (x *UpdatePasswordResponse) Reset() {
+	*x = UpdatePasswordResponse{}
 	mi := &file_usersv1_users_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PreRegisterUserResponse) String() string {
+func (x *UpdatePasswordResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PreRegisterUserResponse) ProtoMessage() {}
+func (*UpdatePasswordResponse) ProtoMessage() {}
 
-func (x *PreRegisterUserResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdatePasswordResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_usersv1_users_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1227,42 +1278,41 @@ func (x *PreRegisterUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PreRegisterUserResponse.ProtoReflect.Descriptor instead.
-func (*PreRegisterUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdatePasswordResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePasswordResponse) Descriptor() ([]byte, []int) {
 	return file_usersv1_users_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *PreRegisterUserResponse) GetPreRegisteredUser() *PreRegisteredUser {
+func (x *UpdatePasswordResponse) GetSuccess() bool {
 	if x != nil {
-		return x.PreRegisteredUser
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-// List pre-registered users
-type ListPreRegisteredUsersRequest struct {
+// Assign/remove platform role for a user
+type AssignPlatformRoleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	IncludeUsed   bool                   `protobuf:"varint,3,opt,name=include_used,json=includeUsed,proto3" json:"include_used,omitempty"` // Include already-consumed entries
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          PlatformRole           `protobuf:"varint,2,opt,name=role,proto3,enum=users.v1.PlatformRole" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPreRegisteredUsersRequest) Reset() {
-	*x = ListPreRegisteredUsersRequest{}
+func (x *AssignPlatformRoleRequest) Reset() {
+	*x = AssignPlatformRoleRequest{}
 	mi := &file_usersv1_users_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPreRegisteredUsersRequest) String() string {
+func (x *AssignPlatformRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPreRegisteredUsersRequest) ProtoMessage() {}
+func (*AssignPlatformRoleRequest) ProtoMessage() {}
 
-func (x *ListPreRegisteredUsersRequest) ProtoReflect() protoreflect.Message {
+func (x *AssignPlatformRoleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_usersv1_users_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1274,54 +1324,46 @@ func (x *ListPreRegisteredUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPreRegisteredUsersRequest.ProtoReflect.Descriptor instead.
-func (*ListPreRegisteredUsersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AssignPlatformRoleRequest.ProtoReflect.Descriptor instead.
+func (*AssignPlatformRoleRequest) Descriptor() ([]byte, []int) {
 	return file_usersv1_users_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ListPreRegisteredUsersRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *ListPreRegisteredUsersRequest) GetLimit() int32 {
+func (x *AssignPlatformRoleRequest) GetUserId() int32 {
 	if x != nil {
-		return x.Limit
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *ListPreRegisteredUsersRequest) GetIncludeUsed() bool {
+func (x *AssignPlatformRoleRequest) GetRole() PlatformRole {
 	if x != nil {
-		return x.IncludeUsed
+		return x.Role
 	}
-	return false
+	return PlatformRole_PLATFORM_ROLE_UNSPECIFIED
 }
 
-type ListPreRegisteredUsersResponse struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	PreRegisteredUsers []*PreRegisteredUser   `protobuf:"bytes,1,rep,name=pre_registered_users,json=preRegisteredUsers,proto3" json:"pre_registered_users,omitempty"`
-	Total              int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type AssignPlatformRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPreRegisteredUsersResponse) Reset() {
-	*x = ListPreRegisteredUsersResponse{}
+func (x *AssignPlatformRoleResponse) Reset() {
+	*x = AssignPlatformRoleResponse{}
 	mi := &file_usersv1_users_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPreRegisteredUsersResponse) String() string {
+func (x *AssignPlatformRoleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPreRegisteredUsersResponse) ProtoMessage() {}
+func (*AssignPlatformRoleResponse) ProtoMessage() {}
 
-func (x *ListPreRegisteredUsersResponse) ProtoReflect() protoreflect.Message {
+func (x *AssignPlatformRoleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_usersv1_users_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1333,19 +1375,773 @@ func (x *ListPreRegisteredUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPreRegisteredUsersResponse.ProtoReflect.Descriptor instead.
-func (*ListPreRegisteredUsersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AssignPlatformRoleResponse.ProtoReflect.Descriptor instead.
+func (*AssignPlatformRoleResponse) Descriptor() ([]byte, []int) {
 	return file_usersv1_users_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ListPreRegisteredUsersResponse) GetPreRegisteredUsers() []*PreRegisteredUser {
+func (x *AssignPlatformRoleResponse) GetUser() *User {
 	if x != nil {
-		return x.PreRegisteredUsers
+		return x.User
 	}
 	return nil
 }
 
-func (x *ListPreRegisteredUsersResponse) GetTotal() int32 {
+// List users holding a platform role
+type GetPlatformRoleMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          PlatformRole           `protobuf:"varint,1,opt,name=role,proto3,enum=users.v1.PlatformRole" json:"role,omitempty"` // STAFF or ADMIN
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlatformRoleMembersRequest) Reset() {
+	*x = GetPlatformRoleMembersRequest{}
+	mi := &file_usersv1_users_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlatformRoleMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlatformRoleMembersRequest) ProtoMessage() {}
+
+func (x *GetPlatformRoleMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_usersv1_users_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlatformRoleMembersRequest.ProtoReflect.Descriptor instead.
+func (*GetPlatformRoleMembersRequest) Descriptor() ([]byte, []int) {
+	return file_usersv1_users_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetPlatformRoleMembersRequest) GetRole() PlatformRole {
+	if x != nil {
+		return x.Role
+	}
+	return PlatformRole_PLATFORM_ROLE_UNSPECIFIED
+}
+
+type GetPlatformRoleMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlatformRoleMembersResponse) Reset() {
+	*x = GetPlatformRoleMembersResponse{}
+	mi := &file_usersv1_users_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlatformRoleMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlatformRoleMembersResponse) ProtoMessage() {}
+
+func (x *GetPlatformRoleMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_usersv1_users_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlatformRoleMembersResponse.ProtoReflect.Descriptor instead.
+func (*GetPlatformRoleMembersResponse) Descriptor() ([]byte, []int) {
+	return file_usersv1_users_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetPlatformRoleMembersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// Fetch a user's identity from the authentication provider
+type GetKratosIdentityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKratosIdentityRequest) Reset() {
+	*x = GetKratosIdentityRequest{}
+	mi := &file_usersv1_users_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKratosIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKratosIdentityRequest) ProtoMessage() {}
+
+func (x *GetKratosIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_usersv1_users_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKratosIdentityRequest.ProtoReflect.Descriptor instead.
+func (*GetKratosIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_usersv1_users_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetKratosIdentityRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetKratosIdentityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	IdentityId    string                 `protobuf:"bytes,2,opt,name=identity_id,json=identityId,proto3" json:"identity_id,omitempty"`
+	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`   // active or inactive
+	Traits        string                 `protobuf:"bytes,4,opt,name=traits,proto3" json:"traits,omitempty"` // Identity traits as a JSON object
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKratosIdentityResponse) Reset() {
+	*x = GetKratosIdentityResponse{}
+	mi := &file_usersv1_users_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKratosIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKratosIdentityResponse) ProtoMessage() {}
+
+func (x *GetKratosIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_usersv1_users_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKratosIdentityResponse.ProtoReflect.Descriptor instead.
+func (*GetKratosIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_usersv1_users_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetKratosIdentityResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GetKratosIdentityResponse) GetIdentityId() string {
+	if x != nil {
+		return x.IdentityId
+	}
+	return ""
+}
+
+func (x *GetKratosIdentityResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *GetKratosIdentityResponse) GetTraits() string {
+	if x != nil {
+		return x.Traits
+	}
+	return ""
+}
+
+// A login session held by the authentication provider
+type UserSession struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Active          bool                   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt       *string                `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	AuthenticatedAt *string                `protobuf:"bytes,5,opt,name=authenticated_at,json=authenticatedAt,proto3,oneof" json:"authenticated_at,omitempty"`
+	Device          *string                `protobuf:"bytes,6,opt,name=device,proto3,oneof" json:"device,omitempty"` // User agent of the most recent device
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UserSession) Reset() {
+	*x = UserSession{}
+	mi := &file_usersv1_users_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSession) ProtoMessage() {}
+
+func (x *UserSession) ProtoReflect() protoreflect.Message {
+	mi := &file_usersv1_users_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSession.ProtoReflect.Descriptor instead.
+func (*UserSession) Descriptor() ([]byte, []int) {
+	return file_usersv1_users_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UserSession) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UserSession) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *UserSession) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *UserSession) GetExpiresAt() string {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *UserSession) GetAuthenticatedAt() string {
+	if x != nil && x.AuthenticatedAt != nil {
+		return *x.AuthenticatedAt
+	}
+	return ""
+}
+
+func (x *UserSession) GetDevice() string {
+	if x != nil && x.Device != nil {
+		return *x.Device
+	}
+	return ""
+}
+
+type ListUserSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserSessionsRequest) Reset() {
+	*x = ListUserSessionsRequest{}
+	mi := &file_usersv1_users_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserSessionsRequest) ProtoMessage() {}
+
+func (x *ListUserSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_usersv1_users_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_usersv1_users_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListUserSessionsRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListUserSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*UserSession         `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserSessionsResponse) Reset() {
+	*x = ListUserSessionsResponse{}
+	mi := &file_usersv1_users_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserSessionsResponse) ProtoMessage() {}
+
+func (x *ListUserSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_usersv1_users_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_usersv1_users_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListUserSessionsResponse) GetSessions() []*UserSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RevokeUserSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeUserSessionRequest) Reset() {
+	*x = RevokeUserSessionRequest{}
+	mi := &file_usersv1_users_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeUserSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeUserSessionRequest) ProtoMessage() {}
+
+func (x *RevokeUserSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_usersv1_users_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeUserSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeUserSessionRequest) Descriptor() ([]byte, []int) {
+	return file_usersv1_users_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RevokeUserSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type RevokeUserSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeUserSessionResponse) Reset() {
+	*x = RevokeUserSessionResponse{}
+	mi := &file_usersv1_users_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeUserSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeUserSessionResponse) ProtoMessage() {}
+
+func (x *RevokeUserSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_usersv1_users_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeUserSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeUserSessionResponse) Descriptor() ([]byte, []int) {
+	return file_usersv1_users_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RevokeUserSessionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RevokeAllUserSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAllUserSessionsRequest) Reset() {
+	*x = RevokeAllUserSessionsRequest{}
+	mi := &file_usersv1_users_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAllUserSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAllUserSessionsRequest) ProtoMessage() {}
+
+func (x *RevokeAllUserSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_usersv1_users_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAllUserSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAllUserSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_usersv1_users_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RevokeAllUserSessionsRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type RevokeAllUserSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RevokedCount  int32                  `protobuf:"varint,1,opt,name=revoked_count,json=revokedCount,proto3" json:"revoked_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAllUserSessionsResponse) Reset() {
+	*x = RevokeAllUserSessionsResponse{}
+	mi := &file_usersv1_users_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAllUserSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAllUserSessionsResponse) ProtoMessage() {}
+
+func (x *RevokeAllUserSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_usersv1_users_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAllUserSessionsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAllUserSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_usersv1_users_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RevokeAllUserSessionsResponse) GetRevokedCount() int32 {
+	if x != nil {
+		return x.RevokedCount
+	}
+	return 0
+}
+
+// Pre-register a user by email with a role (role applied on first sign-up)
+type PreRegisterUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	PlatformRole  PlatformRole           `protobuf:"varint,2,opt,name=platform_role,json=platformRole,proto3,enum=users.v1.PlatformRole" json:"platform_role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreRegisterUserRequest) Reset() {
+	*x = PreRegisterUserRequest{}
+	mi := &file_usersv1_users_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreRegisterUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreRegisterUserRequest) ProtoMessage() {}
+
+func (x *PreRegisterUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_usersv1_users_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreRegisterUserRequest.ProtoReflect.Descriptor instead.
+func (*PreRegisterUserRequest) Descriptor() ([]byte, []int) {
+	return file_usersv1_users_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PreRegisterUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *PreRegisterUserRequest) GetPlatformRole() PlatformRole {
+	if x != nil {
+		return x.PlatformRole
+	}
+	return PlatformRole_PLATFORM_ROLE_UNSPECIFIED
+}
+
+type PreRegisterUserResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PreRegisteredUser *PreRegisteredUser     `protobuf:"bytes,1,opt,name=pre_registered_user,json=preRegisteredUser,proto3" json:"pre_registered_user,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PreRegisterUserResponse) Reset() {
+	*x = PreRegisterUserResponse{}
+	mi := &file_usersv1_users_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreRegisterUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreRegisterUserResponse) ProtoMessage() {}
+
+func (x *PreRegisterUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_usersv1_users_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreRegisterUserResponse.ProtoReflect.Descriptor instead.
+func (*PreRegisterUserResponse) Descriptor() ([]byte, []int) {
+	return file_usersv1_users_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PreRegisterUserResponse) GetPreRegisteredUser() *PreRegisteredUser {
+	if x != nil {
+		return x.PreRegisteredUser
+	}
+	return nil
+}
+
+// List pre-registered users
+type ListPreRegisteredUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	IncludeUsed   bool                   `protobuf:"varint,3,opt,name=include_used,json=includeUsed,proto3" json:"include_used,omitempty"` // Include already-consumed entries
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPreRegisteredUsersRequest) Reset() {
+	*x = ListPreRegisteredUsersRequest{}
+	mi := &file_usersv1_users_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPreRegisteredUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPreRegisteredUsersRequest) ProtoMessage() {}
+
+func (x *ListPreRegisteredUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_usersv1_users_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPreRegisteredUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListPreRegisteredUsersRequest) Descriptor() ([]byte, []int) {
+	return file_usersv1_users_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListPreRegisteredUsersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPreRegisteredUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPreRegisteredUsersRequest) GetIncludeUsed() bool {
+	if x != nil {
+		return x.IncludeUsed
+	}
+	return false
+}
+
+type ListPreRegisteredUsersResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PreRegisteredUsers []*PreRegisteredUser   `protobuf:"bytes,1,rep,name=pre_registered_users,json=preRegisteredUsers,proto3" json:"pre_registered_users,omitempty"`
+	Total              int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListPreRegisteredUsersResponse) Reset() {
+	*x = ListPreRegisteredUsersResponse{}
+	mi := &file_usersv1_users_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPreRegisteredUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPreRegisteredUsersResponse) ProtoMessage() {}
+
+func (x *ListPreRegisteredUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_usersv1_users_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPreRegisteredUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListPreRegisteredUsersResponse) Descriptor() ([]byte, []int) {
+	return file_usersv1_users_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListPreRegisteredUsersResponse) GetPreRegisteredUsers() []*PreRegisteredUser {
+	if x != nil {
+		return x.PreRegisteredUsers
+	}
+	return nil
+}
+
+func (x *ListPreRegisteredUsersResponse) GetTotal() int32 {
 	if x != nil {
 		return x.Total
 	}
@@ -1362,7 +2158,7 @@ type DeletePreRegisteredUserRequest struct {
 
 func (x *DeletePreRegisteredUserRequest) Reset() {
 	*x = DeletePreRegisteredUserRequest{}
-	mi := &file_usersv1_users_proto_msgTypes[24]
+	mi := &file_usersv1_users_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1374,7 +2170,7 @@ func (x *DeletePreRegisteredUserRequest) String() string {
 func (*DeletePreRegisteredUserRequest) ProtoMessage() {}
 
 func (x *DeletePreRegisteredUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_usersv1_users_proto_msgTypes[24]
+	mi := &file_usersv1_users_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1387,7 +2183,7 @@ func (x *DeletePreRegisteredUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePreRegisteredUserRequest.ProtoReflect.Descriptor instead.
 func (*DeletePreRegisteredUserRequest) Descriptor() ([]byte, []int) {
-	return file_usersv1_users_proto_rawDescGZIP(), []int{24}
+	return file_usersv1_users_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *DeletePreRegisteredUserRequest) GetId() int32 {
@@ -1406,7 +2202,7 @@ type DeletePreRegisteredUserResponse struct {
 
 func (x *DeletePreRegisteredUserResponse) Reset() {
 	*x = DeletePreRegisteredUserResponse{}
-	mi := &file_usersv1_users_proto_msgTypes[25]
+	mi := &file_usersv1_users_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1418,7 +2214,7 @@ func (x *DeletePreRegisteredUserResponse) String() string {
 func (*DeletePreRegisteredUserResponse) ProtoMessage() {}
 
 func (x *DeletePreRegisteredUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_usersv1_users_proto_msgTypes[25]
+	mi := &file_usersv1_users_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1431,7 +2227,7 @@ func (x *DeletePreRegisteredUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePreRegisteredUserResponse.ProtoReflect.Descriptor instead.
 func (*DeletePreRegisteredUserResponse) Descriptor() ([]byte, []int) {
-	return file_usersv1_users_proto_rawDescGZIP(), []int{25}
+	return file_usersv1_users_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *DeletePreRegisteredUserResponse) GetSuccess() bool {
@@ -1441,6 +2237,251 @@ func (x *DeletePreRegisteredUserResponse) GetSuccess() bool {
 	return false
 }
 
+// Delivery channels enabled for one notification type
+type NotificationPreference struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	NotificationType NotificationType       `protobuf:"varint,1,opt,name=notification_type,json=notificationType,proto3,enum=users.v1.NotificationType" json:"notification_type,omitempty"`
+	EmailEnabled     bool                   `protobuf:"varint,2,opt,name=email_enabled,json=emailEnabled,proto3" json:"email_enabled,omitempty"`
+	PushEnabled      bool                   `protobuf:"varint,3,opt,name=push_enabled,json=pushEnabled,proto3" json:"push_enabled,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *NotificationPreference) Reset() {
+	*x = NotificationPreference{}
+	mi := &file_usersv1_users_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreference) ProtoMessage() {}
+
+func (x *NotificationPreference) ProtoReflect() protoreflect.Message {
+	mi := &file_usersv1_users_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreference.ProtoReflect.Descriptor instead.
+func (*NotificationPreference) Descriptor() ([]byte, []int) {
+	return file_usersv1_users_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *NotificationPreference) GetNotificationType() NotificationType {
+	if x != nil {
+		return x.NotificationType
+	}
+	return NotificationType_NOTIFICATION_TYPE_UNSPECIFIED
+}
+
+func (x *NotificationPreference) GetEmailEnabled() bool {
+	if x != nil {
+		return x.EmailEnabled
+	}
+	return false
+}
+
+func (x *NotificationPreference) GetPushEnabled() bool {
+	if x != nil {
+		return x.PushEnabled
+	}
+	return false
+}
+
+type GetNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesRequest) Reset() {
+	*x = GetNotificationPreferencesRequest{}
+	mi := &file_usersv1_users_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_usersv1_users_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_usersv1_users_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetNotificationPreferencesRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetNotificationPreferencesResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Preferences   []*NotificationPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"` // One per type, enabled unless changed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesResponse) Reset() {
+	*x = GetNotificationPreferencesResponse{}
+	mi := &file_usersv1_users_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_usersv1_users_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_usersv1_users_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetNotificationPreferencesResponse) GetPreferences() []*NotificationPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type UpdateNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	UserId        int32                     `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Preferences   []*NotificationPreference `protobuf:"bytes,2,rep,name=preferences,proto3" json:"preferences,omitempty"` // Types not listed are left unchanged
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferencesRequest) Reset() {
+	*x = UpdateNotificationPreferencesRequest{}
+	mi := &file_usersv1_users_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_usersv1_users_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_usersv1_users_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetPreferences() []*NotificationPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type UpdateNotificationPreferencesResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Preferences   []*NotificationPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferencesResponse) Reset() {
+	*x = UpdateNotificationPreferencesResponse{}
+	mi := &file_usersv1_users_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_usersv1_users_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_usersv1_users_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UpdateNotificationPreferencesResponse) GetPreferences() []*NotificationPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
 var File_usersv1_users_proto protoreflect.FileDescriptor
 
 const file_usersv1_users_proto_rawDesc = "" +
@@ -1512,6 +2553,15 @@ const file_usersv1_users_proto_rawDesc = "" +
 	"\x11DeleteUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\".\n" +
 	"\x12DeleteUserResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x0f\n" +
+	"\rWhoAmIRequest\"\x96\x01\n" +
+	"\x0eWhoAmIResponse\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.users.v1.UserR\x04user\x12;\n" +
+	"\rplatform_role\x18\x02 \x01(\x0e2\x16.users.v1.PlatformRoleR\fplatformRole\x12#\n" +
+	"\rmanaged_clubs\x18\x03 \x03(\x05R\fmanagedClubs\"6\n" +
+	"\x1bDeleteUserAndCleanupRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"8\n" +
+	"\x1cDeleteUserAndCleanupResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"m\n" +
 	"\x15UpdatePasswordRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12!\n" +
@@ -1523,7 +2573,44 @@ const file_usersv1_users_proto_rawDesc = "" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12*\n" +
 	"\x04role\x18\x02 \x01(\x0e2\x16.users.v1.PlatformRoleR\x04role\"@\n" +
 	"\x1aAssignPlatformRoleResponse\x12\"\n" +
-	"\x04user\x18\x01 \x01(\v2\x0e.users.v1.UserR\x04user\"k\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.users.v1.UserR\x04user\"K\n" +
+	"\x1dGetPlatformRoleMembersRequest\x12*\n" +
+	"\x04role\x18\x01 \x01(\x0e2\x16.users.v1.PlatformRoleR\x04role\"F\n" +
+	"\x1eGetPlatformRoleMembersResponse\x12$\n" +
+	"\x05users\x18\x01 \x03(\v2\x0e.users.v1.UserR\x05users\"3\n" +
+	"\x18GetKratosIdentityRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"\x8e\x01\n" +
+	"\x19GetKratosIdentityResponse\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.users.v1.UserR\x04user\x12\x1f\n" +
+	"\videntity_id\x18\x02 \x01(\tR\n" +
+	"identityId\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12\x16\n" +
+	"\x06traits\x18\x04 \x01(\tR\x06traits\"\xf4\x01\n" +
+	"\vUserSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06active\x18\x02 \x01(\bR\x06active\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\x12\"\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\tH\x00R\texpiresAt\x88\x01\x01\x12.\n" +
+	"\x10authenticated_at\x18\x05 \x01(\tH\x01R\x0fauthenticatedAt\x88\x01\x01\x12\x1b\n" +
+	"\x06device\x18\x06 \x01(\tH\x02R\x06device\x88\x01\x01B\r\n" +
+	"\v_expires_atB\x13\n" +
+	"\x11_authenticated_atB\t\n" +
+	"\a_device\"2\n" +
+	"\x17ListUserSessionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"M\n" +
+	"\x18ListUserSessionsResponse\x121\n" +
+	"\bsessions\x18\x01 \x03(\v2\x15.users.v1.UserSessionR\bsessions\"9\n" +
+	"\x18RevokeUserSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"5\n" +
+	"\x19RevokeUserSessionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"7\n" +
+	"\x1cRevokeAllUserSessionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"D\n" +
+	"\x1dRevokeAllUserSessionsResponse\x12#\n" +
+	"\rrevoked_count\x18\x01 \x01(\x05R\frevokedCount\"k\n" +
 	"\x16PreRegisterUserRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12;\n" +
 	"\rplatform_role\x18\x02 \x01(\x0e2\x16.users.v1.PlatformRoleR\fplatformRole\"f\n" +
@@ -1539,28 +2626,57 @@ const file_usersv1_users_proto_rawDesc = "" +
 	"\x1eDeletePreRegisteredUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\";\n" +
 	"\x1fDeletePreRegisteredUserResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess*w\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa9\x01\n" +
+	"\x16NotificationPreference\x12G\n" +
+	"\x11notification_type\x18\x01 \x01(\x0e2\x1a.users.v1.NotificationTypeR\x10notificationType\x12#\n" +
+	"\remail_enabled\x18\x02 \x01(\bR\femailEnabled\x12!\n" +
+	"\fpush_enabled\x18\x03 \x01(\bR\vpushEnabled\"<\n" +
+	"!GetNotificationPreferencesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"h\n" +
+	"\"GetNotificationPreferencesResponse\x12B\n" +
+	"\vpreferences\x18\x01 \x03(\v2 .users.v1.NotificationPreferenceR\vpreferences\"\x83\x01\n" +
+	"$UpdateNotificationPreferencesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12B\n" +
+	"\vpreferences\x18\x02 \x03(\v2 .users.v1.NotificationPreferenceR\vpreferences\"k\n" +
+	"%UpdateNotificationPreferencesResponse\x12B\n" +
+	"\vpreferences\x18\x01 \x03(\v2 .users.v1.NotificationPreferenceR\vpreferences*w\n" +
 	"\fPlatformRole\x12\x1d\n" +
 	"\x19PLATFORM_ROLE_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12PLATFORM_ROLE_USER\x10\x01\x12\x17\n" +
 	"\x13PLATFORM_ROLE_STAFF\x10\x02\x12\x17\n" +
-	"\x13PLATFORM_ROLE_ADMIN\x10\x032\x8d\b\n" +
+	"\x13PLATFORM_ROLE_ADMIN\x10\x03*\x8c\x02\n" +
+	"\x10NotificationType\x12!\n" +
+	"\x1dNOTIFICATION_TYPE_UNSPECIFIED\x10\x00\x12/\n" +
+	"+NOTIFICATION_TYPE_REGISTRATION_CONFIRMATION\x10\x01\x12$\n" +
+	" NOTIFICATION_TYPE_EVENT_REMINDER\x10\x02\x12(\n" +
+	"$NOTIFICATION_TYPE_EVENT_CANCELLATION\x10\x03\x12'\n" +
+	"#NOTIFICATION_TYPE_WAITLIST_PROMOTED\x10\x04\x12+\n" +
+	"'NOTIFICATION_TYPE_PLATFORM_ANNOUNCEMENT\x10\x052\x9b\x0f\n" +
 	"\fUsersService\x12G\n" +
 	"\n" +
 	"CreateUser\x12\x1b.users.v1.CreateUserRequest\x1a\x1c.users.v1.CreateUserResponse\x12>\n" +
-	"\aGetUser\x12\x18.users.v1.GetUserRequest\x1a\x19.users.v1.GetUserResponse\x12S\n" +
+	"\aGetUser\x12\x18.users.v1.GetUserRequest\x1a\x19.users.v1.GetUserResponse\x12;\n" +
+	"\x06WhoAmI\x12\x17.users.v1.WhoAmIRequest\x1a\x18.users.v1.WhoAmIResponse\x12S\n" +
 	"\x0eGetUserByEmail\x12\x1f.users.v1.GetUserByEmailRequest\x1a .users.v1.GetUserByEmailResponse\x12\\\n" +
 	"\x11GetUserByUsername\x12\".users.v1.GetUserByUsernameRequest\x1a#.users.v1.GetUserByUsernameResponse\x12D\n" +
 	"\tListUsers\x12\x1a.users.v1.ListUsersRequest\x1a\x1b.users.v1.ListUsersResponse\x12G\n" +
 	"\n" +
 	"UpdateUser\x12\x1b.users.v1.UpdateUserRequest\x1a\x1c.users.v1.UpdateUserResponse\x12G\n" +
 	"\n" +
-	"DeleteUser\x12\x1b.users.v1.DeleteUserRequest\x1a\x1c.users.v1.DeleteUserResponse\x12S\n" +
+	"DeleteUser\x12\x1b.users.v1.DeleteUserRequest\x1a\x1c.users.v1.DeleteUserResponse\x12e\n" +
+	"\x14DeleteUserAndCleanup\x12%.users.v1.DeleteUserAndCleanupRequest\x1a&.users.v1.DeleteUserAndCleanupResponse\x12S\n" +
 	"\x0eUpdatePassword\x12\x1f.users.v1.UpdatePasswordRequest\x1a .users.v1.UpdatePasswordResponse\x12_\n" +
-	"\x12AssignPlatformRole\x12#.users.v1.AssignPlatformRoleRequest\x1a$.users.v1.AssignPlatformRoleResponse\x12V\n" +
+	"\x12AssignPlatformRole\x12#.users.v1.AssignPlatformRoleRequest\x1a$.users.v1.AssignPlatformRoleResponse\x12k\n" +
+	"\x16GetPlatformRoleMembers\x12'.users.v1.GetPlatformRoleMembersRequest\x1a(.users.v1.GetPlatformRoleMembersResponse\x12\\\n" +
+	"\x11GetKratosIdentity\x12\".users.v1.GetKratosIdentityRequest\x1a#.users.v1.GetKratosIdentityResponse\x12Y\n" +
+	"\x10ListUserSessions\x12!.users.v1.ListUserSessionsRequest\x1a\".users.v1.ListUserSessionsResponse\x12\\\n" +
+	"\x11RevokeUserSession\x12\".users.v1.RevokeUserSessionRequest\x1a#.users.v1.RevokeUserSessionResponse\x12h\n" +
+	"\x15RevokeAllUserSessions\x12&.users.v1.RevokeAllUserSessionsRequest\x1a'.users.v1.RevokeAllUserSessionsResponse\x12V\n" +
 	"\x0fPreRegisterUser\x12 .users.v1.PreRegisterUserRequest\x1a!.users.v1.PreRegisterUserResponse\x12k\n" +
 	"\x16ListPreRegisteredUsers\x12'.users.v1.ListPreRegisteredUsersRequest\x1a(.users.v1.ListPreRegisteredUsersResponse\x12n\n" +
-	"\x17DeletePreRegisteredUser\x12(.users.v1.DeletePreRegisteredUserRequest\x1a).users.v1.DeletePreRegisteredUserResponseB\x92\x01\n" +
+	"\x17DeletePreRegisteredUser\x12(.users.v1.DeletePreRegisteredUserRequest\x1a).users.v1.DeletePreRegisteredUserResponse\x12w\n" +
+	"\x1aGetNotificationPreferences\x12+.users.v1.GetNotificationPreferencesRequest\x1a,.users.v1.GetNotificationPreferencesResponse\x12\x80\x01\n" +
+	"\x1dUpdateNotificationPreferences\x12..users.v1.UpdateNotificationPreferencesRequest\x1a/.users.v1.UpdateNotificationPreferencesResponseB\x92\x01\n" +
 	"\fcom.users.v1B\n" +
 	"UsersProtoP\x01Z5github.com/studyverse/ems-backend/gen/usersv1;usersv1\xa2\x02\x03UXX\xaa\x02\bUsers.V1\xca\x02\bUsers\\V1\xe2\x02\x14Users\\V1\\GPBMetadata\xea\x02\tUsers::V1b\x06proto3"
 
@@ -1576,80 +2692,129 @@ func file_usersv1_users_proto_rawDescGZIP() []byte {
 	return file_usersv1_users_proto_rawDescData
 }
 
-var file_usersv1_users_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_usersv1_users_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_usersv1_users_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_usersv1_users_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_usersv1_users_proto_goTypes = []any{
-	(PlatformRole)(0),                       // 0: users.v1.PlatformRole
-	(*User)(nil),                            // 1: users.v1.User
-	(*PreRegisteredUser)(nil),               // 2: users.v1.PreRegisteredUser
-	(*CreateUserRequest)(nil),               // 3: users.v1.CreateUserRequest
-	(*CreateUserResponse)(nil),              // 4: users.v1.CreateUserResponse
-	(*GetUserRequest)(nil),                  // 5: users.v1.GetUserRequest
-	(*GetUserResponse)(nil),                 // 6: users.v1.GetUserResponse
-	(*GetUserByEmailRequest)(nil),           // 7: users.v1.GetUserByEmailRequest
-	(*GetUserByEmailResponse)(nil),          // 8: users.v1.GetUserByEmailResponse
-	(*GetUserByUsernameRequest)(nil),        // 9: users.v1.GetUserByUsernameRequest
-	(*GetUserByUsernameResponse)(nil),       // 10: users.v1.GetUserByUsernameResponse
-	(*ListUsersRequest)(nil),                // 11: users.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),               // 12: users.v1.ListUsersResponse
-	(*UpdateUserRequest)(nil),               // 13: users.v1.UpdateUserRequest
-	(*UpdateUserResponse)(nil),              // 14: users.v1.UpdateUserResponse
-	(*DeleteUserRequest)(nil),               // 15: users.v1.DeleteUserRequest
-	(*DeleteUserResponse)(nil),              // 16: users.v1.DeleteUserResponse
-	(*UpdatePasswordRequest)(nil),           // 17: users.v1.UpdatePasswordRequest
-	(*UpdatePasswordResponse)(nil),          // 18: users.v1.UpdatePasswordResponse
-	(*AssignPlatformRoleRequest)(nil),       // 19: users.v1.AssignPlatformRoleRequest
-	(*AssignPlatformRoleResponse)(nil),      // 20: users.v1.AssignPlatformRoleResponse
-	(*PreRegisterUserRequest)(nil),          // 21: users.v1.PreRegisterUserRequest
-	(*PreRegisterUserResponse)(nil),         // 22: users.v1.PreRegisterUserResponse
-	(*ListPreRegisteredUsersRequest)(nil),   // 23: users.v1.ListPreRegisteredUsersRequest
-	(*ListPreRegisteredUsersResponse)(nil),  // 24: users.v1.ListPreRegisteredUsersResponse
-	(*DeletePreRegisteredUserRequest)(nil),  // 25: users.v1.DeletePreRegisteredUserRequest
-	(*DeletePreRegisteredUserResponse)(nil), // 26: users.v1.DeletePreRegisteredUserResponse
+	(PlatformRole)(0),                             // 0: users.v1.PlatformRole
+	(NotificationType)(0),                         // 1: users.v1.NotificationType
+	(*User)(nil),                                  // 2: users.v1.User
+	(*PreRegisteredUser)(nil),                     // 3: users.v1.PreRegisteredUser
+	(*CreateUserRequest)(nil),                     // 4: users.v1.CreateUserRequest
+	(*CreateUserResponse)(nil),                    // 5: users.v1.CreateUserResponse
+	(*GetUserRequest)(nil),                        // 6: users.v1.GetUserRequest
+	(*GetUserResponse)(nil),                       // 7: users.v1.GetUserResponse
+	(*GetUserByEmailRequest)(nil),                 // 8: users.v1.GetUserByEmailRequest
+	(*GetUserByEmailResponse)(nil),                // 9: users.v1.GetUserByEmailResponse
+	(*GetUserByUsernameRequest)(nil),              // 10: users.v1.GetUserByUsernameRequest
+	(*GetUserByUsernameResponse)(nil),             // 11: users.v1.GetUserByUsernameResponse
+	(*ListUsersRequest)(nil),                      // 12: users.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),                     // 13: users.v1.ListUsersResponse
+	(*UpdateUserRequest)(nil),                     // 14: users.v1.UpdateUserRequest
+	(*UpdateUserResponse)(nil),                    // 15: users.v1.UpdateUserResponse
+	(*DeleteUserRequest)(nil),                     // 16: users.v1.DeleteUserRequest
+	(*DeleteUserResponse)(nil),                    // 17: users.v1.DeleteUserResponse
+	(*WhoAmIRequest)(nil),                         // 18: users.v1.WhoAmIRequest
+	(*WhoAmIResponse)(nil),                        // 19: users.v1.WhoAmIResponse
+	(*DeleteUserAndCleanupRequest)(nil),           // 20: users.v1.DeleteUserAndCleanupRequest
+	(*DeleteUserAndCleanupResponse)(nil),          // 21: users.v1.DeleteUserAndCleanupResponse
+	(*UpdatePasswordRequest)(nil),                 // 22: users.v1.UpdatePasswordRequest
+	(*UpdatePasswordResponse)(nil),                // 23: users.v1.UpdatePasswordResponse
+	(*AssignPlatformRoleRequest)(nil),             // 24: users.v1.AssignPlatformRoleRequest
+	(*AssignPlatformRoleResponse)(nil),            // 25: users.v1.AssignPlatformRoleResponse
+	(*GetPlatformRoleMembersRequest)(nil),         // 26: users.v1.GetPlatformRoleMembersRequest
+	(*GetPlatformRoleMembersResponse)(nil),        // 27: users.v1.GetPlatformRoleMembersResponse
+	(*GetKratosIdentityRequest)(nil),              // 28: users.v1.GetKratosIdentityRequest
+	(*GetKratosIdentityResponse)(nil),             // 29: users.v1.GetKratosIdentityResponse
+	(*UserSession)(nil),                           // 30: users.v1.UserSession
+	(*ListUserSessionsRequest)(nil),               // 31: users.v1.ListUserSessionsRequest
+	(*ListUserSessionsResponse)(nil),              // 32: users.v1.ListUserSessionsResponse
+	(*RevokeUserSessionRequest)(nil),              // 33: users.v1.RevokeUserSessionRequest
+	(*RevokeUserSessionResponse)(nil),             // 34: users.v1.RevokeUserSessionResponse
+	(*RevokeAllUserSessionsRequest)(nil),          // 35: users.v1.RevokeAllUserSessionsRequest
+	(*RevokeAllUserSessionsResponse)(nil),         // 36: users.v1.RevokeAllUserSessionsResponse
+	(*PreRegisterUserRequest)(nil),                // 37: users.v1.PreRegisterUserRequest
+	(*PreRegisterUserResponse)(nil),               // 38: users.v1.PreRegisterUserResponse
+	(*ListPreRegisteredUsersRequest)(nil),         // 39: users.v1.ListPreRegisteredUsersRequest
+	(*ListPreRegisteredUsersResponse)(nil),        // 40: users.v1.ListPreRegisteredUsersResponse
+	(*DeletePreRegisteredUserRequest)(nil),        // 41: users.v1.DeletePreRegisteredUserRequest
+	(*DeletePreRegisteredUserResponse)(nil),       // 42: users.v1.DeletePreRegisteredUserResponse
+	(*NotificationPreference)(nil),                // 43: users.v1.NotificationPreference
+	(*GetNotificationPreferencesRequest)(nil),     // 44: users.v1.GetNotificationPreferencesRequest
+	(*GetNotificationPreferencesResponse)(nil),    // 45: users.v1.GetNotificationPreferencesResponse
+	(*UpdateNotificationPreferencesRequest)(nil),  // 46: users.v1.UpdateNotificationPreferencesRequest
+	(*UpdateNotificationPreferencesResponse)(nil), // 47: users.v1.UpdateNotificationPreferencesResponse
 }
 var file_usersv1_users_proto_depIdxs = []int32{
 	0,  // 0: users.v1.User.platform_role:type_name -> users.v1.PlatformRole
 	0,  // 1: users.v1.PreRegisteredUser.platform_role:type_name -> users.v1.PlatformRole
-	1,  // 2: users.v1.CreateUserResponse.user:type_name -> users.v1.User
-	1,  // 3: users.v1.GetUserResponse.user:type_name -> users.v1.User
-	1,  // 4: users.v1.GetUserByEmailResponse.user:type_name -> users.v1.User
-	1,  // 5: users.v1.GetUserByUsernameResponse.user:type_name -> users.v1.User
-	1,  // 6: users.v1.ListUsersResponse.users:type_name -> users.v1.User
-	1,  // 7: users.v1.UpdateUserResponse.user:type_name -> users.v1.User
-	0,  // 8: users.v1.AssignPlatformRoleRequest.role:type_name -> users.v1.PlatformRole
-	1,  // 9: users.v1.AssignPlatformRoleResponse.user:type_name -> users.v1.User
-	0,  // 10: users.v1.PreRegisterUserRequest.platform_role:type_name -> users.v1.PlatformRole
-	2,  // 11: users.v1.PreRegisterUserResponse.pre_registered_user:type_name -> users.v1.PreRegisteredUser
-	2,  // 12: users.v1.ListPreRegisteredUsersResponse.pre_registered_users:type_name -> users.v1.PreRegisteredUser
-	3,  // 13: users.v1.UsersService.CreateUser:input_type -> users.v1.CreateUserRequest
-	5,  // 14: users.v1.UsersService.GetUser:input_type -> users.v1.GetUserRequest
-	7,  // 15: users.v1.UsersService.GetUserByEmail:input_type -> users.v1.GetUserByEmailRequest
-	9,  // 16: users.v1.UsersService.GetUserByUsername:input_type -> users.v1.GetUserByUsernameRequest
-	11, // 17: users.v1.UsersService.ListUsers:input_type -> users.v1.ListUsersRequest
-	13, // 18: users.v1.UsersService.UpdateUser:input_type -> users.v1.UpdateUserRequest
-	15, // 19: users.v1.UsersService.DeleteUser:input_type -> users.v1.DeleteUserRequest
-	17, // 20: users.v1.UsersService.UpdatePassword:input_type -> users.v1.UpdatePasswordRequest
-	19, // 21: users.v1.UsersService.AssignPlatformRole:input_type -> users.v1.AssignPlatformRoleRequest
-	21, // 22: users.v1.UsersService.PreRegisterUser:input_type -> users.v1.PreRegisterUserRequest
-	23, // 23: users.v1.UsersService.ListPreRegisteredUsers:input_type -> users.v1.ListPreRegisteredUsersRequest
-	25, // 24: users.v1.UsersService.DeletePreRegisteredUser:input_type -> users.v1.DeletePreRegisteredUserRequest
-	4,  // 25: users.v1.UsersService.CreateUser:output_type -> users.v1.CreateUserResponse
-	6,  // 26: users.v1.UsersService.GetUser:output_type -> users.v1.GetUserResponse
-	8,  // 27: users.v1.UsersService.GetUserByEmail:output_type -> users.v1.GetUserByEmailResponse
-	10, // 28: users.v1.UsersService.GetUserByUsername:output_type -> users.v1.GetUserByUsernameResponse
-	12, // 29: users.v1.UsersService.ListUsers:output_type -> users.v1.ListUsersResponse
-	14, // 30: users.v1.UsersService.UpdateUser:output_type -> users.v1.UpdateUserResponse
-	16, // 31: users.v1.UsersService.DeleteUser:output_type -> users.v1.DeleteUserResponse
-	18, // 32: users.v1.UsersService.UpdatePassword:output_type -> users.v1.UpdatePasswordResponse
-	20, // 33: users.v1.UsersService.AssignPlatformRole:output_type -> users.v1.AssignPlatformRoleResponse
-	22, // 34: users.v1.UsersService.PreRegisterUser:output_type -> users.v1.PreRegisterUserResponse
-	24, // 35: users.v1.UsersService.ListPreRegisteredUsers:output_type -> users.v1.ListPreRegisteredUsersResponse
-	26, // 36: users.v1.UsersService.DeletePreRegisteredUser:output_type -> users.v1.DeletePreRegisteredUserResponse
-	25, // [25:37] is the sub-list for method output_type
-	13, // [13:25] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	2,  // 2: users.v1.CreateUserResponse.user:type_name -> users.v1.User
+	2,  // 3: users.v1.GetUserResponse.user:type_name -> users.v1.User
+	2,  // 4: users.v1.GetUserByEmailResponse.user:type_name -> users.v1.User
+	2,  // 5: users.v1.GetUserByUsernameResponse.user:type_name -> users.v1.User
+	2,  // 6: users.v1.ListUsersResponse.users:type_name -> users.v1.User
+	2,  // 7: users.v1.UpdateUserResponse.user:type_name -> users.v1.User
+	2,  // 8: users.v1.WhoAmIResponse.user:type_name -> users.v1.User
+	0,  // 9: users.v1.WhoAmIResponse.platform_role:type_name -> users.v1.PlatformRole
+	0,  // 10: users.v1.AssignPlatformRoleRequest.role:type_name -> users.v1.PlatformRole
+	2,  // 11: users.v1.AssignPlatformRoleResponse.user:type_name -> users.v1.User
+	0,  // 12: users.v1.GetPlatformRoleMembersRequest.role:type_name -> users.v1.PlatformRole
+	2,  // 13: users.v1.GetPlatformRoleMembersResponse.users:type_name -> users.v1.User
+	2,  // 14: users.v1.GetKratosIdentityResponse.user:type_name -> users.v1.User
+	30, // 15: users.v1.ListUserSessionsResponse.sessions:type_name -> users.v1.UserSession
+	0,  // 16: users.v1.PreRegisterUserRequest.platform_role:type_name -> users.v1.PlatformRole
+	3,  // 17: users.v1.PreRegisterUserResponse.pre_registered_user:type_name -> users.v1.PreRegisteredUser
+	3,  // 18: users.v1.ListPreRegisteredUsersResponse.pre_registered_users:type_name -> users.v1.PreRegisteredUser
+	1,  // 19: users.v1.NotificationPreference.notification_type:type_name -> users.v1.NotificationType
+	43, // 20: users.v1.GetNotificationPreferencesResponse.preferences:type_name -> users.v1.NotificationPreference
+	43, // 21: users.v1.UpdateNotificationPreferencesRequest.preferences:type_name -> users.v1.NotificationPreference
+	43, // 22: users.v1.UpdateNotificationPreferencesResponse.preferences:type_name -> users.v1.NotificationPreference
+	4,  // 23: users.v1.UsersService.CreateUser:input_type -> users.v1.CreateUserRequest
+	6,  // 24: users.v1.UsersService.GetUser:input_type -> users.v1.GetUserRequest
+	18, // 25: users.v1.UsersService.WhoAmI:input_type -> users.v1.WhoAmIRequest
+	8,  // 26: users.v1.UsersService.GetUserByEmail:input_type -> users.v1.GetUserByEmailRequest
+	10, // 27: users.v1.UsersService.GetUserByUsername:input_type -> users.v1.GetUserByUsernameRequest
+	12, // 28: users.v1.UsersService.ListUsers:input_type -> users.v1.ListUsersRequest
+	14, // 29: users.v1.UsersService.UpdateUser:input_type -> users.v1.UpdateUserRequest
+	16, // 30: users.v1.UsersService.DeleteUser:input_type -> users.v1.DeleteUserRequest
+	20, // 31: users.v1.UsersService.DeleteUserAndCleanup:input_type -> users.v1.DeleteUserAndCleanupRequest
+	22, // 32: users.v1.UsersService.UpdatePassword:input_type -> users.v1.UpdatePasswordRequest
+	24, // 33: users.v1.UsersService.AssignPlatformRole:input_type -> users.v1.AssignPlatformRoleRequest
+	26, // 34: users.v1.UsersService.GetPlatformRoleMembers:input_type -> users.v1.GetPlatformRoleMembersRequest
+	28, // 35: users.v1.UsersService.GetKratosIdentity:input_type -> users.v1.GetKratosIdentityRequest
+	31, // 36: users.v1.UsersService.ListUserSessions:input_type -> users.v1.ListUserSessionsRequest
+	33, // 37: users.v1.UsersService.RevokeUserSession:input_type -> users.v1.RevokeUserSessionRequest
+	35, // 38: users.v1.UsersService.RevokeAllUserSessions:input_type -> users.v1.RevokeAllUserSessionsRequest
+	37, // 39: users.v1.UsersService.PreRegisterUser:input_type -> users.v1.PreRegisterUserRequest
+	39, // 40: users.v1.UsersService.ListPreRegisteredUsers:input_type -> users.v1.ListPreRegisteredUsersRequest
+	41, // 41: users.v1.UsersService.DeletePreRegisteredUser:input_type -> users.v1.DeletePreRegisteredUserRequest
+	44, // 42: users.v1.UsersService.GetNotificationPreferences:input_type -> users.v1.GetNotificationPreferencesRequest
+	46, // 43: users.v1.UsersService.UpdateNotificationPreferences:input_type -> users.v1.UpdateNotificationPreferencesRequest
+	5,  // 44: users.v1.UsersService.CreateUser:output_type -> users.v1.CreateUserResponse
+	7,  // 45: users.v1.UsersService.GetUser:output_type -> users.v1.GetUserResponse
+	19, // 46: users.v1.UsersService.WhoAmI:output_type -> users.v1.WhoAmIResponse
+	9,  // 47: users.v1.UsersService.GetUserByEmail:output_type -> users.v1.GetUserByEmailResponse
+	11, // 48: users.v1.UsersService.GetUserByUsername:output_type -> users.v1.GetUserByUsernameResponse
+	13, // 49: users.v1.UsersService.ListUsers:output_type -> users.v1.ListUsersResponse
+	15, // 50: users.v1.UsersService.UpdateUser:output_type -> users.v1.UpdateUserResponse
+	17, // 51: users.v1.UsersService.DeleteUser:output_type -> users.v1.DeleteUserResponse
+	21, // 52: users.v1.UsersService.DeleteUserAndCleanup:output_type -> users.v1.DeleteUserAndCleanupResponse
+	23, // 53: users.v1.UsersService.UpdatePassword:output_type -> users.v1.UpdatePasswordResponse
+	25, // 54: users.v1.UsersService.AssignPlatformRole:output_type -> users.v1.AssignPlatformRoleResponse
+	27, // 55: users.v1.UsersService.GetPlatformRoleMembers:output_type -> users.v1.GetPlatformRoleMembersResponse
+	29, // 56: users.v1.UsersService.GetKratosIdentity:output_type -> users.v1.GetKratosIdentityResponse
+	32, // 57: users.v1.UsersService.ListUserSessions:output_type -> users.v1.ListUserSessionsResponse
+	34, // 58: users.v1.UsersService.RevokeUserSession:output_type -> users.v1.RevokeUserSessionResponse
+	36, // 59: users.v1.UsersService.RevokeAllUserSessions:output_type -> users.v1.RevokeAllUserSessionsResponse
+	38, // 60: users.v1.UsersService.PreRegisterUser:output_type -> users.v1.PreRegisterUserResponse
+	40, // 61: users.v1.UsersService.ListPreRegisteredUsers:output_type -> users.v1.ListPreRegisteredUsersResponse
+	42, // 62: users.v1.UsersService.DeletePreRegisteredUser:output_type -> users.v1.DeletePreRegisteredUserResponse
+	45, // 63: users.v1.UsersService.GetNotificationPreferences:output_type -> users.v1.GetNotificationPreferencesResponse
+	47, // 64: users.v1.UsersService.UpdateNotificationPreferences:output_type -> users.v1.UpdateNotificationPreferencesResponse
+	44, // [44:65] is the sub-list for method output_type
+	23, // [23:44] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_usersv1_users_proto_init() }
@@ -1660,13 +2825,14 @@ func file_usersv1_users_proto_init() {
 	file_usersv1_users_proto_msgTypes[0].OneofWrappers = []any{}
 	file_usersv1_users_proto_msgTypes[1].OneofWrappers = []any{}
 	file_usersv1_users_proto_msgTypes[12].OneofWrappers = []any{}
+	file_usersv1_users_proto_msgTypes[28].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_usersv1_users_proto_rawDesc), len(file_usersv1_users_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   26,
+			NumEnums:      2,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
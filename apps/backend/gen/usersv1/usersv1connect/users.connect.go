@@ -37,6 +37,8 @@ const (
 	UsersServiceCreateUserProcedure = "/users.v1.UsersService/CreateUser"
 	// UsersServiceGetUserProcedure is the fully-qualified name of the UsersService's GetUser RPC.
 	UsersServiceGetUserProcedure = "/users.v1.UsersService/GetUser"
+	// UsersServiceWhoAmIProcedure is the fully-qualified name of the UsersService's WhoAmI RPC.
+	UsersServiceWhoAmIProcedure = "/users.v1.UsersService/WhoAmI"
 	// UsersServiceGetUserByEmailProcedure is the fully-qualified name of the UsersService's
 	// GetUserByEmail RPC.
 	UsersServiceGetUserByEmailProcedure = "/users.v1.UsersService/GetUserByEmail"
@@ -49,12 +51,30 @@ const (
 	UsersServiceUpdateUserProcedure = "/users.v1.UsersService/UpdateUser"
 	// UsersServiceDeleteUserProcedure is the fully-qualified name of the UsersService's DeleteUser RPC.
 	UsersServiceDeleteUserProcedure = "/users.v1.UsersService/DeleteUser"
+	// UsersServiceDeleteUserAndCleanupProcedure is the fully-qualified name of the UsersService's
+	// DeleteUserAndCleanup RPC.
+	UsersServiceDeleteUserAndCleanupProcedure = "/users.v1.UsersService/DeleteUserAndCleanup"
 	// UsersServiceUpdatePasswordProcedure is the fully-qualified name of the UsersService's
 	// UpdatePassword RPC.
 	UsersServiceUpdatePasswordProcedure = "/users.v1.UsersService/UpdatePassword"
 	// UsersServiceAssignPlatformRoleProcedure is the fully-qualified name of the UsersService's
 	// AssignPlatformRole RPC.
 	UsersServiceAssignPlatformRoleProcedure = "/users.v1.UsersService/AssignPlatformRole"
+	// UsersServiceGetPlatformRoleMembersProcedure is the fully-qualified name of the UsersService's
+	// GetPlatformRoleMembers RPC.
+	UsersServiceGetPlatformRoleMembersProcedure = "/users.v1.UsersService/GetPlatformRoleMembers"
+	// UsersServiceGetKratosIdentityProcedure is the fully-qualified name of the UsersService's
+	// GetKratosIdentity RPC.
+	UsersServiceGetKratosIdentityProcedure = "/users.v1.UsersService/GetKratosIdentity"
+	// UsersServiceListUserSessionsProcedure is the fully-qualified name of the UsersService's
+	// ListUserSessions RPC.
+	UsersServiceListUserSessionsProcedure = "/users.v1.UsersService/ListUserSessions"
+	// UsersServiceRevokeUserSessionProcedure is the fully-qualified name of the UsersService's
+	// RevokeUserSession RPC.
+	UsersServiceRevokeUserSessionProcedure = "/users.v1.UsersService/RevokeUserSession"
+	// UsersServiceRevokeAllUserSessionsProcedure is the fully-qualified name of the UsersService's
+	// RevokeAllUserSessions RPC.
+	UsersServiceRevokeAllUserSessionsProcedure = "/users.v1.UsersService/RevokeAllUserSessions"
 	// UsersServicePreRegisterUserProcedure is the fully-qualified name of the UsersService's
 	// PreRegisterUser RPC.
 	UsersServicePreRegisterUserProcedure = "/users.v1.UsersService/PreRegisterUser"
@@ -64,24 +84,41 @@ const (
 	// UsersServiceDeletePreRegisteredUserProcedure is the fully-qualified name of the UsersService's
 	// DeletePreRegisteredUser RPC.
 	UsersServiceDeletePreRegisteredUserProcedure = "/users.v1.UsersService/DeletePreRegisteredUser"
+	// UsersServiceGetNotificationPreferencesProcedure is the fully-qualified name of the UsersService's
+	// GetNotificationPreferences RPC.
+	UsersServiceGetNotificationPreferencesProcedure = "/users.v1.UsersService/GetNotificationPreferences"
+	// UsersServiceUpdateNotificationPreferencesProcedure is the fully-qualified name of the
+	// UsersService's UpdateNotificationPreferences RPC.
+	UsersServiceUpdateNotificationPreferencesProcedure = "/users.v1.UsersService/UpdateNotificationPreferences"
 )
 
 // UsersServiceClient is a client for the users.v1.UsersService service.
 type UsersServiceClient interface {
 	CreateUser(context.Context, *connect.Request[usersv1.CreateUserRequest]) (*connect.Response[usersv1.CreateUserResponse], error)
 	GetUser(context.Context, *connect.Request[usersv1.GetUserRequest]) (*connect.Response[usersv1.GetUserResponse], error)
+	WhoAmI(context.Context, *connect.Request[usersv1.WhoAmIRequest]) (*connect.Response[usersv1.WhoAmIResponse], error)
 	GetUserByEmail(context.Context, *connect.Request[usersv1.GetUserByEmailRequest]) (*connect.Response[usersv1.GetUserByEmailResponse], error)
 	GetUserByUsername(context.Context, *connect.Request[usersv1.GetUserByUsernameRequest]) (*connect.Response[usersv1.GetUserByUsernameResponse], error)
 	ListUsers(context.Context, *connect.Request[usersv1.ListUsersRequest]) (*connect.Response[usersv1.ListUsersResponse], error)
 	UpdateUser(context.Context, *connect.Request[usersv1.UpdateUserRequest]) (*connect.Response[usersv1.UpdateUserResponse], error)
 	DeleteUser(context.Context, *connect.Request[usersv1.DeleteUserRequest]) (*connect.Response[usersv1.DeleteUserResponse], error)
+	DeleteUserAndCleanup(context.Context, *connect.Request[usersv1.DeleteUserAndCleanupRequest]) (*connect.Response[usersv1.DeleteUserAndCleanupResponse], error)
 	UpdatePassword(context.Context, *connect.Request[usersv1.UpdatePasswordRequest]) (*connect.Response[usersv1.UpdatePasswordResponse], error)
 	// Platform role management
 	AssignPlatformRole(context.Context, *connect.Request[usersv1.AssignPlatformRoleRequest]) (*connect.Response[usersv1.AssignPlatformRoleResponse], error)
+	GetPlatformRoleMembers(context.Context, *connect.Request[usersv1.GetPlatformRoleMembersRequest]) (*connect.Response[usersv1.GetPlatformRoleMembersResponse], error)
+	GetKratosIdentity(context.Context, *connect.Request[usersv1.GetKratosIdentityRequest]) (*connect.Response[usersv1.GetKratosIdentityResponse], error)
+	// Session management (platform admins)
+	ListUserSessions(context.Context, *connect.Request[usersv1.ListUserSessionsRequest]) (*connect.Response[usersv1.ListUserSessionsResponse], error)
+	RevokeUserSession(context.Context, *connect.Request[usersv1.RevokeUserSessionRequest]) (*connect.Response[usersv1.RevokeUserSessionResponse], error)
+	RevokeAllUserSessions(context.Context, *connect.Request[usersv1.RevokeAllUserSessionsRequest]) (*connect.Response[usersv1.RevokeAllUserSessionsResponse], error)
 	// Pre-registration management
 	PreRegisterUser(context.Context, *connect.Request[usersv1.PreRegisterUserRequest]) (*connect.Response[usersv1.PreRegisterUserResponse], error)
 	ListPreRegisteredUsers(context.Context, *connect.Request[usersv1.ListPreRegisteredUsersRequest]) (*connect.Response[usersv1.ListPreRegisteredUsersResponse], error)
 	DeletePreRegisteredUser(context.Context, *connect.Request[usersv1.DeletePreRegisteredUserRequest]) (*connect.Response[usersv1.DeletePreRegisteredUserResponse], error)
+	// Notification preferences
+	GetNotificationPreferences(context.Context, *connect.Request[usersv1.GetNotificationPreferencesRequest]) (*connect.Response[usersv1.GetNotificationPreferencesResponse], error)
+	UpdateNotificationPreferences(context.Context, *connect.Request[usersv1.UpdateNotificationPreferencesRequest]) (*connect.Response[usersv1.UpdateNotificationPreferencesResponse], error)
 }
 
 // NewUsersServiceClient constructs a client for the users.v1.UsersService service. By default, it
@@ -107,6 +144,12 @@ func NewUsersServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(usersServiceMethods.ByName("GetUser")),
 			connect.WithClientOptions(opts...),
 		),
+		whoAmI: connect.NewClient[usersv1.WhoAmIRequest, usersv1.WhoAmIResponse](
+			httpClient,
+			baseURL+UsersServiceWhoAmIProcedure,
+			connect.WithSchema(usersServiceMethods.ByName("WhoAmI")),
+			connect.WithClientOptions(opts...),
+		),
 		getUserByEmail: connect.NewClient[usersv1.GetUserByEmailRequest, usersv1.GetUserByEmailResponse](
 			httpClient,
 			baseURL+UsersServiceGetUserByEmailProcedure,
@@ -137,6 +180,12 @@ func NewUsersServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(usersServiceMethods.ByName("DeleteUser")),
 			connect.WithClientOptions(opts...),
 		),
+		deleteUserAndCleanup: connect.NewClient[usersv1.DeleteUserAndCleanupRequest, usersv1.DeleteUserAndCleanupResponse](
+			httpClient,
+			baseURL+UsersServiceDeleteUserAndCleanupProcedure,
+			connect.WithSchema(usersServiceMethods.ByName("DeleteUserAndCleanup")),
+			connect.WithClientOptions(opts...),
+		),
 		updatePassword: connect.NewClient[usersv1.UpdatePasswordRequest, usersv1.UpdatePasswordResponse](
 			httpClient,
 			baseURL+UsersServiceUpdatePasswordProcedure,
@@ -149,6 +198,36 @@ func NewUsersServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(usersServiceMethods.ByName("AssignPlatformRole")),
 			connect.WithClientOptions(opts...),
 		),
+		getPlatformRoleMembers: connect.NewClient[usersv1.GetPlatformRoleMembersRequest, usersv1.GetPlatformRoleMembersResponse](
+			httpClient,
+			baseURL+UsersServiceGetPlatformRoleMembersProcedure,
+			connect.WithSchema(usersServiceMethods.ByName("GetPlatformRoleMembers")),
+			connect.WithClientOptions(opts...),
+		),
+		getKratosIdentity: connect.NewClient[usersv1.GetKratosIdentityRequest, usersv1.GetKratosIdentityResponse](
+			httpClient,
+			baseURL+UsersServiceGetKratosIdentityProcedure,
+			connect.WithSchema(usersServiceMethods.ByName("GetKratosIdentity")),
+			connect.WithClientOptions(opts...),
+		),
+		listUserSessions: connect.NewClient[usersv1.ListUserSessionsRequest, usersv1.ListUserSessionsResponse](
+			httpClient,
+			baseURL+UsersServiceListUserSessionsProcedure,
+			connect.WithSchema(usersServiceMethods.ByName("ListUserSessions")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeUserSession: connect.NewClient[usersv1.RevokeUserSessionRequest, usersv1.RevokeUserSessionResponse](
+			httpClient,
+			baseURL+UsersServiceRevokeUserSessionProcedure,
+			connect.WithSchema(usersServiceMethods.ByName("RevokeUserSession")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeAllUserSessions: connect.NewClient[usersv1.RevokeAllUserSessionsRequest, usersv1.RevokeAllUserSessionsResponse](
+			httpClient,
+			baseURL+UsersServiceRevokeAllUserSessionsProcedure,
+			connect.WithSchema(usersServiceMethods.ByName("RevokeAllUserSessions")),
+			connect.WithClientOptions(opts...),
+		),
 		preRegisterUser: connect.NewClient[usersv1.PreRegisterUserRequest, usersv1.PreRegisterUserResponse](
 			httpClient,
 			baseURL+UsersServicePreRegisterUserProcedure,
@@ -167,23 +246,44 @@ func NewUsersServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(usersServiceMethods.ByName("DeletePreRegisteredUser")),
 			connect.WithClientOptions(opts...),
 		),
+		getNotificationPreferences: connect.NewClient[usersv1.GetNotificationPreferencesRequest, usersv1.GetNotificationPreferencesResponse](
+			httpClient,
+			baseURL+UsersServiceGetNotificationPreferencesProcedure,
+			connect.WithSchema(usersServiceMethods.ByName("GetNotificationPreferences")),
+			connect.WithClientOptions(opts...),
+		),
+		updateNotificationPreferences: connect.NewClient[usersv1.UpdateNotificationPreferencesRequest, usersv1.UpdateNotificationPreferencesResponse](
+			httpClient,
+			baseURL+UsersServiceUpdateNotificationPreferencesProcedure,
+			connect.WithSchema(usersServiceMethods.ByName("UpdateNotificationPreferences")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // usersServiceClient implements UsersServiceClient.
 type usersServiceClient struct {
-	createUser              *connect.Client[usersv1.CreateUserRequest, usersv1.CreateUserResponse]
-	getUser                 *connect.Client[usersv1.GetUserRequest, usersv1.GetUserResponse]
-	getUserByEmail          *connect.Client[usersv1.GetUserByEmailRequest, usersv1.GetUserByEmailResponse]
-	getUserByUsername       *connect.Client[usersv1.GetUserByUsernameRequest, usersv1.GetUserByUsernameResponse]
-	listUsers               *connect.Client[usersv1.ListUsersRequest, usersv1.ListUsersResponse]
-	updateUser              *connect.Client[usersv1.UpdateUserRequest, usersv1.UpdateUserResponse]
-	deleteUser              *connect.Client[usersv1.DeleteUserRequest, usersv1.DeleteUserResponse]
-	updatePassword          *connect.Client[usersv1.UpdatePasswordRequest, usersv1.UpdatePasswordResponse]
-	assignPlatformRole      *connect.Client[usersv1.AssignPlatformRoleRequest, usersv1.AssignPlatformRoleResponse]
-	preRegisterUser         *connect.Client[usersv1.PreRegisterUserRequest, usersv1.PreRegisterUserResponse]
-	listPreRegisteredUsers  *connect.Client[usersv1.ListPreRegisteredUsersRequest, usersv1.ListPreRegisteredUsersResponse]
-	deletePreRegisteredUser *connect.Client[usersv1.DeletePreRegisteredUserRequest, usersv1.DeletePreRegisteredUserResponse]
+	createUser                    *connect.Client[usersv1.CreateUserRequest, usersv1.CreateUserResponse]
+	getUser                       *connect.Client[usersv1.GetUserRequest, usersv1.GetUserResponse]
+	whoAmI                        *connect.Client[usersv1.WhoAmIRequest, usersv1.WhoAmIResponse]
+	getUserByEmail                *connect.Client[usersv1.GetUserByEmailRequest, usersv1.GetUserByEmailResponse]
+	getUserByUsername             *connect.Client[usersv1.GetUserByUsernameRequest, usersv1.GetUserByUsernameResponse]
+	listUsers                     *connect.Client[usersv1.ListUsersRequest, usersv1.ListUsersResponse]
+	updateUser                    *connect.Client[usersv1.UpdateUserRequest, usersv1.UpdateUserResponse]
+	deleteUser                    *connect.Client[usersv1.DeleteUserRequest, usersv1.DeleteUserResponse]
+	deleteUserAndCleanup          *connect.Client[usersv1.DeleteUserAndCleanupRequest, usersv1.DeleteUserAndCleanupResponse]
+	updatePassword                *connect.Client[usersv1.UpdatePasswordRequest, usersv1.UpdatePasswordResponse]
+	assignPlatformRole            *connect.Client[usersv1.AssignPlatformRoleRequest, usersv1.AssignPlatformRoleResponse]
+	getPlatformRoleMembers        *connect.Client[usersv1.GetPlatformRoleMembersRequest, usersv1.GetPlatformRoleMembersResponse]
+	getKratosIdentity             *connect.Client[usersv1.GetKratosIdentityRequest, usersv1.GetKratosIdentityResponse]
+	listUserSessions              *connect.Client[usersv1.ListUserSessionsRequest, usersv1.ListUserSessionsResponse]
+	revokeUserSession             *connect.Client[usersv1.RevokeUserSessionRequest, usersv1.RevokeUserSessionResponse]
+	revokeAllUserSessions         *connect.Client[usersv1.RevokeAllUserSessionsRequest, usersv1.RevokeAllUserSessionsResponse]
+	preRegisterUser               *connect.Client[usersv1.PreRegisterUserRequest, usersv1.PreRegisterUserResponse]
+	listPreRegisteredUsers        *connect.Client[usersv1.ListPreRegisteredUsersRequest, usersv1.ListPreRegisteredUsersResponse]
+	deletePreRegisteredUser       *connect.Client[usersv1.DeletePreRegisteredUserRequest, usersv1.DeletePreRegisteredUserResponse]
+	getNotificationPreferences    *connect.Client[usersv1.GetNotificationPreferencesRequest, usersv1.GetNotificationPreferencesResponse]
+	updateNotificationPreferences *connect.Client[usersv1.UpdateNotificationPreferencesRequest, usersv1.UpdateNotificationPreferencesResponse]
 }
 
 // CreateUser calls users.v1.UsersService.CreateUser.
@@ -196,6 +296,11 @@ func (c *usersServiceClient) GetUser(ctx context.Context, req *connect.Request[u
 	return c.getUser.CallUnary(ctx, req)
 }
 
+// WhoAmI calls users.v1.UsersService.WhoAmI.
+func (c *usersServiceClient) WhoAmI(ctx context.Context, req *connect.Request[usersv1.WhoAmIRequest]) (*connect.Response[usersv1.WhoAmIResponse], error) {
+	return c.whoAmI.CallUnary(ctx, req)
+}
+
 // GetUserByEmail calls users.v1.UsersService.GetUserByEmail.
 func (c *usersServiceClient) GetUserByEmail(ctx context.Context, req *connect.Request[usersv1.GetUserByEmailRequest]) (*connect.Response[usersv1.GetUserByEmailResponse], error) {
 	return c.getUserByEmail.CallUnary(ctx, req)
@@ -221,6 +326,11 @@ func (c *usersServiceClient) DeleteUser(ctx context.Context, req *connect.Reques
 	return c.deleteUser.CallUnary(ctx, req)
 }
 
+// DeleteUserAndCleanup calls users.v1.UsersService.DeleteUserAndCleanup.
+func (c *usersServiceClient) DeleteUserAndCleanup(ctx context.Context, req *connect.Request[usersv1.DeleteUserAndCleanupRequest]) (*connect.Response[usersv1.DeleteUserAndCleanupResponse], error) {
+	return c.deleteUserAndCleanup.CallUnary(ctx, req)
+}
+
 // UpdatePassword calls users.v1.UsersService.UpdatePassword.
 func (c *usersServiceClient) UpdatePassword(ctx context.Context, req *connect.Request[usersv1.UpdatePasswordRequest]) (*connect.Response[usersv1.UpdatePasswordResponse], error) {
 	return c.updatePassword.CallUnary(ctx, req)
@@ -231,6 +341,31 @@ func (c *usersServiceClient) AssignPlatformRole(ctx context.Context, req *connec
 	return c.assignPlatformRole.CallUnary(ctx, req)
 }
 
+// GetPlatformRoleMembers calls users.v1.UsersService.GetPlatformRoleMembers.
+func (c *usersServiceClient) GetPlatformRoleMembers(ctx context.Context, req *connect.Request[usersv1.GetPlatformRoleMembersRequest]) (*connect.Response[usersv1.GetPlatformRoleMembersResponse], error) {
+	return c.getPlatformRoleMembers.CallUnary(ctx, req)
+}
+
+// GetKratosIdentity calls users.v1.UsersService.GetKratosIdentity.
+func (c *usersServiceClient) GetKratosIdentity(ctx context.Context, req *connect.Request[usersv1.GetKratosIdentityRequest]) (*connect.Response[usersv1.GetKratosIdentityResponse], error) {
+	return c.getKratosIdentity.CallUnary(ctx, req)
+}
+
+// ListUserSessions calls users.v1.UsersService.ListUserSessions.
+func (c *usersServiceClient) ListUserSessions(ctx context.Context, req *connect.Request[usersv1.ListUserSessionsRequest]) (*connect.Response[usersv1.ListUserSessionsResponse], error) {
+	return c.listUserSessions.CallUnary(ctx, req)
+}
+
+// RevokeUserSession calls users.v1.UsersService.RevokeUserSession.
+func (c *usersServiceClient) RevokeUserSession(ctx context.Context, req *connect.Request[usersv1.RevokeUserSessionRequest]) (*connect.Response[usersv1.RevokeUserSessionResponse], error) {
+	return c.revokeUserSession.CallUnary(ctx, req)
+}
+
+// RevokeAllUserSessions calls users.v1.UsersService.RevokeAllUserSessions.
+func (c *usersServiceClient) RevokeAllUserSessions(ctx context.Context, req *connect.Request[usersv1.RevokeAllUserSessionsRequest]) (*connect.Response[usersv1.RevokeAllUserSessionsResponse], error) {
+	return c.revokeAllUserSessions.CallUnary(ctx, req)
+}
+
 // PreRegisterUser calls users.v1.UsersService.PreRegisterUser.
 func (c *usersServiceClient) PreRegisterUser(ctx context.Context, req *connect.Request[usersv1.PreRegisterUserRequest]) (*connect.Response[usersv1.PreRegisterUserResponse], error) {
 	return c.preRegisterUser.CallUnary(ctx, req)
@@ -246,22 +381,43 @@ func (c *usersServiceClient) DeletePreRegisteredUser(ctx context.Context, req *c
 	return c.deletePreRegisteredUser.CallUnary(ctx, req)
 }
 
+// GetNotificationPreferences calls users.v1.UsersService.GetNotificationPreferences.
+func (c *usersServiceClient) GetNotificationPreferences(ctx context.Context, req *connect.Request[usersv1.GetNotificationPreferencesRequest]) (*connect.Response[usersv1.GetNotificationPreferencesResponse], error) {
+	return c.getNotificationPreferences.CallUnary(ctx, req)
+}
+
+// UpdateNotificationPreferences calls users.v1.UsersService.UpdateNotificationPreferences.
+func (c *usersServiceClient) UpdateNotificationPreferences(ctx context.Context, req *connect.Request[usersv1.UpdateNotificationPreferencesRequest]) (*connect.Response[usersv1.UpdateNotificationPreferencesResponse], error) {
+	return c.updateNotificationPreferences.CallUnary(ctx, req)
+}
+
 // UsersServiceHandler is an implementation of the users.v1.UsersService service.
 type UsersServiceHandler interface {
 	CreateUser(context.Context, *connect.Request[usersv1.CreateUserRequest]) (*connect.Response[usersv1.CreateUserResponse], error)
 	GetUser(context.Context, *connect.Request[usersv1.GetUserRequest]) (*connect.Response[usersv1.GetUserResponse], error)
+	WhoAmI(context.Context, *connect.Request[usersv1.WhoAmIRequest]) (*connect.Response[usersv1.WhoAmIResponse], error)
 	GetUserByEmail(context.Context, *connect.Request[usersv1.GetUserByEmailRequest]) (*connect.Response[usersv1.GetUserByEmailResponse], error)
 	GetUserByUsername(context.Context, *connect.Request[usersv1.GetUserByUsernameRequest]) (*connect.Response[usersv1.GetUserByUsernameResponse], error)
 	ListUsers(context.Context, *connect.Request[usersv1.ListUsersRequest]) (*connect.Response[usersv1.ListUsersResponse], error)
 	UpdateUser(context.Context, *connect.Request[usersv1.UpdateUserRequest]) (*connect.Response[usersv1.UpdateUserResponse], error)
 	DeleteUser(context.Context, *connect.Request[usersv1.DeleteUserRequest]) (*connect.Response[usersv1.DeleteUserResponse], error)
+	DeleteUserAndCleanup(context.Context, *connect.Request[usersv1.DeleteUserAndCleanupRequest]) (*connect.Response[usersv1.DeleteUserAndCleanupResponse], error)
 	UpdatePassword(context.Context, *connect.Request[usersv1.UpdatePasswordRequest]) (*connect.Response[usersv1.UpdatePasswordResponse], error)
 	// Platform role management
 	AssignPlatformRole(context.Context, *connect.Request[usersv1.AssignPlatformRoleRequest]) (*connect.Response[usersv1.AssignPlatformRoleResponse], error)
+	GetPlatformRoleMembers(context.Context, *connect.Request[usersv1.GetPlatformRoleMembersRequest]) (*connect.Response[usersv1.GetPlatformRoleMembersResponse], error)
+	GetKratosIdentity(context.Context, *connect.Request[usersv1.GetKratosIdentityRequest]) (*connect.Response[usersv1.GetKratosIdentityResponse], error)
+	// Session management (platform admins)
+	ListUserSessions(context.Context, *connect.Request[usersv1.ListUserSessionsRequest]) (*connect.Response[usersv1.ListUserSessionsResponse], error)
+	RevokeUserSession(context.Context, *connect.Request[usersv1.RevokeUserSessionRequest]) (*connect.Response[usersv1.RevokeUserSessionResponse], error)
+	RevokeAllUserSessions(context.Context, *connect.Request[usersv1.RevokeAllUserSessionsRequest]) (*connect.Response[usersv1.RevokeAllUserSessionsResponse], error)
 	// Pre-registration management
 	PreRegisterUser(context.Context, *connect.Request[usersv1.PreRegisterUserRequest]) (*connect.Response[usersv1.PreRegisterUserResponse], error)
 	ListPreRegisteredUsers(context.Context, *connect.Request[usersv1.ListPreRegisteredUsersRequest]) (*connect.Response[usersv1.ListPreRegisteredUsersResponse], error)
 	DeletePreRegisteredUser(context.Context, *connect.Request[usersv1.DeletePreRegisteredUserRequest]) (*connect.Response[usersv1.DeletePreRegisteredUserResponse], error)
+	// Notification preferences
+	GetNotificationPreferences(context.Context, *connect.Request[usersv1.GetNotificationPreferencesRequest]) (*connect.Response[usersv1.GetNotificationPreferencesResponse], error)
+	UpdateNotificationPreferences(context.Context, *connect.Request[usersv1.UpdateNotificationPreferencesRequest]) (*connect.Response[usersv1.UpdateNotificationPreferencesResponse], error)
 }
 
 // NewUsersServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -283,6 +439,12 @@ func NewUsersServiceHandler(svc UsersServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(usersServiceMethods.ByName("GetUser")),
 		connect.WithHandlerOptions(opts...),
 	)
+	usersServiceWhoAmIHandler := connect.NewUnaryHandler(
+		UsersServiceWhoAmIProcedure,
+		svc.WhoAmI,
+		connect.WithSchema(usersServiceMethods.ByName("WhoAmI")),
+		connect.WithHandlerOptions(opts...),
+	)
 	usersServiceGetUserByEmailHandler := connect.NewUnaryHandler(
 		UsersServiceGetUserByEmailProcedure,
 		svc.GetUserByEmail,
@@ -313,6 +475,12 @@ func NewUsersServiceHandler(svc UsersServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(usersServiceMethods.ByName("DeleteUser")),
 		connect.WithHandlerOptions(opts...),
 	)
+	usersServiceDeleteUserAndCleanupHandler := connect.NewUnaryHandler(
+		UsersServiceDeleteUserAndCleanupProcedure,
+		svc.DeleteUserAndCleanup,
+		connect.WithSchema(usersServiceMethods.ByName("DeleteUserAndCleanup")),
+		connect.WithHandlerOptions(opts...),
+	)
 	usersServiceUpdatePasswordHandler := connect.NewUnaryHandler(
 		UsersServiceUpdatePasswordProcedure,
 		svc.UpdatePassword,
@@ -325,6 +493,36 @@ func NewUsersServiceHandler(svc UsersServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(usersServiceMethods.ByName("AssignPlatformRole")),
 		connect.WithHandlerOptions(opts...),
 	)
+	usersServiceGetPlatformRoleMembersHandler := connect.NewUnaryHandler(
+		UsersServiceGetPlatformRoleMembersProcedure,
+		svc.GetPlatformRoleMembers,
+		connect.WithSchema(usersServiceMethods.ByName("GetPlatformRoleMembers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	usersServiceGetKratosIdentityHandler := connect.NewUnaryHandler(
+		UsersServiceGetKratosIdentityProcedure,
+		svc.GetKratosIdentity,
+		connect.WithSchema(usersServiceMethods.ByName("GetKratosIdentity")),
+		connect.WithHandlerOptions(opts...),
+	)
+	usersServiceListUserSessionsHandler := connect.NewUnaryHandler(
+		UsersServiceListUserSessionsProcedure,
+		svc.ListUserSessions,
+		connect.WithSchema(usersServiceMethods.ByName("ListUserSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	usersServiceRevokeUserSessionHandler := connect.NewUnaryHandler(
+		UsersServiceRevokeUserSessionProcedure,
+		svc.RevokeUserSession,
+		connect.WithSchema(usersServiceMethods.ByName("RevokeUserSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	usersServiceRevokeAllUserSessionsHandler := connect.NewUnaryHandler(
+		UsersServiceRevokeAllUserSessionsProcedure,
+		svc.RevokeAllUserSessions,
+		connect.WithSchema(usersServiceMethods.ByName("RevokeAllUserSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
 	usersServicePreRegisterUserHandler := connect.NewUnaryHandler(
 		UsersServicePreRegisterUserProcedure,
 		svc.PreRegisterUser,
@@ -343,12 +541,26 @@ func NewUsersServiceHandler(svc UsersServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(usersServiceMethods.ByName("DeletePreRegisteredUser")),
 		connect.WithHandlerOptions(opts...),
 	)
+	usersServiceGetNotificationPreferencesHandler := connect.NewUnaryHandler(
+		UsersServiceGetNotificationPreferencesProcedure,
+		svc.GetNotificationPreferences,
+		connect.WithSchema(usersServiceMethods.ByName("GetNotificationPreferences")),
+		connect.WithHandlerOptions(opts...),
+	)
+	usersServiceUpdateNotificationPreferencesHandler := connect.NewUnaryHandler(
+		UsersServiceUpdateNotificationPreferencesProcedure,
+		svc.UpdateNotificationPreferences,
+		connect.WithSchema(usersServiceMethods.ByName("UpdateNotificationPreferences")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/users.v1.UsersService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case UsersServiceCreateUserProcedure:
 			usersServiceCreateUserHandler.ServeHTTP(w, r)
 		case UsersServiceGetUserProcedure:
 			usersServiceGetUserHandler.ServeHTTP(w, r)
+		case UsersServiceWhoAmIProcedure:
+			usersServiceWhoAmIHandler.ServeHTTP(w, r)
 		case UsersServiceGetUserByEmailProcedure:
 			usersServiceGetUserByEmailHandler.ServeHTTP(w, r)
 		case UsersServiceGetUserByUsernameProcedure:
@@ -359,16 +571,32 @@ func NewUsersServiceHandler(svc UsersServiceHandler, opts ...connect.HandlerOpti
 			usersServiceUpdateUserHandler.ServeHTTP(w, r)
 		case UsersServiceDeleteUserProcedure:
 			usersServiceDeleteUserHandler.ServeHTTP(w, r)
+		case UsersServiceDeleteUserAndCleanupProcedure:
+			usersServiceDeleteUserAndCleanupHandler.ServeHTTP(w, r)
 		case UsersServiceUpdatePasswordProcedure:
 			usersServiceUpdatePasswordHandler.ServeHTTP(w, r)
 		case UsersServiceAssignPlatformRoleProcedure:
 			usersServiceAssignPlatformRoleHandler.ServeHTTP(w, r)
+		case UsersServiceGetPlatformRoleMembersProcedure:
+			usersServiceGetPlatformRoleMembersHandler.ServeHTTP(w, r)
+		case UsersServiceGetKratosIdentityProcedure:
+			usersServiceGetKratosIdentityHandler.ServeHTTP(w, r)
+		case UsersServiceListUserSessionsProcedure:
+			usersServiceListUserSessionsHandler.ServeHTTP(w, r)
+		case UsersServiceRevokeUserSessionProcedure:
+			usersServiceRevokeUserSessionHandler.ServeHTTP(w, r)
+		case UsersServiceRevokeAllUserSessionsProcedure:
+			usersServiceRevokeAllUserSessionsHandler.ServeHTTP(w, r)
 		case UsersServicePreRegisterUserProcedure:
 			usersServicePreRegisterUserHandler.ServeHTTP(w, r)
 		case UsersServiceListPreRegisteredUsersProcedure:
 			usersServiceListPreRegisteredUsersHandler.ServeHTTP(w, r)
 		case UsersServiceDeletePreRegisteredUserProcedure:
 			usersServiceDeletePreRegisteredUserHandler.ServeHTTP(w, r)
+		case UsersServiceGetNotificationPreferencesProcedure:
+			usersServiceGetNotificationPreferencesHandler.ServeHTTP(w, r)
+		case UsersServiceUpdateNotificationPreferencesProcedure:
+			usersServiceUpdateNotificationPreferencesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -386,6 +614,10 @@ func (UnimplementedUsersServiceHandler) GetUser(context.Context, *connect.Reques
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("users.v1.UsersService.GetUser is not implemented"))
 }
 
+func (UnimplementedUsersServiceHandler) WhoAmI(context.Context, *connect.Request[usersv1.WhoAmIRequest]) (*connect.Response[usersv1.WhoAmIResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("users.v1.UsersService.WhoAmI is not implemented"))
+}
+
 func (UnimplementedUsersServiceHandler) GetUserByEmail(context.Context, *connect.Request[usersv1.GetUserByEmailRequest]) (*connect.Response[usersv1.GetUserByEmailResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("users.v1.UsersService.GetUserByEmail is not implemented"))
 }
@@ -406,6 +638,10 @@ func (UnimplementedUsersServiceHandler) DeleteUser(context.Context, *connect.Req
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("users.v1.UsersService.DeleteUser is not implemented"))
 }
 
+func (UnimplementedUsersServiceHandler) DeleteUserAndCleanup(context.Context, *connect.Request[usersv1.DeleteUserAndCleanupRequest]) (*connect.Response[usersv1.DeleteUserAndCleanupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("users.v1.UsersService.DeleteUserAndCleanup is not implemented"))
+}
+
 func (UnimplementedUsersServiceHandler) UpdatePassword(context.Context, *connect.Request[usersv1.UpdatePasswordRequest]) (*connect.Response[usersv1.UpdatePasswordResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("users.v1.UsersService.UpdatePassword is not implemented"))
 }
@@ -414,6 +650,26 @@ func (UnimplementedUsersServiceHandler) AssignPlatformRole(context.Context, *con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("users.v1.UsersService.AssignPlatformRole is not implemented"))
 }
 
+func (UnimplementedUsersServiceHandler) GetPlatformRoleMembers(context.Context, *connect.Request[usersv1.GetPlatformRoleMembersRequest]) (*connect.Response[usersv1.GetPlatformRoleMembersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("users.v1.UsersService.GetPlatformRoleMembers is not implemented"))
+}
+
+func (UnimplementedUsersServiceHandler) GetKratosIdentity(context.Context, *connect.Request[usersv1.GetKratosIdentityRequest]) (*connect.Response[usersv1.GetKratosIdentityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("users.v1.UsersService.GetKratosIdentity is not implemented"))
+}
+
+func (UnimplementedUsersServiceHandler) ListUserSessions(context.Context, *connect.Request[usersv1.ListUserSessionsRequest]) (*connect.Response[usersv1.ListUserSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("users.v1.UsersService.ListUserSessions is not implemented"))
+}
+
+func (UnimplementedUsersServiceHandler) RevokeUserSession(context.Context, *connect.Request[usersv1.RevokeUserSessionRequest]) (*connect.Response[usersv1.RevokeUserSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("users.v1.UsersService.RevokeUserSession is not implemented"))
+}
+
+func (UnimplementedUsersServiceHandler) RevokeAllUserSessions(context.Context, *connect.Request[usersv1.RevokeAllUserSessionsRequest]) (*connect.Response[usersv1.RevokeAllUserSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("users.v1.UsersService.RevokeAllUserSessions is not implemented"))
+}
+
 func (UnimplementedUsersServiceHandler) PreRegisterUser(context.Context, *connect.Request[usersv1.PreRegisterUserRequest]) (*connect.Response[usersv1.PreRegisterUserResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("users.v1.UsersService.PreRegisterUser is not implemented"))
 }
@@ -425,3 +681,11 @@ func (UnimplementedUsersServiceHandler) ListPreRegisteredUsers(context.Context,
 func (UnimplementedUsersServiceHandler) DeletePreRegisteredUser(context.Context, *connect.Request[usersv1.DeletePreRegisteredUserRequest]) (*connect.Response[usersv1.DeletePreRegisteredUserResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("users.v1.UsersService.DeletePreRegisteredUser is not implemented"))
 }
+
+func (UnimplementedUsersServiceHandler) GetNotificationPreferences(context.Context, *connect.Request[usersv1.GetNotificationPreferencesRequest]) (*connect.Response[usersv1.GetNotificationPreferencesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("users.v1.UsersService.GetNotificationPreferences is not implemented"))
+}
+
+func (UnimplementedUsersServiceHandler) UpdateNotificationPreferences(context.Context, *connect.Request[usersv1.UpdateNotificationPreferencesRequest]) (*connect.Response[usersv1.UpdateNotificationPreferencesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("users.v1.UsersService.UpdateNotificationPreferences is not implemented"))
+}
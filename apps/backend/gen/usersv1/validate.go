@@ -0,0 +1,82 @@
+// Hand-written, not generated: request checks run by validation.NewInterceptor.
+
+package usersv1
+
+import (
+	"strings"
+
+	"github.com/studyverse/ems-backend/internal/validation"
+)
+
+const (
+	maxUsernameLength = 50
+	// Pre-registration is limited to university accounts
+	preRegisterEmailDomain = "@astanait.edu.kz"
+)
+
+func (x *CreateUserRequest) Validate() error {
+	var errs validation.Errors
+
+	if errs.NonEmpty("username", x.GetUsername()) {
+		errs.MaxLength("username", x.GetUsername(), maxUsernameLength)
+	}
+	errs.Email("email", x.GetEmail())
+	errs.NonEmpty("password", x.GetPassword())
+
+	return errs.Err()
+}
+
+func (x *UpdateUserRequest) Validate() error {
+	var errs validation.Errors
+
+	errs.PositiveID("id", x.GetId())
+	if x.Username != nil && errs.NonEmpty("username", x.GetUsername()) {
+		errs.MaxLength("username", x.GetUsername(), maxUsernameLength)
+	}
+	if x.Email != nil {
+		errs.Email("email", x.GetEmail())
+	}
+
+	return errs.Err()
+}
+
+func (x *DeleteUserAndCleanupRequest) Validate() error {
+	var errs validation.Errors
+
+	errs.PositiveID("user_id", x.GetUserId())
+
+	return errs.Err()
+}
+
+func (x *AssignPlatformRoleRequest) Validate() error {
+	var errs validation.Errors
+
+	errs.PositiveID("user_id", x.GetUserId())
+	if x.GetRole() == PlatformRole_PLATFORM_ROLE_UNSPECIFIED {
+		errs.Add("role", "must be specified")
+	}
+
+	return errs.Err()
+}
+
+func (x *PreRegisterUserRequest) Validate() error {
+	var errs validation.Errors
+
+	email := strings.ToLower(strings.TrimSpace(x.GetEmail()))
+	if errs.Email("email", email) && !strings.HasSuffix(email, preRegisterEmailDomain) {
+		errs.Add("email", "must be an "+preRegisterEmailDomain+" address")
+	}
+	if role := x.GetPlatformRole(); role != PlatformRole_PLATFORM_ROLE_STAFF && role != PlatformRole_PLATFORM_ROLE_ADMIN {
+		errs.Add("platform_role", "must be STAFF or ADMIN")
+	}
+
+	return errs.Err()
+}
+
+func (x *RevokeUserSessionRequest) Validate() error {
+	var errs validation.Errors
+
+	errs.NonEmpty("session_id", x.GetSessionId())
+
+	return errs.Err()
+}
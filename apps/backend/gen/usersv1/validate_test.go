@@ -0,0 +1,62 @@
+package usersv1
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/studyverse/ems-backend/internal/validation"
+	"google.golang.org/protobuf/proto"
+)
+
+// violatedFields lists the fields err reports, nil when err is nil
+func violatedFields(t *testing.T, err error) []string {
+	t.Helper()
+	if err == nil {
+		return nil
+	}
+	errs, ok := err.(validation.Errors)
+	if !ok {
+		t.Fatalf("Validate() returned %T, want validation.Errors", err)
+	}
+	fields := make([]string, len(errs))
+	for i, v := range errs {
+		fields[i] = v.Field
+	}
+	return fields
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		req  validation.Validator
+		want []string
+	}{
+		{"create user", &CreateUserRequest{Username: "aru", Email: "aru@example.com", Password: "secret"}, nil},
+		{"create user with nothing", &CreateUserRequest{}, []string{"username", "email", "password"}},
+		{"create user with a long name", &CreateUserRequest{Username: strings.Repeat("a", maxUsernameLength+1), Email: "aru@example.com", Password: "secret"}, []string{"username"}},
+
+		{"update user with only an ID", &UpdateUserRequest{Id: 1}, nil},
+		{"update user with bad fields", &UpdateUserRequest{Username: proto.String(""), Email: proto.String("aru")}, []string{"id", "username", "email"}},
+
+		{"delete user without an ID", &DeleteUserAndCleanupRequest{}, []string{"user_id"}},
+
+		{"assign role", &AssignPlatformRoleRequest{UserId: 1, Role: PlatformRole_PLATFORM_ROLE_STAFF}, nil},
+		{"assign an unspecified role", &AssignPlatformRoleRequest{UserId: 1}, []string{"role"}},
+
+		{"pre-register staff", &PreRegisterUserRequest{Email: " Staff@AstanaIT.edu.kz ", PlatformRole: PlatformRole_PLATFORM_ROLE_STAFF}, nil},
+		{"pre-register outside the university", &PreRegisterUserRequest{Email: "staff@example.com", PlatformRole: PlatformRole_PLATFORM_ROLE_ADMIN}, []string{"email"}},
+		{"pre-register a regular user", &PreRegisterUserRequest{Email: "staff@astanait.edu.kz", PlatformRole: PlatformRole_PLATFORM_ROLE_USER}, []string{"platform_role"}},
+
+		{"revoke a session", &RevokeUserSessionRequest{SessionId: "abc"}, nil},
+		{"revoke without a session", &RevokeUserSessionRequest{SessionId: " "}, []string{"session_id"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := violatedFields(t, tt.req.Validate()); !slices.Equal(got, tt.want) {
+				t.Errorf("Validate() violations = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
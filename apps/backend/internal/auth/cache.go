@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"sync"
+	"time"
+
+	ory "github.com/ory/kratos-client-go"
+)
+
+// sessionCachePurgeInterval is how often Run drops expired entries
+const sessionCachePurgeInterval = time.Minute
+
+// SessionCache remembers validated Kratos sessions for a short TTL, so
+// repeated requests with the same credentials skip /sessions/whoami.
+// A session revoked in Kratos stays usable here until its entry expires,
+// unless the revoke goes through PurgeSession or PurgeIdentity.
+type SessionCache struct {
+	ttl     time.Duration
+	entries sync.Map // [sha256.Size]byte -> sessionCacheEntry
+}
+
+type sessionCacheEntry struct {
+	session   *ory.Session
+	expiresAt time.Time
+}
+
+// NewSessionCache creates a cache keeping sessions for at most ttl
+func NewSessionCache(ttl time.Duration) *SessionCache {
+	return &SessionCache{ttl: ttl}
+}
+
+// sessionCacheKey hashes the credentials so raw tokens aren't kept in memory
+func sessionCacheKey(cookie, sessionToken string) [sha256.Size]byte {
+	return sha256.Sum256([]byte(cookie + "\x00" + sessionToken))
+}
+
+// Get returns the cached session for the credentials, if still fresh
+func (c *SessionCache) Get(cookie, sessionToken string) (*ory.Session, bool) {
+	key := sessionCacheKey(cookie, sessionToken)
+	v, ok := c.entries.Load(key)
+	if !ok {
+		return nil, false
+	}
+	entry := v.(sessionCacheEntry)
+	if !time.Now().Before(entry.expiresAt) {
+		c.entries.Delete(key)
+		return nil, false
+	}
+	return entry.session, true
+}
+
+// Put caches a session for the TTL, or until the session itself expires
+func (c *SessionCache) Put(cookie, sessionToken string, session *ory.Session) {
+	expiresAt := time.Now().Add(c.ttl)
+	if session.ExpiresAt != nil && session.ExpiresAt.Before(expiresAt) {
+		expiresAt = *session.ExpiresAt
+	}
+	c.entries.Store(sessionCacheKey(cookie, sessionToken), sessionCacheEntry{session: session, expiresAt: expiresAt})
+}
+
+// PurgeSession drops the cached entries of a revoked session. It is a no-op
+// on a nil cache.
+func (c *SessionCache) PurgeSession(sessionID string) {
+	c.purge(func(session *ory.Session) bool { return session.Id == sessionID })
+}
+
+// PurgeIdentity drops the cached sessions of an identity, e.g. after all its
+// sessions were revoked or it was deleted. It is a no-op on a nil cache.
+func (c *SessionCache) PurgeIdentity(identityID string) {
+	c.purge(func(session *ory.Session) bool {
+		return session.Identity != nil && session.Identity.Id == identityID
+	})
+}
+
+// purge drops the entries whose session matches. Revokes are rare, so a
+// scan is cheaper than keeping a second index up to date.
+func (c *SessionCache) purge(match func(*ory.Session) bool) {
+	if c == nil {
+		return
+	}
+	c.entries.Range(func(key, v any) bool {
+		if match(v.(sessionCacheEntry).session) {
+			c.entries.Delete(key)
+		}
+		return true
+	})
+}
+
+// Run purges expired entries every minute until ctx is cancelled
+func (c *SessionCache) Run(ctx context.Context) {
+	ticker := time.NewTicker(sessionCachePurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			c.entries.Range(func(key, v any) bool {
+				if !now.Before(v.(sessionCacheEntry).expiresAt) {
+					c.entries.Delete(key)
+				}
+				return true
+			})
+		}
+	}
+}
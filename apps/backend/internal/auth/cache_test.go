@@ -0,0 +1,143 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeKratos answers /sessions/whoami with a session for any credentials
+// except the token "invalid", and counts the calls
+func fakeKratos(t *testing.T) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/sessions/whoami" {
+			http.NotFound(w, r)
+			return
+		}
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("X-Session-Token") == "invalid" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":{"code":401,"message":"no valid session"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{
+			"id": "session-1",
+			"active": true,
+			"identity": {
+				"id": "identity-1",
+				"schema_id": "default",
+				"schema_url": "http://kratos/schemas/default",
+				"traits": {"email": "student@example.com"}
+			}
+		}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+// serve runs one request with the session token through the middleware and
+// returns the user ID the handler saw
+func serve(middleware func(http.Handler) http.Handler, token string) string {
+	var userID string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID = GetUserID(r.Context())
+	})
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("X-Session-Token", token)
+	middleware(next).ServeHTTP(httptest.NewRecorder(), req)
+	return userID
+}
+
+func TestMiddlewareCachesSessions(t *testing.T) {
+	server, calls := fakeKratos(t)
+	middleware := NewMiddleware(NewKratosClient(server.URL), NewSessionCache(time.Minute))
+
+	for i := 0; i < 3; i++ {
+		if got := serve(middleware, "token-a"); got != "identity-1" {
+			t.Fatalf("request %d user ID = %q, want identity-1", i+1, got)
+		}
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("Kratos called %d times for repeated requests, want 1", got)
+	}
+
+	serve(middleware, "token-b")
+	if got := calls.Load(); got != 2 {
+		t.Errorf("Kratos called %d times after new credentials, want 2", got)
+	}
+}
+
+func TestMiddlewareDoesNotCacheFailures(t *testing.T) {
+	server, calls := fakeKratos(t)
+	middleware := NewMiddleware(NewKratosClient(server.URL), NewSessionCache(time.Minute))
+
+	for i := 0; i < 2; i++ {
+		if got := serve(middleware, "invalid"); got != "" {
+			t.Fatalf("invalid session authenticated as %q", got)
+		}
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("Kratos called %d times, want failures rechecked every time", got)
+	}
+}
+
+func TestMiddlewareWithoutCache(t *testing.T) {
+	server, calls := fakeKratos(t)
+	middleware := NewMiddleware(NewKratosClient(server.URL), nil)
+
+	serve(middleware, "token-a")
+	serve(middleware, "token-a")
+	if got := calls.Load(); got != 2 {
+		t.Errorf("Kratos called %d times without a cache, want 2", got)
+	}
+}
+
+func TestSessionCachePurge(t *testing.T) {
+	tests := []struct {
+		name  string
+		purge func(*SessionCache)
+	}{
+		{"by session", func(c *SessionCache) { c.PurgeSession("session-1") }},
+		{"by identity", func(c *SessionCache) { c.PurgeIdentity("identity-1") }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, calls := fakeKratos(t)
+			cache := NewSessionCache(time.Minute)
+			middleware := NewMiddleware(NewKratosClient(server.URL), cache)
+
+			serve(middleware, "token-a")
+			tt.purge(cache)
+			serve(middleware, "token-a")
+			if got := calls.Load(); got != 2 {
+				t.Errorf("Kratos called %d times, want the purged session revalidated", got)
+			}
+		})
+	}
+}
+
+func TestSessionCachePurgeKeepsOthers(t *testing.T) {
+	server, calls := fakeKratos(t)
+	cache := NewSessionCache(time.Minute)
+	middleware := NewMiddleware(NewKratosClient(server.URL), cache)
+
+	serve(middleware, "token-a")
+	cache.PurgeSession("session-2")
+	cache.PurgeIdentity("identity-2")
+	serve(middleware, "token-a")
+	if got := calls.Load(); got != 1 {
+		t.Errorf("Kratos called %d times, want unrelated purges to keep the entry", got)
+	}
+}
+
+func TestSessionCachePurgeNil(t *testing.T) {
+	var cache *SessionCache
+	cache.PurgeSession("session-1")
+	cache.PurgeIdentity("identity-1")
+}
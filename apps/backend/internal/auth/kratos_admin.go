@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"context"
+
+	ory "github.com/ory/kratos-client-go"
+)
+
+// KratosAdminClient talks to the Kratos admin API, which manages identities
+// directly and must never be exposed to end users
+type KratosAdminClient struct {
+	*ory.APIClient
+}
+
+// NewKratosAdminClient creates a new Ory Kratos admin API client
+func NewKratosAdminClient(kratosAdminURL string) *KratosAdminClient {
+	config := ory.NewConfiguration()
+	config.Servers = ory.ServerConfigurations{
+		{URL: kratosAdminURL},
+	}
+	return &KratosAdminClient{APIClient: ory.NewAPIClient(config)}
+}
+
+// DeleteIdentity permanently deletes an identity and all of its sessions
+func (c *KratosAdminClient) DeleteIdentity(ctx context.Context, identityID string) error {
+	_, err := c.IdentityAPI.DeleteIdentity(ctx, identityID).Execute()
+	return err
+}
+
+// GetIdentity fetches an identity including its traits
+func (c *KratosAdminClient) GetIdentity(ctx context.Context, identityID string) (*ory.Identity, error) {
+	identity, _, err := c.IdentityAPI.GetIdentity(ctx, identityID).Execute()
+	return identity, err
+}
+
+// ListIdentitySessions lists the sessions of an identity, active and inactive
+func (c *KratosAdminClient) ListIdentitySessions(ctx context.Context, identityID string) ([]ory.Session, error) {
+	sessions, _, err := c.IdentityAPI.ListIdentitySessions(ctx, identityID).Execute()
+	return sessions, err
+}
+
+// DisableSession revokes a single session
+func (c *KratosAdminClient) DisableSession(ctx context.Context, sessionID string) error {
+	_, err := c.IdentityAPI.DisableSession(ctx, sessionID).Execute()
+	return err
+}
@@ -30,7 +30,10 @@ const (
 //
 // If the user is not authenticated, the request proceeds without user context
 // (public endpoints still work; protected endpoints should call RequireAuth).
-func NewMiddleware(kratosClient *ory.APIClient) func(http.Handler) http.Handler {
+//
+// Valid sessions are kept in cache when it is non-nil; failed validations
+// are not cached.
+func NewMiddleware(kratosClient *ory.APIClient, cache *SessionCache) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			ctx := r.Context()
@@ -49,26 +52,38 @@ func NewMiddleware(kratosClient *ory.APIClient) func(http.Handler) http.Handler
 
 			slog.Debug("Auth middleware", "hasCookie", cookie != "", "hasToken", sessionToken != "", "path", r.URL.Path)
 
-			toSession := kratosClient.FrontendAPI.ToSession(ctx)
-			if cookie != "" {
-				toSession = toSession.Cookie(cookie)
-			}
-			if sessionToken != "" {
-				toSession = toSession.XSessionToken(sessionToken)
+			var session *ory.Session
+			var cached bool
+			if cache != nil {
+				session, cached = cache.Get(cookie, sessionToken)
 			}
+			if !cached {
+				toSession := kratosClient.FrontendAPI.ToSession(ctx)
+				if cookie != "" {
+					toSession = toSession.Cookie(cookie)
+				}
+				if sessionToken != "" {
+					toSession = toSession.XSessionToken(sessionToken)
+				}
 
-			session, _, err := toSession.Execute()
-			if err != nil {
-				// Treat validation failures as anonymous (not hard-fail middleware).
-				// Caller can decide whether endpoint requires auth.
-				slog.Debug("No valid session", "error", err)
-				next.ServeHTTP(w, r)
-				return
-			}
+				var err error
+				session, _, err = toSession.Execute()
+				if err != nil {
+					// Treat validation failures as anonymous (not hard-fail middleware).
+					// Caller can decide whether endpoint requires auth.
+					slog.Debug("No valid session", "error", err)
+					next.ServeHTTP(w, r)
+					return
+				}
 
-			if session.Identity == nil {
-				next.ServeHTTP(w, r)
-				return
+				if session.Identity == nil {
+					next.ServeHTTP(w, r)
+					return
+				}
+
+				if cache != nil {
+					cache.Put(cookie, sessionToken, session)
+				}
 			}
 
 			ctx = context.WithValue(ctx, UserIDKey, session.Identity.Id)
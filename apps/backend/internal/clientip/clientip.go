@@ -0,0 +1,116 @@
+// Package clientip resolves the originating client address of a request that
+// may have passed through reverse proxies.
+package clientip
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+const forwardedForHeader = "X-Forwarded-For"
+
+type contextKey struct{}
+
+// Resolver trusts X-Forwarded-For only when the request arrives from one of
+// the configured proxy networks, so clients can't pick their own address.
+type Resolver struct {
+	trusted []netip.Prefix
+}
+
+// NewResolver parses the trusted proxies, given as CIDRs such as
+// "10.0.0.0/8" or bare IPs. No entries means X-Forwarded-For is ignored.
+func NewResolver(proxies []string) (*Resolver, error) {
+	r := &Resolver{}
+	for _, proxy := range proxies {
+		prefix, err := netip.ParsePrefix(proxy)
+		if err != nil {
+			addr, addrErr := netip.ParseAddr(proxy)
+			if addrErr != nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q: %w", proxy, err)
+			}
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		r.trusted = append(r.trusted, prefix.Masked())
+	}
+	return r, nil
+}
+
+// ClientIP returns the address of the client. When the peer is a trusted
+// proxy, X-Forwarded-For is walked from the right and the first address not
+// belonging to a trusted proxy wins; entries to its left are client-supplied
+// and ignored.
+func (r *Resolver) ClientIP(remoteAddr string, header http.Header) string {
+	host := hostOnly(remoteAddr)
+	if !r.isTrusted(host) {
+		return host
+	}
+
+	var hops []string
+	for _, value := range header.Values(forwardedForHeader) {
+		for _, hop := range strings.Split(value, ",") {
+			if hop = strings.TrimSpace(hop); hop != "" {
+				hops = append(hops, hop)
+			}
+		}
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := hostOnly(hops[i])
+		if _, err := netip.ParseAddr(hop); err != nil {
+			// A garbled hop can't be attributed, stop at the last proxy
+			return host
+		}
+		if !r.isTrusted(hop) {
+			return hop
+		}
+		host = hop
+	}
+	return host
+}
+
+func (r *Resolver) isTrusted(host string) bool {
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range r.trusted {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Middleware stores the resolved client IP in the request context
+func (r *Resolver) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ip := r.ClientIP(req.RemoteAddr, req.Header)
+		next.ServeHTTP(w, req.WithContext(WithClientIP(req.Context(), ip)))
+	})
+}
+
+// WithClientIP returns a copy of ctx carrying the given client IP
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, contextKey{}, ip)
+}
+
+// FromContext extracts the client IP from the context
+// Returns empty string if the middleware didn't run
+func FromContext(ctx context.Context) string {
+	if ip, ok := ctx.Value(contextKey{}).(string); ok {
+		return ip
+	}
+	return ""
+}
+
+// hostOnly strips the port, if any, from an address
+func hostOnly(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return strings.Trim(addr, "[]")
+}
@@ -0,0 +1,63 @@
+package clientip
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestClientIP(t *testing.T) {
+	resolver, err := NewResolver([]string{"10.0.0.0/8", "192.168.1.5"})
+	if err != nil {
+		t.Fatalf("NewResolver() error = %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor []string
+		want         string
+	}{
+		{"untrusted peer ignores header", "203.0.113.7:5000", []string{"1.2.3.4"}, "203.0.113.7"},
+		{"trusted peer without header", "10.1.2.3:5000", nil, "10.1.2.3"},
+		{"trusted peer uses forwarded client", "10.1.2.3:5000", []string{"198.51.100.9"}, "198.51.100.9"},
+		{"spoofed leftmost entry is ignored", "10.1.2.3:5000", []string{"1.2.3.4, 198.51.100.9"}, "198.51.100.9"},
+		{"trusted hops are skipped", "10.1.2.3:5000", []string{"198.51.100.9, 192.168.1.5, 10.9.9.9"}, "198.51.100.9"},
+		{"multiple header lines", "10.1.2.3:5000", []string{"1.2.3.4", "198.51.100.9"}, "198.51.100.9"},
+		{"all hops trusted returns leftmost", "10.1.2.3:5000", []string{"10.0.0.2, 10.0.0.3"}, "10.0.0.2"},
+		{"garbled hop stops at last proxy", "10.1.2.3:5000", []string{"198.51.100.9, not-an-ip"}, "10.1.2.3"},
+		{"bare IP proxy entry", "192.168.1.5:80", []string{"198.51.100.9"}, "198.51.100.9"},
+		{"IPv6 peer", "[2001:db8::1]:443", []string{"1.2.3.4"}, "2001:db8::1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			for _, v := range tt.forwardedFor {
+				header.Add(forwardedForHeader, v)
+			}
+			if got := resolver.ClientIP(tt.remoteAddr, header); got != tt.want {
+				t.Errorf("ClientIP(%q, %v) = %q, want %q", tt.remoteAddr, tt.forwardedFor, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewResolverRejectsInvalidProxy(t *testing.T) {
+	if _, err := NewResolver([]string{"10.0.0.0/33"}); err == nil {
+		t.Error("NewResolver() accepted an invalid CIDR")
+	}
+	if _, err := NewResolver([]string{"proxy.internal"}); err == nil {
+		t.Error("NewResolver() accepted a hostname")
+	}
+}
+
+func TestClientIPWithoutTrustedProxies(t *testing.T) {
+	resolver, err := NewResolver(nil)
+	if err != nil {
+		t.Fatalf("NewResolver() error = %v", err)
+	}
+	header := http.Header{forwardedForHeader: []string{"1.2.3.4"}}
+	if got := resolver.ClientIP("203.0.113.7:5000", header); got != "203.0.113.7" {
+		t.Errorf("ClientIP() = %q, want peer address", got)
+	}
+}
@@ -0,0 +1,170 @@
+package config
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadDefaults(t *testing.T) {
+	for _, key := range []string{"PORT", "BASE_URL", "ADMIN_SECRET", "MAX_PAGE_SIZE", "CORS_ORIGINS"} {
+		t.Setenv(key, "")
+	}
+
+	cfg := Load()
+	if cfg.Port != "5555" {
+		t.Errorf("Port = %q, want 5555", cfg.Port)
+	}
+	if cfg.BaseURL != "http://localhost:5555" {
+		t.Errorf("BaseURL = %q, want http://localhost:5555", cfg.BaseURL)
+	}
+	if cfg.AdminSecret != "" {
+		t.Errorf("AdminSecret = %q, want the admin endpoints disabled by default", cfg.AdminSecret)
+	}
+	if cfg.MaxPageSize != 200 {
+		t.Errorf("MaxPageSize = %d, want 200", cfg.MaxPageSize)
+	}
+	if len(cfg.CORSOrigins) != 3 {
+		t.Errorf("CORSOrigins = %q, want the three local frontends", cfg.CORSOrigins)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Setenv("PORT", "8080")
+	t.Setenv("BASE_URL", "https://ems.example.com/")
+	t.Setenv("ADMIN_SECRET", "s3cret")
+	t.Setenv("MAX_PAGE_SIZE", "0")
+	t.Setenv("CORS_ORIGINS", " https://ems.example.com, ,*.example.com ")
+
+	cfg := Load()
+	if cfg.Port != "8080" {
+		t.Errorf("Port = %q, want 8080", cfg.Port)
+	}
+	if cfg.BaseURL != "https://ems.example.com" {
+		t.Errorf("BaseURL = %q, want the trailing slash trimmed", cfg.BaseURL)
+	}
+	if cfg.AdminSecret != "s3cret" {
+		t.Errorf("AdminSecret = %q, want it read from ADMIN_SECRET", cfg.AdminSecret)
+	}
+	if cfg.MaxPageSize != 1 {
+		t.Errorf("MaxPageSize = %d, want it raised to 1", cfg.MaxPageSize)
+	}
+	if len(cfg.CORSOrigins) != 2 || cfg.CORSOrigins[0] != "https://ems.example.com" || cfg.CORSOrigins[1] != "*.example.com" {
+		t.Errorf("CORSOrigins = %q, want trimmed entries without blanks", cfg.CORSOrigins)
+	}
+}
+
+func TestLoadServerTimeouts(t *testing.T) {
+	tests := []struct {
+		name                          string
+		read, write, idle             string
+		wantRead, wantWrite, wantIdle time.Duration
+	}{
+		{"defaults", "", "", "", 30 * time.Second, 30 * time.Second, 120 * time.Second},
+		{"durations", "5s", "2m", "1h30m", 5 * time.Second, 2 * time.Minute, 90 * time.Minute},
+		{"invalid durations fall back", "5", "soon", "-", 30 * time.Second, 30 * time.Second, 120 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("SERVER_READ_TIMEOUT", tt.read)
+			t.Setenv("SERVER_WRITE_TIMEOUT", tt.write)
+			t.Setenv("SERVER_IDLE_TIMEOUT", tt.idle)
+
+			cfg := Load()
+			if cfg.ServerReadTimeout != tt.wantRead {
+				t.Errorf("ServerReadTimeout = %v, want %v", cfg.ServerReadTimeout, tt.wantRead)
+			}
+			if cfg.ServerWriteTimeout != tt.wantWrite {
+				t.Errorf("ServerWriteTimeout = %v, want %v", cfg.ServerWriteTimeout, tt.wantWrite)
+			}
+			if cfg.ServerIdleTimeout != tt.wantIdle {
+				t.Errorf("ServerIdleTimeout = %v, want %v", cfg.ServerIdleTimeout, tt.wantIdle)
+			}
+		})
+	}
+}
+
+func TestLoadSpiceDBTLS(t *testing.T) {
+	tests := []struct {
+		name, insecure, skipVerify   string
+		wantInsecure, wantSkipVerify bool
+	}{
+		{"verified TLS by default", "", "", false, false},
+		{"plaintext", "true", "", true, false},
+		{"skip verification", "", "1", false, true},
+		{"invalid values keep the defaults", "maybe", "yes", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("SPICEDB_INSECURE", tt.insecure)
+			t.Setenv("SPICEDB_SKIP_VERIFY_CA", tt.skipVerify)
+
+			cfg := Load()
+			if cfg.SpiceDBInsecure != tt.wantInsecure {
+				t.Errorf("SpiceDBInsecure = %v, want %v", cfg.SpiceDBInsecure, tt.wantInsecure)
+			}
+			if cfg.SpiceDBSkipVerifyCA != tt.wantSkipVerify {
+				t.Errorf("SpiceDBSkipVerifyCA = %v, want %v", cfg.SpiceDBSkipVerifyCA, tt.wantSkipVerify)
+			}
+		})
+	}
+}
+
+func TestValidateWarnsOnSkipVerifyCA(t *testing.T) {
+	tests := []struct {
+		name                 string
+		insecure, skipVerify bool
+		wantWarning          bool
+	}{
+		{"verified TLS", false, false, false},
+		{"plaintext", true, false, false},
+		{"plaintext ignores skip verification", true, true, false},
+		{"TLS without verification", false, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var logs bytes.Buffer
+			previous := slog.Default()
+			slog.SetDefault(slog.New(slog.NewTextHandler(&logs, nil)))
+			t.Cleanup(func() { slog.SetDefault(previous) })
+
+			cfg := &Config{SpiceDBInsecure: tt.insecure, SpiceDBSkipVerifyCA: tt.skipVerify, RegistrationLinkSecret: "secret"}
+			cfg.Validate()
+			if got := strings.Contains(logs.String(), "SkipVerifyCA"); got != tt.wantWarning {
+				t.Errorf("warned = %v, want %v; logs:\n%s", got, tt.wantWarning, logs.String())
+			}
+		})
+	}
+}
+
+func TestParseLogLevel(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    slog.Level
+		wantErr bool
+	}{
+		{"debug", slog.LevelDebug, false},
+		{"INFO", slog.LevelInfo, false},
+		{"Warn", slog.LevelWarn, false},
+		{"error", slog.LevelError, false},
+		{"verbose", 0, true},
+		{"", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseLogLevel(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseLogLevel(%q) error = %v, want error %v", tt.in, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("ParseLogLevel(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+// Package cors answers cross-origin requests from the configured origins.
+package cors
+
+import (
+	"net"
+	"net/http"
+	"strings"
+)
+
+// Matcher decides whether an Origin header is allowed. Entries are exact
+// origins such as "https://admin.example.com", wildcard subdomain patterns
+// such as "*.example.com", or "*" for any origin.
+type Matcher struct {
+	any      bool
+	exact    map[string]bool
+	suffixes []string // ".example.com" for "*.example.com"
+}
+
+// NewMatcher parses the allowed origins. No entries allows any origin.
+func NewMatcher(origins []string) *Matcher {
+	m := &Matcher{any: len(origins) == 0, exact: make(map[string]bool)}
+	for _, origin := range origins {
+		switch {
+		case origin == "*":
+			m.any = true
+		case strings.HasPrefix(origin, "*."):
+			m.suffixes = append(m.suffixes, origin[1:])
+		default:
+			m.exact[origin] = true
+		}
+	}
+	return m
+}
+
+// Allowed reports whether origin matches an entry. Wildcard patterns match
+// any scheme and port and only proper subdomains, so "*.example.com" allows
+// "https://admin.example.com:8443" but not "https://example.com".
+func (m *Matcher) Allowed(origin string) bool {
+	if origin == "" {
+		return false
+	}
+	if m.any || m.exact[origin] {
+		return true
+	}
+	_, host, ok := strings.Cut(origin, "://")
+	if !ok {
+		return false
+	}
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	for _, suffix := range m.suffixes {
+		if strings.HasSuffix(host, suffix) && len(host) > len(suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Middleware sets the CORS headers, echoing the Origin when it is allowed,
+// and answers preflight requests itself
+func Middleware(origins []string, next http.Handler) http.Handler {
+	matcher := NewMatcher(origins)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+
+		if matcher.Allowed(origin) {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+		}
+
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Accept, Accept-Language, Content-Type, Content-Language, Authorization, Connect-Protocol-Version, Connect-Timeout-Ms, X-Grpc-Timeout, X-User-Agent, X-Session-Token, X-Request-Id")
+		w.Header().Set("Access-Control-Expose-Headers", "Connect-Content-Encoding, Connect-Timeout-Ms, Grpc-Status, Grpc-Message, Grpc-Status-Details-Bin, X-Request-Id")
+		w.Header().Set("Access-Control-Max-Age", "86400")
+		w.Header().Set("Access-Control-Allow-Credentials", "true")
+
+		// Handle preflight requests
+		if r.Method == http.MethodOptions {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
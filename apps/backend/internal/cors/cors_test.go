@@ -0,0 +1,70 @@
+package cors
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMatcherAllowed(t *testing.T) {
+	tests := []struct {
+		name    string
+		origins []string
+		origin  string
+		want    bool
+	}{
+		{"exact match", []string{"http://localhost:5173"}, "http://localhost:5173", true},
+		{"exact needs the same port", []string{"http://localhost:5173"}, "http://localhost:6868", false},
+		{"exact needs the same scheme", []string{"https://admin.example.com"}, "http://admin.example.com", false},
+		{"star allows anything", []string{"*"}, "https://evil.test", true},
+		{"no entries allow anything", nil, "https://evil.test", true},
+		{"wildcard subdomain", []string{"*.example.com"}, "https://admin.example.com", true},
+		{"wildcard nested subdomain", []string{"*.example.com"}, "https://a.b.example.com", true},
+		{"wildcard with a port", []string{"*.example.com"}, "https://admin.example.com:8443", true},
+		{"wildcard excludes the apex", []string{"*.example.com"}, "https://example.com", false},
+		{"wildcard excludes the apex with a port", []string{"*.example.com"}, "https://example.com:8443", false},
+		{"wildcard needs a dot boundary", []string{"*.example.com"}, "https://evilexample.com", false},
+		{"wildcard suffix in the port doesn't count", []string{"*.example.com"}, "https://evil.test:.example.com", false},
+		{"non-matching origin", []string{"https://admin.example.com", "*.example.org"}, "https://evil.test", false},
+		{"origin without a scheme", []string{"*.example.com"}, "admin.example.com", false},
+		{"empty origin", []string{"*"}, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewMatcher(tt.origins).Allowed(tt.origin); got != tt.want {
+				t.Errorf("NewMatcher(%q).Allowed(%q) = %v, want %v", tt.origins, tt.origin, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	var reached bool
+	handler := Middleware([]string{"*.example.com"}, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reached = true
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	req.Header.Set("Origin", "https://admin.example.com")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "https://admin.example.com" {
+		t.Errorf("allowed origin echoed as %q", got)
+	}
+	if !reached {
+		t.Error("request not passed on")
+	}
+
+	reached = false
+	req = httptest.NewRequest(http.MethodOptions, "/", nil)
+	req.Header.Set("Origin", "https://evil.test")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "" {
+		t.Errorf("disallowed origin echoed as %q", got)
+	}
+	if rec.Code != http.StatusNoContent || reached {
+		t.Errorf("preflight status = %d, reached handler = %v, want 204 answered by the middleware", rec.Code, reached)
+	}
+}
@@ -0,0 +1,30 @@
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// UnlimitedSlots is returned by CountAvailableSlots for events without a capacity
+const UnlimitedSlots int32 = -1
+
+// CountAvailableSlots returns how many seats are left on an event after
+// subtracting active registrations and unexpired holds, or UnlimitedSlots.
+// Callers that act on the result should hold the LockEventForRegistration lock.
+func (q *Queries) CountAvailableSlots(ctx context.Context, eventID int32) (int32, error) {
+	usage, err := q.GetEventCapacityUsage(ctx, eventID)
+	if err != nil {
+		return 0, err
+	}
+	return availableSlots(usage.Capacity, usage.Registered, usage.Held), nil
+}
+
+// availableSlots is the capacity left after registrations and holds, never
+// negative, or UnlimitedSlots without a capacity
+func availableSlots(capacity pgtype.Int4, registered, held int32) int32 {
+	if !capacity.Valid {
+		return UnlimitedSlots
+	}
+	return max(capacity.Int32-registered-held, 0)
+}
@@ -0,0 +1,32 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestAvailableSlots(t *testing.T) {
+	tests := []struct {
+		name       string
+		capacity   pgtype.Int4
+		registered int32
+		held       int32
+		want       int32
+	}{
+		{"no capacity is unlimited", pgtype.Int4{}, 500, 20, UnlimitedSlots},
+		{"empty event", pgtype.Int4{Int32: 10, Valid: true}, 0, 0, 10},
+		{"holds count against capacity", pgtype.Int4{Int32: 10, Valid: true}, 4, 3, 3},
+		{"exactly full", pgtype.Int4{Int32: 10, Valid: true}, 7, 3, 0},
+		{"overbooked never goes negative", pgtype.Int4{Int32: 10, Valid: true}, 9, 5, 0},
+		{"zero capacity", pgtype.Int4{Int32: 0, Valid: true}, 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := availableSlots(tt.capacity, tt.registered, tt.held); got != tt.want {
+				t.Errorf("availableSlots(%v, %d, %d) = %d, want %d", tt.capacity, tt.registered, tt.held, got, tt.want)
+			}
+		})
+	}
+}
@@ -4,15 +4,57 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
-func NewPool(ctx context.Context, databaseURL string) (*pgxpool.Pool, error) {
+// PoolConfig tunes the connection pool. Zero values keep the pgx defaults,
+// except StatementTimeout, where zero leaves statements unbounded.
+type PoolConfig struct {
+	MaxConns         int32
+	MinConns         int32
+	MaxConnIdleTime  time.Duration
+	MaxConnLifetime  time.Duration
+	StatementTimeout time.Duration
+}
+
+func NewPool(ctx context.Context, databaseURL string, poolCfg PoolConfig) (*pgxpool.Pool, error) {
 	config, err := pgxpool.ParseConfig(databaseURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse database URL: %w", err)
 	}
+	config.ConnConfig.Tracer = queryTracer{}
+
+	if poolCfg.MaxConns > 0 {
+		config.MaxConns = poolCfg.MaxConns
+	}
+	if poolCfg.MinConns > 0 {
+		config.MinConns = poolCfg.MinConns
+	}
+	if poolCfg.MaxConnIdleTime > 0 {
+		config.MaxConnIdleTime = poolCfg.MaxConnIdleTime
+	}
+	if poolCfg.MaxConnLifetime > 0 {
+		config.MaxConnLifetime = poolCfg.MaxConnLifetime
+	}
+	if poolCfg.StatementTimeout > 0 {
+		// Set once per connection, the setting sticks for the session
+		timeoutMs := poolCfg.StatementTimeout.Milliseconds()
+		config.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
+			_, err := conn.Exec(ctx, fmt.Sprintf("SET statement_timeout = %d", timeoutMs))
+			return err
+		}
+	}
+
+	slog.Info("Database pool configured",
+		"maxConns", config.MaxConns,
+		"minConns", config.MinConns,
+		"maxConnIdleTime", config.MaxConnIdleTime,
+		"maxConnLifetime", config.MaxConnLifetime,
+		"statementTimeout", poolCfg.StatementTimeout,
+	)
 
 	pool, err := pgxpool.NewWithConfig(ctx, config)
 	if err != nil {
@@ -25,6 +25,77 @@ func (q *Queries) AddEventTag(ctx context.Context, arg AddEventTagParams) error
 	return err
 }
 
+const addEventTags = `-- name: AddEventTags :exec
+INSERT INTO event_tags (event_id, tag_id)
+SELECT $1, unnest($2::int[])
+`
+
+type AddEventTagsParams struct {
+	EventID int32   `json:"event_id"`
+	TagIds  []int32 `json:"tag_ids"`
+}
+
+func (q *Queries) AddEventTags(ctx context.Context, arg AddEventTagsParams) error {
+	_, err := q.db.Exec(ctx, addEventTags, arg.EventID, arg.TagIds)
+	return err
+}
+
+const allocateEventIDs = `-- name: AllocateEventIDs :many
+SELECT nextval(pg_get_serial_sequence('events', 'id'))::int AS id
+FROM generate_series(1, $1::int)
+`
+
+// Reserves IDs for BulkInsertEvents, which can't return the rows it copies
+func (q *Queries) AllocateEventIDs(ctx context.Context, count int32) ([]int32, error) {
+	rows, err := q.db.Query(ctx, allocateEventIDs, count)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+type BulkInsertEventTagsParams struct {
+	EventID int32 `json:"event_id"`
+	TagID   int32 `json:"tag_id"`
+}
+
+type BulkInsertEventsParams struct {
+	ID             int32              `json:"id"`
+	Title          string             `json:"title"`
+	Description    string             `json:"description"`
+	ImageUrl       pgtype.Text        `json:"image_url"`
+	UserID         int32              `json:"user_id"`
+	OrganizationID int32              `json:"organization_id"`
+	Location       string             `json:"location"`
+	StartTime      pgtype.Timestamptz `json:"start_time"`
+	EndTime        pgtype.Timestamptz `json:"end_time"`
+	Format         NullFormat         `json:"format"`
+	Capacity       pgtype.Int4        `json:"capacity"`
+}
+
+const countDeletedEvents = `-- name: CountDeletedEvents :one
+SELECT COUNT(*) FROM events WHERE is_deleted
+`
+
+func (q *Queries) CountDeletedEvents(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, countDeletedEvents)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countEvents = `-- name: CountEvents :one
 SELECT COUNT(DISTINCT e.id)
 FROM events e
@@ -32,17 +103,48 @@ LEFT JOIN event_tags et ON et.event_id = e.id
 WHERE 
     ($1::int IS NULL OR e.user_id = $1) AND
     ($2::int IS NULL OR e.organization_id = $2) AND
-    ($3::int[] IS NULL OR et.tag_id = ANY($3::int[]))
+    ($3::int[] IS NULL OR et.tag_id = ANY($3::int[])) AND
+    (e.published OR $4::bool) AND
+    NOT e.is_deleted
 `
 
 type CountEventsParams struct {
-	UserID         pgtype.Int4 `json:"user_id"`
-	OrganizationID pgtype.Int4 `json:"organization_id"`
-	TagIds         []int32     `json:"tag_ids"`
+	UserID             pgtype.Int4 `json:"user_id"`
+	OrganizationID     pgtype.Int4 `json:"organization_id"`
+	TagIds             []int32     `json:"tag_ids"`
+	IncludeUnpublished bool        `json:"include_unpublished"`
 }
 
 func (q *Queries) CountEvents(ctx context.Context, arg CountEventsParams) (int64, error) {
-	row := q.db.QueryRow(ctx, countEvents, arg.UserID, arg.OrganizationID, arg.TagIds)
+	row := q.db.QueryRow(ctx, countEvents,
+		arg.UserID,
+		arg.OrganizationID,
+		arg.TagIds,
+		arg.IncludeUnpublished,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countEventsByAllTags = `-- name: CountEventsByAllTags :one
+SELECT COUNT(*)
+FROM events e
+WHERE e.id IN (
+    SELECT event_id FROM event_tags
+    WHERE tag_id = ANY($1::int[])
+    GROUP BY event_id
+    HAVING COUNT(DISTINCT tag_id) = $2::int
+) AND e.published AND NOT e.is_deleted
+`
+
+type CountEventsByAllTagsParams struct {
+	TagIds   []int32 `json:"tag_ids"`
+	TagCount int32   `json:"tag_count"`
+}
+
+func (q *Queries) CountEventsByAllTags(ctx context.Context, arg CountEventsByAllTagsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countEventsByAllTags, arg.TagIds, arg.TagCount)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -59,10 +161,22 @@ func (q *Queries) CountTags(ctx context.Context) (int64, error) {
 	return count, err
 }
 
+const countTagsWithMinEventCount = `-- name: CountTagsWithMinEventCount :one
+SELECT COUNT(*) FROM tags t
+WHERE (SELECT COUNT(DISTINCT et.event_id) FROM event_tags et JOIN events e ON e.id = et.event_id WHERE et.tag_id = t.id AND NOT e.is_deleted) >= $1::int
+`
+
+func (q *Queries) CountTagsWithMinEventCount(ctx context.Context, minEventCount int32) (int64, error) {
+	row := q.db.QueryRow(ctx, countTagsWithMinEventCount, minEventCount)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createEvent = `-- name: CreateEvent :one
-INSERT INTO events (title, description, image_url, user_id, organization_id, location, start_time, end_time, format)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, COALESCE($9::format, 'offline'::format))
-RETURNING id, title, description, image_url, user_id, organization_id, location, start_time, end_time, format, created_at, updated_at
+INSERT INTO events (title, description, image_url, user_id, organization_id, location, start_time, end_time, format, capacity)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, COALESCE($9::format, 'offline'::format), $10)
+RETURNING id, title, description, image_url, user_id, organization_id, location, start_time, end_time, format, created_at, updated_at, capacity, published, is_deleted, deleted_at, version
 `
 
 type CreateEventParams struct {
@@ -75,6 +189,7 @@ type CreateEventParams struct {
 	StartTime      pgtype.Timestamptz `json:"start_time"`
 	EndTime        pgtype.Timestamptz `json:"end_time"`
 	Format         NullFormat         `json:"format"`
+	Capacity       pgtype.Int4        `json:"capacity"`
 }
 
 func (q *Queries) CreateEvent(ctx context.Context, arg CreateEventParams) (Event, error) {
@@ -88,6 +203,7 @@ func (q *Queries) CreateEvent(ctx context.Context, arg CreateEventParams) (Event
 		arg.StartTime,
 		arg.EndTime,
 		arg.Format,
+		arg.Capacity,
 	)
 	var i Event
 	err := row.Scan(
@@ -103,6 +219,11 @@ func (q *Queries) CreateEvent(ctx context.Context, arg CreateEventParams) (Event
 		&i.Format,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Capacity,
+		&i.Published,
+		&i.IsDeleted,
+		&i.DeletedAt,
+		&i.Version,
 	)
 	return i, err
 }
@@ -126,9 +247,14 @@ func (q *Queries) CreateTag(ctx context.Context, name string) (Tag, error) {
 }
 
 const deleteEvent = `-- name: DeleteEvent :exec
-DELETE FROM events WHERE id = $1
+UPDATE events
+SET is_deleted = TRUE,
+    deleted_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1 AND NOT is_deleted
 `
 
+// Soft delete, so registration and attendance history survive
 func (q *Queries) DeleteEvent(ctx context.Context, id int32) error {
 	_, err := q.db.Exec(ctx, deleteEvent, id)
 	return err
@@ -144,7 +270,7 @@ func (q *Queries) DeleteTag(ctx context.Context, id int32) error {
 }
 
 const getEvent = `-- name: GetEvent :one
-SELECT id, title, description, image_url, user_id, organization_id, location, start_time, end_time, format, created_at, updated_at FROM events WHERE id = $1
+SELECT id, title, description, image_url, user_id, organization_id, location, start_time, end_time, format, created_at, updated_at, capacity, published, is_deleted, deleted_at, version FROM events WHERE id = $1 AND NOT is_deleted
 `
 
 func (q *Queries) GetEvent(ctx context.Context, id int32) (Event, error) {
@@ -163,10 +289,232 @@ func (q *Queries) GetEvent(ctx context.Context, id int32) (Event, error) {
 		&i.Format,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Capacity,
+		&i.Published,
+		&i.IsDeleted,
+		&i.DeletedAt,
+		&i.Version,
 	)
 	return i, err
 }
 
+const getEventCalendar = `-- name: GetEventCalendar :many
+WITH days AS (
+    SELECT d::date AS day
+    FROM generate_series(
+        make_date($1::int, $2::int, 1),
+        make_date($1::int, $2::int, 1) + INTERVAL '1 month' - INTERVAL '1 day',
+        INTERVAL '1 day'
+    ) AS d
+), day_events AS (
+    SELECT d.day, e.id, e.title, e.start_time, e.format, e.organization_id,
+        ROW_NUMBER() OVER (PARTITION BY d.day ORDER BY e.start_time, e.id) AS rn
+    FROM days d
+    LEFT JOIN events e ON e.start_time >= d.day
+        AND e.start_time < d.day + 1
+        AND e.published AND NOT e.is_deleted
+        AND ($3::int IS NULL OR e.organization_id = $3)
+)
+SELECT day::date AS day,
+    COUNT(id)::int AS event_count,
+    (ARRAY_AGG(id ORDER BY rn) FILTER (WHERE rn <= 5))::int[] AS event_ids,
+    (ARRAY_AGG(title ORDER BY rn) FILTER (WHERE rn <= 5))::text[] AS event_titles,
+    (ARRAY_AGG(start_time ORDER BY rn) FILTER (WHERE rn <= 5))::timestamptz[] AS event_start_times,
+    (ARRAY_AGG(COALESCE(format::text, '') ORDER BY rn) FILTER (WHERE rn <= 5))::text[] AS event_formats,
+    (ARRAY_AGG(organization_id ORDER BY rn) FILTER (WHERE rn <= 5))::int[] AS event_organization_ids
+FROM day_events
+GROUP BY day
+HAVING COUNT(id) > 0
+ORDER BY day
+`
+
+type GetEventCalendarParams struct {
+	Year           int32       `json:"year"`
+	Month          int32       `json:"month"`
+	OrganizationID pgtype.Int4 `json:"organization_id"`
+}
+
+type GetEventCalendarRow struct {
+	Day                  pgtype.Date          `json:"day"`
+	EventCount           int32                `json:"event_count"`
+	EventIds             []int32              `json:"event_ids"`
+	EventTitles          []string             `json:"event_titles"`
+	EventStartTimes      []pgtype.Timestamptz `json:"event_start_times"`
+	EventFormats         []string             `json:"event_formats"`
+	EventOrganizationIds []int32              `json:"event_organization_ids"`
+}
+
+// One row per day of the month with published events starting on it, in the
+// database time zone. Only the first 5 events of a day are listed.
+func (q *Queries) GetEventCalendar(ctx context.Context, arg GetEventCalendarParams) ([]GetEventCalendarRow, error) {
+	rows, err := q.db.Query(ctx, getEventCalendar, arg.Year, arg.Month, arg.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetEventCalendarRow
+	for rows.Next() {
+		var i GetEventCalendarRow
+		if err := rows.Scan(
+			&i.Day,
+			&i.EventCount,
+			&i.EventIds,
+			&i.EventTitles,
+			&i.EventStartTimes,
+			&i.EventFormats,
+			&i.EventOrganizationIds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getEventCounts = `-- name: GetEventCounts :one
+SELECT
+    (SELECT COUNT(*) FROM event_registrations er
+     WHERE er.event_id = $1 AND er.status = 'registered')::int AS registration_count,
+    (SELECT COUNT(*) FROM event_attendance ea
+     JOIN event_registrations er ON er.id = ea.registration_id
+     WHERE er.event_id = $1 AND ea.status = 'attended')::int AS attendance_count,
+    COALESCE((SELECT e.published AND NOT e.is_deleted FROM events e WHERE e.id = $1), false)::bool AS published
+`
+
+type GetEventCountsRow struct {
+	RegistrationCount int32 `json:"registration_count"`
+	AttendanceCount   int32 `json:"attendance_count"`
+	Published         bool  `json:"published"`
+}
+
+func (q *Queries) GetEventCounts(ctx context.Context, eventID int32) (GetEventCountsRow, error) {
+	row := q.db.QueryRow(ctx, getEventCounts, eventID)
+	var i GetEventCountsRow
+	err := row.Scan(&i.RegistrationCount, &i.AttendanceCount, &i.Published)
+	return i, err
+}
+
+const getEventFeed = `-- name: GetEventFeed :many
+WITH member_orgs AS (
+    SELECT DISTINCT ur.organization_id FROM user_roles ur WHERE ur.user_id = $4
+), top_tags AS (
+    SELECT et.tag_id
+    FROM event_registrations er
+    JOIN event_tags et ON et.event_id = er.event_id
+    WHERE er.user_id = $4
+      AND er.status <> 'cancelled'
+      AND er.registered_at > $5::timestamptz - INTERVAL '90 days'
+    GROUP BY et.tag_id
+    ORDER BY COUNT(*) DESC, et.tag_id
+    LIMIT 5
+), candidates AS (
+    SELECT e.id, 10 AS base_score, 'followed_org' AS source
+    FROM events e
+    JOIN member_orgs mo ON mo.organization_id = e.organization_id
+    WHERE e.end_time > $5
+    UNION ALL
+    SELECT DISTINCT e.id, 5 AS base_score, 'recommended' AS source
+    FROM events e
+    JOIN event_tags et ON et.event_id = e.id
+    JOIN top_tags tt ON tt.tag_id = et.tag_id
+    WHERE e.end_time > $5
+      AND NOT EXISTS (
+          SELECT 1 FROM event_registrations er
+          WHERE er.event_id = e.id AND er.user_id = $4
+      )
+), best AS (
+    SELECT DISTINCT ON (c.id) c.id, c.base_score, c.source
+    FROM candidates c
+    ORDER BY c.id, c.base_score DESC
+), scored AS (
+    SELECT b.id, b.source,
+        (b.base_score + CASE
+            WHEN e.start_time > $5
+            THEN 1.0 / (FLOOR(EXTRACT(EPOCH FROM e.start_time - $5) / 86400) + 1)
+            ELSE 0
+        END)::float8 AS score
+    FROM best b
+    JOIN events e ON e.id = b.id
+    WHERE e.published AND NOT e.is_deleted
+)
+SELECT e.id, e.title, e.description, e.image_url, e.user_id, e.organization_id, e.location, e.start_time, e.end_time, e.format, e.created_at, e.updated_at, e.capacity, e.published, e.is_deleted, e.deleted_at, e.version, s.source::text AS source, s.score::float8 AS score
+FROM scored s
+JOIN events e ON e.id = s.id
+WHERE $1::float8 IS NULL
+   OR (s.score, s.id) < ($1::float8, $2::int)
+ORDER BY s.score DESC, s.id DESC
+LIMIT $3
+`
+
+type GetEventFeedParams struct {
+	CursorScore pgtype.Float8      `json:"cursor_score"`
+	CursorID    pgtype.Int4        `json:"cursor_id"`
+	Limit       int32              `json:"limit"`
+	UserID      int32              `json:"user_id"`
+	AsOf        pgtype.Timestamptz `json:"as_of"`
+}
+
+type GetEventFeedRow struct {
+	Event  Event   `json:"event"`
+	Source string  `json:"source"`
+	Score  float64 `json:"score"`
+}
+
+// Events of the user's clubs score 10, upcoming events matching the tags the
+// user registered for most in the 90 days before as_of score 5, plus
+// 1/(days until start + 1) for upcoming events. Scores are computed at as_of so
+// they stay stable across pages. top_tags is served by
+// idx_event_registrations_user (user_id, status), the tag match by
+// idx_event_tags_tag.
+func (q *Queries) GetEventFeed(ctx context.Context, arg GetEventFeedParams) ([]GetEventFeedRow, error) {
+	rows, err := q.db.Query(ctx, getEventFeed,
+		arg.CursorScore,
+		arg.CursorID,
+		arg.Limit,
+		arg.UserID,
+		arg.AsOf,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetEventFeedRow
+	for rows.Next() {
+		var i GetEventFeedRow
+		if err := rows.Scan(
+			&i.Event.ID,
+			&i.Event.Title,
+			&i.Event.Description,
+			&i.Event.ImageUrl,
+			&i.Event.UserID,
+			&i.Event.OrganizationID,
+			&i.Event.Location,
+			&i.Event.StartTime,
+			&i.Event.EndTime,
+			&i.Event.Format,
+			&i.Event.CreatedAt,
+			&i.Event.UpdatedAt,
+			&i.Event.Capacity,
+			&i.Event.Published,
+			&i.Event.IsDeleted,
+			&i.Event.DeletedAt,
+			&i.Event.Version,
+			&i.Source,
+			&i.Score,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getEventTagIDs = `-- name: GetEventTagIDs :many
 SELECT tag_id FROM event_tags WHERE event_id = $1
 `
@@ -223,11 +571,171 @@ func (q *Queries) GetEventTags(ctx context.Context, eventID int32) ([]Tag, error
 	return items, nil
 }
 
+const getEventWithCreator = `-- name: GetEventWithCreator :one
+SELECT e.id, e.title, e.description, e.image_url, e.user_id, e.organization_id, e.location, e.start_time, e.end_time, e.format, e.created_at, e.updated_at, e.capacity, e.published, e.is_deleted, e.deleted_at, e.version, u.username AS creator_username
+FROM events e
+JOIN users u ON u.id = e.user_id
+WHERE e.id = $1 AND NOT e.is_deleted
+`
+
+type GetEventWithCreatorRow struct {
+	Event           Event  `json:"event"`
+	CreatorUsername string `json:"creator_username"`
+}
+
+func (q *Queries) GetEventWithCreator(ctx context.Context, id int32) (GetEventWithCreatorRow, error) {
+	row := q.db.QueryRow(ctx, getEventWithCreator, id)
+	var i GetEventWithCreatorRow
+	err := row.Scan(
+		&i.Event.ID,
+		&i.Event.Title,
+		&i.Event.Description,
+		&i.Event.ImageUrl,
+		&i.Event.UserID,
+		&i.Event.OrganizationID,
+		&i.Event.Location,
+		&i.Event.StartTime,
+		&i.Event.EndTime,
+		&i.Event.Format,
+		&i.Event.CreatedAt,
+		&i.Event.UpdatedAt,
+		&i.Event.Capacity,
+		&i.Event.Published,
+		&i.Event.IsDeleted,
+		&i.Event.DeletedAt,
+		&i.Event.Version,
+		&i.CreatorUsername,
+	)
+	return i, err
+}
+
+const getEventsByAllTags = `-- name: GetEventsByAllTags :many
+SELECT e.id, e.title, e.description, e.image_url, e.user_id, e.organization_id, e.location, e.start_time, e.end_time, e.format, e.created_at, e.updated_at, e.capacity, e.published, e.is_deleted, e.deleted_at, e.version,
+    (SELECT COUNT(*) FROM event_registrations er
+     WHERE er.event_id = e.id AND er.status = 'registered')::int AS registration_count,
+    (SELECT COUNT(*) FROM event_attendance ea
+     JOIN event_registrations er ON er.id = ea.registration_id
+     WHERE er.event_id = e.id AND ea.status = 'attended')::int AS attendance_count,
+    u.username AS creator_username
+FROM events e
+JOIN users u ON u.id = e.user_id
+WHERE e.id IN (
+    SELECT event_id FROM event_tags
+    WHERE tag_id = ANY($3::int[])
+    GROUP BY event_id
+    HAVING COUNT(DISTINCT tag_id) = $4::int
+) AND e.published AND NOT e.is_deleted
+ORDER BY e.id
+LIMIT $1 OFFSET $2
+`
+
+type GetEventsByAllTagsParams struct {
+	Limit    int32   `json:"limit"`
+	Offset   int32   `json:"offset"`
+	TagIds   []int32 `json:"tag_ids"`
+	TagCount int32   `json:"tag_count"`
+}
+
+type GetEventsByAllTagsRow struct {
+	Event             Event  `json:"event"`
+	RegistrationCount int32  `json:"registration_count"`
+	AttendanceCount   int32  `json:"attendance_count"`
+	CreatorUsername   string `json:"creator_username"`
+}
+
+func (q *Queries) GetEventsByAllTags(ctx context.Context, arg GetEventsByAllTagsParams) ([]GetEventsByAllTagsRow, error) {
+	rows, err := q.db.Query(ctx, getEventsByAllTags,
+		arg.Limit,
+		arg.Offset,
+		arg.TagIds,
+		arg.TagCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetEventsByAllTagsRow
+	for rows.Next() {
+		var i GetEventsByAllTagsRow
+		if err := rows.Scan(
+			&i.Event.ID,
+			&i.Event.Title,
+			&i.Event.Description,
+			&i.Event.ImageUrl,
+			&i.Event.UserID,
+			&i.Event.OrganizationID,
+			&i.Event.Location,
+			&i.Event.StartTime,
+			&i.Event.EndTime,
+			&i.Event.Format,
+			&i.Event.CreatedAt,
+			&i.Event.UpdatedAt,
+			&i.Event.Capacity,
+			&i.Event.Published,
+			&i.Event.IsDeleted,
+			&i.Event.DeletedAt,
+			&i.Event.Version,
+			&i.RegistrationCount,
+			&i.AttendanceCount,
+			&i.CreatorUsername,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getEventsByIDs = `-- name: GetEventsByIDs :many
+SELECT id, title, description, image_url, user_id, organization_id, location, start_time, end_time, format, created_at, updated_at, capacity, published, is_deleted, deleted_at, version FROM events WHERE id = ANY($1::int[]) AND NOT is_deleted
+`
+
+func (q *Queries) GetEventsByIDs(ctx context.Context, ids []int32) ([]Event, error) {
+	rows, err := q.db.Query(ctx, getEventsByIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Event
+	for rows.Next() {
+		var i Event
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.ImageUrl,
+			&i.UserID,
+			&i.OrganizationID,
+			&i.Location,
+			&i.StartTime,
+			&i.EndTime,
+			&i.Format,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Capacity,
+			&i.Published,
+			&i.IsDeleted,
+			&i.DeletedAt,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getEventsByTagID = `-- name: GetEventsByTagID :many
-SELECT e.id, e.title, e.description, e.image_url, e.user_id, e.organization_id, e.location, e.start_time, e.end_time, e.format, e.created_at, e.updated_at
+SELECT e.id, e.title, e.description, e.image_url, e.user_id, e.organization_id, e.location, e.start_time, e.end_time, e.format, e.created_at, e.updated_at, e.capacity, e.published, e.is_deleted, e.deleted_at, e.version
 FROM events e
 INNER JOIN event_tags et ON et.event_id = e.id
-WHERE et.tag_id = $1
+WHERE et.tag_id = $1 AND e.published AND NOT e.is_deleted
 `
 
 func (q *Queries) GetEventsByTagID(ctx context.Context, tagID int32) ([]Event, error) {
@@ -252,6 +760,70 @@ func (q *Queries) GetEventsByTagID(ctx context.Context, tagID int32) ([]Event, e
 			&i.Format,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Capacity,
+			&i.Published,
+			&i.IsDeleted,
+			&i.DeletedAt,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getEventsWithRegistrationCounts = `-- name: GetEventsWithRegistrationCounts :many
+SELECT e.id, e.title, e.description, e.image_url, e.user_id, e.organization_id, e.location, e.start_time, e.end_time, e.format, e.created_at, e.updated_at, e.capacity, e.published, e.is_deleted, e.deleted_at, e.version,
+    COUNT(DISTINCT CASE WHEN er.status = 'registered' THEN er.id END)::int AS registered_count,
+    COUNT(DISTINCT CASE WHEN ea.status = 'attended' THEN ea.id END)::int AS attended_count
+FROM events e
+LEFT JOIN event_registrations er ON er.event_id = e.id
+LEFT JOIN event_attendance ea ON ea.registration_id = er.id
+WHERE e.id = ANY($1::int[])
+GROUP BY e.id
+`
+
+type GetEventsWithRegistrationCountsRow struct {
+	Event           Event `json:"event"`
+	RegisteredCount int32 `json:"registered_count"`
+	AttendedCount   int32 `json:"attended_count"`
+}
+
+// Registered and attended counts of several events in one query, for list
+// views that would otherwise count per event
+func (q *Queries) GetEventsWithRegistrationCounts(ctx context.Context, ids []int32) ([]GetEventsWithRegistrationCountsRow, error) {
+	rows, err := q.db.Query(ctx, getEventsWithRegistrationCounts, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetEventsWithRegistrationCountsRow
+	for rows.Next() {
+		var i GetEventsWithRegistrationCountsRow
+		if err := rows.Scan(
+			&i.Event.ID,
+			&i.Event.Title,
+			&i.Event.Description,
+			&i.Event.ImageUrl,
+			&i.Event.UserID,
+			&i.Event.OrganizationID,
+			&i.Event.Location,
+			&i.Event.StartTime,
+			&i.Event.EndTime,
+			&i.Event.Format,
+			&i.Event.CreatedAt,
+			&i.Event.UpdatedAt,
+			&i.Event.Capacity,
+			&i.Event.Published,
+			&i.Event.IsDeleted,
+			&i.Event.DeletedAt,
+			&i.Event.Version,
+			&i.RegisteredCount,
+			&i.AttendedCount,
 		); err != nil {
 			return nil, err
 		}
@@ -279,34 +851,136 @@ func (q *Queries) GetTag(ctx context.Context, id int32) (Tag, error) {
 	return i, err
 }
 
-const listEvents = `-- name: ListEvents :many
-SELECT DISTINCT e.id, e.title, e.description, e.image_url, e.user_id, e.organization_id, e.location, e.start_time, e.end_time, e.format, e.created_at, e.updated_at
+const getTagCounts = `-- name: GetTagCounts :one
+SELECT
+    COUNT(DISTINCT e.organization_id)::int AS organization_count,
+    COUNT(DISTINCT et.event_id)::int AS event_count
+FROM event_tags et
+JOIN events e ON e.id = et.event_id
+WHERE et.tag_id = $1 AND NOT e.is_deleted
+`
+
+type GetTagCountsRow struct {
+	OrganizationCount int32 `json:"organization_count"`
+	EventCount        int32 `json:"event_count"`
+}
+
+func (q *Queries) GetTagCounts(ctx context.Context, tagID int32) (GetTagCountsRow, error) {
+	row := q.db.QueryRow(ctx, getTagCounts, tagID)
+	var i GetTagCountsRow
+	err := row.Scan(&i.OrganizationCount, &i.EventCount)
+	return i, err
+}
+
+const getTagEventIDs = `-- name: GetTagEventIDs :many
+SELECT DISTINCT event_id FROM event_tags WHERE tag_id = $1
+`
+
+func (q *Queries) GetTagEventIDs(ctx context.Context, tagID int32) ([]int32, error) {
+	rows, err := q.db.Query(ctx, getTagEventIDs, tagID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var event_id int32
+		if err := rows.Scan(&event_id); err != nil {
+			return nil, err
+		}
+		items = append(items, event_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTagsByIDs = `-- name: GetTagsByIDs :many
+SELECT id, name, created_at, updated_at FROM tags WHERE id = ANY($1::int[])
+`
+
+func (q *Queries) GetTagsByIDs(ctx context.Context, ids []int32) ([]Tag, error) {
+	rows, err := q.db.Query(ctx, getTagsByIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Tag
+	for rows.Next() {
+		var i Tag
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTagsForEvents = `-- name: GetTagsForEvents :many
+SELECT et.event_id, t.id, t.name, t.created_at, t.updated_at
+FROM event_tags et
+INNER JOIN tags t ON t.id = et.tag_id
+WHERE et.event_id = ANY($1::int[])
+`
+
+type GetTagsForEventsRow struct {
+	EventID int32 `json:"event_id"`
+	Tag     Tag   `json:"tag"`
+}
+
+func (q *Queries) GetTagsForEvents(ctx context.Context, eventIds []int32) ([]GetTagsForEventsRow, error) {
+	rows, err := q.db.Query(ctx, getTagsForEvents, eventIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTagsForEventsRow
+	for rows.Next() {
+		var i GetTagsForEventsRow
+		if err := rows.Scan(
+			&i.EventID,
+			&i.Tag.ID,
+			&i.Tag.Name,
+			&i.Tag.CreatedAt,
+			&i.Tag.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserSubscribedEvents = `-- name: GetUserSubscribedEvents :many
+SELECT e.id, e.title, e.description, e.image_url, e.user_id, e.organization_id, e.location, e.start_time, e.end_time, e.format, e.created_at, e.updated_at, e.capacity, e.published, e.is_deleted, e.deleted_at, e.version
 FROM events e
-LEFT JOIN event_tags et ON et.event_id = e.id
-WHERE 
-    ($3::int IS NULL OR e.user_id = $3) AND
-    ($4::int IS NULL OR e.organization_id = $4) AND
-    ($5::int[] IS NULL OR et.tag_id = ANY($5::int[]))
-ORDER BY e.id
-LIMIT $1 OFFSET $2
+INNER JOIN event_registrations er ON er.event_id = e.id
+WHERE er.user_id = $1 AND NOT e.is_deleted
+ORDER BY er.registered_at DESC
+LIMIT $2::int OFFSET $3
 `
 
-type ListEventsParams struct {
-	Limit          int32       `json:"limit"`
-	Offset         int32       `json:"offset"`
-	UserID         pgtype.Int4 `json:"user_id"`
-	OrganizationID pgtype.Int4 `json:"organization_id"`
-	TagIds         []int32     `json:"tag_ids"`
+type GetUserSubscribedEventsParams struct {
+	UserID int32       `json:"user_id"`
+	Limit  pgtype.Int4 `json:"limit"`
+	Offset int32       `json:"offset"`
 }
 
-func (q *Queries) ListEvents(ctx context.Context, arg ListEventsParams) ([]Event, error) {
-	rows, err := q.db.Query(ctx, listEvents,
-		arg.Limit,
-		arg.Offset,
-		arg.UserID,
-		arg.OrganizationID,
-		arg.TagIds,
-	)
+// A NULL limit returns all of the user's events
+func (q *Queries) GetUserSubscribedEvents(ctx context.Context, arg GetUserSubscribedEventsParams) ([]Event, error) {
+	rows, err := q.db.Query(ctx, getUserSubscribedEvents, arg.UserID, arg.Limit, arg.Offset)
 	if err != nil {
 		return nil, err
 	}
@@ -327,6 +1001,11 @@ func (q *Queries) ListEvents(ctx context.Context, arg ListEventsParams) ([]Event
 			&i.Format,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Capacity,
+			&i.Published,
+			&i.IsDeleted,
+			&i.DeletedAt,
+			&i.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -338,31 +1017,130 @@ func (q *Queries) ListEvents(ctx context.Context, arg ListEventsParams) ([]Event
 	return items, nil
 }
 
-const listTags = `-- name: ListTags :many
-SELECT id, name, created_at, updated_at FROM tags
-ORDER BY id
+const listDeletedEvents = `-- name: ListDeletedEvents :many
+SELECT id, title, description, image_url, user_id, organization_id, location, start_time, end_time, format, created_at, updated_at, capacity, published, is_deleted, deleted_at, version FROM events
+WHERE is_deleted
+ORDER BY deleted_at DESC, id DESC
 LIMIT $1 OFFSET $2
 `
 
-type ListTagsParams struct {
+type ListDeletedEventsParams struct {
 	Limit  int32 `json:"limit"`
 	Offset int32 `json:"offset"`
 }
 
-func (q *Queries) ListTags(ctx context.Context, arg ListTagsParams) ([]Tag, error) {
-	rows, err := q.db.Query(ctx, listTags, arg.Limit, arg.Offset)
+func (q *Queries) ListDeletedEvents(ctx context.Context, arg ListDeletedEventsParams) ([]Event, error) {
+	rows, err := q.db.Query(ctx, listDeletedEvents, arg.Limit, arg.Offset)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Tag
+	var items []Event
 	for rows.Next() {
-		var i Tag
+		var i Event
 		if err := rows.Scan(
 			&i.ID,
-			&i.Name,
+			&i.Title,
+			&i.Description,
+			&i.ImageUrl,
+			&i.UserID,
+			&i.OrganizationID,
+			&i.Location,
+			&i.StartTime,
+			&i.EndTime,
+			&i.Format,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Capacity,
+			&i.Published,
+			&i.IsDeleted,
+			&i.DeletedAt,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEvents = `-- name: ListEvents :many
+SELECT DISTINCT e.id, e.title, e.description, e.image_url, e.user_id, e.organization_id, e.location, e.start_time, e.end_time, e.format, e.created_at, e.updated_at, e.capacity, e.published, e.is_deleted, e.deleted_at, e.version,
+    (SELECT COUNT(*) FROM event_registrations er
+     WHERE er.event_id = e.id AND er.status = 'registered')::int AS registration_count,
+    (SELECT COUNT(*) FROM event_attendance ea
+     JOIN event_registrations er ON er.id = ea.registration_id
+     WHERE er.event_id = e.id AND ea.status = 'attended')::int AS attendance_count,
+    u.username AS creator_username
+FROM events e
+JOIN users u ON u.id = e.user_id
+LEFT JOIN event_tags et ON et.event_id = e.id
+WHERE 
+    ($3::int IS NULL OR e.user_id = $3) AND
+    ($4::int IS NULL OR e.organization_id = $4) AND
+    ($5::int[] IS NULL OR et.tag_id = ANY($5::int[])) AND
+    (e.published OR $6::bool) AND
+    NOT e.is_deleted
+ORDER BY e.id
+LIMIT $1 OFFSET $2
+`
+
+type ListEventsParams struct {
+	Limit              int32       `json:"limit"`
+	Offset             int32       `json:"offset"`
+	UserID             pgtype.Int4 `json:"user_id"`
+	OrganizationID     pgtype.Int4 `json:"organization_id"`
+	TagIds             []int32     `json:"tag_ids"`
+	IncludeUnpublished bool        `json:"include_unpublished"`
+}
+
+type ListEventsRow struct {
+	Event             Event  `json:"event"`
+	RegistrationCount int32  `json:"registration_count"`
+	AttendanceCount   int32  `json:"attendance_count"`
+	CreatorUsername   string `json:"creator_username"`
+}
+
+func (q *Queries) ListEvents(ctx context.Context, arg ListEventsParams) ([]ListEventsRow, error) {
+	rows, err := q.db.Query(ctx, listEvents,
+		arg.Limit,
+		arg.Offset,
+		arg.UserID,
+		arg.OrganizationID,
+		arg.TagIds,
+		arg.IncludeUnpublished,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListEventsRow
+	for rows.Next() {
+		var i ListEventsRow
+		if err := rows.Scan(
+			&i.Event.ID,
+			&i.Event.Title,
+			&i.Event.Description,
+			&i.Event.ImageUrl,
+			&i.Event.UserID,
+			&i.Event.OrganizationID,
+			&i.Event.Location,
+			&i.Event.StartTime,
+			&i.Event.EndTime,
+			&i.Event.Format,
+			&i.Event.CreatedAt,
+			&i.Event.UpdatedAt,
+			&i.Event.Capacity,
+			&i.Event.Published,
+			&i.Event.IsDeleted,
+			&i.Event.DeletedAt,
+			&i.Event.Version,
+			&i.RegistrationCount,
+			&i.AttendanceCount,
+			&i.CreatorUsername,
 		); err != nil {
 			return nil, err
 		}
@@ -374,6 +1152,180 @@ func (q *Queries) ListTags(ctx context.Context, arg ListTagsParams) ([]Tag, erro
 	return items, nil
 }
 
+const listEventsByCursor = `-- name: ListEventsByCursor :many
+SELECT DISTINCT e.id, e.title, e.description, e.image_url, e.user_id, e.organization_id, e.location, e.start_time, e.end_time, e.format, e.created_at, e.updated_at, e.capacity, e.published, e.is_deleted, e.deleted_at, e.version,
+    (SELECT COUNT(*) FROM event_registrations er
+     WHERE er.event_id = e.id AND er.status = 'registered')::int AS registration_count,
+    (SELECT COUNT(*) FROM event_attendance ea
+     JOIN event_registrations er ON er.id = ea.registration_id
+     WHERE er.event_id = e.id AND ea.status = 'attended')::int AS attendance_count,
+    u.username AS creator_username
+FROM events e
+JOIN users u ON u.id = e.user_id
+LEFT JOIN event_tags et ON et.event_id = e.id
+WHERE
+    ($1::int IS NULL OR e.user_id = $1) AND
+    ($2::int IS NULL OR e.organization_id = $2) AND
+    ($3::int[] IS NULL OR et.tag_id = ANY($3::int[])) AND
+    (e.published OR $4::bool) AND
+    NOT e.is_deleted AND
+    ($5::timestamptz IS NULL OR
+     (e.start_time, e.id) > ($5::timestamptz, $6::int))
+ORDER BY e.start_time, e.id
+LIMIT $7
+`
+
+type ListEventsByCursorParams struct {
+	UserID             pgtype.Int4        `json:"user_id"`
+	OrganizationID     pgtype.Int4        `json:"organization_id"`
+	TagIds             []int32            `json:"tag_ids"`
+	IncludeUnpublished bool               `json:"include_unpublished"`
+	CursorStartTime    pgtype.Timestamptz `json:"cursor_start_time"`
+	CursorID           pgtype.Int4        `json:"cursor_id"`
+	Limit              int32              `json:"limit"`
+}
+
+type ListEventsByCursorRow struct {
+	Event             Event  `json:"event"`
+	RegistrationCount int32  `json:"registration_count"`
+	AttendanceCount   int32  `json:"attendance_count"`
+	CreatorUsername   string `json:"creator_username"`
+}
+
+// Keyset pagination by (start_time, id), the cursor is the last row of the previous page
+func (q *Queries) ListEventsByCursor(ctx context.Context, arg ListEventsByCursorParams) ([]ListEventsByCursorRow, error) {
+	rows, err := q.db.Query(ctx, listEventsByCursor,
+		arg.UserID,
+		arg.OrganizationID,
+		arg.TagIds,
+		arg.IncludeUnpublished,
+		arg.CursorStartTime,
+		arg.CursorID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListEventsByCursorRow
+	for rows.Next() {
+		var i ListEventsByCursorRow
+		if err := rows.Scan(
+			&i.Event.ID,
+			&i.Event.Title,
+			&i.Event.Description,
+			&i.Event.ImageUrl,
+			&i.Event.UserID,
+			&i.Event.OrganizationID,
+			&i.Event.Location,
+			&i.Event.StartTime,
+			&i.Event.EndTime,
+			&i.Event.Format,
+			&i.Event.CreatedAt,
+			&i.Event.UpdatedAt,
+			&i.Event.Capacity,
+			&i.Event.Published,
+			&i.Event.IsDeleted,
+			&i.Event.DeletedAt,
+			&i.Event.Version,
+			&i.RegistrationCount,
+			&i.AttendanceCount,
+			&i.CreatorUsername,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTags = `-- name: ListTags :many
+SELECT t.id, t.name, t.created_at, t.updated_at,
+    (SELECT COUNT(DISTINCT e.organization_id) FROM event_tags et JOIN events e ON e.id = et.event_id WHERE et.tag_id = t.id AND NOT e.is_deleted)::int AS organization_count,
+    (SELECT COUNT(DISTINCT et.event_id) FROM event_tags et JOIN events e ON e.id = et.event_id WHERE et.tag_id = t.id AND NOT e.is_deleted)::int AS event_count
+FROM tags t
+WHERE (SELECT COUNT(DISTINCT et.event_id) FROM event_tags et JOIN events e ON e.id = et.event_id WHERE et.tag_id = t.id AND NOT e.is_deleted) >= $3::int
+ORDER BY
+    CASE WHEN $4::bool THEN (SELECT COUNT(DISTINCT et.event_id) FROM event_tags et JOIN events e ON e.id = et.event_id WHERE et.tag_id = t.id AND NOT e.is_deleted) END DESC,
+    t.id
+LIMIT $1 OFFSET $2
+`
+
+type ListTagsParams struct {
+	Limit         int32 `json:"limit"`
+	Offset        int32 `json:"offset"`
+	MinEventCount int32 `json:"min_event_count"`
+	ByEventCount  bool  `json:"by_event_count"`
+}
+
+type ListTagsRow struct {
+	Tag               Tag   `json:"tag"`
+	OrganizationCount int32 `json:"organization_count"`
+	EventCount        int32 `json:"event_count"`
+}
+
+func (q *Queries) ListTags(ctx context.Context, arg ListTagsParams) ([]ListTagsRow, error) {
+	rows, err := q.db.Query(ctx, listTags,
+		arg.Limit,
+		arg.Offset,
+		arg.MinEventCount,
+		arg.ByEventCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTagsRow
+	for rows.Next() {
+		var i ListTagsRow
+		if err := rows.Scan(
+			&i.Tag.ID,
+			&i.Tag.Name,
+			&i.Tag.CreatedAt,
+			&i.Tag.UpdatedAt,
+			&i.OrganizationCount,
+			&i.EventCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const mergeEventTags = `-- name: MergeEventTags :execrows
+WITH moved AS (
+    DELETE FROM event_tags WHERE tag_id = $1
+    RETURNING event_id
+)
+INSERT INTO event_tags (event_id, tag_id)
+SELECT DISTINCT m.event_id, $2::int FROM moved m
+WHERE NOT EXISTS (
+    SELECT 1 FROM event_tags et WHERE et.event_id = m.event_id AND et.tag_id = $2::int
+)
+`
+
+type MergeEventTagsParams struct {
+	SourceID int32 `json:"source_id"`
+	TargetID int32 `json:"target_id"`
+}
+
+// Moves the source tag's events to the target tag, skipping events that
+// already have it, so no duplicate rows are left behind
+func (q *Queries) MergeEventTags(ctx context.Context, arg MergeEventTagsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, mergeEventTags, arg.SourceID, arg.TargetID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const removeEventTags = `-- name: RemoveEventTags :exec
 DELETE FROM event_tags WHERE event_id = $1
 `
@@ -383,6 +1335,117 @@ func (q *Queries) RemoveEventTags(ctx context.Context, eventID int32) error {
 	return err
 }
 
+const restoreEvent = `-- name: RestoreEvent :one
+UPDATE events
+SET is_deleted = FALSE,
+    deleted_at = NULL,
+    updated_at = NOW()
+WHERE id = $1 AND is_deleted
+RETURNING id, title, description, image_url, user_id, organization_id, location, start_time, end_time, format, created_at, updated_at, capacity, published, is_deleted, deleted_at, version
+`
+
+func (q *Queries) RestoreEvent(ctx context.Context, id int32) (Event, error) {
+	row := q.db.QueryRow(ctx, restoreEvent, id)
+	var i Event
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Description,
+		&i.ImageUrl,
+		&i.UserID,
+		&i.OrganizationID,
+		&i.Location,
+		&i.StartTime,
+		&i.EndTime,
+		&i.Format,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Capacity,
+		&i.Published,
+		&i.IsDeleted,
+		&i.DeletedAt,
+		&i.Version,
+	)
+	return i, err
+}
+
+const setEventPublished = `-- name: SetEventPublished :one
+UPDATE events
+SET published = $1,
+    updated_at = NOW()
+WHERE id = $2 AND NOT is_deleted
+RETURNING id, title, description, image_url, user_id, organization_id, location, start_time, end_time, format, created_at, updated_at, capacity, published, is_deleted, deleted_at, version
+`
+
+type SetEventPublishedParams struct {
+	Published bool  `json:"published"`
+	ID        int32 `json:"id"`
+}
+
+func (q *Queries) SetEventPublished(ctx context.Context, arg SetEventPublishedParams) (Event, error) {
+	row := q.db.QueryRow(ctx, setEventPublished, arg.Published, arg.ID)
+	var i Event
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Description,
+		&i.ImageUrl,
+		&i.UserID,
+		&i.OrganizationID,
+		&i.Location,
+		&i.StartTime,
+		&i.EndTime,
+		&i.Format,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Capacity,
+		&i.Published,
+		&i.IsDeleted,
+		&i.DeletedAt,
+		&i.Version,
+	)
+	return i, err
+}
+
+const suggestTagsByName = `-- name: SuggestTagsByName :many
+SELECT id, name, similarity(name, $1)::float8 AS score
+FROM tags
+ORDER BY score DESC, id
+LIMIT $2
+`
+
+type SuggestTagsByNameParams struct {
+	Title string `json:"title"`
+	Limit int32  `json:"limit"`
+}
+
+type SuggestTagsByNameRow struct {
+	ID    int32   `json:"id"`
+	Name  string  `json:"name"`
+	Score float64 `json:"score"`
+}
+
+// Requires the pg_trgm extension
+func (q *Queries) SuggestTagsByName(ctx context.Context, arg SuggestTagsByNameParams) ([]SuggestTagsByNameRow, error) {
+	rows, err := q.db.Query(ctx, suggestTagsByName, arg.Title, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SuggestTagsByNameRow
+	for rows.Next() {
+		var i SuggestTagsByNameRow
+		if err := rows.Scan(&i.ID, &i.Name, &i.Score); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateEvent = `-- name: UpdateEvent :one
 UPDATE events
 SET title = COALESCE($1, title),
@@ -394,24 +1457,33 @@ SET title = COALESCE($1, title),
     start_time = COALESCE($7, start_time),
     end_time = COALESCE($8, end_time),
     format = COALESCE($9::format, format),
+    capacity = COALESCE($10, capacity),
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $10
-RETURNING id, title, description, image_url, user_id, organization_id, location, start_time, end_time, format, created_at, updated_at
+WHERE id = $11 AND NOT is_deleted
+  AND ($12::int IS NULL OR version = $12::int)
+RETURNING id, title, description, image_url, user_id, organization_id, location, start_time, end_time, format, created_at, updated_at, capacity, published, is_deleted, deleted_at, version
 `
 
 type UpdateEventParams struct {
-	Title          pgtype.Text        `json:"title"`
-	Description    pgtype.Text        `json:"description"`
-	ImageUrl       pgtype.Text        `json:"image_url"`
-	UserID         pgtype.Int4        `json:"user_id"`
-	OrganizationID pgtype.Int4        `json:"organization_id"`
-	Location       pgtype.Text        `json:"location"`
-	StartTime      pgtype.Timestamptz `json:"start_time"`
-	EndTime        pgtype.Timestamptz `json:"end_time"`
-	Format         NullFormat         `json:"format"`
-	ID             int32              `json:"id"`
+	Title           pgtype.Text        `json:"title"`
+	Description     pgtype.Text        `json:"description"`
+	ImageUrl        pgtype.Text        `json:"image_url"`
+	UserID          pgtype.Int4        `json:"user_id"`
+	OrganizationID  pgtype.Int4        `json:"organization_id"`
+	Location        pgtype.Text        `json:"location"`
+	StartTime       pgtype.Timestamptz `json:"start_time"`
+	EndTime         pgtype.Timestamptz `json:"end_time"`
+	Format          NullFormat         `json:"format"`
+	Capacity        pgtype.Int4        `json:"capacity"`
+	ID              int32              `json:"id"`
+	ExpectedVersion pgtype.Int4        `json:"expected_version"`
 }
 
+// With expected_version set, no row is returned when the event was changed
+// since the caller read it
+// Concurrent updates with the same expected_version queue on the row lock and
+// recheck the version once the first commits, so only one of them applies
 func (q *Queries) UpdateEvent(ctx context.Context, arg UpdateEventParams) (Event, error) {
 	row := q.db.QueryRow(ctx, updateEvent,
 		arg.Title,
@@ -423,7 +1495,9 @@ func (q *Queries) UpdateEvent(ctx context.Context, arg UpdateEventParams) (Event
 		arg.StartTime,
 		arg.EndTime,
 		arg.Format,
+		arg.Capacity,
 		arg.ID,
+		arg.ExpectedVersion,
 	)
 	var i Event
 	err := row.Scan(
@@ -439,6 +1513,11 @@ func (q *Queries) UpdateEvent(ctx context.Context, arg UpdateEventParams) (Event
 		&i.Format,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Capacity,
+		&i.Published,
+		&i.IsDeleted,
+		&i.DeletedAt,
+		&i.Version,
 	)
 	return i, err
 }
@@ -0,0 +1,203 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	// subscriberBuffer is how many payloads a subscriber may fall behind by
+	// before it is dropped
+	subscriberBuffer = 64
+
+	// listenerRetryDelay is the pause before reconnecting a failed listener
+	listenerRetryDelay = 5 * time.Second
+)
+
+// AttendanceChannel is the NOTIFY channel carrying one event's attendance
+// changes. Payloads are EventAttendance rows as JSON.
+func AttendanceChannel(eventID int32) string {
+	return fmt.Sprintf("attendance_event_%d", eventID)
+}
+
+// Listener fans notifications out to any number of subscribers over a single
+// connection, so the number of PostgreSQL connections doesn't grow with the
+// number of subscribers. The connection is its own rather than one from the
+// pool, so listening can't starve queries.
+type Listener struct {
+	pool *pgxpool.Pool
+
+	mu   sync.Mutex
+	subs map[string]map[chan string]struct{}
+	wake chan struct{} // Closed when the set of channels changes
+}
+
+// NewListener creates a listener. Run must be started for subscribers to
+// receive anything.
+func NewListener(pool *pgxpool.Pool) *Listener {
+	return &Listener{
+		pool: pool,
+		subs: make(map[string]map[chan string]struct{}),
+		wake: make(chan struct{}),
+	}
+}
+
+// Subscribe returns the payloads of notifications on channel and a function
+// ending the subscription. The payload channel is closed if the subscriber
+// falls too far behind, rather than stalling everyone else.
+func (l *Listener) Subscribe(channel string) (<-chan string, func()) {
+	ch := make(chan string, subscriberBuffer)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.subs[channel] == nil {
+		l.subs[channel] = make(map[chan string]struct{})
+		l.changed()
+	}
+	l.subs[channel][ch] = struct{}{}
+
+	return ch, func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		l.remove(channel, ch)
+	}
+}
+
+// remove drops a subscriber if it's still subscribed. Callers hold mu.
+func (l *Listener) remove(channel string, ch chan string) {
+	subs := l.subs[channel]
+	if _, ok := subs[ch]; !ok {
+		return
+	}
+	delete(subs, ch)
+	close(ch)
+	if len(subs) == 0 {
+		delete(l.subs, channel)
+		l.changed()
+	}
+}
+
+// changed wakes Run to LISTEN or UNLISTEN. Callers hold mu.
+func (l *Listener) changed() {
+	close(l.wake)
+	l.wake = make(chan struct{})
+}
+
+// channels returns the channels with subscribers and the wake channel that
+// is closed when they change
+func (l *Listener) channels() (map[string]bool, <-chan struct{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	wanted := make(map[string]bool, len(l.subs))
+	for channel := range l.subs {
+		wanted[channel] = true
+	}
+	return wanted, l.wake
+}
+
+// dispatch hands a payload to every subscriber of channel
+func (l *Listener) dispatch(channel, payload string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for ch := range l.subs[channel] {
+		select {
+		case ch <- payload:
+		default:
+			slog.Warn("Dropping notification subscriber that fell behind", "channel", channel)
+			l.remove(channel, ch)
+		}
+	}
+}
+
+// Run holds the listening connection until ctx is cancelled, reconnecting
+// after failures. Notifications sent while reconnecting are lost.
+func (l *Listener) Run(ctx context.Context) {
+	for {
+		err := l.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		slog.Warn("Notification listener failed, reconnecting", "error", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(listenerRetryDelay):
+		}
+	}
+}
+
+func (l *Listener) listen(ctx context.Context) error {
+	conn, err := pgx.ConnectConfig(ctx, l.pool.Config().ConnConfig.Copy())
+	if err != nil {
+		return fmt.Errorf("failed to connect listener: %w", err)
+	}
+	defer conn.Close(context.Background())
+
+	listening := make(map[string]bool)
+	for {
+		wanted, wake := l.channels()
+		for channel := range wanted {
+			if listening[channel] {
+				continue
+			}
+			if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+				return fmt.Errorf("failed to listen on %s: %w", channel, err)
+			}
+			listening[channel] = true
+		}
+		for channel := range listening {
+			if wanted[channel] {
+				continue
+			}
+			if _, err := conn.Exec(ctx, "UNLISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+				return fmt.Errorf("failed to unlisten on %s: %w", channel, err)
+			}
+			delete(listening, channel)
+		}
+
+		notification, err := waitForNotification(ctx, conn, wake)
+		if err != nil {
+			return err
+		}
+		if notification != nil {
+			l.dispatch(notification.Channel, notification.Payload)
+		}
+	}
+}
+
+// waitForNotification waits for the next notification. It returns nil
+// without an error if wake is closed first; the interrupted wait leaves the
+// connection usable.
+func waitForNotification(ctx context.Context, conn *pgx.Conn, wake <-chan struct{}) (*pgconn.Notification, error) {
+	waitCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-wake:
+			cancel()
+		case <-waitCtx.Done():
+		}
+	}()
+
+	notification, err := conn.WaitForNotification(waitCtx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		select {
+		case <-wake:
+			return nil, nil
+		default:
+		}
+		return nil, fmt.Errorf("failed to wait for notification: %w", err)
+	}
+	return notification, nil
+}
@@ -0,0 +1,97 @@
+package db
+
+import "testing"
+
+func TestListenerFanOut(t *testing.T) {
+	l := NewListener(nil)
+	a, unsubscribeA := l.Subscribe("attendance_event_1")
+	b, unsubscribeB := l.Subscribe("attendance_event_1")
+	other, unsubscribeOther := l.Subscribe("attendance_event_2")
+	defer unsubscribeOther()
+
+	l.dispatch("attendance_event_1", "hello")
+	for name, ch := range map[string]<-chan string{"a": a, "b": b} {
+		select {
+		case got := <-ch:
+			if got != "hello" {
+				t.Errorf("subscriber %s got %q, want %q", name, got, "hello")
+			}
+		default:
+			t.Errorf("subscriber %s got nothing", name)
+		}
+	}
+	select {
+	case got := <-other:
+		t.Errorf("subscriber of another channel got %q", got)
+	default:
+	}
+
+	unsubscribeA()
+	if _, ok := <-a; ok {
+		t.Error("payloads still open after unsubscribing")
+	}
+	unsubscribeA() // A second call is harmless
+
+	wanted, _ := l.channels()
+	if !wanted["attendance_event_1"] {
+		t.Error("channel dropped while it still has a subscriber")
+	}
+	unsubscribeB()
+	wanted, _ = l.channels()
+	if wanted["attendance_event_1"] {
+		t.Error("channel kept after its last subscriber left")
+	}
+}
+
+func TestListenerWakesOnChannelChanges(t *testing.T) {
+	l := NewListener(nil)
+	_, wake := l.channels()
+
+	_, unsubscribe := l.Subscribe("attendance_event_1")
+	select {
+	case <-wake:
+	default:
+		t.Fatal("subscribing to a new channel didn't wake the listener")
+	}
+
+	_, wake = l.channels()
+	_, unsubscribeSecond := l.Subscribe("attendance_event_1")
+	select {
+	case <-wake:
+		t.Fatal("a second subscriber to the same channel woke the listener")
+	default:
+	}
+
+	unsubscribeSecond()
+	unsubscribe()
+	select {
+	case <-wake:
+	default:
+		t.Fatal("the last subscriber leaving didn't wake the listener")
+	}
+}
+
+func TestListenerDropsSlowSubscribers(t *testing.T) {
+	l := NewListener(nil)
+	slow, unsubscribeSlow := l.Subscribe("attendance_event_1")
+	defer unsubscribeSlow()
+	fast, unsubscribeFast := l.Subscribe("attendance_event_1")
+	defer unsubscribeFast()
+
+	for i := 0; i <= subscriberBuffer; i++ {
+		l.dispatch("attendance_event_1", "update")
+		<-fast
+	}
+
+	for range subscriberBuffer {
+		<-slow
+	}
+	if _, ok := <-slow; ok {
+		t.Error("slow subscriber still open after overflowing its buffer")
+	}
+
+	l.dispatch("attendance_event_1", "after")
+	if got := <-fast; got != "after" {
+		t.Errorf("fast subscriber got %q, want %q", got, "after")
+	}
+}
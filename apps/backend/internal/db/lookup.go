@@ -0,0 +1,77 @@
+package db
+
+import "context"
+
+// GetOrganizationsMap loads the given organizations in one query, keyed by ID.
+// IDs without a matching organization are absent from the map.
+func (q *Queries) GetOrganizationsMap(ctx context.Context, ids []int32) (map[int32]Organization, error) {
+	orgs, err := q.GetOrganizationsByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[int32]Organization, len(orgs))
+	for _, o := range orgs {
+		m[o.ID] = o
+	}
+	return m, nil
+}
+
+// GetOrganizationTypesMap loads the given organization types in one query,
+// keyed by ID. IDs without a matching type are absent from the map.
+func (q *Queries) GetOrganizationTypesMap(ctx context.Context, ids []int32) (map[int32]OrganizationType, error) {
+	types, err := q.GetOrganizationTypesByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[int32]OrganizationType, len(types))
+	for _, t := range types {
+		m[t.ID] = t
+	}
+	return m, nil
+}
+
+// GetUsersMap loads the given users in one query, keyed by ID.
+// IDs without a matching user are absent from the map.
+func (q *Queries) GetUsersMap(ctx context.Context, ids []int32) (map[int32]User, error) {
+	users, err := q.GetUsersByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[int32]User, len(users))
+	for _, u := range users {
+		m[u.ID] = u
+	}
+	return m, nil
+}
+
+// GetEventsMap loads the given events in one query, keyed by ID.
+// IDs without a matching event are absent from the map.
+func (q *Queries) GetEventsMap(ctx context.Context, ids []int32) (map[int32]Event, error) {
+	events, err := q.GetEventsByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[int32]Event, len(events))
+	for _, e := range events {
+		m[e.ID] = e
+	}
+	return m, nil
+}
+
+// GetEventTagsMap loads the tags of the given events in one query, keyed by event ID
+func (q *Queries) GetEventTagsMap(ctx context.Context, eventIDs []int32) (map[int32][]Tag, error) {
+	rows, err := q.GetTagsForEvents(ctx, eventIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[int32][]Tag)
+	for _, r := range rows {
+		m[r.EventID] = append(m[r.EventID], r.Tag)
+	}
+	return m, nil
+}
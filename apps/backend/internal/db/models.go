@@ -59,6 +59,7 @@ type Format string
 const (
 	FormatOnline  Format = "online"
 	FormatOffline Format = "offline"
+	FormatHybrid  Format = "hybrid"
 )
 
 func (e *Format) Scan(src interface{}) error {
@@ -96,6 +97,95 @@ func (ns NullFormat) Value() (driver.Value, error) {
 	return string(ns.Format), nil
 }
 
+type InquiryStatus string
+
+const (
+	InquiryStatusOpen       InquiryStatus = "open"
+	InquiryStatusInProgress InquiryStatus = "in_progress"
+	InquiryStatusResolved   InquiryStatus = "resolved"
+	InquiryStatusSpam       InquiryStatus = "spam"
+)
+
+func (e *InquiryStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = InquiryStatus(s)
+	case string:
+		*e = InquiryStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for InquiryStatus: %T", src)
+	}
+	return nil
+}
+
+type NullInquiryStatus struct {
+	InquiryStatus InquiryStatus `json:"inquiry_status"`
+	Valid         bool          `json:"valid"` // Valid is true if InquiryStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullInquiryStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.InquiryStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.InquiryStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullInquiryStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.InquiryStatus), nil
+}
+
+type NotificationType string
+
+const (
+	NotificationTypeRegistrationConfirmation NotificationType = "registration_confirmation"
+	NotificationTypeEventReminder            NotificationType = "event_reminder"
+	NotificationTypeEventCancellation        NotificationType = "event_cancellation"
+	NotificationTypeWaitlistPromoted         NotificationType = "waitlist_promoted"
+	NotificationTypePlatformAnnouncement     NotificationType = "platform_announcement"
+)
+
+func (e *NotificationType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = NotificationType(s)
+	case string:
+		*e = NotificationType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for NotificationType: %T", src)
+	}
+	return nil
+}
+
+type NullNotificationType struct {
+	NotificationType NotificationType `json:"notification_type"`
+	Valid            bool             `json:"valid"` // Valid is true if NotificationType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullNotificationType) Scan(value interface{}) error {
+	if value == nil {
+		ns.NotificationType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.NotificationType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullNotificationType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.NotificationType), nil
+}
+
 type OrganizationStatus string
 
 const (
@@ -224,6 +314,60 @@ func (ns NullRegistrationStatus) Value() (driver.Value, error) {
 	return string(ns.RegistrationStatus), nil
 }
 
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+	WebhookDeliveryStatusDead      WebhookDeliveryStatus = "dead"
+)
+
+func (e *WebhookDeliveryStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WebhookDeliveryStatus(s)
+	case string:
+		*e = WebhookDeliveryStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WebhookDeliveryStatus: %T", src)
+	}
+	return nil
+}
+
+type NullWebhookDeliveryStatus struct {
+	WebhookDeliveryStatus WebhookDeliveryStatus `json:"webhook_delivery_status"`
+	Valid                 bool                  `json:"valid"` // Valid is true if WebhookDeliveryStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWebhookDeliveryStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.WebhookDeliveryStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WebhookDeliveryStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWebhookDeliveryStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WebhookDeliveryStatus), nil
+}
+
+type AuditLog struct {
+	ID            int64              `json:"id"`
+	ActorKratosID pgtype.Text        `json:"actor_kratos_id"`
+	Action        string             `json:"action"`
+	ResourceType  string             `json:"resource_type"`
+	ResourceID    string             `json:"resource_id"`
+	Payload       []byte             `json:"payload"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+}
+
 type Event struct {
 	ID             int32              `json:"id"`
 	Title          string             `json:"title"`
@@ -237,6 +381,11 @@ type Event struct {
 	Format         NullFormat         `json:"format"`
 	CreatedAt      pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+	Capacity       pgtype.Int4        `json:"capacity"`
+	Published      bool               `json:"published"`
+	IsDeleted      bool               `json:"is_deleted"`
+	DeletedAt      pgtype.Timestamptz `json:"deleted_at"`
+	Version        int32              `json:"version"`
 }
 
 type EventAttendance struct {
@@ -251,14 +400,16 @@ type EventAttendance struct {
 }
 
 type EventRegistration struct {
-	ID           int32              `json:"id"`
-	EventID      int32              `json:"event_id"`
-	UserID       int32              `json:"user_id"`
-	Status       RegistrationStatus `json:"status"`
-	RegisteredAt pgtype.Timestamptz `json:"registered_at"`
-	CancelledAt  pgtype.Timestamptz `json:"cancelled_at"`
-	CreatedAt    pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+	ID                   int32              `json:"id"`
+	EventID              int32              `json:"event_id"`
+	UserID               int32              `json:"user_id"`
+	Status               RegistrationStatus `json:"status"`
+	RegisteredAt         pgtype.Timestamptz `json:"registered_at"`
+	CancelledAt          pgtype.Timestamptz `json:"cancelled_at"`
+	CreatedAt            pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt            pgtype.Timestamptz `json:"updated_at"`
+	CancelToken          pgtype.Text        `json:"cancel_token"`
+	CancelTokenExpiresAt pgtype.Timestamptz `json:"cancel_token_expires_at"`
 }
 
 type EventTag struct {
@@ -266,6 +417,17 @@ type EventTag struct {
 	TagID   int32 `json:"tag_id"`
 }
 
+type OrgInquiry struct {
+	ID          int32              `json:"id"`
+	OrgID       int32              `json:"org_id"`
+	SenderEmail string             `json:"sender_email"`
+	SenderName  string             `json:"sender_name"`
+	Subject     string             `json:"subject"`
+	Message     string             `json:"message"`
+	Status      InquiryStatus      `json:"status"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
 type Organization struct {
 	ID                 int32                  `json:"id"`
 	Title              string                 `json:"title"`
@@ -282,6 +444,8 @@ type Organization struct {
 	Status             NullOrganizationStatus `json:"status"`
 	CreatedAt          pgtype.Timestamptz     `json:"created_at"`
 	UpdatedAt          pgtype.Timestamptz     `json:"updated_at"`
+	MonthlyEventQuota  pgtype.Int4            `json:"monthly_event_quota"`
+	ContactEmail       pgtype.Text            `json:"contact_email"`
 }
 
 type OrganizationType struct {
@@ -302,6 +466,14 @@ type PreRegisteredUser struct {
 	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
 }
 
+type RegistrationHold struct {
+	ID        int32              `json:"id"`
+	EventID   int32              `json:"event_id"`
+	UserID    int32              `json:"user_id"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
 type Role struct {
 	ID        int32              `json:"id"`
 	Name      string             `json:"name"`
@@ -309,6 +481,14 @@ type Role struct {
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 }
 
+type StatisticsSnapshot struct {
+	ID          int32              `json:"id"`
+	Scope       string             `json:"scope"`
+	MetricName  string             `json:"metric_name"`
+	MetricValue float64            `json:"metric_value"`
+	ComputedAt  pgtype.Timestamptz `json:"computed_at"`
+}
+
 type Tag struct {
 	ID        int32              `json:"id"`
 	Name      string             `json:"name"`
@@ -328,9 +508,46 @@ type User struct {
 	LastName  pgtype.Text        `json:"last_name"`
 }
 
+type UserNotificationPreference struct {
+	ID               int32              `json:"id"`
+	UserID           int32              `json:"user_id"`
+	NotificationType NotificationType   `json:"notification_type"`
+	EmailEnabled     bool               `json:"email_enabled"`
+	PushEnabled      bool               `json:"push_enabled"`
+	UpdatedAt        pgtype.Timestamptz `json:"updated_at"`
+}
+
 type UserRole struct {
 	ID             int32 `json:"id"`
 	UserID         int32 `json:"user_id"`
 	OrganizationID int32 `json:"organization_id"`
 	RoleID         int32 `json:"role_id"`
 }
+
+type Webhook struct {
+	ID         int32              `json:"id"`
+	Url        string             `json:"url"`
+	Secret     string             `json:"secret"`
+	EventTypes []string           `json:"event_types"`
+	IsActive   bool               `json:"is_active"`
+	CreatedBy  pgtype.Int4        `json:"created_by"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
+}
+
+type WebhookDelivery struct {
+	ID           int32                 `json:"id"`
+	WebhookID    int32                 `json:"webhook_id"`
+	EventType    string                `json:"event_type"`
+	Payload      []byte                `json:"payload"`
+	PayloadHash  string                `json:"payload_hash"`
+	Attempt      int32                 `json:"attempt"`
+	Status       WebhookDeliveryStatus `json:"status"`
+	HttpStatus   pgtype.Int4           `json:"http_status"`
+	ResponseBody pgtype.Text           `json:"response_body"`
+	AttemptedAt  pgtype.Timestamptz    `json:"attempted_at"`
+	NextRetryAt  pgtype.Timestamptz    `json:"next_retry_at"`
+	Succeeded    bool                  `json:"succeeded"`
+	CreatedAt    pgtype.Timestamptz    `json:"created_at"`
+	UpdatedAt    pgtype.Timestamptz    `json:"updated_at"`
+}
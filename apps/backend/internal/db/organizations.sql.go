@@ -11,6 +11,30 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countOrganizationEventsThisMonth = `-- name: CountOrganizationEventsThisMonth :one
+SELECT COUNT(*) FROM events
+WHERE organization_id = $1 AND DATE_TRUNC('month', created_at) = DATE_TRUNC('month', NOW()) AND NOT is_deleted
+`
+
+func (q *Queries) CountOrganizationEventsThisMonth(ctx context.Context, organizationID int32) (int64, error) {
+	row := q.db.QueryRow(ctx, countOrganizationEventsThisMonth, organizationID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countOrganizationMembers = `-- name: CountOrganizationMembers :one
+SELECT COUNT(*) FROM user_roles
+WHERE organization_id = $1
+`
+
+func (q *Queries) CountOrganizationMembers(ctx context.Context, organizationID int32) (int64, error) {
+	row := q.db.QueryRow(ctx, countOrganizationMembers, organizationID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countOrganizationTypes = `-- name: CountOrganizationTypes :one
 SELECT COUNT(*) FROM organization_types
 `
@@ -24,10 +48,18 @@ func (q *Queries) CountOrganizationTypes(ctx context.Context) (int64, error) {
 
 const countOrganizations = `-- name: CountOrganizations :one
 SELECT COUNT(*) FROM organizations
+WHERE
+    ($1::organization_status IS NULL OR status = $1) AND
+    ($2::int IS NULL OR organization_type_id = $2)
 `
 
-func (q *Queries) CountOrganizations(ctx context.Context) (int64, error) {
-	row := q.db.QueryRow(ctx, countOrganizations)
+type CountOrganizationsParams struct {
+	Status             NullOrganizationStatus `json:"status"`
+	OrganizationTypeID pgtype.Int4            `json:"organization_type_id"`
+}
+
+func (q *Queries) CountOrganizations(ctx context.Context, arg CountOrganizationsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countOrganizations, arg.Status, arg.OrganizationTypeID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -36,7 +68,7 @@ func (q *Queries) CountOrganizations(ctx context.Context) (int64, error) {
 const createOrganization = `-- name: CreateOrganization :one
 INSERT INTO organizations (title, image_url, description, organization_type_id, instagram, telegram_channel, telegram_chat, website, youtube, tiktok, linkedin, status)
 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, COALESCE($12::organization_status, 'active'::organization_status))
-RETURNING id, title, image_url, description, organization_type_id, instagram, telegram_channel, telegram_chat, website, youtube, tiktok, linkedin, status, created_at, updated_at
+RETURNING id, title, image_url, description, organization_type_id, instagram, telegram_channel, telegram_chat, website, youtube, tiktok, linkedin, status, created_at, updated_at, monthly_event_quota, contact_email
 `
 
 type CreateOrganizationParams struct {
@@ -86,6 +118,8 @@ func (q *Queries) CreateOrganization(ctx context.Context, arg CreateOrganization
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.MonthlyEventQuota,
+		&i.ContactEmail,
 	)
 	return i, err
 }
@@ -126,8 +160,26 @@ func (q *Queries) DeleteOrganizationType(ctx context.Context, id int32) error {
 	return err
 }
 
+const deleteUserClubRoles = `-- name: DeleteUserClubRoles :execrows
+DELETE FROM user_roles
+WHERE user_id = $1 AND organization_id = $2
+`
+
+type DeleteUserClubRolesParams struct {
+	UserID         int32 `json:"user_id"`
+	OrganizationID int32 `json:"organization_id"`
+}
+
+func (q *Queries) DeleteUserClubRoles(ctx context.Context, arg DeleteUserClubRolesParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUserClubRoles, arg.UserID, arg.OrganizationID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const getOrganization = `-- name: GetOrganization :one
-SELECT id, title, image_url, description, organization_type_id, instagram, telegram_channel, telegram_chat, website, youtube, tiktok, linkedin, status, created_at, updated_at FROM organizations WHERE id = $1
+SELECT id, title, image_url, description, organization_type_id, instagram, telegram_channel, telegram_chat, website, youtube, tiktok, linkedin, status, created_at, updated_at, monthly_event_quota, contact_email FROM organizations WHERE id = $1
 `
 
 func (q *Queries) GetOrganization(ctx context.Context, id int32) (Organization, error) {
@@ -149,6 +201,8 @@ func (q *Queries) GetOrganization(ctx context.Context, id int32) (Organization,
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.MonthlyEventQuota,
+		&i.ContactEmail,
 	)
 	return i, err
 }
@@ -169,8 +223,110 @@ func (q *Queries) GetOrganizationType(ctx context.Context, id int32) (Organizati
 	return i, err
 }
 
+const getOrganizationTypeWithStats = `-- name: GetOrganizationTypeWithStats :one
+SELECT ot.id, ot.title, ot.created_at, ot.updated_at,
+    COUNT(DISTINCT o.id)::int AS organization_count,
+    COUNT(DISTINCT e.id)::int AS event_count
+FROM organization_types ot
+LEFT JOIN organizations o ON o.organization_type_id = ot.id
+LEFT JOIN events e ON e.organization_id = o.id AND NOT e.is_deleted
+WHERE ot.id = $1
+GROUP BY ot.id
+`
+
+type GetOrganizationTypeWithStatsRow struct {
+	OrganizationType  OrganizationType `json:"organization_type"`
+	OrganizationCount int32            `json:"organization_count"`
+	EventCount        int32            `json:"event_count"`
+}
+
+func (q *Queries) GetOrganizationTypeWithStats(ctx context.Context, id int32) (GetOrganizationTypeWithStatsRow, error) {
+	row := q.db.QueryRow(ctx, getOrganizationTypeWithStats, id)
+	var i GetOrganizationTypeWithStatsRow
+	err := row.Scan(
+		&i.OrganizationType.ID,
+		&i.OrganizationType.Title,
+		&i.OrganizationType.CreatedAt,
+		&i.OrganizationType.UpdatedAt,
+		&i.OrganizationCount,
+		&i.EventCount,
+	)
+	return i, err
+}
+
+const getOrganizationTypesByIDs = `-- name: GetOrganizationTypesByIDs :many
+SELECT id, title, created_at, updated_at FROM organization_types WHERE id = ANY($1::int[])
+`
+
+func (q *Queries) GetOrganizationTypesByIDs(ctx context.Context, ids []int32) ([]OrganizationType, error) {
+	rows, err := q.db.Query(ctx, getOrganizationTypesByIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OrganizationType
+	for rows.Next() {
+		var i OrganizationType
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOrganizationsByIDs = `-- name: GetOrganizationsByIDs :many
+SELECT id, title, image_url, description, organization_type_id, instagram, telegram_channel, telegram_chat, website, youtube, tiktok, linkedin, status, created_at, updated_at, monthly_event_quota, contact_email FROM organizations WHERE id = ANY($1::int[])
+`
+
+func (q *Queries) GetOrganizationsByIDs(ctx context.Context, ids []int32) ([]Organization, error) {
+	rows, err := q.db.Query(ctx, getOrganizationsByIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Organization
+	for rows.Next() {
+		var i Organization
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.ImageUrl,
+			&i.Description,
+			&i.OrganizationTypeID,
+			&i.Instagram,
+			&i.TelegramChannel,
+			&i.TelegramChat,
+			&i.Website,
+			&i.Youtube,
+			&i.Tiktok,
+			&i.Linkedin,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.MonthlyEventQuota,
+			&i.ContactEmail,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getOrganizationsByUserRoles = `-- name: GetOrganizationsByUserRoles :many
-SELECT DISTINCT o.id, o.title, o.image_url, o.description, o.organization_type_id, o.instagram, o.telegram_channel, o.telegram_chat, o.website, o.youtube, o.tiktok, o.linkedin, o.status, o.created_at, o.updated_at
+SELECT DISTINCT o.id, o.title, o.image_url, o.description, o.organization_type_id, o.instagram, o.telegram_channel, o.telegram_chat, o.website, o.youtube, o.tiktok, o.linkedin, o.status, o.created_at, o.updated_at, o.monthly_event_quota, o.contact_email
 FROM organizations o
 INNER JOIN user_roles ur ON ur.organization_id = o.id
 INNER JOIN roles r ON r.id = ur.role_id
@@ -207,6 +363,57 @@ func (q *Queries) GetOrganizationsByUserRoles(ctx context.Context, arg GetOrgani
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.MonthlyEventQuota,
+			&i.ContactEmail,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrganizationMembers = `-- name: ListOrganizationMembers :many
+SELECT u.id AS user_id, u.username, u.email, r.name AS role_name
+FROM user_roles ur
+INNER JOIN roles r ON r.id = ur.role_id
+INNER JOIN users u ON u.id = ur.user_id
+WHERE ur.organization_id = $1
+ORDER BY u.username, r.name
+LIMIT $3 OFFSET $2
+`
+
+type ListOrganizationMembersParams struct {
+	OrganizationID int32 `json:"organization_id"`
+	Offset         int32 `json:"offset"`
+	Limit          int32 `json:"limit"`
+}
+
+type ListOrganizationMembersRow struct {
+	UserID   int32  `json:"user_id"`
+	Username string `json:"username"`
+	Email    string `json:"email"`
+	RoleName string `json:"role_name"`
+}
+
+// One row per role, so a user with two roles in the club appears twice
+func (q *Queries) ListOrganizationMembers(ctx context.Context, arg ListOrganizationMembersParams) ([]ListOrganizationMembersRow, error) {
+	rows, err := q.db.Query(ctx, listOrganizationMembers, arg.OrganizationID, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOrganizationMembersRow
+	for rows.Next() {
+		var i ListOrganizationMembersRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Username,
+			&i.Email,
+			&i.RoleName,
 		); err != nil {
 			return nil, err
 		}
@@ -254,19 +461,79 @@ func (q *Queries) ListOrganizationTypes(ctx context.Context, arg ListOrganizatio
 	return items, nil
 }
 
+const listOrganizationTypesWithStats = `-- name: ListOrganizationTypesWithStats :many
+SELECT ot.id, ot.title, ot.created_at, ot.updated_at,
+    COUNT(DISTINCT o.id)::int AS organization_count,
+    COUNT(DISTINCT e.id)::int AS event_count
+FROM organization_types ot
+LEFT JOIN organizations o ON o.organization_type_id = ot.id
+LEFT JOIN events e ON e.organization_id = o.id AND NOT e.is_deleted
+GROUP BY ot.id
+ORDER BY ot.id
+LIMIT $1 OFFSET $2
+`
+
+type ListOrganizationTypesWithStatsParams struct {
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
+}
+
+type ListOrganizationTypesWithStatsRow struct {
+	OrganizationType  OrganizationType `json:"organization_type"`
+	OrganizationCount int32            `json:"organization_count"`
+	EventCount        int32            `json:"event_count"`
+}
+
+func (q *Queries) ListOrganizationTypesWithStats(ctx context.Context, arg ListOrganizationTypesWithStatsParams) ([]ListOrganizationTypesWithStatsRow, error) {
+	rows, err := q.db.Query(ctx, listOrganizationTypesWithStats, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOrganizationTypesWithStatsRow
+	for rows.Next() {
+		var i ListOrganizationTypesWithStatsRow
+		if err := rows.Scan(
+			&i.OrganizationType.ID,
+			&i.OrganizationType.Title,
+			&i.OrganizationType.CreatedAt,
+			&i.OrganizationType.UpdatedAt,
+			&i.OrganizationCount,
+			&i.EventCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listOrganizations = `-- name: ListOrganizations :many
-SELECT id, title, image_url, description, organization_type_id, instagram, telegram_channel, telegram_chat, website, youtube, tiktok, linkedin, status, created_at, updated_at FROM organizations
+SELECT id, title, image_url, description, organization_type_id, instagram, telegram_channel, telegram_chat, website, youtube, tiktok, linkedin, status, created_at, updated_at, monthly_event_quota, contact_email FROM organizations
+WHERE
+    ($3::organization_status IS NULL OR status = $3) AND
+    ($4::int IS NULL OR organization_type_id = $4)
 ORDER BY id
 LIMIT $1 OFFSET $2
 `
 
 type ListOrganizationsParams struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+	Limit              int32                  `json:"limit"`
+	Offset             int32                  `json:"offset"`
+	Status             NullOrganizationStatus `json:"status"`
+	OrganizationTypeID pgtype.Int4            `json:"organization_type_id"`
 }
 
 func (q *Queries) ListOrganizations(ctx context.Context, arg ListOrganizationsParams) ([]Organization, error) {
-	rows, err := q.db.Query(ctx, listOrganizations, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, listOrganizations,
+		arg.Limit,
+		arg.Offset,
+		arg.Status,
+		arg.OrganizationTypeID,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -290,6 +557,8 @@ func (q *Queries) ListOrganizations(ctx context.Context, arg ListOrganizationsPa
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.MonthlyEventQuota,
+			&i.ContactEmail,
 		); err != nil {
 			return nil, err
 		}
@@ -301,6 +570,121 @@ func (q *Queries) ListOrganizations(ctx context.Context, arg ListOrganizationsPa
 	return items, nil
 }
 
+const lockOrganization = `-- name: LockOrganization :one
+SELECT id, title, image_url, description, organization_type_id, instagram, telegram_channel, telegram_chat, website, youtube, tiktok, linkedin, status, created_at, updated_at, monthly_event_quota, contact_email FROM organizations WHERE id = $1 FOR UPDATE
+`
+
+// Serializes quota checks for one organization until the transaction ends
+func (q *Queries) LockOrganization(ctx context.Context, id int32) (Organization, error) {
+	row := q.db.QueryRow(ctx, lockOrganization, id)
+	var i Organization
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.ImageUrl,
+		&i.Description,
+		&i.OrganizationTypeID,
+		&i.Instagram,
+		&i.TelegramChannel,
+		&i.TelegramChat,
+		&i.Website,
+		&i.Youtube,
+		&i.Tiktok,
+		&i.Linkedin,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.MonthlyEventQuota,
+		&i.ContactEmail,
+	)
+	return i, err
+}
+
+const moveOrganizationEvents = `-- name: MoveOrganizationEvents :many
+UPDATE events
+SET organization_id = $1,
+    updated_at = NOW()
+WHERE organization_id = $2
+RETURNING id, title, description, image_url, user_id, organization_id, location, start_time, end_time, format, created_at, updated_at, capacity, published, is_deleted, deleted_at, version
+`
+
+type MoveOrganizationEventsParams struct {
+	TargetID int32 `json:"target_id"`
+	SourceID int32 `json:"source_id"`
+}
+
+// Soft-deleted events move too, so restoring one puts it in the new club
+func (q *Queries) MoveOrganizationEvents(ctx context.Context, arg MoveOrganizationEventsParams) ([]Event, error) {
+	rows, err := q.db.Query(ctx, moveOrganizationEvents, arg.TargetID, arg.SourceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Event
+	for rows.Next() {
+		var i Event
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.ImageUrl,
+			&i.UserID,
+			&i.OrganizationID,
+			&i.Location,
+			&i.StartTime,
+			&i.EndTime,
+			&i.Format,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Capacity,
+			&i.Published,
+			&i.IsDeleted,
+			&i.DeletedAt,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const replaceUserClubRole = `-- name: ReplaceUserClubRole :one
+WITH removed AS (
+    DELETE FROM user_roles
+    WHERE user_id = $1 AND organization_id = $2
+), role AS (
+    INSERT INTO roles (name) VALUES ($3)
+    ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name
+    RETURNING id
+)
+INSERT INTO user_roles (user_id, organization_id, role_id)
+SELECT $1, $2, role.id FROM role
+RETURNING id, user_id, organization_id, role_id
+`
+
+type ReplaceUserClubRoleParams struct {
+	UserID         int32  `json:"user_id"`
+	OrganizationID int32  `json:"organization_id"`
+	RoleName       string `json:"role_name"`
+}
+
+// Makes role_name the user's only role in the club, creating the role if needed
+func (q *Queries) ReplaceUserClubRole(ctx context.Context, arg ReplaceUserClubRoleParams) (UserRole, error) {
+	row := q.db.QueryRow(ctx, replaceUserClubRole, arg.UserID, arg.OrganizationID, arg.RoleName)
+	var i UserRole
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.OrganizationID,
+		&i.RoleID,
+	)
+	return i, err
+}
+
 const updateOrganization = `-- name: UpdateOrganization :one
 UPDATE organizations
 SET title = COALESCE($1, title),
@@ -315,25 +699,31 @@ SET title = COALESCE($1, title),
     tiktok = COALESCE($10, tiktok),
     linkedin = COALESCE($11, linkedin),
     status = COALESCE($12::organization_status, status),
+    monthly_event_quota = CASE WHEN $13::bool THEN NULL
+        ELSE COALESCE($14, monthly_event_quota) END,
+    contact_email = COALESCE($15, contact_email),
     updated_at = NOW()
-WHERE id = $13
-RETURNING id, title, image_url, description, organization_type_id, instagram, telegram_channel, telegram_chat, website, youtube, tiktok, linkedin, status, created_at, updated_at
+WHERE id = $16
+RETURNING id, title, image_url, description, organization_type_id, instagram, telegram_channel, telegram_chat, website, youtube, tiktok, linkedin, status, created_at, updated_at, monthly_event_quota, contact_email
 `
 
 type UpdateOrganizationParams struct {
-	Title              pgtype.Text            `json:"title"`
-	ImageUrl           pgtype.Text            `json:"image_url"`
-	Description        pgtype.Text            `json:"description"`
-	OrganizationTypeID pgtype.Int4            `json:"organization_type_id"`
-	Instagram          pgtype.Text            `json:"instagram"`
-	TelegramChannel    pgtype.Text            `json:"telegram_channel"`
-	TelegramChat       pgtype.Text            `json:"telegram_chat"`
-	Website            pgtype.Text            `json:"website"`
-	Youtube            pgtype.Text            `json:"youtube"`
-	Tiktok             pgtype.Text            `json:"tiktok"`
-	Linkedin           pgtype.Text            `json:"linkedin"`
-	Status             NullOrganizationStatus `json:"status"`
-	ID                 int32                  `json:"id"`
+	Title                  pgtype.Text            `json:"title"`
+	ImageUrl               pgtype.Text            `json:"image_url"`
+	Description            pgtype.Text            `json:"description"`
+	OrganizationTypeID     pgtype.Int4            `json:"organization_type_id"`
+	Instagram              pgtype.Text            `json:"instagram"`
+	TelegramChannel        pgtype.Text            `json:"telegram_channel"`
+	TelegramChat           pgtype.Text            `json:"telegram_chat"`
+	Website                pgtype.Text            `json:"website"`
+	Youtube                pgtype.Text            `json:"youtube"`
+	Tiktok                 pgtype.Text            `json:"tiktok"`
+	Linkedin               pgtype.Text            `json:"linkedin"`
+	Status                 NullOrganizationStatus `json:"status"`
+	ClearMonthlyEventQuota bool                   `json:"clear_monthly_event_quota"`
+	MonthlyEventQuota      pgtype.Int4            `json:"monthly_event_quota"`
+	ContactEmail           pgtype.Text            `json:"contact_email"`
+	ID                     int32                  `json:"id"`
 }
 
 func (q *Queries) UpdateOrganization(ctx context.Context, arg UpdateOrganizationParams) (Organization, error) {
@@ -350,6 +740,9 @@ func (q *Queries) UpdateOrganization(ctx context.Context, arg UpdateOrganization
 		arg.Tiktok,
 		arg.Linkedin,
 		arg.Status,
+		arg.ClearMonthlyEventQuota,
+		arg.MonthlyEventQuota,
+		arg.ContactEmail,
 		arg.ID,
 	)
 	var i Organization
@@ -369,6 +762,8 @@ func (q *Queries) UpdateOrganization(ctx context.Context, arg UpdateOrganization
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.MonthlyEventQuota,
+		&i.ContactEmail,
 	)
 	return i, err
 }
@@ -12,64 +12,199 @@ import (
 
 type Querier interface {
 	AddEventTag(ctx context.Context, arg AddEventTagParams) error
-	CancelEventRegistration(ctx context.Context, id int32) error
+	AddEventTags(ctx context.Context, arg AddEventTagsParams) error
+	// Reserves IDs for BulkInsertEvents, which can't return the rows it copies
+	AllocateEventIDs(ctx context.Context, count int32) ([]int32, error)
+	// Checks in every registration in one statement, keeping existing notes and
+	// the first check-in time
+	BulkCheckInAttendance(ctx context.Context, arg BulkCheckInAttendanceParams) ([]EventAttendance, error)
+	BulkInsertEventTags(ctx context.Context, arg []BulkInsertEventTagsParams) (int64, error)
+	BulkInsertEvents(ctx context.Context, arg []BulkInsertEventsParams) (int64, error)
+	// Affects no rows when the registration was already cancelled
+	CancelEventRegistration(ctx context.Context, id int32) (int64, error)
+	// Leases due deliveries by moving next_retry_at past the lease, so other
+	// workers skip them while they are sent. SKIP LOCKED lets several instances
+	// claim concurrently; a worker that dies mid-batch leaves its deliveries due
+	// again once the lease runs out.
+	ClaimDueWebhookDeliveries(ctx context.Context, arg ClaimDueWebhookDeliveriesParams) ([]ClaimDueWebhookDeliveriesRow, error)
+	ConsumeRegistrationCancelToken(ctx context.Context, arg ConsumeRegistrationCancelTokenParams) (EventRegistration, error)
+	CountAuditLogs(ctx context.Context, arg CountAuditLogsParams) (int64, error)
+	CountDeletedEvents(ctx context.Context) (int64, error)
 	CountEventAttendanceStats(ctx context.Context, eventID int32) (CountEventAttendanceStatsRow, error)
 	CountEventRegistrations(ctx context.Context, eventID int32) (int64, error)
 	CountEvents(ctx context.Context, arg CountEventsParams) (int64, error)
+	CountEventsByAllTags(ctx context.Context, arg CountEventsByAllTagsParams) (int64, error)
+	CountOrgInquiries(ctx context.Context, orgID int32) (int64, error)
+	CountOrganizationEventsThisMonth(ctx context.Context, organizationID int32) (int64, error)
+	CountOrganizationMembers(ctx context.Context, organizationID int32) (int64, error)
 	CountOrganizationTypes(ctx context.Context) (int64, error)
-	CountOrganizations(ctx context.Context) (int64, error)
+	CountOrganizations(ctx context.Context, arg CountOrganizationsParams) (int64, error)
 	CountPreRegisteredUsers(ctx context.Context, includeUsed bool) (int64, error)
 	CountTags(ctx context.Context) (int64, error)
+	CountTagsWithMinEventCount(ctx context.Context, minEventCount int32) (int64, error)
+	CountUserAttendedEvents(ctx context.Context, userID int32) (int64, error)
+	// Events can't lose their creator, so these block deleting the user
+	CountUserCreatedEvents(ctx context.Context, userID int32) (int64, error)
+	CountUserRegistrations(ctx context.Context, arg CountUserRegistrationsParams) (int64, error)
 	CountUsers(ctx context.Context) (int64, error)
+	CountWebhookDeliveries(ctx context.Context, webhookID int32) (int64, error)
+	CountWebhooks(ctx context.Context) (int64, error)
+	CreateAuditEntry(ctx context.Context, arg CreateAuditEntryParams) error
 	CreateEvent(ctx context.Context, arg CreateEventParams) (Event, error)
 	CreateEventAttendance(ctx context.Context, arg CreateEventAttendanceParams) (EventAttendance, error)
-	CreateEventRegistration(ctx context.Context, arg CreateEventRegistrationParams) (EventRegistration, error)
+	CreateOrgInquiry(ctx context.Context, arg CreateOrgInquiryParams) (OrgInquiry, error)
 	CreateOrganization(ctx context.Context, arg CreateOrganizationParams) (Organization, error)
 	CreateOrganizationType(ctx context.Context, title string) (OrganizationType, error)
 	// Pre-registered users queries
 	CreatePreRegisteredUser(ctx context.Context, arg CreatePreRegisteredUserParams) (PreRegisteredUser, error)
+	CreateRegistrationHold(ctx context.Context, arg CreateRegistrationHoldParams) (RegistrationHold, error)
 	CreateTag(ctx context.Context, name string) (Tag, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
 	CreateUserFromKratos(ctx context.Context, arg CreateUserFromKratosParams) (User, error)
+	CreateWebhook(ctx context.Context, arg CreateWebhookParams) (Webhook, error)
+	CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (WebhookDelivery, error)
+	// Soft delete, so registration and attendance history survive
 	DeleteEvent(ctx context.Context, id int32) error
 	DeleteOrganization(ctx context.Context, id int32) error
 	DeleteOrganizationType(ctx context.Context, id int32) error
 	DeletePreRegisteredUser(ctx context.Context, id int32) error
+	DeleteRegistrationHold(ctx context.Context, id int32) error
 	DeleteTag(ctx context.Context, id int32) error
 	DeleteUser(ctx context.Context, id int32) error
+	DeleteUserClubRoles(ctx context.Context, arg DeleteUserClubRolesParams) (int64, error)
+	DeleteWebhook(ctx context.Context, id int32) error
+	// Drops the user's club roles and clears the optional references to them,
+	// so the user row can be deleted
+	DetachUserReferences(ctx context.Context, userID int32) error
+	GetActiveRegistrationHold(ctx context.Context, arg GetActiveRegistrationHoldParams) (RegistrationHold, error)
 	GetEvent(ctx context.Context, id int32) (Event, error)
 	GetEventAttendanceByRegistration(ctx context.Context, registrationID int32) (EventAttendance, error)
 	GetEventAttendanceForEvent(ctx context.Context, eventID int32) ([]EventAttendance, error)
+	// One row per day of the month with published events starting on it, in the
+	// database time zone. Only the first 5 events of a day are listed.
+	GetEventCalendar(ctx context.Context, arg GetEventCalendarParams) ([]GetEventCalendarRow, error)
+	GetEventCapacityUsage(ctx context.Context, id int32) (GetEventCapacityUsageRow, error)
+	GetEventCounts(ctx context.Context, eventID int32) (GetEventCountsRow, error)
+	// Events of the user's clubs score 10, upcoming events matching the tags the
+	// user registered for most in the 90 days before as_of score 5, plus
+	// 1/(days until start + 1) for upcoming events. Scores are computed at as_of so
+	// they stay stable across pages. top_tags is served by
+	// idx_event_registrations_user (user_id, status), the tag match by
+	// idx_event_tags_tag.
+	GetEventFeed(ctx context.Context, arg GetEventFeedParams) ([]GetEventFeedRow, error)
 	GetEventRegistration(ctx context.Context, id int32) (EventRegistration, error)
 	GetEventRegistrationByEventAndUser(ctx context.Context, arg GetEventRegistrationByEventAndUserParams) (EventRegistration, error)
+	GetEventRegistrationForUpdate(ctx context.Context, id int32) (EventRegistration, error)
 	GetEventRegistrations(ctx context.Context, arg GetEventRegistrationsParams) ([]EventRegistration, error)
+	GetEventRegistrationsByIDs(ctx context.Context, ids []int32) ([]EventRegistration, error)
 	GetEventTagIDs(ctx context.Context, eventID int32) ([]int32, error)
 	GetEventTags(ctx context.Context, eventID int32) ([]Tag, error)
+	GetEventWithCreator(ctx context.Context, id int32) (GetEventWithCreatorRow, error)
+	GetEventsByAllTags(ctx context.Context, arg GetEventsByAllTagsParams) ([]GetEventsByAllTagsRow, error)
+	GetEventsByIDs(ctx context.Context, ids []int32) ([]Event, error)
 	GetEventsByTagID(ctx context.Context, tagID int32) ([]Event, error)
+	// Registered and attended counts of several events in one query, for list
+	// views that would otherwise count per event
+	GetEventsWithRegistrationCounts(ctx context.Context, ids []int32) ([]GetEventsWithRegistrationCountsRow, error)
+	GetOrgInquiry(ctx context.Context, id int32) (OrgInquiry, error)
 	GetOrganization(ctx context.Context, id int32) (Organization, error)
 	GetOrganizationType(ctx context.Context, id int32) (OrganizationType, error)
+	GetOrganizationTypeWithStats(ctx context.Context, id int32) (GetOrganizationTypeWithStatsRow, error)
+	GetOrganizationTypesByIDs(ctx context.Context, ids []int32) ([]OrganizationType, error)
+	GetOrganizationsByIDs(ctx context.Context, ids []int32) ([]Organization, error)
 	GetOrganizationsByUserRoles(ctx context.Context, arg GetOrganizationsByUserRolesParams) ([]Organization, error)
 	GetPreRegisteredUserByEmail(ctx context.Context, email string) (PreRegisteredUser, error)
+	// Emails of the users actively registered for an event, waitlist excluded
+	GetRegistrantEmails(ctx context.Context, eventID int32) ([]string, error)
+	GetRegistrationHoldForUpdate(ctx context.Context, id int32) (RegistrationHold, error)
 	GetTag(ctx context.Context, id int32) (Tag, error)
+	GetTagCounts(ctx context.Context, tagID int32) (GetTagCountsRow, error)
+	GetTagEventIDs(ctx context.Context, tagID int32) ([]int32, error)
+	GetTagsByIDs(ctx context.Context, ids []int32) ([]Tag, error)
+	GetTagsForEvents(ctx context.Context, eventIds []int32) ([]GetTagsForEventsRow, error)
 	GetUser(ctx context.Context, id int32) (User, error)
+	// Events the user attended, newest first. The cursor is the last row of the
+	// previous page; leave it null to page by offset.
+	GetUserAttendanceHistory(ctx context.Context, arg GetUserAttendanceHistoryParams) ([]GetUserAttendanceHistoryRow, error)
 	GetUserByEmail(ctx context.Context, email string) (User, error)
 	GetUserByKratosID(ctx context.Context, kratosID pgtype.Text) (User, error)
 	GetUserByUsername(ctx context.Context, username string) (User, error)
-	GetUserRegistrations(ctx context.Context, userID int32) ([]EventRegistration, error)
-	ListEvents(ctx context.Context, arg ListEventsParams) ([]Event, error)
+	// Every channel is enabled until the user opts out
+	GetUserNotificationPreference(ctx context.Context, arg GetUserNotificationPreferenceParams) (GetUserNotificationPreferenceRow, error)
+	// A NULL limit returns all of the user's registrations
+	GetUserRegistrations(ctx context.Context, arg GetUserRegistrationsParams) ([]EventRegistration, error)
+	// A NULL limit returns all of the user's events
+	GetUserSubscribedEvents(ctx context.Context, arg GetUserSubscribedEventsParams) ([]Event, error)
+	GetUsersByIDs(ctx context.Context, ids []int32) ([]User, error)
+	GetWebhook(ctx context.Context, id int32) (Webhook, error)
+	GetWebhookDelivery(ctx context.Context, id int32) (WebhookDelivery, error)
+	ListActiveWebhooksForEventType(ctx context.Context, eventType string) ([]Webhook, error)
+	// SpiceDB subjects are Kratos IDs, falling back to the local user ID for
+	// users without a Kratos identity
+	ListClubRoleSubjects(ctx context.Context, roles []string) ([]ListClubRoleSubjectsRow, error)
+	// Empty resource_type or resource_id matches every value
+	ListAuditLogs(ctx context.Context, arg ListAuditLogsParams) ([]AuditLog, error)
+	ListDeletedEvents(ctx context.Context, arg ListDeletedEventsParams) ([]Event, error)
+	ListEventCreatorSubjects(ctx context.Context) ([]ListEventCreatorSubjectsRow, error)
+	ListEvents(ctx context.Context, arg ListEventsParams) ([]ListEventsRow, error)
+	// Keyset pagination by (start_time, id), the cursor is the last row of the previous page
+	ListEventsByCursor(ctx context.Context, arg ListEventsByCursorParams) ([]ListEventsByCursorRow, error)
+	ListOrgInquiries(ctx context.Context, arg ListOrgInquiriesParams) ([]OrgInquiry, error)
+	ListOrganizationIDs(ctx context.Context) ([]int32, error)
+	// One row per role, so a user with two roles in the club appears twice
+	ListOrganizationMembers(ctx context.Context, arg ListOrganizationMembersParams) ([]ListOrganizationMembersRow, error)
 	ListOrganizationTypes(ctx context.Context, arg ListOrganizationTypesParams) ([]OrganizationType, error)
+	ListOrganizationTypesWithStats(ctx context.Context, arg ListOrganizationTypesWithStatsParams) ([]ListOrganizationTypesWithStatsRow, error)
 	ListOrganizations(ctx context.Context, arg ListOrganizationsParams) ([]Organization, error)
+	ListPlatformRoleSubjects(ctx context.Context) ([]ListPlatformRoleSubjectsRow, error)
 	ListPreRegisteredUsers(ctx context.Context, arg ListPreRegisteredUsersParams) ([]PreRegisteredUser, error)
-	ListTags(ctx context.Context, arg ListTagsParams) ([]Tag, error)
+	ListStatisticsSnapshots(ctx context.Context, scope string) ([]StatisticsSnapshot, error)
+	ListTags(ctx context.Context, arg ListTagsParams) ([]ListTagsRow, error)
+	ListUserNotificationPreferences(ctx context.Context, userID int32) ([]UserNotificationPreference, error)
 	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
+	ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error)
+	ListWebhooks(ctx context.Context, arg ListWebhooksParams) ([]Webhook, error)
+	// Serializes registrations and holds for one event until the transaction ends
+	LockEventForRegistration(ctx context.Context, id int32) (Event, error)
+	// Serializes quota checks for one organization until the transaction ends
+	LockOrganization(ctx context.Context, id int32) (Organization, error)
 	MarkPreRegisteredUserUsed(ctx context.Context, arg MarkPreRegisteredUserUsedParams) (PreRegisteredUser, error)
+	// Moves the source tag's events to the target tag, skipping events that
+	// already have it, so no duplicate rows are left behind
+	MergeEventTags(ctx context.Context, arg MergeEventTagsParams) (int64, error)
+	// Soft-deleted events move too, so restoring one puts it in the new club
+	MoveOrganizationEvents(ctx context.Context, arg MoveOrganizationEventsParams) ([]Event, error)
+	// Wakes StreamEventAttendance listeners, see AttendanceChannel
+	NotifyAttendanceChange(ctx context.Context, arg NotifyAttendanceChangeParams) error
+	// Moves the longest-waiting registration of the event onto the list
+	PromoteNextWaitlistRegistration(ctx context.Context, eventID int32) (EventRegistration, error)
+	PurgeExpiredRegistrationHolds(ctx context.Context) (int64, error)
+	RecordWebhookDeliveryAttempt(ctx context.Context, arg RecordWebhookDeliveryAttemptParams) error
 	RemoveEventTags(ctx context.Context, eventID int32) error
+	// Makes role_name the user's only role in the club, creating the role if needed
+	ReplaceUserClubRole(ctx context.Context, arg ReplaceUserClubRoleParams) (UserRole, error)
+	ResetWebhookDelivery(ctx context.Context, id int32) (WebhookDelivery, error)
+	RestoreEvent(ctx context.Context, id int32) (Event, error)
+	SetEventPublished(ctx context.Context, arg SetEventPublishedParams) (Event, error)
+	SetRegistrationCancelToken(ctx context.Context, arg SetRegistrationCancelTokenParams) error
+	// Requires the pg_trgm extension
+	SuggestTagsByName(ctx context.Context, arg SuggestTagsByNameParams) ([]SuggestTagsByNameRow, error)
+	// With expected_version set, no row is returned when the event was changed
+	// since the caller read it
+	// Concurrent updates with the same expected_version queue on the row lock and
+	// recheck the version once the first commits, so only one of them applies
 	UpdateEvent(ctx context.Context, arg UpdateEventParams) (Event, error)
 	UpdateEventAttendance(ctx context.Context, arg UpdateEventAttendanceParams) (EventAttendance, error)
+	UpdateOrgInquiryStatus(ctx context.Context, arg UpdateOrgInquiryStatusParams) (OrgInquiry, error)
 	UpdateOrganization(ctx context.Context, arg UpdateOrganizationParams) (Organization, error)
 	UpdateOrganizationType(ctx context.Context, arg UpdateOrganizationTypeParams) (OrganizationType, error)
 	UpdateTag(ctx context.Context, arg UpdateTagParams) (Tag, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	// Only a cancelled registration is revived, an active one returns no rows.
+	// The old cancel link is cleared so it can't cancel the new registration.
+	UpsertEventRegistration(ctx context.Context, arg UpsertEventRegistrationParams) (EventRegistration, error)
+	UpsertStatisticsSnapshot(ctx context.Context, arg UpsertStatisticsSnapshotParams) error
+	UpsertUserNotificationPreference(ctx context.Context, arg UpsertUserNotificationPreferenceParams) (UserNotificationPreference, error)
 }
 
 var _ Querier = (*Queries)(nil)
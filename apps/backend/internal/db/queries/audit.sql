@@ -0,0 +1,16 @@
+-- name: CreateAuditEntry :exec
+INSERT INTO audit_logs (actor_kratos_id, action, resource_type, resource_id, payload)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: ListAuditLogs :many
+-- Empty resource_type or resource_id matches every value
+SELECT * FROM audit_logs
+WHERE (sqlc.arg('resource_type')::text = '' OR resource_type = sqlc.arg('resource_type')::text)
+  AND (sqlc.arg('resource_id')::text = '' OR resource_id = sqlc.arg('resource_id')::text)
+ORDER BY created_at DESC, id DESC
+LIMIT $3 OFFSET $4;
+
+-- name: CountAuditLogs :one
+SELECT COUNT(*) FROM audit_logs
+WHERE (sqlc.arg('resource_type')::text = '' OR resource_type = sqlc.arg('resource_type')::text)
+  AND (sqlc.arg('resource_id')::text = '' OR resource_id = sqlc.arg('resource_id')::text);
@@ -6,14 +6,42 @@ RETURNING *;
 -- name: GetTag :one
 SELECT * FROM tags WHERE id = $1;
 
+-- name: GetTagsByIDs :many
+SELECT * FROM tags WHERE id = ANY(sqlc.arg('ids')::int[]);
+
+-- name: SuggestTagsByName :many
+-- Requires the pg_trgm extension
+SELECT id, name, similarity(name, sqlc.arg('title'))::float8 AS score
+FROM tags
+ORDER BY score DESC, id
+LIMIT sqlc.arg('limit');
+
 -- name: ListTags :many
-SELECT * FROM tags
-ORDER BY id
+SELECT sqlc.embed(t),
+    (SELECT COUNT(DISTINCT e.organization_id) FROM event_tags et JOIN events e ON e.id = et.event_id WHERE et.tag_id = t.id AND NOT e.is_deleted)::int AS organization_count,
+    (SELECT COUNT(DISTINCT et.event_id) FROM event_tags et JOIN events e ON e.id = et.event_id WHERE et.tag_id = t.id AND NOT e.is_deleted)::int AS event_count
+FROM tags t
+WHERE (SELECT COUNT(DISTINCT et.event_id) FROM event_tags et JOIN events e ON e.id = et.event_id WHERE et.tag_id = t.id AND NOT e.is_deleted) >= sqlc.arg('min_event_count')::int
+ORDER BY
+    CASE WHEN sqlc.arg('by_event_count')::bool THEN (SELECT COUNT(DISTINCT et.event_id) FROM event_tags et JOIN events e ON e.id = et.event_id WHERE et.tag_id = t.id AND NOT e.is_deleted) END DESC,
+    t.id
 LIMIT $1 OFFSET $2;
 
+-- name: GetTagCounts :one
+SELECT
+    COUNT(DISTINCT e.organization_id)::int AS organization_count,
+    COUNT(DISTINCT et.event_id)::int AS event_count
+FROM event_tags et
+JOIN events e ON e.id = et.event_id
+WHERE et.tag_id = $1 AND NOT e.is_deleted;
+
 -- name: CountTags :one
 SELECT COUNT(*) FROM tags;
 
+-- name: CountTagsWithMinEventCount :one
+SELECT COUNT(*) FROM tags t
+WHERE (SELECT COUNT(DISTINCT et.event_id) FROM event_tags et JOIN events e ON e.id = et.event_id WHERE et.tag_id = t.id AND NOT e.is_deleted) >= sqlc.arg('min_event_count')::int;
+
 -- name: UpdateTag :one
 UPDATE tags
 SET name = COALESCE(sqlc.narg('name'), name),
@@ -24,15 +52,50 @@ RETURNING *;
 -- name: DeleteTag :exec
 DELETE FROM tags WHERE id = $1;
 
+-- name: GetTagEventIDs :many
+SELECT DISTINCT event_id FROM event_tags WHERE tag_id = $1;
+
+-- name: MergeEventTags :execrows
+-- Moves the source tag's events to the target tag, skipping events that
+-- already have it, so no duplicate rows are left behind
+WITH moved AS (
+    DELETE FROM event_tags WHERE tag_id = sqlc.arg('source_id')
+    RETURNING event_id
+)
+INSERT INTO event_tags (event_id, tag_id)
+SELECT DISTINCT m.event_id, sqlc.arg('target_id')::int FROM moved m
+WHERE NOT EXISTS (
+    SELECT 1 FROM event_tags et WHERE et.event_id = m.event_id AND et.tag_id = sqlc.arg('target_id')::int
+);
+
 -- name: CreateEvent :one
-INSERT INTO events (title, description, image_url, user_id, organization_id, location, start_time, end_time, format)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, COALESCE(sqlc.narg('format')::format, 'offline'::format))
+INSERT INTO events (title, description, image_url, user_id, organization_id, location, start_time, end_time, format, capacity)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, COALESCE(sqlc.narg('format')::format, 'offline'::format), sqlc.narg('capacity'))
 RETURNING *;
 
+-- name: AllocateEventIDs :many
+-- Reserves IDs for BulkInsertEvents, which can't return the rows it copies
+SELECT nextval(pg_get_serial_sequence('events', 'id'))::int AS id
+FROM generate_series(1, sqlc.arg('count')::int);
+
+-- name: BulkInsertEvents :copyfrom
+INSERT INTO events (id, title, description, image_url, user_id, organization_id, location, start_time, end_time, format, capacity)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
+
 -- name: GetEvent :one
-SELECT * FROM events WHERE id = $1;
+SELECT * FROM events WHERE id = $1 AND NOT is_deleted;
+
+-- name: GetEventWithCreator :one
+SELECT sqlc.embed(e), u.username AS creator_username
+FROM events e
+JOIN users u ON u.id = e.user_id
+WHERE e.id = $1 AND NOT e.is_deleted;
 
 -- name: UpdateEvent :one
+-- With expected_version set, no row is returned when the event was changed
+-- since the caller read it
+-- Concurrent updates with the same expected_version queue on the row lock and
+-- recheck the version once the first commits, so only one of them applies
 UPDATE events
 SET title = COALESCE(sqlc.narg('title'), title),
     description = COALESCE(sqlc.narg('description'), description),
@@ -43,16 +106,48 @@ SET title = COALESCE(sqlc.narg('title'), title),
     start_time = COALESCE(sqlc.narg('start_time'), start_time),
     end_time = COALESCE(sqlc.narg('end_time'), end_time),
     format = COALESCE(sqlc.narg('format')::format, format),
+    capacity = COALESCE(sqlc.narg('capacity'), capacity),
+    version = version + 1,
     updated_at = NOW()
-WHERE id = sqlc.arg('id')
+WHERE id = sqlc.arg('id') AND NOT is_deleted
+  AND (sqlc.narg('expected_version')::int IS NULL OR version = sqlc.narg('expected_version')::int)
 RETURNING *;
 
 -- name: DeleteEvent :exec
-DELETE FROM events WHERE id = $1;
+-- Soft delete, so registration and attendance history survive
+UPDATE events
+SET is_deleted = TRUE,
+    deleted_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1 AND NOT is_deleted;
+
+-- name: RestoreEvent :one
+UPDATE events
+SET is_deleted = FALSE,
+    deleted_at = NULL,
+    updated_at = NOW()
+WHERE id = $1 AND is_deleted
+RETURNING *;
+
+-- name: ListDeletedEvents :many
+SELECT * FROM events
+WHERE is_deleted
+ORDER BY deleted_at DESC, id DESC
+LIMIT $1 OFFSET $2;
+
+-- name: CountDeletedEvents :one
+SELECT COUNT(*) FROM events WHERE is_deleted;
 
 -- name: AddEventTag :exec
 INSERT INTO event_tags (event_id, tag_id) VALUES ($1, $2);
 
+-- name: AddEventTags :exec
+INSERT INTO event_tags (event_id, tag_id)
+SELECT sqlc.arg('event_id'), unnest(sqlc.arg('tag_ids')::int[]);
+
+-- name: BulkInsertEventTags :copyfrom
+INSERT INTO event_tags (event_id, tag_id) VALUES ($1, $2);
+
 -- name: RemoveEventTags :exec
 DELETE FROM event_tags WHERE event_id = $1;
 
@@ -62,26 +157,97 @@ FROM tags t
 INNER JOIN event_tags et ON et.tag_id = t.id
 WHERE et.event_id = $1;
 
+-- name: GetTagsForEvents :many
+SELECT et.event_id, sqlc.embed(t)
+FROM event_tags et
+INNER JOIN tags t ON t.id = et.tag_id
+WHERE et.event_id = ANY(sqlc.arg('event_ids')::int[]);
+
 -- name: GetEventTagIDs :many
 SELECT tag_id FROM event_tags WHERE event_id = $1;
 
+-- name: GetEventCounts :one
+SELECT
+    (SELECT COUNT(*) FROM event_registrations er
+     WHERE er.event_id = sqlc.arg('event_id') AND er.status = 'registered')::int AS registration_count,
+    (SELECT COUNT(*) FROM event_attendance ea
+     JOIN event_registrations er ON er.id = ea.registration_id
+     WHERE er.event_id = sqlc.arg('event_id') AND ea.status = 'attended')::int AS attendance_count,
+    COALESCE((SELECT e.published AND NOT e.is_deleted FROM events e WHERE e.id = sqlc.arg('event_id')), false)::bool AS published;
+
+-- name: GetEventsByIDs :many
+SELECT * FROM events WHERE id = ANY(sqlc.arg('ids')::int[]) AND NOT is_deleted;
+
+-- name: GetEventsWithRegistrationCounts :many
+-- Registered and attended counts of several events in one query, for list
+-- views that would otherwise count per event
+SELECT sqlc.embed(e),
+    COUNT(DISTINCT CASE WHEN er.status = 'registered' THEN er.id END)::int AS registered_count,
+    COUNT(DISTINCT CASE WHEN ea.status = 'attended' THEN ea.id END)::int AS attended_count
+FROM events e
+LEFT JOIN event_registrations er ON er.event_id = e.id
+LEFT JOIN event_attendance ea ON ea.registration_id = er.id
+WHERE e.id = ANY(sqlc.arg('ids')::int[])
+GROUP BY e.id;
+
 -- name: GetEventsByTagID :many
 SELECT e.*
 FROM events e
 INNER JOIN event_tags et ON et.event_id = e.id
-WHERE et.tag_id = $1;
+WHERE et.tag_id = $1 AND e.published AND NOT e.is_deleted;
+
+-- name: GetUserSubscribedEvents :many
+-- A NULL limit returns all of the user's events
+SELECT e.*
+FROM events e
+INNER JOIN event_registrations er ON er.event_id = e.id
+WHERE er.user_id = sqlc.arg('user_id') AND NOT e.is_deleted
+ORDER BY er.registered_at DESC
+LIMIT sqlc.narg('limit')::int OFFSET sqlc.arg('offset');
 
 -- name: ListEvents :many
-SELECT DISTINCT e.*
+SELECT DISTINCT sqlc.embed(e),
+    (SELECT COUNT(*) FROM event_registrations er
+     WHERE er.event_id = e.id AND er.status = 'registered')::int AS registration_count,
+    (SELECT COUNT(*) FROM event_attendance ea
+     JOIN event_registrations er ON er.id = ea.registration_id
+     WHERE er.event_id = e.id AND ea.status = 'attended')::int AS attendance_count,
+    u.username AS creator_username
 FROM events e
+JOIN users u ON u.id = e.user_id
 LEFT JOIN event_tags et ON et.event_id = e.id
 WHERE 
     (sqlc.narg('user_id')::int IS NULL OR e.user_id = sqlc.narg('user_id')) AND
     (sqlc.narg('organization_id')::int IS NULL OR e.organization_id = sqlc.narg('organization_id')) AND
-    (sqlc.narg('tag_ids')::int[] IS NULL OR et.tag_id = ANY(sqlc.narg('tag_ids')::int[]))
+    (sqlc.narg('tag_ids')::int[] IS NULL OR et.tag_id = ANY(sqlc.narg('tag_ids')::int[])) AND
+    (e.published OR sqlc.arg('include_unpublished')::bool) AND
+    NOT e.is_deleted
 ORDER BY e.id
 LIMIT $1 OFFSET $2;
 
+-- name: ListEventsByCursor :many
+-- Keyset pagination by (start_time, id), the cursor is the last row of the previous page
+SELECT DISTINCT sqlc.embed(e),
+    (SELECT COUNT(*) FROM event_registrations er
+     WHERE er.event_id = e.id AND er.status = 'registered')::int AS registration_count,
+    (SELECT COUNT(*) FROM event_attendance ea
+     JOIN event_registrations er ON er.id = ea.registration_id
+     WHERE er.event_id = e.id AND ea.status = 'attended')::int AS attendance_count,
+    u.username AS creator_username
+FROM events e
+JOIN users u ON u.id = e.user_id
+LEFT JOIN event_tags et ON et.event_id = e.id
+WHERE
+    (sqlc.narg('user_id')::int IS NULL OR e.user_id = sqlc.narg('user_id')) AND
+    (sqlc.narg('organization_id')::int IS NULL OR e.organization_id = sqlc.narg('organization_id')) AND
+    (sqlc.narg('tag_ids')::int[] IS NULL OR et.tag_id = ANY(sqlc.narg('tag_ids')::int[])) AND
+    (e.published OR sqlc.arg('include_unpublished')::bool) AND
+    NOT e.is_deleted AND
+    (sqlc.narg('cursor_start_time')::timestamptz IS NULL OR
+     (e.start_time, e.id) > (sqlc.narg('cursor_start_time')::timestamptz, sqlc.narg('cursor_id')::int))
+ORDER BY e.start_time, e.id
+LIMIT sqlc.arg('limit');
+
 -- name: CountEvents :one
 SELECT COUNT(DISTINCT e.id)
 FROM events e
@@ -89,4 +255,130 @@ LEFT JOIN event_tags et ON et.event_id = e.id
 WHERE 
     (sqlc.narg('user_id')::int IS NULL OR e.user_id = sqlc.narg('user_id')) AND
     (sqlc.narg('organization_id')::int IS NULL OR e.organization_id = sqlc.narg('organization_id')) AND
-    (sqlc.narg('tag_ids')::int[] IS NULL OR et.tag_id = ANY(sqlc.narg('tag_ids')::int[]));
+    (sqlc.narg('tag_ids')::int[] IS NULL OR et.tag_id = ANY(sqlc.narg('tag_ids')::int[])) AND
+    (e.published OR sqlc.arg('include_unpublished')::bool) AND
+    NOT e.is_deleted;
+
+-- name: GetEventsByAllTags :many
+SELECT sqlc.embed(e),
+    (SELECT COUNT(*) FROM event_registrations er
+     WHERE er.event_id = e.id AND er.status = 'registered')::int AS registration_count,
+    (SELECT COUNT(*) FROM event_attendance ea
+     JOIN event_registrations er ON er.id = ea.registration_id
+     WHERE er.event_id = e.id AND ea.status = 'attended')::int AS attendance_count,
+    u.username AS creator_username
+FROM events e
+JOIN users u ON u.id = e.user_id
+WHERE e.id IN (
+    SELECT event_id FROM event_tags
+    WHERE tag_id = ANY(sqlc.arg('tag_ids')::int[])
+    GROUP BY event_id
+    HAVING COUNT(DISTINCT tag_id) = sqlc.arg('tag_count')::int
+) AND e.published AND NOT e.is_deleted
+ORDER BY e.id
+LIMIT $1 OFFSET $2;
+
+-- name: CountEventsByAllTags :one
+SELECT COUNT(*)
+FROM events e
+WHERE e.id IN (
+    SELECT event_id FROM event_tags
+    WHERE tag_id = ANY(sqlc.arg('tag_ids')::int[])
+    GROUP BY event_id
+    HAVING COUNT(DISTINCT tag_id) = sqlc.arg('tag_count')::int
+) AND e.published AND NOT e.is_deleted;
+
+-- name: GetEventCalendar :many
+-- One row per day of the month with published events starting on it, in the
+-- database time zone. Only the first 5 events of a day are listed.
+WITH days AS (
+    SELECT d::date AS day
+    FROM generate_series(
+        make_date(sqlc.arg('year')::int, sqlc.arg('month')::int, 1),
+        make_date(sqlc.arg('year')::int, sqlc.arg('month')::int, 1) + INTERVAL '1 month' - INTERVAL '1 day',
+        INTERVAL '1 day'
+    ) AS d
+), day_events AS (
+    SELECT d.day, e.id, e.title, e.start_time, e.format, e.organization_id,
+        ROW_NUMBER() OVER (PARTITION BY d.day ORDER BY e.start_time, e.id) AS rn
+    FROM days d
+    LEFT JOIN events e ON e.start_time >= d.day
+        AND e.start_time < d.day + 1
+        AND e.published AND NOT e.is_deleted
+        AND (sqlc.narg('organization_id')::int IS NULL OR e.organization_id = sqlc.narg('organization_id'))
+)
+SELECT day::date AS day,
+    COUNT(id)::int AS event_count,
+    (ARRAY_AGG(id ORDER BY rn) FILTER (WHERE rn <= 5))::int[] AS event_ids,
+    (ARRAY_AGG(title ORDER BY rn) FILTER (WHERE rn <= 5))::text[] AS event_titles,
+    (ARRAY_AGG(start_time ORDER BY rn) FILTER (WHERE rn <= 5))::timestamptz[] AS event_start_times,
+    (ARRAY_AGG(COALESCE(format::text, '') ORDER BY rn) FILTER (WHERE rn <= 5))::text[] AS event_formats,
+    (ARRAY_AGG(organization_id ORDER BY rn) FILTER (WHERE rn <= 5))::int[] AS event_organization_ids
+FROM day_events
+GROUP BY day
+HAVING COUNT(id) > 0
+ORDER BY day;
+
+-- name: GetEventFeed :many
+-- Events of the user's clubs score 10, upcoming events matching the tags the
+-- user registered for most in the 90 days before as_of score 5, plus
+-- 1/(days until start + 1) for upcoming events. Scores are computed at as_of so
+-- they stay stable across pages. top_tags is served by
+-- idx_event_registrations_user (user_id, status), the tag match by
+-- idx_event_tags_tag.
+WITH member_orgs AS (
+    SELECT DISTINCT ur.organization_id FROM user_roles ur WHERE ur.user_id = sqlc.arg('user_id')
+), top_tags AS (
+    SELECT et.tag_id
+    FROM event_registrations er
+    JOIN event_tags et ON et.event_id = er.event_id
+    WHERE er.user_id = sqlc.arg('user_id')
+      AND er.status <> 'cancelled'
+      AND er.registered_at > sqlc.arg('as_of')::timestamptz - INTERVAL '90 days'
+    GROUP BY et.tag_id
+    ORDER BY COUNT(*) DESC, et.tag_id
+    LIMIT 5
+), candidates AS (
+    SELECT e.id, 10 AS base_score, 'followed_org' AS source
+    FROM events e
+    JOIN member_orgs mo ON mo.organization_id = e.organization_id
+    WHERE e.end_time > sqlc.arg('as_of')
+    UNION ALL
+    SELECT DISTINCT e.id, 5 AS base_score, 'recommended' AS source
+    FROM events e
+    JOIN event_tags et ON et.event_id = e.id
+    JOIN top_tags tt ON tt.tag_id = et.tag_id
+    WHERE e.end_time > sqlc.arg('as_of')
+      AND NOT EXISTS (
+          SELECT 1 FROM event_registrations er
+          WHERE er.event_id = e.id AND er.user_id = sqlc.arg('user_id')
+      )
+), best AS (
+    SELECT DISTINCT ON (c.id) c.id, c.base_score, c.source
+    FROM candidates c
+    ORDER BY c.id, c.base_score DESC
+), scored AS (
+    SELECT b.id, b.source,
+        (b.base_score + CASE
+            WHEN e.start_time > sqlc.arg('as_of')
+            THEN 1.0 / (FLOOR(EXTRACT(EPOCH FROM e.start_time - sqlc.arg('as_of')) / 86400) + 1)
+            ELSE 0
+        END)::float8 AS score
+    FROM best b
+    JOIN events e ON e.id = b.id
+    WHERE e.published AND NOT e.is_deleted
+)
+SELECT sqlc.embed(e), s.source::text AS source, s.score::float8 AS score
+FROM scored s
+JOIN events e ON e.id = s.id
+WHERE sqlc.narg('cursor_score')::float8 IS NULL
+   OR (s.score, s.id) < (sqlc.narg('cursor_score')::float8, sqlc.narg('cursor_id')::int)
+ORDER BY s.score DESC, s.id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: SetEventPublished :one
+UPDATE events
+SET published = sqlc.arg('published'),
+    updated_at = NOW()
+WHERE id = sqlc.arg('id') AND NOT is_deleted
+RETURNING *;
@@ -0,0 +1,22 @@
+-- name: CreateOrgInquiry :one
+INSERT INTO org_inquiries (org_id, sender_email, sender_name, subject, message)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: ListOrgInquiries :many
+SELECT * FROM org_inquiries
+WHERE org_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT $2 OFFSET $3;
+
+-- name: CountOrgInquiries :one
+SELECT COUNT(*) FROM org_inquiries WHERE org_id = $1;
+
+-- name: GetOrgInquiry :one
+SELECT * FROM org_inquiries WHERE id = $1;
+
+-- name: UpdateOrgInquiryStatus :one
+UPDATE org_inquiries
+SET status = $2
+WHERE id = $1
+RETURNING *;
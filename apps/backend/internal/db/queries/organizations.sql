@@ -6,13 +6,30 @@ RETURNING *;
 -- name: GetOrganization :one
 SELECT * FROM organizations WHERE id = $1;
 
+-- name: GetOrganizationsByIDs :many
+SELECT * FROM organizations WHERE id = ANY(sqlc.arg('ids')::int[]);
+
+-- name: LockOrganization :one
+-- Serializes quota checks for one organization until the transaction ends
+SELECT * FROM organizations WHERE id = $1 FOR UPDATE;
+
+-- name: CountOrganizationEventsThisMonth :one
+SELECT COUNT(*) FROM events
+WHERE organization_id = $1 AND DATE_TRUNC('month', created_at) = DATE_TRUNC('month', NOW()) AND NOT is_deleted;
+
 -- name: ListOrganizations :many
 SELECT * FROM organizations
+WHERE
+    (sqlc.narg('status')::organization_status IS NULL OR status = sqlc.narg('status')) AND
+    (sqlc.narg('organization_type_id')::int IS NULL OR organization_type_id = sqlc.narg('organization_type_id'))
 ORDER BY id
 LIMIT $1 OFFSET $2;
 
 -- name: CountOrganizations :one
-SELECT COUNT(*) FROM organizations;
+SELECT COUNT(*) FROM organizations
+WHERE
+    (sqlc.narg('status')::organization_status IS NULL OR status = sqlc.narg('status')) AND
+    (sqlc.narg('organization_type_id')::int IS NULL OR organization_type_id = sqlc.narg('organization_type_id'));
 
 -- name: UpdateOrganization :one
 UPDATE organizations
@@ -28,10 +45,21 @@ SET title = COALESCE(sqlc.narg('title'), title),
     tiktok = COALESCE(sqlc.narg('tiktok'), tiktok),
     linkedin = COALESCE(sqlc.narg('linkedin'), linkedin),
     status = COALESCE(sqlc.narg('status')::organization_status, status),
+    monthly_event_quota = CASE WHEN sqlc.arg('clear_monthly_event_quota')::bool THEN NULL
+        ELSE COALESCE(sqlc.narg('monthly_event_quota'), monthly_event_quota) END,
+    contact_email = COALESCE(sqlc.narg('contact_email'), contact_email),
     updated_at = NOW()
 WHERE id = sqlc.arg('id')
 RETURNING *;
 
+-- name: MoveOrganizationEvents :many
+-- Soft-deleted events move too, so restoring one puts it in the new club
+UPDATE events
+SET organization_id = sqlc.arg('target_id'),
+    updated_at = NOW()
+WHERE organization_id = sqlc.arg('source_id')
+RETURNING *;
+
 -- name: DeleteOrganization :exec
 DELETE FROM organizations WHERE id = $1;
 
@@ -42,6 +70,38 @@ INNER JOIN user_roles ur ON ur.organization_id = o.id
 INNER JOIN roles r ON r.id = ur.role_id
 WHERE ur.user_id = $1 AND r.name = ANY(sqlc.arg('roles')::text[]);
 
+-- name: ListOrganizationMembers :many
+-- One row per role, so a user with two roles in the club appears twice
+SELECT u.id AS user_id, u.username, u.email, r.name AS role_name
+FROM user_roles ur
+INNER JOIN roles r ON r.id = ur.role_id
+INNER JOIN users u ON u.id = ur.user_id
+WHERE ur.organization_id = sqlc.arg('organization_id')
+ORDER BY u.username, r.name
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountOrganizationMembers :one
+SELECT COUNT(*) FROM user_roles
+WHERE organization_id = $1;
+
+-- name: ReplaceUserClubRole :one
+-- Makes role_name the user's only role in the club, creating the role if needed
+WITH removed AS (
+    DELETE FROM user_roles
+    WHERE user_id = sqlc.arg('user_id') AND organization_id = sqlc.arg('organization_id')
+), role AS (
+    INSERT INTO roles (name) VALUES (sqlc.arg('role_name'))
+    ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name
+    RETURNING id
+)
+INSERT INTO user_roles (user_id, organization_id, role_id)
+SELECT sqlc.arg('user_id'), sqlc.arg('organization_id'), role.id FROM role
+RETURNING *;
+
+-- name: DeleteUserClubRoles :execrows
+DELETE FROM user_roles
+WHERE user_id = $1 AND organization_id = $2;
+
 -- name: CreateOrganizationType :one
 INSERT INTO organization_types (title)
 VALUES ($1)
@@ -50,11 +110,35 @@ RETURNING *;
 -- name: GetOrganizationType :one
 SELECT * FROM organization_types WHERE id = $1;
 
+-- name: GetOrganizationTypesByIDs :many
+SELECT * FROM organization_types WHERE id = ANY(sqlc.arg('ids')::int[]);
+
 -- name: ListOrganizationTypes :many
 SELECT * FROM organization_types
 ORDER BY id
 LIMIT $1 OFFSET $2;
 
+-- name: GetOrganizationTypeWithStats :one
+SELECT sqlc.embed(ot),
+    COUNT(DISTINCT o.id)::int AS organization_count,
+    COUNT(DISTINCT e.id)::int AS event_count
+FROM organization_types ot
+LEFT JOIN organizations o ON o.organization_type_id = ot.id
+LEFT JOIN events e ON e.organization_id = o.id AND NOT e.is_deleted
+WHERE ot.id = $1
+GROUP BY ot.id;
+
+-- name: ListOrganizationTypesWithStats :many
+SELECT sqlc.embed(ot),
+    COUNT(DISTINCT o.id)::int AS organization_count,
+    COUNT(DISTINCT e.id)::int AS event_count
+FROM organization_types ot
+LEFT JOIN organizations o ON o.organization_type_id = ot.id
+LEFT JOIN events e ON e.organization_id = o.id AND NOT e.is_deleted
+GROUP BY ot.id
+ORDER BY ot.id
+LIMIT $1 OFFSET $2;
+
 -- name: CountOrganizationTypes :one
 SELECT COUNT(*) FROM organization_types;
 
@@ -1,18 +1,48 @@
--- name: CreateEventRegistration :one
+-- name: UpsertEventRegistration :one
+-- Only a cancelled registration is revived, an active one returns no rows.
+-- The old cancel link is cleared so it can't cancel the new registration.
 INSERT INTO event_registrations (event_id, user_id, status, registered_at)
-VALUES ($1, $2, 'registered', NOW())
+VALUES ($1, $2, $3, NOW())
+ON CONFLICT (event_id, user_id) DO UPDATE
+SET status = EXCLUDED.status,
+    registered_at = NOW(),
+    cancelled_at = NULL,
+    cancel_token = NULL,
+    cancel_token_expires_at = NULL,
+    updated_at = NOW()
+WHERE event_registrations.status = 'cancelled'
+RETURNING *;
+
+-- name: PromoteNextWaitlistRegistration :one
+-- Moves the longest-waiting registration of the event onto the list
+UPDATE event_registrations
+SET status = 'registered', updated_at = NOW()
+WHERE id = (
+    SELECT er.id FROM event_registrations er
+    WHERE er.event_id = $1 AND er.status = 'waitlist'
+    ORDER BY er.registered_at, er.id
+    LIMIT 1
+    FOR UPDATE
+)
 RETURNING *;
 
 -- name: GetEventRegistration :one
 SELECT * FROM event_registrations WHERE id = $1;
 
+-- name: GetEventRegistrationsByIDs :many
+SELECT * FROM event_registrations WHERE id = ANY(sqlc.arg('ids')::int[]);
+
 -- name: GetEventRegistrationByEventAndUser :one
 SELECT * FROM event_registrations WHERE event_id = $1 AND user_id = $2;
 
--- name: CancelEventRegistration :exec
+-- name: GetEventRegistrationForUpdate :one
+SELECT * FROM event_registrations WHERE id = $1 FOR UPDATE;
+
+-- name: CancelEventRegistration :execrows
+-- Affects no rows when the registration was already cancelled
 UPDATE event_registrations
 SET status = 'cancelled', cancelled_at = NOW(), updated_at = NOW()
-WHERE id = $1;
+WHERE id = $1 AND status <> 'cancelled';
 
 -- name: GetEventRegistrations :many
 SELECT * FROM event_registrations
@@ -20,13 +50,29 @@ WHERE event_id = $1
 ORDER BY registered_at DESC
 LIMIT $2 OFFSET $3;
 
+-- name: GetRegistrantEmails :many
+-- Emails of the users actively registered for an event, waitlist excluded
+SELECT u.email
+FROM event_registrations er
+JOIN users u ON u.id = er.user_id
+WHERE er.event_id = $1 AND er.status = 'registered'
+ORDER BY er.id;
+
 -- name: CountEventRegistrations :one
 SELECT COUNT(*) FROM event_registrations WHERE event_id = $1;
 
 -- name: GetUserRegistrations :many
+-- A NULL limit returns all of the user's registrations
 SELECT * FROM event_registrations
-WHERE user_id = $1
-ORDER BY registered_at DESC;
+WHERE user_id = sqlc.arg('user_id')
+  AND (sqlc.narg('status_filter')::registration_status IS NULL OR status = sqlc.narg('status_filter'))
+ORDER BY registered_at DESC
+LIMIT sqlc.narg('limit')::int OFFSET sqlc.arg('offset');
+
+-- name: CountUserRegistrations :one
+SELECT COUNT(*) FROM event_registrations
+WHERE user_id = sqlc.arg('user_id')
+  AND (sqlc.narg('status_filter')::registration_status IS NULL OR status = sqlc.narg('status_filter'));
 
 -- name: CreateEventAttendance :one
 INSERT INTO event_attendance (registration_id, status, checked_in_at, checked_in_by, notes)
@@ -39,6 +85,22 @@ SET status = $2, notes = COALESCE(sqlc.narg('notes'), notes), updated_at = NOW()
 WHERE registration_id = $1
 RETURNING *;
 
+-- name: BulkCheckInAttendance :many
+-- Checks in every registration in one statement, keeping existing notes and
+-- the first check-in time
+INSERT INTO event_attendance (registration_id, status, checked_in_at, checked_in_by)
+SELECT unnest(sqlc.arg('registration_ids')::int[]), 'checked_in', NOW(), sqlc.narg('checked_in_by')::int
+ON CONFLICT (registration_id) DO UPDATE
+SET status = 'checked_in',
+    checked_in_at = COALESCE(event_attendance.checked_in_at, EXCLUDED.checked_in_at),
+    checked_in_by = EXCLUDED.checked_in_by,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: NotifyAttendanceChange :exec
+-- Wakes StreamEventAttendance listeners, see AttendanceChannel
+SELECT pg_notify(sqlc.arg('channel')::text, sqlc.arg('payload')::text);
+
 -- name: GetEventAttendanceByRegistration :one
 SELECT * FROM event_attendance WHERE registration_id = $1;
 
@@ -48,6 +110,26 @@ FROM event_attendance ea
 INNER JOIN event_registrations er ON er.id = ea.registration_id
 WHERE er.event_id = $1;
 
+-- name: GetUserAttendanceHistory :many
+-- Events the user attended, newest first. The cursor is the last row of the
+-- previous page; leave it null to page by offset.
+SELECT sqlc.embed(e), ea.checked_in_at, ea.notes
+FROM event_attendance ea
+JOIN event_registrations er ON er.id = ea.registration_id
+JOIN events e ON e.id = er.event_id
+WHERE er.user_id = sqlc.arg('user_id') AND ea.status = 'attended' AND NOT e.is_deleted AND
+    (sqlc.narg('cursor_start_time')::timestamptz IS NULL OR
+     (e.start_time, e.id) < (sqlc.narg('cursor_start_time')::timestamptz, sqlc.narg('cursor_id')::int))
+ORDER BY e.start_time DESC, e.id DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountUserAttendedEvents :one
+SELECT COUNT(*)
+FROM event_attendance ea
+JOIN event_registrations er ON er.id = ea.registration_id
+JOIN events e ON e.id = er.event_id
+WHERE er.user_id = $1 AND ea.status = 'attended' AND NOT e.is_deleted;
+
 -- name: CountEventAttendanceStats :one
 SELECT 
     COUNT(*) FILTER (WHERE er.status = 'registered') as total_registered,
@@ -56,3 +138,45 @@ SELECT
 FROM event_registrations er
 LEFT JOIN event_attendance ea ON ea.registration_id = er.id
 WHERE er.event_id = $1;
+
+-- name: LockEventForRegistration :one
+-- Serializes registrations and holds for one event until the transaction ends
+SELECT * FROM events WHERE id = $1 AND NOT is_deleted FOR UPDATE;
+
+-- name: GetEventCapacityUsage :one
+SELECT e.capacity,
+    (SELECT COUNT(*) FROM event_registrations er WHERE er.event_id = e.id AND er.status = 'registered')::int AS registered,
+    (SELECT COUNT(*) FROM registration_holds h WHERE h.event_id = e.id AND h.expires_at > NOW())::int AS held
+FROM events e
+WHERE e.id = $1;
+
+-- name: CreateRegistrationHold :one
+INSERT INTO registration_holds (event_id, user_id, expires_at)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetActiveRegistrationHold :one
+SELECT * FROM registration_holds
+WHERE event_id = $1 AND user_id = $2 AND expires_at > NOW()
+ORDER BY expires_at DESC
+LIMIT 1;
+
+-- name: GetRegistrationHoldForUpdate :one
+SELECT * FROM registration_holds WHERE id = $1 FOR UPDATE;
+
+-- name: DeleteRegistrationHold :exec
+DELETE FROM registration_holds WHERE id = $1;
+
+-- name: PurgeExpiredRegistrationHolds :execrows
+DELETE FROM registration_holds WHERE expires_at <= NOW();
+
+-- name: SetRegistrationCancelToken :exec
+UPDATE event_registrations
+SET cancel_token = $2, cancel_token_expires_at = $3, updated_at = NOW()
+WHERE id = $1;
+
+-- name: ConsumeRegistrationCancelToken :one
+UPDATE event_registrations
+SET cancel_token = NULL, cancel_token_expires_at = NULL, updated_at = NOW()
+WHERE id = $1 AND cancel_token = $2 AND cancel_token_expires_at > NOW() AND status = 'registered'
+RETURNING *;
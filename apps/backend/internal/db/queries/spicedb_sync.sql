@@ -0,0 +1,27 @@
+-- name: ListOrganizationIDs :many
+SELECT id FROM organizations ORDER BY id;
+
+-- name: ListClubRoleSubjects :many
+-- SpiceDB subjects are Kratos IDs, falling back to the local user ID for
+-- users without a Kratos identity
+SELECT ur.organization_id, r.name AS role_name,
+    COALESCE(u.kratos_id, u.id::text)::text AS subject_id
+FROM user_roles ur
+JOIN roles r ON r.id = ur.role_id
+JOIN users u ON u.id = ur.user_id
+WHERE r.name = ANY(sqlc.arg('roles')::text[])
+ORDER BY ur.organization_id, ur.id;
+
+-- name: ListEventCreatorSubjects :many
+SELECT e.id, e.organization_id,
+    COALESCE(u.kratos_id, e.user_id::text)::text AS creator_id
+FROM events e
+LEFT JOIN users u ON u.id = e.user_id
+ORDER BY e.id;
+
+-- name: ListPlatformRoleSubjects :many
+SELECT u.kratos_id::text AS kratos_id, p.platform_role
+FROM pre_registered_users p
+JOIN users u ON u.id = p.used_by_user_id
+WHERE u.kratos_id IS NOT NULL
+ORDER BY u.id;
@@ -0,0 +1,11 @@
+-- name: UpsertStatisticsSnapshot :exec
+INSERT INTO statistics_snapshots (scope, metric_name, metric_value, computed_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (scope, metric_name) DO UPDATE
+SET metric_value = EXCLUDED.metric_value,
+    computed_at = EXCLUDED.computed_at;
+
+-- name: ListStatisticsSnapshots :many
+SELECT * FROM statistics_snapshots
+WHERE scope = $1
+ORDER BY metric_name;
@@ -6,6 +6,9 @@ RETURNING *;
 -- name: GetUser :one
 SELECT * FROM users WHERE id = $1;
 
+-- name: GetUsersByIDs :many
+SELECT * FROM users WHERE id = ANY(sqlc.arg('ids')::int[]);
+
 -- name: GetUserByEmail :one
 SELECT * FROM users WHERE email = $1;
 
@@ -40,6 +43,25 @@ RETURNING *;
 -- name: DeleteUser :exec
 DELETE FROM users WHERE id = $1;
 
+-- name: CountUserCreatedEvents :one
+-- Events can't lose their creator, so these block deleting the user
+SELECT COUNT(*) FROM events WHERE user_id = $1;
+
+-- name: DetachUserReferences :exec
+-- Drops the user's club roles and clears the optional references to them,
+-- so the user row can be deleted
+WITH club_roles AS (
+    DELETE FROM user_roles WHERE user_id = $1
+), attendance AS (
+    UPDATE event_attendance SET checked_in_by = NULL WHERE checked_in_by = $1
+), pre_registrations AS (
+    UPDATE pre_registered_users
+    SET created_by = NULLIF(created_by, $1),
+        used_by_user_id = NULLIF(used_by_user_id, $1)
+    WHERE created_by = $1 OR used_by_user_id = $1
+)
+UPDATE webhooks SET created_by = NULL WHERE created_by = $1;
+
 -- Pre-registered users queries
 
 -- name: CreatePreRegisteredUser :one
@@ -68,3 +90,25 @@ RETURNING *;
 
 -- name: DeletePreRegisteredUser :exec
 DELETE FROM pre_registered_users WHERE id = $1;
+
+-- name: ListUserNotificationPreferences :many
+SELECT * FROM user_notification_preferences
+WHERE user_id = $1
+ORDER BY notification_type;
+
+-- name: GetUserNotificationPreference :one
+-- Every channel is enabled until the user opts out
+SELECT
+    COALESCE((SELECT p.email_enabled FROM user_notification_preferences p
+              WHERE p.user_id = sqlc.arg('user_id') AND p.notification_type = sqlc.arg('notification_type')), true)::bool AS email_enabled,
+    COALESCE((SELECT p.push_enabled FROM user_notification_preferences p
+              WHERE p.user_id = sqlc.arg('user_id') AND p.notification_type = sqlc.arg('notification_type')), true)::bool AS push_enabled;
+
+-- name: UpsertUserNotificationPreference :one
+INSERT INTO user_notification_preferences (user_id, notification_type, email_enabled, push_enabled)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_id, notification_type) DO UPDATE
+SET email_enabled = EXCLUDED.email_enabled,
+    push_enabled = EXCLUDED.push_enabled,
+    updated_at = NOW()
+RETURNING *;
@@ -0,0 +1,77 @@
+-- name: CreateWebhook :one
+INSERT INTO webhooks (url, secret, event_types, created_by)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetWebhook :one
+SELECT * FROM webhooks WHERE id = $1;
+
+-- name: ListWebhooks :many
+SELECT * FROM webhooks
+ORDER BY id
+LIMIT $1 OFFSET $2;
+
+-- name: CountWebhooks :one
+SELECT COUNT(*) FROM webhooks;
+
+-- name: DeleteWebhook :exec
+DELETE FROM webhooks WHERE id = $1;
+
+-- name: ListActiveWebhooksForEventType :many
+SELECT * FROM webhooks
+WHERE is_active = true
+  AND (cardinality(event_types) = 0 OR sqlc.arg('event_type')::text = ANY(event_types));
+
+-- name: CreateWebhookDelivery :one
+INSERT INTO webhook_deliveries (webhook_id, event_type, payload, payload_hash, next_retry_at)
+VALUES ($1, $2, $3, $4, NOW())
+RETURNING *;
+
+-- name: GetWebhookDelivery :one
+SELECT * FROM webhook_deliveries WHERE id = $1;
+
+-- name: ListWebhookDeliveries :many
+SELECT * FROM webhook_deliveries
+WHERE webhook_id = $1
+ORDER BY created_at DESC
+LIMIT $2 OFFSET $3;
+
+-- name: CountWebhookDeliveries :one
+SELECT COUNT(*) FROM webhook_deliveries WHERE webhook_id = $1;
+
+-- name: ClaimDueWebhookDeliveries :many
+-- Leases due deliveries by moving next_retry_at past the lease, so other
+-- workers skip them while they are sent. SKIP LOCKED lets several instances
+-- claim concurrently; a worker that dies mid-batch leaves its deliveries due
+-- again once the lease runs out.
+WITH due AS (
+    SELECT id FROM webhook_deliveries
+    WHERE status IN ('pending', 'failed') AND next_retry_at <= NOW()
+    ORDER BY next_retry_at
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE webhook_deliveries d
+SET next_retry_at = NOW() + sqlc.arg('lease_seconds')::int * INTERVAL '1 second',
+    updated_at = NOW()
+FROM due, webhooks w
+WHERE d.id = due.id AND w.id = d.webhook_id
+RETURNING d.id, d.webhook_id, d.event_type, d.payload, d.attempt, w.url, w.secret;
+
+-- name: RecordWebhookDeliveryAttempt :exec
+UPDATE webhook_deliveries
+SET attempt = $2,
+    status = $3,
+    http_status = $4,
+    response_body = $5,
+    succeeded = $6,
+    next_retry_at = $7,
+    attempted_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: ResetWebhookDelivery :one
+UPDATE webhook_deliveries
+SET status = 'pending', attempt = 0, next_retry_at = NOW(), updated_at = NOW()
+WHERE id = $1
+RETURNING *;
@@ -11,15 +11,96 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-const cancelEventRegistration = `-- name: CancelEventRegistration :exec
+const bulkCheckInAttendance = `-- name: BulkCheckInAttendance :many
+INSERT INTO event_attendance (registration_id, status, checked_in_at, checked_in_by)
+SELECT unnest($1::int[]), 'checked_in', NOW(), $2::int
+ON CONFLICT (registration_id) DO UPDATE
+SET status = 'checked_in',
+    checked_in_at = COALESCE(event_attendance.checked_in_at, EXCLUDED.checked_in_at),
+    checked_in_by = EXCLUDED.checked_in_by,
+    updated_at = NOW()
+RETURNING id, registration_id, status, checked_in_at, checked_in_by, notes, created_at, updated_at
+`
+
+type BulkCheckInAttendanceParams struct {
+	RegistrationIds []int32     `json:"registration_ids"`
+	CheckedInBy     pgtype.Int4 `json:"checked_in_by"`
+}
+
+// Checks in every registration in one statement, keeping existing notes and
+// the first check-in time
+func (q *Queries) BulkCheckInAttendance(ctx context.Context, arg BulkCheckInAttendanceParams) ([]EventAttendance, error) {
+	rows, err := q.db.Query(ctx, bulkCheckInAttendance, arg.RegistrationIds, arg.CheckedInBy)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EventAttendance
+	for rows.Next() {
+		var i EventAttendance
+		if err := rows.Scan(
+			&i.ID,
+			&i.RegistrationID,
+			&i.Status,
+			&i.CheckedInAt,
+			&i.CheckedInBy,
+			&i.Notes,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const cancelEventRegistration = `-- name: CancelEventRegistration :execrows
 UPDATE event_registrations
 SET status = 'cancelled', cancelled_at = NOW(), updated_at = NOW()
-WHERE id = $1
+WHERE id = $1 AND status <> 'cancelled'
 `
 
-func (q *Queries) CancelEventRegistration(ctx context.Context, id int32) error {
-	_, err := q.db.Exec(ctx, cancelEventRegistration, id)
-	return err
+// Affects no rows when the registration was already cancelled
+func (q *Queries) CancelEventRegistration(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.Exec(ctx, cancelEventRegistration, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const consumeRegistrationCancelToken = `-- name: ConsumeRegistrationCancelToken :one
+UPDATE event_registrations
+SET cancel_token = NULL, cancel_token_expires_at = NULL, updated_at = NOW()
+WHERE id = $1 AND cancel_token = $2 AND cancel_token_expires_at > NOW() AND status = 'registered'
+RETURNING id, event_id, user_id, status, registered_at, cancelled_at, created_at, updated_at, cancel_token, cancel_token_expires_at
+`
+
+type ConsumeRegistrationCancelTokenParams struct {
+	ID          int32       `json:"id"`
+	CancelToken pgtype.Text `json:"cancel_token"`
+}
+
+func (q *Queries) ConsumeRegistrationCancelToken(ctx context.Context, arg ConsumeRegistrationCancelTokenParams) (EventRegistration, error) {
+	row := q.db.QueryRow(ctx, consumeRegistrationCancelToken, arg.ID, arg.CancelToken)
+	var i EventRegistration
+	err := row.Scan(
+		&i.ID,
+		&i.EventID,
+		&i.UserID,
+		&i.Status,
+		&i.RegisteredAt,
+		&i.CancelledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CancelToken,
+		&i.CancelTokenExpiresAt,
+	)
+	return i, err
 }
 
 const countEventAttendanceStats = `-- name: CountEventAttendanceStats :one
@@ -56,6 +137,39 @@ func (q *Queries) CountEventRegistrations(ctx context.Context, eventID int32) (i
 	return count, err
 }
 
+const countUserAttendedEvents = `-- name: CountUserAttendedEvents :one
+SELECT COUNT(*)
+FROM event_attendance ea
+JOIN event_registrations er ON er.id = ea.registration_id
+JOIN events e ON e.id = er.event_id
+WHERE er.user_id = $1 AND ea.status = 'attended' AND NOT e.is_deleted
+`
+
+func (q *Queries) CountUserAttendedEvents(ctx context.Context, userID int32) (int64, error) {
+	row := q.db.QueryRow(ctx, countUserAttendedEvents, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countUserRegistrations = `-- name: CountUserRegistrations :one
+SELECT COUNT(*) FROM event_registrations
+WHERE user_id = $1
+  AND ($2::registration_status IS NULL OR status = $2)
+`
+
+type CountUserRegistrationsParams struct {
+	UserID       int32                  `json:"user_id"`
+	StatusFilter NullRegistrationStatus `json:"status_filter"`
+}
+
+func (q *Queries) CountUserRegistrations(ctx context.Context, arg CountUserRegistrationsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countUserRegistrations, arg.UserID, arg.StatusFilter)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createEventAttendance = `-- name: CreateEventAttendance :one
 INSERT INTO event_attendance (registration_id, status, checked_in_at, checked_in_by, notes)
 VALUES ($1, $2, CASE WHEN $5::boolean THEN NOW() ELSE NULL END, $3, $4)
@@ -92,29 +206,61 @@ func (q *Queries) CreateEventAttendance(ctx context.Context, arg CreateEventAtte
 	return i, err
 }
 
-const createEventRegistration = `-- name: CreateEventRegistration :one
-INSERT INTO event_registrations (event_id, user_id, status, registered_at)
-VALUES ($1, $2, 'registered', NOW())
-RETURNING id, event_id, user_id, status, registered_at, cancelled_at, created_at, updated_at
+const createRegistrationHold = `-- name: CreateRegistrationHold :one
+INSERT INTO registration_holds (event_id, user_id, expires_at)
+VALUES ($1, $2, $3)
+RETURNING id, event_id, user_id, expires_at, created_at
+`
+
+type CreateRegistrationHoldParams struct {
+	EventID   int32              `json:"event_id"`
+	UserID    int32              `json:"user_id"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+}
+
+func (q *Queries) CreateRegistrationHold(ctx context.Context, arg CreateRegistrationHoldParams) (RegistrationHold, error) {
+	row := q.db.QueryRow(ctx, createRegistrationHold, arg.EventID, arg.UserID, arg.ExpiresAt)
+	var i RegistrationHold
+	err := row.Scan(
+		&i.ID,
+		&i.EventID,
+		&i.UserID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteRegistrationHold = `-- name: DeleteRegistrationHold :exec
+DELETE FROM registration_holds WHERE id = $1
 `
 
-type CreateEventRegistrationParams struct {
+func (q *Queries) DeleteRegistrationHold(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, deleteRegistrationHold, id)
+	return err
+}
+
+const getActiveRegistrationHold = `-- name: GetActiveRegistrationHold :one
+SELECT id, event_id, user_id, expires_at, created_at FROM registration_holds
+WHERE event_id = $1 AND user_id = $2 AND expires_at > NOW()
+ORDER BY expires_at DESC
+LIMIT 1
+`
+
+type GetActiveRegistrationHoldParams struct {
 	EventID int32 `json:"event_id"`
 	UserID  int32 `json:"user_id"`
 }
 
-func (q *Queries) CreateEventRegistration(ctx context.Context, arg CreateEventRegistrationParams) (EventRegistration, error) {
-	row := q.db.QueryRow(ctx, createEventRegistration, arg.EventID, arg.UserID)
-	var i EventRegistration
+func (q *Queries) GetActiveRegistrationHold(ctx context.Context, arg GetActiveRegistrationHoldParams) (RegistrationHold, error) {
+	row := q.db.QueryRow(ctx, getActiveRegistrationHold, arg.EventID, arg.UserID)
+	var i RegistrationHold
 	err := row.Scan(
 		&i.ID,
 		&i.EventID,
 		&i.UserID,
-		&i.Status,
-		&i.RegisteredAt,
-		&i.CancelledAt,
+		&i.ExpiresAt,
 		&i.CreatedAt,
-		&i.UpdatedAt,
 	)
 	return i, err
 }
@@ -175,8 +321,29 @@ func (q *Queries) GetEventAttendanceForEvent(ctx context.Context, eventID int32)
 	return items, nil
 }
 
+const getEventCapacityUsage = `-- name: GetEventCapacityUsage :one
+SELECT e.capacity,
+    (SELECT COUNT(*) FROM event_registrations er WHERE er.event_id = e.id AND er.status = 'registered')::int AS registered,
+    (SELECT COUNT(*) FROM registration_holds h WHERE h.event_id = e.id AND h.expires_at > NOW())::int AS held
+FROM events e
+WHERE e.id = $1
+`
+
+type GetEventCapacityUsageRow struct {
+	Capacity   pgtype.Int4 `json:"capacity"`
+	Registered int32       `json:"registered"`
+	Held       int32       `json:"held"`
+}
+
+func (q *Queries) GetEventCapacityUsage(ctx context.Context, id int32) (GetEventCapacityUsageRow, error) {
+	row := q.db.QueryRow(ctx, getEventCapacityUsage, id)
+	var i GetEventCapacityUsageRow
+	err := row.Scan(&i.Capacity, &i.Registered, &i.Held)
+	return i, err
+}
+
 const getEventRegistration = `-- name: GetEventRegistration :one
-SELECT id, event_id, user_id, status, registered_at, cancelled_at, created_at, updated_at FROM event_registrations WHERE id = $1
+SELECT id, event_id, user_id, status, registered_at, cancelled_at, created_at, updated_at, cancel_token, cancel_token_expires_at FROM event_registrations WHERE id = $1
 `
 
 func (q *Queries) GetEventRegistration(ctx context.Context, id int32) (EventRegistration, error) {
@@ -191,12 +358,14 @@ func (q *Queries) GetEventRegistration(ctx context.Context, id int32) (EventRegi
 		&i.CancelledAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CancelToken,
+		&i.CancelTokenExpiresAt,
 	)
 	return i, err
 }
 
 const getEventRegistrationByEventAndUser = `-- name: GetEventRegistrationByEventAndUser :one
-SELECT id, event_id, user_id, status, registered_at, cancelled_at, created_at, updated_at FROM event_registrations WHERE event_id = $1 AND user_id = $2
+SELECT id, event_id, user_id, status, registered_at, cancelled_at, created_at, updated_at, cancel_token, cancel_token_expires_at FROM event_registrations WHERE event_id = $1 AND user_id = $2
 `
 
 type GetEventRegistrationByEventAndUserParams struct {
@@ -216,12 +385,36 @@ func (q *Queries) GetEventRegistrationByEventAndUser(ctx context.Context, arg Ge
 		&i.CancelledAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CancelToken,
+		&i.CancelTokenExpiresAt,
+	)
+	return i, err
+}
+
+const getEventRegistrationForUpdate = `-- name: GetEventRegistrationForUpdate :one
+SELECT id, event_id, user_id, status, registered_at, cancelled_at, created_at, updated_at, cancel_token, cancel_token_expires_at FROM event_registrations WHERE id = $1 FOR UPDATE
+`
+
+func (q *Queries) GetEventRegistrationForUpdate(ctx context.Context, id int32) (EventRegistration, error) {
+	row := q.db.QueryRow(ctx, getEventRegistrationForUpdate, id)
+	var i EventRegistration
+	err := row.Scan(
+		&i.ID,
+		&i.EventID,
+		&i.UserID,
+		&i.Status,
+		&i.RegisteredAt,
+		&i.CancelledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CancelToken,
+		&i.CancelTokenExpiresAt,
 	)
 	return i, err
 }
 
 const getEventRegistrations = `-- name: GetEventRegistrations :many
-SELECT id, event_id, user_id, status, registered_at, cancelled_at, created_at, updated_at FROM event_registrations
+SELECT id, event_id, user_id, status, registered_at, cancelled_at, created_at, updated_at, cancel_token, cancel_token_expires_at FROM event_registrations
 WHERE event_id = $1
 ORDER BY registered_at DESC
 LIMIT $2 OFFSET $3
@@ -251,6 +444,163 @@ func (q *Queries) GetEventRegistrations(ctx context.Context, arg GetEventRegistr
 			&i.CancelledAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.CancelToken,
+			&i.CancelTokenExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getEventRegistrationsByIDs = `-- name: GetEventRegistrationsByIDs :many
+SELECT id, event_id, user_id, status, registered_at, cancelled_at, created_at, updated_at, cancel_token, cancel_token_expires_at FROM event_registrations WHERE id = ANY($1::int[])
+`
+
+func (q *Queries) GetEventRegistrationsByIDs(ctx context.Context, ids []int32) ([]EventRegistration, error) {
+	rows, err := q.db.Query(ctx, getEventRegistrationsByIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EventRegistration
+	for rows.Next() {
+		var i EventRegistration
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.UserID,
+			&i.Status,
+			&i.RegisteredAt,
+			&i.CancelledAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CancelToken,
+			&i.CancelTokenExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRegistrantEmails = `-- name: GetRegistrantEmails :many
+SELECT u.email
+FROM event_registrations er
+JOIN users u ON u.id = er.user_id
+WHERE er.event_id = $1 AND er.status = 'registered'
+ORDER BY er.id
+`
+
+// Emails of the users actively registered for an event, waitlist excluded
+func (q *Queries) GetRegistrantEmails(ctx context.Context, eventID int32) ([]string, error) {
+	rows, err := q.db.Query(ctx, getRegistrantEmails, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var email string
+		if err := rows.Scan(&email); err != nil {
+			return nil, err
+		}
+		items = append(items, email)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRegistrationHoldForUpdate = `-- name: GetRegistrationHoldForUpdate :one
+SELECT id, event_id, user_id, expires_at, created_at FROM registration_holds WHERE id = $1 FOR UPDATE
+`
+
+func (q *Queries) GetRegistrationHoldForUpdate(ctx context.Context, id int32) (RegistrationHold, error) {
+	row := q.db.QueryRow(ctx, getRegistrationHoldForUpdate, id)
+	var i RegistrationHold
+	err := row.Scan(
+		&i.ID,
+		&i.EventID,
+		&i.UserID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getUserAttendanceHistory = `-- name: GetUserAttendanceHistory :many
+SELECT e.id, e.title, e.description, e.image_url, e.user_id, e.organization_id, e.location, e.start_time, e.end_time, e.format, e.created_at, e.updated_at, e.capacity, e.published, e.is_deleted, e.deleted_at, e.version, ea.checked_in_at, ea.notes
+FROM event_attendance ea
+JOIN event_registrations er ON er.id = ea.registration_id
+JOIN events e ON e.id = er.event_id
+WHERE er.user_id = $1 AND ea.status = 'attended' AND NOT e.is_deleted AND
+    ($2::timestamptz IS NULL OR
+     (e.start_time, e.id) < ($2::timestamptz, $3::int))
+ORDER BY e.start_time DESC, e.id DESC
+LIMIT $4 OFFSET $5
+`
+
+type GetUserAttendanceHistoryParams struct {
+	UserID          int32              `json:"user_id"`
+	CursorStartTime pgtype.Timestamptz `json:"cursor_start_time"`
+	CursorID        pgtype.Int4        `json:"cursor_id"`
+	Limit           int32              `json:"limit"`
+	Offset          int32              `json:"offset"`
+}
+
+type GetUserAttendanceHistoryRow struct {
+	Event       Event              `json:"event"`
+	CheckedInAt pgtype.Timestamptz `json:"checked_in_at"`
+	Notes       pgtype.Text        `json:"notes"`
+}
+
+// Events the user attended, newest first. The cursor is the last row of the
+// previous page; leave it null to page by offset.
+func (q *Queries) GetUserAttendanceHistory(ctx context.Context, arg GetUserAttendanceHistoryParams) ([]GetUserAttendanceHistoryRow, error) {
+	rows, err := q.db.Query(ctx, getUserAttendanceHistory,
+		arg.UserID,
+		arg.CursorStartTime,
+		arg.CursorID,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUserAttendanceHistoryRow
+	for rows.Next() {
+		var i GetUserAttendanceHistoryRow
+		if err := rows.Scan(
+			&i.Event.ID,
+			&i.Event.Title,
+			&i.Event.Description,
+			&i.Event.ImageUrl,
+			&i.Event.UserID,
+			&i.Event.OrganizationID,
+			&i.Event.Location,
+			&i.Event.StartTime,
+			&i.Event.EndTime,
+			&i.Event.Format,
+			&i.Event.CreatedAt,
+			&i.Event.UpdatedAt,
+			&i.Event.Capacity,
+			&i.Event.Published,
+			&i.Event.IsDeleted,
+			&i.Event.DeletedAt,
+			&i.Event.Version,
+			&i.CheckedInAt,
+			&i.Notes,
 		); err != nil {
 			return nil, err
 		}
@@ -263,13 +613,28 @@ func (q *Queries) GetEventRegistrations(ctx context.Context, arg GetEventRegistr
 }
 
 const getUserRegistrations = `-- name: GetUserRegistrations :many
-SELECT id, event_id, user_id, status, registered_at, cancelled_at, created_at, updated_at FROM event_registrations
+SELECT id, event_id, user_id, status, registered_at, cancelled_at, created_at, updated_at, cancel_token, cancel_token_expires_at FROM event_registrations
 WHERE user_id = $1
+  AND ($2::registration_status IS NULL OR status = $2)
 ORDER BY registered_at DESC
+LIMIT $3::int OFFSET $4
 `
 
-func (q *Queries) GetUserRegistrations(ctx context.Context, userID int32) ([]EventRegistration, error) {
-	rows, err := q.db.Query(ctx, getUserRegistrations, userID)
+type GetUserRegistrationsParams struct {
+	UserID       int32                  `json:"user_id"`
+	StatusFilter NullRegistrationStatus `json:"status_filter"`
+	Limit        pgtype.Int4            `json:"limit"`
+	Offset       int32                  `json:"offset"`
+}
+
+// A NULL limit returns all of the user's registrations
+func (q *Queries) GetUserRegistrations(ctx context.Context, arg GetUserRegistrationsParams) ([]EventRegistration, error) {
+	rows, err := q.db.Query(ctx, getUserRegistrations,
+		arg.UserID,
+		arg.StatusFilter,
+		arg.Limit,
+		arg.Offset,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -286,6 +651,8 @@ func (q *Queries) GetUserRegistrations(ctx context.Context, userID int32) ([]Eve
 			&i.CancelledAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.CancelToken,
+			&i.CancelTokenExpiresAt,
 		); err != nil {
 			return nil, err
 		}
@@ -297,6 +664,112 @@ func (q *Queries) GetUserRegistrations(ctx context.Context, userID int32) ([]Eve
 	return items, nil
 }
 
+const lockEventForRegistration = `-- name: LockEventForRegistration :one
+SELECT id, title, description, image_url, user_id, organization_id, location, start_time, end_time, format, created_at, updated_at, capacity, published, is_deleted, deleted_at, version FROM events WHERE id = $1 AND NOT is_deleted FOR UPDATE
+`
+
+// Serializes registrations and holds for one event until the transaction ends
+func (q *Queries) LockEventForRegistration(ctx context.Context, id int32) (Event, error) {
+	row := q.db.QueryRow(ctx, lockEventForRegistration, id)
+	var i Event
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Description,
+		&i.ImageUrl,
+		&i.UserID,
+		&i.OrganizationID,
+		&i.Location,
+		&i.StartTime,
+		&i.EndTime,
+		&i.Format,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Capacity,
+		&i.Published,
+		&i.IsDeleted,
+		&i.DeletedAt,
+		&i.Version,
+	)
+	return i, err
+}
+
+const notifyAttendanceChange = `-- name: NotifyAttendanceChange :exec
+SELECT pg_notify($1::text, $2::text)
+`
+
+type NotifyAttendanceChangeParams struct {
+	Channel string `json:"channel"`
+	Payload string `json:"payload"`
+}
+
+// Wakes StreamEventAttendance listeners, see AttendanceChannel
+func (q *Queries) NotifyAttendanceChange(ctx context.Context, arg NotifyAttendanceChangeParams) error {
+	_, err := q.db.Exec(ctx, notifyAttendanceChange, arg.Channel, arg.Payload)
+	return err
+}
+
+const promoteNextWaitlistRegistration = `-- name: PromoteNextWaitlistRegistration :one
+UPDATE event_registrations
+SET status = 'registered', updated_at = NOW()
+WHERE id = (
+    SELECT er.id FROM event_registrations er
+    WHERE er.event_id = $1 AND er.status = 'waitlist'
+    ORDER BY er.registered_at, er.id
+    LIMIT 1
+    FOR UPDATE
+)
+RETURNING id, event_id, user_id, status, registered_at, cancelled_at, created_at, updated_at, cancel_token, cancel_token_expires_at
+`
+
+// Moves the longest-waiting registration of the event onto the list
+func (q *Queries) PromoteNextWaitlistRegistration(ctx context.Context, eventID int32) (EventRegistration, error) {
+	row := q.db.QueryRow(ctx, promoteNextWaitlistRegistration, eventID)
+	var i EventRegistration
+	err := row.Scan(
+		&i.ID,
+		&i.EventID,
+		&i.UserID,
+		&i.Status,
+		&i.RegisteredAt,
+		&i.CancelledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CancelToken,
+		&i.CancelTokenExpiresAt,
+	)
+	return i, err
+}
+
+const purgeExpiredRegistrationHolds = `-- name: PurgeExpiredRegistrationHolds :execrows
+DELETE FROM registration_holds WHERE expires_at <= NOW()
+`
+
+func (q *Queries) PurgeExpiredRegistrationHolds(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeExpiredRegistrationHolds)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setRegistrationCancelToken = `-- name: SetRegistrationCancelToken :exec
+UPDATE event_registrations
+SET cancel_token = $2, cancel_token_expires_at = $3, updated_at = NOW()
+WHERE id = $1
+`
+
+type SetRegistrationCancelTokenParams struct {
+	ID                   int32              `json:"id"`
+	CancelToken          pgtype.Text        `json:"cancel_token"`
+	CancelTokenExpiresAt pgtype.Timestamptz `json:"cancel_token_expires_at"`
+}
+
+func (q *Queries) SetRegistrationCancelToken(ctx context.Context, arg SetRegistrationCancelTokenParams) error {
+	_, err := q.db.Exec(ctx, setRegistrationCancelToken, arg.ID, arg.CancelToken, arg.CancelTokenExpiresAt)
+	return err
+}
+
 const updateEventAttendance = `-- name: UpdateEventAttendance :one
 UPDATE event_attendance
 SET status = $2, notes = COALESCE($3, notes), updated_at = NOW()
@@ -325,3 +798,43 @@ func (q *Queries) UpdateEventAttendance(ctx context.Context, arg UpdateEventAtte
 	)
 	return i, err
 }
+
+const upsertEventRegistration = `-- name: UpsertEventRegistration :one
+INSERT INTO event_registrations (event_id, user_id, status, registered_at)
+VALUES ($1, $2, $3, NOW())
+ON CONFLICT (event_id, user_id) DO UPDATE
+SET status = EXCLUDED.status,
+    registered_at = NOW(),
+    cancelled_at = NULL,
+    cancel_token = NULL,
+    cancel_token_expires_at = NULL,
+    updated_at = NOW()
+WHERE event_registrations.status = 'cancelled'
+RETURNING id, event_id, user_id, status, registered_at, cancelled_at, created_at, updated_at, cancel_token, cancel_token_expires_at
+`
+
+type UpsertEventRegistrationParams struct {
+	EventID int32              `json:"event_id"`
+	UserID  int32              `json:"user_id"`
+	Status  RegistrationStatus `json:"status"`
+}
+
+// Only a cancelled registration is revived, an active one returns no rows.
+// The old cancel link is cleared so it can't cancel the new registration.
+func (q *Queries) UpsertEventRegistration(ctx context.Context, arg UpsertEventRegistrationParams) (EventRegistration, error) {
+	row := q.db.QueryRow(ctx, upsertEventRegistration, arg.EventID, arg.UserID, arg.Status)
+	var i EventRegistration
+	err := row.Scan(
+		&i.ID,
+		&i.EventID,
+		&i.UserID,
+		&i.Status,
+		&i.RegisteredAt,
+		&i.CancelledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CancelToken,
+		&i.CancelTokenExpiresAt,
+	)
+	return i, err
+}
@@ -0,0 +1,125 @@
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Streaming queries are hand-written (sqlc has no support for row-by-row
+// iteration). Each one runs the query in a goroutine and pumps rows into an
+// unbuffered channel, so callers only ever hold a single row in memory.
+// The row channel is closed when the query finishes, fails or the context is
+// cancelled; the error channel then yields at most one error and is closed.
+
+// EventRegistrationRow is a registration joined with its user and attendance
+type EventRegistrationRow struct {
+	RegistrationID   int32                `json:"registration_id"`
+	UserID           int32                `json:"user_id"`
+	Username         string               `json:"username"`
+	Email            string               `json:"email"`
+	FirstName        pgtype.Text          `json:"first_name"`
+	LastName         pgtype.Text          `json:"last_name"`
+	Status           RegistrationStatus   `json:"status"`
+	RegisteredAt     pgtype.Timestamptz   `json:"registered_at"`
+	CancelledAt      pgtype.Timestamptz   `json:"cancelled_at"`
+	AttendanceStatus NullAttendanceStatus `json:"attendance_status"`
+	CheckedInAt      pgtype.Timestamptz   `json:"checked_in_at"`
+}
+
+const streamEventRegistrations = `
+SELECT er.id AS registration_id, er.user_id, u.username, u.email, u.first_name, u.last_name,
+       er.status, er.registered_at, er.cancelled_at,
+       ea.status AS attendance_status, ea.checked_in_at
+FROM event_registrations er
+JOIN users u ON u.id = er.user_id
+LEFT JOIN event_attendance ea ON ea.registration_id = er.id
+WHERE er.event_id = $1
+ORDER BY er.registered_at, er.id
+`
+
+// StreamEventRegistrations streams all registrations of an event
+func (q *Queries) StreamEventRegistrations(ctx context.Context, eventID int32) (<-chan EventRegistrationRow, <-chan error) {
+	return streamRows[EventRegistrationRow](ctx, q.db, streamEventRegistrations, eventID)
+}
+
+// EventAttendanceExportRow is an attendance record joined with its registrant
+type EventAttendanceExportRow struct {
+	Username    string             `json:"username"`
+	Email       string             `json:"email"`
+	Status      AttendanceStatus   `json:"status"`
+	CheckedInAt pgtype.Timestamptz `json:"checked_in_at"`
+	Notes       pgtype.Text        `json:"notes"`
+}
+
+const streamEventAttendance = `
+SELECT u.username, u.email, ea.status, ea.checked_in_at, ea.notes
+FROM event_attendance ea
+JOIN event_registrations er ON er.id = ea.registration_id
+JOIN users u ON u.id = er.user_id
+WHERE er.event_id = $1
+ORDER BY ea.checked_in_at NULLS LAST, ea.id
+`
+
+// StreamEventAttendance streams all attendance records of an event
+func (q *Queries) StreamEventAttendance(ctx context.Context, eventID int32) (<-chan EventAttendanceExportRow, <-chan error) {
+	return streamRows[EventAttendanceExportRow](ctx, q.db, streamEventAttendance, eventID)
+}
+
+const streamEvents = `
+SELECT DISTINCT e.id, e.title, e.description, e.image_url, e.user_id, e.organization_id, e.location,
+       e.start_time, e.end_time, e.format, e.capacity, e.published, e.is_deleted, e.deleted_at,
+       e.version, e.created_at, e.updated_at
+FROM events e
+LEFT JOIN event_tags et ON et.event_id = e.id
+WHERE
+    ($1::int IS NULL OR e.user_id = $1) AND
+    ($2::int IS NULL OR e.organization_id = $2) AND
+    ($3::int[] IS NULL OR et.tag_id = ANY($3::int[])) AND
+    (e.published OR $4::bool) AND
+    NOT e.is_deleted
+ORDER BY e.id
+`
+
+// StreamEvents streams all events matching the ListEvents filters.
+// Limit and Offset are ignored.
+func (q *Queries) StreamEvents(ctx context.Context, arg ListEventsParams) (<-chan Event, <-chan error) {
+	return streamRows[Event](ctx, q.db, streamEvents, arg.UserID, arg.OrganizationID, arg.TagIds, arg.IncludeUnpublished)
+}
+
+func streamRows[T any](ctx context.Context, db DBTX, query string, args ...interface{}) (<-chan T, <-chan error) {
+	out := make(chan T)
+	errc := make(chan error, 1)
+
+	go func() {
+		defer close(errc)
+		defer close(out)
+
+		rows, err := db.Query(ctx, query, args...)
+		if err != nil {
+			errc <- err
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			item, err := pgx.RowToStructByName[T](rows)
+			if err != nil {
+				errc <- err
+				return
+			}
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				errc <- ctx.Err()
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			errc <- err
+		}
+	}()
+
+	return out, errc
+}
@@ -0,0 +1,57 @@
+package db
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/studyverse/ems-backend/internal/metrics"
+)
+
+// unnamedQuery labels hand-written SQL, which has no sqlc name comment
+const unnamedQuery = "unnamed"
+
+// queryTracer records per-query latency and errors. sqlc prefixes every
+// statement with "-- name: GetEvent :one", which gives the metric label.
+type queryTracer struct{}
+
+type queryTraceKey struct{}
+
+type queryTrace struct {
+	name  string
+	start time.Time
+}
+
+func (queryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	return context.WithValue(ctx, queryTraceKey{}, queryTrace{name: queryName(data.SQL), start: time.Now()})
+}
+
+func (queryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	trace, ok := ctx.Value(queryTraceKey{}).(queryTrace)
+	if !ok {
+		return
+	}
+	metrics.ObserveDBQuery(trace.name, time.Since(trace.start))
+	if data.Err != nil {
+		metrics.DBQueryErrorsTotal.WithLabelValues(trace.name).Inc()
+	}
+}
+
+// queryName extracts the sqlc query name from the SQL text
+func queryName(sql string) string {
+	rest, ok := strings.CutPrefix(sql, "-- name: ")
+	if !ok {
+		return unnamedQuery
+	}
+	end := strings.IndexFunc(rest, unicode.IsSpace)
+	if end < 0 {
+		end = len(rest)
+	}
+	if end == 0 {
+		return unnamedQuery
+	}
+	return rest[:end]
+}
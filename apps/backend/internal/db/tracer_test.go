@@ -0,0 +1,29 @@
+package db
+
+import "testing"
+
+func TestQueryName(t *testing.T) {
+	tests := []struct {
+		name string
+		sql  string
+		want string
+	}{
+		{"sqlc query", "-- name: GetEvent :one\nSELECT * FROM events WHERE id = $1", "GetEvent"},
+		{"sqlc query with a doc comment", "-- name: UpdateEvent :one\n-- With expected_version set, no row is returned\n-- since the caller read it\nUPDATE events SET version = version + 1", "UpdateEvent"},
+		{"Windows line endings", "-- name: ListTags :many\r\nSELECT * FROM tags", "ListTags"},
+		{"name without a command", "-- name: Ping", "Ping"},
+		{"hand-written SQL", "SELECT 1", unnamedQuery},
+		{"other leading comment", "-- Soft delete\n-- name: DeleteEvent :exec\nUPDATE events SET is_deleted = TRUE", unnamedQuery},
+		{"block comment", "/* name: GetEvent */ SELECT 1", unnamedQuery},
+		{"empty name", "-- name: \nSELECT 1", unnamedQuery},
+		{"empty SQL", "", unnamedQuery},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := queryName(tt.sql); got != tt.want {
+				t.Errorf("queryName(%q) = %q, want %q", tt.sql, got, tt.want)
+			}
+		})
+	}
+}
@@ -23,6 +23,18 @@ func (q *Queries) CountPreRegisteredUsers(ctx context.Context, includeUsed bool)
 	return count, err
 }
 
+const countUserCreatedEvents = `-- name: CountUserCreatedEvents :one
+SELECT COUNT(*) FROM events WHERE user_id = $1
+`
+
+// Events can't lose their creator, so these block deleting the user
+func (q *Queries) CountUserCreatedEvents(ctx context.Context, userID int32) (int64, error) {
+	row := q.db.QueryRow(ctx, countUserCreatedEvents, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countUsers = `-- name: CountUsers :one
 SELECT COUNT(*) FROM users
 `
@@ -141,6 +153,27 @@ func (q *Queries) DeleteUser(ctx context.Context, id int32) error {
 	return err
 }
 
+const detachUserReferences = `-- name: DetachUserReferences :exec
+WITH club_roles AS (
+    DELETE FROM user_roles WHERE user_id = $1
+), attendance AS (
+    UPDATE event_attendance SET checked_in_by = NULL WHERE checked_in_by = $1
+), pre_registrations AS (
+    UPDATE pre_registered_users
+    SET created_by = NULLIF(created_by, $1),
+        used_by_user_id = NULLIF(used_by_user_id, $1)
+    WHERE created_by = $1 OR used_by_user_id = $1
+)
+UPDATE webhooks SET created_by = NULL WHERE created_by = $1
+`
+
+// Drops the user's club roles and clears the optional references to them,
+// so the user row can be deleted
+func (q *Queries) DetachUserReferences(ctx context.Context, userID int32) error {
+	_, err := q.db.Exec(ctx, detachUserReferences, userID)
+	return err
+}
+
 const getPreRegisteredUserByEmail = `-- name: GetPreRegisteredUserByEmail :one
 SELECT id, email, platform_role, created_by, used_at, used_by_user_id, created_at, updated_at FROM pre_registered_users WHERE email = $1 AND used_at IS NULL
 `
@@ -245,6 +278,66 @@ func (q *Queries) GetUserByUsername(ctx context.Context, username string) (User,
 	return i, err
 }
 
+const getUserNotificationPreference = `-- name: GetUserNotificationPreference :one
+SELECT
+    COALESCE((SELECT p.email_enabled FROM user_notification_preferences p
+              WHERE p.user_id = $1 AND p.notification_type = $2), true)::bool AS email_enabled,
+    COALESCE((SELECT p.push_enabled FROM user_notification_preferences p
+              WHERE p.user_id = $1 AND p.notification_type = $2), true)::bool AS push_enabled
+`
+
+type GetUserNotificationPreferenceParams struct {
+	UserID           int32            `json:"user_id"`
+	NotificationType NotificationType `json:"notification_type"`
+}
+
+type GetUserNotificationPreferenceRow struct {
+	EmailEnabled bool `json:"email_enabled"`
+	PushEnabled  bool `json:"push_enabled"`
+}
+
+// Every channel is enabled until the user opts out
+func (q *Queries) GetUserNotificationPreference(ctx context.Context, arg GetUserNotificationPreferenceParams) (GetUserNotificationPreferenceRow, error) {
+	row := q.db.QueryRow(ctx, getUserNotificationPreference, arg.UserID, arg.NotificationType)
+	var i GetUserNotificationPreferenceRow
+	err := row.Scan(&i.EmailEnabled, &i.PushEnabled)
+	return i, err
+}
+
+const getUsersByIDs = `-- name: GetUsersByIDs :many
+SELECT id, kratos_id, username, email, password, created_at, updated_at, first_name, last_name FROM users WHERE id = ANY($1::int[])
+`
+
+func (q *Queries) GetUsersByIDs(ctx context.Context, ids []int32) ([]User, error) {
+	rows, err := q.db.Query(ctx, getUsersByIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.KratosID,
+			&i.Username,
+			&i.Email,
+			&i.Password,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.FirstName,
+			&i.LastName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listPreRegisteredUsers = `-- name: ListPreRegisteredUsers :many
 SELECT id, email, platform_role, created_by, used_at, used_by_user_id, created_at, updated_at FROM pre_registered_users
 WHERE ($3::boolean = true OR used_at IS NULL)
@@ -287,6 +380,39 @@ func (q *Queries) ListPreRegisteredUsers(ctx context.Context, arg ListPreRegiste
 	return items, nil
 }
 
+const listUserNotificationPreferences = `-- name: ListUserNotificationPreferences :many
+SELECT id, user_id, notification_type, email_enabled, push_enabled, updated_at FROM user_notification_preferences
+WHERE user_id = $1
+ORDER BY notification_type
+`
+
+func (q *Queries) ListUserNotificationPreferences(ctx context.Context, userID int32) ([]UserNotificationPreference, error) {
+	rows, err := q.db.Query(ctx, listUserNotificationPreferences, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserNotificationPreference
+	for rows.Next() {
+		var i UserNotificationPreference
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.NotificationType,
+			&i.EmailEnabled,
+			&i.PushEnabled,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listUsers = `-- name: ListUsers :many
 SELECT id, kratos_id, username, email, password, created_at, updated_at, first_name, last_name FROM users
 ORDER BY id
@@ -387,3 +513,39 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 	)
 	return i, err
 }
+
+const upsertUserNotificationPreference = `-- name: UpsertUserNotificationPreference :one
+INSERT INTO user_notification_preferences (user_id, notification_type, email_enabled, push_enabled)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_id, notification_type) DO UPDATE
+SET email_enabled = EXCLUDED.email_enabled,
+    push_enabled = EXCLUDED.push_enabled,
+    updated_at = NOW()
+RETURNING id, user_id, notification_type, email_enabled, push_enabled, updated_at
+`
+
+type UpsertUserNotificationPreferenceParams struct {
+	UserID           int32            `json:"user_id"`
+	NotificationType NotificationType `json:"notification_type"`
+	EmailEnabled     bool             `json:"email_enabled"`
+	PushEnabled      bool             `json:"push_enabled"`
+}
+
+func (q *Queries) UpsertUserNotificationPreference(ctx context.Context, arg UpsertUserNotificationPreferenceParams) (UserNotificationPreference, error) {
+	row := q.db.QueryRow(ctx, upsertUserNotificationPreference,
+		arg.UserID,
+		arg.NotificationType,
+		arg.EmailEnabled,
+		arg.PushEnabled,
+	)
+	var i UserNotificationPreference
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.NotificationType,
+		&i.EmailEnabled,
+		&i.PushEnabled,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,45 @@
+package dberrors
+
+import (
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// uniqueViolation is the Postgres SQLSTATE of a unique constraint violation
+const uniqueViolation = "23505"
+
+// Map converts a database error to a Connect error: no rows is NotFound, a
+// unique violation is AlreadyExists and anything else is Internal. Connect
+// errors are returned unchanged, and so is nil.
+func Map(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return err
+	}
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	}
+
+	return connect.NewError(connect.CodeInternal, err)
+}
+
+// MapNotFound is Map with msg, e.g. "user not found", as the NotFound message
+func MapNotFound(err error, msg string) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return connect.NewError(connect.CodeNotFound, errors.New(msg))
+	}
+	return Map(err)
+}
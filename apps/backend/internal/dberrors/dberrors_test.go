@@ -0,0 +1,73 @@
+package dberrors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func TestMap(t *testing.T) {
+	permissionDenied := connect.NewError(connect.CodePermissionDenied, errors.New("nope"))
+
+	tests := []struct {
+		name string
+		err  error
+		want connect.Code // 0 means the result should be nil
+	}{
+		{"nil stays nil", nil, 0},
+		{"no rows", pgx.ErrNoRows, connect.CodeNotFound},
+		{"wrapped no rows", fmt.Errorf("get user: %w", pgx.ErrNoRows), connect.CodeNotFound},
+		{"unique violation", &pgconn.PgError{Code: "23505"}, connect.CodeAlreadyExists},
+		{"wrapped unique violation", fmt.Errorf("insert: %w", &pgconn.PgError{Code: "23505"}), connect.CodeAlreadyExists},
+		{"other postgres error", &pgconn.PgError{Code: "23503"}, connect.CodeInternal},
+		{"plain error", errors.New("connection reset"), connect.CodeInternal},
+		{"connect error passes through", permissionDenied, connect.CodePermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Map(tt.err)
+			if tt.want == 0 {
+				if got != nil {
+					t.Fatalf("Map(%v) = %v, want nil", tt.err, got)
+				}
+				return
+			}
+			if code := connect.CodeOf(got); code != tt.want {
+				t.Errorf("Map(%v) code = %v, want %v", tt.err, code, tt.want)
+			}
+			if !errors.Is(got, tt.err) {
+				t.Errorf("Map(%v) = %v, doesn't wrap the original error", tt.err, got)
+			}
+		})
+	}
+}
+
+func TestMapLeavesConnectErrorUnchanged(t *testing.T) {
+	err := connect.NewError(connect.CodeFailedPrecondition, errors.New("quota exceeded"))
+	if got := Map(err); got != err {
+		t.Errorf("Map() = %v, want the same *connect.Error", got)
+	}
+}
+
+func TestMapNotFound(t *testing.T) {
+	got := MapNotFound(pgx.ErrNoRows, "user not found")
+	if connect.CodeOf(got) != connect.CodeNotFound {
+		t.Errorf("MapNotFound(ErrNoRows) code = %v, want not_found", connect.CodeOf(got))
+	}
+	var connectErr *connect.Error
+	if !errors.As(got, &connectErr) || connectErr.Message() != "user not found" {
+		t.Errorf("MapNotFound(ErrNoRows) = %v, want message %q", got, "user not found")
+	}
+
+	if got := MapNotFound(errors.New("timeout"), "user not found"); connect.CodeOf(got) != connect.CodeInternal {
+		t.Errorf("MapNotFound(other) code = %v, want internal", connect.CodeOf(got))
+	}
+	if got := MapNotFound(nil, "user not found"); got != nil {
+		t.Errorf("MapNotFound(nil) = %v, want nil", got)
+	}
+}
@@ -0,0 +1,23 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/requestid"
+)
+
+// WithContext returns the default logger annotated with the request's
+// correlation attributes: "request_id" and, for authenticated requests,
+// "user_id". Attributes missing from the context are omitted.
+func WithContext(ctx context.Context) *slog.Logger {
+	logger := slog.Default()
+	if id := requestid.GetRequestID(ctx); id != "" {
+		logger = logger.With("request_id", id)
+	}
+	if userID := auth.GetUserID(ctx); userID != "" {
+		logger = logger.With("user_id", userID)
+	}
+	return logger
+}
@@ -0,0 +1,100 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// QuotaExceededTotal counts CreateEvent calls rejected by an organization's monthly quota
+var QuotaExceededTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "quota_exceeded_total",
+	Help: "Event creations rejected because the organization's monthly event quota was reached.",
+}, []string{"org_id"})
+
+// AvgPlatformAttendanceRate is the average per-event attendance rate in percent,
+// refreshed daily by the statistics service
+var AvgPlatformAttendanceRate = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: "avg_platform_attendance_rate",
+	Help: "Average share of registrants who attended, across all events, in percent.",
+})
+
+// StatisticsSnapshotAgeSeconds is the age of the dashboard statistics snapshot
+// when it was last refreshed or read
+var StatisticsSnapshotAgeSeconds = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: "statistics_snapshot_age_seconds",
+	Help: "Seconds since the dashboard statistics snapshot was computed.",
+})
+
+// DBQueryDuration is the latency of database queries by sqlc query name.
+// Hand-written SQL is labelled "unnamed".
+var DBQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "db_query_duration_seconds",
+	Help:    "Database query latency by query name.",
+	Buckets: []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+}, []string{"query"})
+
+// DBQueryErrorsTotal counts database queries that returned an error, by query name
+var DBQueryErrorsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "query_errors_total",
+	Help: "Database queries that returned an error, by query name.",
+}, []string{"query"})
+
+// ObserveDBQuery records the latency of one database query
+func ObserveDBQuery(queryName string, duration time.Duration) {
+	DBQueryDuration.WithLabelValues(queryName).Observe(duration.Seconds())
+}
+
+// SearchIndexDocuments is the number of documents in each Meilisearch index
+var SearchIndexDocuments = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "search_index_documents",
+	Help: "Documents in the search index.",
+}, []string{"index"})
+
+// SearchIndexIndexing is 1 while Meilisearch is indexing into the index, else 0
+var SearchIndexIndexing = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "search_index_indexing",
+	Help: "Whether the search index is currently indexing (1) or idle (0).",
+}, []string{"index"})
+
+// SearchIndexStale is 1 when the index document count is more than 10% off
+// the database row count
+var SearchIndexStale = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "search_index_stale",
+	Help: "Whether the search index document count deviates from the database by more than 10% (1) or not (0).",
+}, []string{"index"})
+
+// NotificationsSentTotal counts emails accepted by the notification relay,
+// one per recipient
+var NotificationsSentTotal = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "notifications_sent_total",
+	Help: "Notification emails accepted by the relay, per recipient.",
+})
+
+// Handler serves the default Prometheus registry
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// RPCRequestsTotal counts finished Connect RPCs by procedure and status code
+var RPCRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "ems_rpc_requests_total",
+	Help: "Finished RPCs by procedure and Connect status code.",
+}, []string{"procedure", "status"})
+
+// RPCDuration is the latency of Connect RPCs by procedure. For streams it is
+// the lifetime of the stream.
+var RPCDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "ems_rpc_duration_seconds",
+	Help:    "RPC latency by procedure.",
+	Buckets: prometheus.DefBuckets,
+}, []string{"procedure"})
+
+// ObserveRPC records one finished RPC; status is "ok" or a Connect code name
+func ObserveRPC(procedure, status string, duration time.Duration) {
+	RPCRequestsTotal.WithLabelValues(procedure, status).Inc()
+	RPCDuration.WithLabelValues(procedure).Observe(duration.Seconds())
+}
@@ -0,0 +1,59 @@
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// PoolStatsInterval is how often RunPoolStats samples the pool
+const PoolStatsInterval = 15 * time.Second
+
+// PoolSaturationThreshold is the share of acquired connections above which
+// RunPoolStats warns that the pool is close to exhausted
+const PoolSaturationThreshold = 0.8
+
+// DBPoolConnections is the number of database pool connections by state:
+// acquired (in use), idle, or total
+var DBPoolConnections = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "ems_db_pool_connections",
+	Help: "Database pool connections by state.",
+}, []string{"state"})
+
+// DBPoolMaxConnections is the configured pool size
+var DBPoolMaxConnections = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: "ems_db_pool_max_connections",
+	Help: "Maximum size of the database pool.",
+})
+
+// RunPoolStats samples pool.Stat() into the pool gauges until ctx is
+// cancelled, logging a warning for every sample over PoolSaturationThreshold
+func RunPoolStats(ctx context.Context, pool *pgxpool.Pool) {
+	ticker := time.NewTicker(PoolStatsInterval)
+	defer ticker.Stop()
+
+	for {
+		stat := pool.Stat()
+		DBPoolConnections.WithLabelValues("acquired").Set(float64(stat.AcquiredConns()))
+		DBPoolConnections.WithLabelValues("idle").Set(float64(stat.IdleConns()))
+		DBPoolConnections.WithLabelValues("total").Set(float64(stat.TotalConns()))
+		DBPoolMaxConnections.Set(float64(stat.MaxConns()))
+		if usage := float64(stat.AcquiredConns()) / float64(stat.MaxConns()); usage > PoolSaturationThreshold {
+			slog.Warn("Database pool is nearly saturated",
+				"acquired", stat.AcquiredConns(),
+				"max", stat.MaxConns(),
+				"emptyAcquireWaits", stat.EmptyAcquireCount(),
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
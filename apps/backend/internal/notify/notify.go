@@ -0,0 +1,66 @@
+// Package notify hands emails to an HTTP mail relay.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	requestTimeout  = 10 * time.Second
+	maxResponseBody = 1024
+)
+
+// Email is the JSON body posted to the relay
+type Email struct {
+	To      []string `json:"to"`
+	Subject string   `json:"subject"`
+	Body    string   `json:"body"`
+}
+
+// Client posts emails to the relay at url
+type Client struct {
+	url    string
+	client *http.Client
+}
+
+// NewClient returns nil when url is empty, so callers can treat a nil
+// *Client as notifications being disabled
+func NewClient(url string) *Client {
+	if url == "" {
+		return nil
+	}
+	return &Client{url: url, client: &http.Client{Timeout: requestTimeout}}
+}
+
+// Send posts one email. Any non-2xx answer is an error carrying the start
+// of the response body.
+func (c *Client) Send(ctx context.Context, email Email) error {
+	body, err := json.Marshal(email)
+	if err != nil {
+		return fmt.Errorf("failed to encode email: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+		return fmt.Errorf("relay answered %d: %s", resp.StatusCode, respBody)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package perms
 
 import (
 	"context"
+	"io"
 	"log/slog"
 
 	pb "github.com/authzed/authzed-go/proto/authzed/api/v1"
@@ -230,6 +231,48 @@ func (c *Client) LookupSubjects(ctx context.Context, resourceType, resourceID, p
 	return subjectIDs, nil
 }
 
+// MaxReadRelationships caps how many relationships ReadRelationships returns
+const MaxReadRelationships = 1000
+
+// ReadRelationships returns the relationships stored directly on a resource,
+// read fully consistent so debugging sees the latest writes
+func (c *Client) ReadRelationships(ctx context.Context, resourceType, resourceID string) ([]Relationship, error) {
+	stream, err := c.client.ReadRelationships(ctx, &pb.ReadRelationshipsRequest{
+		Consistency: &pb.Consistency{
+			Requirement: &pb.Consistency_FullyConsistent{FullyConsistent: true},
+		},
+		RelationshipFilter: &pb.RelationshipFilter{
+			ResourceType:       resourceType,
+			OptionalResourceId: resourceID,
+		},
+		OptionalLimit: MaxReadRelationships,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var relationships []Relationship
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		rel := resp.GetRelationship()
+		relationships = append(relationships, Relationship{
+			Resource:    rel.GetResource().GetObjectType(),
+			ResourceID:  rel.GetResource().GetObjectId(),
+			Relation:    rel.GetRelation(),
+			SubjectType: rel.GetSubject().GetObject().GetObjectType(),
+			SubjectID:   rel.GetSubject().GetObject().GetObjectId(),
+		})
+	}
+
+	return relationships, nil
+}
+
 // Platform ID constant - use this for platform-level permissions
 const PlatformID = "astanait"
 
@@ -252,13 +295,140 @@ func (c *Client) SetupClubRelationship(ctx context.Context, clubID, userID, role
 		{
 			Resource:    "club",
 			ResourceID:  clubID,
-			Relation:    role, // "president" or "member"
+			Relation:    role, // "president", "staff" or "member"
 			SubjectType: "user",
 			SubjectID:   userID,
 		},
 	})
 }
 
+// RevokeClubRole removes one of a user's roles in a club
+func (c *Client) RevokeClubRole(ctx context.Context, clubID, userID, role string) error {
+	return c.DeleteRelationships(ctx, []Relationship{
+		{
+			Resource:    "club",
+			ResourceID:  clubID,
+			Relation:    role, // "president", "staff" or "member"
+			SubjectType: "user",
+			SubjectID:   userID,
+		},
+	})
+}
+
+// RevokeClubRoleForAllClubs removes every role the user holds in any club
+// with a single filtered delete
+func (c *Client) RevokeClubRoleForAllClubs(ctx context.Context, userID string) error {
+	_, err := c.client.DeleteRelationships(ctx, &pb.DeleteRelationshipsRequest{
+		RelationshipFilter: &pb.RelationshipFilter{
+			ResourceType: "club",
+			OptionalSubjectFilter: &pb.SubjectFilter{
+				SubjectType:       "user",
+				OptionalSubjectId: userID,
+			},
+		},
+	})
+	if err != nil {
+		slog.Error("SpiceDB DeleteRelationships by filter failed", "user_id", userID, "error", err)
+		return err
+	}
+	return nil
+}
+
+// userResourceTypes are the schema definitions with relations to users
+var userResourceTypes = []string{"platform", "club", "event", "event_registration"}
+
+// DeleteUserRelationships removes every relationship with the user as subject,
+// one filtered delete per resource type that can relate to a user
+func (c *Client) DeleteUserRelationships(ctx context.Context, userID string) error {
+	for _, resourceType := range userResourceTypes {
+		_, err := c.client.DeleteRelationships(ctx, &pb.DeleteRelationshipsRequest{
+			RelationshipFilter: &pb.RelationshipFilter{
+				ResourceType: resourceType,
+				OptionalSubjectFilter: &pb.SubjectFilter{
+					SubjectType:       "user",
+					OptionalSubjectId: userID,
+				},
+			},
+		})
+		if err != nil {
+			slog.Error("SpiceDB DeleteRelationships by filter failed", "resource_type", resourceType, "user_id", userID, "error", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// GetClubRoles returns the relations the user holds directly on a club,
+// read fully consistent so a revoke can be undone exactly
+func (c *Client) GetClubRoles(ctx context.Context, clubID, userID string) ([]string, error) {
+	stream, err := c.client.ReadRelationships(ctx, &pb.ReadRelationshipsRequest{
+		Consistency: &pb.Consistency{
+			Requirement: &pb.Consistency_FullyConsistent{FullyConsistent: true},
+		},
+		RelationshipFilter: &pb.RelationshipFilter{
+			ResourceType:       "club",
+			OptionalResourceId: clubID,
+			OptionalSubjectFilter: &pb.SubjectFilter{
+				SubjectType:       "user",
+				OptionalSubjectId: userID,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var roles []string
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, resp.GetRelationship().GetRelation())
+	}
+
+	return roles, nil
+}
+
+// mergeClubsBatchSize keeps MergeClubs writes under SpiceDB's per-request update limit
+const mergeClubsBatchSize = 500
+
+// MergeClubs records that source was merged into target and moves the given
+// events of source over to target
+func (c *Client) MergeClubs(ctx context.Context, sourceClubID, targetClubID string, eventIDs []string) error {
+	if err := c.WriteRelationships(ctx, []Relationship{
+		{
+			Resource:    "club",
+			ResourceID:  targetClubID,
+			Relation:    "merged_from",
+			SubjectType: "club",
+			SubjectID:   sourceClubID,
+		},
+	}); err != nil {
+		return err
+	}
+
+	for start := 0; start < len(eventIDs); start += mergeClubsBatchSize {
+		batch := eventIDs[start:min(start+mergeClubsBatchSize, len(eventIDs))]
+		oldHosts := make([]Relationship, len(batch))
+		newHosts := make([]Relationship, len(batch))
+		for i, eventID := range batch {
+			oldHosts[i] = Relationship{Resource: "event", ResourceID: eventID, Relation: "host_club", SubjectType: "club", SubjectID: sourceClubID}
+			newHosts[i] = Relationship{Resource: "event", ResourceID: eventID, Relation: "host_club", SubjectType: "club", SubjectID: targetClubID}
+		}
+		if err := c.WriteRelationships(ctx, newHosts); err != nil {
+			return err
+		}
+		if err := c.DeleteRelationships(ctx, oldHosts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // LinkClubToPlatform ensures a club is linked to the platform for permission inheritance
 func (c *Client) LinkClubToPlatform(ctx context.Context, clubID string) error {
 	return c.WriteRelationships(ctx, []Relationship{
@@ -298,6 +468,24 @@ func (c *Client) IsGlobalStaff(ctx context.Context, userID string) (bool, error)
 	return c.CheckPermission(ctx, userID, "platform", PlatformID, "manage_clubs")
 }
 
+// Ping checks that SpiceDB answers a permission check for a user that
+// doesn't exist. Only errors count; the answer itself is ignored.
+func (c *Client) Ping(ctx context.Context) error {
+	_, err := c.CheckPermission(ctx, "healthcheck", "platform", PlatformID, "manage_clubs")
+	return err
+}
+
+// GetPlatformAdmins returns the IDs of all users with manage_system on the platform
+func (c *Client) GetPlatformAdmins(ctx context.Context) ([]string, error) {
+	return c.LookupSubjects(ctx, "platform", PlatformID, "manage_system")
+}
+
+// GetPlatformStaff returns the IDs of all users with manage_clubs on the platform
+// (this includes admins, since manage_clubs = admin + staff)
+func (c *Client) GetPlatformStaff(ctx context.Context) ([]string, error) {
+	return c.LookupSubjects(ctx, "platform", PlatformID, "manage_clubs")
+}
+
 // GetManagedClubs returns all club IDs where the user can manage (president or staff)
 func (c *Client) GetManagedClubs(ctx context.Context, userID string) ([]string, error) {
 	return c.LookupResources(ctx, userID, "club", "manage_settings")
@@ -0,0 +1,102 @@
+package ratelimit
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/clientip"
+	"golang.org/x/time/rate"
+)
+
+// idleTTL is how long a client's bucket is kept after its last request
+const idleTTL = 10 * time.Minute
+
+// Limiter keeps a token bucket per client, keyed by Kratos user ID for
+// authenticated requests and by client IP otherwise
+type Limiter struct {
+	limit rate.Limit
+	burst int
+
+	mu        sync.Mutex
+	clients   map[string]*client
+	lastSweep time.Time
+}
+
+type client struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// New creates a limiter allowing rps requests per second per client with
+// bursts of up to burst requests
+func New(rps float64, burst int) *Limiter {
+	return &Limiter{
+		limit:     rate.Limit(rps),
+		burst:     max(burst, 1),
+		clients:   make(map[string]*client),
+		lastSweep: time.Now(),
+	}
+}
+
+// Middleware rejects requests over the client's limit with 429 Too Many
+// Requests and a Retry-After header. It must run after the auth middleware
+// so the user ID is in the context.
+func (l *Limiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reservation := l.bucket(clientKey(r)).Reserve()
+		if delay := reservation.Delay(); delay > 0 {
+			// Give the token back, the request isn't going to wait for it
+			reservation.Cancel()
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+			http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// bucket returns the client's token bucket, creating it on first use and
+// dropping idle ones so the map doesn't grow without bound
+func (l *Limiter) bucket(key string) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(l.lastSweep) > idleTTL {
+		for k, c := range l.clients {
+			if now.Sub(c.lastSeen) > idleTTL {
+				delete(l.clients, k)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	c, ok := l.clients[key]
+	if !ok {
+		c = &client{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.clients[key] = c
+	}
+	c.lastSeen = now
+	return c.limiter
+}
+
+// clientKey identifies the caller: the user ID when authenticated, otherwise
+// the client IP resolved by the clientip middleware
+func clientKey(r *http.Request) string {
+	if userID := auth.GetUserID(r.Context()); userID != "" {
+		return "user:" + userID
+	}
+	if ip := clientip.FromContext(r.Context()); ip != "" {
+		return "ip:" + ip
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return "ip:" + host
+}
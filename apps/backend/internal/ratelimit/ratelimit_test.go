@@ -0,0 +1,72 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/clientip"
+)
+
+func TestClientKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		userID     string
+		clientIP   string
+		remoteAddr string
+		want       string
+	}{
+		{"authenticated user wins over IP", "kratos-1", "203.0.113.7", "10.0.0.1:4000", "user:kratos-1"},
+		{"anonymous uses resolved client IP", "", "203.0.113.7", "10.0.0.1:4000", "ip:203.0.113.7"},
+		{"falls back to peer without middleware", "", "", "198.51.100.2:4000", "ip:198.51.100.2"},
+		{"peer without port", "", "", "198.51.100.2", "ip:198.51.100.2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/", nil)
+			r.RemoteAddr = tt.remoteAddr
+			ctx := context.Background()
+			if tt.userID != "" {
+				ctx = context.WithValue(ctx, auth.UserIDKey, tt.userID)
+			}
+			if tt.clientIP != "" {
+				ctx = clientip.WithClientIP(ctx, tt.clientIP)
+			}
+			if got := clientKey(r.WithContext(ctx)); got != tt.want {
+				t.Errorf("clientKey() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMiddlewareBurstAndIsolation(t *testing.T) {
+	l := New(0.001, 2)
+	handler := l.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	request := func(userID string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest("GET", "/", nil)
+		r = r.WithContext(context.WithValue(r.Context(), auth.UserIDKey, userID))
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	for i := range 2 {
+		if w := request("alice"); w.Code != http.StatusOK {
+			t.Fatalf("request %d within burst: status %d", i+1, w.Code)
+		}
+	}
+	w := request("alice")
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("request over burst: status %d, want 429", w.Code)
+	}
+	if w.Header().Get("Retry-After") == "" {
+		t.Error("429 response has no Retry-After header")
+	}
+	if w := request("bob"); w.Code != http.StatusOK {
+		t.Errorf("other user limited by alice's bucket: status %d", w.Code)
+	}
+}
@@ -0,0 +1,56 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// Defaults for polling Meilisearch tasks
+const (
+	taskPollInitialInterval = 50 * time.Millisecond
+	taskPollMaxInterval     = 2 * time.Second
+	taskPollTimeout         = 30 * time.Second
+)
+
+// TaskGetter is the part of the Meilisearch client that task polling needs
+type TaskGetter interface {
+	GetTaskWithContext(ctx context.Context, taskUID int64) (*meilisearch.Task, error)
+}
+
+// WaitForTaskWithBackoff polls a task until it is no longer enqueued or
+// processing. The interval starts at initialInterval and doubles after every
+// poll up to maxInterval. It gives up once timeout has passed or ctx is done.
+// Like WaitForTask, a failed task is returned without an error.
+func WaitForTaskWithBackoff(ctx context.Context, meili TaskGetter, taskUID int64, initialInterval, maxInterval, timeout time.Duration) (*meilisearch.Task, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	interval := initialInterval
+	for {
+		task, err := meili.GetTaskWithContext(ctx, taskUID)
+		if err != nil {
+			return nil, err
+		}
+		if task.Status != meilisearch.TaskStatusEnqueued && task.Status != meilisearch.TaskStatusProcessing {
+			return task, nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("waiting for task %d: %w", taskUID, ctx.Err())
+		case <-timer.C:
+		}
+		interval = min(interval*2, maxInterval)
+	}
+}
+
+// waitForTask waits for a task with the default backoff and timeout
+func (c *Client) waitForTask(ctx context.Context, taskUID int64) error {
+	_, err := WaitForTaskWithBackoff(ctx, c.meili, taskUID, taskPollInitialInterval, taskPollMaxInterval, taskPollTimeout)
+	return err
+}
@@ -0,0 +1,119 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// fakeTasks reports the task as processing until the done-th poll, then
+// with the final status, and records when each poll happened
+type fakeTasks struct {
+	done   int
+	final  meilisearch.TaskStatus
+	err    error
+	polled []time.Time
+}
+
+func (f *fakeTasks) GetTaskWithContext(ctx context.Context, taskUID int64) (*meilisearch.Task, error) {
+	f.polled = append(f.polled, time.Now())
+	if f.err != nil {
+		return nil, f.err
+	}
+	status := meilisearch.TaskStatusProcessing
+	if len(f.polled) == 1 {
+		status = meilisearch.TaskStatusEnqueued
+	}
+	if f.done > 0 && len(f.polled) >= f.done {
+		status = f.final
+	}
+	return &meilisearch.Task{TaskUID: taskUID, Status: status}, nil
+}
+
+func TestWaitForTaskWithBackoffCompletes(t *testing.T) {
+	tests := []struct {
+		name  string
+		final meilisearch.TaskStatus
+	}{
+		{"succeeded", meilisearch.TaskStatusSucceeded},
+		{"failed tasks aren't errors", meilisearch.TaskStatusFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tasks := &fakeTasks{done: 4, final: tt.final}
+			task, err := WaitForTaskWithBackoff(context.Background(), tasks, 7, time.Millisecond, 10*time.Millisecond, time.Second)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if task.TaskUID != 7 || task.Status != tt.final {
+				t.Errorf("task = %d %s, want 7 %s", task.TaskUID, task.Status, tt.final)
+			}
+			if len(tasks.polled) != 4 {
+				t.Errorf("polled %d times, want 4", len(tasks.polled))
+			}
+		})
+	}
+}
+
+func TestWaitForTaskWithBackoffIntervals(t *testing.T) {
+	const initial, maxInterval = 5 * time.Millisecond, 20 * time.Millisecond
+	tasks := &fakeTasks{done: 7, final: meilisearch.TaskStatusSucceeded}
+	if _, err := WaitForTaskWithBackoff(context.Background(), tasks, 1, initial, maxInterval, 5*time.Second); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []time.Duration{5, 10, 20, 20, 20, 20}
+	if len(tasks.polled) != len(want)+1 {
+		t.Fatalf("polled %d times, want %d", len(tasks.polled), len(want)+1)
+	}
+	for i, w := range want {
+		w *= time.Millisecond
+		gap := tasks.polled[i+1].Sub(tasks.polled[i])
+		if gap < w {
+			t.Errorf("wait %d = %v, want at least %v", i+1, gap, w)
+		}
+		// Uncapped, the later waits would be 40ms, 80ms and 160ms
+		if w == maxInterval && gap >= 4*maxInterval {
+			t.Errorf("wait %d = %v, want it capped near %v", i+1, gap, maxInterval)
+		}
+	}
+}
+
+func TestWaitForTaskWithBackoffTimeout(t *testing.T) {
+	tasks := &fakeTasks{}
+	start := time.Now()
+	_, err := WaitForTaskWithBackoff(context.Background(), tasks, 1, time.Millisecond, 5*time.Millisecond, 30*time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("error = %v, want a deadline exceeded error", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("gave up after %v, want about 30ms", elapsed)
+	}
+	if len(tasks.polled) < 2 {
+		t.Errorf("polled %d times before the timeout, want several", len(tasks.polled))
+	}
+}
+
+func TestWaitForTaskWithBackoffCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := WaitForTaskWithBackoff(ctx, &fakeTasks{}, 1, time.Second, time.Second, time.Minute)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("error = %v, want canceled", err)
+	}
+}
+
+func TestWaitForTaskWithBackoffGetError(t *testing.T) {
+	getErr := errors.New("meilisearch unavailable")
+	tasks := &fakeTasks{err: getErr}
+	if _, err := WaitForTaskWithBackoff(context.Background(), tasks, 1, time.Millisecond, time.Millisecond, time.Second); !errors.Is(err, getErr) {
+		t.Errorf("error = %v, want %v", err, getErr)
+	}
+	if len(tasks.polled) != 1 {
+		t.Errorf("polled %d times, want no retries after an error", len(tasks.polled))
+	}
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
-	"time"
+	"math"
 
 	"github.com/meilisearch/meilisearch-go"
 )
@@ -15,10 +15,9 @@ const (
 	IndexOrganizations = "organizations"
 	IndexUsers         = "users"
 	IndexTags          = "tags"
-)
 
-// Default wait interval for Meilisearch tasks
-const defaultWaitInterval = 50 * time.Millisecond
+	IndexOrganizationTypes = "organization_types"
+)
 
 // Client wraps the Meilisearch client with domain-specific methods
 type Client struct {
@@ -44,8 +43,25 @@ func NewClient(url, masterKey string) (*Client, error) {
 	return c, nil
 }
 
+// Ping checks that Meilisearch reports itself available
+func (c *Client) Ping(ctx context.Context) error {
+	health, err := c.meili.HealthWithContext(ctx)
+	if err != nil {
+		return err
+	}
+	if health.Status != "available" {
+		return fmt.Errorf("meilisearch status is %q", health.Status)
+	}
+	return nil
+}
+
 // initializeIndexes creates indexes and configures their settings
 func (c *Client) initializeIndexes() error {
+	configs, err := loadIndexConfigs()
+	if err != nil {
+		return err
+	}
+
 	indexes := []struct {
 		name       string
 		primaryKey string
@@ -56,9 +72,9 @@ func (c *Client) initializeIndexes() error {
 		{
 			name:       IndexEvents,
 			primaryKey: "id",
-			searchable: []string{"title", "description", "location", "organizationTitle", "tags"},
-			filterable: []string{"organizationId", "format", "startTime", "tagIds"},
-			sortable:   []string{"startTime", "createdAt", "title"},
+			searchable: []string{"title", "description", "location", "organizationTitle", "tags", "creatorUsername"},
+			filterable: []string{"organizationId", "organizationTypeId", "format", "startTime", "startTimestamp", "endTimestamp", "tagIds"},
+			sortable:   []string{"startTime", "createdAt", "title", "attendanceRate"},
 		},
 		{
 			name:       IndexOrganizations,
@@ -79,7 +95,14 @@ func (c *Client) initializeIndexes() error {
 			primaryKey: "id",
 			searchable: []string{"name"},
 			filterable: []string{},
-			sortable:   []string{"name", "createdAt"},
+			sortable:   []string{"name", "createdAt", "eventCount"},
+		},
+		{
+			name:       IndexOrganizationTypes,
+			primaryKey: "id",
+			searchable: []string{"title"},
+			filterable: []string{},
+			sortable:   []string{"title", "createdAt"},
 		},
 	}
 
@@ -94,7 +117,7 @@ func (c *Client) initializeIndexes() error {
 			slog.Debug("Index creation returned error (may already exist)", "index", idx.name, "error", err)
 		} else {
 			// Wait for index creation
-			_, err = c.meili.WaitForTask(task.TaskUID, defaultWaitInterval)
+			err = c.waitForTask(context.Background(), task.TaskUID)
 			if err != nil {
 				slog.Warn("Failed to wait for index creation", "index", idx.name, "error", err)
 			}
@@ -108,7 +131,23 @@ func (c *Client) initializeIndexes() error {
 			if err != nil {
 				slog.Warn("Failed to update searchable attributes", "index", idx.name, "error", err)
 			} else {
-				_, _ = c.meili.WaitForTask(task.TaskUID, defaultWaitInterval)
+				_ = c.waitForTask(context.Background(), task.TaskUID)
+			}
+		}
+
+		// Update typo tolerance
+		if cfg, ok := configs[idx.name]; ok {
+			task, err := index.UpdateTypoTolerance(&meilisearch.TypoTolerance{
+				Enabled: cfg.TypoToleranceEnabled,
+				MinWordSizeForTypos: meilisearch.MinWordSizeForTypos{
+					OneTypo:  cfg.MinWordSize1Typo,
+					TwoTypos: cfg.MinWordSize2Typos,
+				},
+			})
+			if err != nil {
+				slog.Warn("Failed to update typo tolerance", "index", idx.name, "error", err)
+			} else {
+				_ = c.waitForTask(context.Background(), task.TaskUID)
 			}
 		}
 
@@ -122,7 +161,7 @@ func (c *Client) initializeIndexes() error {
 			if err != nil {
 				slog.Warn("Failed to update filterable attributes", "index", idx.name, "error", err)
 			} else {
-				_, _ = c.meili.WaitForTask(task.TaskUID, defaultWaitInterval)
+				_ = c.waitForTask(context.Background(), task.TaskUID)
 			}
 		}
 
@@ -132,7 +171,7 @@ func (c *Client) initializeIndexes() error {
 			if err != nil {
 				slog.Warn("Failed to update sortable attributes", "index", idx.name, "error", err)
 			} else {
-				_, _ = c.meili.WaitForTask(task.TaskUID, defaultWaitInterval)
+				_ = c.waitForTask(context.Background(), task.TaskUID)
 			}
 		}
 
@@ -144,19 +183,27 @@ func (c *Client) initializeIndexes() error {
 
 // EventDocument represents an event in the search index
 type EventDocument struct {
-	ID                int32    `json:"id"`
-	Title             string   `json:"title"`
-	Description       string   `json:"description"`
-	Location          string   `json:"location"`
-	ImageURL          string   `json:"imageUrl,omitempty"`
-	OrganizationID    int32    `json:"organizationId"`
-	OrganizationTitle string   `json:"organizationTitle"`
-	Format            string   `json:"format"`
-	StartTime         string   `json:"startTime"`
-	EndTime           string   `json:"endTime"`
-	TagIds            []int32  `json:"tagIds"`
-	Tags              []string `json:"tags"`
-	CreatedAt         string   `json:"createdAt"`
+	ID                    int32    `json:"id"`
+	Title                 string   `json:"title"`
+	Description           string   `json:"description"`
+	Location              string   `json:"location"`
+	ImageURL              string   `json:"imageUrl,omitempty"`
+	OrganizationID        int32    `json:"organizationId"`
+	OrganizationTitle     string   `json:"organizationTitle"`
+	OrganizationTypeID    int32    `json:"organizationTypeId"`
+	OrganizationTypeTitle string   `json:"organizationTypeTitle"`
+	Format                string   `json:"format"`
+	StartTime             string   `json:"startTime"`
+	EndTime               string   `json:"endTime"`
+	StartTimestamp        int64    `json:"startTimestamp"` // Unix seconds, for range filters
+	EndTimestamp          int64    `json:"endTimestamp"`
+	TagIds                []int32  `json:"tagIds"`
+	Tags                  []string `json:"tags"`
+	RegistrationCount     int32    `json:"registrationCount"`
+	AttendanceCount       int32    `json:"attendanceCount"`
+	AttendanceRate        float64  `json:"attendanceRate"` // Percent of registrants who attended, 0-100
+	CreatorUsername       string   `json:"creatorUsername"`
+	CreatedAt             string   `json:"createdAt"`
 }
 
 // OrganizationDocument represents an organization in the search index
@@ -177,17 +224,31 @@ type UserDocument struct {
 	Email     string `json:"email"`
 	FirstName string `json:"firstName,omitempty"`
 	LastName  string `json:"lastName,omitempty"`
-	Role      string `json:"role,omitempty"`
+	Role      string `json:"role,omitempty"` // UserRoleAdmin, UserRoleStaff or empty
 	CreatedAt string `json:"createdAt"`
 }
 
-// TagDocument represents a tag in the search index
-type TagDocument struct {
+// OrganizationTypeDocument represents an organization type in the search index
+type OrganizationTypeDocument struct {
 	ID        int32  `json:"id"`
-	Name      string `json:"name"`
+	Title     string `json:"title"`
 	CreatedAt string `json:"createdAt"`
 }
 
+// Platform roles stored in UserDocument.Role
+const (
+	UserRoleAdmin = "admin"
+	UserRoleStaff = "staff"
+)
+
+// TagDocument represents a tag in the search index
+type TagDocument struct {
+	ID         int32  `json:"id"`
+	Name       string `json:"name"`
+	EventCount int32  `json:"eventCount"`
+	CreatedAt  string `json:"createdAt"`
+}
+
 // primaryKeyOption creates a DocumentOptions with primary key set to "id"
 func primaryKeyOption() *meilisearch.DocumentOptions {
 	pk := "id"
@@ -200,8 +261,30 @@ func (c *Client) IndexEvent(ctx context.Context, doc *EventDocument) error {
 	if err != nil {
 		return fmt.Errorf("failed to index event: %w", err)
 	}
-	_, err = c.meili.WaitForTask(task.TaskUID, defaultWaitInterval)
-	return err
+	return c.waitForTask(ctx, task.TaskUID)
+}
+
+// UpdateEventCounts refreshes the registration and attendance counts, and the
+// attendance rate derived from them, without rewriting the rest of the document
+func (c *Client) UpdateEventCounts(ctx context.Context, eventID, registrationCount, attendanceCount int32) error {
+	task, err := c.meili.Index(IndexEvents).UpdateDocuments([]map[string]interface{}{{
+		"id":                eventID,
+		"registrationCount": registrationCount,
+		"attendanceCount":   attendanceCount,
+		"attendanceRate":    AttendanceRate(registrationCount, attendanceCount),
+	}}, primaryKeyOption())
+	if err != nil {
+		return fmt.Errorf("failed to update event counts: %w", err)
+	}
+	return c.waitForTask(ctx, task.TaskUID)
+}
+
+// AttendanceRate is the percentage of registrants who attended, capped at 100
+func AttendanceRate(registrationCount, attendanceCount int32) float64 {
+	if registrationCount <= 0 {
+		return 0
+	}
+	return math.Min(float64(attendanceCount)/float64(registrationCount)*100, 100)
 }
 
 // IndexEvents adds or updates multiple events in the search index
@@ -213,8 +296,7 @@ func (c *Client) IndexEvents(ctx context.Context, docs []EventDocument) error {
 	if err != nil {
 		return fmt.Errorf("failed to index events: %w", err)
 	}
-	_, err = c.meili.WaitForTask(task.TaskUID, defaultWaitInterval)
-	return err
+	return c.waitForTask(ctx, task.TaskUID)
 }
 
 // IndexOrganization adds or updates an organization in the search index
@@ -223,8 +305,7 @@ func (c *Client) IndexOrganization(ctx context.Context, doc *OrganizationDocumen
 	if err != nil {
 		return fmt.Errorf("failed to index organization: %w", err)
 	}
-	_, err = c.meili.WaitForTask(task.TaskUID, defaultWaitInterval)
-	return err
+	return c.waitForTask(ctx, task.TaskUID)
 }
 
 // IndexOrganizations adds or updates multiple organizations in the search index
@@ -236,18 +317,17 @@ func (c *Client) IndexOrganizations(ctx context.Context, docs []OrganizationDocu
 	if err != nil {
 		return fmt.Errorf("failed to index organizations: %w", err)
 	}
-	_, err = c.meili.WaitForTask(task.TaskUID, defaultWaitInterval)
-	return err
+	return c.waitForTask(ctx, task.TaskUID)
 }
 
-// IndexUser adds or updates a user in the search index
+// IndexUser adds or updates a user in the search index. Fields left empty
+// keep their indexed value, so the role set by ReindexUsers survives edits.
 func (c *Client) IndexUser(ctx context.Context, doc *UserDocument) error {
-	task, err := c.meili.Index(IndexUsers).AddDocuments([]UserDocument{*doc}, primaryKeyOption())
+	task, err := c.meili.Index(IndexUsers).UpdateDocuments([]UserDocument{*doc}, primaryKeyOption())
 	if err != nil {
 		return fmt.Errorf("failed to index user: %w", err)
 	}
-	_, err = c.meili.WaitForTask(task.TaskUID, defaultWaitInterval)
-	return err
+	return c.waitForTask(ctx, task.TaskUID)
 }
 
 // IndexUsers adds or updates multiple users in the search index
@@ -259,8 +339,7 @@ func (c *Client) IndexUsers(ctx context.Context, docs []UserDocument) error {
 	if err != nil {
 		return fmt.Errorf("failed to index users: %w", err)
 	}
-	_, err = c.meili.WaitForTask(task.TaskUID, defaultWaitInterval)
-	return err
+	return c.waitForTask(ctx, task.TaskUID)
 }
 
 // IndexTag adds or updates a tag in the search index
@@ -269,8 +348,19 @@ func (c *Client) IndexTag(ctx context.Context, doc *TagDocument) error {
 	if err != nil {
 		return fmt.Errorf("failed to index tag: %w", err)
 	}
-	_, err = c.meili.WaitForTask(task.TaskUID, defaultWaitInterval)
-	return err
+	return c.waitForTask(ctx, task.TaskUID)
+}
+
+// UpdateTagEventCount updates only the event count of an already indexed tag
+func (c *Client) UpdateTagEventCount(ctx context.Context, tagID, eventCount int32) error {
+	task, err := c.meili.Index(IndexTags).UpdateDocuments([]map[string]interface{}{{
+		"id":         tagID,
+		"eventCount": eventCount,
+	}}, primaryKeyOption())
+	if err != nil {
+		return fmt.Errorf("failed to update tag event count: %w", err)
+	}
+	return c.waitForTask(ctx, task.TaskUID)
 }
 
 // IndexTags adds or updates multiple tags in the search index
@@ -282,8 +372,28 @@ func (c *Client) IndexTags(ctx context.Context, docs []TagDocument) error {
 	if err != nil {
 		return fmt.Errorf("failed to index tags: %w", err)
 	}
-	_, err = c.meili.WaitForTask(task.TaskUID, defaultWaitInterval)
-	return err
+	return c.waitForTask(ctx, task.TaskUID)
+}
+
+// IndexOrganizationType adds or updates an organization type in the search index
+func (c *Client) IndexOrganizationType(ctx context.Context, doc *OrganizationTypeDocument) error {
+	task, err := c.meili.Index(IndexOrganizationTypes).AddDocuments([]OrganizationTypeDocument{*doc}, primaryKeyOption())
+	if err != nil {
+		return fmt.Errorf("failed to index organization type: %w", err)
+	}
+	return c.waitForTask(ctx, task.TaskUID)
+}
+
+// IndexOrganizationTypes adds or updates multiple organization types in the search index
+func (c *Client) IndexOrganizationTypes(ctx context.Context, docs []OrganizationTypeDocument) error {
+	if len(docs) == 0 {
+		return nil
+	}
+	task, err := c.meili.Index(IndexOrganizationTypes).AddDocuments(docs, primaryKeyOption())
+	if err != nil {
+		return fmt.Errorf("failed to index organization types: %w", err)
+	}
+	return c.waitForTask(ctx, task.TaskUID)
 }
 
 // DeleteDocument removes a document from an index
@@ -292,8 +402,31 @@ func (c *Client) DeleteDocument(ctx context.Context, indexName string, id int32)
 	if err != nil {
 		return fmt.Errorf("failed to delete document: %w", err)
 	}
-	_, err = c.meili.WaitForTask(task.TaskUID, defaultWaitInterval)
-	return err
+	return c.waitForTask(ctx, task.TaskUID)
+}
+
+// IndexStats is the document count and indexing state of one index
+type IndexStats struct {
+	NumberOfDocuments int64            `json:"numberOfDocuments"`
+	IsIndexing        bool             `json:"isIndexing"`
+	FieldDistribution map[string]int64 `json:"fieldDistribution"`
+}
+
+// GetIndexStats returns the stats of every index, keyed by index name
+func (c *Client) GetIndexStats(ctx context.Context) (map[string]IndexStats, error) {
+	indexStats := make(map[string]IndexStats, 5)
+	for _, name := range []string{IndexEvents, IndexOrganizations, IndexUsers, IndexTags, IndexOrganizationTypes} {
+		stats, err := c.meili.Index(name).GetStatsWithContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get stats of index %s: %w", name, err)
+		}
+		indexStats[name] = IndexStats{
+			NumberOfDocuments: stats.NumberOfDocuments,
+			IsIndexing:        stats.IsIndexing,
+			FieldDistribution: stats.FieldDistribution,
+		}
+	}
+	return indexStats, nil
 }
 
 // SearchResult represents a single search result
@@ -305,44 +438,51 @@ type SearchResult struct {
 	ImageURL    string `json:"imageUrl,omitempty"`
 }
 
+// IndexResult contains the hits of a single index within a multi-search
+type IndexResult struct {
+	IndexName string         `json:"indexName"`
+	HitCount  int64          `json:"hitCount"`
+	Results   []SearchResult `json:"results"`
+}
+
 // MultiSearchResult contains results from multiple indexes
 type MultiSearchResult struct {
 	Results   []SearchResult `json:"results"`
+	Indexes   []IndexResult  `json:"indexes"`
 	TotalHits int64          `json:"totalHits"`
 	Query     string         `json:"query"`
 	TimeMs    int64          `json:"timeMs"`
 }
 
-// GlobalSearch performs a search across all indexes
-func (c *Client) GlobalSearch(ctx context.Context, query string, limit int32) (*MultiSearchResult, error) {
+// GlobalSearch performs a search across all indexes.
+// When limitPerIndex is positive every index returns up to that many hits;
+// otherwise limit applies to events and organizations and half of it to
+// users and tags.
+func (c *Client) GlobalSearch(ctx context.Context, query string, limit, limitPerIndex int32) (*MultiSearchResult, error) {
 	if limit <= 0 {
 		limit = 10
 	}
 
+	limits := map[string]int64{
+		IndexEvents:        int64(limit),
+		IndexOrganizations: int64(limit),
+		IndexUsers:         int64(limit / 2), // Fewer user results
+		IndexTags:          int64(limit / 2), // Fewer tag results
+	}
+	if limitPerIndex > 0 {
+		for index := range limits {
+			limits[index] = int64(limitPerIndex)
+		}
+	}
+
 	// Perform multi-search across all indexes
-	multiSearchReq := &meilisearch.MultiSearchRequest{
-		Queries: []*meilisearch.SearchRequest{
-			{
-				IndexUID: IndexEvents,
-				Query:    query,
-				Limit:    int64(limit),
-			},
-			{
-				IndexUID: IndexOrganizations,
-				Query:    query,
-				Limit:    int64(limit),
-			},
-			{
-				IndexUID: IndexUsers,
-				Query:    query,
-				Limit:    int64(limit / 2), // Fewer user results
-			},
-			{
-				IndexUID: IndexTags,
-				Query:    query,
-				Limit:    int64(limit / 2), // Fewer tag results
-			},
-		},
+	multiSearchReq := &meilisearch.MultiSearchRequest{}
+	for _, index := range []string{IndexEvents, IndexOrganizations, IndexUsers, IndexTags} {
+		multiSearchReq.Queries = append(multiSearchReq.Queries, &meilisearch.SearchRequest{
+			IndexUID: index,
+			Query:    query,
+			Limit:    limits[index],
+		})
 	}
 
 	resp, err := c.meili.MultiSearch(multiSearchReq)
@@ -360,6 +500,12 @@ func (c *Client) GlobalSearch(ctx context.Context, query string, limit int32) (*
 		result.TotalHits += searchResp.EstimatedTotalHits
 		result.TimeMs += searchResp.ProcessingTimeMs
 
+		indexResult := IndexResult{
+			IndexName: indexUID,
+			HitCount:  searchResp.EstimatedTotalHits,
+			Results:   make([]SearchResult, 0, len(searchResp.Hits)),
+		}
+
 		for _, hit := range searchResp.Hits {
 			// Decode hit into a generic map
 			var hitMap map[string]interface{}
@@ -410,17 +556,21 @@ func (c *Client) GlobalSearch(ctx context.Context, query string, limit int32) (*
 			}
 
 			result.Results = append(result.Results, sr)
+			indexResult.Results = append(indexResult.Results, sr)
 		}
+
+		result.Indexes = append(result.Indexes, indexResult)
 	}
 
 	return result, nil
 }
 
 // SearchEvents searches only the events index
-func (c *Client) SearchEvents(ctx context.Context, query string, limit int32, filters string) (*meilisearch.SearchResponse, error) {
+func (c *Client) SearchEvents(ctx context.Context, query string, limit int32, filters string, sort []string) (*meilisearch.SearchResponse, error) {
 	req := &meilisearch.SearchRequest{
 		Query: query,
 		Limit: int64(limit),
+		Sort:  sort,
 	}
 	if filters != "" {
 		req.Filter = filters
@@ -428,9 +578,81 @@ func (c *Client) SearchEvents(ctx context.Context, query string, limit int32, fi
 	return c.meili.Index(IndexEvents).Search(query, req)
 }
 
-// SearchOrganizations searches only the organizations index
-func (c *Client) SearchOrganizations(ctx context.Context, query string, limit int32) (*meilisearch.SearchResponse, error) {
-	return c.meili.Index(IndexOrganizations).Search(query, &meilisearch.SearchRequest{
+// SearchOrganizations searches only the organizations index with optional filters
+func (c *Client) SearchOrganizations(ctx context.Context, query string, limit int32, filters string) (*meilisearch.SearchResponse, error) {
+	req := &meilisearch.SearchRequest{
+		Query: query,
+		Limit: int64(limit),
+	}
+	if filters != "" {
+		req.Filter = filters
+	}
+	return c.meili.Index(IndexOrganizations).Search(query, req)
+}
+
+// SearchOrganizationTypes searches only the organization types index
+func (c *Client) SearchOrganizationTypes(ctx context.Context, query string, limit int32) (*meilisearch.SearchResponse, error) {
+	return c.meili.Index(IndexOrganizationTypes).Search(query, &meilisearch.SearchRequest{
+		Query: query,
+		Limit: int64(limit),
+	})
+}
+
+// SearchUsers searches only the users index with optional filters, e.g.
+// role = 'admin'
+func (c *Client) SearchUsers(ctx context.Context, query string, limit int32, filters string) (*meilisearch.SearchResponse, error) {
+	req := &meilisearch.SearchRequest{
+		Query: query,
 		Limit: int64(limit),
+	}
+	if filters != "" {
+		req.Filter = filters
+	}
+	return c.meili.Index(IndexUsers).Search(query, req)
+}
+
+// SearchUserIDs returns the IDs of the users matching query, best match first
+func (c *Client) SearchUserIDs(ctx context.Context, query string, limit int32) ([]int32, error) {
+	resp, err := c.meili.Index(IndexUsers).Search(query, &meilisearch.SearchRequest{
+		Limit:                int64(limit),
+		AttributesToRetrieve: []string{"id"},
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int32, 0, len(resp.Hits))
+	for _, hit := range resp.Hits {
+		var doc struct {
+			ID int32 `json:"id"`
+		}
+		if err := hit.DecodeInto(&doc); err != nil {
+			continue
+		}
+		ids = append(ids, doc.ID)
+	}
+	return ids, nil
+}
+
+// SearchEventTags returns the tag IDs of each event matching query, best match first
+func (c *Client) SearchEventTags(ctx context.Context, query string, limit int32) ([][]int32, error) {
+	resp, err := c.meili.Index(IndexEvents).Search(query, &meilisearch.SearchRequest{
+		Limit:                int64(limit),
+		AttributesToRetrieve: []string{"tagIds", "tags"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tagIDs := make([][]int32, 0, len(resp.Hits))
+	for _, hit := range resp.Hits {
+		var doc struct {
+			TagIds []int32 `json:"tagIds"`
+		}
+		if err := hit.DecodeInto(&doc); err != nil {
+			continue
+		}
+		tagIDs = append(tagIDs, doc.TagIds)
+	}
+	return tagIDs, nil
 }
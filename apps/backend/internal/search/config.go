@@ -0,0 +1,49 @@
+package search
+
+import (
+	_ "embed"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Meilisearch defaults for minWordSizeForTypos
+const (
+	defaultMinWordSize1Typo  = 5
+	defaultMinWordSize2Typos = 9
+)
+
+//go:embed search.yml
+var searchConfigYAML []byte
+
+// IndexConfig holds the tunable settings of a single index
+type IndexConfig struct {
+	TypoToleranceEnabled bool  `yaml:"typo_tolerance_enabled"`
+	MinWordSize1Typo     int64 `yaml:"min_word_size_1_typo"`
+	MinWordSize2Typos    int64 `yaml:"min_word_size_2_typos"`
+}
+
+// loadIndexConfigs parses the embedded search.yml, keyed by index name
+func loadIndexConfigs() (map[string]IndexConfig, error) {
+	var file struct {
+		Indexes map[string]IndexConfig `yaml:"indexes"`
+	}
+	if err := yaml.Unmarshal(searchConfigYAML, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse search.yml: %w", err)
+	}
+
+	for name, cfg := range file.Indexes {
+		if cfg.MinWordSize1Typo <= 0 {
+			cfg.MinWordSize1Typo = defaultMinWordSize1Typo
+		}
+		if cfg.MinWordSize2Typos <= 0 {
+			cfg.MinWordSize2Typos = defaultMinWordSize2Typos
+		}
+		if cfg.MinWordSize2Typos < cfg.MinWordSize1Typo {
+			return nil, fmt.Errorf("search.yml: %s: min_word_size_2_typos must be >= min_word_size_1_typo", name)
+		}
+		file.Indexes[name] = cfg
+	}
+
+	return file.Indexes, nil
+}
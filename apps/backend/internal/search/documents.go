@@ -0,0 +1,116 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/studyverse/ems-backend/internal/db"
+)
+
+// eventCounts are the registration and attendance counts of an event
+type eventCounts struct {
+	registered int32
+	attended   int32
+}
+
+// BuildEventDocument builds the complete search document of an event
+func BuildEventDocument(ctx context.Context, queries *db.Queries, event db.Event) (*EventDocument, error) {
+	docs, err := BuildEventDocuments(ctx, queries, []db.Event{event})
+	if err != nil {
+		return nil, err
+	}
+	return &docs[0], nil
+}
+
+// BuildEventDocuments builds the complete search documents of events, in the
+// same order. Organizations, their types, tags, creators and counts are each
+// loaded in one query however many events there are.
+func BuildEventDocuments(ctx context.Context, queries *db.Queries, events []db.Event) ([]EventDocument, error) {
+	if len(events) == 0 {
+		return nil, nil
+	}
+
+	eventIDs := make([]int32, len(events))
+	orgIDs := make([]int32, len(events))
+	userIDs := make([]int32, len(events))
+	for i, e := range events {
+		eventIDs[i] = e.ID
+		orgIDs[i] = e.OrganizationID
+		userIDs[i] = e.UserID
+	}
+
+	orgs, err := queries.GetOrganizationsMap(ctx, orgIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load organizations: %w", err)
+	}
+	orgTypeIDs := make([]int32, 0, len(orgs))
+	for _, o := range orgs {
+		orgTypeIDs = append(orgTypeIDs, o.OrganizationTypeID)
+	}
+	orgTypes, err := queries.GetOrganizationTypesMap(ctx, orgTypeIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load organization types: %w", err)
+	}
+	tags, err := queries.GetEventTagsMap(ctx, eventIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load event tags: %w", err)
+	}
+	creators, err := queries.GetUsersMap(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load event creators: %w", err)
+	}
+	countRows, err := queries.GetEventsWithRegistrationCounts(ctx, eventIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load event counts: %w", err)
+	}
+	counts := make(map[int32]eventCounts, len(countRows))
+	for _, row := range countRows {
+		counts[row.Event.ID] = eventCounts{registered: row.RegisteredCount, attended: row.AttendedCount}
+	}
+
+	docs := make([]EventDocument, len(events))
+	for i, e := range events {
+		org := orgs[e.OrganizationID]
+		docs[i] = newEventDocument(e, org, orgTypes[org.OrganizationTypeID], tags[e.ID], creators[e.UserID], counts[e.ID])
+	}
+	return docs, nil
+}
+
+// newEventDocument assembles an event's search document from its loaded
+// relations. Missing relations leave their fields empty.
+func newEventDocument(event db.Event, org db.Organization, orgType db.OrganizationType, tags []db.Tag, creator db.User, counts eventCounts) EventDocument {
+	doc := EventDocument{
+		ID:                    event.ID,
+		Title:                 event.Title,
+		Description:           event.Description,
+		Location:              event.Location,
+		OrganizationID:        event.OrganizationID,
+		OrganizationTitle:     org.Title,
+		OrganizationTypeID:    org.OrganizationTypeID,
+		OrganizationTypeTitle: orgType.Title,
+		Format:                "offline",
+		StartTime:             event.StartTime.Time.Format(time.RFC3339),
+		EndTime:               event.EndTime.Time.Format(time.RFC3339),
+		StartTimestamp:        event.StartTime.Time.Unix(),
+		EndTimestamp:          event.EndTime.Time.Unix(),
+		TagIds:                make([]int32, len(tags)),
+		Tags:                  make([]string, len(tags)),
+		RegistrationCount:     counts.registered,
+		AttendanceCount:       counts.attended,
+		AttendanceRate:        AttendanceRate(counts.registered, counts.attended),
+		CreatorUsername:       creator.Username,
+		CreatedAt:             event.CreatedAt.Time.Format(time.RFC3339),
+	}
+	if event.ImageUrl.Valid {
+		doc.ImageURL = event.ImageUrl.String
+	}
+	if event.Format.Valid {
+		doc.Format = string(event.Format.Format)
+	}
+	for i, t := range tags {
+		doc.TagIds[i] = t.ID
+		doc.Tags[i] = t.Name
+	}
+	return doc
+}
@@ -0,0 +1,64 @@
+package search
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/studyverse/ems-backend/internal/db"
+)
+
+func TestNewEventDocument(t *testing.T) {
+	start := time.Date(2026, 3, 1, 18, 0, 0, 0, time.UTC)
+	event := db.Event{
+		ID:             7,
+		Title:          "Hackathon",
+		UserID:         3,
+		OrganizationID: 2,
+		StartTime:      pgtype.Timestamptz{Time: start, Valid: true},
+		EndTime:        pgtype.Timestamptz{Time: start.Add(2 * time.Hour), Valid: true},
+		ImageUrl:       pgtype.Text{String: "https://example.com/a.png", Valid: true},
+		Format:         db.NullFormat{Format: db.FormatHybrid, Valid: true},
+	}
+	org := db.Organization{ID: 2, Title: "Robotics", OrganizationTypeID: 5}
+	orgType := db.OrganizationType{ID: 5, Title: "Club"}
+	tags := []db.Tag{{ID: 1, Name: "tech"}, {ID: 4, Name: "contest"}}
+	creator := db.User{ID: 3, Username: "ada"}
+
+	doc := newEventDocument(event, org, orgType, tags, creator, eventCounts{registered: 8, attended: 6})
+
+	if doc.OrganizationTitle != "Robotics" || doc.OrganizationTypeID != 5 || doc.OrganizationTypeTitle != "Club" {
+		t.Errorf("organization fields = %q, %d, %q", doc.OrganizationTitle, doc.OrganizationTypeID, doc.OrganizationTypeTitle)
+	}
+	if doc.Format != "hybrid" || doc.ImageURL != "https://example.com/a.png" || doc.CreatorUsername != "ada" {
+		t.Errorf("format, image, creator = %q, %q, %q", doc.Format, doc.ImageURL, doc.CreatorUsername)
+	}
+	if !slices.Equal(doc.TagIds, []int32{1, 4}) || !slices.Equal(doc.Tags, []string{"tech", "contest"}) {
+		t.Errorf("tags = %v %v", doc.TagIds, doc.Tags)
+	}
+	if doc.StartTime != "2026-03-01T18:00:00Z" || doc.StartTimestamp != start.Unix() {
+		t.Errorf("start = %q, %d", doc.StartTime, doc.StartTimestamp)
+	}
+	if doc.RegistrationCount != 8 || doc.AttendanceCount != 6 || doc.AttendanceRate != AttendanceRate(8, 6) {
+		t.Errorf("counts = %d, %d, %v", doc.RegistrationCount, doc.AttendanceCount, doc.AttendanceRate)
+	}
+}
+
+func TestNewEventDocumentMissingRelations(t *testing.T) {
+	doc := newEventDocument(db.Event{ID: 9, OrganizationID: 2}, db.Organization{}, db.OrganizationType{}, nil, db.User{}, eventCounts{})
+
+	if doc.Format != "offline" {
+		t.Errorf("Format = %q, want offline for a NULL format", doc.Format)
+	}
+	if doc.OrganizationID != 2 || doc.OrganizationTitle != "" {
+		t.Errorf("organization = %d %q, want the event's ID and no title", doc.OrganizationID, doc.OrganizationTitle)
+	}
+	// Empty, not null, so the filterable attributes stay arrays
+	if doc.TagIds == nil || doc.Tags == nil {
+		t.Error("tags are nil, want empty slices")
+	}
+	if doc.AttendanceRate != 0 {
+		t.Errorf("AttendanceRate = %v, want 0 without registrations", doc.AttendanceRate)
+	}
+}
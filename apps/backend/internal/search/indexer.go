@@ -4,31 +4,41 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strconv"
+	"time"
 
 	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/perms"
 )
 
+// roleLookupTimeout bounds the SpiceDB lookups of ReindexUsers
+const roleLookupTimeout = 10 * time.Second
+
 // Indexer handles the indexing of database entities into Meilisearch
 type Indexer struct {
 	client  *Client
 	queries *db.Queries
+	perms   *perms.Client
 }
 
-// NewIndexer creates a new search indexer
-func NewIndexer(client *Client, queries *db.Queries) *Indexer {
+// NewIndexer creates a new search indexer. permsClient may be nil, users are
+// then indexed without a role.
+func NewIndexer(client *Client, queries *db.Queries, permsClient *perms.Client) *Indexer {
 	return &Indexer{
 		client:  client,
 		queries: queries,
+		perms:   permsClient,
 	}
 }
 
 // ReindexResult contains the results of a reindex operation
 type ReindexResult struct {
-	EventsIndexed        int
-	OrganizationsIndexed int
-	UsersIndexed         int
-	TagsIndexed          int
-	Errors               []error
+	EventsIndexed            int
+	OrganizationsIndexed     int
+	UsersIndexed             int
+	TagsIndexed              int
+	OrganizationTypesIndexed int
+	Errors                   []error
 }
 
 // ReindexAll reindexes all entities from the database
@@ -63,11 +73,19 @@ func (i *Indexer) ReindexAll(ctx context.Context) (*ReindexResult, error) {
 	}
 	result.TagsIndexed = tagsCount
 
+	// Reindex organization types
+	orgTypesCount, err := i.ReindexOrganizationTypes(ctx)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Errorf("organization types: %w", err))
+	}
+	result.OrganizationTypesIndexed = orgTypesCount
+
 	slog.Info("Reindex completed",
 		"events", result.EventsIndexed,
 		"organizations", result.OrganizationsIndexed,
 		"users", result.UsersIndexed,
 		"tags", result.TagsIndexed,
+		"organizationTypes", result.OrganizationTypesIndexed,
 		"errors", len(result.Errors),
 	)
 
@@ -76,83 +94,50 @@ func (i *Indexer) ReindexAll(ctx context.Context) (*ReindexResult, error) {
 
 // ReindexEvents reindexes all events
 func (i *Indexer) ReindexEvents(ctx context.Context) (int, error) {
-	// Get all events with pagination to avoid memory issues
+	// Stream events and flush them in batches to avoid memory issues
 	const batchSize = 100
-	var allDocs []EventDocument
-	offset := int32(0)
+	batch := make([]db.Event, 0, batchSize)
+	total := 0
 
-	for {
-		events, err := i.queries.ListEvents(ctx, db.ListEventsParams{
-			Limit:  batchSize,
-			Offset: offset,
-		})
+	// Each flush loads the relations of the whole batch in a few queries
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		docs, err := BuildEventDocuments(ctx, i.queries, batch)
 		if err != nil {
-			return 0, fmt.Errorf("failed to list events: %w", err)
+			return err
 		}
-
-		if len(events) == 0 {
-			break
+		if err := i.client.IndexEvents(ctx, docs); err != nil {
+			return fmt.Errorf("failed to index events: %w", err)
 		}
+		total += len(batch)
+		batch = batch[:0]
+		return nil
+	}
 
-		for _, event := range events {
-			// Get organization title
-			org, _ := i.queries.GetOrganization(ctx, event.OrganizationID)
-			orgTitle := ""
-			if org.ID != 0 {
-				orgTitle = org.Title
-			}
-
-			// Get tags
-			tags, _ := i.queries.GetEventTags(ctx, event.ID)
-			tagNames := make([]string, len(tags))
-			tagIds := make([]int32, len(tags))
-			for j, t := range tags {
-				tagNames[j] = t.Name
-				tagIds[j] = t.ID
-			}
-
-			format := "offline"
-			if event.Format.Valid && event.Format.Format == db.FormatOnline {
-				format = "online"
-			}
-
-			imageURL := ""
-			if event.ImageUrl.Valid {
-				imageURL = event.ImageUrl.String
-			}
+	// Cancelling on early return stops the streaming query
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
-			doc := EventDocument{
-				ID:                event.ID,
-				Title:             event.Title,
-				Description:       event.Description,
-				Location:          event.Location,
-				ImageURL:          imageURL,
-				OrganizationID:    event.OrganizationID,
-				OrganizationTitle: orgTitle,
-				Format:            format,
-				StartTime:         event.StartTime.Time.Format("2006-01-02T15:04:05Z07:00"),
-				EndTime:           event.EndTime.Time.Format("2006-01-02T15:04:05Z07:00"),
-				TagIds:            tagIds,
-				Tags:              tagNames,
-				CreatedAt:         event.CreatedAt.Time.Format("2006-01-02T15:04:05Z07:00"),
+	events, errc := i.queries.StreamEvents(ctx, db.ListEventsParams{})
+	for event := range events {
+		batch = append(batch, event)
+		if len(batch) == batchSize {
+			if err := flush(); err != nil {
+				return total, err
 			}
-			allDocs = append(allDocs, doc)
-		}
-
-		offset += int32(len(events))
-		if len(events) < batchSize {
-			break
 		}
 	}
-
-	if len(allDocs) > 0 {
-		if err := i.client.IndexEvents(ctx, allDocs); err != nil {
-			return 0, fmt.Errorf("failed to index events: %w", err)
-		}
+	if err := <-errc; err != nil {
+		return total, fmt.Errorf("failed to list events: %w", err)
+	}
+	if err := flush(); err != nil {
+		return total, err
 	}
 
-	slog.Info("Reindexed events", "count", len(allDocs))
-	return len(allDocs), nil
+	slog.Info("Reindexed events", "count", total)
+	return total, nil
 }
 
 // ReindexOrganizations reindexes all organizations
@@ -224,6 +209,8 @@ func (i *Indexer) ReindexUsers(ctx context.Context) (int, error) {
 	var allDocs []UserDocument
 	offset := int32(0)
 
+	roles := i.platformRoles(ctx)
+
 	for {
 		users, err := i.queries.ListUsers(ctx, db.ListUsersParams{
 			Limit:  batchSize,
@@ -242,6 +229,7 @@ func (i *Indexer) ReindexUsers(ctx context.Context) (int, error) {
 				ID:        user.ID,
 				Username:  user.Username,
 				Email:     user.Email,
+				Role:      roles.of(user),
 				CreatedAt: user.CreatedAt.Time.Format("2006-01-02T15:04:05Z07:00"),
 			}
 			allDocs = append(allDocs, doc)
@@ -263,6 +251,52 @@ func (i *Indexer) ReindexUsers(ctx context.Context) (int, error) {
 	return len(allDocs), nil
 }
 
+// platformRoleSet maps SpiceDB user subject IDs to their platform role
+type platformRoleSet map[string]string
+
+// platformRoles looks up the platform admins and staff once for the whole
+// reindex. On failure users are indexed without a role rather than not at all.
+func (i *Indexer) platformRoles(ctx context.Context) platformRoleSet {
+	roles := platformRoleSet{}
+	if i.perms == nil {
+		return roles
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, roleLookupTimeout)
+	defer cancel()
+
+	staff, err := i.perms.GetPlatformStaff(ctx)
+	if err != nil {
+		slog.Warn("Failed to lookup platform staff for user index", "error", err)
+		return roles
+	}
+	for _, id := range staff {
+		roles[id] = UserRoleStaff
+	}
+
+	// Staff includes admins, so admins overwrite their staff entry
+	admins, err := i.perms.GetPlatformAdmins(ctx)
+	if err != nil {
+		slog.Warn("Failed to lookup platform admins for user index", "error", err)
+		return roles
+	}
+	for _, id := range admins {
+		roles[id] = UserRoleAdmin
+	}
+	return roles
+}
+
+// of returns the role of user. Subjects are Kratos identity IDs, except for
+// relationships written with the local numeric user ID.
+func (roles platformRoleSet) of(user db.User) string {
+	if user.KratosID.Valid {
+		if role, ok := roles[user.KratosID.String]; ok {
+			return role
+		}
+	}
+	return roles[strconv.Itoa(int(user.ID))]
+}
+
 // ReindexTags reindexes all tags
 func (i *Indexer) ReindexTags(ctx context.Context) (int, error) {
 	const batchSize = 100
@@ -282,11 +316,12 @@ func (i *Indexer) ReindexTags(ctx context.Context) (int, error) {
 			break
 		}
 
-		for _, tag := range tags {
+		for _, row := range tags {
 			doc := TagDocument{
-				ID:        tag.ID,
-				Name:      tag.Name,
-				CreatedAt: tag.CreatedAt.Time.Format("2006-01-02T15:04:05Z07:00"),
+				ID:         row.Tag.ID,
+				Name:       row.Tag.Name,
+				EventCount: row.EventCount,
+				CreatedAt:  row.Tag.CreatedAt.Time.Format("2006-01-02T15:04:05Z07:00"),
 			}
 			allDocs = append(allDocs, doc)
 		}
@@ -306,3 +341,47 @@ func (i *Indexer) ReindexTags(ctx context.Context) (int, error) {
 	slog.Info("Reindexed tags", "count", len(allDocs))
 	return len(allDocs), nil
 }
+
+// ReindexOrganizationTypes reindexes all organization types
+func (i *Indexer) ReindexOrganizationTypes(ctx context.Context) (int, error) {
+	const batchSize = 100
+	var allDocs []OrganizationTypeDocument
+	offset := int32(0)
+
+	for {
+		orgTypes, err := i.queries.ListOrganizationTypes(ctx, db.ListOrganizationTypesParams{
+			Limit:  batchSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to list organization types: %w", err)
+		}
+
+		if len(orgTypes) == 0 {
+			break
+		}
+
+		for _, ot := range orgTypes {
+			doc := OrganizationTypeDocument{
+				ID:        ot.ID,
+				Title:     ot.Title,
+				CreatedAt: ot.CreatedAt.Time.Format("2006-01-02T15:04:05Z07:00"),
+			}
+			allDocs = append(allDocs, doc)
+		}
+
+		offset += int32(len(orgTypes))
+		if len(orgTypes) < batchSize {
+			break
+		}
+	}
+
+	if len(allDocs) > 0 {
+		if err := i.client.IndexOrganizationTypes(ctx, allDocs); err != nil {
+			return 0, fmt.Errorf("failed to index organization types: %w", err)
+		}
+	}
+
+	slog.Info("Reindexed organization types", "count", len(allDocs))
+	return len(allDocs), nil
+}
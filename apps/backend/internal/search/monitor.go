@@ -0,0 +1,162 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/metrics"
+)
+
+const (
+	// MonitorInterval is how often the monitor compares the indexes with the database
+	MonitorInterval = 5 * time.Minute
+	// maxIndexDrift is the share by which an index may differ from the database before it counts as stale
+	maxIndexDrift = 0.1
+)
+
+// IndexHealth is the last observed state of one index
+type IndexHealth struct {
+	IndexStats
+	DatabaseCount int64 `json:"databaseCount"`
+	Stale         bool  `json:"stale"`
+}
+
+// Monitor periodically exports index stats as Prometheus gauges and flags
+// indexes that drifted from the database
+type Monitor struct {
+	client  *Client
+	queries *db.Queries
+
+	mu        sync.Mutex
+	indexes   map[string]IndexHealth
+	checkedAt time.Time
+}
+
+// NewMonitor creates a new index monitor
+func NewMonitor(client *Client, queries *db.Queries) *Monitor {
+	return &Monitor{client: client, queries: queries}
+}
+
+// Run checks the indexes every MonitorInterval until ctx is cancelled,
+// starting immediately
+func (m *Monitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(MonitorInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.check(ctx); err != nil && ctx.Err() == nil {
+			slog.Warn("Failed to check search indexes", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *Monitor) check(ctx context.Context) error {
+	stats, err := m.client.GetIndexStats(ctx)
+	if err != nil {
+		return err
+	}
+	counts, err := m.databaseCounts(ctx)
+	if err != nil {
+		return err
+	}
+
+	indexes := make(map[string]IndexHealth, len(stats))
+	for name, s := range stats {
+		health := IndexHealth{IndexStats: s, DatabaseCount: counts[name]}
+		health.Stale = isStale(s.NumberOfDocuments, health.DatabaseCount)
+		indexes[name] = health
+
+		metrics.SearchIndexDocuments.WithLabelValues(name).Set(float64(s.NumberOfDocuments))
+		metrics.SearchIndexIndexing.WithLabelValues(name).Set(boolToGauge(s.IsIndexing))
+		metrics.SearchIndexStale.WithLabelValues(name).Set(boolToGauge(health.Stale))
+	}
+
+	m.mu.Lock()
+	m.indexes = indexes
+	m.checkedAt = time.Now()
+	m.mu.Unlock()
+	return nil
+}
+
+// databaseCounts counts the rows each index is built from. Events only
+// count published ones, since the indexer skips the rest.
+func (m *Monitor) databaseCounts(ctx context.Context) (map[string]int64, error) {
+	events, err := m.queries.CountEvents(ctx, db.CountEventsParams{})
+	if err != nil {
+		return nil, err
+	}
+	orgs, err := m.queries.CountOrganizations(ctx, db.CountOrganizationsParams{})
+	if err != nil {
+		return nil, err
+	}
+	users, err := m.queries.CountUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := m.queries.CountTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	orgTypes, err := m.queries.CountOrganizationTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]int64{
+		IndexEvents:            events,
+		IndexOrganizations:     orgs,
+		IndexUsers:             users,
+		IndexTags:              tags,
+		IndexOrganizationTypes: orgTypes,
+	}, nil
+}
+
+func isStale(documents, rows int64) bool {
+	if rows == 0 {
+		return documents > 0
+	}
+	return math.Abs(float64(documents-rows))/float64(rows) > maxIndexDrift
+}
+
+func boolToGauge(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// ServeHTTP reports the last check as JSON. It answers 503 before the first
+// check and while any index is stale.
+func (m *Monitor) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	indexes, checkedAt := m.indexes, m.checkedAt
+	m.mu.Unlock()
+
+	status := http.StatusOK
+	if indexes == nil {
+		status = http.StatusServiceUnavailable
+	}
+	for _, health := range indexes {
+		if health.Stale {
+			status = http.StatusServiceUnavailable
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(struct {
+		CheckedAt time.Time              `json:"checkedAt"`
+		Indexes   map[string]IndexHealth `json:"indexes"`
+	}{checkedAt, indexes})
+}
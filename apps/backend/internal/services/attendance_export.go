@@ -0,0 +1,113 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+
+	"connectrpc.com/connect"
+	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
+	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/dberrors"
+	"github.com/studyverse/ems-backend/internal/logging"
+)
+
+// attendanceExportChunkSize caps the data of each AttendanceExportChunk
+const attendanceExportChunkSize = 64 * 1024
+
+// ExportEventAttendance streams an event's attendance as CSV. Rows are
+// written as they are read from the database and sent in chunks of up to
+// 64 KiB, so memory usage does not grow with the number of attendees.
+func (s *EventAttendanceService) ExportEventAttendance(ctx context.Context, req *connect.Request[eventsv1.ExportEventAttendanceRequest], stream *connect.ServerStream[eventsv1.AttendanceExportChunk]) error {
+	logging.WithContext(ctx).Debug("ExportEventAttendance", "eventId", req.Msg.EventId)
+
+	userID := auth.GetUserID(ctx)
+	if userID == "" {
+		return connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	if s.perms != nil {
+		eventID := fmt.Sprintf("%d", req.Msg.EventId)
+		allowed, err := s.perms.CheckPermission(ctx, userID, "event", eventID, "check_in")
+		if err != nil {
+			logging.WithContext(ctx).Warn("Permission check failed", "error", err)
+		}
+		if !allowed {
+			return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("you don't have permission to export this event's attendance"))
+		}
+	}
+
+	if _, err := s.queries.GetEvent(ctx, req.Msg.EventId); err != nil {
+		return dberrors.MapNotFound(err, "event not found")
+	}
+
+	stream.ResponseHeader().Set("Content-Type", "text/csv; charset=utf-8")
+	stream.ResponseHeader().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"event-%d-attendance.csv\"", req.Msg.EventId))
+
+	chunks := &chunkWriter{size: attendanceExportChunkSize, send: func(data []byte) error {
+		return stream.Send(&eventsv1.AttendanceExportChunk{Data: data})
+	}}
+	cw := csv.NewWriter(chunks)
+	_ = cw.Write([]string{"username", "email", "status", "checked_in_at", "notes"})
+
+	rows, errc := s.queries.StreamEventAttendance(ctx, req.Msg.EventId)
+	for row := range rows {
+		if err := cw.Write([]string{
+			row.Username,
+			row.Email,
+			string(row.Status),
+			formatCSVTime(row.CheckedInAt.Time, row.CheckedInAt.Valid),
+			row.Notes.String,
+		}); err != nil {
+			// Client went away; the cancelled request context stops the query
+			logging.WithContext(ctx).Warn("Failed to write CSV row", "eventId", req.Msg.EventId, "error", err)
+			return nil
+		}
+	}
+	if err := <-errc; err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to read attendance: %w", err))
+	}
+
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		logging.WithContext(ctx).Warn("Failed to flush CSV export", "eventId", req.Msg.EventId, "error", err)
+		return nil
+	}
+	if err := chunks.Flush(); err != nil {
+		logging.WithContext(ctx).Warn("Failed to send final CSV chunk", "eventId", req.Msg.EventId, "error", err)
+	}
+	return nil
+}
+
+// chunkWriter buffers writes and passes them to send in blocks of exactly
+// size bytes; Flush sends whatever is left
+type chunkWriter struct {
+	size int
+	buf  []byte
+	send func([]byte) error
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for len(w.buf) >= w.size {
+		chunk := make([]byte, w.size)
+		copy(chunk, w.buf)
+		if err := w.send(chunk); err != nil {
+			return 0, err
+		}
+		w.buf = w.buf[w.size:]
+	}
+	return len(p), nil
+}
+
+func (w *chunkWriter) Flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	chunk := w.buf
+	w.buf = nil
+	return w.send(chunk)
+}
@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
+	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/dberrors"
+	"github.com/studyverse/ems-backend/internal/logging"
+)
+
+// GetUserAttendanceHistory lists the events a user attended, newest first.
+// Users can see their own history, platform staff anyone's. Pages by page
+// and limit, or by cursor when one is given, as in ListEvents.
+func (s *EventAttendanceService) GetUserAttendanceHistory(ctx context.Context, req *connect.Request[eventsv1.GetUserAttendanceHistoryRequest]) (*connect.Response[eventsv1.UserAttendanceHistoryResponse], error) {
+	logging.WithContext(ctx).Debug("GetUserAttendanceHistory", "userId", req.Msg.UserId, "page", req.Msg.Page, "limit", req.Msg.Limit)
+
+	kratosID := auth.GetUserID(ctx)
+	if kratosID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	user, err := s.queries.GetUser(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, dberrors.MapNotFound(err, "user not found")
+	}
+
+	isSelf := user.KratosID.Valid && user.KratosID.String == kratosID
+	if !isSelf && s.perms != nil {
+		allowed, err := s.perms.IsGlobalStaff(ctx, kratosID)
+		if err != nil {
+			logging.WithContext(ctx).Warn("Permission check failed", "error", err)
+		}
+		if !allowed {
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("you don't have permission to view this user's attendance"))
+		}
+	}
+
+	page := req.Msg.Page
+	if page <= 0 {
+		page = 1
+	}
+	limit := req.Msg.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+	limit = min(limit, int32(s.cfg.MaxPageSize))
+
+	params := db.GetUserAttendanceHistoryParams{
+		UserID: user.ID,
+		Limit:  limit,
+		Offset: (page - 1) * limit,
+	}
+	if req.Msg.Cursor != nil {
+		params.Offset = 0
+		// One extra row tells whether there is a next page
+		params.Limit = limit + 1
+		if *req.Msg.Cursor != "" {
+			c, err := decodeEventListCursor(*req.Msg.Cursor)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid cursor"))
+			}
+			params.CursorStartTime = pgtype.Timestamptz{Time: c.StartTime, Valid: true}
+			params.CursorID = pgtype.Int4{Int32: c.ID, Valid: true}
+		}
+	}
+
+	rows, err := s.queries.GetUserAttendanceHistory(ctx, params)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	var nextCursor *string
+	if req.Msg.Cursor != nil && len(rows) > int(limit) {
+		rows = rows[:limit]
+		last := rows[len(rows)-1].Event
+		next := eventListCursor{StartTime: last.StartTime.Time, ID: last.ID}.encode()
+		nextCursor = &next
+	}
+
+	total, err := s.queries.CountUserAttendedEvents(ctx, user.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	eventIDs := make([]int32, len(rows))
+	orgIDs := make([]int32, len(rows))
+	for i, r := range rows {
+		eventIDs[i] = r.Event.ID
+		orgIDs[i] = r.Event.OrganizationID
+	}
+	orgs, err := s.queries.GetOrganizationsMap(ctx, orgIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	tags, err := s.queries.GetEventTagsMap(ctx, eventIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	events := make([]*eventsv1.AttendedEventSummary, len(rows))
+	for i, r := range rows {
+		summary := &eventsv1.AttendedEventSummary{
+			Event: dbEventWithRelationsToProto(r.Event, orgs[r.Event.OrganizationID], tags[r.Event.ID]),
+		}
+		if r.CheckedInAt.Valid {
+			checkedInAt := r.CheckedInAt.Time.Format(time.RFC3339)
+			summary.CheckedInAt = &checkedInAt
+		}
+		if r.Notes.Valid {
+			summary.Notes = &r.Notes.String
+		}
+		events[i] = summary
+	}
+
+	return connect.NewResponse(&eventsv1.UserAttendanceHistoryResponse{
+		Events:     events,
+		Total:      int32(total),
+		NextCursor: nextCursor,
+	}), nil
+}
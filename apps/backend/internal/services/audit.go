@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
+	"github.com/studyverse/ems-backend/gen/eventsv1/eventsv1connect"
+	usersv1 "github.com/studyverse/ems-backend/gen/usersv1"
+	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/logging"
+	"github.com/studyverse/ems-backend/internal/perms"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+type AuditService struct {
+	eventsv1connect.UnimplementedAuditServiceHandler
+	queries *db.Queries
+	perms   *perms.Client
+}
+
+func NewAuditService(queries *db.Queries, permsClient *perms.Client) *AuditService {
+	return &AuditService{queries: queries, perms: permsClient}
+}
+
+// ListAuditLogs lists recorded mutations, newest first. Only platform admins
+// can read the audit trail.
+func (s *AuditService) ListAuditLogs(ctx context.Context, req *connect.Request[eventsv1.ListAuditLogsRequest]) (*connect.Response[eventsv1.ListAuditLogsResponse], error) {
+	logging.WithContext(ctx).Debug("ListAuditLogs", "resourceType", req.Msg.ResourceType, "resourceId", req.Msg.ResourceId)
+
+	userID := auth.GetUserID(ctx)
+	if userID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	if err := requirePlatformPermission(ctx, s.perms, userID, "manage_system", "view the audit log"); err != nil {
+		return nil, err
+	}
+
+	page := req.Msg.Page
+	if page < 1 {
+		page = 1
+	}
+	limit := req.Msg.Limit
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	entries, err := s.queries.ListAuditLogs(ctx, db.ListAuditLogsParams{
+		ResourceType: req.Msg.ResourceType,
+		ResourceID:   req.Msg.ResourceId,
+		Limit:        limit,
+		Offset:       (page - 1) * limit,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	total, err := s.queries.CountAuditLogs(ctx, db.CountAuditLogsParams{
+		ResourceType: req.Msg.ResourceType,
+		ResourceID:   req.Msg.ResourceId,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoEntries := make([]*eventsv1.AuditLogEntry, len(entries))
+	for i, e := range entries {
+		protoEntries[i] = dbAuditLogToProto(e)
+	}
+
+	return connect.NewResponse(&eventsv1.ListAuditLogsResponse{
+		Entries: protoEntries,
+		Total:   int32(total),
+	}), nil
+}
+
+// recordAudit writes an audit entry for a mutating RPC that succeeded. The
+// action is the RPC name and the payload the request message. Failures are
+// only logged, since the mutation itself already went through.
+func recordAudit(ctx context.Context, queries *db.Queries, req connect.AnyRequest, resourceType string, resourceID any) {
+	action := path.Base(req.Spec().Procedure)
+
+	payload, err := protojson.Marshal(redactAuditPayload(req.Any().(proto.Message)))
+	if err != nil {
+		logging.WithContext(ctx).Warn("Failed to encode audit payload", "error", err, "action", action)
+		return
+	}
+
+	actor := auth.GetUserID(ctx)
+	if err := queries.CreateAuditEntry(ctx, db.CreateAuditEntryParams{
+		ActorKratosID: pgtype.Text{String: actor, Valid: actor != ""},
+		Action:        action,
+		ResourceType:  resourceType,
+		ResourceID:    fmt.Sprint(resourceID),
+		Payload:       payload,
+	}); err != nil {
+		logging.WithContext(ctx).Warn("Failed to record audit entry", "error", err, "action", action, "resourceType", resourceType, "resourceId", resourceID)
+	}
+}
+
+// redactAuditPayload returns msg with secrets cleared, copying it first so
+// the handler's request is left alone
+func redactAuditPayload(msg proto.Message) proto.Message {
+	switch m := msg.(type) {
+	case *usersv1.CreateUserRequest:
+		m = proto.Clone(m).(*usersv1.CreateUserRequest)
+		m.Password = ""
+		return m
+	}
+	return msg
+}
+
+func dbAuditLogToProto(e db.AuditLog) *eventsv1.AuditLogEntry {
+	entry := &eventsv1.AuditLogEntry{
+		Id:           e.ID,
+		Action:       e.Action,
+		ResourceType: e.ResourceType,
+		ResourceId:   e.ResourceID,
+		Payload:      string(e.Payload),
+		CreatedAt:    e.CreatedAt.Time.Format(time.RFC3339),
+	}
+	if e.ActorKratosID.Valid {
+		entry.ActorKratosId = &e.ActorKratosID.String
+	}
+	return entry
+}
@@ -0,0 +1,220 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/logging"
+)
+
+// cancelLinkTTL is how long an emailed cancellation link stays valid
+const cancelLinkTTL = 7 * 24 * time.Hour
+
+// CancelLinkSigner builds self-service cancellation links for registration emails
+type CancelLinkSigner struct {
+	baseURL string
+	secret  []byte
+}
+
+// NewCancelLinkSigner returns nil when secret is empty, which disables links
+func NewCancelLinkSigner(baseURL, secret string) *CancelLinkSigner {
+	if secret == "" {
+		return nil
+	}
+	return &CancelLinkSigner{baseURL: baseURL, secret: []byte(secret)}
+}
+
+// sign computes the token for a registration. The expiry is part of the MAC,
+// so a re-issued link never repeats an earlier token.
+func (c *CancelLinkSigner) sign(reg db.EventRegistration, expiresAt time.Time) string {
+	mac := hmac.New(sha256.New, c.secret)
+	fmt.Fprintf(mac, "%d:%d:%d:%d", reg.ID, reg.UserID, reg.EventID, expiresAt.Unix())
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (c *CancelLinkSigner) url(registrationID int32, token string) string {
+	return fmt.Sprintf("%s/registrations/%d/cancel?token=%s", c.baseURL, registrationID, token)
+}
+
+// hashCancelToken is what gets stored, so a database leak doesn't expose working links
+func hashCancelToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// issueCancelLink stores a fresh token for reg and returns its link.
+// It returns an empty string when links are disabled.
+func (s *EventRegistrationsService) issueCancelLink(ctx context.Context, q *db.Queries, reg db.EventRegistration) (string, error) {
+	if s.cancelLinks == nil {
+		return "", nil
+	}
+
+	expiresAt := time.Now().Add(cancelLinkTTL)
+	token := s.cancelLinks.sign(reg, expiresAt)
+	if err := q.SetRegistrationCancelToken(ctx, db.SetRegistrationCancelTokenParams{
+		ID:                   reg.ID,
+		CancelToken:          pgtype.Text{String: hashCancelToken(token), Valid: true},
+		CancelTokenExpiresAt: pgtype.Timestamptz{Time: expiresAt, Valid: true},
+	}); err != nil {
+		return "", err
+	}
+
+	return s.cancelLinks.url(reg.ID, token), nil
+}
+
+// RegisterRoutes mounts the plain HTTP routes on the given mux
+func (s *EventRegistrationsService) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /registrations/{id}/cancel", s.ConfirmCancelRegistrationByLink)
+	mux.HandleFunc("POST /registrations/{id}/cancel", s.CancelRegistrationByLink)
+}
+
+// ConfirmCancelRegistrationByLink is where an emailed link lands. It only
+// renders a form that posts the token back, so mail scanners and prefetchers
+// following the link don't cancel anything.
+func (s *EventRegistrationsService) ConfirmCancelRegistrationByLink(w http.ResponseWriter, r *http.Request) {
+	registrationID, err := strconv.ParseInt(r.PathValue("id"), 10, 32)
+	if err != nil {
+		http.Error(w, "invalid registration id", http.StatusBadRequest)
+		return
+	}
+	logging.WithContext(r.Context()).Debug("ConfirmCancelRegistrationByLink", "registrationId", registrationID)
+
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		http.Error(w, "missing token", http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Referrer-Policy", "no-referrer")
+	_ = cancelConfirmPage.Execute(w, struct {
+		Action string
+		Token  string
+	}{
+		Action: fmt.Sprintf("/registrations/%d/cancel", registrationID),
+		Token:  token,
+	})
+}
+
+// CancelRegistrationByLink cancels a registration from the confirmation form.
+// The token is cleared in the same transaction, so each link works once.
+func (s *EventRegistrationsService) CancelRegistrationByLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	registrationID, err := strconv.ParseInt(r.PathValue("id"), 10, 32)
+	if err != nil {
+		http.Error(w, "invalid registration id", http.StatusBadRequest)
+		return
+	}
+	logging.WithContext(ctx).Debug("CancelRegistrationByLink", "registrationId", registrationID)
+
+	token := r.PostFormValue("token")
+	if token == "" {
+		http.Error(w, "missing token", http.StatusBadRequest)
+		return
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := s.queries.WithTx(tx)
+
+	const invalidLink = "This cancellation link is invalid, has expired or has already been used."
+
+	// Take the event lock before touching the registration row, in the same
+	// order as CancelRegistration, so the two can't deadlock
+	reg, err := qtx.GetEventRegistration(ctx, int32(registrationID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			writeCancelLinkPage(w, http.StatusGone, invalidLink)
+			return
+		}
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	if _, err := qtx.LockEventForRegistration(ctx, reg.EventID); err != nil {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	reg, err = qtx.ConsumeRegistrationCancelToken(ctx, db.ConsumeRegistrationCancelTokenParams{
+		ID:          int32(registrationID),
+		CancelToken: pgtype.Text{String: hashCancelToken(token), Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			writeCancelLinkPage(w, http.StatusGone, invalidLink)
+			return
+		}
+		logging.WithContext(ctx).Error("Failed to consume cancel token", "registrationId", registrationID, "error", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	cancelled, err := qtx.CancelEventRegistration(ctx, reg.ID)
+	if err != nil {
+		logging.WithContext(ctx).Error("Failed to cancel registration", "registrationId", reg.ID, "error", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	// The token only matches a registered seat, so a cancel here freed one
+	if cancelled == 1 {
+		if err := s.promoteFromWaitlist(ctx, qtx, reg.EventID); err != nil {
+			logging.WithContext(ctx).Error("Failed to promote from waitlist", "eventId", reg.EventID, "error", err)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	logging.WithContext(ctx).Info("Registration cancelled by link", "registrationId", reg.ID, "eventId", reg.EventID)
+	reindexEventCounts(ctx, s.queries, s.search, reg.EventID)
+
+	writeCancelLinkPage(w, http.StatusOK, "Your registration has been cancelled.")
+}
+
+var cancelConfirmPage = template.Must(template.New("confirm").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Registration cancellation</title></head>
+<body>
+<p>Do you want to cancel your registration?</p>
+<form method="post" action="{{.Action}}">
+<input type="hidden" name="token" value="{{.Token}}">
+<button type="submit">Cancel registration</button>
+</form>
+</body>
+</html>
+`))
+
+var cancelLinkPage = template.Must(template.New("cancel").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Registration cancellation</title></head>
+<body><p>{{.}}</p></body>
+</html>
+`))
+
+func writeCancelLinkPage(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	_ = cancelLinkPage.Execute(w, message)
+}
@@ -0,0 +1,98 @@
+package services
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/studyverse/ems-backend/internal/db"
+)
+
+func TestNewCancelLinkSignerDisabledWithoutSecret(t *testing.T) {
+	if c := NewCancelLinkSigner("https://ems.example.com", ""); c != nil {
+		t.Errorf("NewCancelLinkSigner with no secret = %v, want nil", c)
+	}
+}
+
+func TestCancelLinkSignerSign(t *testing.T) {
+	c := NewCancelLinkSigner("https://ems.example.com", "secret")
+	reg := db.EventRegistration{ID: 1, UserID: 2, EventID: 3}
+	expiresAt := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	token := c.sign(reg, expiresAt)
+	if token != c.sign(reg, expiresAt) {
+		t.Fatal("sign isn't deterministic")
+	}
+	if len(token) != 64 {
+		t.Errorf("token %q has length %d, want a hex SHA-256 MAC", token, len(token))
+	}
+
+	tests := []struct {
+		name      string
+		signer    *CancelLinkSigner
+		reg       db.EventRegistration
+		expiresAt time.Time
+	}{
+		{"other registration", c, db.EventRegistration{ID: 9, UserID: 2, EventID: 3}, expiresAt},
+		{"other user", c, db.EventRegistration{ID: 1, UserID: 9, EventID: 3}, expiresAt},
+		{"other event", c, db.EventRegistration{ID: 1, UserID: 2, EventID: 9}, expiresAt},
+		{"re-issued later", c, reg, expiresAt.Add(time.Second)},
+		{"other secret", NewCancelLinkSigner("https://ems.example.com", "rotated"), reg, expiresAt},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.signer.sign(tt.reg, tt.expiresAt) == token {
+				t.Error("token didn't change")
+			}
+		})
+	}
+}
+
+func TestCancelLinkSignerURL(t *testing.T) {
+	c := NewCancelLinkSigner("https://ems.example.com", "secret")
+	want := "https://ems.example.com/registrations/42/cancel?token=abc123"
+	if got := c.url(42, "abc123"); got != want {
+		t.Errorf("url() = %q, want %q", got, want)
+	}
+}
+
+func TestHashCancelToken(t *testing.T) {
+	token := "abc123"
+	hash := hashCancelToken(token)
+	if hash == token {
+		t.Fatal("token stored unhashed")
+	}
+	if hash != hashCancelToken(token) {
+		t.Error("hashCancelToken isn't deterministic")
+	}
+	if hash == hashCancelToken("abc124") {
+		t.Error("different tokens hash the same")
+	}
+}
+
+func TestConfirmCancelRegistrationByLink(t *testing.T) {
+	s := &EventRegistrationsService{}
+	mux := http.NewServeMux()
+	s.RegisterRoutes(mux)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/registrations/42/cancel?token=abc123", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+	body := rec.Body.String()
+	if !strings.Contains(body, `action="/registrations/42/cancel"`) || !strings.Contains(body, `value="abc123"`) {
+		t.Errorf("confirmation form doesn't post the token back:\n%s", body)
+	}
+	if rec.Header().Get("Cache-Control") != "no-store" {
+		t.Error("confirmation page may be cached")
+	}
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/registrations/42/cancel", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("missing token status = %d, want 400", rec.Code)
+	}
+}
@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
+	"github.com/studyverse/ems-backend/internal/logging"
+)
+
+// clubLeaderboardScore maps each sort option to the single score column
+// GetClubLeaderboard pages by, over the per-club totals of its query
+var clubLeaderboardScore = map[eventsv1.ClubLeaderboardSortBy]string{
+	eventsv1.ClubLeaderboardSortBy_CLUB_LEADERBOARD_SORT_BY_UNSPECIFIED:              "total_events::float8",
+	eventsv1.ClubLeaderboardSortBy_CLUB_LEADERBOARD_SORT_BY_TOTAL_EVENTS_DESC:        "total_events::float8",
+	eventsv1.ClubLeaderboardSortBy_CLUB_LEADERBOARD_SORT_BY_TOTAL_REGISTRATIONS_DESC: "total_regs::float8",
+	eventsv1.ClubLeaderboardSortBy_CLUB_LEADERBOARD_SORT_BY_AVG_ATTENDANCE_RATE_DESC: "COALESCE(total_attended::float8 / NULLIF(total_regs, 0), 0)",
+}
+
+// clubLeaderboardCursor is the (score, id) keyset position after the last
+// club of a page. Since pins the start of the period, otherwise it would move
+// with the clock between pages and clubs could be skipped or repeated.
+type clubLeaderboardCursor struct {
+	LastScore float64   `json:"lastScore"`
+	LastID    int32     `json:"lastId"`
+	Since     time.Time `json:"since"`
+}
+
+func (c clubLeaderboardCursor) encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeClubLeaderboardCursor(s string) (clubLeaderboardCursor, error) {
+	var c clubLeaderboardCursor
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, err
+	}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return c, err
+	}
+	return c, nil
+}
+
+// GetClubLeaderboard ranks the clubs with events in the last days by the
+// sort_by metric, best first, paging by cursor on (score, club ID)
+func (s *StatisticsService) GetClubLeaderboard(ctx context.Context, req *connect.Request[eventsv1.GetClubLeaderboardRequest]) (*connect.Response[eventsv1.ClubLeaderboardResponse], error) {
+	logging.WithContext(ctx).Debug("GetClubLeaderboard", "days", req.Msg.Days, "limit", req.Msg.Limit, "sortBy", req.Msg.SortBy)
+
+	limit := req.Msg.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+	limit = min(limit, int32(s.cfg.MaxPageSize))
+	days := int(req.Msg.Days)
+	if days <= 0 {
+		days = 90
+	}
+	score, ok := clubLeaderboardScore[req.Msg.SortBy]
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported sort_by %v", req.Msg.SortBy))
+	}
+
+	since := time.Now().AddDate(0, 0, -days)
+	var cursor *clubLeaderboardCursor
+	if req.Msg.Cursor != "" {
+		c, err := decodeClubLeaderboardCursor(req.Msg.Cursor)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid cursor"))
+		}
+		cursor = &c
+		since = c.Since
+	}
+
+	var total int32
+	if err := s.pool.QueryRow(ctx, `
+		SELECT COUNT(DISTINCT organization_id) FROM events WHERE start_time >= $1 AND NOT is_deleted
+	`, since).Scan(&total); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	var cursorScore *float64
+	var cursorID int32
+	if cursor != nil {
+		cursorScore = &cursor.LastScore
+		cursorID = cursor.LastID
+	}
+
+	// The events join is served by idx_events_org_start (organization_id,
+	// start_time). The score is computed once in club_totals so the keyset
+	// condition and ORDER BY compare the exact same float8 values.
+	rows, err := s.pool.Query(ctx, `
+		WITH club_totals AS (
+			SELECT o.id, o.title, o.image_url,
+				COUNT(DISTINCT e.id) AS total_events,
+				COUNT(DISTINCT CASE WHEN er.status = 'registered' THEN er.id END) AS total_regs,
+				COUNT(DISTINCT CASE WHEN ea.status = 'attended' THEN ea.id END) AS total_attended
+			FROM organizations o
+			INNER JOIN events e ON e.organization_id = o.id AND e.start_time >= $1 AND NOT e.is_deleted
+			LEFT JOIN event_registrations er ON er.event_id = e.id
+			LEFT JOIN event_attendance ea ON ea.registration_id = er.id
+			GROUP BY o.id, o.title, o.image_url
+		), scored AS (
+			SELECT *, `+score+` AS score FROM club_totals
+		)
+		SELECT id, title, image_url, total_events, total_regs, total_attended, score
+		FROM scored
+		WHERE $2::float8 IS NULL OR score < $2 OR (score = $2 AND id > $3)
+		ORDER BY score DESC, id
+		LIMIT $4
+	`, since, cursorScore, cursorID, limit+1)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	defer rows.Close()
+
+	var clubs []*eventsv1.ClubLeaderboard
+	var scores []float64
+	for rows.Next() {
+		var id int32
+		var title string
+		var imageURL *string
+		var totalEvents, totalRegs, totalAttended int32
+		var rowScore float64
+		if err := rows.Scan(&id, &title, &imageURL, &totalEvents, &totalRegs, &totalAttended, &rowScore); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		var avgRate float64
+		if totalRegs > 0 {
+			avgRate = float64(totalAttended) / float64(totalRegs) * 100
+		}
+		clubs = append(clubs, &eventsv1.ClubLeaderboard{
+			OrganizationId:        id,
+			OrganizationTitle:     title,
+			OrganizationImage:     imageURL,
+			TotalEvents:           totalEvents,
+			TotalRegistrations:    totalRegs,
+			TotalAttendees:        totalAttended,
+			AverageAttendanceRate: avgRate,
+		})
+		scores = append(scores, rowScore)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &eventsv1.ClubLeaderboardResponse{TotalClubs: total}
+	// One extra row tells whether there is a next page
+	if len(clubs) > int(limit) {
+		clubs = clubs[:limit]
+		last := clubs[len(clubs)-1]
+		next := clubLeaderboardCursor{LastScore: scores[limit-1], LastID: last.OrganizationId, Since: since}.encode()
+		resp.NextCursor = &next
+	}
+	resp.Clubs = clubs
+	return connect.NewResponse(resp), nil
+}
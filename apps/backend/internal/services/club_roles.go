@@ -0,0 +1,190 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"connectrpc.com/connect"
+	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
+	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/dberrors"
+	"github.com/studyverse/ems-backend/internal/logging"
+	"github.com/studyverse/ems-backend/internal/perms"
+)
+
+// clubRoles pairs the proto club roles with their user_roles role names,
+// which clubRoleRelations maps to SpiceDB relations
+var clubRoles = []struct {
+	proto eventsv1.ClubRole
+	name  string
+}{
+	{eventsv1.ClubRole_CLUB_ROLE_PRESIDENT, "President"},
+	{eventsv1.ClubRole_CLUB_ROLE_STAFF, "Staff"},
+	{eventsv1.ClubRole_CLUB_ROLE_MEMBER, "Member"},
+}
+
+func protoClubRoleToDB(r eventsv1.ClubRole) (string, bool) {
+	for _, cr := range clubRoles {
+		if cr.proto == r {
+			return cr.name, true
+		}
+	}
+	return "", false
+}
+
+// AssignClubRole gives a user exactly one role in a club, replacing any
+// role they had before
+func (s *OrganizationsService) AssignClubRole(ctx context.Context, req *connect.Request[eventsv1.AssignClubRoleRequest]) (*connect.Response[eventsv1.AssignClubRoleResponse], error) {
+	logging.WithContext(ctx).Debug("AssignClubRole", "organizationId", req.Msg.OrganizationId, "userId", req.Msg.UserId, "role", req.Msg.Role)
+
+	roleName, ok := protoClubRoleToDB(req.Msg.Role)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("role is required"))
+	}
+
+	if err := s.requireManageSettings(ctx, req.Msg.OrganizationId, "manage this organization's members"); err != nil {
+		return nil, err
+	}
+
+	user, err := s.clubMemberUser(ctx, req.Msg.OrganizationId, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.queries.ReplaceUserClubRole(ctx, db.ReplaceUserClubRoleParams{
+		UserID:         user.ID,
+		OrganizationID: req.Msg.OrganizationId,
+		RoleName:       roleName,
+	}); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to assign role: %w", err))
+	}
+
+	if s.perms != nil {
+		clubID := strconv.Itoa(int(req.Msg.OrganizationId))
+		subjectID := spiceDBUserSubject(user)
+		relation := clubRoleRelations[roleName]
+
+		// Deletes are no-ops for relationships that don't exist, so drop every
+		// other role rather than only the one recorded in the database
+		var stale []perms.Relationship
+		for _, other := range clubRoleRelations {
+			if other != relation {
+				stale = append(stale, perms.Relationship{Resource: "club", ResourceID: clubID, Relation: other, SubjectType: "user", SubjectID: subjectID})
+			}
+		}
+		if err := s.perms.DeleteRelationships(ctx, stale); err != nil {
+			logging.WithContext(ctx).Warn("Failed to remove old club roles in SpiceDB", "error", err, "clubId", clubID, "userId", user.ID)
+		}
+		if err := s.perms.SetupClubRelationship(ctx, clubID, subjectID, relation); err != nil {
+			logging.WithContext(ctx).Warn("Failed to setup club role relationship in SpiceDB", "error", err, "clubId", clubID, "userId", user.ID)
+		}
+	}
+
+	recordAudit(ctx, s.queries, req, "organization", req.Msg.OrganizationId)
+
+	return connect.NewResponse(&eventsv1.AssignClubRoleResponse{
+		Member: &eventsv1.OrganizationMember{
+			UserId:   user.ID,
+			Username: user.Username,
+			Email:    user.Email,
+			Role:     roleName,
+		},
+	}), nil
+}
+
+// RemoveClubMember takes away all of a user's roles in a club. The
+// user_roles rows and the SpiceDB relations are removed together: if the
+// database change can't be committed, the revoked relations are restored.
+func (s *OrganizationsService) RemoveClubMember(ctx context.Context, req *connect.Request[eventsv1.RemoveClubMemberRequest]) (*connect.Response[eventsv1.RemoveClubMemberResponse], error) {
+	logging.WithContext(ctx).Debug("RemoveClubMember", "organizationId", req.Msg.OrganizationId, "userId", req.Msg.UserId)
+
+	if err := s.requireManageSettings(ctx, req.Msg.OrganizationId, "manage this organization's members"); err != nil {
+		return nil, err
+	}
+
+	user, err := s.clubMemberUser(ctx, req.Msg.OrganizationId, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	defer tx.Rollback(ctx)
+	qtx := s.queries.WithTx(tx)
+
+	if _, err := qtx.DeleteUserClubRoles(ctx, db.DeleteUserClubRolesParams{
+		UserID:         user.ID,
+		OrganizationID: req.Msg.OrganizationId,
+	}); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to remove roles: %w", err))
+	}
+
+	// SpiceDB can hold roles without a user_roles row, e.g. the creator's
+	// presidency, so revoke whatever relations it has rather than only the
+	// roles recorded in the database
+	committed := false
+	if s.perms != nil {
+		clubID := strconv.Itoa(int(req.Msg.OrganizationId))
+		subjectID := spiceDBUserSubject(user)
+
+		held, err := s.perms.GetClubRoles(ctx, clubID, subjectID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to read club roles: %w", err))
+		}
+
+		var revoked []string
+		defer func() {
+			if committed {
+				return
+			}
+			for _, role := range revoked {
+				if err := s.perms.SetupClubRelationship(context.WithoutCancel(ctx), clubID, subjectID, role); err != nil {
+					logging.WithContext(ctx).Error("Failed to restore club role in SpiceDB", "error", err, "clubId", clubID, "userId", user.ID, "role", role)
+				}
+			}
+		}()
+		for _, role := range held {
+			if err := s.perms.RevokeClubRole(ctx, clubID, subjectID, role); err != nil {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to revoke club role: %w", err))
+			}
+			revoked = append(revoked, role)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	committed = true
+
+	recordAudit(ctx, s.queries, req, "organization", req.Msg.OrganizationId)
+
+	return connect.NewResponse(&eventsv1.RemoveClubMemberResponse{
+		Success: true,
+	}), nil
+}
+
+// clubMemberUser checks that the club exists and returns the user
+func (s *OrganizationsService) clubMemberUser(ctx context.Context, orgID, userID int32) (db.User, error) {
+	if _, err := s.queries.GetOrganization(ctx, orgID); err != nil {
+		return db.User{}, dberrors.MapNotFound(err, "organization not found")
+	}
+
+	user, err := s.queries.GetUser(ctx, userID)
+	if err != nil {
+		return db.User{}, dberrors.MapNotFound(err, "user not found")
+	}
+	return user, nil
+}
+
+// spiceDBUserSubject is the SpiceDB subject of a user: the Kratos identity
+// ID, falling back to the local ID
+func spiceDBUserSubject(user db.User) string {
+	if user.KratosID.Valid {
+		return user.KratosID.String
+	}
+	return strconv.Itoa(int(user.ID))
+}
@@ -2,41 +2,52 @@ package services
 
 import (
 	"context"
-	"log/slog"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
 	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
 	"github.com/studyverse/ems-backend/gen/eventsv1/eventsv1connect"
+	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/config"
 	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/dberrors"
+	"github.com/studyverse/ems-backend/internal/logging"
+	"github.com/studyverse/ems-backend/internal/perms"
+	"github.com/studyverse/ems-backend/internal/search"
 )
 
 type EventAttendanceService struct {
 	eventsv1connect.UnimplementedEventAttendanceServiceHandler
-	queries *db.Queries
+	queries  *db.Queries
+	pool     *pgxpool.Pool
+	listener *db.Listener
+	perms    *perms.Client
+	search   *search.Client
+	cfg      *config.Config
 }
 
-func NewEventAttendanceService(queries *db.Queries) *EventAttendanceService {
-	return &EventAttendanceService{queries: queries}
+func NewEventAttendanceService(queries *db.Queries, pool *pgxpool.Pool, listener *db.Listener, permsClient *perms.Client, searchClient *search.Client, cfg *config.Config) *EventAttendanceService {
+	return &EventAttendanceService{queries: queries, pool: pool, listener: listener, perms: permsClient, search: searchClient, cfg: cfg}
 }
 
 func (s *EventAttendanceService) CheckInAttendee(ctx context.Context, req *connect.Request[eventsv1.CheckInAttendeeRequest]) (*connect.Response[eventsv1.CheckInAttendeeResponse], error) {
-	slog.Debug("CheckInAttendee", "registrationId", req.Msg.RegistrationId)
+	logging.WithContext(ctx).Debug("CheckInAttendee", "registrationId", req.Msg.RegistrationId)
 
 	// Check if registration exists
-	_, err := s.queries.GetEventRegistration(ctx, req.Msg.RegistrationId)
+	reg, err := s.queries.GetEventRegistration(ctx, req.Msg.RegistrationId)
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return nil, connect.NewError(connect.CodeNotFound, nil)
-		}
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, dberrors.Map(err)
 	}
 
 	// Check if already has attendance record
 	_, err = s.queries.GetEventAttendanceByRegistration(ctx, req.Msg.RegistrationId)
-	if err != nil && err != pgx.ErrNoRows {
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
@@ -67,28 +78,136 @@ func (s *EventAttendanceService) CheckInAttendee(ctx context.Context, req *conne
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	s.publishAttendance(ctx, reg.EventID, att)
+
 	return connect.NewResponse(&eventsv1.CheckInAttendeeResponse{
 		Attendance: dbEventAttendanceToProto(att),
 	}), nil
 }
 
-func (s *EventAttendanceService) MarkAttendance(ctx context.Context, req *connect.Request[eventsv1.MarkAttendanceRequest]) (*connect.Response[eventsv1.MarkAttendanceResponse], error) {
-	slog.Debug("MarkAttendance", "registrationId", req.Msg.RegistrationId, "status", req.Msg.Status)
+// BulkCheckIn checks in many registrations in one call, for staff scanning
+// tickets at the door. Registrations that can't be checked in are reported
+// in failed, the others are still checked in.
+func (s *EventAttendanceService) BulkCheckIn(ctx context.Context, req *connect.Request[eventsv1.BulkCheckInRequest]) (*connect.Response[eventsv1.BulkCheckInResponse], error) {
+	logging.WithContext(ctx).Debug("BulkCheckIn", "count", len(req.Msg.RegistrationIds), "checkedInBy", req.Msg.CheckedInBy)
 
-	// Check if registration exists
-	_, err := s.queries.GetEventRegistration(ctx, req.Msg.RegistrationId)
+	userID := auth.GetUserID(ctx)
+	if userID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	tx, err := s.pool.Begin(ctx)
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return nil, connect.NewError(connect.CodeNotFound, nil)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	defer tx.Rollback(ctx)
+	qtx := s.queries.WithTx(tx)
+
+	regs, err := qtx.GetEventRegistrationsByIDs(ctx, req.Msg.RegistrationIds)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	regsByID := make(map[int32]db.EventRegistration, len(regs))
+	for _, reg := range regs {
+		regsByID[reg.ID] = reg
+	}
+
+	checkInIDs, failed := partitionCheckIns(req.Msg.RegistrationIds, regsByID, func(eventID int32) bool {
+		return s.canCheckIn(ctx, userID, eventID)
+	})
+	resp := &eventsv1.BulkCheckInResponse{Failed: failed}
+
+	var atts []db.EventAttendance
+	if len(checkInIDs) > 0 {
+		atts, err = qtx.BulkCheckInAttendance(ctx, db.BulkCheckInAttendanceParams{
+			RegistrationIds: checkInIDs,
+			CheckedInBy:     pgtype.Int4{Int32: req.Msg.CheckedInBy, Valid: true},
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
 		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	for _, att := range atts {
+		resp.Succeeded = append(resp.Succeeded, att.RegistrationID)
+		s.publishAttendance(ctx, regsByID[att.RegistrationID].EventID, att)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// partitionCheckIns splits the requested IDs, in request order and without
+// duplicates, into the registrations to check in and failures with a reason.
+// canCheckIn is asked once per event, scans usually cover a single one.
+func partitionCheckIns(ids []int32, regsByID map[int32]db.EventRegistration, canCheckIn func(eventID int32) bool) ([]int32, []*eventsv1.BulkCheckInFailure) {
+	var checkInIDs []int32
+	var failed []*eventsv1.BulkCheckInFailure
+	fail := func(id int32, reason string) {
+		failed = append(failed, &eventsv1.BulkCheckInFailure{RegistrationId: id, Reason: reason})
+	}
+
+	allowedEvents := map[int32]bool{}
+	seen := make(map[int32]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		reg, ok := regsByID[id]
+		if !ok {
+			fail(id, "registration not found")
+			continue
+		}
+		if reg.Status != db.RegistrationStatusRegistered {
+			fail(id, fmt.Sprintf("registration is %s", reg.Status))
+			continue
+		}
+		allowed, checked := allowedEvents[reg.EventID]
+		if !checked {
+			allowed = canCheckIn(reg.EventID)
+			allowedEvents[reg.EventID] = allowed
+		}
+		if !allowed {
+			fail(id, "permission denied")
+			continue
+		}
+		checkInIDs = append(checkInIDs, id)
+	}
+	return checkInIDs, failed
+}
+
+// canCheckIn reports whether the user may check in attendees of the event.
+// A failed check denies.
+func (s *EventAttendanceService) canCheckIn(ctx context.Context, userID string, eventID int32) bool {
+	if s.perms == nil {
+		return true
+	}
+	allowed, err := s.perms.CheckPermission(ctx, userID, "event", fmt.Sprintf("%d", eventID), "check_in")
+	if err != nil {
+		logging.WithContext(ctx).Warn("Permission check failed", "error", err, "eventId", eventID)
+	}
+	return allowed
+}
+
+func (s *EventAttendanceService) MarkAttendance(ctx context.Context, req *connect.Request[eventsv1.MarkAttendanceRequest]) (*connect.Response[eventsv1.MarkAttendanceResponse], error) {
+	logging.WithContext(ctx).Debug("MarkAttendance", "registrationId", req.Msg.RegistrationId, "status", req.Msg.Status)
+
+	// Check if registration exists
+	reg, err := s.queries.GetEventRegistration(ctx, req.Msg.RegistrationId)
+	if err != nil {
+		return nil, dberrors.Map(err)
+	}
+
 	status := protoAttendanceStatusToDB(req.Msg.Status)
 
 	// Check if already has attendance record
-	_, err = s.queries.GetEventAttendanceByRegistration(ctx, req.Msg.RegistrationId)
-	if err != nil && err != pgx.ErrNoRows {
+	existing, err := s.queries.GetEventAttendanceByRegistration(ctx, req.Msg.RegistrationId)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
@@ -119,13 +238,20 @@ func (s *EventAttendanceService) MarkAttendance(ctx context.Context, req *connec
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	s.publishAttendance(ctx, reg.EventID, att)
+
+	// Only the attended status counts towards the rate, so moving into or out of it changes it
+	if status == db.AttendanceStatusAttended || existing.Status == db.AttendanceStatusAttended {
+		reindexEventCounts(ctx, s.queries, s.search, reg.EventID)
+	}
+
 	return connect.NewResponse(&eventsv1.MarkAttendanceResponse{
 		Attendance: dbEventAttendanceToProto(att),
 	}), nil
 }
 
 func (s *EventAttendanceService) GetEventAttendance(ctx context.Context, req *connect.Request[eventsv1.GetEventAttendanceRequest]) (*connect.Response[eventsv1.GetEventAttendanceResponse], error) {
-	slog.Debug("GetEventAttendance", "eventId", req.Msg.EventId)
+	logging.WithContext(ctx).Debug("GetEventAttendance", "eventId", req.Msg.EventId)
 
 	attendanceList, err := s.queries.GetEventAttendanceForEvent(ctx, req.Msg.EventId)
 	if err != nil {
@@ -150,6 +276,76 @@ func (s *EventAttendanceService) GetEventAttendance(ctx context.Context, req *co
 	}), nil
 }
 
+// StreamEventAttendance sends the event's attendance changes as they are
+// written, until the client disconnects
+func (s *EventAttendanceService) StreamEventAttendance(ctx context.Context, req *connect.Request[eventsv1.StreamEventAttendanceRequest], stream *connect.ServerStream[eventsv1.StreamEventAttendanceResponse]) error {
+	logging.WithContext(ctx).Debug("StreamEventAttendance", "eventId", req.Msg.EventId)
+
+	userID := auth.GetUserID(ctx)
+	if userID == "" {
+		return connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	if s.perms != nil {
+		eventID := fmt.Sprintf("%d", req.Msg.EventId)
+		allowed, err := s.perms.CheckPermission(ctx, userID, "event", eventID, "check_in")
+		if err != nil {
+			logging.WithContext(ctx).Warn("Permission check failed", "error", err)
+		}
+		if !allowed {
+			return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("you don't have permission to view this event's attendance"))
+		}
+	}
+
+	if _, err := s.queries.GetEvent(ctx, req.Msg.EventId); err != nil {
+		return dberrors.MapNotFound(err, "event not found")
+	}
+
+	payloads, unsubscribe := s.listener.Subscribe(db.AttendanceChannel(req.Msg.EventId))
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case payload, ok := <-payloads:
+			if !ok {
+				return connect.NewError(connect.CodeUnavailable, fmt.Errorf("attendance stream fell behind, reconnect to resume"))
+			}
+			var att db.EventAttendance
+			if err := json.Unmarshal([]byte(payload), &att); err != nil {
+				logging.WithContext(ctx).Warn("Skipping malformed attendance notification", "error", err, "eventId", req.Msg.EventId)
+				continue
+			}
+			if err := stream.Send(&eventsv1.StreamEventAttendanceResponse{
+				Attendance: dbEventAttendanceToProto(att),
+			}); err != nil {
+				// A failed send usually means the client went away
+				if ctx.Err() != nil {
+					return nil
+				}
+				return connect.NewError(connect.CodeInternal, err)
+			}
+		}
+	}
+}
+
+// publishAttendance notifies the event's StreamEventAttendance listeners.
+// Failures are only logged, since the write itself succeeded.
+func (s *EventAttendanceService) publishAttendance(ctx context.Context, eventID int32, att db.EventAttendance) {
+	payload, err := json.Marshal(att)
+	if err != nil {
+		logging.WithContext(ctx).Warn("Failed to encode attendance notification", "error", err, "eventId", eventID)
+		return
+	}
+	if err := s.queries.NotifyAttendanceChange(ctx, db.NotifyAttendanceChangeParams{
+		Channel: db.AttendanceChannel(eventID),
+		Payload: string(payload),
+	}); err != nil {
+		logging.WithContext(ctx).Warn("Failed to notify attendance listeners", "error", err, "eventId", eventID)
+	}
+}
+
 func dbEventAttendanceToProto(a db.EventAttendance) *eventsv1.EventAttendance {
 	att := &eventsv1.EventAttendance{
 		Id:             a.ID,
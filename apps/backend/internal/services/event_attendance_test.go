@@ -0,0 +1,72 @@
+package services
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/studyverse/ems-backend/internal/db"
+)
+
+func TestPartitionCheckIns(t *testing.T) {
+	regsByID := map[int32]db.EventRegistration{
+		1: {ID: 1, EventID: 10, Status: db.RegistrationStatusRegistered},
+		2: {ID: 2, EventID: 10, Status: db.RegistrationStatusCancelled},
+		3: {ID: 3, EventID: 10, Status: db.RegistrationStatusWaitlist},
+		4: {ID: 4, EventID: 20, Status: db.RegistrationStatusRegistered},
+		5: {ID: 5, EventID: 10, Status: db.RegistrationStatusRegistered},
+	}
+	checks := map[int32]int{}
+	canCheckIn := func(eventID int32) bool {
+		checks[eventID]++
+		return eventID == 10
+	}
+
+	checkInIDs, failed := partitionCheckIns([]int32{5, 1, 2, 3, 4, 99, 1, 5}, regsByID, canCheckIn)
+
+	if want := []int32{5, 1}; !slices.Equal(checkInIDs, want) {
+		t.Errorf("check-ins = %v, want %v in request order without duplicates", checkInIDs, want)
+	}
+	wantFailed := map[int32]string{
+		2:  "registration is cancelled",
+		3:  "registration is waitlist",
+		4:  "permission denied",
+		99: "registration not found",
+	}
+	if len(failed) != len(wantFailed) {
+		t.Fatalf("got %d failures, want %d", len(failed), len(wantFailed))
+	}
+	for _, f := range failed {
+		if f.GetReason() != wantFailed[f.GetRegistrationId()] {
+			t.Errorf("registration %d failed with %q, want %q", f.GetRegistrationId(), f.GetReason(), wantFailed[f.GetRegistrationId()])
+		}
+	}
+	if checks[10] != 1 || checks[20] != 1 {
+		t.Errorf("permission checks per event = %v, want one each", checks)
+	}
+}
+
+// A full door scan: 500 registrations across a few events
+func TestPartitionCheckInsLargeScan(t *testing.T) {
+	const n = 500
+	regsByID := make(map[int32]db.EventRegistration, n)
+	ids := make([]int32, 0, n)
+	for id := int32(1); id <= n; id++ {
+		regsByID[id] = db.EventRegistration{ID: id, EventID: id % 3, Status: db.RegistrationStatusRegistered}
+		ids = append(ids, id)
+	}
+	var checks int
+	checkInIDs, failed := partitionCheckIns(ids, regsByID, func(int32) bool {
+		checks++
+		return true
+	})
+
+	if len(checkInIDs) != n || len(failed) != 0 {
+		t.Errorf("checked in %d with %d failures, want all %d", len(checkInIDs), len(failed), n)
+	}
+	if !slices.Equal(checkInIDs, ids) {
+		t.Error("check-ins not in request order")
+	}
+	if checks != 3 {
+		t.Errorf("permission checked %d times, want once per event", checks)
+	}
+}
@@ -0,0 +1,216 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
+	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/logging"
+	"github.com/studyverse/ems-backend/internal/perms"
+	"github.com/studyverse/ems-backend/internal/search"
+	"github.com/studyverse/ems-backend/internal/validation"
+)
+
+// BulkCreateEvents imports a batch of events for platform staff. The batch is
+// created in one transaction, so any invalid row rejects all of them.
+func (s *EventsService) BulkCreateEvents(ctx context.Context, req *connect.Request[eventsv1.BulkCreateEventsRequest]) (*connect.Response[eventsv1.BulkCreateEventsResponse], error) {
+	logging.WithContext(ctx).Debug("BulkCreateEvents", "count", len(req.Msg.Events))
+
+	kratosUserID := auth.GetUserID(ctx)
+	if kratosUserID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	if err := requirePlatformPermission(ctx, s.perms, kratosUserID, "manage_clubs", "import events"); err != nil {
+		return nil, err
+	}
+
+	email := auth.GetUserEmail(ctx)
+	if email == "" {
+		email = kratosUserID + "@placeholder.local"
+	}
+	username, _, _ := strings.Cut(email, "@")
+	creator, err := s.queries.CreateUserFromKratos(ctx, db.CreateUserFromKratosParams{
+		KratosID: pgtype.Text{String: kratosUserID, Valid: true},
+		Email:    email,
+		Username: username,
+	})
+	if err != nil {
+		logging.WithContext(ctx).Error("Failed to get/create local user", "error", err, "kratosId", kratosUserID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to sync user: %w", err))
+	}
+
+	items := req.Msg.Events
+
+	// Check the referenced organizations and tags up front, so a missing one
+	// is reported against its rows instead of as a foreign key error
+	orgCounts := make(map[int32]int64)
+	var tagIDs []int32
+	for _, item := range items {
+		orgCounts[item.OrganizationId]++
+		tagIDs = append(tagIDs, item.TagIds...)
+	}
+	orgIDs := make([]int32, 0, len(orgCounts))
+	for id := range orgCounts {
+		orgIDs = append(orgIDs, id)
+	}
+	// Lock organizations in a fixed order so concurrent imports can't deadlock
+	slices.Sort(orgIDs)
+
+	orgs, err := s.queries.GetOrganizationsMap(ctx, orgIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	tagRows, err := s.queries.GetTagsByIDs(ctx, tagIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	tags := make(map[int32]db.Tag, len(tagRows))
+	for _, t := range tagRows {
+		tags[t.ID] = t
+	}
+
+	var errs validation.Errors
+	for i, item := range items {
+		if _, ok := orgs[item.OrganizationId]; !ok {
+			errs.Add(fmt.Sprintf("events[%d].organization_id", i), "organization not found")
+		}
+		for _, tagID := range item.TagIds {
+			if _, ok := tags[tagID]; !ok {
+				errs.Add(fmt.Sprintf("events[%d].tag_ids", i), fmt.Sprintf("tag %d not found", tagID))
+			}
+		}
+	}
+	if err := errs.Err(); err != nil {
+		return nil, validation.InvalidArgument(err)
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to begin transaction: %w", err))
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := s.queries.WithTx(tx)
+
+	for _, orgID := range orgIDs {
+		if _, err := checkMonthlyEventQuota(ctx, qtx, orgID, orgCounts[orgID]); err != nil {
+			return nil, err
+		}
+	}
+
+	ids, err := qtx.AllocateEventIDs(ctx, int32(len(items)))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to allocate event IDs: %w", err))
+	}
+
+	eventRows := make([]db.BulkInsertEventsParams, len(items))
+	var eventTagRows []db.BulkInsertEventTagsParams
+	for i, item := range items {
+		startTime, _ := time.Parse(time.RFC3339, item.StartTime)
+		endTime, _ := time.Parse(time.RFC3339, item.EndTime)
+
+		row := db.BulkInsertEventsParams{
+			ID:             ids[i],
+			Title:          item.Title,
+			Description:    item.Description,
+			UserID:         creator.ID,
+			OrganizationID: item.OrganizationId,
+			Location:       item.Location,
+			StartTime:      pgtype.Timestamptz{Time: startTime, Valid: true},
+			EndTime:        pgtype.Timestamptz{Time: endTime, Valid: true},
+			Format:         db.NullFormat{Format: protoEventFormatToDB(item.Format), Valid: true},
+		}
+		if item.ImageUrl != nil {
+			row.ImageUrl = pgtype.Text{String: *item.ImageUrl, Valid: true}
+		}
+		if item.Capacity != nil {
+			row.Capacity = pgtype.Int4{Int32: *item.Capacity, Valid: true}
+		}
+		eventRows[i] = row
+
+		for _, tagID := range item.TagIds {
+			eventTagRows = append(eventTagRows, db.BulkInsertEventTagsParams{EventID: ids[i], TagID: tagID})
+		}
+	}
+
+	if _, err := qtx.BulkInsertEvents(ctx, eventRows); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to insert events: %w", err))
+	}
+	if len(eventTagRows) > 0 {
+		if _, err := qtx.BulkInsertEventTags(ctx, eventTagRows); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to add tags: %w", err))
+		}
+	}
+
+	// Read the rows back for the defaults the copy left to the database
+	created, err := qtx.GetEventsMap(ctx, ids)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to commit transaction: %w", err))
+	}
+
+	events := make([]db.Event, len(ids))
+	eventTags := make([][]db.Tag, len(ids))
+	protoEvents := make([]*eventsv1.Event, len(ids))
+	for i, id := range ids {
+		events[i] = created[id]
+		for _, tagID := range items[i].TagIds {
+			eventTags[i] = append(eventTags[i], tags[tagID])
+		}
+		protoEvents[i] = dbEventWithRelationsToProto(events[i], orgs[events[i].OrganizationID], eventTags[i])
+	}
+
+	s.setupBulkCreatedEvents(ctx, events, kratosUserID)
+	s.reindexTagCounts(ctx, tagIDs)
+
+	recordAudit(ctx, s.queries, req, "platform", perms.PlatformID)
+
+	return connect.NewResponse(&eventsv1.BulkCreateEventsResponse{
+		Events: protoEvents,
+	}), nil
+}
+
+// setupBulkCreatedEvents writes the SpiceDB relationships of the new events
+// and indexes them, each with one call (async, don't block response)
+func (s *EventsService) setupBulkCreatedEvents(ctx context.Context, events []db.Event, creatorID string) {
+	go func() {
+		if s.perms != nil {
+			relationships := make([]perms.Relationship, 0, 2*len(events))
+			for _, event := range events {
+				eventID := strconv.Itoa(int(event.ID))
+				relationships = append(relationships,
+					perms.Relationship{Resource: "event", ResourceID: eventID, Relation: "host_club", SubjectType: "club", SubjectID: strconv.Itoa(int(event.OrganizationID))},
+					perms.Relationship{Resource: "event", ResourceID: eventID, Relation: "creator", SubjectType: "user", SubjectID: creatorID},
+				)
+			}
+			if err := s.perms.WriteRelationships(context.Background(), relationships); err != nil {
+				logging.WithContext(ctx).Warn("Failed to setup event relationships in SpiceDB", "error", err, "count", len(events))
+			}
+		}
+
+		if s.search == nil {
+			return
+		}
+
+		docs, err := search.BuildEventDocuments(context.Background(), s.queries, events)
+		if err != nil {
+			logging.WithContext(ctx).Warn("Failed to build search documents", "error", err, "count", len(events))
+			return
+		}
+		if err := s.search.IndexEvents(context.Background(), docs); err != nil {
+			logging.WithContext(ctx).Warn("Failed to index events in search", "error", err, "count", len(docs))
+		}
+	}()
+}
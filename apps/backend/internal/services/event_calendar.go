@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
+	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/logging"
+)
+
+// GetEventCalendar returns the days of a month that have published events,
+// each with its event count and the first few events of the day
+func (s *EventsService) GetEventCalendar(ctx context.Context, req *connect.Request[eventsv1.GetEventCalendarRequest]) (*connect.Response[eventsv1.GetEventCalendarResponse], error) {
+	logging.WithContext(ctx).Debug("GetEventCalendar", "year", req.Msg.Year, "month", req.Msg.Month)
+
+	params := db.GetEventCalendarParams{
+		Year:  req.Msg.Year,
+		Month: req.Msg.Month,
+	}
+	if req.Msg.OrganizationId != nil {
+		params.OrganizationID = pgtype.Int4{Int32: *req.Msg.OrganizationId, Valid: true}
+	}
+
+	rows, err := s.queries.GetEventCalendar(ctx, params)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	days := make([]*eventsv1.CalendarDay, len(rows))
+	for i, row := range rows {
+		events := make([]*eventsv1.EventSummary, len(row.EventIds))
+		for j, id := range row.EventIds {
+			events[j] = &eventsv1.EventSummary{
+				Id:             id,
+				Title:          row.EventTitles[j],
+				StartTime:      row.EventStartTimes[j].Time.Format(time.RFC3339),
+				Format:         dbEventFormatToProto(db.Format(row.EventFormats[j])),
+				OrganizationId: row.EventOrganizationIds[j],
+			}
+		}
+		days[i] = &eventsv1.CalendarDay{
+			Date:       row.Day.Time.Format(time.DateOnly),
+			EventCount: row.EventCount,
+			Events:     events,
+		}
+	}
+
+	return connect.NewResponse(&eventsv1.GetEventCalendarResponse{Days: days}), nil
+}
@@ -0,0 +1,129 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
+	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/dberrors"
+	"github.com/studyverse/ems-backend/internal/logging"
+)
+
+// DuplicateEvent creates a copy of an event at new times, for recurring
+// events. The caller becomes the creator of the copy.
+func (s *EventsService) DuplicateEvent(ctx context.Context, req *connect.Request[eventsv1.DuplicateEventRequest]) (*connect.Response[eventsv1.DuplicateEventResponse], error) {
+	logging.WithContext(ctx).Debug("DuplicateEvent", "sourceEventId", req.Msg.SourceEventId)
+
+	kratosUserID := auth.GetUserID(ctx)
+	if kratosUserID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	source, err := s.queries.GetEvent(ctx, req.Msg.SourceEventId)
+	if err != nil {
+		return nil, dberrors.MapNotFound(err, "event not found")
+	}
+
+	// The copy is a new event of the same club, so it needs create_event there
+	if s.perms != nil {
+		clubID := strconv.Itoa(int(source.OrganizationID))
+		allowed, err := s.perms.CheckPermission(ctx, kratosUserID, "club", clubID, "create_event")
+		if err != nil {
+			logging.WithContext(ctx).Warn("Permission check failed", "error", err)
+		}
+		if !allowed {
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("you don't have permission to create events for this organization"))
+		}
+	}
+
+	email := auth.GetUserEmail(ctx)
+	if email == "" {
+		email = kratosUserID + "@placeholder.local"
+	}
+	username, _, _ := strings.Cut(email, "@")
+	creator, err := s.queries.CreateUserFromKratos(ctx, db.CreateUserFromKratosParams{
+		KratosID: pgtype.Text{String: kratosUserID, Valid: true},
+		Email:    email,
+		Username: username,
+	})
+	if err != nil {
+		logging.WithContext(ctx).Error("Failed to get/create local user", "error", err, "kratosId", kratosUserID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to sync user: %w", err))
+	}
+
+	tags, err := s.queries.GetEventTags(ctx, source.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	tagIDs := make([]int32, len(tags))
+	for i, t := range tags {
+		tagIDs[i] = t.ID
+	}
+
+	startTime, _ := time.Parse(time.RFC3339, req.Msg.NewStartTime)
+	endTime, _ := time.Parse(time.RFC3339, req.Msg.NewEndTime)
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to begin transaction: %w", err))
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := s.queries.WithTx(tx)
+
+	org, err := checkMonthlyEventQuota(ctx, qtx, source.OrganizationID, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := qtx.CreateEvent(ctx, db.CreateEventParams{
+		Title:          source.Title,
+		Description:    source.Description,
+		ImageUrl:       source.ImageUrl,
+		UserID:         creator.ID,
+		OrganizationID: source.OrganizationID,
+		Location:       source.Location,
+		StartTime:      pgtype.Timestamptz{Time: startTime, Valid: true},
+		EndTime:        pgtype.Timestamptz{Time: endTime, Valid: true},
+		Format:         source.Format,
+		Capacity:       source.Capacity,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if err := qtx.AddEventTags(ctx, db.AddEventTagsParams{
+		EventID: event.ID,
+		TagIds:  tagIDs,
+	}); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to add tags: %w", err))
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to commit transaction: %w", err))
+	}
+
+	if s.perms != nil {
+		eventID := strconv.Itoa(int(event.ID))
+		clubID := strconv.Itoa(int(event.OrganizationID))
+		if err := s.perms.SetupEventRelationship(ctx, eventID, clubID, kratosUserID); err != nil {
+			logging.WithContext(ctx).Warn("Failed to setup event relationship in SpiceDB", "error", err, "eventId", eventID)
+		}
+	}
+
+	s.reindexEvent(ctx, event)
+	s.reindexTagCounts(ctx, tagIDs)
+
+	recordAudit(ctx, s.queries, req, "event", event.ID)
+
+	return connect.NewResponse(&eventsv1.DuplicateEventResponse{
+		Event: dbEventWithRelationsToProto(event, org, tags),
+	}), nil
+}
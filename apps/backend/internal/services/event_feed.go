@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
+	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/dberrors"
+	"github.com/studyverse/ems-backend/internal/logging"
+)
+
+const maxEventFeedLimit = 50
+
+// feedCursor is the keyset position after the last event of a page. AsOf pins
+// the time scores are computed at, otherwise the upcoming bonus would shift
+// between pages and events could be skipped or repeated.
+type feedCursor struct {
+	LastEventID int32     `json:"lastEventId"`
+	LastScore   float64   `json:"lastScore"`
+	AsOf        time.Time `json:"asOf"`
+}
+
+func (c feedCursor) encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeFeedCursor(s string) (feedCursor, error) {
+	var c feedCursor
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, err
+	}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return c, err
+	}
+	return c, nil
+}
+
+// GetEventFeed returns the caller's home feed: events of their clubs, then
+// upcoming events matching the tags they registered for most in the last 90
+// days, best score first
+func (s *EventsService) GetEventFeed(ctx context.Context, req *connect.Request[eventsv1.GetEventFeedRequest]) (*connect.Response[eventsv1.GetEventFeedResponse], error) {
+	logging.WithContext(ctx).Debug("GetEventFeed", "limit", req.Msg.Limit)
+
+	kratosID := auth.GetUserID(ctx)
+	if kratosID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	user, err := s.queries.GetUserByKratosID(ctx, pgtype.Text{String: kratosID, Valid: true})
+	if err != nil {
+		return nil, dberrors.MapNotFound(err, "user not found")
+	}
+
+	limit := req.Msg.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+	limit = min(limit, maxEventFeedLimit)
+
+	params := db.GetEventFeedParams{
+		UserID: user.ID,
+		AsOf:   pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		// One extra row tells whether there is a next page
+		Limit: limit + 1,
+	}
+	if req.Msg.Cursor != nil && *req.Msg.Cursor != "" {
+		cursor, err := decodeFeedCursor(*req.Msg.Cursor)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid cursor"))
+		}
+		params.AsOf = pgtype.Timestamptz{Time: cursor.AsOf, Valid: true}
+		params.CursorScore = pgtype.Float8{Float64: cursor.LastScore, Valid: true}
+		params.CursorID = pgtype.Int4{Int32: cursor.LastEventID, Valid: true}
+	}
+
+	rows, err := s.queries.GetEventFeed(ctx, params)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	var nextCursor *string
+	if len(rows) > int(limit) {
+		rows = rows[:limit]
+		last := rows[len(rows)-1]
+		cursor := feedCursor{LastEventID: last.Event.ID, LastScore: last.Score, AsOf: params.AsOf.Time}.encode()
+		nextCursor = &cursor
+	}
+
+	orgIDs := make([]int32, len(rows))
+	eventIDs := make([]int32, len(rows))
+	for i, row := range rows {
+		orgIDs[i] = row.Event.OrganizationID
+		eventIDs[i] = row.Event.ID
+	}
+	orgs, err := s.queries.GetOrganizationsMap(ctx, orgIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	tags, err := s.queries.GetEventTagsMap(ctx, eventIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	feed := make([]*eventsv1.FeedEvent, len(rows))
+	for i, row := range rows {
+		feed[i] = &eventsv1.FeedEvent{
+			Event:  dbEventWithRelationsToProto(row.Event, orgs[row.Event.OrganizationID], tags[row.Event.ID]),
+			Source: row.Source,
+			Score:  row.Score,
+		}
+	}
+
+	return connect.NewResponse(&eventsv1.GetEventFeedResponse{
+		Events:     feed,
+		NextCursor: nextCursor,
+	}), nil
+}
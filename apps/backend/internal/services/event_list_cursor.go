@@ -0,0 +1,87 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/studyverse/ems-backend/internal/db"
+)
+
+// eventListCursor is the (start_time, id) keyset position after the last
+// event of a ListEvents page
+type eventListCursor struct {
+	StartTime time.Time `json:"startTime"`
+	ID        int32     `json:"id"`
+}
+
+func (c eventListCursor) encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeEventListCursor(s string) (eventListCursor, error) {
+	var c eventListCursor
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, err
+	}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return c, err
+	}
+	return c, nil
+}
+
+// listEvents pages by params.Offset when cursor is nil, otherwise by
+// (start_time, id) after the cursor, where an empty cursor is the first page.
+// The next cursor is only returned in cursor mode when more events follow.
+// Errors are connect errors.
+func (s *EventsService) listEvents(ctx context.Context, params db.ListEventsParams, cursor *string) ([]db.ListEventsRow, *string, error) {
+	if cursor == nil {
+		rows, err := s.queries.ListEvents(ctx, params)
+		if err != nil {
+			return nil, nil, connect.NewError(connect.CodeInternal, err)
+		}
+		return rows, nil, nil
+	}
+
+	cursorParams := db.ListEventsByCursorParams{
+		UserID:             params.UserID,
+		OrganizationID:     params.OrganizationID,
+		TagIds:             params.TagIds,
+		IncludeUnpublished: params.IncludeUnpublished,
+		// One extra row tells whether there is a next page
+		Limit: params.Limit + 1,
+	}
+	if *cursor != "" {
+		c, err := decodeEventListCursor(*cursor)
+		if err != nil {
+			return nil, nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid cursor"))
+		}
+		cursorParams.CursorStartTime = pgtype.Timestamptz{Time: c.StartTime, Valid: true}
+		cursorParams.CursorID = pgtype.Int4{Int32: c.ID, Valid: true}
+	}
+
+	cursorRows, err := s.queries.ListEventsByCursor(ctx, cursorParams)
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	var nextCursor *string
+	if len(cursorRows) > int(params.Limit) {
+		cursorRows = cursorRows[:params.Limit]
+		last := cursorRows[len(cursorRows)-1].Event
+		next := eventListCursor{StartTime: last.StartTime.Time, ID: last.ID}.encode()
+		nextCursor = &next
+	}
+
+	rows := make([]db.ListEventsRow, len(cursorRows))
+	for i, r := range cursorRows {
+		rows[i] = db.ListEventsRow(r)
+	}
+	return rows, nextCursor, nil
+}
@@ -0,0 +1,36 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"connectrpc.com/connect"
+	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/dberrors"
+	"github.com/studyverse/ems-backend/internal/metrics"
+)
+
+// checkMonthlyEventQuota locks the organization and fails with
+// ResourceExhausted if adding more events this month would take it over its
+// quota. qtx must be the transaction that creates the events, so concurrent
+// creations can't both slip under the quota. Returns the locked organization.
+func checkMonthlyEventQuota(ctx context.Context, qtx *db.Queries, orgID int32, adding int64) (db.Organization, error) {
+	org, err := qtx.LockOrganization(ctx, orgID)
+	if err != nil {
+		return db.Organization{}, dberrors.MapNotFound(err, fmt.Sprintf("organization %d not found", orgID))
+	}
+	if !org.MonthlyEventQuota.Valid {
+		return org, nil
+	}
+
+	used, err := qtx.CountOrganizationEventsThisMonth(ctx, org.ID)
+	if err != nil {
+		return db.Organization{}, connect.NewError(connect.CodeInternal, err)
+	}
+	if used+adding > int64(org.MonthlyEventQuota.Int32) {
+		metrics.QuotaExceededTotal.WithLabelValues(strconv.Itoa(int(org.ID))).Inc()
+		return db.Organization{}, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("monthly event quota of %d for organization %d exceeded", org.MonthlyEventQuota.Int32, org.ID))
+	}
+	return org, nil
+}
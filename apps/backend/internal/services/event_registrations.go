@@ -2,91 +2,153 @@ package services
 
 import (
 	"context"
-	"log/slog"
+	"errors"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
 	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
 	"github.com/studyverse/ems-backend/gen/eventsv1/eventsv1connect"
+	"github.com/studyverse/ems-backend/internal/config"
 	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/dberrors"
+	"github.com/studyverse/ems-backend/internal/logging"
+	"github.com/studyverse/ems-backend/internal/notify"
+	"github.com/studyverse/ems-backend/internal/perms"
+	"github.com/studyverse/ems-backend/internal/search"
 )
 
 type EventRegistrationsService struct {
 	eventsv1connect.UnimplementedEventRegistrationsServiceHandler
-	queries *db.Queries
+	queries     *db.Queries
+	pool        *pgxpool.Pool
+	perms       *perms.Client
+	search      *search.Client
+	cancelLinks *CancelLinkSigner
+	notifier    *notify.Client
+	cfg         *config.Config
 }
 
-func NewEventRegistrationsService(queries *db.Queries) *EventRegistrationsService {
-	return &EventRegistrationsService{queries: queries}
+func NewEventRegistrationsService(queries *db.Queries, pool *pgxpool.Pool, permsClient *perms.Client, searchClient *search.Client, cancelLinks *CancelLinkSigner, notifier *notify.Client, cfg *config.Config) *EventRegistrationsService {
+	return &EventRegistrationsService{queries: queries, pool: pool, perms: permsClient, search: searchClient, cancelLinks: cancelLinks, notifier: notifier, cfg: cfg}
 }
 
 func (s *EventRegistrationsService) RegisterForEvent(ctx context.Context, req *connect.Request[eventsv1.RegisterForEventRequest]) (*connect.Response[eventsv1.RegisterForEventResponse], error) {
-	slog.Debug("RegisterForEvent", "eventId", req.Msg.EventId, "userId", req.Msg.UserId)
+	logging.WithContext(ctx).Debug("RegisterForEvent", "eventId", req.Msg.EventId, "userId", req.Msg.UserId)
 
-	// Check if event exists
-	_, err := s.queries.GetEvent(ctx, req.Msg.EventId)
+	tx, err := s.pool.Begin(ctx)
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return nil, connect.NewError(connect.CodeNotFound, nil)
-		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := s.queries.WithTx(tx)
 
-	// Check if already registered
-	existing, err := s.queries.GetEventRegistrationByEventAndUser(ctx, db.GetEventRegistrationByEventAndUserParams{
-		EventID: req.Msg.EventId,
-		UserID:  req.Msg.UserId,
-	})
-	if err != nil && err != pgx.ErrNoRows {
+	// Check if event exists, locking it so concurrent registrations can't overbook
+	_, err = qtx.LockEventForRegistration(ctx, req.Msg.EventId)
+	if err != nil {
+		return nil, dberrors.Map(err)
+	}
+
+	available, err := qtx.CountAvailableSlots(ctx, req.Msg.EventId)
+	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
-	if err == nil {
-		// Found existing registration - check if cancelled
-		// If cancelled, we could allow re-registration, but for now just return duplicate error
-		if existing.Status == db.RegistrationStatusCancelled {
-			// TODO: Consider allowing re-registration by updating existing record
-			slog.Debug("Found cancelled registration, returning duplicate error", "event_id", req.Msg.EventId, "user_id", req.Msg.UserId)
-		}
-		return nil, connect.NewError(connect.CodeAlreadyExists, nil)
+
+	// A full event puts the user on the waitlist, CancelRegistration promotes from it
+	status := db.RegistrationStatusRegistered
+	if available == 0 {
+		status = db.RegistrationStatusWaitlist
 	}
 
-	reg, err := s.queries.CreateEventRegistration(ctx, db.CreateEventRegistrationParams{
+	// A cancelled registration is revived in place, an active one is a duplicate
+	reg, err := qtx.UpsertEventRegistration(ctx, db.UpsertEventRegistrationParams{
 		EventID: req.Msg.EventId,
 		UserID:  req.Msg.UserId,
+		Status:  status,
 	})
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, nil)
+		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	return connect.NewResponse(&eventsv1.RegisterForEventResponse{
+	var cancelURL string
+	if reg.Status == db.RegistrationStatusRegistered {
+		cancelURL, err = s.issueCancelLink(ctx, qtx, reg)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	reindexEventCounts(ctx, s.queries, s.search, reg.EventID)
+
+	resp := &eventsv1.RegisterForEventResponse{
 		Registration: dbEventRegistrationToProto(reg),
-	}), nil
+	}
+	if cancelURL != "" {
+		resp.CancelUrl = &cancelURL
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *EventRegistrationsService) CancelRegistration(ctx context.Context, req *connect.Request[eventsv1.CancelRegistrationRequest]) (*connect.Response[eventsv1.CancelRegistrationResponse], error) {
-	slog.Debug("CancelRegistration", "registrationId", req.Msg.RegistrationId)
+	logging.WithContext(ctx).Debug("CancelRegistration", "registrationId", req.Msg.RegistrationId)
 
-	_, err := s.queries.GetEventRegistration(ctx, req.Msg.RegistrationId)
+	tx, err := s.pool.Begin(ctx)
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return nil, connect.NewError(connect.CodeNotFound, nil)
-		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := s.queries.WithTx(tx)
 
-	err = s.queries.CancelEventRegistration(ctx, req.Msg.RegistrationId)
+	// Only the event ID is used from this read, the status may be stale
+	reg, err := qtx.GetEventRegistration(ctx, req.Msg.RegistrationId)
 	if err != nil {
+		return nil, dberrors.Map(err)
+	}
+
+	// Lock the event so the freed seat can't be taken twice, then re-read the
+	// registration under it so concurrent cancels see each other
+	if _, err := qtx.LockEventForRegistration(ctx, reg.EventID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	reg, err = qtx.GetEventRegistrationForUpdate(ctx, req.Msg.RegistrationId)
+	if err != nil {
+		return nil, dberrors.Map(err)
+	}
+
+	cancelled, err := qtx.CancelEventRegistration(ctx, req.Msg.RegistrationId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Only a seat this transaction freed goes to the waitlist
+	if cancelled == 1 && reg.Status == db.RegistrationStatusRegistered {
+		if err := s.promoteFromWaitlist(ctx, qtx, reg.EventID); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	reindexEventCounts(ctx, s.queries, s.search, reg.EventID)
+
 	return connect.NewResponse(&eventsv1.CancelRegistrationResponse{
 		Success: true,
 	}), nil
 }
 
 func (s *EventRegistrationsService) GetEventRegistrations(ctx context.Context, req *connect.Request[eventsv1.GetEventRegistrationsRequest]) (*connect.Response[eventsv1.GetEventRegistrationsResponse], error) {
-	slog.Debug("GetEventRegistrations", "eventId", req.Msg.EventId)
+	logging.WithContext(ctx).Debug("GetEventRegistrations", "eventId", req.Msg.EventId)
 
 	page := int32(1)
 	if req.Msg.Page != nil {
@@ -96,6 +158,7 @@ func (s *EventRegistrationsService) GetEventRegistrations(ctx context.Context, r
 	if req.Msg.Limit != nil {
 		limit = *req.Msg.Limit
 	}
+	limit = min(limit, int32(s.cfg.MaxPageSize))
 
 	regs, err := s.queries.GetEventRegistrations(ctx, db.GetEventRegistrationsParams{
 		EventID: req.Msg.EventId,
@@ -123,9 +186,35 @@ func (s *EventRegistrationsService) GetEventRegistrations(ctx context.Context, r
 }
 
 func (s *EventRegistrationsService) GetUserRegistrations(ctx context.Context, req *connect.Request[eventsv1.GetUserRegistrationsRequest]) (*connect.Response[eventsv1.GetUserRegistrationsResponse], error) {
-	slog.Debug("GetUserRegistrations", "userId", req.Msg.UserId)
+	logging.WithContext(ctx).Debug("GetUserRegistrations", "userId", req.Msg.UserId, "page", req.Msg.Page, "limit", req.Msg.Limit, "includeEvent", req.Msg.IncludeEvent)
+
+	// Without a limit every registration is returned, as before pagination
+	var limit pgtype.Int4
+	var offset int32
+	if req.Msg.Limit > 0 {
+		limit = pgtype.Int4{Int32: min(req.Msg.Limit, int32(s.cfg.MaxPageSize)), Valid: true}
+		offset = (max(req.Msg.Page, 1) - 1) * limit.Int32
+	}
 
-	regs, err := s.queries.GetUserRegistrations(ctx, req.Msg.UserId)
+	var statusFilter db.NullRegistrationStatus
+	if req.Msg.Status != nil {
+		statusFilter = db.NullRegistrationStatus{RegistrationStatus: protoRegistrationStatusToDB(*req.Msg.Status), Valid: true}
+	}
+
+	regs, err := s.queries.GetUserRegistrations(ctx, db.GetUserRegistrationsParams{
+		UserID:       req.Msg.UserId,
+		StatusFilter: statusFilter,
+		Limit:        limit,
+		Offset:       offset,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	total, err := s.queries.CountUserRegistrations(ctx, db.CountUserRegistrationsParams{
+		UserID:       req.Msg.UserId,
+		StatusFilter: statusFilter,
+	})
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
@@ -135,11 +224,100 @@ func (s *EventRegistrationsService) GetUserRegistrations(ctx context.Context, re
 		protoRegs[i] = dbEventRegistrationToProto(r)
 	}
 
+	if req.Msg.IncludeEvent && len(regs) > 0 {
+		eventIDs := make([]int32, len(regs))
+		for i, r := range regs {
+			eventIDs[i] = r.EventID
+		}
+		events, err := s.loadEventsWithRelations(ctx, eventIDs)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		for i, r := range regs {
+			protoRegs[i].Event = events[r.EventID]
+		}
+	}
+
 	return connect.NewResponse(&eventsv1.GetUserRegistrationsResponse{
 		Registrations: protoRegs,
+		Total:         int32(total),
 	}), nil
 }
 
+// promoteFromWaitlist gives a free seat to the longest-waiting registration.
+// Callers must hold the LockEventForRegistration lock.
+func (s *EventRegistrationsService) promoteFromWaitlist(ctx context.Context, q *db.Queries, eventID int32) error {
+	available, err := q.CountAvailableSlots(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	if available == 0 {
+		return nil
+	}
+
+	promoted, err := q.PromoteNextWaitlistRegistration(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	logging.WithContext(ctx).Info("Promoted registration from waitlist", "registrationId", promoted.ID, "eventId", eventID, "userId", promoted.UserID)
+	return nil
+}
+
+// reindexEventCounts refreshes the registration and attendance counts, and
+// the attendance rate, of an event in Meilisearch (async, don't block response)
+func reindexEventCounts(ctx context.Context, queries *db.Queries, searchClient *search.Client, eventID int32) {
+	if searchClient == nil {
+		return
+	}
+	go func() {
+		counts, err := queries.GetEventCounts(context.Background(), eventID)
+		if err != nil {
+			logging.WithContext(ctx).Warn("Failed to count event registrations for search", "error", err, "eventId", eventID)
+			return
+		}
+		// Unpublished events have no document, updating would create a stub
+		if !counts.Published {
+			return
+		}
+		if err := searchClient.UpdateEventCounts(context.Background(), eventID, counts.RegistrationCount, counts.AttendanceCount); err != nil {
+			logging.WithContext(ctx).Warn("Failed to update event counts in search", "error", err, "eventId", eventID)
+		}
+	}()
+}
+
+// loadEventsWithRelations fetches events with their organizations and tags
+// in three queries regardless of how many IDs are requested
+func (s *EventRegistrationsService) loadEventsWithRelations(ctx context.Context, ids []int32) (map[int32]*eventsv1.Event, error) {
+	events, err := s.queries.GetEventsMap(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	orgIDs := make([]int32, 0, len(events))
+	for _, e := range events {
+		orgIDs = append(orgIDs, e.OrganizationID)
+	}
+	orgs, err := s.queries.GetOrganizationsMap(ctx, orgIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.queries.GetEventTagsMap(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[int32]*eventsv1.Event, len(events))
+	for id, e := range events {
+		m[id] = dbEventWithRelationsToProto(e, orgs[e.OrganizationID], tags[id])
+	}
+	return m, nil
+}
+
 func dbEventRegistrationToProto(r db.EventRegistration) *eventsv1.EventRegistration {
 	reg := &eventsv1.EventRegistration{
 		Id:           r.ID,
@@ -169,3 +347,14 @@ func dbRegistrationStatusToProto(status db.RegistrationStatus) eventsv1.Registra
 		return eventsv1.RegistrationStatus_REGISTRATION_STATUS_UNSPECIFIED
 	}
 }
+
+func protoRegistrationStatusToDB(status eventsv1.RegistrationStatus) db.RegistrationStatus {
+	switch status {
+	case eventsv1.RegistrationStatus_REGISTRATION_STATUS_CANCELLED:
+		return db.RegistrationStatusCancelled
+	case eventsv1.RegistrationStatus_REGISTRATION_STATUS_WAITLIST:
+		return db.RegistrationStatusWaitlist
+	default:
+		return db.RegistrationStatusRegistered
+	}
+}
@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
+	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/dberrors"
+	"github.com/studyverse/ems-backend/internal/logging"
+)
+
+// RestoreEvent undoes a soft delete and puts the event back into search
+func (s *EventsService) RestoreEvent(ctx context.Context, req *connect.Request[eventsv1.RestoreEventRequest]) (*connect.Response[eventsv1.RestoreEventResponse], error) {
+	logging.WithContext(ctx).Debug("RestoreEvent", "id", req.Msg.Id)
+
+	if err := s.requireSystemAdmin(ctx, "restore events"); err != nil {
+		return nil, err
+	}
+
+	event, err := s.queries.RestoreEvent(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, dberrors.MapNotFound(err, "deleted event not found")
+	}
+
+	org, _ := s.queries.GetOrganization(ctx, event.OrganizationID)
+	tags, _ := s.queries.GetEventTags(ctx, event.ID)
+
+	// Unpublished events stay out of search until they are published again
+	if event.Published {
+		s.reindexEvent(ctx, event)
+	}
+
+	tagIDs := make([]int32, len(tags))
+	for i, t := range tags {
+		tagIDs[i] = t.ID
+	}
+	s.reindexTagCounts(ctx, tagIDs)
+
+	recordAudit(ctx, s.queries, req, "event", event.ID)
+
+	return connect.NewResponse(&eventsv1.RestoreEventResponse{
+		Event: dbEventWithRelationsToProto(event, org, tags),
+	}), nil
+}
+
+// ListDeletedEvents lists soft-deleted events for auditing
+func (s *EventsService) ListDeletedEvents(ctx context.Context, req *connect.Request[eventsv1.ListDeletedEventsRequest]) (*connect.Response[eventsv1.ListDeletedEventsResponse], error) {
+	logging.WithContext(ctx).Debug("ListDeletedEvents", "page", req.Msg.Page, "limit", req.Msg.Limit)
+
+	if err := s.requireSystemAdmin(ctx, "view deleted events"); err != nil {
+		return nil, err
+	}
+
+	page := req.Msg.Page
+	if page <= 0 {
+		page = 1
+	}
+	limit := req.Msg.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+	limit = min(limit, int32(s.cfg.MaxPageSize))
+
+	events, err := s.queries.ListDeletedEvents(ctx, db.ListDeletedEventsParams{
+		Limit:  limit,
+		Offset: (page - 1) * limit,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	orgIDs := make([]int32, len(events))
+	eventIDs := make([]int32, len(events))
+	for i, e := range events {
+		orgIDs[i] = e.OrganizationID
+		eventIDs[i] = e.ID
+	}
+	orgs, err := s.queries.GetOrganizationsMap(ctx, orgIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	tags, err := s.queries.GetEventTagsMap(ctx, eventIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoEvents := make([]*eventsv1.Event, len(events))
+	for i, e := range events {
+		protoEvents[i] = dbEventWithRelationsToProto(e, orgs[e.OrganizationID], tags[e.ID])
+	}
+
+	total, err := s.queries.CountDeletedEvents(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&eventsv1.ListDeletedEventsResponse{
+		Events: protoEvents,
+		Total:  int32(total),
+	}), nil
+}
+
+// requireSystemAdmin checks that the caller has manage_system on the platform
+func (s *EventsService) requireSystemAdmin(ctx context.Context, action string) error {
+	userID := auth.GetUserID(ctx)
+	if userID == "" {
+		return connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	return requirePlatformPermission(ctx, s.perms, userID, "manage_system", action)
+}
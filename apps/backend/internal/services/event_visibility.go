@@ -0,0 +1,64 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
+	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/dberrors"
+	"github.com/studyverse/ems-backend/internal/logging"
+	"github.com/studyverse/ems-backend/internal/search"
+)
+
+// ToggleEventVisibility publishes or unpublishes an event. Unpublished events
+// keep their registrations but disappear from public listings and search.
+func (s *EventsService) ToggleEventVisibility(ctx context.Context, req *connect.Request[eventsv1.ToggleEventVisibilityRequest]) (*connect.Response[eventsv1.ToggleEventVisibilityResponse], error) {
+	logging.WithContext(ctx).Debug("ToggleEventVisibility", "eventId", req.Msg.EventId, "published", req.Msg.Published)
+
+	userID := auth.GetUserID(ctx)
+	if userID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	if s.perms != nil {
+		eventID := fmt.Sprintf("%d", req.Msg.EventId)
+		allowed, err := s.perms.CheckPermission(ctx, userID, "event", eventID, "edit")
+		if err != nil {
+			logging.WithContext(ctx).Warn("Permission check failed", "error", err)
+		}
+		if !allowed {
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("you don't have permission to edit this event"))
+		}
+	}
+
+	event, err := s.queries.SetEventPublished(ctx, db.SetEventPublishedParams{
+		ID:        req.Msg.EventId,
+		Published: req.Msg.Published,
+	})
+	if err != nil {
+		return nil, dberrors.Map(err)
+	}
+
+	org, _ := s.queries.GetOrganization(ctx, event.OrganizationID)
+	tags, _ := s.queries.GetEventTags(ctx, event.ID)
+
+	if event.Published {
+		s.reindexEvent(ctx, event)
+	} else if s.search != nil {
+		// Remove event from Meilisearch (async, don't block response)
+		go func() {
+			if err := s.search.DeleteDocument(context.Background(), search.IndexEvents, event.ID); err != nil {
+				logging.WithContext(ctx).Warn("Failed to remove unpublished event from search", "error", err, "eventId", event.ID)
+			}
+		}()
+	}
+
+	recordAudit(ctx, s.queries, req, "event", event.ID)
+
+	return connect.NewResponse(&eventsv1.ToggleEventVisibilityResponse{
+		Event: dbEventWithRelationsToProto(event, org, tags),
+	}), nil
+}
@@ -2,8 +2,8 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log/slog"
 	"time"
 
 	"connectrpc.com/connect"
@@ -13,7 +13,10 @@ import (
 	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
 	"github.com/studyverse/ems-backend/gen/eventsv1/eventsv1connect"
 	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/config"
 	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/dberrors"
+	"github.com/studyverse/ems-backend/internal/logging"
 	"github.com/studyverse/ems-backend/internal/perms"
 	"github.com/studyverse/ems-backend/internal/search"
 )
@@ -24,14 +27,15 @@ type EventsService struct {
 	pool    *pgxpool.Pool
 	perms   *perms.Client
 	search  *search.Client
+	cfg     *config.Config
 }
 
-func NewEventsService(queries *db.Queries, pool *pgxpool.Pool, permsClient *perms.Client, searchClient *search.Client) *EventsService {
-	return &EventsService{queries: queries, pool: pool, perms: permsClient, search: searchClient}
+func NewEventsService(queries *db.Queries, pool *pgxpool.Pool, permsClient *perms.Client, searchClient *search.Client, cfg *config.Config) *EventsService {
+	return &EventsService{queries: queries, pool: pool, perms: permsClient, search: searchClient, cfg: cfg}
 }
 
 func (s *EventsService) CreateEvent(ctx context.Context, req *connect.Request[eventsv1.CreateEventRequest]) (*connect.Response[eventsv1.CreateEventResponse], error) {
-	slog.Debug("CreateEvent", "title", req.Msg.Title)
+	logging.WithContext(ctx).Debug("CreateEvent", "title", req.Msg.Title)
 
 	// Authorization: Check if user can create events for this organization
 	kratosUserID := auth.GetUserID(ctx)
@@ -41,7 +45,7 @@ func (s *EventsService) CreateEvent(ctx context.Context, req *connect.Request[ev
 	if kratosUserID == "" {
 		// No authenticated user - use system user for batch imports (dev mode)
 		// In production, this should require authentication
-		slog.Warn("CreateEvent called without authentication - using system user")
+		logging.WithContext(ctx).Warn("CreateEvent called without authentication - using system user")
 
 		// Get or create a system user for imports
 		systemUser, err := s.queries.CreateUserFromKratos(ctx, db.CreateUserFromKratosParams{
@@ -50,7 +54,7 @@ func (s *EventsService) CreateEvent(ctx context.Context, req *connect.Request[ev
 			Username: "system",
 		})
 		if err != nil {
-			slog.Error("Failed to get/create system user", "error", err)
+			logging.WithContext(ctx).Error("Failed to get/create system user", "error", err)
 			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create system user: %w", err))
 		}
 		localUserID = systemUser.ID
@@ -78,7 +82,7 @@ func (s *EventsService) CreateEvent(ctx context.Context, req *connect.Request[ev
 			Username: username,
 		})
 		if err != nil {
-			slog.Error("Failed to get/create local user", "error", err, "kratosId", kratosUserID)
+			logging.WithContext(ctx).Error("Failed to get/create local user", "error", err, "kratosId", kratosUserID)
 			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to sync user: %w", err))
 		}
 		localUserID = localUser.ID
@@ -89,17 +93,14 @@ func (s *EventsService) CreateEvent(ctx context.Context, req *connect.Request[ev
 		clubID := fmt.Sprintf("%d", req.Msg.OrganizationId)
 		allowed, err := s.perms.CheckPermission(ctx, kratosUserID, "club", clubID, "create_event")
 		if err != nil {
-			slog.Warn("Permission check failed", "error", err)
+			logging.WithContext(ctx).Warn("Permission check failed", "error", err)
 		}
 		if !allowed {
 			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("you don't have permission to create events for this organization"))
 		}
 	}
 
-	format := db.NullFormat{Format: db.FormatOffline, Valid: true}
-	if req.Msg.Format == eventsv1.EventFormat_EVENT_FORMAT_ONLINE {
-		format = db.NullFormat{Format: db.FormatOnline, Valid: true}
-	}
+	format := db.NullFormat{Format: protoEventFormatToDB(req.Msg.Format), Valid: true}
 
 	startTime, _ := time.Parse(time.RFC3339, req.Msg.StartTime)
 	endTime, _ := time.Parse(time.RFC3339, req.Msg.EndTime)
@@ -127,6 +128,16 @@ func (s *EventsService) CreateEvent(ctx context.Context, req *connect.Request[ev
 	if req.Msg.ImageUrl != nil {
 		createParams.ImageUrl = pgtype.Text{String: *req.Msg.ImageUrl, Valid: true}
 	}
+	if req.Msg.Capacity != nil {
+		if *req.Msg.Capacity < 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("capacity must not be negative"))
+		}
+		createParams.Capacity = pgtype.Int4{Int32: *req.Msg.Capacity, Valid: true}
+	}
+
+	if _, err := checkMonthlyEventQuota(ctx, qtx, req.Msg.OrganizationId, 1); err != nil {
+		return nil, err
+	}
 
 	event, err := qtx.CreateEvent(ctx, createParams)
 	if err != nil {
@@ -134,13 +145,11 @@ func (s *EventsService) CreateEvent(ctx context.Context, req *connect.Request[ev
 	}
 
 	// Add tags
-	for _, tagID := range req.Msg.TagIds {
-		if err := qtx.AddEventTag(ctx, db.AddEventTagParams{
-			EventID: event.ID,
-			TagID:   tagID,
-		}); err != nil {
-			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to add tag: %w", err))
-		}
+	if err := qtx.AddEventTags(ctx, db.AddEventTagsParams{
+		EventID: event.ID,
+		TagIds:  req.Msg.TagIds,
+	}); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to add tags: %w", err))
 	}
 
 	if err := tx.Commit(ctx); err != nil {
@@ -152,7 +161,7 @@ func (s *EventsService) CreateEvent(ctx context.Context, req *connect.Request[ev
 		eventID := fmt.Sprintf("%d", event.ID)
 		clubID := fmt.Sprintf("%d", req.Msg.OrganizationId)
 		if err := s.perms.SetupEventRelationship(ctx, eventID, clubID, kratosUserID); err != nil {
-			slog.Warn("Failed to setup event relationship in SpiceDB", "error", err, "eventId", eventID)
+			logging.WithContext(ctx).Warn("Failed to setup event relationship in SpiceDB", "error", err, "eventId", eventID)
 			// Don't fail the request, just log the warning
 		}
 	}
@@ -160,57 +169,50 @@ func (s *EventsService) CreateEvent(ctx context.Context, req *connect.Request[ev
 	// Index event in Meilisearch (async, don't block response)
 	if s.search != nil {
 		go func() {
-			// Fetch organization title for search document
-			org, err := s.queries.GetOrganization(context.Background(), event.OrganizationID)
-			orgTitle := ""
-			if err == nil {
-				orgTitle = org.Title
-			}
-
-			// Fetch tag names
-			tags, _ := s.queries.GetEventTags(context.Background(), event.ID)
-			tagNames := make([]string, len(tags))
-			for i, t := range tags {
-				tagNames[i] = t.Name
-			}
-
-			doc := &search.EventDocument{
-				ID:                event.ID,
-				Title:             event.Title,
-				Description:       event.Description,
-				Location:          event.Location,
-				OrganizationID:    event.OrganizationID,
-				OrganizationTitle: orgTitle,
-				Format:            string(event.Format.Format),
-				StartTime:         event.StartTime.Time.Format(time.RFC3339),
-				EndTime:           event.EndTime.Time.Format(time.RFC3339),
-				TagIds:            req.Msg.TagIds,
-				Tags:              tagNames,
-				CreatedAt:         event.CreatedAt.Time.Format(time.RFC3339),
-			}
-			if event.ImageUrl.Valid {
-				doc.ImageURL = event.ImageUrl.String
+			doc, err := search.BuildEventDocument(context.Background(), s.queries, event)
+			if err != nil {
+				logging.WithContext(ctx).Warn("Failed to build search document", "error", err, "eventId", event.ID)
+				return
 			}
 			if err := s.search.IndexEvent(context.Background(), doc); err != nil {
-				slog.Warn("Failed to index event in search", "error", err, "eventId", event.ID)
+				logging.WithContext(ctx).Warn("Failed to index event in search", "error", err, "eventId", event.ID)
 			}
 		}()
 	}
 
+	s.reindexTagCounts(ctx, req.Msg.TagIds)
+
+	recordAudit(ctx, s.queries, req, "event", event.ID)
+
 	return connect.NewResponse(&eventsv1.CreateEventResponse{
 		Event: dbEventToProto(event, nil, req.Msg.TagIds),
 	}), nil
 }
 
 func (s *EventsService) GetEvent(ctx context.Context, req *connect.Request[eventsv1.GetEventRequest]) (*connect.Response[eventsv1.GetEventResponse], error) {
-	slog.Debug("GetEvent", "id", req.Msg.Id)
+	logging.WithContext(ctx).Debug("GetEvent", "id", req.Msg.Id)
 
-	event, err := s.queries.GetEvent(ctx, req.Msg.Id)
+	row, err := s.queries.GetEventWithCreator(ctx, req.Msg.Id)
 	if err != nil {
-		if err == pgx.ErrNoRows {
+		return nil, dberrors.Map(err)
+	}
+	event := row.Event
+
+	// Unpublished events only exist for the people who can edit them
+	if !event.Published {
+		kratosUserID := auth.GetUserID(ctx)
+		if kratosUserID == "" {
 			return nil, connect.NewError(connect.CodeNotFound, nil)
 		}
-		return nil, connect.NewError(connect.CodeInternal, err)
+		if s.perms != nil {
+			allowed, err := s.perms.CheckPermission(ctx, kratosUserID, "event", fmt.Sprintf("%d", event.ID), "edit")
+			if err != nil {
+				logging.WithContext(ctx).Warn("Permission check failed", "error", err)
+			}
+			if !allowed {
+				return nil, connect.NewError(connect.CodeNotFound, nil)
+			}
+		}
 	}
 
 	org, err := s.queries.GetOrganization(ctx, event.OrganizationID)
@@ -223,13 +225,44 @@ func (s *EventsService) GetEvent(ctx context.Context, req *connect.Request[event
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get tags: %w", err))
 	}
 
-	return connect.NewResponse(&eventsv1.GetEventResponse{
+	resp := &eventsv1.GetEventResponse{
 		Event: dbEventWithRelationsToProto(event, org, tags),
-	}), nil
+	}
+	resp.Event.CreatorUsername = row.CreatorUsername
+
+	// Attach the caller's own registration and attendance, if any
+	if kratosUserID := auth.GetUserID(ctx); kratosUserID != "" {
+		user, err := s.queries.GetUserByKratosID(ctx, pgtype.Text{String: kratosUserID, Valid: true})
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if err == nil {
+			reg, err := s.queries.GetEventRegistrationByEventAndUser(ctx, db.GetEventRegistrationByEventAndUserParams{
+				EventID: event.ID,
+				UserID:  user.ID,
+			})
+			if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+				return nil, connect.NewError(connect.CodeInternal, err)
+			}
+			if err == nil {
+				resp.CallerRegistration = dbEventRegistrationToProto(reg)
+
+				attendance, err := s.queries.GetEventAttendanceByRegistration(ctx, reg.ID)
+				if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+					return nil, connect.NewError(connect.CodeInternal, err)
+				}
+				if err == nil {
+					resp.CallerAttendance = dbEventAttendanceToProto(attendance)
+				}
+			}
+		}
+	}
+
+	return connect.NewResponse(resp), nil
 }
 
 func (s *EventsService) ListEvents(ctx context.Context, req *connect.Request[eventsv1.ListEventsRequest]) (*connect.Response[eventsv1.ListEventsResponse], error) {
-	slog.Debug("ListEvents", "page", req.Msg.Page, "limit", req.Msg.Limit)
+	logging.WithContext(ctx).Debug("ListEvents", "page", req.Msg.Page, "limit", req.Msg.Limit)
 
 	page := req.Msg.Page
 	if page <= 0 {
@@ -239,6 +272,7 @@ func (s *EventsService) ListEvents(ctx context.Context, req *connect.Request[eve
 	if limit <= 0 {
 		limit = 10
 	}
+	limit = min(limit, int32(s.cfg.MaxPageSize))
 
 	params := db.ListEventsParams{
 		Limit:  limit,
@@ -253,38 +287,67 @@ func (s *EventsService) ListEvents(ctx context.Context, req *connect.Request[eve
 	if len(req.Msg.TagIds) > 0 {
 		params.TagIds = req.Msg.TagIds
 	}
+	if req.Msg.IncludeUnpublished {
+		userID := auth.GetUserID(ctx)
+		if userID == "" {
+			return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+		}
+		if err := requirePlatformPermission(ctx, s.perms, userID, "manage_clubs", "list unpublished events"); err != nil {
+			return nil, err
+		}
+		params.IncludeUnpublished = true
+	}
 
-	events, err := s.queries.ListEvents(ctx, params)
+	events, nextCursor, err := s.listEvents(ctx, params, req.Msg.Cursor)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, err
 	}
 
 	// Count total
 	countParams := db.CountEventsParams{
-		UserID:         params.UserID,
-		OrganizationID: params.OrganizationID,
-		TagIds:         params.TagIds,
+		UserID:             params.UserID,
+		OrganizationID:     params.OrganizationID,
+		TagIds:             params.TagIds,
+		IncludeUnpublished: params.IncludeUnpublished,
 	}
 	total, err := s.queries.CountEvents(ctx, countParams)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	orgIDs := make([]int32, len(events))
+	eventIDs := make([]int32, len(events))
+	for i, row := range events {
+		orgIDs[i] = row.Event.OrganizationID
+		eventIDs[i] = row.Event.ID
+	}
+	orgs, err := s.queries.GetOrganizationsMap(ctx, orgIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	tags, err := s.queries.GetEventTagsMap(ctx, eventIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
 	protoEvents := make([]*eventsv1.Event, len(events))
-	for i, e := range events {
-		org, _ := s.queries.GetOrganization(ctx, e.OrganizationID)
-		tags, _ := s.queries.GetEventTags(ctx, e.ID)
-		protoEvents[i] = dbEventWithRelationsToProto(e, org, tags)
+	for i, row := range events {
+		e := row.Event
+		protoEvents[i] = dbEventWithRelationsToProto(e, orgs[e.OrganizationID], tags[e.ID])
+		protoEvents[i].TotalRegistrations = row.RegistrationCount
+		protoEvents[i].TotalAttendees = row.AttendanceCount
+		protoEvents[i].CreatorUsername = row.CreatorUsername
 	}
 
 	return connect.NewResponse(&eventsv1.ListEventsResponse{
-		Events: protoEvents,
-		Total:  int32(total),
+		Events:     protoEvents,
+		Total:      int32(total),
+		NextCursor: nextCursor,
 	}), nil
 }
 
 func (s *EventsService) ListEventsForAdmin(ctx context.Context, req *connect.Request[eventsv1.ListEventsForAdminRequest]) (*connect.Response[eventsv1.ListEventsForAdminResponse], error) {
-	slog.Debug("ListEventsForAdmin", "page", req.Msg.Page, "limit", req.Msg.Limit)
+	logging.WithContext(ctx).Debug("ListEventsForAdmin", "page", req.Msg.Page, "limit", req.Msg.Limit)
 
 	page := req.Msg.Page
 	if page <= 0 {
@@ -294,10 +357,12 @@ func (s *EventsService) ListEventsForAdmin(ctx context.Context, req *connect.Req
 	if limit <= 0 {
 		limit = 10
 	}
+	limit = min(limit, int32(s.cfg.MaxPageSize))
 
 	params := db.ListEventsParams{
-		Limit:  limit,
-		Offset: (page - 1) * limit,
+		Limit:              limit,
+		Offset:             (page - 1) * limit,
+		IncludeUnpublished: true,
 	}
 	if req.Msg.UserId != nil {
 		params.UserID = pgtype.Int4{Int32: *req.Msg.UserId, Valid: true}
@@ -306,22 +371,40 @@ func (s *EventsService) ListEventsForAdmin(ctx context.Context, req *connect.Req
 		params.OrganizationID = pgtype.Int4{Int32: *req.Msg.OrganizationId, Valid: true}
 	}
 
-	events, err := s.queries.ListEvents(ctx, params)
+	events, nextCursor, err := s.listEvents(ctx, params, req.Msg.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	orgIDs := make([]int32, len(events))
+	eventIDs := make([]int32, len(events))
+	for i, row := range events {
+		orgIDs[i] = row.Event.OrganizationID
+		eventIDs[i] = row.Event.ID
+	}
+	orgs, err := s.queries.GetOrganizationsMap(ctx, orgIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	tags, err := s.queries.GetEventTagsMap(ctx, eventIDs)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
 	protoEvents := make([]*eventsv1.Event, len(events))
-	for i, e := range events {
-		org, _ := s.queries.GetOrganization(ctx, e.OrganizationID)
-		tags, _ := s.queries.GetEventTags(ctx, e.ID)
-		protoEvents[i] = dbEventWithRelationsToProto(e, org, tags)
+	for i, row := range events {
+		e := row.Event
+		protoEvents[i] = dbEventWithRelationsToProto(e, orgs[e.OrganizationID], tags[e.ID])
+		protoEvents[i].TotalRegistrations = row.RegistrationCount
+		protoEvents[i].TotalAttendees = row.AttendanceCount
+		protoEvents[i].CreatorUsername = row.CreatorUsername
 	}
 
 	// Count total
 	countParams := db.CountEventsParams{
-		UserID:         params.UserID,
-		OrganizationID: params.OrganizationID,
+		UserID:             params.UserID,
+		OrganizationID:     params.OrganizationID,
+		IncludeUnpublished: true,
 	}
 	total, err := s.queries.CountEvents(ctx, countParams)
 	if err != nil {
@@ -329,13 +412,14 @@ func (s *EventsService) ListEventsForAdmin(ctx context.Context, req *connect.Req
 	}
 
 	return connect.NewResponse(&eventsv1.ListEventsForAdminResponse{
-		Events: protoEvents,
-		Total:  int32(total),
+		Events:     protoEvents,
+		Total:      int32(total),
+		NextCursor: nextCursor,
 	}), nil
 }
 
 func (s *EventsService) UpdateEvent(ctx context.Context, req *connect.Request[eventsv1.UpdateEventRequest]) (*connect.Response[eventsv1.UpdateEventResponse], error) {
-	slog.Debug("UpdateEvent", "id", req.Msg.Id)
+	logging.WithContext(ctx).Debug("UpdateEvent", "id", req.Msg.Id)
 
 	// Authorization: Check if user can edit this event
 	userID := auth.GetUserID(ctx)
@@ -347,7 +431,7 @@ func (s *EventsService) UpdateEvent(ctx context.Context, req *connect.Request[ev
 		eventID := fmt.Sprintf("%d", req.Msg.Id)
 		allowed, err := s.perms.CheckPermission(ctx, userID, "event", eventID, "edit")
 		if err != nil {
-			slog.Warn("Permission check failed", "error", err)
+			logging.WithContext(ctx).Warn("Permission check failed", "error", err)
 		}
 		if !allowed {
 			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("you don't have permission to edit this event"))
@@ -366,6 +450,9 @@ func (s *EventsService) UpdateEvent(ctx context.Context, req *connect.Request[ev
 	params := db.UpdateEventParams{
 		ID: req.Msg.Id,
 	}
+	if req.Msg.ExpectedVersion != nil {
+		params.ExpectedVersion = pgtype.Int4{Int32: *req.Msg.ExpectedVersion, Valid: true}
+	}
 
 	if req.Msg.Title != nil {
 		params.Title = pgtype.Text{String: *req.Msg.Title, Valid: true}
@@ -394,33 +481,51 @@ func (s *EventsService) UpdateEvent(ctx context.Context, req *connect.Request[ev
 		params.EndTime = pgtype.Timestamptz{Time: t, Valid: true}
 	}
 	if req.Msg.Format != nil {
-		if *req.Msg.Format == eventsv1.EventFormat_EVENT_FORMAT_ONLINE {
-			params.Format = db.NullFormat{Format: db.FormatOnline, Valid: true}
-		} else {
-			params.Format = db.NullFormat{Format: db.FormatOffline, Valid: true}
+		params.Format = db.NullFormat{Format: protoEventFormatToDB(*req.Msg.Format), Valid: true}
+	}
+	if req.Msg.Capacity != nil {
+		if *req.Msg.Capacity < 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("capacity must not be negative"))
 		}
+		params.Capacity = pgtype.Int4{Int32: *req.Msg.Capacity, Valid: true}
 	}
 
 	event, err := qtx.UpdateEvent(ctx, params)
 	if err != nil {
-		if err == pgx.ErrNoRows {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if !params.ExpectedVersion.Valid {
 			return nil, connect.NewError(connect.CodeNotFound, nil)
 		}
-		return nil, connect.NewError(connect.CodeInternal, err)
+		// No row is either a missing event or a stale expected_version
+		current, err := qtx.GetEvent(ctx, req.Msg.Id)
+		if err != nil {
+			return nil, dberrors.Map(err)
+		}
+		return nil, connect.NewError(connect.CodeAborted, fmt.Errorf("event was modified concurrently: expected version %d, current version %d", params.ExpectedVersion.Int32, current.Version))
 	}
 
 	// Update tags if provided
+	var changedTagIDs []int32
 	if len(req.Msg.TagIds) > 0 {
+		oldTags, err := qtx.GetEventTags(ctx, req.Msg.Id)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		for _, t := range oldTags {
+			changedTagIDs = append(changedTagIDs, t.ID)
+		}
+		changedTagIDs = append(changedTagIDs, req.Msg.TagIds...)
+
 		if err := qtx.RemoveEventTags(ctx, req.Msg.Id); err != nil {
 			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to remove old tags: %w", err))
 		}
-		for _, tagID := range req.Msg.TagIds {
-			if err := qtx.AddEventTag(ctx, db.AddEventTagParams{
-				EventID: event.ID,
-				TagID:   tagID,
-			}); err != nil {
-				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to add tag: %w", err))
-			}
+		if err := qtx.AddEventTags(ctx, db.AddEventTagsParams{
+			EventID: event.ID,
+			TagIds:  req.Msg.TagIds,
+		}); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to add tags: %w", err))
 		}
 	}
 
@@ -432,46 +537,39 @@ func (s *EventsService) UpdateEvent(ctx context.Context, req *connect.Request[ev
 	org, _ := s.queries.GetOrganization(ctx, event.OrganizationID)
 	tags, _ := s.queries.GetEventTags(ctx, event.ID)
 
-	// Re-index event in Meilisearch (async, don't block response)
-	if s.search != nil {
-		go func() {
-			tagNames := make([]string, len(tags))
-			tagIds := make([]int32, len(tags))
-			for i, t := range tags {
-				tagNames[i] = t.Name
-				tagIds[i] = t.ID
-			}
-
-			doc := &search.EventDocument{
-				ID:                event.ID,
-				Title:             event.Title,
-				Description:       event.Description,
-				Location:          event.Location,
-				OrganizationID:    event.OrganizationID,
-				OrganizationTitle: org.Title,
-				Format:            string(event.Format.Format),
-				StartTime:         event.StartTime.Time.Format(time.RFC3339),
-				EndTime:           event.EndTime.Time.Format(time.RFC3339),
-				TagIds:            tagIds,
-				Tags:              tagNames,
-				CreatedAt:         event.CreatedAt.Time.Format(time.RFC3339),
-			}
-			if event.ImageUrl.Valid {
-				doc.ImageURL = event.ImageUrl.String
-			}
-			if err := s.search.IndexEvent(context.Background(), doc); err != nil {
-				slog.Warn("Failed to re-index event in search", "error", err, "eventId", event.ID)
-			}
-		}()
+	// Unpublished events stay out of the index until they are published again
+	if event.Published {
+		s.reindexEvent(ctx, event)
 	}
 
+	s.reindexTagCounts(ctx, changedTagIDs)
+
+	recordAudit(ctx, s.queries, req, "event", event.ID)
+
 	return connect.NewResponse(&eventsv1.UpdateEventResponse{
 		Event: dbEventWithRelationsToProto(event, org, tags),
 	}), nil
 }
 
+// reindexEvent replaces the event's search document (async, don't block response)
+func (s *EventsService) reindexEvent(ctx context.Context, event db.Event) {
+	if s.search == nil {
+		return
+	}
+	go func() {
+		doc, err := search.BuildEventDocument(context.Background(), s.queries, event)
+		if err != nil {
+			logging.WithContext(ctx).Warn("Failed to build search document", "error", err, "eventId", event.ID)
+			return
+		}
+		if err := s.search.IndexEvent(context.Background(), doc); err != nil {
+			logging.WithContext(ctx).Warn("Failed to re-index event in search", "error", err, "eventId", event.ID)
+		}
+	}()
+}
+
 func (s *EventsService) DeleteEvent(ctx context.Context, req *connect.Request[eventsv1.DeleteEventRequest]) (*connect.Response[eventsv1.DeleteEventResponse], error) {
-	slog.Debug("DeleteEvent", "id", req.Msg.Id)
+	logging.WithContext(ctx).Debug("DeleteEvent", "id", req.Msg.Id)
 
 	// Authorization: Check if user can delete this event
 	userID := auth.GetUserID(ctx)
@@ -483,14 +581,22 @@ func (s *EventsService) DeleteEvent(ctx context.Context, req *connect.Request[ev
 		eventID := fmt.Sprintf("%d", req.Msg.Id)
 		allowed, err := s.perms.CheckPermission(ctx, userID, "event", eventID, "delete")
 		if err != nil {
-			slog.Warn("Permission check failed", "error", err)
+			logging.WithContext(ctx).Warn("Permission check failed", "error", err)
 		}
 		if !allowed {
 			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("you don't have permission to delete this event"))
 		}
 	}
 
-	err := s.queries.DeleteEvent(ctx, req.Msg.Id)
+	// Tag counts skip deleted events, remember the tags to refresh them
+	tags, err := s.queries.GetEventTags(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Soft delete: registrations, attendance and SpiceDB relationships are
+	// kept so RestoreEvent can bring the event back
+	err = s.queries.DeleteEvent(ctx, req.Msg.Id)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
@@ -500,29 +606,50 @@ func (s *EventsService) DeleteEvent(ctx context.Context, req *connect.Request[ev
 		eventID := req.Msg.Id
 		go func() {
 			if err := s.search.DeleteDocument(context.Background(), search.IndexEvents, eventID); err != nil {
-				slog.Warn("Failed to delete event from search", "error", err, "eventId", eventID)
+				logging.WithContext(ctx).Warn("Failed to delete event from search", "error", err, "eventId", eventID)
 			}
 		}()
 	}
 
+	tagIDs := make([]int32, len(tags))
+	for i, t := range tags {
+		tagIDs[i] = t.ID
+	}
+	s.reindexTagCounts(ctx, tagIDs)
+
+	recordAudit(ctx, s.queries, req, "event", req.Msg.Id)
+
 	return connect.NewResponse(&eventsv1.DeleteEventResponse{
 		Success: true,
 	}), nil
 }
 
 func (s *EventsService) GetEventsByTagId(ctx context.Context, req *connect.Request[eventsv1.GetEventsByTagIdRequest]) (*connect.Response[eventsv1.GetEventsByTagIdResponse], error) {
-	slog.Debug("GetEventsByTagId", "tagId", req.Msg.TagId)
+	logging.WithContext(ctx).Debug("GetEventsByTagId", "tagId", req.Msg.TagId)
 
 	events, err := s.queries.GetEventsByTagID(ctx, req.Msg.TagId)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	orgIDs := make([]int32, len(events))
+	eventIDs := make([]int32, len(events))
+	for i, e := range events {
+		orgIDs[i] = e.OrganizationID
+		eventIDs[i] = e.ID
+	}
+	orgs, err := s.queries.GetOrganizationsMap(ctx, orgIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	tags, err := s.queries.GetEventTagsMap(ctx, eventIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
 	protoEvents := make([]*eventsv1.Event, len(events))
 	for i, e := range events {
-		org, _ := s.queries.GetOrganization(ctx, e.OrganizationID)
-		tags, _ := s.queries.GetEventTags(ctx, e.ID)
-		protoEvents[i] = dbEventWithRelationsToProto(e, org, tags)
+		protoEvents[i] = dbEventWithRelationsToProto(e, orgs[e.OrganizationID], tags[e.ID])
 	}
 
 	return connect.NewResponse(&eventsv1.GetEventsByTagIdResponse{
@@ -530,27 +657,135 @@ func (s *EventsService) GetEventsByTagId(ctx context.Context, req *connect.Reque
 	}), nil
 }
 
+// maxAllTagsFilter caps GetEventsByAllTags, every extra tag widens the group scan
+const maxAllTagsFilter = 10
+
+// GetEventsByAllTags returns events tagged with every requested tag.
+// Duplicate tag IDs are collapsed so they don't inflate the required count.
+func (s *EventsService) GetEventsByAllTags(ctx context.Context, req *connect.Request[eventsv1.GetEventsByAllTagsRequest]) (*connect.Response[eventsv1.GetEventsByAllTagsResponse], error) {
+	logging.WithContext(ctx).Debug("GetEventsByAllTags", "tagIds", req.Msg.TagIds, "page", req.Msg.Page, "limit", req.Msg.Limit)
+
+	seen := make(map[int32]bool, len(req.Msg.TagIds))
+	tagIDs := make([]int32, 0, len(req.Msg.TagIds))
+	for _, id := range req.Msg.TagIds {
+		if !seen[id] {
+			seen[id] = true
+			tagIDs = append(tagIDs, id)
+		}
+	}
+	if len(tagIDs) == 0 || len(tagIDs) > maxAllTagsFilter {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("between 1 and %d tag IDs are required", maxAllTagsFilter))
+	}
+
+	page := req.Msg.Page
+	if page <= 0 {
+		page = 1
+	}
+	limit := req.Msg.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+	limit = min(limit, int32(s.cfg.MaxPageSize))
+
+	rows, err := s.queries.GetEventsByAllTags(ctx, db.GetEventsByAllTagsParams{
+		TagIds:   tagIDs,
+		TagCount: int32(len(tagIDs)),
+		Limit:    limit,
+		Offset:   (page - 1) * limit,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	total, err := s.queries.CountEventsByAllTags(ctx, db.CountEventsByAllTagsParams{
+		TagIds:   tagIDs,
+		TagCount: int32(len(tagIDs)),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	orgIDs := make([]int32, len(rows))
+	eventIDs := make([]int32, len(rows))
+	for i, row := range rows {
+		orgIDs[i] = row.Event.OrganizationID
+		eventIDs[i] = row.Event.ID
+	}
+	orgs, err := s.queries.GetOrganizationsMap(ctx, orgIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	tags, err := s.queries.GetEventTagsMap(ctx, eventIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoEvents := make([]*eventsv1.Event, len(rows))
+	for i, row := range rows {
+		e := row.Event
+		protoEvents[i] = dbEventWithRelationsToProto(e, orgs[e.OrganizationID], tags[e.ID])
+		protoEvents[i].TotalRegistrations = row.RegistrationCount
+		protoEvents[i].TotalAttendees = row.AttendanceCount
+		protoEvents[i].CreatorUsername = row.CreatorUsername
+	}
+
+	return connect.NewResponse(&eventsv1.GetEventsByAllTagsResponse{
+		Events: protoEvents,
+		Total:  int32(total),
+	}), nil
+}
+
 func (s *EventsService) GetUserSubscribedEvents(ctx context.Context, req *connect.Request[eventsv1.GetUserSubscribedEventsRequest]) (*connect.Response[eventsv1.GetUserSubscribedEventsResponse], error) {
-	slog.Debug("GetUserSubscribedEvents", "userId", req.Msg.UserId)
+	logging.WithContext(ctx).Debug("GetUserSubscribedEvents", "userId", req.Msg.UserId, "page", req.Msg.Page, "limit", req.Msg.Limit)
+
+	// Without a limit every event is returned, as before pagination
+	var limit pgtype.Int4
+	var offset int32
+	if req.Msg.Limit > 0 {
+		limit = pgtype.Int4{Int32: min(req.Msg.Limit, int32(s.cfg.MaxPageSize)), Valid: true}
+		offset = (max(req.Msg.Page, 1) - 1) * limit.Int32
+	}
 
-	regs, err := s.queries.GetUserRegistrations(ctx, req.Msg.UserId)
+	events, err := s.queries.GetUserSubscribedEvents(ctx, db.GetUserSubscribedEventsParams{
+		UserID: req.Msg.UserId,
+		Limit:  limit,
+		Offset: offset,
+	})
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	var protoEvents []*eventsv1.Event
-	for _, reg := range regs {
-		event, err := s.queries.GetEvent(ctx, reg.EventID)
-		if err != nil {
-			continue
-		}
-		org, _ := s.queries.GetOrganization(ctx, event.OrganizationID)
-		tags, _ := s.queries.GetEventTags(ctx, event.ID)
-		protoEvents = append(protoEvents, dbEventWithRelationsToProto(event, org, tags))
+	total, err := s.queries.CountUserRegistrations(ctx, db.CountUserRegistrationsParams{
+		UserID: req.Msg.UserId,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	orgIDs := make([]int32, len(events))
+	eventIDs := make([]int32, len(events))
+	for i, e := range events {
+		orgIDs[i] = e.OrganizationID
+		eventIDs[i] = e.ID
+	}
+	orgs, err := s.queries.GetOrganizationsMap(ctx, orgIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	tags, err := s.queries.GetEventTagsMap(ctx, eventIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoEvents := make([]*eventsv1.Event, len(events))
+	for i, event := range events {
+		protoEvents[i] = dbEventWithRelationsToProto(event, orgs[event.OrganizationID], tags[event.ID])
 	}
 
 	return connect.NewResponse(&eventsv1.GetUserSubscribedEventsResponse{
 		Events: protoEvents,
+		Total:  int32(total),
 	}), nil
 }
 
@@ -558,8 +793,8 @@ func (s *EventsService) GetUserSubscribedEvents(ctx context.Context, req *connec
 
 func dbEventToProto(e db.Event, org *db.Organization, tagIDs []int32) *eventsv1.Event {
 	format := eventsv1.EventFormat_EVENT_FORMAT_OFFLINE
-	if e.Format.Valid && e.Format.Format == db.FormatOnline {
-		format = eventsv1.EventFormat_EVENT_FORMAT_ONLINE
+	if e.Format.Valid {
+		format = dbEventFormatToProto(e.Format.Format)
 	}
 
 	event := &eventsv1.Event{
@@ -575,10 +810,19 @@ func dbEventToProto(e db.Event, org *db.Organization, tagIDs []int32) *eventsv1.
 		TagIds:         tagIDs,
 		CreatedAt:      e.CreatedAt.Time.Format(time.RFC3339),
 		UpdatedAt:      e.UpdatedAt.Time.Format(time.RFC3339),
+		Published:      e.Published,
+		Version:        e.Version,
 	}
 	if e.ImageUrl.Valid {
 		event.ImageUrl = &e.ImageUrl.String
 	}
+	if e.Capacity.Valid {
+		event.Capacity = &e.Capacity.Int32
+	}
+	if e.DeletedAt.Valid {
+		deletedAt := e.DeletedAt.Time.Format(time.RFC3339)
+		event.DeletedAt = &deletedAt
+	}
 	if org != nil {
 		event.Organization = dbOrganizationToProto(*org)
 	}
@@ -647,10 +891,39 @@ func dbOrganizationToProto(o db.Organization) *eventsv1.Organization {
 	if o.Linkedin.Valid {
 		org.Linkedin = &o.Linkedin.String
 	}
+	if o.MonthlyEventQuota.Valid {
+		org.MonthlyEventQuota = &o.MonthlyEventQuota.Int32
+	}
 
 	return org
 }
 
+// reindexTagCounts refreshes the event counts of the given tags in Meilisearch
+// (async, don't block response)
+func (s *EventsService) reindexTagCounts(ctx context.Context, tagIDs []int32) {
+	if s.search == nil || len(tagIDs) == 0 {
+		return
+	}
+	go func() {
+		seen := make(map[int32]bool, len(tagIDs))
+		for _, tagID := range tagIDs {
+			if seen[tagID] {
+				continue
+			}
+			seen[tagID] = true
+
+			counts, err := s.queries.GetTagCounts(context.Background(), tagID)
+			if err != nil {
+				logging.WithContext(ctx).Warn("Failed to count tag events", "error", err, "tagId", tagID)
+				continue
+			}
+			if err := s.search.UpdateTagEventCount(context.Background(), tagID, counts.EventCount); err != nil {
+				logging.WithContext(ctx).Warn("Failed to update tag event count in search", "error", err, "tagId", tagID)
+			}
+		}
+	}()
+}
+
 func dbTagToProto(t *db.Tag) *eventsv1.Tag {
 	return &eventsv1.Tag{
 		Id:        t.ID,
@@ -659,3 +932,33 @@ func dbTagToProto(t *db.Tag) *eventsv1.Tag {
 		UpdatedAt: t.UpdatedAt.Time.Format(time.RFC3339),
 	}
 }
+
+func dbTagWithCountsToProto(t *db.Tag, organizationCount, eventCount int32) *eventsv1.Tag {
+	protoTag := dbTagToProto(t)
+	protoTag.OrganizationCount = organizationCount
+	protoTag.EventCount = eventCount
+	return protoTag
+}
+
+// protoEventFormatToDB maps the proto format, defaulting to offline when unset
+func protoEventFormatToDB(f eventsv1.EventFormat) db.Format {
+	switch f {
+	case eventsv1.EventFormat_EVENT_FORMAT_ONLINE:
+		return db.FormatOnline
+	case eventsv1.EventFormat_EVENT_FORMAT_HYBRID:
+		return db.FormatHybrid
+	default:
+		return db.FormatOffline
+	}
+}
+
+func dbEventFormatToProto(f db.Format) eventsv1.EventFormat {
+	switch f {
+	case db.FormatOnline:
+		return eventsv1.EventFormat_EVENT_FORMAT_ONLINE
+	case db.FormatHybrid:
+		return eventsv1.EventFormat_EVENT_FORMAT_HYBRID
+	default:
+		return eventsv1.EventFormat_EVENT_FORMAT_OFFLINE
+	}
+}
@@ -0,0 +1,113 @@
+package services
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/logging"
+	"github.com/studyverse/ems-backend/internal/perms"
+)
+
+// ExportHandler serves CSV exports over plain HTTP
+type ExportHandler struct {
+	queries *db.Queries
+	perms   *perms.Client
+}
+
+func NewExportHandler(queries *db.Queries, permsClient *perms.Client) *ExportHandler {
+	return &ExportHandler{queries: queries, perms: permsClient}
+}
+
+// Register mounts the export routes on the given mux
+func (h *ExportHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /export/events/{id}/registrations.csv", h.ExportEventRegistrations)
+}
+
+// ExportEventRegistrations streams an event's registrations as CSV.
+// Rows are written as they are read from the database, so memory usage
+// does not grow with the number of registrants.
+func (h *ExportHandler) ExportEventRegistrations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	eventID, err := strconv.ParseInt(r.PathValue("id"), 10, 32)
+	if err != nil {
+		http.Error(w, "invalid event id", http.StatusBadRequest)
+		return
+	}
+	logging.WithContext(ctx).Debug("ExportEventRegistrations", "eventId", eventID)
+
+	userID := auth.GetUserID(ctx)
+	if userID == "" {
+		http.Error(w, "authentication required", http.StatusUnauthorized)
+		return
+	}
+
+	if _, err := h.queries.GetEvent(ctx, int32(eventID)); err != nil {
+		http.Error(w, "event not found", http.StatusNotFound)
+		return
+	}
+
+	if h.perms != nil {
+		allowed, err := h.perms.CheckPermission(ctx, userID, "event", fmt.Sprintf("%d", eventID), "manage_registrations")
+		if err != nil {
+			logging.WithContext(ctx).Warn("Permission check failed", "error", err)
+		}
+		if !allowed {
+			http.Error(w, "you don't have permission to export registrations for this event", http.StatusForbidden)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"event-%d-registrations.csv\"", eventID))
+
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"registration_id", "user_id", "username", "email", "first_name", "last_name", "status", "registered_at", "cancelled_at", "attendance_status", "checked_in_at"})
+
+	rows, errc := h.queries.StreamEventRegistrations(ctx, int32(eventID))
+	for row := range rows {
+		attendance := ""
+		if row.AttendanceStatus.Valid {
+			attendance = string(row.AttendanceStatus.AttendanceStatus)
+		}
+		if err := cw.Write([]string{
+			strconv.Itoa(int(row.RegistrationID)),
+			strconv.Itoa(int(row.UserID)),
+			row.Username,
+			row.Email,
+			row.FirstName.String,
+			row.LastName.String,
+			string(row.Status),
+			formatCSVTime(row.RegisteredAt.Time, row.RegisteredAt.Valid),
+			formatCSVTime(row.CancelledAt.Time, row.CancelledAt.Valid),
+			attendance,
+			formatCSVTime(row.CheckedInAt.Time, row.CheckedInAt.Valid),
+		}); err != nil {
+			// Client went away; the cancelled request context stops the query
+			logging.WithContext(ctx).Warn("Failed to write CSV row", "eventId", eventID, "error", err)
+			return
+		}
+	}
+	if err := <-errc; err != nil {
+		// Headers are already sent, so the best we can do is log and truncate
+		logging.WithContext(ctx).Error("Failed to stream event registrations", "eventId", eventID, "error", err)
+		return
+	}
+
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		logging.WithContext(ctx).Warn("Failed to flush CSV export", "eventId", eventID, "error", err)
+	}
+}
+
+func formatCSVTime(t time.Time, valid bool) string {
+	if !valid {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
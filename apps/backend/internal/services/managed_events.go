@@ -0,0 +1,162 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
+	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/dberrors"
+	"github.com/studyverse/ems-backend/internal/logging"
+)
+
+// GetManagedEvents returns the events a user can edit, ordered by ID.
+// With SpiceDB this includes every event of the clubs the user runs; without
+// it, or with the creator role filter, only the events the user created are
+// returned.
+func (s *EventsService) GetManagedEvents(ctx context.Context, req *connect.Request[eventsv1.GetManagedEventsRequest]) (*connect.Response[eventsv1.GetManagedEventsResponse], error) {
+	logging.WithContext(ctx).Debug("GetManagedEvents", "userId", req.Msg.UserId, "page", req.Msg.Page, "limit", req.Msg.Limit, "roleFilter", req.Msg.RoleFilter)
+
+	kratosID := auth.GetUserID(ctx)
+	if kratosID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	caller, err := s.queries.GetUserByKratosID(ctx, pgtype.Text{String: kratosID, Valid: true})
+	if err != nil {
+		return nil, dberrors.MapNotFound(err, "user not found")
+	}
+
+	user := caller
+	if req.Msg.UserId != 0 && req.Msg.UserId != caller.ID {
+		if err := requirePlatformPermission(ctx, s.perms, kratosID, "manage_system", "view another user's managed events"); err != nil {
+			return nil, err
+		}
+		user, err = s.queries.GetUser(ctx, req.Msg.UserId)
+		if err != nil {
+			return nil, dberrors.MapNotFound(err, "user not found")
+		}
+	}
+
+	page := req.Msg.Page
+	if page <= 0 {
+		page = 1
+	}
+	limit := req.Msg.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+	limit = min(limit, int32(s.cfg.MaxPageSize))
+	offset := (page - 1) * limit
+
+	var events []db.Event
+	var total int64
+	if s.perms != nil && req.Msg.RoleFilter != eventsv1.ManagedEventRole_MANAGED_EVENT_ROLE_CREATOR {
+		events, total, err = s.lookupEditableEvents(ctx, user, limit, offset)
+	} else {
+		events, total, err = s.listCreatedEvents(ctx, user.ID, limit, offset)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	orgIDs := make([]int32, len(events))
+	eventIDs := make([]int32, len(events))
+	for i, e := range events {
+		orgIDs[i] = e.OrganizationID
+		eventIDs[i] = e.ID
+	}
+	orgs, err := s.queries.GetOrganizationsMap(ctx, orgIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	tags, err := s.queries.GetEventTagsMap(ctx, eventIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoEvents := make([]*eventsv1.Event, len(events))
+	for i, e := range events {
+		protoEvents[i] = dbEventWithRelationsToProto(e, orgs[e.OrganizationID], tags[e.ID])
+	}
+
+	return connect.NewResponse(&eventsv1.GetManagedEventsResponse{
+		Events: protoEvents,
+		Total:  int32(total),
+	}), nil
+}
+
+// lookupEditableEvents pages through the events SpiceDB says the user can edit
+func (s *EventsService) lookupEditableEvents(ctx context.Context, user db.User, limit, offset int32) ([]db.Event, int64, error) {
+	// Relationships are written with the Kratos identity when there is one
+	subjectID := fmt.Sprintf("%d", user.ID)
+	if user.KratosID.Valid {
+		subjectID = user.KratosID.String
+	}
+
+	eventIDs, err := s.perms.LookupResources(ctx, subjectID, "event", "edit")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int32, 0, len(eventIDs))
+	for _, eventID := range eventIDs {
+		id, err := strconv.ParseInt(eventID, 10, 32)
+		if err != nil {
+			logging.WithContext(ctx).Warn("Ignoring non-numeric event ID from SpiceDB", "eventId", eventID)
+			continue
+		}
+		ids = append(ids, int32(id))
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	total := int64(len(ids))
+	if int(offset) >= len(ids) {
+		return []db.Event{}, total, nil
+	}
+	ids = ids[offset:min(int(offset+limit), len(ids))]
+
+	byID, err := s.queries.GetEventsMap(ctx, ids)
+	if err != nil {
+		return nil, 0, err
+	}
+	// Relationships can outlive deleted events, skip those
+	events := make([]db.Event, 0, len(ids))
+	for _, id := range ids {
+		if e, ok := byID[id]; ok {
+			events = append(events, e)
+		}
+	}
+	return events, total, nil
+}
+
+// listCreatedEvents is the fallback without SpiceDB: the events the user created
+func (s *EventsService) listCreatedEvents(ctx context.Context, userID, limit, offset int32) ([]db.Event, int64, error) {
+	creator := pgtype.Int4{Int32: userID, Valid: true}
+
+	rows, err := s.queries.ListEvents(ctx, db.ListEventsParams{
+		UserID:             creator,
+		Limit:              limit,
+		Offset:             offset,
+		IncludeUnpublished: true,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.queries.CountEvents(ctx, db.CountEventsParams{UserID: creator, IncludeUnpublished: true})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	events := make([]db.Event, len(rows))
+	for i, row := range rows {
+		events[i] = row.Event
+	}
+	return events, total, nil
+}
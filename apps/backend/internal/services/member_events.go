@@ -0,0 +1,162 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	searchv1 "github.com/studyverse/ems-backend/gen/searchv1"
+	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/dberrors"
+	"github.com/studyverse/ems-backend/internal/logging"
+)
+
+// memberClubCacheTTL bounds how long a membership change takes to show up in SearchMemberEvents
+const memberClubCacheTTL = 60 * time.Second
+
+// SearchMemberEvents searches the events of the clubs the caller can view
+func (s *SearchService) SearchMemberEvents(ctx context.Context, req *connect.Request[searchv1.SearchMemberEventsRequest]) (*connect.Response[searchv1.SearchMemberEventsResponse], error) {
+	logging.WithContext(ctx).Debug("SearchMemberEvents", "query", req.Msg.Query, "limit", req.Msg.Limit)
+
+	kratosID := auth.GetUserID(ctx)
+	if kratosID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	if s.searchClient == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("search service not available"))
+	}
+
+	limit := req.Msg.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+	limit = min(limit, int32(s.cfg.MaxPageSize))
+
+	clubIDs, ok := s.memberClubs.get(kratosID)
+	if !ok {
+		var err error
+		clubIDs, err = s.lookupMemberClubIDs(ctx, kratosID)
+		if err != nil {
+			return nil, dberrors.MapNotFound(err, "user not found")
+		}
+		s.memberClubs.set(kratosID, clubIDs)
+	}
+
+	if len(clubIDs) == 0 {
+		return connect.NewResponse(&searchv1.SearchMemberEventsResponse{
+			Results: []*searchv1.SearchResult{},
+		}), nil
+	}
+
+	result, err := s.searchClient.SearchEvents(ctx, req.Msg.Query, limit, clubFilter(clubIDs), nil)
+	if err != nil {
+		logging.WithContext(ctx).Error("SearchMemberEvents failed", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("search failed: %w", err))
+	}
+
+	return connect.NewResponse(&searchv1.SearchMemberEventsResponse{
+		Results:   hitsToProto(result.Hits, searchv1.SearchResultType_SEARCH_RESULT_TYPE_EVENT),
+		TotalHits: result.EstimatedTotalHits,
+	}), nil
+}
+
+// lookupMemberClubIDs asks SpiceDB which clubs the user can view, falling
+// back to user_roles when SpiceDB is not configured or the lookup fails
+func (s *SearchService) lookupMemberClubIDs(ctx context.Context, kratosID string) ([]int32, error) {
+	if s.perms != nil {
+		clubIDs, err := s.perms.LookupResources(ctx, kratosID, "club", "view")
+		if err == nil {
+			ids := make([]int32, 0, len(clubIDs))
+			for _, clubID := range clubIDs {
+				id, err := strconv.ParseInt(clubID, 10, 32)
+				if err != nil {
+					logging.WithContext(ctx).Warn("Ignoring non-numeric club ID from SpiceDB", "clubId", clubID)
+					continue
+				}
+				ids = append(ids, int32(id))
+			}
+			return ids, nil
+		}
+		logging.WithContext(ctx).Warn("SpiceDB club lookup failed, falling back to user_roles", "error", err)
+	}
+
+	user, err := s.queries.GetUserByKratosID(ctx, pgtype.Text{String: kratosID, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+	orgs, err := s.queries.GetOrganizationsByUserRoles(ctx, db.GetOrganizationsByUserRolesParams{
+		UserID: user.ID,
+		Roles:  []string{"President", "Staff", "Member"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int32, len(orgs))
+	for i, o := range orgs {
+		ids[i] = o.ID
+	}
+	return ids, nil
+}
+
+// clubFilter builds a Meilisearch filter matching events of any of the clubs
+func clubFilter(clubIDs []int32) string {
+	ids := make([]string, len(clubIDs))
+	for i, id := range clubIDs {
+		ids[i] = strconv.Itoa(int(id))
+	}
+	return fmt.Sprintf("organizationId IN [%s]", strings.Join(ids, ", "))
+}
+
+// memberClubCache remembers each user's club IDs for a short while, so
+// typing in the search box doesn't hit SpiceDB on every keystroke
+type memberClubCache struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[string]memberClubCacheEntry
+}
+
+type memberClubCacheEntry struct {
+	clubIDs   []int32
+	expiresAt time.Time
+}
+
+func newMemberClubCache(ttl time.Duration) *memberClubCache {
+	return &memberClubCache{ttl: ttl, entries: make(map[string]memberClubCacheEntry)}
+}
+
+func (c *memberClubCache) get(userID string) ([]int32, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[userID]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expiresAt) {
+		delete(c.entries, userID)
+		return nil, false
+	}
+	return entry.clubIDs, true
+}
+
+func (c *memberClubCache) set(userID string, clubIDs []int32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	// Drop expired entries on write so the map doesn't grow without bound
+	for k, e := range c.entries {
+		if now.After(e.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[userID] = memberClubCacheEntry{clubIDs: clubIDs, expiresAt: now.Add(c.ttl)}
+}
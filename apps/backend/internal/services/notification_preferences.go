@@ -0,0 +1,139 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	usersv1 "github.com/studyverse/ems-backend/gen/usersv1"
+	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/dberrors"
+	"github.com/studyverse/ems-backend/internal/logging"
+)
+
+// notificationTypes pairs the proto and database notification types, in the
+// order preferences are returned
+var notificationTypes = []struct {
+	proto usersv1.NotificationType
+	db    db.NotificationType
+}{
+	{usersv1.NotificationType_NOTIFICATION_TYPE_REGISTRATION_CONFIRMATION, db.NotificationTypeRegistrationConfirmation},
+	{usersv1.NotificationType_NOTIFICATION_TYPE_EVENT_REMINDER, db.NotificationTypeEventReminder},
+	{usersv1.NotificationType_NOTIFICATION_TYPE_EVENT_CANCELLATION, db.NotificationTypeEventCancellation},
+	{usersv1.NotificationType_NOTIFICATION_TYPE_WAITLIST_PROMOTED, db.NotificationTypeWaitlistPromoted},
+	{usersv1.NotificationType_NOTIFICATION_TYPE_PLATFORM_ANNOUNCEMENT, db.NotificationTypePlatformAnnouncement},
+}
+
+func protoNotificationTypeToDB(t usersv1.NotificationType) (db.NotificationType, bool) {
+	for _, nt := range notificationTypes {
+		if nt.proto == t {
+			return nt.db, true
+		}
+	}
+	return "", false
+}
+
+// GetNotificationPreferences returns the user's preference for every notification type
+func (s *UsersService) GetNotificationPreferences(ctx context.Context, req *connect.Request[usersv1.GetNotificationPreferencesRequest]) (*connect.Response[usersv1.GetNotificationPreferencesResponse], error) {
+	logging.WithContext(ctx).Debug("GetNotificationPreferences", "userId", req.Msg.UserId)
+
+	if err := s.requireSelfOrSystemAdmin(ctx, req.Msg.UserId, "view this user's notification preferences"); err != nil {
+		return nil, err
+	}
+
+	prefs, err := s.notificationPreferences(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&usersv1.GetNotificationPreferencesResponse{
+		Preferences: prefs,
+	}), nil
+}
+
+// UpdateNotificationPreferences stores the given preferences and returns all of them
+func (s *UsersService) UpdateNotificationPreferences(ctx context.Context, req *connect.Request[usersv1.UpdateNotificationPreferencesRequest]) (*connect.Response[usersv1.UpdateNotificationPreferencesResponse], error) {
+	logging.WithContext(ctx).Debug("UpdateNotificationPreferences", "userId", req.Msg.UserId, "count", len(req.Msg.Preferences))
+
+	if err := s.requireSelfOrSystemAdmin(ctx, req.Msg.UserId, "change this user's notification preferences"); err != nil {
+		return nil, err
+	}
+
+	// Validate everything first so a bad entry doesn't leave a partial update
+	params := make([]db.UpsertUserNotificationPreferenceParams, len(req.Msg.Preferences))
+	for i, p := range req.Msg.Preferences {
+		notificationType, ok := protoNotificationTypeToDB(p.NotificationType)
+		if !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown notification type %v", p.NotificationType))
+		}
+		params[i] = db.UpsertUserNotificationPreferenceParams{
+			UserID:           req.Msg.UserId,
+			NotificationType: notificationType,
+			EmailEnabled:     p.EmailEnabled,
+			PushEnabled:      p.PushEnabled,
+		}
+	}
+
+	for _, p := range params {
+		if _, err := s.queries.UpsertUserNotificationPreference(ctx, p); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to save notification preference: %w", err))
+		}
+	}
+
+	prefs, err := s.notificationPreferences(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	recordAudit(ctx, s.queries, req, "user", req.Msg.UserId)
+
+	return connect.NewResponse(&usersv1.UpdateNotificationPreferencesResponse{
+		Preferences: prefs,
+	}), nil
+}
+
+// notificationPreferences lists one preference per type, enabled unless stored otherwise
+func (s *UsersService) notificationPreferences(ctx context.Context, userID int32) ([]*usersv1.NotificationPreference, error) {
+	rows, err := s.queries.ListUserNotificationPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	stored := make(map[db.NotificationType]db.UserNotificationPreference, len(rows))
+	for _, r := range rows {
+		stored[r.NotificationType] = r
+	}
+
+	prefs := make([]*usersv1.NotificationPreference, len(notificationTypes))
+	for i, nt := range notificationTypes {
+		pref := &usersv1.NotificationPreference{
+			NotificationType: nt.proto,
+			EmailEnabled:     true,
+			PushEnabled:      true,
+		}
+		if r, ok := stored[nt.db]; ok {
+			pref.EmailEnabled = r.EmailEnabled
+			pref.PushEnabled = r.PushEnabled
+		}
+		prefs[i] = pref
+	}
+	return prefs, nil
+}
+
+// requireSelfOrSystemAdmin lets users act on their own account and admins on anyone's
+func (s *UsersService) requireSelfOrSystemAdmin(ctx context.Context, userID int32, action string) error {
+	kratosID := auth.GetUserID(ctx)
+	if kratosID == "" {
+		return connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	user, err := s.queries.GetUser(ctx, userID)
+	if err != nil {
+		return dberrors.MapNotFound(err, "user not found")
+	}
+
+	if user.KratosID.Valid && user.KratosID.String == kratosID {
+		return nil
+	}
+	return s.requireSystemAdmin(ctx, action)
+}
@@ -0,0 +1,305 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
+	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/clientip"
+	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/dberrors"
+	"github.com/studyverse/ems-backend/internal/logging"
+)
+
+const (
+	inquiryRateLimitWindow = 5 * time.Minute
+
+	// InquiryWebhookEvent is enqueued for every inquiry to a club with a contact
+	// email, so a mail relay subscribed to it can forward the message
+	InquiryWebhookEvent = "organization.inquiry"
+)
+
+// inquiryWebhookPayload is the body of an InquiryWebhookEvent delivery
+type inquiryWebhookPayload struct {
+	InquiryID         int32  `json:"inquiryId"`
+	OrganizationID    int32  `json:"organizationId"`
+	OrganizationTitle string `json:"organizationTitle"`
+	ContactEmail      string `json:"contactEmail"`
+	SenderEmail       string `json:"senderEmail"`
+	SenderName        string `json:"senderName"`
+	Subject           string `json:"subject"`
+	Message           string `json:"message"`
+	CreatedAt         string `json:"createdAt"`
+}
+
+// SubmitOrganizationInquiry stores a message from the public contact form.
+// Each client IP and each sender email may submit once per
+// inquiryRateLimitWindow.
+func (s *OrganizationsService) SubmitOrganizationInquiry(ctx context.Context, req *connect.Request[eventsv1.SubmitOrganizationInquiryRequest]) (*connect.Response[eventsv1.SubmitOrganizationInquiryResponse], error) {
+	logging.WithContext(ctx).Debug("SubmitOrganizationInquiry", "organizationId", req.Msg.OrganizationId)
+
+	senderEmail := strings.TrimSpace(req.Msg.SenderEmail)
+	senderName := strings.TrimSpace(req.Msg.SenderName)
+	subject := strings.TrimSpace(req.Msg.Subject)
+	message := strings.TrimSpace(req.Msg.Message)
+
+	org, err := s.queries.GetOrganization(ctx, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, dberrors.Map(err)
+	}
+
+	// Key on both the client and the sender so neither rotating addresses nor
+	// rotating emails gets around the limit
+	ipKey := "ip:" + inquiryClientIP(ctx, req.Peer().Addr)
+	emailKey := "email:" + strings.ToLower(senderEmail)
+	if !s.inquiryLimiter.allow(time.Now(), ipKey, emailKey) {
+		return nil, connect.NewError(connect.CodeResourceExhausted, errors.New("too many inquiries, please try again later"))
+	}
+
+	inquiry, err := s.queries.CreateOrgInquiry(ctx, db.CreateOrgInquiryParams{
+		OrgID:       org.ID,
+		SenderEmail: senderEmail,
+		SenderName:  senderName,
+		Subject:     subject,
+		Message:     message,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if s.webhooks != nil && org.ContactEmail.Valid && org.ContactEmail.String != "" {
+		if err := s.webhooks.Enqueue(ctx, InquiryWebhookEvent, inquiryWebhookPayload{
+			InquiryID:         inquiry.ID,
+			OrganizationID:    org.ID,
+			OrganizationTitle: org.Title,
+			ContactEmail:      org.ContactEmail.String,
+			SenderEmail:       inquiry.SenderEmail,
+			SenderName:        inquiry.SenderName,
+			Subject:           inquiry.Subject,
+			Message:           inquiry.Message,
+			CreatedAt:         inquiry.CreatedAt.Time.Format(time.RFC3339),
+		}); err != nil {
+			// The inquiry is stored and visible to the club, forwarding is best effort
+			logging.WithContext(ctx).Warn("Failed to queue inquiry forwarding", "inquiryId", inquiry.ID, "error", err)
+		}
+	}
+
+	recordAudit(ctx, s.queries, req, "org_inquiry", inquiry.ID)
+
+	return connect.NewResponse(&eventsv1.SubmitOrganizationInquiryResponse{
+		InquiryId: inquiry.ID,
+	}), nil
+}
+
+func (s *OrganizationsService) ListOrganizationInquiries(ctx context.Context, req *connect.Request[eventsv1.ListOrganizationInquiriesRequest]) (*connect.Response[eventsv1.ListOrganizationInquiriesResponse], error) {
+	logging.WithContext(ctx).Debug("ListOrganizationInquiries", "organizationId", req.Msg.OrganizationId, "page", req.Msg.Page, "limit", req.Msg.Limit)
+
+	if err := s.requireManageSettings(ctx, req.Msg.OrganizationId, "manage inquiries for this organization"); err != nil {
+		return nil, err
+	}
+
+	page := req.Msg.Page
+	if page <= 0 {
+		page = 1
+	}
+	limit := req.Msg.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+	limit = min(limit, int32(s.cfg.MaxPageSize))
+
+	inquiries, err := s.queries.ListOrgInquiries(ctx, db.ListOrgInquiriesParams{
+		OrgID:  req.Msg.OrganizationId,
+		Limit:  limit,
+		Offset: (page - 1) * limit,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	total, err := s.queries.CountOrgInquiries(ctx, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoInquiries := make([]*eventsv1.OrganizationInquiry, len(inquiries))
+	for i, inquiry := range inquiries {
+		protoInquiries[i] = dbOrgInquiryToProto(inquiry)
+	}
+
+	return connect.NewResponse(&eventsv1.ListOrganizationInquiriesResponse{
+		Inquiries: protoInquiries,
+		Total:     int32(total),
+	}), nil
+}
+
+func (s *OrganizationsService) UpdateInquiryStatus(ctx context.Context, req *connect.Request[eventsv1.UpdateInquiryStatusRequest]) (*connect.Response[eventsv1.UpdateInquiryStatusResponse], error) {
+	logging.WithContext(ctx).Debug("UpdateInquiryStatus", "inquiryId", req.Msg.InquiryId, "status", req.Msg.Status)
+
+	status, ok := protoInquiryStatusToDB(req.Msg.Status)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("status is required"))
+	}
+
+	inquiry, err := s.queries.GetOrgInquiry(ctx, req.Msg.InquiryId)
+	if err != nil {
+		return nil, dberrors.Map(err)
+	}
+
+	if err := s.requireManageSettings(ctx, inquiry.OrgID, "manage inquiries for this organization"); err != nil {
+		return nil, err
+	}
+
+	inquiry, err = s.queries.UpdateOrgInquiryStatus(ctx, db.UpdateOrgInquiryStatusParams{
+		ID:     inquiry.ID,
+		Status: status,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	recordAudit(ctx, s.queries, req, "org_inquiry", inquiry.ID)
+
+	return connect.NewResponse(&eventsv1.UpdateInquiryStatusResponse{
+		Inquiry: dbOrgInquiryToProto(inquiry),
+	}), nil
+}
+
+// requireManageSettings checks that the caller has manage_settings on the club
+func (s *OrganizationsService) requireManageSettings(ctx context.Context, orgID int32, action string) error {
+	userID := auth.GetUserID(ctx)
+	if userID == "" {
+		return connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	if s.perms != nil {
+		allowed, err := s.perms.CheckPermission(ctx, userID, "club", fmt.Sprintf("%d", orgID), "manage_settings")
+		if err != nil {
+			logging.WithContext(ctx).Warn("Permission check failed", "error", err)
+		}
+		if !allowed {
+			return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("you don't have permission to %s", action))
+		}
+	}
+
+	return nil
+}
+
+// inquiryClientIP returns the caller's IP as resolved by the clientip
+// middleware, falling back to the connect peer address
+func inquiryClientIP(ctx context.Context, peerAddr string) string {
+	if ip := clientip.FromContext(ctx); ip != "" {
+		return ip
+	}
+	if host, _, err := net.SplitHostPort(peerAddr); err == nil {
+		return host
+	}
+	return peerAddr
+}
+
+// inquiryRateLimiter allows one submission per key within the window
+type inquiryRateLimiter struct {
+	window time.Duration
+	mu     sync.Mutex
+	last   map[string]time.Time
+}
+
+func newInquiryRateLimiter(window time.Duration) *inquiryRateLimiter {
+	return &inquiryRateLimiter{window: window, last: make(map[string]time.Time)}
+}
+
+// allow reports whether a submission identified by all of keys may go ahead
+// now. It is refused if any key submitted within the window; otherwise every
+// key is recorded.
+func (l *inquiryRateLimiter) allow(now time.Time, keys ...string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, key := range keys {
+		if last, ok := l.last[key]; ok && now.Sub(last) < l.window {
+			return false
+		}
+	}
+	for _, key := range keys {
+		l.last[key] = now
+	}
+	return true
+}
+
+// sweep drops entries whose window has passed
+func (l *inquiryRateLimiter) sweep(now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for k, t := range l.last {
+		if now.Sub(t) >= l.window {
+			delete(l.last, k)
+		}
+	}
+}
+
+// RunInquiryLimiterSweeper evicts expired inquiry rate limit entries until
+// ctx is cancelled, so the limiter's map doesn't grow without bound.
+func (s *OrganizationsService) RunInquiryLimiterSweeper(ctx context.Context) {
+	ticker := time.NewTicker(inquiryRateLimitWindow)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.inquiryLimiter.sweep(now)
+		}
+	}
+}
+
+func dbOrgInquiryToProto(i db.OrgInquiry) *eventsv1.OrganizationInquiry {
+	return &eventsv1.OrganizationInquiry{
+		Id:             i.ID,
+		OrganizationId: i.OrgID,
+		SenderEmail:    i.SenderEmail,
+		SenderName:     i.SenderName,
+		Subject:        i.Subject,
+		Message:        i.Message,
+		Status:         dbInquiryStatusToProto(i.Status),
+		CreatedAt:      i.CreatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+func dbInquiryStatusToProto(status db.InquiryStatus) eventsv1.InquiryStatus {
+	switch status {
+	case db.InquiryStatusOpen:
+		return eventsv1.InquiryStatus_INQUIRY_STATUS_OPEN
+	case db.InquiryStatusInProgress:
+		return eventsv1.InquiryStatus_INQUIRY_STATUS_IN_PROGRESS
+	case db.InquiryStatusResolved:
+		return eventsv1.InquiryStatus_INQUIRY_STATUS_RESOLVED
+	case db.InquiryStatusSpam:
+		return eventsv1.InquiryStatus_INQUIRY_STATUS_SPAM
+	default:
+		return eventsv1.InquiryStatus_INQUIRY_STATUS_UNSPECIFIED
+	}
+}
+
+func protoInquiryStatusToDB(status eventsv1.InquiryStatus) (db.InquiryStatus, bool) {
+	switch status {
+	case eventsv1.InquiryStatus_INQUIRY_STATUS_OPEN:
+		return db.InquiryStatusOpen, true
+	case eventsv1.InquiryStatus_INQUIRY_STATUS_IN_PROGRESS:
+		return db.InquiryStatusInProgress, true
+	case eventsv1.InquiryStatus_INQUIRY_STATUS_RESOLVED:
+		return db.InquiryStatusResolved, true
+	case eventsv1.InquiryStatus_INQUIRY_STATUS_SPAM:
+		return db.InquiryStatusSpam, true
+	default:
+		return "", false
+	}
+}
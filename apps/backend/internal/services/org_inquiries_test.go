@@ -0,0 +1,42 @@
+package services
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInquiryRateLimiter(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	l := newInquiryRateLimiter(5 * time.Minute)
+
+	if !l.allow(start, "ip:1.2.3.4", "email:a@example.com") {
+		t.Fatal("first submission refused")
+	}
+	if l.allow(start.Add(time.Minute), "ip:1.2.3.4", "email:b@example.com") {
+		t.Error("same IP with a new email allowed within the window")
+	}
+	if l.allow(start.Add(time.Minute), "ip:5.6.7.8", "email:a@example.com") {
+		t.Error("same email from a new IP allowed within the window")
+	}
+	if !l.allow(start.Add(time.Minute), "ip:5.6.7.8", "email:b@example.com") {
+		t.Error("unrelated submission refused")
+	}
+	if !l.allow(start.Add(5*time.Minute), "ip:1.2.3.4", "email:a@example.com") {
+		t.Error("submission refused after the window passed")
+	}
+}
+
+func TestInquiryRateLimiterSweep(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	l := newInquiryRateLimiter(5 * time.Minute)
+	l.allow(start, "ip:1.2.3.4")
+	l.allow(start.Add(3*time.Minute), "ip:5.6.7.8")
+
+	l.sweep(start.Add(6 * time.Minute))
+	if _, ok := l.last["ip:1.2.3.4"]; ok {
+		t.Error("expired entry kept after sweep")
+	}
+	if _, ok := l.last["ip:5.6.7.8"]; !ok {
+		t.Error("live entry dropped by sweep")
+	}
+}
@@ -0,0 +1,125 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"connectrpc.com/connect"
+	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
+	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/dberrors"
+	"github.com/studyverse/ems-backend/internal/logging"
+	"github.com/studyverse/ems-backend/internal/search"
+)
+
+// MergeOrganizations consolidates a duplicate club into another one. Club
+// roles of the source are left as they are.
+func (s *OrganizationsService) MergeOrganizations(ctx context.Context, req *connect.Request[eventsv1.MergeOrganizationsRequest]) (*connect.Response[eventsv1.MergeOrganizationsResponse], error) {
+	logging.WithContext(ctx).Debug("MergeOrganizations", "sourceId", req.Msg.SourceId, "targetId", req.Msg.TargetId)
+
+	userID := auth.GetUserID(ctx)
+	if userID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	if err := requirePlatformPermission(ctx, s.perms, userID, "manage_clubs", "merge organizations"); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to begin transaction: %w", err))
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := s.queries.WithTx(tx)
+
+	// Lock both organizations in ID order so opposite merges can't deadlock
+	orgIDs := []int32{req.Msg.SourceId, req.Msg.TargetId}
+	if orgIDs[0] > orgIDs[1] {
+		orgIDs[0], orgIDs[1] = orgIDs[1], orgIDs[0]
+	}
+	orgs := make(map[int32]db.Organization, 2)
+	for _, id := range orgIDs {
+		org, err := qtx.LockOrganization(ctx, id)
+		if err != nil {
+			return nil, dberrors.MapNotFound(err, fmt.Sprintf("organization %d not found", id))
+		}
+		orgs[id] = org
+	}
+	target := orgs[req.Msg.TargetId]
+
+	events, err := qtx.MoveOrganizationEvents(ctx, db.MoveOrganizationEventsParams{
+		SourceID: req.Msg.SourceId,
+		TargetID: req.Msg.TargetId,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to move events: %w", err))
+	}
+
+	archived, err := qtx.UpdateOrganization(ctx, db.UpdateOrganizationParams{
+		ID:     req.Msg.SourceId,
+		Status: db.NullOrganizationStatus{OrganizationStatus: db.OrganizationStatusArchived, Valid: true},
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to archive organization: %w", err))
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to commit transaction: %w", err))
+	}
+
+	if s.perms != nil {
+		eventIDs := make([]string, len(events))
+		for i, e := range events {
+			eventIDs[i] = strconv.Itoa(int(e.ID))
+		}
+		if err := s.perms.MergeClubs(ctx, strconv.Itoa(int(archived.ID)), strconv.Itoa(int(target.ID)), eventIDs); err != nil {
+			logging.WithContext(ctx).Warn("Failed to merge clubs in SpiceDB", "error", err, "sourceId", archived.ID, "targetId", target.ID)
+		}
+	}
+
+	s.reindexOrganization(ctx, archived)
+	s.reindexMovedEvents(ctx, events)
+
+	recordAudit(ctx, s.queries, req, "organization", req.Msg.SourceId)
+
+	return connect.NewResponse(&eventsv1.MergeOrganizationsResponse{
+		ArchivedOrg: dbOrganizationToProto(archived),
+		TargetOrg:   dbOrganizationToProto(target),
+		EventsMoved: int32(len(events)),
+	}), nil
+}
+
+// reindexMovedEvents re-indexes the searchable moved events under their new
+// organization with one call (async, don't block response)
+func (s *OrganizationsService) reindexMovedEvents(ctx context.Context, events []db.Event) {
+	if s.search == nil {
+		return
+	}
+	go func() {
+		bg := context.Background()
+
+		// Unpublished and deleted events aren't in the index
+		var indexed []db.Event
+		for _, e := range events {
+			if e.Published && !e.IsDeleted {
+				indexed = append(indexed, e)
+			}
+		}
+		if len(indexed) == 0 {
+			return
+		}
+
+		docs, err := search.BuildEventDocuments(bg, s.queries, indexed)
+		if err != nil {
+			logging.WithContext(ctx).Warn("Failed to build search documents of moved events", "error", err)
+			return
+		}
+		if err := s.search.IndexEvents(bg, docs); err != nil {
+			logging.WithContext(ctx).Warn("Failed to re-index moved events in search", "error", err, "count", len(docs))
+		}
+	}()
+}
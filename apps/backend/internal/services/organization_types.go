@@ -2,57 +2,60 @@ package services
 
 import (
 	"context"
-	"log/slog"
 	"time"
 
 	"connectrpc.com/connect"
-	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
 	"github.com/studyverse/ems-backend/gen/eventsv1/eventsv1connect"
+	"github.com/studyverse/ems-backend/internal/config"
 	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/dberrors"
+	"github.com/studyverse/ems-backend/internal/logging"
+	"github.com/studyverse/ems-backend/internal/search"
 )
 
 type OrganizationTypesService struct {
 	eventsv1connect.UnimplementedOrganizationTypesServiceHandler
 	queries *db.Queries
+	search  *search.Client
+	cfg     *config.Config
 }
 
-func NewOrganizationTypesService(queries *db.Queries) *OrganizationTypesService {
-	return &OrganizationTypesService{queries: queries}
+func NewOrganizationTypesService(queries *db.Queries, searchClient *search.Client, cfg *config.Config) *OrganizationTypesService {
+	return &OrganizationTypesService{queries: queries, search: searchClient, cfg: cfg}
 }
 
 func (s *OrganizationTypesService) CreateOrganizationType(ctx context.Context, req *connect.Request[eventsv1.CreateOrganizationTypeRequest]) (*connect.Response[eventsv1.CreateOrganizationTypeResponse], error) {
-	slog.Debug("CreateOrganizationType", "title", req.Msg.Title)
+	logging.WithContext(ctx).Debug("CreateOrganizationType", "title", req.Msg.Title)
 
 	ot, err := s.queries.CreateOrganizationType(ctx, req.Msg.Title)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	s.indexOrganizationType(ctx, ot)
+
 	return connect.NewResponse(&eventsv1.CreateOrganizationTypeResponse{
 		OrganizationType: dbOrganizationTypeToProto(ot),
 	}), nil
 }
 
 func (s *OrganizationTypesService) GetOrganizationType(ctx context.Context, req *connect.Request[eventsv1.GetOrganizationTypeRequest]) (*connect.Response[eventsv1.GetOrganizationTypeResponse], error) {
-	slog.Debug("GetOrganizationType", "id", req.Msg.Id)
+	logging.WithContext(ctx).Debug("GetOrganizationType", "id", req.Msg.Id)
 
-	ot, err := s.queries.GetOrganizationType(ctx, req.Msg.Id)
+	row, err := s.queries.GetOrganizationTypeWithStats(ctx, req.Msg.Id)
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return nil, connect.NewError(connect.CodeNotFound, nil)
-		}
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, dberrors.Map(err)
 	}
 
 	return connect.NewResponse(&eventsv1.GetOrganizationTypeResponse{
-		OrganizationType: dbOrganizationTypeToProto(ot),
+		OrganizationType: dbOrganizationTypeWithStatsToProto(row.OrganizationType, row.OrganizationCount, row.EventCount),
 	}), nil
 }
 
 func (s *OrganizationTypesService) ListOrganizationTypes(ctx context.Context, req *connect.Request[eventsv1.ListOrganizationTypesRequest]) (*connect.Response[eventsv1.ListOrganizationTypesResponse], error) {
-	slog.Debug("ListOrganizationTypes", "page", req.Msg.Page, "limit", req.Msg.Limit)
+	logging.WithContext(ctx).Debug("ListOrganizationTypes", "page", req.Msg.Page, "limit", req.Msg.Limit)
 
 	page := req.Msg.Page
 	if page <= 0 {
@@ -62,8 +65,9 @@ func (s *OrganizationTypesService) ListOrganizationTypes(ctx context.Context, re
 	if limit <= 0 {
 		limit = 10
 	}
+	limit = min(limit, int32(s.cfg.MaxPageSize))
 
-	types, err := s.queries.ListOrganizationTypes(ctx, db.ListOrganizationTypesParams{
+	rows, err := s.queries.ListOrganizationTypesWithStats(ctx, db.ListOrganizationTypesWithStatsParams{
 		Limit:  limit,
 		Offset: (page - 1) * limit,
 	})
@@ -76,9 +80,9 @@ func (s *OrganizationTypesService) ListOrganizationTypes(ctx context.Context, re
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	protoTypes := make([]*eventsv1.OrganizationType, len(types))
-	for i, t := range types {
-		protoTypes[i] = dbOrganizationTypeToProto(t)
+	protoTypes := make([]*eventsv1.OrganizationType, len(rows))
+	for i, row := range rows {
+		protoTypes[i] = dbOrganizationTypeWithStatsToProto(row.OrganizationType, row.OrganizationCount, row.EventCount)
 	}
 
 	return connect.NewResponse(&eventsv1.ListOrganizationTypesResponse{
@@ -88,7 +92,7 @@ func (s *OrganizationTypesService) ListOrganizationTypes(ctx context.Context, re
 }
 
 func (s *OrganizationTypesService) UpdateOrganizationType(ctx context.Context, req *connect.Request[eventsv1.UpdateOrganizationTypeRequest]) (*connect.Response[eventsv1.UpdateOrganizationTypeResponse], error) {
-	slog.Debug("UpdateOrganizationType", "id", req.Msg.Id)
+	logging.WithContext(ctx).Debug("UpdateOrganizationType", "id", req.Msg.Id)
 
 	params := db.UpdateOrganizationTypeParams{
 		ID: req.Msg.Id,
@@ -99,30 +103,56 @@ func (s *OrganizationTypesService) UpdateOrganizationType(ctx context.Context, r
 
 	ot, err := s.queries.UpdateOrganizationType(ctx, params)
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return nil, connect.NewError(connect.CodeNotFound, nil)
-		}
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, dberrors.Map(err)
 	}
 
+	s.indexOrganizationType(ctx, ot)
+
 	return connect.NewResponse(&eventsv1.UpdateOrganizationTypeResponse{
 		OrganizationType: dbOrganizationTypeToProto(ot),
 	}), nil
 }
 
 func (s *OrganizationTypesService) DeleteOrganizationType(ctx context.Context, req *connect.Request[eventsv1.DeleteOrganizationTypeRequest]) (*connect.Response[eventsv1.DeleteOrganizationTypeResponse], error) {
-	slog.Debug("DeleteOrganizationType", "id", req.Msg.Id)
+	logging.WithContext(ctx).Debug("DeleteOrganizationType", "id", req.Msg.Id)
 
 	err := s.queries.DeleteOrganizationType(ctx, req.Msg.Id)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	// Remove from Meilisearch (async, don't block response)
+	if s.search != nil {
+		orgTypeID := req.Msg.Id
+		go func() {
+			if err := s.search.DeleteDocument(context.Background(), search.IndexOrganizationTypes, orgTypeID); err != nil {
+				logging.WithContext(ctx).Warn("Failed to delete organization type from search", "error", err, "organizationTypeId", orgTypeID)
+			}
+		}()
+	}
+
 	return connect.NewResponse(&eventsv1.DeleteOrganizationTypeResponse{
 		Success: true,
 	}), nil
 }
 
+// indexOrganizationType adds or updates ot in Meilisearch (async, don't block response)
+func (s *OrganizationTypesService) indexOrganizationType(ctx context.Context, ot db.OrganizationType) {
+	if s.search == nil {
+		return
+	}
+	go func() {
+		doc := &search.OrganizationTypeDocument{
+			ID:        ot.ID,
+			Title:     ot.Title,
+			CreatedAt: ot.CreatedAt.Time.Format(time.RFC3339),
+		}
+		if err := s.search.IndexOrganizationType(context.Background(), doc); err != nil {
+			logging.WithContext(ctx).Warn("Failed to index organization type in search", "error", err, "organizationTypeId", ot.ID)
+		}
+	}()
+}
+
 func dbOrganizationTypeToProto(ot db.OrganizationType) *eventsv1.OrganizationType {
 	return &eventsv1.OrganizationType{
 		Id:        ot.ID,
@@ -131,3 +161,10 @@ func dbOrganizationTypeToProto(ot db.OrganizationType) *eventsv1.OrganizationTyp
 		UpdatedAt: ot.UpdatedAt.Time.Format(time.RFC3339),
 	}
 }
+
+func dbOrganizationTypeWithStatsToProto(ot db.OrganizationType, organizationCount, eventCount int32) *eventsv1.OrganizationType {
+	protoType := dbOrganizationTypeToProto(ot)
+	protoType.OrganizationCount = organizationCount
+	protoType.TotalEvents = eventCount
+	return protoType
+}
@@ -2,33 +2,51 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log/slog"
+	"net/mail"
+	"strconv"
 
 	"connectrpc.com/connect"
-	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
 	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
 	"github.com/studyverse/ems-backend/gen/eventsv1/eventsv1connect"
 	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/config"
 	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/dberrors"
+	"github.com/studyverse/ems-backend/internal/logging"
 	"github.com/studyverse/ems-backend/internal/perms"
 	"github.com/studyverse/ems-backend/internal/search"
+	"github.com/studyverse/ems-backend/internal/webhooks"
 )
 
 type OrganizationsService struct {
 	eventsv1connect.UnimplementedOrganizationsServiceHandler
-	queries *db.Queries
-	perms   *perms.Client
-	search  *search.Client
+	queries        *db.Queries
+	pool           *pgxpool.Pool
+	perms          *perms.Client
+	search         *search.Client
+	webhooks       *webhooks.Dispatcher
+	inquiryLimiter *inquiryRateLimiter
+	cfg            *config.Config
 }
 
-func NewOrganizationsService(queries *db.Queries, permsClient *perms.Client, searchClient *search.Client) *OrganizationsService {
-	return &OrganizationsService{queries: queries, perms: permsClient, search: searchClient}
+func NewOrganizationsService(queries *db.Queries, pool *pgxpool.Pool, permsClient *perms.Client, searchClient *search.Client, webhookDispatcher *webhooks.Dispatcher, cfg *config.Config) *OrganizationsService {
+	return &OrganizationsService{
+		queries:        queries,
+		pool:           pool,
+		perms:          permsClient,
+		search:         searchClient,
+		webhooks:       webhookDispatcher,
+		inquiryLimiter: newInquiryRateLimiter(inquiryRateLimitWindow),
+		cfg:            cfg,
+	}
 }
 
 func (s *OrganizationsService) CreateOrganization(ctx context.Context, req *connect.Request[eventsv1.CreateOrganizationRequest]) (*connect.Response[eventsv1.CreateOrganizationResponse], error) {
-	slog.Debug("CreateOrganization", "title", req.Msg.Title)
+	logging.WithContext(ctx).Debug("CreateOrganization", "title", req.Msg.Title)
 
 	// Authorization: Check if user can create organizations (platform admin/staff)
 	userID := auth.GetUserID(ctx)
@@ -36,14 +54,8 @@ func (s *OrganizationsService) CreateOrganization(ctx context.Context, req *conn
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
 	}
 
-	if s.perms != nil {
-		allowed, err := s.perms.CheckPermission(ctx, userID, "platform", "astanait", "manage_clubs")
-		if err != nil {
-			slog.Warn("Permission check failed", "error", err)
-		}
-		if !allowed {
-			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("you don't have permission to create organizations"))
-		}
+	if err := requirePlatformPermission(ctx, s.perms, userID, "manage_clubs", "create organizations"); err != nil {
+		return nil, err
 	}
 
 	params := db.CreateOrganizationParams{
@@ -89,11 +101,11 @@ func (s *OrganizationsService) CreateOrganization(ctx context.Context, req *conn
 	if s.perms != nil {
 		clubID := fmt.Sprintf("%d", created.ID)
 		if err := s.perms.LinkClubToPlatform(ctx, clubID); err != nil {
-			slog.Warn("Failed to link club to platform in SpiceDB", "error", err, "clubId", clubID)
+			logging.WithContext(ctx).Warn("Failed to link club to platform in SpiceDB", "error", err, "clubId", clubID)
 		}
 		// Make the creator an admin of this club
 		if err := s.perms.SetupClubRelationship(ctx, clubID, userID, "president"); err != nil {
-			slog.Warn("Failed to setup club admin relationship in SpiceDB", "error", err, "clubId", clubID)
+			logging.WithContext(ctx).Warn("Failed to setup club admin relationship in SpiceDB", "error", err, "clubId", clubID)
 		}
 	}
 
@@ -114,25 +126,24 @@ func (s *OrganizationsService) CreateOrganization(ctx context.Context, req *conn
 				doc.ImageURL = created.ImageUrl.String
 			}
 			if err := s.search.IndexOrganization(context.Background(), doc); err != nil {
-				slog.Warn("Failed to index organization in search", "error", err, "orgId", created.ID)
+				logging.WithContext(ctx).Warn("Failed to index organization in search", "error", err, "orgId", created.ID)
 			}
 		}()
 	}
 
+	recordAudit(ctx, s.queries, req, "organization", created.ID)
+
 	return connect.NewResponse(&eventsv1.CreateOrganizationResponse{
 		Organization: dbOrganizationToProto(created),
 	}), nil
 }
 
 func (s *OrganizationsService) GetOrganization(ctx context.Context, req *connect.Request[eventsv1.GetOrganizationRequest]) (*connect.Response[eventsv1.GetOrganizationResponse], error) {
-	slog.Debug("GetOrganization", "id", req.Msg.Id)
+	logging.WithContext(ctx).Debug("GetOrganization", "id", req.Msg.Id)
 
 	org, err := s.queries.GetOrganization(ctx, req.Msg.Id)
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return nil, connect.NewError(connect.CodeNotFound, nil)
-		}
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, dberrors.Map(err)
 	}
 
 	return connect.NewResponse(&eventsv1.GetOrganizationResponse{
@@ -141,7 +152,7 @@ func (s *OrganizationsService) GetOrganization(ctx context.Context, req *connect
 }
 
 func (s *OrganizationsService) ListOrganizations(ctx context.Context, req *connect.Request[eventsv1.ListOrganizationsRequest]) (*connect.Response[eventsv1.ListOrganizationsResponse], error) {
-	slog.Debug("ListOrganizations", "page", req.Msg.Page, "limit", req.Msg.Limit)
+	logging.WithContext(ctx).Debug("ListOrganizations", "page", req.Msg.Page, "limit", req.Msg.Limit, "status", req.Msg.StatusFilter, "organizationTypeId", req.Msg.OrganizationTypeId)
 
 	page := req.Msg.Page
 	if page <= 0 {
@@ -151,16 +162,32 @@ func (s *OrganizationsService) ListOrganizations(ctx context.Context, req *conne
 	if limit <= 0 {
 		limit = 10
 	}
+	limit = min(limit, int32(s.cfg.MaxPageSize))
+
+	// UNSPECIFIED status means no status filter
+	var status db.NullOrganizationStatus
+	if req.Msg.GetStatusFilter() != eventsv1.OrganizationStatus_ORGANIZATION_STATUS_UNSPECIFIED {
+		status = db.NullOrganizationStatus{OrganizationStatus: protoStatusToDB(req.Msg.GetStatusFilter()), Valid: true}
+	}
+	var orgTypeID pgtype.Int4
+	if req.Msg.OrganizationTypeId != nil {
+		orgTypeID = pgtype.Int4{Int32: *req.Msg.OrganizationTypeId, Valid: true}
+	}
 
 	orgs, err := s.queries.ListOrganizations(ctx, db.ListOrganizationsParams{
-		Limit:  limit,
-		Offset: (page - 1) * limit,
+		Limit:              limit,
+		Offset:             (page - 1) * limit,
+		Status:             status,
+		OrganizationTypeID: orgTypeID,
 	})
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	total, err := s.queries.CountOrganizations(ctx)
+	total, err := s.queries.CountOrganizations(ctx, db.CountOrganizationsParams{
+		Status:             status,
+		OrganizationTypeID: orgTypeID,
+	})
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
@@ -177,7 +204,7 @@ func (s *OrganizationsService) ListOrganizations(ctx context.Context, req *conne
 }
 
 func (s *OrganizationsService) UpdateOrganization(ctx context.Context, req *connect.Request[eventsv1.UpdateOrganizationRequest]) (*connect.Response[eventsv1.UpdateOrganizationResponse], error) {
-	slog.Debug("UpdateOrganization", "id", req.Msg.Id)
+	logging.WithContext(ctx).Debug("UpdateOrganization", "id", req.Msg.Id)
 
 	// Authorization: Check if user can edit this club
 	userID := auth.GetUserID(ctx)
@@ -189,7 +216,7 @@ func (s *OrganizationsService) UpdateOrganization(ctx context.Context, req *conn
 		clubID := fmt.Sprintf("%d", req.Msg.Id)
 		allowed, err := s.perms.CheckPermission(ctx, userID, "club", clubID, "edit")
 		if err != nil {
-			slog.Warn("Permission check failed", "error", err)
+			logging.WithContext(ctx).Warn("Permission check failed", "error", err)
 		}
 		if !allowed {
 			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("you don't have permission to edit this organization"))
@@ -236,44 +263,67 @@ func (s *OrganizationsService) UpdateOrganization(ctx context.Context, req *conn
 	if req.Msg.Status != nil {
 		params.Status = db.NullOrganizationStatus{OrganizationStatus: protoStatusToDB(*req.Msg.Status), Valid: true}
 	}
+	if req.Msg.MonthlyEventQuota != nil || req.Msg.ClearMonthlyEventQuota {
+		// Quotas are a platform control, club presidents can't lift their own
+		if err := requirePlatformPermission(ctx, s.perms, userID, "manage_system", "change the event quota"); err != nil {
+			return nil, err
+		}
+		if req.Msg.MonthlyEventQuota != nil {
+			if *req.Msg.MonthlyEventQuota < 0 {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("monthly_event_quota must not be negative"))
+			}
+			params.MonthlyEventQuota = pgtype.Int4{Int32: *req.Msg.MonthlyEventQuota, Valid: true}
+		}
+		params.ClearMonthlyEventQuota = req.Msg.ClearMonthlyEventQuota
+	}
+	if req.Msg.ContactEmail != nil {
+		if _, err := mail.ParseAddress(*req.Msg.ContactEmail); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("contact_email must be a valid email address"))
+		}
+		params.ContactEmail = pgtype.Text{String: *req.Msg.ContactEmail, Valid: true}
+	}
 
 	org, err := s.queries.UpdateOrganization(ctx, params)
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return nil, connect.NewError(connect.CodeNotFound, nil)
-		}
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, dberrors.Map(err)
 	}
 
-	// Re-index organization in Meilisearch (async, don't block response)
-	if s.search != nil {
-		go func() {
-			doc := &search.OrganizationDocument{
-				ID:                 org.ID,
-				Title:              org.Title,
-				OrganizationTypeID: org.OrganizationTypeID,
-				Status:             string(org.Status.OrganizationStatus),
-				CreatedAt:          org.CreatedAt.Time.Format("2006-01-02T15:04:05Z07:00"),
-			}
-			if org.Description.Valid {
-				doc.Description = org.Description.String
-			}
-			if org.ImageUrl.Valid {
-				doc.ImageURL = org.ImageUrl.String
-			}
-			if err := s.search.IndexOrganization(context.Background(), doc); err != nil {
-				slog.Warn("Failed to re-index organization in search", "error", err, "orgId", org.ID)
-			}
-		}()
-	}
+	s.reindexOrganization(ctx, org)
+
+	recordAudit(ctx, s.queries, req, "organization", org.ID)
 
 	return connect.NewResponse(&eventsv1.UpdateOrganizationResponse{
 		Organization: dbOrganizationToProto(org),
 	}), nil
 }
 
+// reindexOrganization replaces the organization's search document (async, don't block response)
+func (s *OrganizationsService) reindexOrganization(ctx context.Context, org db.Organization) {
+	if s.search == nil {
+		return
+	}
+	go func() {
+		doc := &search.OrganizationDocument{
+			ID:                 org.ID,
+			Title:              org.Title,
+			OrganizationTypeID: org.OrganizationTypeID,
+			Status:             string(org.Status.OrganizationStatus),
+			CreatedAt:          org.CreatedAt.Time.Format("2006-01-02T15:04:05Z07:00"),
+		}
+		if org.Description.Valid {
+			doc.Description = org.Description.String
+		}
+		if org.ImageUrl.Valid {
+			doc.ImageURL = org.ImageUrl.String
+		}
+		if err := s.search.IndexOrganization(context.Background(), doc); err != nil {
+			logging.WithContext(ctx).Warn("Failed to re-index organization in search", "error", err, "orgId", org.ID)
+		}
+	}()
+}
+
 func (s *OrganizationsService) DeleteOrganization(ctx context.Context, req *connect.Request[eventsv1.DeleteOrganizationRequest]) (*connect.Response[eventsv1.DeleteOrganizationResponse], error) {
-	slog.Debug("DeleteOrganization", "id", req.Msg.Id)
+	logging.WithContext(ctx).Debug("DeleteOrganization", "id", req.Msg.Id)
 
 	// Authorization: Only platform admins can delete organizations
 	userID := auth.GetUserID(ctx)
@@ -281,14 +331,8 @@ func (s *OrganizationsService) DeleteOrganization(ctx context.Context, req *conn
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
 	}
 
-	if s.perms != nil {
-		allowed, err := s.perms.CheckPermission(ctx, userID, "platform", "astanait", "manage_clubs")
-		if err != nil {
-			slog.Warn("Permission check failed", "error", err)
-		}
-		if !allowed {
-			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("you don't have permission to delete organizations"))
-		}
+	if err := requirePlatformPermission(ctx, s.perms, userID, "manage_clubs", "delete organizations"); err != nil {
+		return nil, err
 	}
 
 	err := s.queries.DeleteOrganization(ctx, req.Msg.Id)
@@ -301,26 +345,34 @@ func (s *OrganizationsService) DeleteOrganization(ctx context.Context, req *conn
 		orgID := req.Msg.Id
 		go func() {
 			if err := s.search.DeleteDocument(context.Background(), search.IndexOrganizations, orgID); err != nil {
-				slog.Warn("Failed to delete organization from search", "error", err, "orgId", orgID)
+				logging.WithContext(ctx).Warn("Failed to delete organization from search", "error", err, "orgId", orgID)
 			}
 		}()
 	}
 
+	recordAudit(ctx, s.queries, req, "organization", req.Msg.Id)
+
 	return connect.NewResponse(&eventsv1.DeleteOrganizationResponse{
 		Success: true,
 	}), nil
 }
 
 func (s *OrganizationsService) GetPublishableOrganizations(ctx context.Context, req *connect.Request[eventsv1.GetPublishableOrganizationsRequest]) (*connect.Response[eventsv1.GetPublishableOrganizationsResponse], error) {
-	slog.Debug("GetPublishableOrganizations", "userId", req.Msg.UserId)
+	logging.WithContext(ctx).Debug("GetPublishableOrganizations", "userId", req.Msg.UserId)
 
-	roles := []string{"President", "Staff"}
-	orgs, err := s.queries.GetOrganizationsByUserRoles(ctx, db.GetOrganizationsByUserRolesParams{
-		UserID: req.Msg.UserId,
-		Roles:  roles,
-	})
+	orgs, err := s.lookupUserClubs(ctx, req.Msg.UserId, "create_event")
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		if s.perms != nil {
+			logging.WithContext(ctx).Warn("SpiceDB club lookup failed, falling back to user_roles", "error", err, "userId", req.Msg.UserId)
+		}
+		roles := []string{"President", "Staff"}
+		orgs, err = s.queries.GetOrganizationsByUserRoles(ctx, db.GetOrganizationsByUserRolesParams{
+			UserID: req.Msg.UserId,
+			Roles:  roles,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
 	}
 
 	protoOrgs := make([]*eventsv1.Organization, len(orgs))
@@ -334,15 +386,21 @@ func (s *OrganizationsService) GetPublishableOrganizations(ctx context.Context,
 }
 
 func (s *OrganizationsService) GetUserOrganizations(ctx context.Context, req *connect.Request[eventsv1.GetUserOrganizationsRequest]) (*connect.Response[eventsv1.GetUserOrganizationsResponse], error) {
-	slog.Debug("GetUserOrganizations", "userId", req.Msg.UserId)
+	logging.WithContext(ctx).Debug("GetUserOrganizations", "userId", req.Msg.UserId)
 
-	roles := []string{"President", "Staff", "Member"}
-	orgs, err := s.queries.GetOrganizationsByUserRoles(ctx, db.GetOrganizationsByUserRolesParams{
-		UserID: req.Msg.UserId,
-		Roles:  roles,
-	})
+	orgs, err := s.lookupUserClubs(ctx, req.Msg.UserId, "view")
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		if s.perms != nil {
+			logging.WithContext(ctx).Warn("SpiceDB club lookup failed, falling back to user_roles", "error", err, "userId", req.Msg.UserId)
+		}
+		roles := []string{"President", "Staff", "Member"}
+		orgs, err = s.queries.GetOrganizationsByUserRoles(ctx, db.GetOrganizationsByUserRolesParams{
+			UserID: req.Msg.UserId,
+			Roles:  roles,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
 	}
 
 	protoOrgs := make([]*eventsv1.Organization, len(orgs))
@@ -355,6 +413,141 @@ func (s *OrganizationsService) GetUserOrganizations(ctx context.Context, req *co
 	}), nil
 }
 
+// GetOrganizationQuotaUsage reports how much of its monthly event quota an organization has used
+func (s *OrganizationsService) GetOrganizationQuotaUsage(ctx context.Context, req *connect.Request[eventsv1.GetOrganizationQuotaUsageRequest]) (*connect.Response[eventsv1.GetOrganizationQuotaUsageResponse], error) {
+	logging.WithContext(ctx).Debug("GetOrganizationQuotaUsage", "organizationId", req.Msg.OrganizationId)
+
+	userID := auth.GetUserID(ctx)
+	if userID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	if s.perms != nil {
+		clubID := fmt.Sprintf("%d", req.Msg.OrganizationId)
+		allowed, err := s.perms.CheckPermission(ctx, userID, "club", clubID, "view_analytics")
+		if err != nil {
+			logging.WithContext(ctx).Warn("Permission check failed", "error", err)
+		}
+		if !allowed {
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("you don't have permission to view this organization's quota"))
+		}
+	}
+
+	org, err := s.queries.GetOrganization(ctx, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, dberrors.Map(err)
+	}
+
+	used, err := s.queries.CountOrganizationEventsThisMonth(ctx, org.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &eventsv1.GetOrganizationQuotaUsageResponse{
+		Used: int32(used),
+	}
+	if org.MonthlyEventQuota.Valid {
+		quota := org.MonthlyEventQuota.Int32
+		remaining := quota - int32(used)
+		if remaining < 0 {
+			remaining = 0
+		}
+		resp.Quota = &quota
+		resp.Remaining = &remaining
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// GetOrganizationMembers lists the users holding a role in a club
+func (s *OrganizationsService) GetOrganizationMembers(ctx context.Context, req *connect.Request[eventsv1.GetOrganizationMembersRequest]) (*connect.Response[eventsv1.GetOrganizationMembersResponse], error) {
+	logging.WithContext(ctx).Debug("GetOrganizationMembers", "organizationId", req.Msg.OrganizationId, "page", req.Msg.Page, "limit", req.Msg.Limit)
+
+	if err := s.requireManageSettings(ctx, req.Msg.OrganizationId, "view this organization's members"); err != nil {
+		return nil, err
+	}
+
+	page := req.Msg.Page
+	if page <= 0 {
+		page = 1
+	}
+	limit := req.Msg.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+	limit = min(limit, int32(s.cfg.MaxPageSize))
+
+	members, err := s.queries.ListOrganizationMembers(ctx, db.ListOrganizationMembersParams{
+		OrganizationID: req.Msg.OrganizationId,
+		Limit:          limit,
+		Offset:         (page - 1) * limit,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	total, err := s.queries.CountOrganizationMembers(ctx, req.Msg.OrganizationId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoMembers := make([]*eventsv1.OrganizationMember, len(members))
+	for i, m := range members {
+		protoMembers[i] = &eventsv1.OrganizationMember{
+			UserId:   m.UserID,
+			Username: m.Username,
+			Email:    m.Email,
+			Role:     m.RoleName,
+		}
+	}
+
+	return connect.NewResponse(&eventsv1.GetOrganizationMembersResponse{
+		Members: protoMembers,
+		Total:   int32(total),
+	}), nil
+}
+
+// errNoPermsClient signals that club lookups must use the user_roles table
+var errNoPermsClient = errors.New("spicedb client not configured")
+
+// lookupUserClubs returns the clubs on which the user has the given permission
+// according to SpiceDB, which is authoritative for club membership
+func (s *OrganizationsService) lookupUserClubs(ctx context.Context, userID int32, permission string) ([]db.Organization, error) {
+	if s.perms == nil {
+		return nil, errNoPermsClient
+	}
+
+	user, err := s.queries.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	// Relationships are written with the Kratos identity when there is one
+	subjectID := fmt.Sprintf("%d", user.ID)
+	if user.KratosID.Valid {
+		subjectID = user.KratosID.String
+	}
+
+	clubIDs, err := s.perms.LookupResources(ctx, subjectID, "club", permission)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int32, 0, len(clubIDs))
+	for _, clubID := range clubIDs {
+		id, err := strconv.ParseInt(clubID, 10, 32)
+		if err != nil {
+			logging.WithContext(ctx).Warn("Ignoring non-numeric club ID from SpiceDB", "clubId", clubID)
+			continue
+		}
+		ids = append(ids, int32(id))
+	}
+	if len(ids) == 0 {
+		return []db.Organization{}, nil
+	}
+
+	return s.queries.GetOrganizationsByIDs(ctx, ids)
+}
+
 func protoStatusToDB(status eventsv1.OrganizationStatus) db.OrganizationStatus {
 	switch status {
 	case eventsv1.OrganizationStatus_ORGANIZATION_STATUS_ARCHIVED:
@@ -366,8 +559,7 @@ func protoStatusToDB(status eventsv1.OrganizationStatus) db.OrganizationStatus {
 	}
 }
 
-// protoStatusToString converts a proto organization status to a string
-// nolint:unused
+// protoStatusToString converts a proto organization status to its search index value
 func protoStatusToString(status eventsv1.OrganizationStatus) string {
 	switch status {
 	case eventsv1.OrganizationStatus_ORGANIZATION_STATUS_ARCHIVED:
@@ -0,0 +1,141 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	permissionsv1 "github.com/studyverse/ems-backend/gen/permissionsv1"
+	"github.com/studyverse/ems-backend/gen/permissionsv1/permissionsv1connect"
+	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/dberrors"
+	"github.com/studyverse/ems-backend/internal/logging"
+	"github.com/studyverse/ems-backend/internal/perms"
+)
+
+// PermissionsService exposes SpiceDB permissions to the frontend, so it can
+// decide what to show without stitching several RPCs together
+type PermissionsService struct {
+	permissionsv1connect.UnimplementedPermissionsServiceHandler
+	queries *db.Queries
+	perms   *perms.Client
+}
+
+func NewPermissionsService(queries *db.Queries, permsClient *perms.Client) *PermissionsService {
+	return &PermissionsService{queries: queries, perms: permsClient}
+}
+
+// GetUserPermissions returns the platform flags and managed clubs of a user
+func (s *PermissionsService) GetUserPermissions(ctx context.Context, req *connect.Request[permissionsv1.GetUserPermissionsRequest]) (*connect.Response[permissionsv1.GetUserPermissionsResponse], error) {
+	logging.WithContext(ctx).Debug("GetUserPermissions", "userId", req.Msg.GetUserId())
+
+	kratosID := auth.GetUserID(ctx)
+	if kratosID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	if s.perms == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("authorization service is unavailable"))
+	}
+
+	var user db.User
+	var err error
+	if req.Msg.UserId == nil {
+		user, err = s.queries.GetUserByKratosID(ctx, pgtype.Text{String: kratosID, Valid: true})
+	} else {
+		user, err = s.queries.GetUser(ctx, *req.Msg.UserId)
+	}
+	if err != nil {
+		return nil, dberrors.MapNotFound(err, "user not found")
+	}
+
+	// SpiceDB subjects are Kratos identity IDs, falling back to the local ID
+	subjectID := strconv.Itoa(int(user.ID))
+	if user.KratosID.Valid {
+		subjectID = user.KratosID.String
+	}
+
+	if subjectID != kratosID {
+		if err := requirePlatformPermission(ctx, s.perms, kratosID, "manage_system", "view this user's permissions"); err != nil {
+			return nil, err
+		}
+	}
+
+	p, err := s.perms.GetUserPermissions(ctx, subjectID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get user permissions: %w", err))
+	}
+
+	return connect.NewResponse(&permissionsv1.GetUserPermissionsResponse{
+		Permissions: &permissionsv1.UserPermissions{
+			UserId:         user.ID,
+			IsAdmin:        p.IsAdmin,
+			IsGlobalStaff:  p.IsGlobalStaff,
+			ManagedClubIds: parseClubIDs(ctx, p.ManagedClubs),
+		},
+	}), nil
+}
+
+// parseClubIDs converts SpiceDB club IDs back to local IDs, skipping any
+// that aren't numeric
+func parseClubIDs(ctx context.Context, ids []string) []int32 {
+	clubIDs := make([]int32, 0, len(ids))
+	for _, id := range ids {
+		clubID, err := strconv.Atoi(id)
+		if err != nil {
+			logging.WithContext(ctx).Warn("Skipping non-numeric club ID from SpiceDB", "clubId", id)
+			continue
+		}
+		clubIDs = append(clubIDs, int32(clubID))
+	}
+	return clubIDs
+}
+
+// CheckPermission checks one permission of the authenticated user. It is
+// meant for pre-flight UI checks; handlers still enforce their own.
+func (s *PermissionsService) CheckPermission(ctx context.Context, req *connect.Request[permissionsv1.CheckPermissionRequest]) (*connect.Response[permissionsv1.CheckPermissionResponse], error) {
+	logging.WithContext(ctx).Debug("CheckPermission", "resourceType", req.Msg.ResourceType, "resourceId", req.Msg.ResourceId, "permission", req.Msg.Permission)
+
+	userID := auth.GetUserID(ctx)
+	if userID == "" {
+		return connect.NewResponse(&permissionsv1.CheckPermissionResponse{Allowed: false}), nil
+	}
+
+	if s.perms == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("authorization service is unavailable"))
+	}
+
+	allowed, err := s.perms.CheckPermission(ctx, userID, req.Msg.ResourceType, req.Msg.ResourceId, req.Msg.Permission)
+	if err != nil {
+		logging.WithContext(ctx).Warn("Permission check failed", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("permission check failed: %w", err))
+	}
+
+	return connect.NewResponse(&permissionsv1.CheckPermissionResponse{
+		Allowed: allowed,
+	}), nil
+}
+
+// requirePlatformPermission checks that userID holds a platform-wide
+// permission such as manage_system or manage_clubs. These guard admin-only
+// data and actions, so unlike per-resource checks they fail closed when
+// SpiceDB isn't configured.
+func requirePlatformPermission(ctx context.Context, permsClient *perms.Client, userID, permission, action string) error {
+	if permsClient == nil {
+		return connect.NewError(connect.CodeUnavailable, errors.New("authorization service is unavailable"))
+	}
+
+	allowed, err := permsClient.CheckPermission(ctx, userID, "platform", perms.PlatformID, permission)
+	if err != nil {
+		logging.WithContext(ctx).Warn("Permission check failed", "error", err)
+	}
+	if !allowed {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("you don't have permission to %s", action))
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
+	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/dberrors"
+	"github.com/studyverse/ems-backend/internal/logging"
+	"github.com/studyverse/ems-backend/internal/metrics"
+	"github.com/studyverse/ems-backend/internal/notify"
+)
+
+// notifyBatchSize is the most recipients posted to the relay at once
+const notifyBatchSize = 50
+
+// NotifyRegistrants emails an announcement to everyone registered for the
+// event through the notification relay. A batch the relay rejects is
+// reported in errors and the remaining batches are still sent.
+func (s *EventRegistrationsService) NotifyRegistrants(ctx context.Context, req *connect.Request[eventsv1.NotifyRegistrantsRequest]) (*connect.Response[eventsv1.NotifyRegistrantsResponse], error) {
+	logging.WithContext(ctx).Debug("NotifyRegistrants", "eventId", req.Msg.EventId, "dryRun", req.Msg.DryRun)
+
+	userID := auth.GetUserID(ctx)
+	if userID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	event, err := s.queries.GetEvent(ctx, req.Msg.EventId)
+	if err != nil {
+		return nil, dberrors.MapNotFound(err, "event not found")
+	}
+
+	if s.perms != nil {
+		clubID := fmt.Sprintf("%d", event.OrganizationID)
+		allowed, err := s.perms.CheckPermission(ctx, userID, "club", clubID, "create_event")
+		if err != nil {
+			logging.WithContext(ctx).Warn("Permission check failed", "error", err)
+		}
+		if !allowed {
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("you don't have permission to notify this event's registrants"))
+		}
+	}
+
+	if !req.Msg.DryRun && s.notifier == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("notifications are not configured"))
+	}
+
+	emails, err := s.queries.GetRegistrantEmails(ctx, event.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if req.Msg.DryRun {
+		return connect.NewResponse(&eventsv1.NotifyRegistrantsResponse{
+			EmailsSent: int32(len(emails)),
+			Recipients: emails,
+		}), nil
+	}
+
+	resp := sendNotificationBatches(ctx, s.notifier, event.ID, emails, notify.Email{
+		Subject: req.Msg.Subject,
+		Body:    req.Msg.Body,
+	})
+
+	logging.WithContext(ctx).Info("Registrants notified", "eventId", event.ID, "sent", resp.EmailsSent, "failedBatches", len(resp.Errors))
+
+	return connect.NewResponse(resp), nil
+}
+
+// sendNotificationBatches sends email to emails in batches of notifyBatchSize,
+// recording a failed batch in the response's errors and moving on
+func sendNotificationBatches(ctx context.Context, notifier *notify.Client, eventID int32, emails []string, email notify.Email) *eventsv1.NotifyRegistrantsResponse {
+	resp := &eventsv1.NotifyRegistrantsResponse{}
+	for start := 0; start < len(emails); start += notifyBatchSize {
+		batch := emails[start:min(start+notifyBatchSize, len(emails))]
+		email.To = batch
+		if err := notifier.Send(ctx, email); err != nil {
+			logging.WithContext(ctx).Warn("Failed to send registrant notification batch", "error", err, "eventId", eventID, "batchStart", start)
+			resp.Errors = append(resp.Errors, fmt.Sprintf("recipients %d-%d: %v", start+1, start+len(batch), err))
+			continue
+		}
+		resp.EmailsSent += int32(len(batch))
+		metrics.NotificationsSentTotal.Add(float64(len(batch)))
+	}
+	return resp
+}
@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/studyverse/ems-backend/internal/notify"
+)
+
+// fakeRelay records the emails posted to it and rejects the batches whose
+// first recipient is in fail
+func fakeRelay(t *testing.T, fail map[string]bool) (*httptest.Server, func() []notify.Email) {
+	t.Helper()
+	var mu sync.Mutex
+	var received []notify.Email
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var email notify.Email
+		if err := json.NewDecoder(r.Body).Decode(&email); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		received = append(received, email)
+		mu.Unlock()
+		if len(email.To) > 0 && fail[email.To[0]] {
+			http.Error(w, "mailbox full", http.StatusServiceUnavailable)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, func() []notify.Email {
+		mu.Lock()
+		defer mu.Unlock()
+		return received
+	}
+}
+
+func registrantEmails(n int) []string {
+	emails := make([]string, n)
+	for i := range emails {
+		emails[i] = fmt.Sprintf("student%d@astanait.edu.kz", i+1)
+	}
+	return emails
+}
+
+func TestSendNotificationBatches(t *testing.T) {
+	tests := []struct {
+		name        string
+		recipients  int
+		wantBatches []int
+	}{
+		{"no registrants", 0, nil},
+		{"one partial batch", 7, []int{7}},
+		{"exactly one batch", notifyBatchSize, []int{50}},
+		{"several batches", 120, []int{50, 50, 20}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, received := fakeRelay(t, nil)
+			emails := registrantEmails(tt.recipients)
+
+			resp := sendNotificationBatches(context.Background(), notify.NewClient(server.URL), 1, emails, notify.Email{Subject: "Moved", Body: "Room 101"})
+			if resp.EmailsSent != int32(tt.recipients) || len(resp.Errors) != 0 {
+				t.Errorf("sent %d with errors %q, want %d sent", resp.EmailsSent, resp.Errors, tt.recipients)
+			}
+
+			got := received()
+			if len(got) != len(tt.wantBatches) {
+				t.Fatalf("relay got %d batches, want %d", len(got), len(tt.wantBatches))
+			}
+			next := 0
+			for i, email := range got {
+				if len(email.To) != tt.wantBatches[i] {
+					t.Errorf("batch %d has %d recipients, want %d", i+1, len(email.To), tt.wantBatches[i])
+				}
+				if email.To[0] != emails[next] {
+					t.Errorf("batch %d starts with %s, want %s", i+1, email.To[0], emails[next])
+				}
+				if email.Subject != "Moved" || email.Body != "Room 101" {
+					t.Errorf("batch %d = %q/%q, want the announcement", i+1, email.Subject, email.Body)
+				}
+				next += len(email.To)
+			}
+		})
+	}
+}
+
+func TestSendNotificationBatchesPartialFailure(t *testing.T) {
+	emails := registrantEmails(120)
+	server, received := fakeRelay(t, map[string]bool{emails[50]: true})
+
+	resp := sendNotificationBatches(context.Background(), notify.NewClient(server.URL), 1, emails, notify.Email{Subject: "Moved", Body: "Room 101"})
+	if len(received()) != 3 {
+		t.Errorf("relay got %d batches, want the batch after the failure sent too", len(received()))
+	}
+	if resp.EmailsSent != 70 {
+		t.Errorf("EmailsSent = %d, want 70", resp.EmailsSent)
+	}
+	if len(resp.Errors) != 1 {
+		t.Fatalf("errors = %q, want one for the failed batch", resp.Errors)
+	}
+	if !strings.HasPrefix(resp.Errors[0], "recipients 51-100: ") || !strings.Contains(resp.Errors[0], "503") {
+		t.Errorf("error = %q, want the failed recipients and relay status", resp.Errors[0])
+	}
+}
@@ -0,0 +1,241 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
+	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/dberrors"
+	"github.com/studyverse/ems-backend/internal/logging"
+)
+
+const (
+	defaultHoldDuration = 5 * time.Minute
+	maxHoldDuration     = time.Hour
+	holdPurgeInterval   = 30 * time.Second
+)
+
+// HoldEventRegistration reserves a seat on an event for a limited time.
+// The hold counts against capacity until it is confirmed or expires.
+func (s *EventRegistrationsService) HoldEventRegistration(ctx context.Context, req *connect.Request[eventsv1.HoldEventRegistrationRequest]) (*connect.Response[eventsv1.HoldEventRegistrationResponse], error) {
+	logging.WithContext(ctx).Debug("HoldEventRegistration", "eventId", req.Msg.EventId, "userId", req.Msg.UserId, "holdDurationSeconds", req.Msg.HoldDurationSeconds)
+
+	kratosID := auth.GetUserID(ctx)
+	if kratosID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+	caller, err := s.queries.GetUserByKratosID(ctx, pgtype.Text{String: kratosID, Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodePermissionDenied, errors.New("you can only hold seats for yourself"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	userID, err := holdUserID(caller.ID, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	duration := defaultHoldDuration
+	if req.Msg.HoldDurationSeconds > 0 {
+		duration = time.Duration(req.Msg.HoldDurationSeconds) * time.Second
+	}
+	if duration > maxHoldDuration {
+		duration = maxHoldDuration
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := s.queries.WithTx(tx)
+
+	// Lock the event so concurrent holds see each other's rows
+	if _, err := qtx.LockEventForRegistration(ctx, req.Msg.EventId); err != nil {
+		return nil, dberrors.Map(err)
+	}
+
+	existing, err := qtx.GetEventRegistrationByEventAndUser(ctx, db.GetEventRegistrationByEventAndUserParams{
+		EventID: req.Msg.EventId,
+		UserID:  userID,
+	})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if err == nil && existing.Status != db.RegistrationStatusCancelled {
+		return nil, connect.NewError(connect.CodeAlreadyExists, nil)
+	}
+
+	available, err := qtx.CountAvailableSlots(ctx, req.Msg.EventId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// A user gets one seat per event: a retry returns the hold they already
+	// have instead of stacking another one until the event is full
+	active, err := qtx.GetActiveRegistrationHold(ctx, db.GetActiveRegistrationHoldParams{
+		EventID: req.Msg.EventId,
+		UserID:  userID,
+	})
+	if err == nil {
+		return connect.NewResponse(&eventsv1.HoldEventRegistrationResponse{
+			Hold:           dbRegistrationHoldToProto(active),
+			AvailableSlots: available,
+		}), nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if available == 0 {
+		return nil, connect.NewError(connect.CodeResourceExhausted, errors.New("event is at full capacity"))
+	}
+
+	hold, err := qtx.CreateRegistrationHold(ctx, db.CreateRegistrationHoldParams{
+		EventID:   req.Msg.EventId,
+		UserID:    userID,
+		ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(duration), Valid: true},
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if available != db.UnlimitedSlots {
+		available--
+	}
+
+	return connect.NewResponse(&eventsv1.HoldEventRegistrationResponse{
+		Hold:           dbRegistrationHoldToProto(hold),
+		AvailableSlots: available,
+	}), nil
+}
+
+// holdUserID returns who a hold is for. Holds always belong to the caller,
+// otherwise anyone could fill an event with holds for other users; the
+// request's user_id is only accepted when it names the caller.
+func holdUserID(callerID, requestedID int32) (int32, error) {
+	if requestedID != 0 && requestedID != callerID {
+		return 0, connect.NewError(connect.CodePermissionDenied, errors.New("you can only hold seats for yourself"))
+	}
+	return callerID, nil
+}
+
+// ConfirmRegistration converts an unexpired hold into a registration
+func (s *EventRegistrationsService) ConfirmRegistration(ctx context.Context, req *connect.Request[eventsv1.ConfirmRegistrationRequest]) (*connect.Response[eventsv1.ConfirmRegistrationResponse], error) {
+	logging.WithContext(ctx).Debug("ConfirmRegistration", "holdId", req.Msg.HoldId)
+
+	kratosID := auth.GetUserID(ctx)
+	if kratosID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+	caller, err := s.queries.GetUserByKratosID(ctx, pgtype.Text{String: kratosID, Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodePermissionDenied, errors.New("you can only confirm your own holds"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := s.queries.WithTx(tx)
+
+	hold, err := qtx.GetRegistrationHoldForUpdate(ctx, req.Msg.HoldId)
+	if err != nil {
+		return nil, dberrors.MapNotFound(err, "hold not found or already released")
+	}
+	if hold.UserID != caller.ID {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("you can only confirm your own holds"))
+	}
+	if !hold.ExpiresAt.Time.After(time.Now()) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("hold has expired"))
+	}
+
+	if err := qtx.DeleteRegistrationHold(ctx, hold.ID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// The seat was already reserved by the hold, so capacity is not rechecked
+	reg, err := qtx.UpsertEventRegistration(ctx, db.UpsertEventRegistrationParams{
+		EventID: hold.EventID,
+		UserID:  hold.UserID,
+		Status:  db.RegistrationStatusRegistered,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("already registered for this event"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	cancelURL, err := s.issueCancelLink(ctx, qtx, reg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	reindexEventCounts(ctx, s.queries, s.search, reg.EventID)
+
+	resp := &eventsv1.ConfirmRegistrationResponse{
+		Registration: dbEventRegistrationToProto(reg),
+	}
+	if cancelURL != "" {
+		resp.CancelUrl = &cancelURL
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// RunHoldPurger deletes expired holds until ctx is cancelled.
+// Expired holds already stop counting against capacity; purging keeps the table small.
+func (s *EventRegistrationsService) RunHoldPurger(ctx context.Context) {
+	ticker := time.NewTicker(holdPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		purged, err := s.queries.PurgeExpiredRegistrationHolds(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				slog.Warn("Failed to purge expired registration holds", "error", err)
+			}
+			continue
+		}
+		if purged > 0 {
+			slog.Debug("Purged expired registration holds", "count", purged)
+		}
+	}
+}
+
+func dbRegistrationHoldToProto(h db.RegistrationHold) *eventsv1.RegistrationHold {
+	return &eventsv1.RegistrationHold{
+		Id:        h.ID,
+		EventId:   h.EventID,
+		UserId:    h.UserID,
+		ExpiresAt: h.ExpiresAt.Time.Format(time.RFC3339),
+		CreatedAt: h.CreatedAt.Time.Format(time.RFC3339),
+	}
+}
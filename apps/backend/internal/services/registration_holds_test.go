@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
+)
+
+func TestHoldUserID(t *testing.T) {
+	tests := []struct {
+		name      string
+		requested int32
+		want      int32
+		wantCode  connect.Code // 0 means no error
+	}{
+		{"no user given holds for the caller", 0, 7, 0},
+		{"the caller's own ID", 7, 7, 0},
+		{"someone else is refused", 8, 0, connect.CodePermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := holdUserID(7, tt.requested)
+			if tt.wantCode != 0 {
+				if connect.CodeOf(err) != tt.wantCode {
+					t.Fatalf("holdUserID(7, %d) error = %v, want code %v", tt.requested, err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("holdUserID(7, %d) error = %v", tt.requested, err)
+			}
+			if got != tt.want {
+				t.Errorf("holdUserID(7, %d) = %d, want %d", tt.requested, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHoldEventRegistrationRequiresAuthentication(t *testing.T) {
+	s := &EventRegistrationsService{}
+	_, err := s.HoldEventRegistration(context.Background(), connect.NewRequest(&eventsv1.HoldEventRegistrationRequest{
+		EventId: 1,
+		UserId:  2,
+	}))
+	if connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("HoldEventRegistration without a session error = %v, want unauthenticated", err)
+	}
+}
@@ -0,0 +1,61 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
+	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/dberrors"
+	"github.com/studyverse/ems-backend/internal/logging"
+)
+
+// GetEventRegistrationStatus reports a user's registration state for an
+// event, NOT_REGISTERED when there is no registration row at all. Users can
+// look up themselves, event managers anyone.
+func (s *EventRegistrationsService) GetEventRegistrationStatus(ctx context.Context, req *connect.Request[eventsv1.GetEventRegistrationStatusRequest]) (*connect.Response[eventsv1.GetEventRegistrationStatusResponse], error) {
+	logging.WithContext(ctx).Debug("GetEventRegistrationStatus", "eventId", req.Msg.EventId, "userId", req.Msg.UserId)
+
+	kratosID := auth.GetUserID(ctx)
+	if kratosID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	user, err := s.queries.GetUser(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, dberrors.MapNotFound(err, "user not found")
+	}
+
+	isSelf := user.KratosID.Valid && user.KratosID.String == kratosID
+	if !isSelf && s.perms != nil {
+		allowed, err := s.perms.CheckPermission(ctx, kratosID, "event", fmt.Sprintf("%d", req.Msg.EventId), "manage_registrations")
+		if err != nil {
+			logging.WithContext(ctx).Warn("Permission check failed", "error", err)
+		}
+		if !allowed {
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("you don't have permission to view this user's registration"))
+		}
+	}
+
+	reg, err := s.queries.GetEventRegistrationByEventAndUser(ctx, db.GetEventRegistrationByEventAndUserParams{
+		EventID: req.Msg.EventId,
+		UserID:  user.ID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return connect.NewResponse(&eventsv1.GetEventRegistrationStatusResponse{
+				Status: eventsv1.RegistrationStatus_REGISTRATION_STATUS_NOT_REGISTERED,
+			}), nil
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&eventsv1.GetEventRegistrationStatusResponse{
+		Status:       dbRegistrationStatusToProto(reg.Status),
+		Registration: dbEventRegistrationToProto(reg),
+	}), nil
+}
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
+	"github.com/studyverse/ems-backend/internal/db"
+)
+
+func TestDBRegistrationStatusToProto(t *testing.T) {
+	tests := []struct {
+		status db.RegistrationStatus
+		want   eventsv1.RegistrationStatus
+	}{
+		{db.RegistrationStatusRegistered, eventsv1.RegistrationStatus_REGISTRATION_STATUS_REGISTERED},
+		{db.RegistrationStatusCancelled, eventsv1.RegistrationStatus_REGISTRATION_STATUS_CANCELLED},
+		{db.RegistrationStatusWaitlist, eventsv1.RegistrationStatus_REGISTRATION_STATUS_WAITLIST},
+		{"", eventsv1.RegistrationStatus_REGISTRATION_STATUS_UNSPECIFIED},
+		{"pending", eventsv1.RegistrationStatus_REGISTRATION_STATUS_UNSPECIFIED},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			got := dbRegistrationStatusToProto(tt.status)
+			if got != tt.want {
+				t.Errorf("dbRegistrationStatusToProto(%q) = %v, want %v", tt.status, got, tt.want)
+			}
+			// NOT_REGISTERED means there is no row, so no stored status maps to it
+			if got == eventsv1.RegistrationStatus_REGISTRATION_STATUS_NOT_REGISTERED {
+				t.Errorf("stored status %q reported as not registered", tt.status)
+			}
+			if tt.want != eventsv1.RegistrationStatus_REGISTRATION_STATUS_UNSPECIFIED && protoRegistrationStatusToDB(got) != tt.status {
+				t.Errorf("%q doesn't round-trip, got %q back", tt.status, protoRegistrationStatusToDB(got))
+			}
+		})
+	}
+}
+
+func TestGetEventRegistrationStatusRequiresAuthentication(t *testing.T) {
+	s := &EventRegistrationsService{}
+	_, err := s.GetEventRegistrationStatus(context.Background(), connect.NewRequest(&eventsv1.GetEventRegistrationStatusRequest{
+		EventId: 1,
+		UserId:  2,
+	}))
+	if connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("GetEventRegistrationStatus without a session error = %v, want unauthenticated", err)
+	}
+}
@@ -3,12 +3,19 @@ package services
 import (
 	"context"
 	"fmt"
-	"log/slog"
+	"strconv"
+	"strings"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/meilisearch/meilisearch-go"
+	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
 	searchv1 "github.com/studyverse/ems-backend/gen/searchv1"
 	"github.com/studyverse/ems-backend/gen/searchv1/searchv1connect"
+	"github.com/studyverse/ems-backend/internal/config"
 	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/logging"
+	"github.com/studyverse/ems-backend/internal/perms"
 	"github.com/studyverse/ems-backend/internal/search"
 )
 
@@ -17,22 +24,30 @@ type SearchService struct {
 	searchClient  *search.Client
 	searchIndexer *search.Indexer
 	queries       *db.Queries
+	perms         *perms.Client
+	suggestions   *suggestionCache
+	memberClubs   *memberClubCache
+	cfg           *config.Config
 }
 
-func NewSearchService(searchClient *search.Client, queries *db.Queries) *SearchService {
+func NewSearchService(searchClient *search.Client, queries *db.Queries, permsClient *perms.Client, cfg *config.Config) *SearchService {
 	var indexer *search.Indexer
 	if searchClient != nil {
-		indexer = search.NewIndexer(searchClient, queries)
+		indexer = search.NewIndexer(searchClient, queries, permsClient)
 	}
 	return &SearchService{
 		searchClient:  searchClient,
 		searchIndexer: indexer,
 		queries:       queries,
+		perms:         permsClient,
+		suggestions:   newSuggestionCache(suggestionCacheTTL, suggestionCacheSize),
+		memberClubs:   newMemberClubCache(memberClubCacheTTL),
+		cfg:           cfg,
 	}
 }
 
 func (s *SearchService) GlobalSearch(ctx context.Context, req *connect.Request[searchv1.GlobalSearchRequest]) (*connect.Response[searchv1.GlobalSearchResponse], error) {
-	slog.Debug("GlobalSearch", "query", req.Msg.Query, "limit", req.Msg.Limit)
+	logging.WithContext(ctx).Debug("GlobalSearch", "query", req.Msg.Query, "limit", req.Msg.Limit, "limitPerIndex", req.Msg.LimitPerIndex)
 
 	if s.searchClient == nil {
 		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("search service not available"))
@@ -40,49 +55,44 @@ func (s *SearchService) GlobalSearch(ctx context.Context, req *connect.Request[s
 
 	if req.Msg.Query == "" {
 		return connect.NewResponse(&searchv1.GlobalSearchResponse{
-			Results: []*searchv1.SearchResult{},
-			Query:   req.Msg.Query,
+			Results:      []*searchv1.SearchResult{},
+			IndexResults: []*searchv1.IndexResult{},
+			Query:        req.Msg.Query,
 		}), nil
 	}
 
+	// Legacy callers only send limit; everyone else gets limit_per_index (default 3)
 	limit := req.Msg.Limit
-	if limit <= 0 {
-		limit = 10
+	limitPerIndex := req.Msg.LimitPerIndex
+	if limitPerIndex <= 0 && limit <= 0 {
+		limitPerIndex = 3
 	}
+	limit = min(limit, int32(s.cfg.MaxPageSize))
+	limitPerIndex = min(limitPerIndex, int32(s.cfg.MaxPageSize))
 
-	result, err := s.searchClient.GlobalSearch(ctx, req.Msg.Query, limit)
+	result, err := s.searchClient.GlobalSearch(ctx, req.Msg.Query, limit, limitPerIndex)
 	if err != nil {
-		slog.Error("GlobalSearch failed", "error", err)
+		logging.WithContext(ctx).Error("GlobalSearch failed", "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("search failed: %w", err))
 	}
 
 	// Convert internal results to proto
 	protoResults := make([]*searchv1.SearchResult, len(result.Results))
 	for i, r := range result.Results {
-		resultType := searchv1.SearchResultType_SEARCH_RESULT_TYPE_UNSPECIFIED
-		switch r.Type {
-		case search.IndexEvents:
-			resultType = searchv1.SearchResultType_SEARCH_RESULT_TYPE_EVENT
-		case search.IndexOrganizations:
-			resultType = searchv1.SearchResultType_SEARCH_RESULT_TYPE_ORGANIZATION
-		case search.IndexUsers:
-			resultType = searchv1.SearchResultType_SEARCH_RESULT_TYPE_USER
-		case search.IndexTags:
-			resultType = searchv1.SearchResultType_SEARCH_RESULT_TYPE_TAG
-		}
+		protoResults[i] = searchResultToProto(r)
+	}
 
-		protoResult := &searchv1.SearchResult{
-			Type:  resultType,
-			Id:    r.ID,
-			Title: r.Title,
+	indexResults := make([]*searchv1.IndexResult, len(result.Indexes))
+	for i, idx := range result.Indexes {
+		hits := make([]*searchv1.SearchResult, len(idx.Results))
+		for j, r := range idx.Results {
+			hits[j] = searchResultToProto(r)
 		}
-		if r.Description != "" {
-			protoResult.Description = &r.Description
+		indexResults[i] = &searchv1.IndexResult{
+			IndexName: idx.IndexName,
+			HitCount:  idx.HitCount,
+			Results:   hits,
 		}
-		if r.ImageURL != "" {
-			protoResult.ImageUrl = &r.ImageURL
-		}
-		protoResults[i] = protoResult
 	}
 
 	return connect.NewResponse(&searchv1.GlobalSearchResponse{
@@ -90,11 +100,12 @@ func (s *SearchService) GlobalSearch(ctx context.Context, req *connect.Request[s
 		TotalHits:        result.TotalHits,
 		ProcessingTimeMs: result.TimeMs,
 		Query:            result.Query,
+		IndexResults:     indexResults,
 	}), nil
 }
 
 func (s *SearchService) SearchEvents(ctx context.Context, req *connect.Request[searchv1.SearchEventsRequest]) (*connect.Response[searchv1.SearchEventsResponse], error) {
-	slog.Debug("SearchEvents", "query", req.Msg.Query, "limit", req.Msg.Limit)
+	logging.WithContext(ctx).Debug("SearchEvents", "query", req.Msg.Query, "limit", req.Msg.Limit, "tagIds", req.Msg.TagIds, "tagFilterMode", req.Msg.TagFilterMode, "sortBy", req.Msg.SortBy, "startAfter", req.Msg.GetStartAfter(), "endBefore", req.Msg.GetEndBefore(), "format", req.Msg.GetFormat())
 
 	if s.searchClient == nil {
 		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("search service not available"))
@@ -110,29 +121,128 @@ func (s *SearchService) SearchEvents(ctx context.Context, req *connect.Request[s
 	if limit <= 0 {
 		limit = 10
 	}
+	limit = min(limit, int32(s.cfg.MaxPageSize))
 
 	// Build filters
-	filters := ""
+	var conditions []string
 	if req.Msg.OrganizationId != nil {
-		filters = fmt.Sprintf("organizationId = %d", *req.Msg.OrganizationId)
+		conditions = append(conditions, fmt.Sprintf("organizationId = %d", *req.Msg.OrganizationId))
+	}
+	if req.Msg.OrganizationTypeId != nil {
+		conditions = append(conditions, fmt.Sprintf("organizationTypeId = %d", *req.Msg.OrganizationTypeId))
+	}
+	if len(req.Msg.TagIds) > 0 {
+		conditions = append(conditions, tagFilter(req.Msg.TagIds, req.Msg.TagFilterMode))
+	}
+	// Both bounds were checked by SearchEventsRequest.Validate
+	if req.Msg.StartAfter != nil {
+		startAfter, _ := time.Parse(time.RFC3339, *req.Msg.StartAfter)
+		conditions = append(conditions, fmt.Sprintf("startTimestamp >= %d", startAfter.Unix()))
+	}
+	if req.Msg.EndBefore != nil {
+		endBefore, _ := time.Parse(time.RFC3339, *req.Msg.EndBefore)
+		conditions = append(conditions, fmt.Sprintf("endTimestamp <= %d", endBefore.Unix()))
+	}
+	if req.Msg.Format != nil && *req.Msg.Format != eventsv1.EventFormat_EVENT_FORMAT_UNSPECIFIED {
+		conditions = append(conditions, fmt.Sprintf("format = %q", protoEventFormatToDB(*req.Msg.Format)))
+	}
+	filters := strings.Join(conditions, " AND ")
+
+	var sort []string
+	if req.Msg.SortBy == searchv1.EventSortBy_EVENT_SORT_BY_ATTENDANCE_RATE_DESC {
+		sort = []string{"attendanceRate:desc"}
+	}
+
+	result, err := s.searchClient.SearchEvents(ctx, req.Msg.Query, limit, filters, sort)
+	if err != nil {
+		logging.WithContext(ctx).Error("SearchEvents failed", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("search failed: %w", err))
+	}
+
+	return connect.NewResponse(&searchv1.SearchEventsResponse{
+		Results:   hitsToProto(result.Hits, searchv1.SearchResultType_SEARCH_RESULT_TYPE_EVENT),
+		TotalHits: result.EstimatedTotalHits,
+	}), nil
+}
+
+func (s *SearchService) SearchOrganizations(ctx context.Context, req *connect.Request[searchv1.SearchOrganizationsRequest]) (*connect.Response[searchv1.SearchOrganizationsResponse], error) {
+	logging.WithContext(ctx).Debug("SearchOrganizations", "query", req.Msg.Query, "limit", req.Msg.Limit, "organizationTypeId", req.Msg.GetOrganizationTypeId(), "status", req.Msg.GetStatus())
+
+	if s.searchClient == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("search service not available"))
+	}
+
+	if req.Msg.Query == "" {
+		return connect.NewResponse(&searchv1.SearchOrganizationsResponse{
+			Results: []*searchv1.SearchResult{},
+		}), nil
+	}
+
+	limit := req.Msg.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+	limit = min(limit, int32(s.cfg.MaxPageSize))
+
+	var conditions []string
+	if req.Msg.OrganizationTypeId != nil {
+		conditions = append(conditions, fmt.Sprintf("organizationTypeId = %d", *req.Msg.OrganizationTypeId))
 	}
+	if req.Msg.Status != nil && *req.Msg.Status != eventsv1.OrganizationStatus_ORGANIZATION_STATUS_UNSPECIFIED {
+		conditions = append(conditions, fmt.Sprintf("status = %q", protoStatusToString(*req.Msg.Status)))
+	}
+	filters := strings.Join(conditions, " AND ")
+
+	result, err := s.searchClient.SearchOrganizations(ctx, req.Msg.Query, limit, filters)
+	if err != nil {
+		logging.WithContext(ctx).Error("SearchOrganizations failed", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("search failed: %w", err))
+	}
+
+	return connect.NewResponse(&searchv1.SearchOrganizationsResponse{
+		Results:          hitsToProto(result.Hits, searchv1.SearchResultType_SEARCH_RESULT_TYPE_ORGANIZATION),
+		TotalHits:        result.EstimatedTotalHits,
+		ProcessingTimeMs: result.ProcessingTimeMs,
+	}), nil
+}
+
+func (s *SearchService) SearchOrganizationTypes(ctx context.Context, req *connect.Request[searchv1.SearchOrganizationTypesRequest]) (*connect.Response[searchv1.SearchOrganizationTypesResponse], error) {
+	logging.WithContext(ctx).Debug("SearchOrganizationTypes", "query", req.Msg.Query, "limit", req.Msg.Limit)
+
+	if s.searchClient == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("search service not available"))
+	}
+
+	limit := req.Msg.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+	limit = min(limit, int32(s.cfg.MaxPageSize))
 
-	result, err := s.searchClient.SearchEvents(ctx, req.Msg.Query, limit, filters)
+	result, err := s.searchClient.SearchOrganizationTypes(ctx, req.Msg.Query, limit)
 	if err != nil {
-		slog.Error("SearchEvents failed", "error", err)
+		logging.WithContext(ctx).Error("SearchOrganizationTypes failed", "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("search failed: %w", err))
 	}
 
-	// Convert hits to proto results
-	protoResults := make([]*searchv1.SearchResult, 0, len(result.Hits))
-	for _, hit := range result.Hits {
+	return connect.NewResponse(&searchv1.SearchOrganizationTypesResponse{
+		Results:   hitsToProto(result.Hits, searchv1.SearchResultType_SEARCH_RESULT_TYPE_ORGANIZATION_TYPE),
+		TotalHits: result.EstimatedTotalHits,
+	}), nil
+}
+
+// hitsToProto converts events, organizations or organization types index
+// hits to proto results
+func hitsToProto(hits meilisearch.Hits, resultType searchv1.SearchResultType) []*searchv1.SearchResult {
+	protoResults := make([]*searchv1.SearchResult, 0, len(hits))
+	for _, hit := range hits {
 		var hitMap map[string]interface{}
 		if err := hit.DecodeInto(&hitMap); err != nil {
 			continue
 		}
 
 		sr := &searchv1.SearchResult{
-			Type: searchv1.SearchResultType_SEARCH_RESULT_TYPE_EVENT,
+			Type: resultType,
 		}
 
 		if id, ok := hitMap["id"].(float64); ok {
@@ -150,15 +260,11 @@ func (s *SearchService) SearchEvents(ctx context.Context, req *connect.Request[s
 
 		protoResults = append(protoResults, sr)
 	}
-
-	return connect.NewResponse(&searchv1.SearchEventsResponse{
-		Results:   protoResults,
-		TotalHits: result.EstimatedTotalHits,
-	}), nil
+	return protoResults
 }
 
 func (s *SearchService) Reindex(ctx context.Context, req *connect.Request[searchv1.ReindexRequest]) (*connect.Response[searchv1.ReindexResponse], error) {
-	slog.Info("Reindex requested", "indexes", req.Msg.Indexes)
+	logging.WithContext(ctx).Info("Reindex requested", "indexes", req.Msg.Indexes)
 
 	if s.searchIndexer == nil {
 		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("search indexer not available"))
@@ -166,7 +272,7 @@ func (s *SearchService) Reindex(ctx context.Context, req *connect.Request[search
 
 	result, err := s.searchIndexer.ReindexAll(ctx)
 	if err != nil {
-		slog.Error("Reindex failed", "error", err)
+		logging.WithContext(ctx).Error("Reindex failed", "error", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("reindex failed: %w", err))
 	}
 
@@ -176,11 +282,59 @@ func (s *SearchService) Reindex(ctx context.Context, req *connect.Request[search
 	}
 
 	return connect.NewResponse(&searchv1.ReindexResponse{
-		Success:              len(result.Errors) == 0,
-		Message:              message,
-		EventsIndexed:        int32(result.EventsIndexed),
-		OrganizationsIndexed: int32(result.OrganizationsIndexed),
-		UsersIndexed:         int32(result.UsersIndexed),
-		TagsIndexed:          int32(result.TagsIndexed),
+		Success:                  len(result.Errors) == 0,
+		Message:                  message,
+		EventsIndexed:            int32(result.EventsIndexed),
+		OrganizationsIndexed:     int32(result.OrganizationsIndexed),
+		UsersIndexed:             int32(result.UsersIndexed),
+		TagsIndexed:              int32(result.TagsIndexed),
+		OrganizationTypesIndexed: int32(result.OrganizationTypesIndexed),
 	}), nil
 }
+
+// tagFilter builds a Meilisearch filter on the tagIds array. ANY matches
+// events with at least one of the tags, ALL requires every one of them.
+func tagFilter(tagIDs []int32, mode searchv1.TagFilterMode) string {
+	ids := make([]string, len(tagIDs))
+	for i, id := range tagIDs {
+		ids[i] = strconv.Itoa(int(id))
+	}
+
+	if mode == searchv1.TagFilterMode_TAG_FILTER_MODE_ALL {
+		clauses := make([]string, len(ids))
+		for i, id := range ids {
+			clauses[i] = "tagIds IN [" + id + "]"
+		}
+		return strings.Join(clauses, " AND ")
+	}
+	return "tagIds IN [" + strings.Join(ids, ", ") + "]"
+}
+
+func searchResultToProto(r search.SearchResult) *searchv1.SearchResult {
+	resultType := searchv1.SearchResultType_SEARCH_RESULT_TYPE_UNSPECIFIED
+	switch r.Type {
+	case search.IndexEvents:
+		resultType = searchv1.SearchResultType_SEARCH_RESULT_TYPE_EVENT
+	case search.IndexOrganizations:
+		resultType = searchv1.SearchResultType_SEARCH_RESULT_TYPE_ORGANIZATION
+	case search.IndexUsers:
+		resultType = searchv1.SearchResultType_SEARCH_RESULT_TYPE_USER
+	case search.IndexTags:
+		resultType = searchv1.SearchResultType_SEARCH_RESULT_TYPE_TAG
+	case search.IndexOrganizationTypes:
+		resultType = searchv1.SearchResultType_SEARCH_RESULT_TYPE_ORGANIZATION_TYPE
+	}
+
+	protoResult := &searchv1.SearchResult{
+		Type:  resultType,
+		Id:    r.ID,
+		Title: r.Title,
+	}
+	if r.Description != "" {
+		protoResult.Description = &r.Description
+	}
+	if r.ImageURL != "" {
+		protoResult.ImageUrl = &r.ImageURL
+	}
+	return protoResult
+}
@@ -0,0 +1,166 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/perms"
+)
+
+// spiceDBSyncBatchSize is how many relationships go into one WriteRelationships call
+const spiceDBSyncBatchSize = 50
+
+// clubRoleRelations maps user_roles role names to club relations in the schema
+var clubRoleRelations = map[string]string{
+	"President": "president",
+	"Staff":     "staff",
+	"Member":    "member",
+}
+
+// SpiceDBSyncHandler rebuilds SpiceDB relationships from the database
+type SpiceDBSyncHandler struct {
+	queries *db.Queries
+	perms   *perms.Client
+}
+
+func NewSpiceDBSyncHandler(queries *db.Queries, permsClient *perms.Client) *SpiceDBSyncHandler {
+	return &SpiceDBSyncHandler{queries: queries, perms: permsClient}
+}
+
+// spiceDBSyncProgress is one line of the newline-delimited JSON response.
+// Errors counts relationships that failed to write plus failed database
+// reads. The line with Stage "done" carries the final totals.
+type spiceDBSyncProgress struct {
+	Stage         string `json:"stage"`
+	Organizations int    `json:"organizations"`
+	Events        int    `json:"events"`
+	Users         int    `json:"users"`
+	Errors        int    `json:"errors"`
+}
+
+// ServeHTTP writes every relationship derivable from the database. Writes
+// are touches, so running it against a healthy SpiceDB changes nothing.
+// Progress is streamed as NDJSON after each batch.
+func (h *SpiceDBSyncHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	slog.Info("SpiceDB sync started")
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	enc := json.NewEncoder(w)
+	rc := http.NewResponseController(w)
+	// A full sync can outlast the server's WriteTimeout
+	_ = rc.SetWriteDeadline(time.Time{})
+
+	progress := spiceDBSyncProgress{Stage: "organizations"}
+	report := func() {
+		_ = enc.Encode(progress)
+		_ = rc.Flush()
+	}
+
+	// Report after every batch so long syncs show progress
+	batch := newRelationshipBatcher(ctx, h.perms, func(failed int) {
+		progress.Errors += failed
+		report()
+	})
+
+	// Clubs: platform link plus president/staff/member roles
+	orgIDs, err := h.queries.ListOrganizationIDs(ctx)
+	if err != nil {
+		slog.Error("SpiceDB sync: failed to list organizations", "error", err)
+		progress.Errors++
+	}
+	for _, id := range orgIDs {
+		batch.add(perms.Relationship{Resource: "club", ResourceID: strconv.Itoa(int(id)), Relation: "parent_platform", SubjectType: "platform", SubjectID: perms.PlatformID})
+		progress.Organizations++
+	}
+	roles := make([]string, 0, len(clubRoleRelations))
+	for role := range clubRoleRelations {
+		roles = append(roles, role)
+	}
+	members, err := h.queries.ListClubRoleSubjects(ctx, roles)
+	if err != nil {
+		slog.Error("SpiceDB sync: failed to list club roles", "error", err)
+		progress.Errors++
+	}
+	for _, m := range members {
+		batch.add(perms.Relationship{Resource: "club", ResourceID: strconv.Itoa(int(m.OrganizationID)), Relation: clubRoleRelations[m.RoleName], SubjectType: "user", SubjectID: m.SubjectID})
+	}
+	batch.flush()
+
+	// Events: host club and creator
+	progress.Stage = "events"
+	events, err := h.queries.ListEventCreatorSubjects(ctx)
+	if err != nil {
+		slog.Error("SpiceDB sync: failed to list events", "error", err)
+		progress.Errors++
+	}
+	for _, e := range events {
+		eventID := strconv.Itoa(int(e.ID))
+		batch.add(perms.Relationship{Resource: "event", ResourceID: eventID, Relation: "host_club", SubjectType: "club", SubjectID: strconv.Itoa(int(e.OrganizationID))})
+		batch.add(perms.Relationship{Resource: "event", ResourceID: eventID, Relation: "creator", SubjectType: "user", SubjectID: e.CreatorID})
+		progress.Events++
+	}
+	batch.flush()
+
+	// Platform roles granted through pre-registration
+	progress.Stage = "users"
+	users, err := h.queries.ListPlatformRoleSubjects(ctx)
+	if err != nil {
+		slog.Error("SpiceDB sync: failed to list platform roles", "error", err)
+		progress.Errors++
+	}
+	for _, u := range users {
+		batch.add(perms.Relationship{Resource: "platform", ResourceID: perms.PlatformID, Relation: string(u.PlatformRole), SubjectType: "user", SubjectID: u.KratosID})
+		progress.Users++
+	}
+	batch.flush()
+
+	progress.Stage = "done"
+	report()
+
+	slog.Info("SpiceDB sync finished", "organizations", progress.Organizations, "events", progress.Events, "users", progress.Users, "errors", progress.Errors)
+}
+
+// relationshipBatcher buffers relationships and writes them spiceDBSyncBatchSize at a time
+type relationshipBatcher struct {
+	ctx     context.Context
+	perms   *perms.Client
+	pending []perms.Relationship
+	onFlush func(failed int)
+}
+
+func newRelationshipBatcher(ctx context.Context, permsClient *perms.Client, onFlush func(failed int)) *relationshipBatcher {
+	return &relationshipBatcher{
+		ctx:     ctx,
+		perms:   permsClient,
+		pending: make([]perms.Relationship, 0, spiceDBSyncBatchSize),
+		onFlush: onFlush,
+	}
+}
+
+func (b *relationshipBatcher) add(rel perms.Relationship) {
+	b.pending = append(b.pending, rel)
+	if len(b.pending) == spiceDBSyncBatchSize {
+		b.flush()
+	}
+}
+
+// flush writes the pending relationships. A failed batch is reported and
+// skipped so one bad row doesn't stop the rest of the sync.
+func (b *relationshipBatcher) flush() {
+	if len(b.pending) == 0 {
+		return
+	}
+	failed := 0
+	if err := b.perms.WriteRelationships(b.ctx, b.pending); err != nil {
+		slog.Warn("SpiceDB sync: batch write failed", "count", len(b.pending), "error", err)
+		failed = len(b.pending)
+	}
+	b.pending = b.pending[:0]
+	b.onFlush(failed)
+}
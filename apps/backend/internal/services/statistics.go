@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"time"
 
@@ -9,37 +10,42 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
 	"github.com/studyverse/ems-backend/gen/eventsv1/eventsv1connect"
+	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/config"
 	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/dberrors"
+	"github.com/studyverse/ems-backend/internal/logging"
+	"github.com/studyverse/ems-backend/internal/metrics"
+	"github.com/studyverse/ems-backend/internal/perms"
+	"github.com/studyverse/ems-backend/internal/stats"
 )
 
 type StatisticsService struct {
 	eventsv1connect.UnimplementedStatisticsServiceHandler
-	queries *db.Queries
-	pool    *pgxpool.Pool
+	queries   *db.Queries
+	pool      *pgxpool.Pool
+	perms     *perms.Client
+	snapshots *stats.SnapshotWorker
+	cfg       *config.Config
 }
 
-func NewStatisticsService(queries *db.Queries, pool *pgxpool.Pool) *StatisticsService {
-	return &StatisticsService{queries: queries, pool: pool}
+func NewStatisticsService(queries *db.Queries, pool *pgxpool.Pool, permsClient *perms.Client, snapshots *stats.SnapshotWorker, cfg *config.Config) *StatisticsService {
+	return &StatisticsService{queries: queries, pool: pool, perms: permsClient, snapshots: snapshots, cfg: cfg}
 }
 
 func (s *StatisticsService) GetDashboardStatistics(ctx context.Context, req *connect.Request[eventsv1.GetDashboardStatisticsRequest]) (*connect.Response[eventsv1.GetDashboardStatisticsResponse], error) {
-	slog.Debug("GetDashboardStatistics")
+	logging.WithContext(ctx).Debug("GetDashboardStatistics", "forceRefresh", req.Msg.ForceRefresh)
 
-	// Get total counts
-	var totalEvents, totalRegs, totalAttendees int32
-	_ = s.pool.QueryRow(ctx, `SELECT COUNT(*) FROM events`).Scan(&totalEvents)
-	_ = s.pool.QueryRow(ctx, `SELECT COUNT(*) FROM event_registrations WHERE status = 'registered'`).Scan(&totalRegs)
-	_ = s.pool.QueryRow(ctx, `SELECT COUNT(*) FROM event_attendance WHERE status = 'attended'`).Scan(&totalAttendees)
-
-	now := time.Now()
-	var upcomingEvents, pastEvents int32
-	_ = s.pool.QueryRow(ctx, `SELECT COUNT(*) FROM events WHERE start_time >= $1`, now).Scan(&upcomingEvents)
-	_ = s.pool.QueryRow(ctx, `SELECT COUNT(*) FROM events WHERE start_time < $1`, now).Scan(&pastEvents)
+	snap, err := s.snapshots.Get(ctx, req.Msg.ForceRefresh)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
 
-	// Get recent events with stats
+	// Get recent events, then their stats in one batch
 	rows, err := s.pool.Query(ctx, `
-		SELECT id, title, start_time
+		SELECT id
 		FROM events
+		WHERE NOT is_deleted
 		ORDER BY start_time DESC
 		LIMIT 5
 	`)
@@ -48,53 +54,60 @@ func (s *StatisticsService) GetDashboardStatistics(ctx context.Context, req *con
 	}
 	defer rows.Close()
 
-	var recentEvents []*eventsv1.EventStats
+	var recentIDs []int32
 	for rows.Next() {
 		var id int32
-		var title string
-		var startTime time.Time
-		if err := rows.Scan(&id, &title, &startTime); err != nil {
+		if err := rows.Scan(&id); err != nil {
 			continue
 		}
+		recentIDs = append(recentIDs, id)
+	}
 
-		// Get stats for this event
-		var regs, attended int32
-		_ = s.pool.QueryRow(ctx, `SELECT COUNT(*) FROM event_registrations WHERE event_id = $1 AND status = 'registered'`, id).Scan(&regs)
-		_ = s.pool.QueryRow(ctx, `
-			SELECT COUNT(*) FROM event_attendance ea
-			INNER JOIN event_registrations er ON er.id = ea.registration_id
-			WHERE er.event_id = $1 AND ea.status = 'attended'
-		`, id).Scan(&attended)
+	counted, err := s.queries.GetEventsWithRegistrationCounts(ctx, recentIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	countsByID := make(map[int32]db.GetEventsWithRegistrationCountsRow, len(counted))
+	for _, c := range counted {
+		countsByID[c.Event.ID] = c
+	}
+
+	var recentEvents []*eventsv1.EventStats
+	for _, id := range recentIDs {
+		c, ok := countsByID[id]
+		if !ok {
+			continue
+		}
 
 		var attendanceRate float64
-		if regs > 0 {
-			attendanceRate = float64(attended) / float64(regs) * 100
+		if c.RegisteredCount > 0 {
+			attendanceRate = float64(c.AttendedCount) / float64(c.RegisteredCount) * 100
 		}
 
 		recentEvents = append(recentEvents, &eventsv1.EventStats{
 			EventId:        id,
-			EventTitle:     title,
-			Registrations:  regs,
-			Attendees:      attended,
+			EventTitle:     c.Event.Title,
+			Registrations:  c.RegisteredCount,
+			Attendees:      c.AttendedCount,
 			AttendanceRate: attendanceRate,
-			StartTime:      startTime.Format(time.RFC3339),
+			StartTime:      c.Event.StartTime.Time.Format(time.RFC3339),
 		})
 	}
 
 	return connect.NewResponse(&eventsv1.GetDashboardStatisticsResponse{
 		Statistics: &eventsv1.EventStatistics{
-			TotalEvents:        totalEvents,
-			TotalRegistrations: totalRegs,
-			TotalAttendees:     totalAttendees,
-			UpcomingEvents:     upcomingEvents,
-			PastEvents:         pastEvents,
+			TotalEvents:        snap.TotalEvents,
+			TotalRegistrations: snap.TotalRegistrations,
+			TotalAttendees:     snap.TotalAttendees,
+			UpcomingEvents:     snap.UpcomingEvents,
+			PastEvents:         snap.PastEvents,
 			RecentEvents:       recentEvents,
 		},
 	}), nil
 }
 
 func (s *StatisticsService) GetEventStatistics(ctx context.Context, req *connect.Request[eventsv1.GetEventStatisticsRequest]) (*connect.Response[eventsv1.GetEventStatisticsResponse], error) {
-	slog.Debug("GetEventStatistics", "eventId", req.Msg.EventId)
+	logging.WithContext(ctx).Debug("GetEventStatistics", "eventId", req.Msg.EventId)
 
 	var totalRegs, totalAttended, checkedIn, noShow int32
 	_ = s.pool.QueryRow(ctx, `SELECT COUNT(*) FROM event_registrations WHERE event_id = $1 AND status = 'registered'`, req.Msg.EventId).Scan(&totalRegs)
@@ -129,7 +142,7 @@ func (s *StatisticsService) GetEventStatistics(ctx context.Context, req *connect
 }
 
 func (s *StatisticsService) GetEventTagsDistributionByMonth(ctx context.Context, req *connect.Request[eventsv1.GetEventTagsDistributionByMonthRequest]) (*connect.Response[eventsv1.GetEventTagsDistributionByMonthResponse], error) {
-	slog.Debug("GetEventTagsDistributionByMonth", "year", req.Msg.Year, "month", req.Msg.Month)
+	logging.WithContext(ctx).Debug("GetEventTagsDistributionByMonth", "year", req.Msg.Year, "month", req.Msg.Month)
 
 	startDate := time.Date(int(req.Msg.Year), time.Month(req.Msg.Month), 1, 0, 0, 0, 0, time.UTC)
 	endDate := startDate.AddDate(0, 1, 0)
@@ -139,7 +152,7 @@ func (s *StatisticsService) GetEventTagsDistributionByMonth(ctx context.Context,
 		FROM tags t
 		INNER JOIN event_tags et ON et.tag_id = t.id
 		INNER JOIN events e ON e.id = et.event_id
-		WHERE e.start_time >= $1 AND e.start_time < $2
+		WHERE e.start_time >= $1 AND e.start_time < $2 AND NOT e.is_deleted
 		GROUP BY t.id, t.name
 		ORDER BY event_count DESC
 	`, startDate, endDate)
@@ -172,7 +185,7 @@ func (s *StatisticsService) GetEventTagsDistributionByMonth(ctx context.Context,
 }
 
 func (s *StatisticsService) GetEventActivityByYear(ctx context.Context, req *connect.Request[eventsv1.GetEventActivityByYearRequest]) (*connect.Response[eventsv1.GetEventActivityByYearResponse], error) {
-	slog.Debug("GetEventActivityByYear", "year", req.Msg.Year)
+	logging.WithContext(ctx).Debug("GetEventActivityByYear", "year", req.Msg.Year)
 
 	startDate := time.Date(int(req.Msg.Year), 1, 1, 0, 0, 0, 0, time.UTC)
 	endDate := startDate.AddDate(1, 0, 0)
@@ -180,7 +193,7 @@ func (s *StatisticsService) GetEventActivityByYear(ctx context.Context, req *con
 	rows, err := s.pool.Query(ctx, `
 		SELECT DATE(start_time) as date, COUNT(*) as count
 		FROM events
-		WHERE start_time >= $1 AND start_time < $2
+		WHERE start_time >= $1 AND start_time < $2 AND NOT is_deleted
 		GROUP BY DATE(start_time)
 		ORDER BY date
 	`, startDate, endDate)
@@ -220,55 +233,27 @@ func (s *StatisticsService) GetEventActivityByYear(ctx context.Context, req *con
 }
 
 func (s *StatisticsService) GetOverallStatistics(ctx context.Context, req *connect.Request[eventsv1.GetOverallStatisticsRequest]) (*connect.Response[eventsv1.GetOverallStatisticsResponse], error) {
-	slog.Debug("GetOverallStatistics")
-
-	var totalEvents, totalUsers, totalOrgs, totalRegs, upcomingEvents int32
-	_ = s.pool.QueryRow(ctx, `SELECT COUNT(*) FROM events`).Scan(&totalEvents)
-	_ = s.pool.QueryRow(ctx, `SELECT COUNT(*) FROM users`).Scan(&totalUsers)
-	_ = s.pool.QueryRow(ctx, `SELECT COUNT(*) FROM organizations`).Scan(&totalOrgs)
-	_ = s.pool.QueryRow(ctx, `SELECT COUNT(*) FROM event_registrations WHERE status = 'registered'`).Scan(&totalRegs)
-	_ = s.pool.QueryRow(ctx, `SELECT COUNT(*) FROM events WHERE start_time >= NOW()`).Scan(&upcomingEvents)
-
-	// Calculate average attendance rate
-	var avgRate float64
-	_ = s.pool.QueryRow(ctx, `
-		SELECT COALESCE(AVG(
-			CASE WHEN reg_count > 0 THEN att_count::float / reg_count::float * 100 ELSE 0 END
-		), 0)
-		FROM (
-			SELECT e.id,
-				COUNT(DISTINCT CASE WHEN er.status = 'registered' THEN er.id END) as reg_count,
-				COUNT(DISTINCT CASE WHEN ea.status = 'attended' THEN ea.id END) as att_count
-			FROM events e
-			LEFT JOIN event_registrations er ON er.event_id = e.id
-			LEFT JOIN event_attendance ea ON ea.registration_id = er.id
-			GROUP BY e.id
-		) stats
-	`).Scan(&avgRate)
-
-	// Events this month
-	now := time.Now()
-	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
-	monthEnd := monthStart.AddDate(0, 1, 0)
+	logging.WithContext(ctx).Debug("GetOverallStatistics", "forceRefresh", req.Msg.ForceRefresh)
 
-	var eventsThisMonth, regsThisMonth int32
-	_ = s.pool.QueryRow(ctx, `SELECT COUNT(*) FROM events WHERE start_time >= $1 AND start_time < $2`, monthStart, monthEnd).Scan(&eventsThisMonth)
-	_ = s.pool.QueryRow(ctx, `SELECT COUNT(*) FROM event_registrations WHERE registered_at >= $1 AND registered_at < $2`, monthStart, monthEnd).Scan(&regsThisMonth)
+	snap, err := s.snapshots.Get(ctx, req.Msg.ForceRefresh)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
 
 	return connect.NewResponse(&eventsv1.GetOverallStatisticsResponse{
-		TotalEvents:            totalEvents,
-		TotalUsers:             totalUsers,
-		TotalOrganizations:     totalOrgs,
-		TotalRegistrations:     totalRegs,
-		UpcomingEvents:         upcomingEvents,
-		AverageAttendanceRate:  avgRate,
-		EventsThisMonth:        eventsThisMonth,
-		RegistrationsThisMonth: regsThisMonth,
+		TotalEvents:            snap.TotalEvents,
+		TotalUsers:             snap.TotalUsers,
+		TotalOrganizations:     snap.TotalOrganizations,
+		TotalRegistrations:     snap.TotalRegistrations,
+		UpcomingEvents:         snap.UpcomingEvents,
+		AverageAttendanceRate:  snap.AverageAttendanceRate,
+		EventsThisMonth:        snap.EventsThisMonth,
+		RegistrationsThisMonth: snap.RegistrationsThisMonth,
 	}), nil
 }
 
 func (s *StatisticsService) GetEventTrends(ctx context.Context, req *connect.Request[eventsv1.GetEventTrendsRequest]) (*connect.Response[eventsv1.GetEventTrendsResponse], error) {
-	slog.Debug("GetEventTrends", "days", req.Msg.Days)
+	logging.WithContext(ctx).Debug("GetEventTrends", "days", req.Msg.Days)
 
 	days := int(req.Msg.Days)
 	if days <= 0 {
@@ -283,7 +268,7 @@ func (s *StatisticsService) GetEventTrends(ctx context.Context, req *connect.Req
 			COUNT(DISTINCT er.id) as reg_count
 		FROM events e
 		LEFT JOIN event_registrations er ON er.event_id = e.id AND er.status = 'registered'
-		WHERE e.start_time >= $1
+		WHERE e.start_time >= $1 AND NOT e.is_deleted
 		GROUP BY DATE(e.start_time)
 		ORDER BY date
 	`, startDate)
@@ -311,33 +296,125 @@ func (s *StatisticsService) GetEventTrends(ctx context.Context, req *connect.Req
 	}), nil
 }
 
+// GetEventCountByFormat counts events per format, optionally for one club
+// and events starting within [start_date, end_date]
+func (s *StatisticsService) GetEventCountByFormat(ctx context.Context, req *connect.Request[eventsv1.GetEventCountByFormatRequest]) (*connect.Response[eventsv1.GetEventCountByFormatResponse], error) {
+	logging.WithContext(ctx).Debug("GetEventCountByFormat", "organizationId", req.Msg.GetOrganizationId(), "startDate", req.Msg.GetStartDate(), "endDate", req.Msg.GetEndDate())
+
+	// Both bounds were checked by GetEventCountByFormatRequest.Validate
+	var startDate, endDate *time.Time
+	if req.Msg.StartDate != nil {
+		t, _ := time.Parse(time.RFC3339, *req.Msg.StartDate)
+		startDate = &t
+	}
+	if req.Msg.EndDate != nil {
+		t, _ := time.Parse(time.RFC3339, *req.Msg.EndDate)
+		endDate = &t
+	}
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT format, COUNT(*)
+		FROM events
+		WHERE NOT is_deleted
+			AND ($1::int IS NULL OR organization_id = $1)
+			AND ($2::timestamptz IS NULL OR start_time >= $2)
+			AND ($3::timestamptz IS NULL OR start_time <= $3)
+		GROUP BY format
+	`, req.Msg.OrganizationId, startDate, endDate)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	defer rows.Close()
+
+	resp := &eventsv1.GetEventCountByFormatResponse{}
+	for rows.Next() {
+		var format db.Format
+		var count int32
+		if err := rows.Scan(&format, &count); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		switch format {
+		case db.FormatOnline:
+			resp.OnlineCount = count
+		case db.FormatOffline:
+			resp.OfflineCount = count
+		case db.FormatHybrid:
+			resp.HybridCount = count
+		}
+		resp.Total += count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// attendanceRateExpr is attended / registered for the leaderboard queries.
+// ORDER BY can't use output aliases inside expressions, so it repeats the aggregates.
+const attendanceRateExpr = `COALESCE(
+	COUNT(DISTINCT CASE WHEN ea.status = 'attended' THEN ea.id END)::float8
+	/ NULLIF(COUNT(DISTINCT CASE WHEN er.status = 'registered' THEN er.id END), 0), 0)`
+
+// clubLeaderboardOrder maps each sort option to a fixed ORDER BY clause.
+// The club ID breaks ties so pages don't overlap.
+var clubLeaderboardOrder = map[eventsv1.ClubLeaderboardSortBy]string{
+	eventsv1.ClubLeaderboardSortBy_CLUB_LEADERBOARD_SORT_BY_UNSPECIFIED:              "total_events DESC, total_regs DESC, o.id",
+	eventsv1.ClubLeaderboardSortBy_CLUB_LEADERBOARD_SORT_BY_TOTAL_EVENTS_DESC:        "total_events DESC, total_regs DESC, o.id",
+	eventsv1.ClubLeaderboardSortBy_CLUB_LEADERBOARD_SORT_BY_TOTAL_REGISTRATIONS_DESC: "total_regs DESC, total_events DESC, o.id",
+	eventsv1.ClubLeaderboardSortBy_CLUB_LEADERBOARD_SORT_BY_AVG_ATTENDANCE_RATE_DESC: attendanceRateExpr + " DESC, total_regs DESC, o.id",
+}
+
+// topEventsOrder is the GetTopPerformingEvents counterpart of clubLeaderboardOrder
+var topEventsOrder = map[eventsv1.TopEventsSortBy]string{
+	eventsv1.TopEventsSortBy_TOP_EVENTS_SORT_BY_UNSPECIFIED:              "total_regs DESC, total_attended DESC, e.id",
+	eventsv1.TopEventsSortBy_TOP_EVENTS_SORT_BY_TOTAL_REGISTRATIONS_DESC: "total_regs DESC, total_attended DESC, e.id",
+	eventsv1.TopEventsSortBy_TOP_EVENTS_SORT_BY_ATTENDANCE_RATE_DESC:     attendanceRateExpr + " DESC, total_regs DESC, e.id",
+}
+
 func (s *StatisticsService) GetTopPerformingClubs(ctx context.Context, req *connect.Request[eventsv1.GetTopPerformingClubsRequest]) (*connect.Response[eventsv1.GetTopPerformingClubsResponse], error) {
-	slog.Debug("GetTopPerformingClubs", "limit", req.Msg.Limit, "days", req.Msg.Days)
+	logging.WithContext(ctx).Debug("GetTopPerformingClubs", "limit", req.Msg.Limit, "days", req.Msg.Days, "page", req.Msg.Page, "sortBy", req.Msg.SortBy)
 
 	limit := int(req.Msg.Limit)
 	if limit <= 0 {
 		limit = 10
 	}
+	limit = min(limit, s.cfg.MaxPageSize)
+	page := int(req.Msg.Page)
+	if page <= 0 {
+		page = 1
+	}
 	days := int(req.Msg.Days)
 	if days <= 0 {
 		days = 90
 	}
+	orderBy, ok := clubLeaderboardOrder[req.Msg.SortBy]
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported sort_by %v", req.Msg.SortBy))
+	}
 
 	startDate := time.Now().AddDate(0, 0, -days)
 
+	var total int32
+	if err := s.pool.QueryRow(ctx, `
+		SELECT COUNT(DISTINCT organization_id) FROM events WHERE start_time >= $1 AND NOT is_deleted
+	`, startDate).Scan(&total); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
 	rows, err := s.pool.Query(ctx, `
 		SELECT o.id, o.title, o.image_url,
 			COUNT(DISTINCT e.id) as total_events,
 			COUNT(DISTINCT CASE WHEN er.status = 'registered' THEN er.id END) as total_regs,
 			COUNT(DISTINCT CASE WHEN ea.status = 'attended' THEN ea.id END) as total_attended
 		FROM organizations o
-		INNER JOIN events e ON e.organization_id = o.id AND e.start_time >= $1
+		INNER JOIN events e ON e.organization_id = o.id AND e.start_time >= $1 AND NOT e.is_deleted
 		LEFT JOIN event_registrations er ON er.event_id = e.id
 		LEFT JOIN event_attendance ea ON ea.registration_id = er.id
 		GROUP BY o.id, o.title, o.image_url
-		ORDER BY total_events DESC, total_regs DESC
-		LIMIT $2
-	`, startDate, limit)
+		ORDER BY `+orderBy+`
+		LIMIT $2 OFFSET $3
+	`, startDate, limit, (page-1)*limit)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
@@ -369,11 +446,12 @@ func (s *StatisticsService) GetTopPerformingClubs(ctx context.Context, req *conn
 
 	return connect.NewResponse(&eventsv1.GetTopPerformingClubsResponse{
 		Clubs: clubs,
+		Total: total,
 	}), nil
 }
 
 func (s *StatisticsService) GetUserEngagementLevels(ctx context.Context, req *connect.Request[eventsv1.GetUserEngagementLevelsRequest]) (*connect.Response[eventsv1.GetUserEngagementLevelsResponse], error) {
-	slog.Debug("GetUserEngagementLevels")
+	logging.WithContext(ctx).Debug("GetUserEngagementLevels")
 
 	var totalUsers int32
 	_ = s.pool.QueryRow(ctx, `SELECT COUNT(*) FROM users`).Scan(&totalUsers)
@@ -416,31 +494,48 @@ func (s *StatisticsService) GetUserEngagementLevels(ctx context.Context, req *co
 }
 
 func (s *StatisticsService) GetTopPerformingEvents(ctx context.Context, req *connect.Request[eventsv1.GetTopPerformingEventsRequest]) (*connect.Response[eventsv1.GetTopPerformingEventsResponse], error) {
-	slog.Debug("GetTopPerformingEvents", "limit", req.Msg.Limit)
+	logging.WithContext(ctx).Debug("GetTopPerformingEvents", "limit", req.Msg.Limit, "days", req.Msg.Days, "page", req.Msg.Page, "sortBy", req.Msg.SortBy)
 
 	limit := int(req.Msg.Limit)
 	if limit <= 0 {
 		limit = 10
 	}
+	limit = min(limit, s.cfg.MaxPageSize)
+	page := int(req.Msg.Page)
+	if page <= 0 {
+		page = 1
+	}
 	days := int(req.Msg.Days)
 	if days <= 0 {
 		days = 90
 	}
+	orderBy, ok := topEventsOrder[req.Msg.SortBy]
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported sort_by %v", req.Msg.SortBy))
+	}
 
 	startDate := time.Now().AddDate(0, 0, -days)
 
+	var total int32
+	if err := s.pool.QueryRow(ctx, `
+		SELECT COUNT(*) FROM events WHERE start_time >= $1 AND NOT is_deleted
+	`, startDate).Scan(&total); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
 	rows, err := s.pool.Query(ctx, `
-		SELECT e.id, e.title, e.image_url, e.start_time, e.organization_id,
+		SELECT e.id, e.title, e.image_url, e.start_time, `+organizationColumns+`,
 			COUNT(DISTINCT CASE WHEN er.status = 'registered' THEN er.id END) as total_regs,
 			COUNT(DISTINCT CASE WHEN ea.status = 'attended' THEN ea.id END) as total_attended
 		FROM events e
+		JOIN organizations o ON o.id = e.organization_id
 		LEFT JOIN event_registrations er ON er.event_id = e.id
 		LEFT JOIN event_attendance ea ON ea.registration_id = er.id
-		WHERE e.start_time >= $1
-		GROUP BY e.id, e.title, e.image_url, e.start_time, e.organization_id
-		ORDER BY total_regs DESC, total_attended DESC
-		LIMIT $2
-	`, startDate, limit)
+		WHERE e.start_time >= $1 AND NOT e.is_deleted
+		GROUP BY e.id, e.title, e.image_url, e.start_time, o.id
+		ORDER BY `+orderBy+`
+		LIMIT $2 OFFSET $3
+	`, startDate, limit, (page-1)*limit)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
@@ -452,14 +547,13 @@ func (s *StatisticsService) GetTopPerformingEvents(ctx context.Context, req *con
 		var title string
 		var imageURL *string
 		var startTime time.Time
-		var orgID int32
+		var org db.Organization
 		var totalRegs, totalAttended int32
-		if err := rows.Scan(&id, &title, &imageURL, &startTime, &orgID, &totalRegs, &totalAttended); err != nil {
+		dest := append([]any{&id, &title, &imageURL, &startTime}, organizationScanDest(&org)...)
+		if err := rows.Scan(append(dest, &totalRegs, &totalAttended)...); err != nil {
 			continue
 		}
 
-		org, _ := s.queries.GetOrganization(ctx, orgID)
-
 		var avgRate float64
 		if totalRegs > 0 {
 			avgRate = float64(totalAttended) / float64(totalRegs) * 100
@@ -473,20 +567,19 @@ func (s *StatisticsService) GetTopPerformingEvents(ctx context.Context, req *con
 			TotalRegistrations: totalRegs,
 			TotalAttendees:     totalAttended,
 			AttendanceRate:     avgRate,
-		}
-		if org.ID != 0 {
-			event.Organization = dbOrganizationToProto(org)
+			Organization:       dbOrganizationToProto(org),
 		}
 		events = append(events, event)
 	}
 
 	return connect.NewResponse(&eventsv1.GetTopPerformingEventsResponse{
 		Events: events,
+		Total:  total,
 	}), nil
 }
 
 func (s *StatisticsService) GetLowRegistrationEvents(ctx context.Context, req *connect.Request[eventsv1.GetLowRegistrationEventsRequest]) (*connect.Response[eventsv1.GetLowRegistrationEventsResponse], error) {
-	slog.Debug("GetLowRegistrationEvents", "threshold", req.Msg.Threshold)
+	logging.WithContext(ctx).Debug("GetLowRegistrationEvents", "threshold", req.Msg.Threshold)
 
 	daysAhead := int(req.Msg.DaysAhead)
 	if daysAhead <= 0 {
@@ -496,12 +589,13 @@ func (s *StatisticsService) GetLowRegistrationEvents(ctx context.Context, req *c
 	endDate := time.Now().AddDate(0, 0, daysAhead)
 
 	rows, err := s.pool.Query(ctx, `
-		SELECT e.id, e.title, e.image_url, e.start_time, e.organization_id,
+		SELECT e.id, e.title, e.image_url, e.start_time, e.capacity, `+organizationColumns+`,
 			COUNT(DISTINCT er.id) as total_regs
 		FROM events e
+		JOIN organizations o ON o.id = e.organization_id
 		LEFT JOIN event_registrations er ON er.event_id = e.id AND er.status = 'registered'
-		WHERE e.start_time >= NOW() AND e.start_time <= $1
-		GROUP BY e.id, e.title, e.image_url, e.start_time, e.organization_id
+		WHERE e.start_time >= NOW() AND e.start_time <= $1 AND NOT e.is_deleted
+		GROUP BY e.id, e.title, e.image_url, e.start_time, e.capacity, o.id
 		HAVING COUNT(DISTINCT er.id) < 10
 		ORDER BY e.start_time
 	`, endDate)
@@ -516,27 +610,32 @@ func (s *StatisticsService) GetLowRegistrationEvents(ctx context.Context, req *c
 		var title string
 		var imageURL *string
 		var startTime time.Time
-		var orgID int32
+		var capacity *int32
+		var org db.Organization
 		var totalRegs int32
-		if err := rows.Scan(&id, &title, &imageURL, &startTime, &orgID, &totalRegs); err != nil {
+		dest := append([]any{&id, &title, &imageURL, &startTime, &capacity}, organizationScanDest(&org)...)
+		if err := rows.Scan(append(dest, &totalRegs)...); err != nil {
 			continue
 		}
 
-		org, _ := s.queries.GetOrganization(ctx, orgID)
 		daysUntil := int32(time.Until(startTime).Hours() / 24)
 
+		// Events without a capacity are measured against a nominal 100 seats
+		eventCapacity := int32(100)
+		if capacity != nil && *capacity > 0 {
+			eventCapacity = *capacity
+		}
+
 		event := &eventsv1.LowRegistrationEvent{
 			Id:                  id,
 			Title:               title,
 			ImageUrl:            imageURL,
 			StartTime:           startTime.Format(time.RFC3339),
-			Capacity:            100, // TODO: Add capacity field to events
+			Capacity:            eventCapacity,
 			TotalRegistrations:  totalRegs,
-			CapacityUtilization: float64(totalRegs) / 100 * 100,
+			CapacityUtilization: float64(totalRegs) / float64(eventCapacity) * 100,
 			DaysUntilEvent:      daysUntil,
-		}
-		if org.ID != 0 {
-			event.Organization = dbOrganizationToProto(org)
+			Organization:        dbOrganizationToProto(org),
 		}
 		events = append(events, event)
 	}
@@ -547,12 +646,13 @@ func (s *StatisticsService) GetLowRegistrationEvents(ctx context.Context, req *c
 }
 
 func (s *StatisticsService) GetOrganizationActivity(ctx context.Context, req *connect.Request[eventsv1.GetOrganizationActivityRequest]) (*connect.Response[eventsv1.GetOrganizationActivityResponse], error) {
-	slog.Debug("GetOrganizationActivity", "limit", req.Msg.Limit)
+	logging.WithContext(ctx).Debug("GetOrganizationActivity", "limit", req.Msg.Limit)
 
 	limit := int(req.Msg.Limit)
 	if limit <= 0 {
 		limit = 20
 	}
+	limit = min(limit, s.cfg.MaxPageSize)
 
 	now := time.Now()
 	thisMonthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
@@ -564,7 +664,7 @@ func (s *StatisticsService) GetOrganizationActivity(ctx context.Context, req *co
 			COUNT(DISTINCT CASE WHEN e.start_time >= $2 AND e.start_time < $1 THEN e.id END) as events_last_month,
 			COUNT(DISTINCT e.id) as total_events
 		FROM organizations o
-		LEFT JOIN events e ON e.organization_id = o.id
+		LEFT JOIN events e ON e.organization_id = o.id AND NOT e.is_deleted
 		GROUP BY o.id, o.title, o.image_url
 		ORDER BY events_this_month DESC, total_events DESC
 		LIMIT $3
@@ -607,3 +707,141 @@ func (s *StatisticsService) GetOrganizationActivity(ctx context.Context, req *co
 		Organizations: orgs,
 	}), nil
 }
+
+// GetStatisticsForOrganization summarizes one club's events that start within
+// the last days. Club presidents and platform staff (through manage_settings)
+// may call it.
+func (s *StatisticsService) GetStatisticsForOrganization(ctx context.Context, req *connect.Request[eventsv1.GetStatisticsForOrganizationRequest]) (*connect.Response[eventsv1.OrganizationStatisticsResponse], error) {
+	logging.WithContext(ctx).Debug("GetStatisticsForOrganization", "organizationId", req.Msg.OrganizationId, "days", req.Msg.Days)
+
+	userID := auth.GetUserID(ctx)
+	if userID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	if s.perms != nil {
+		allowed, err := s.perms.CheckPermission(ctx, userID, "club", fmt.Sprintf("%d", req.Msg.OrganizationId), "manage_settings")
+		if err != nil {
+			logging.WithContext(ctx).Warn("Permission check failed", "error", err)
+		}
+		if !allowed {
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("you don't have permission to view this club's statistics"))
+		}
+	}
+
+	if _, err := s.queries.GetOrganization(ctx, req.Msg.OrganizationId); err != nil {
+		return nil, dberrors.MapNotFound(err, "organization not found")
+	}
+
+	days := int(req.Msg.Days)
+	if days <= 0 {
+		days = 90
+	}
+	startDate := time.Now().AddDate(0, 0, -days)
+	orgID := req.Msg.OrganizationId
+
+	resp := &eventsv1.OrganizationStatisticsResponse{OrganizationId: orgID}
+	if err := s.pool.QueryRow(ctx, `
+		SELECT COUNT(DISTINCT e.id),
+			COUNT(DISTINCT CASE WHEN er.status = 'registered' THEN er.id END),
+			COUNT(DISTINCT CASE WHEN ea.status = 'attended' THEN ea.id END)
+		FROM events e
+		LEFT JOIN event_registrations er ON er.event_id = e.id
+		LEFT JOIN event_attendance ea ON ea.registration_id = er.id
+		WHERE e.organization_id = $1 AND e.start_time >= $2 AND NOT e.is_deleted
+	`, orgID, startDate).Scan(&resp.TotalEvents, &resp.TotalRegistrations, &resp.TotalAttendees); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if resp.TotalRegistrations > 0 {
+		resp.AttendanceRate = float64(resp.TotalAttendees) / float64(resp.TotalRegistrations) * 100
+	}
+
+	tagRows, err := s.pool.Query(ctx, `
+		SELECT t.id, t.name, COUNT(DISTINCT e.id) as event_count
+		FROM tags t
+		INNER JOIN event_tags et ON et.tag_id = t.id
+		INNER JOIN events e ON e.id = et.event_id
+		WHERE e.organization_id = $1 AND e.start_time >= $2 AND NOT e.is_deleted
+		GROUP BY t.id, t.name
+		ORDER BY event_count DESC, t.id
+		LIMIT 5
+	`, orgID, startDate)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	defer tagRows.Close()
+	for tagRows.Next() {
+		tag := &eventsv1.TagDistribution{}
+		if err := tagRows.Scan(&tag.TagId, &tag.TagName, &tag.EventCount); err != nil {
+			continue
+		}
+		resp.TopTags = append(resp.TopTags, tag)
+	}
+
+	trendRows, err := s.pool.Query(ctx, `
+		SELECT DATE(e.start_time) as date,
+			COUNT(DISTINCT e.id) as event_count,
+			COUNT(DISTINCT er.id) as reg_count
+		FROM events e
+		LEFT JOIN event_registrations er ON er.event_id = e.id AND er.status = 'registered'
+		WHERE e.organization_id = $1 AND e.start_time >= $2 AND NOT e.is_deleted
+		GROUP BY DATE(e.start_time)
+		ORDER BY date
+	`, orgID, startDate)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	defer trendRows.Close()
+	for trendRows.Next() {
+		var date time.Time
+		trend := &eventsv1.EventTrend{}
+		if err := trendRows.Scan(&date, &trend.EventCount, &trend.RegistrationCount); err != nil {
+			continue
+		}
+		trend.Date = date.Format("2006-01-02")
+		resp.Trends = append(resp.Trends, trend)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// organizationColumns selects every organizations column (aliased as o) in
+// db.Organization field order, for scanning with organizationScanDest.
+const organizationColumns = `o.id, o.title, o.image_url, o.description, o.organization_type_id,
+			o.instagram, o.telegram_channel, o.telegram_chat, o.website, o.youtube, o.tiktok, o.linkedin,
+			o.status, o.created_at, o.updated_at, o.monthly_event_quota, o.contact_email`
+
+func organizationScanDest(o *db.Organization) []any {
+	return []any{
+		&o.ID, &o.Title, &o.ImageUrl, &o.Description, &o.OrganizationTypeID,
+		&o.Instagram, &o.TelegramChannel, &o.TelegramChat, &o.Website, &o.Youtube, &o.Tiktok, &o.Linkedin,
+		&o.Status, &o.CreatedAt, &o.UpdatedAt, &o.MonthlyEventQuota, &o.ContactEmail,
+	}
+}
+
+// attendanceRateGaugeInterval is how often the platform attendance gauge is refreshed
+const attendanceRateGaugeInterval = 24 * time.Hour
+
+// RunAttendanceRateGauge keeps metrics.AvgPlatformAttendanceRate up to date
+// until ctx is cancelled. It sets the gauge once at startup, then daily.
+func (s *StatisticsService) RunAttendanceRateGauge(ctx context.Context) {
+	ticker := time.NewTicker(attendanceRateGaugeInterval)
+	defer ticker.Stop()
+
+	for {
+		var avgRate float64
+		if err := s.pool.QueryRow(ctx, stats.AverageAttendanceRateQuery).Scan(&avgRate); err != nil {
+			if ctx.Err() == nil {
+				slog.Warn("Failed to compute platform attendance rate", "error", err)
+			}
+		} else {
+			metrics.AvgPlatformAttendanceRate.Set(avgRate)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
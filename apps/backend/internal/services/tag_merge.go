@@ -0,0 +1,124 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
+	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/dberrors"
+	"github.com/studyverse/ems-backend/internal/logging"
+	"github.com/studyverse/ems-backend/internal/search"
+)
+
+// MergeTags folds a duplicate tag into another: events tagged with the
+// source get the target tag instead and the source tag is deleted
+func (s *TagsService) MergeTags(ctx context.Context, req *connect.Request[eventsv1.MergeTagsRequest]) (*connect.Response[eventsv1.MergeTagsResponse], error) {
+	logging.WithContext(ctx).Debug("MergeTags", "sourceTagId", req.Msg.SourceTagId, "targetTagId", req.Msg.TargetTagId)
+
+	userID := auth.GetUserID(ctx)
+	if userID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	if err := requirePlatformPermission(ctx, s.perms, userID, "manage_clubs", "merge tags"); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to begin transaction: %w", err))
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := s.queries.WithTx(tx)
+
+	tags := make(map[int32]db.Tag, 2)
+	for _, id := range []int32{req.Msg.SourceTagId, req.Msg.TargetTagId} {
+		tag, err := qtx.GetTag(ctx, id)
+		if err != nil {
+			return nil, dberrors.MapNotFound(err, fmt.Sprintf("tag %d not found", id))
+		}
+		tags[id] = tag
+	}
+	target := tags[req.Msg.TargetTagId]
+
+	// Read before the merge, afterwards the source tag has no events left
+	eventIDs, err := qtx.GetTagEventIDs(ctx, req.Msg.SourceTagId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if _, err := qtx.MergeEventTags(ctx, db.MergeEventTagsParams{
+		SourceID: req.Msg.SourceTagId,
+		TargetID: req.Msg.TargetTagId,
+	}); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to move event tags: %w", err))
+	}
+
+	if err := qtx.DeleteTag(ctx, req.Msg.SourceTagId); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete source tag: %w", err))
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to commit transaction: %w", err))
+	}
+
+	counts, err := s.queries.GetTagCounts(ctx, target.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	s.reindexMergedTags(ctx, req.Msg.SourceTagId, target.ID, counts.EventCount, eventIDs)
+
+	return connect.NewResponse(&eventsv1.MergeTagsResponse{
+		TargetTag:      dbTagWithCountsToProto(&target, counts.OrganizationCount, counts.EventCount),
+		EventsAffected: int32(len(eventIDs)),
+	}), nil
+}
+
+// reindexMergedTags removes the source tag from search, refreshes the
+// target's event count and re-indexes the moved events (async, don't block
+// response)
+func (s *TagsService) reindexMergedTags(ctx context.Context, sourceID, targetID, targetEventCount int32, eventIDs []int32) {
+	if s.search == nil {
+		return
+	}
+	go func() {
+		bg := context.Background()
+
+		if err := s.search.DeleteDocument(bg, search.IndexTags, sourceID); err != nil {
+			logging.WithContext(ctx).Warn("Failed to delete tag from search", "error", err, "tagId", sourceID)
+		}
+		if err := s.search.UpdateTagEventCount(bg, targetID, targetEventCount); err != nil {
+			logging.WithContext(ctx).Warn("Failed to update tag event count in search", "error", err, "tagId", targetID)
+		}
+
+		if len(eventIDs) == 0 {
+			return
+		}
+		events, err := s.queries.GetEventsMap(bg, eventIDs)
+		if err != nil {
+			logging.WithContext(ctx).Warn("Failed to load events of merged tag", "error", err)
+			return
+		}
+		// Unpublished and deleted events aren't in the index, indexing them
+		// here would make them searchable
+		var indexed []db.Event
+		for _, e := range events {
+			if e.Published && !e.IsDeleted {
+				indexed = append(indexed, e)
+			}
+		}
+		docs, err := search.BuildEventDocuments(bg, s.queries, indexed)
+		if err != nil {
+			logging.WithContext(ctx).Warn("Failed to build search documents of merged tag's events", "error", err)
+			return
+		}
+		if err := s.search.IndexEvents(bg, docs); err != nil {
+			logging.WithContext(ctx).Warn("Failed to re-index events of merged tag in search", "error", err, "count", len(docs))
+		}
+	}()
+}
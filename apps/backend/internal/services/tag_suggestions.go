@@ -0,0 +1,208 @@
+package services
+
+import (
+	"container/list"
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	searchv1 "github.com/studyverse/ems-backend/gen/searchv1"
+	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/logging"
+)
+
+const (
+	suggestionCacheTTL     = 5 * time.Minute
+	suggestionCacheSize    = 1000
+	defaultSuggestionLimit = 5
+	// similarEventsLimit is how many matching events are sampled for their tags,
+	// and the most suggestions returned
+	similarEventsLimit = 20
+)
+
+// SuggestTagsForEvent ranks tags by how often they appear on events with a
+// similar title. When no similar events exist it falls back to trigram
+// similarity between the title and the tag names.
+func (s *SearchService) SuggestTagsForEvent(ctx context.Context, req *connect.Request[searchv1.SuggestTagsForEventRequest]) (*connect.Response[searchv1.SuggestTagsForEventResponse], error) {
+	logging.WithContext(ctx).Debug("SuggestTagsForEvent", "title", req.Msg.Title, "limit", req.Msg.Limit)
+
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = defaultSuggestionLimit
+	}
+	if limit > similarEventsLimit {
+		limit = similarEventsLimit
+	}
+
+	// Collapsing whitespace lets titles that only differ in spacing share a cache entry
+	title := strings.Join(strings.Fields(strings.ToLower(req.Msg.Title)), " ")
+	if title == "" {
+		return connect.NewResponse(&searchv1.SuggestTagsForEventResponse{
+			Suggestions: []*searchv1.TagSuggestion{},
+		}), nil
+	}
+
+	suggestions, ok := s.suggestions.get(title)
+	if !ok {
+		var err error
+		suggestions, err = s.similarEventTags(ctx, title)
+		if err != nil {
+			logging.WithContext(ctx).Warn("Similar event search failed, falling back to tag names", "error", err)
+		}
+		if len(suggestions) == 0 {
+			// Fetch a full page so the cached list serves any limit
+			suggestions, err = s.similarTagNames(ctx, title, similarEventsLimit)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, err)
+			}
+		}
+		s.suggestions.set(title, suggestions)
+	}
+
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+
+	return connect.NewResponse(&searchv1.SuggestTagsForEventResponse{
+		Suggestions: suggestions,
+	}), nil
+}
+
+// similarEventTags counts tag occurrences across the events best matching title.
+// Confidence is the share of those events carrying the tag.
+func (s *SearchService) similarEventTags(ctx context.Context, title string) ([]*searchv1.TagSuggestion, error) {
+	if s.searchClient == nil {
+		return nil, nil
+	}
+
+	hits, err := s.searchClient.SearchEventTags(ctx, title, similarEventsLimit)
+	if err != nil {
+		return nil, err
+	}
+	if len(hits) == 0 {
+		return nil, nil
+	}
+
+	counts := make(map[int32]int)
+	for _, tagIDs := range hits {
+		for _, id := range tagIDs {
+			counts[id]++
+		}
+	}
+	if len(counts) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]int32, 0, len(counts))
+	for id := range counts {
+		ids = append(ids, id)
+	}
+	// Names come from the database, the index may hold renamed or deleted tags
+	tags, err := s.queries.GetTagsByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions := make([]*searchv1.TagSuggestion, len(tags))
+	for i, t := range tags {
+		suggestions[i] = &searchv1.TagSuggestion{
+			TagId:           t.ID,
+			Name:            t.Name,
+			ConfidenceScore: float64(counts[t.ID]) / float64(len(hits)),
+		}
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].ConfidenceScore != suggestions[j].ConfidenceScore {
+			return suggestions[i].ConfidenceScore > suggestions[j].ConfidenceScore
+		}
+		return suggestions[i].TagId < suggestions[j].TagId
+	})
+	return suggestions, nil
+}
+
+// similarTagNames ranks tags by pg_trgm similarity of their name to title
+func (s *SearchService) similarTagNames(ctx context.Context, title string, limit int) ([]*searchv1.TagSuggestion, error) {
+	rows, err := s.queries.SuggestTagsByName(ctx, db.SuggestTagsByNameParams{
+		Title: title,
+		Limit: int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions := make([]*searchv1.TagSuggestion, 0, len(rows))
+	for _, r := range rows {
+		if r.Score <= 0 {
+			continue
+		}
+		suggestions = append(suggestions, &searchv1.TagSuggestion{
+			TagId:           r.ID,
+			Name:            r.Name,
+			ConfidenceScore: r.Score,
+		})
+	}
+	return suggestions, nil
+}
+
+// suggestionCache holds ranked suggestions per normalized title. Titles
+// are free text, so besides expiring, entries are evicted least recently
+// used once the cache is full.
+type suggestionCache struct {
+	ttl     time.Duration
+	size    int
+	mu      sync.Mutex
+	order   *list.List // Most recently used at the front
+	entries map[string]*list.Element
+}
+
+type suggestionCacheEntry struct {
+	key         string
+	suggestions []*searchv1.TagSuggestion
+	expiresAt   time.Time
+}
+
+func newSuggestionCache(ttl time.Duration, size int) *suggestionCache {
+	return &suggestionCache{ttl: ttl, size: size, order: list.New(), entries: make(map[string]*list.Element)}
+}
+
+func (c *suggestionCache) get(key string) ([]*searchv1.TagSuggestion, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*suggestionCacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.order.Remove(elem)
+		delete(c.entries, key)
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return entry.suggestions, true
+}
+
+func (c *suggestionCache) set(key string, suggestions []*searchv1.TagSuggestion) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := time.Now().Add(c.ttl)
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*suggestionCacheEntry)
+		entry.suggestions = suggestions
+		entry.expiresAt = expiresAt
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&suggestionCacheEntry{key: key, suggestions: suggestions, expiresAt: expiresAt})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*suggestionCacheEntry).key)
+	}
+}
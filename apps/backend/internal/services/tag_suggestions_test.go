@@ -0,0 +1,43 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	searchv1 "github.com/studyverse/ems-backend/gen/searchv1"
+)
+
+func TestSuggestionCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	c := newSuggestionCache(time.Minute, 2)
+	suggestions := []*searchv1.TagSuggestion{{TagId: 1, Name: "hackathon"}}
+
+	c.set("a", suggestions)
+	c.set("b", suggestions)
+	if _, ok := c.get("a"); !ok {
+		t.Fatal("entry missing before the cache was full")
+	}
+	c.set("c", suggestions)
+
+	if _, ok := c.get("b"); ok {
+		t.Error("least recently used entry kept past the size limit")
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, ok := c.get(key); !ok {
+			t.Errorf("entry %q evicted, want it kept", key)
+		}
+	}
+	if c.order.Len() != 2 || len(c.entries) != 2 {
+		t.Errorf("cache holds %d/%d entries, want 2", c.order.Len(), len(c.entries))
+	}
+}
+
+func TestSuggestionCacheExpiry(t *testing.T) {
+	c := newSuggestionCache(-time.Second, 10)
+	c.set("a", nil)
+	if _, ok := c.get("a"); ok {
+		t.Error("expired entry returned")
+	}
+	if c.order.Len() != 0 || len(c.entries) != 0 {
+		t.Error("expired entry kept after get")
+	}
+}
@@ -3,15 +3,17 @@ package services
 import (
 	"context"
 	"fmt"
-	"log/slog"
 
 	"connectrpc.com/connect"
-	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
 	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
 	"github.com/studyverse/ems-backend/gen/eventsv1/eventsv1connect"
 	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/config"
 	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/dberrors"
+	"github.com/studyverse/ems-backend/internal/logging"
 	"github.com/studyverse/ems-backend/internal/perms"
 	"github.com/studyverse/ems-backend/internal/search"
 )
@@ -19,16 +21,18 @@ import (
 type TagsService struct {
 	eventsv1connect.UnimplementedTagsServiceHandler
 	queries *db.Queries
+	pool    *pgxpool.Pool
 	perms   *perms.Client
 	search  *search.Client
+	cfg     *config.Config
 }
 
-func NewTagsService(queries *db.Queries, permsClient *perms.Client, searchClient *search.Client) *TagsService {
-	return &TagsService{queries: queries, perms: permsClient, search: searchClient}
+func NewTagsService(queries *db.Queries, pool *pgxpool.Pool, permsClient *perms.Client, searchClient *search.Client, cfg *config.Config) *TagsService {
+	return &TagsService{queries: queries, pool: pool, perms: permsClient, search: searchClient, cfg: cfg}
 }
 
 func (s *TagsService) CreateTag(ctx context.Context, req *connect.Request[eventsv1.CreateTagRequest]) (*connect.Response[eventsv1.CreateTagResponse], error) {
-	slog.Debug("CreateTag", "name", req.Msg.Name)
+	logging.WithContext(ctx).Debug("CreateTag", "name", req.Msg.Name)
 
 	// Authorization: Only platform staff can create tags
 	userID := auth.GetUserID(ctx)
@@ -36,19 +40,13 @@ func (s *TagsService) CreateTag(ctx context.Context, req *connect.Request[events
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
 	}
 
-	if s.perms != nil {
-		allowed, err := s.perms.CheckPermission(ctx, userID, "platform", "astanait", "manage_clubs")
-		if err != nil {
-			slog.Warn("Permission check failed", "error", err)
-		}
-		if !allowed {
-			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("you don't have permission to create tags"))
-		}
+	if err := requirePlatformPermission(ctx, s.perms, userID, "manage_clubs", "create tags"); err != nil {
+		return nil, err
 	}
 
 	tag, err := s.queries.CreateTag(ctx, req.Msg.Name)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, dberrors.Map(err)
 	}
 
 	// Index tag in Meilisearch (async, don't block response)
@@ -60,7 +58,7 @@ func (s *TagsService) CreateTag(ctx context.Context, req *connect.Request[events
 				CreatedAt: tag.CreatedAt.Time.Format("2006-01-02T15:04:05Z07:00"),
 			}
 			if err := s.search.IndexTag(context.Background(), doc); err != nil {
-				slog.Warn("Failed to index tag in search", "error", err, "tagId", tag.ID)
+				logging.WithContext(ctx).Warn("Failed to index tag in search", "error", err, "tagId", tag.ID)
 			}
 		}()
 	}
@@ -71,23 +69,25 @@ func (s *TagsService) CreateTag(ctx context.Context, req *connect.Request[events
 }
 
 func (s *TagsService) GetTag(ctx context.Context, req *connect.Request[eventsv1.GetTagRequest]) (*connect.Response[eventsv1.GetTagResponse], error) {
-	slog.Debug("GetTag", "id", req.Msg.Id)
+	logging.WithContext(ctx).Debug("GetTag", "id", req.Msg.Id)
 
 	tag, err := s.queries.GetTag(ctx, req.Msg.Id)
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return nil, connect.NewError(connect.CodeNotFound, nil)
-		}
+		return nil, dberrors.Map(err)
+	}
+
+	counts, err := s.queries.GetTagCounts(ctx, tag.ID)
+	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
 	return connect.NewResponse(&eventsv1.GetTagResponse{
-		Tag: dbTagToProto(&tag),
+		Tag: dbTagWithCountsToProto(&tag, counts.OrganizationCount, counts.EventCount),
 	}), nil
 }
 
 func (s *TagsService) ListTags(ctx context.Context, req *connect.Request[eventsv1.ListTagsRequest]) (*connect.Response[eventsv1.ListTagsResponse], error) {
-	slog.Debug("ListTags", "page", req.Msg.Page, "limit", req.Msg.Limit)
+	logging.WithContext(ctx).Debug("ListTags", "page", req.Msg.Page, "limit", req.Msg.Limit, "minEventCount", req.Msg.GetMinEventCount())
 
 	page := req.Msg.Page
 	if page <= 0 {
@@ -97,23 +97,31 @@ func (s *TagsService) ListTags(ctx context.Context, req *connect.Request[eventsv
 	if limit <= 0 {
 		limit = 10
 	}
+	limit = min(limit, int32(s.cfg.MaxPageSize))
 
 	tags, err := s.queries.ListTags(ctx, db.ListTagsParams{
-		Limit:  limit,
-		Offset: (page - 1) * limit,
+		Limit:         limit,
+		Offset:        (page - 1) * limit,
+		MinEventCount: req.Msg.GetMinEventCount(),
+		ByEventCount:  req.Msg.SortBy == eventsv1.TagSortBy_TAG_SORT_BY_EVENT_COUNT_DESC,
 	})
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	total, err := s.queries.CountTags(ctx)
+	var total int64
+	if req.Msg.MinEventCount != nil {
+		total, err = s.queries.CountTagsWithMinEventCount(ctx, req.Msg.GetMinEventCount())
+	} else {
+		total, err = s.queries.CountTags(ctx)
+	}
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
 	protoTags := make([]*eventsv1.Tag, len(tags))
-	for i, t := range tags {
-		protoTags[i] = dbTagToProto(&t)
+	for i, row := range tags {
+		protoTags[i] = dbTagWithCountsToProto(&row.Tag, row.OrganizationCount, row.EventCount)
 	}
 
 	return connect.NewResponse(&eventsv1.ListTagsResponse{
@@ -123,7 +131,7 @@ func (s *TagsService) ListTags(ctx context.Context, req *connect.Request[eventsv
 }
 
 func (s *TagsService) UpdateTag(ctx context.Context, req *connect.Request[eventsv1.UpdateTagRequest]) (*connect.Response[eventsv1.UpdateTagResponse], error) {
-	slog.Debug("UpdateTag", "id", req.Msg.Id)
+	logging.WithContext(ctx).Debug("UpdateTag", "id", req.Msg.Id)
 
 	// Authorization: Only platform staff can update tags
 	userID := auth.GetUserID(ctx)
@@ -131,14 +139,8 @@ func (s *TagsService) UpdateTag(ctx context.Context, req *connect.Request[events
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
 	}
 
-	if s.perms != nil {
-		allowed, err := s.perms.CheckPermission(ctx, userID, "platform", "astanait", "manage_clubs")
-		if err != nil {
-			slog.Warn("Permission check failed", "error", err)
-		}
-		if !allowed {
-			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("you don't have permission to update tags"))
-		}
+	if err := requirePlatformPermission(ctx, s.perms, userID, "manage_clubs", "update tags"); err != nil {
+		return nil, err
 	}
 
 	params := db.UpdateTagParams{
@@ -150,9 +152,11 @@ func (s *TagsService) UpdateTag(ctx context.Context, req *connect.Request[events
 
 	tag, err := s.queries.UpdateTag(ctx, params)
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return nil, connect.NewError(connect.CodeNotFound, nil)
-		}
+		return nil, dberrors.Map(err)
+	}
+
+	counts, err := s.queries.GetTagCounts(ctx, tag.ID)
+	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
@@ -160,23 +164,24 @@ func (s *TagsService) UpdateTag(ctx context.Context, req *connect.Request[events
 	if s.search != nil {
 		go func() {
 			doc := &search.TagDocument{
-				ID:        tag.ID,
-				Name:      tag.Name,
-				CreatedAt: tag.CreatedAt.Time.Format("2006-01-02T15:04:05Z07:00"),
+				ID:         tag.ID,
+				Name:       tag.Name,
+				EventCount: counts.EventCount,
+				CreatedAt:  tag.CreatedAt.Time.Format("2006-01-02T15:04:05Z07:00"),
 			}
 			if err := s.search.IndexTag(context.Background(), doc); err != nil {
-				slog.Warn("Failed to re-index tag in search", "error", err, "tagId", tag.ID)
+				logging.WithContext(ctx).Warn("Failed to re-index tag in search", "error", err, "tagId", tag.ID)
 			}
 		}()
 	}
 
 	return connect.NewResponse(&eventsv1.UpdateTagResponse{
-		Tag: dbTagToProto(&tag),
+		Tag: dbTagWithCountsToProto(&tag, counts.OrganizationCount, counts.EventCount),
 	}), nil
 }
 
 func (s *TagsService) DeleteTag(ctx context.Context, req *connect.Request[eventsv1.DeleteTagRequest]) (*connect.Response[eventsv1.DeleteTagResponse], error) {
-	slog.Debug("DeleteTag", "id", req.Msg.Id)
+	logging.WithContext(ctx).Debug("DeleteTag", "id", req.Msg.Id)
 
 	// Authorization: Only platform staff can delete tags
 	userID := auth.GetUserID(ctx)
@@ -184,14 +189,8 @@ func (s *TagsService) DeleteTag(ctx context.Context, req *connect.Request[events
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
 	}
 
-	if s.perms != nil {
-		allowed, err := s.perms.CheckPermission(ctx, userID, "platform", "astanait", "manage_clubs")
-		if err != nil {
-			slog.Warn("Permission check failed", "error", err)
-		}
-		if !allowed {
-			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("you don't have permission to delete tags"))
-		}
+	if err := requirePlatformPermission(ctx, s.perms, userID, "manage_clubs", "delete tags"); err != nil {
+		return nil, err
 	}
 
 	err := s.queries.DeleteTag(ctx, req.Msg.Id)
@@ -204,7 +203,7 @@ func (s *TagsService) DeleteTag(ctx context.Context, req *connect.Request[events
 		tagID := req.Msg.Id
 		go func() {
 			if err := s.search.DeleteDocument(context.Background(), search.IndexTags, tagID); err != nil {
-				slog.Warn("Failed to delete tag from search", "error", err, "tagId", tagID)
+				logging.WithContext(ctx).Warn("Failed to delete tag from search", "error", err, "tagId", tagID)
 			}
 		}()
 	}
@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"connectrpc.com/connect"
+	searchv1 "github.com/studyverse/ems-backend/gen/searchv1"
+	usersv1 "github.com/studyverse/ems-backend/gen/usersv1"
+	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/logging"
+)
+
+// maxUserSearchResults caps the search hits SearchUsers filters and pages through
+const maxUserSearchResults = 200
+
+// SearchUsers searches the users index and narrows the hits to a platform
+// role and/or the members of a club using SpiceDB
+func (s *SearchService) SearchUsers(ctx context.Context, req *connect.Request[searchv1.SearchUsersRequest]) (*connect.Response[searchv1.SearchUsersResponse], error) {
+	logging.WithContext(ctx).Debug("SearchUsers", "query", req.Msg.Query, "platformRoleFilter", req.Msg.PlatformRoleFilter, "orgIdFilter", req.Msg.OrgIdFilter, "page", req.Msg.Page, "limit", req.Msg.Limit)
+
+	userID := auth.GetUserID(ctx)
+	if userID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	if err := requirePlatformPermission(ctx, s.perms, userID, "manage_system", "search users"); err != nil {
+		return nil, err
+	}
+
+	if s.searchClient == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("search service not available"))
+	}
+
+	roleFilter := req.Msg.PlatformRoleFilter
+	if roleFilter != nil && *roleFilter != usersv1.PlatformRole_PLATFORM_ROLE_STAFF && *roleFilter != usersv1.PlatformRole_PLATFORM_ROLE_ADMIN {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("platform_role_filter must be STAFF or ADMIN"))
+	}
+
+	page := req.Msg.Page
+	if page <= 0 {
+		page = 1
+	}
+	limit := req.Msg.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+	limit = min(limit, int32(s.cfg.MaxPageSize))
+
+	ids, err := s.searchClient.SearchUserIDs(ctx, req.Msg.Query, maxUserSearchResults)
+	if err != nil {
+		logging.WithContext(ctx).Error("SearchUsers failed", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("search failed: %w", err))
+	}
+
+	users, err := s.queries.GetUsersMap(ctx, ids)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Roles are needed for every result, so look the role holders up once
+	// instead of checking each user
+	adminIDs, err := s.perms.GetPlatformAdmins(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to lookup platform admins: %w", err))
+	}
+	staffIDs, err := s.perms.GetPlatformStaff(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to lookup platform staff: %w", err))
+	}
+	admins := newSubjectSet(adminIDs)
+	staff := newSubjectSet(staffIDs)
+
+	var members subjectSet
+	if req.Msg.OrgIdFilter != nil {
+		memberIDs, err := s.perms.LookupSubjects(ctx, "club", strconv.Itoa(int(*req.Msg.OrgIdFilter)), "member")
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to lookup club members: %w", err))
+		}
+		members = newSubjectSet(memberIDs)
+	}
+
+	matched := make([]*usersv1.User, 0, len(ids))
+	for _, id := range ids {
+		// The index can lag behind deleted users
+		u, ok := users[id]
+		if !ok {
+			continue
+		}
+
+		role := usersv1.PlatformRole_PLATFORM_ROLE_USER
+		if admins.has(u) {
+			role = usersv1.PlatformRole_PLATFORM_ROLE_ADMIN
+		} else if staff.has(u) {
+			role = usersv1.PlatformRole_PLATFORM_ROLE_STAFF
+		}
+
+		if roleFilter != nil {
+			// Staff includes admins, same as GetPlatformRoleMembers
+			if *roleFilter == usersv1.PlatformRole_PLATFORM_ROLE_ADMIN && !admins.has(u) {
+				continue
+			}
+			if *roleFilter == usersv1.PlatformRole_PLATFORM_ROLE_STAFF && !staff.has(u) {
+				continue
+			}
+		}
+		if members != nil && !members.has(u) {
+			continue
+		}
+
+		matched = append(matched, dbUserToProtoWithRole(u, role))
+	}
+
+	total := int32(len(matched))
+	offset := min((page-1)*limit, total)
+	end := min(offset+limit, total)
+
+	return connect.NewResponse(&searchv1.SearchUsersResponse{
+		Users: matched[offset:end],
+		Total: total,
+	}), nil
+}
+
+// subjectSet holds SpiceDB user subject IDs
+type subjectSet map[string]bool
+
+func newSubjectSet(subjectIDs []string) subjectSet {
+	set := make(subjectSet, len(subjectIDs))
+	for _, id := range subjectIDs {
+		set[id] = true
+	}
+	return set
+}
+
+// has reports whether the user is in the set. Subjects are Kratos identity
+// IDs, except for relationships written with the local numeric user ID.
+func (set subjectSet) has(u db.User) bool {
+	if u.KratosID.Valid && set[u.KratosID.String] {
+		return true
+	}
+	return set[strconv.Itoa(int(u.ID))]
+}
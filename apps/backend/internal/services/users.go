@@ -2,35 +2,47 @@ package services
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"log/slog"
+	"strconv"
 	"strings"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	ory "github.com/ory/kratos-client-go"
 	usersv1 "github.com/studyverse/ems-backend/gen/usersv1"
 	"github.com/studyverse/ems-backend/gen/usersv1/usersv1connect"
+	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/config"
 	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/dberrors"
+	"github.com/studyverse/ems-backend/internal/logging"
 	"github.com/studyverse/ems-backend/internal/perms"
 	"github.com/studyverse/ems-backend/internal/search"
 )
 
 type UsersService struct {
 	usersv1connect.UnimplementedUsersServiceHandler
-	queries *db.Queries
-	perms   *perms.Client
-	search  *search.Client
+	queries  *db.Queries
+	pool     *pgxpool.Pool
+	perms    *perms.Client
+	search   *search.Client
+	kratos   *auth.KratosAdminClient
+	sessions *auth.SessionCache
+	cfg      *config.Config
 }
 
-func NewUsersService(queries *db.Queries, permsClient *perms.Client, searchClient *search.Client) *UsersService {
-	return &UsersService{queries: queries, perms: permsClient, search: searchClient}
+func NewUsersService(queries *db.Queries, pool *pgxpool.Pool, permsClient *perms.Client, searchClient *search.Client, kratosAdmin *auth.KratosAdminClient, sessions *auth.SessionCache, cfg *config.Config) *UsersService {
+	return &UsersService{queries: queries, pool: pool, perms: permsClient, search: searchClient, kratos: kratosAdmin, sessions: sessions, cfg: cfg}
 }
 
 func (s *UsersService) CreateUser(ctx context.Context, req *connect.Request[usersv1.CreateUserRequest]) (*connect.Response[usersv1.CreateUserResponse], error) {
-	slog.Debug("CreateUser", "username", req.Msg.Username, "email", req.Msg.Email)
+	logging.WithContext(ctx).Debug("CreateUser", "username", req.Msg.Username, "email", req.Msg.Email)
 
 	// Note: Password hashing is handled by Ory Kratos for authenticated users.
 	// This endpoint is for local user creation only (dev/admin purposes).
@@ -41,7 +53,7 @@ func (s *UsersService) CreateUser(ctx context.Context, req *connect.Request[user
 		Password: req.Msg.Password,
 	})
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, dberrors.Map(err)
 	}
 
 	// Index user in Meilisearch (async, don't block response)
@@ -54,25 +66,24 @@ func (s *UsersService) CreateUser(ctx context.Context, req *connect.Request[user
 				CreatedAt: user.CreatedAt.Time.Format(time.RFC3339),
 			}
 			if err := s.search.IndexUser(context.Background(), doc); err != nil {
-				slog.Warn("Failed to index user in search", "error", err, "userId", user.ID)
+				logging.WithContext(ctx).Warn("Failed to index user in search", "error", err, "userId", user.ID)
 			}
 		}()
 	}
 
+	recordAudit(ctx, s.queries, req, "user", user.ID)
+
 	return connect.NewResponse(&usersv1.CreateUserResponse{
 		User: s.dbUserToProto(ctx, user),
 	}), nil
 }
 
 func (s *UsersService) GetUser(ctx context.Context, req *connect.Request[usersv1.GetUserRequest]) (*connect.Response[usersv1.GetUserResponse], error) {
-	slog.Debug("GetUser", "id", req.Msg.Id)
+	logging.WithContext(ctx).Debug("GetUser", "id", req.Msg.Id)
 
 	user, err := s.queries.GetUser(ctx, req.Msg.Id)
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return nil, connect.NewError(connect.CodeNotFound, nil)
-		}
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, dberrors.Map(err)
 	}
 
 	return connect.NewResponse(&usersv1.GetUserResponse{
@@ -80,15 +91,57 @@ func (s *UsersService) GetUser(ctx context.Context, req *connect.Request[usersv1
 	}), nil
 }
 
+// WhoAmI returns the authenticated user with their platform role and managed
+// clubs, creating the local user on first sight of the Kratos identity
+func (s *UsersService) WhoAmI(ctx context.Context, req *connect.Request[usersv1.WhoAmIRequest]) (*connect.Response[usersv1.WhoAmIResponse], error) {
+	logging.WithContext(ctx).Debug("WhoAmI")
+
+	kratosID := auth.GetUserID(ctx)
+	if kratosID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	user, err := s.queries.GetUserByKratosID(ctx, pgtype.Text{String: kratosID, Valid: true})
+	if errors.Is(err, pgx.ErrNoRows) {
+		email := auth.GetUserEmail(ctx)
+		if email == "" {
+			email = kratosID + "@placeholder.local"
+		}
+		username, _, _ := strings.Cut(email, "@")
+		user, err = s.queries.CreateUserFromKratos(ctx, db.CreateUserFromKratosParams{
+			KratosID: pgtype.Text{String: kratosID, Valid: true},
+			Email:    email,
+			Username: username,
+		})
+	}
+	if err != nil {
+		logging.WithContext(ctx).Error("Failed to get/create local user", "error", err, "kratosId", kratosID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to sync user: %w", err))
+	}
+
+	protoUser := s.dbUserToProto(ctx, user)
+	managedClubs := []int32{}
+	if s.perms != nil {
+		ids, err := s.perms.GetManagedClubs(ctx, spiceDBUserSubject(user))
+		if err != nil {
+			logging.WithContext(ctx).Warn("Failed to look up managed clubs", "error", err, "userId", user.ID)
+		}
+		managedClubs = parseClubIDs(ctx, ids)
+	}
+
+	return connect.NewResponse(&usersv1.WhoAmIResponse{
+		User:         protoUser,
+		PlatformRole: protoUser.PlatformRole,
+		ManagedClubs: managedClubs,
+	}), nil
+}
+
 func (s *UsersService) GetUserByEmail(ctx context.Context, req *connect.Request[usersv1.GetUserByEmailRequest]) (*connect.Response[usersv1.GetUserByEmailResponse], error) {
-	slog.Debug("GetUserByEmail", "email", req.Msg.Email)
+	logging.WithContext(ctx).Debug("GetUserByEmail", "email", req.Msg.Email)
 
 	user, err := s.queries.GetUserByEmail(ctx, req.Msg.Email)
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return nil, connect.NewError(connect.CodeNotFound, nil)
-		}
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, dberrors.Map(err)
 	}
 
 	return connect.NewResponse(&usersv1.GetUserByEmailResponse{
@@ -97,14 +150,11 @@ func (s *UsersService) GetUserByEmail(ctx context.Context, req *connect.Request[
 }
 
 func (s *UsersService) GetUserByUsername(ctx context.Context, req *connect.Request[usersv1.GetUserByUsernameRequest]) (*connect.Response[usersv1.GetUserByUsernameResponse], error) {
-	slog.Debug("GetUserByUsername", "username", req.Msg.Username)
+	logging.WithContext(ctx).Debug("GetUserByUsername", "username", req.Msg.Username)
 
 	user, err := s.queries.GetUserByUsername(ctx, req.Msg.Username)
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return nil, connect.NewError(connect.CodeNotFound, nil)
-		}
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, dberrors.Map(err)
 	}
 
 	return connect.NewResponse(&usersv1.GetUserByUsernameResponse{
@@ -113,7 +163,7 @@ func (s *UsersService) GetUserByUsername(ctx context.Context, req *connect.Reque
 }
 
 func (s *UsersService) ListUsers(ctx context.Context, req *connect.Request[usersv1.ListUsersRequest]) (*connect.Response[usersv1.ListUsersResponse], error) {
-	slog.Debug("ListUsers", "page", req.Msg.Page, "limit", req.Msg.Limit)
+	logging.WithContext(ctx).Debug("ListUsers", "page", req.Msg.Page, "limit", req.Msg.Limit)
 
 	page := req.Msg.Page
 	if page <= 0 {
@@ -123,6 +173,7 @@ func (s *UsersService) ListUsers(ctx context.Context, req *connect.Request[users
 	if limit <= 0 {
 		limit = 50
 	}
+	limit = min(limit, int32(s.cfg.MaxPageSize))
 
 	users, err := s.queries.ListUsers(ctx, db.ListUsersParams{
 		Limit:  limit,
@@ -150,7 +201,7 @@ func (s *UsersService) ListUsers(ctx context.Context, req *connect.Request[users
 }
 
 func (s *UsersService) UpdateUser(ctx context.Context, req *connect.Request[usersv1.UpdateUserRequest]) (*connect.Response[usersv1.UpdateUserResponse], error) {
-	slog.Debug("UpdateUser", "id", req.Msg.Id)
+	logging.WithContext(ctx).Debug("UpdateUser", "id", req.Msg.Id)
 
 	params := db.UpdateUserParams{
 		ID: req.Msg.Id,
@@ -164,10 +215,7 @@ func (s *UsersService) UpdateUser(ctx context.Context, req *connect.Request[user
 
 	user, err := s.queries.UpdateUser(ctx, params)
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return nil, connect.NewError(connect.CodeNotFound, nil)
-		}
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, dberrors.Map(err)
 	}
 
 	// Re-index user in Meilisearch (async, don't block response)
@@ -180,41 +228,166 @@ func (s *UsersService) UpdateUser(ctx context.Context, req *connect.Request[user
 				CreatedAt: user.CreatedAt.Time.Format(time.RFC3339),
 			}
 			if err := s.search.IndexUser(context.Background(), doc); err != nil {
-				slog.Warn("Failed to re-index user in search", "error", err, "userId", user.ID)
+				logging.WithContext(ctx).Warn("Failed to re-index user in search", "error", err, "userId", user.ID)
 			}
 		}()
 	}
 
+	recordAudit(ctx, s.queries, req, "user", user.ID)
+
 	return connect.NewResponse(&usersv1.UpdateUserResponse{
 		User: s.dbUserToProto(ctx, user),
 	}), nil
 }
 
 func (s *UsersService) DeleteUser(ctx context.Context, req *connect.Request[usersv1.DeleteUserRequest]) (*connect.Response[usersv1.DeleteUserResponse], error) {
-	slog.Debug("DeleteUser", "id", req.Msg.Id)
+	logging.WithContext(ctx).Debug("DeleteUser", "id", req.Msg.Id)
+
+	// Deleting also removes the Kratos identity and club roles, so only
+	// platform admins may do it
+	if err := s.requireSystemAdmin(ctx, "delete users"); err != nil {
+		return nil, err
+	}
+
+	// Look up the Kratos identity first, it is gone once the row is deleted
+	user, err := s.queries.GetUser(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, dberrors.Map(err)
+	}
 
-	err := s.queries.DeleteUser(ctx, req.Msg.Id)
+	err = s.queries.DeleteUser(ctx, req.Msg.Id)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	// Drop the user's club roles so SpiceDB doesn't keep granting them to a
+	// reused subject ID
+	if s.perms != nil {
+		if err := s.perms.RevokeClubRoleForAllClubs(ctx, spiceDBUserSubject(user)); err != nil {
+			logging.WithContext(ctx).Warn("Failed to revoke club roles in SpiceDB", "error", err, "userId", user.ID)
+		}
+	}
+
+	// Delete the Kratos identity so the user can't log back in and get re-provisioned.
+	// The local row is the primary record, so a failure here doesn't fail the RPC.
+	if s.kratos != nil && user.KratosID.Valid {
+		if err := s.kratos.DeleteIdentity(ctx, user.KratosID.String); err != nil {
+			logging.WithContext(ctx).Warn("Failed to delete Kratos identity", "error", err, "userId", user.ID, "kratosId", user.KratosID.String)
+		}
+	}
+	if user.KratosID.Valid {
+		s.sessions.PurgeIdentity(user.KratosID.String)
+	}
+
 	// Remove user from Meilisearch (async, don't block response)
 	if s.search != nil {
 		userID := req.Msg.Id
 		go func() {
 			if err := s.search.DeleteDocument(context.Background(), search.IndexUsers, userID); err != nil {
-				slog.Warn("Failed to delete user from search", "error", err, "userId", userID)
+				logging.WithContext(ctx).Warn("Failed to delete user from search", "error", err, "userId", userID)
 			}
 		}()
 	}
 
+	recordAudit(ctx, s.queries, req, "user", req.Msg.Id)
+
 	return connect.NewResponse(&usersv1.DeleteUserResponse{
 		Success: true,
 	}), nil
 }
 
+// foreignKeyViolation is the Postgres SQLSTATE of a foreign key violation
+const foreignKeyViolation = "23503"
+
+// DeleteUserAndCleanup deletes a user together with their club roles and
+// every SpiceDB relationship they are the subject of. Optional references to
+// the user are cleared; events they created must be reassigned or deleted
+// first. SpiceDB is only cleaned up once the row delete has gone through, and
+// a failed cleanup rolls the delete back, so the call can be retried.
+func (s *UsersService) DeleteUserAndCleanup(ctx context.Context, req *connect.Request[usersv1.DeleteUserAndCleanupRequest]) (*connect.Response[usersv1.DeleteUserAndCleanupResponse], error) {
+	logging.WithContext(ctx).Debug("DeleteUserAndCleanup", "userId", req.Msg.UserId)
+
+	if err := s.requireSystemAdmin(ctx, "delete users"); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to begin transaction: %w", err))
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+	qtx := s.queries.WithTx(tx)
+
+	user, err := qtx.GetUser(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, dberrors.Map(err)
+	}
+
+	createdEvents, err := qtx.CountUserCreatedEvents(ctx, user.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if createdEvents > 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("user created %d events, reassign or delete them first", createdEvents))
+	}
+
+	if err := qtx.DetachUserReferences(ctx, user.ID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to detach user references: %w", err))
+	}
+	if err := qtx.DeleteUser(ctx, user.ID); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("user is still referenced by %s", pgErr.TableName))
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Club and event relationships use the Kratos ID while platform roles use
+	// the local ID, so both subjects are cleaned up. Users without a Kratos ID
+	// only ever had the local one.
+	subjects := []string{strconv.Itoa(int(user.ID))}
+	if user.KratosID.Valid && user.KratosID.String != "" {
+		subjects = append(subjects, user.KratosID.String)
+	}
+	for _, subject := range subjects {
+		if err := s.perms.DeleteUserRelationships(ctx, subject); err != nil {
+			logging.WithContext(ctx).Error("Failed to delete SpiceDB relationships", "error", err, "userId", user.ID, "subject", subject)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete user relationships: %w", err))
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to commit transaction: %w", err))
+	}
+
+	if s.kratos != nil && user.KratosID.Valid && user.KratosID.String != "" {
+		if err := s.kratos.DeleteIdentity(ctx, user.KratosID.String); err != nil {
+			logging.WithContext(ctx).Warn("Failed to delete Kratos identity", "error", err, "userId", user.ID, "kratosId", user.KratosID.String)
+		}
+	}
+	if user.KratosID.Valid {
+		s.sessions.PurgeIdentity(user.KratosID.String)
+	}
+
+	// Remove user from Meilisearch (async, don't block response)
+	if s.search != nil {
+		userID := user.ID
+		go func() {
+			if err := s.search.DeleteDocument(context.Background(), search.IndexUsers, userID); err != nil {
+				logging.WithContext(ctx).Warn("Failed to delete user from search", "error", err, "userId", userID)
+			}
+		}()
+	}
+
+	recordAudit(ctx, s.queries, req, "user", user.ID)
+
+	return connect.NewResponse(&usersv1.DeleteUserAndCleanupResponse{
+		Success: true,
+	}), nil
+}
+
 func (s *UsersService) UpdatePassword(ctx context.Context, req *connect.Request[usersv1.UpdatePasswordRequest]) (*connect.Response[usersv1.UpdatePasswordResponse], error) {
-	slog.Debug("UpdatePassword", "id", req.Msg.Id)
+	logging.WithContext(ctx).Debug("UpdatePassword", "id", req.Msg.Id)
 
 	// Password updates should be handled via Ory Kratos self-service flows.
 	// This endpoint is deprecated - return an error directing users to use Kratos.
@@ -223,20 +396,12 @@ func (s *UsersService) UpdatePassword(ctx context.Context, req *connect.Request[
 
 // AssignPlatformRole assigns or updates a user's platform-level role
 func (s *UsersService) AssignPlatformRole(ctx context.Context, req *connect.Request[usersv1.AssignPlatformRoleRequest]) (*connect.Response[usersv1.AssignPlatformRoleResponse], error) {
-	slog.Debug("AssignPlatformRole", "userId", req.Msg.UserId, "role", req.Msg.Role)
-
-	// Validate role
-	if req.Msg.Role == usersv1.PlatformRole_PLATFORM_ROLE_UNSPECIFIED {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("role must be specified"))
-	}
+	logging.WithContext(ctx).Debug("AssignPlatformRole", "userId", req.Msg.UserId, "role", req.Msg.Role)
 
 	// Get user to validate they exist
 	user, err := s.queries.GetUser(ctx, req.Msg.UserId)
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return nil, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
-		}
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, dberrors.MapNotFound(err, "user not found")
 	}
 
 	// Update SpiceDB relationships based on role
@@ -253,43 +418,276 @@ func (s *UsersService) AssignPlatformRole(ctx context.Context, req *connect.Requ
 		// Now add the new role (only if not USER role)
 		if req.Msg.Role == usersv1.PlatformRole_PLATFORM_ROLE_ADMIN {
 			if err := s.perms.SetupPlatformRelationship(ctx, userIDStr, "admin"); err != nil {
-				slog.Error("Failed to set admin role in SpiceDB", "error", err, "userId", user.ID)
+				logging.WithContext(ctx).Error("Failed to set admin role in SpiceDB", "error", err, "userId", user.ID)
 				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to assign role: %w", err))
 			}
 		} else if req.Msg.Role == usersv1.PlatformRole_PLATFORM_ROLE_STAFF {
 			if err := s.perms.SetupPlatformRelationship(ctx, userIDStr, "staff"); err != nil {
-				slog.Error("Failed to set staff role in SpiceDB", "error", err, "userId", user.ID)
+				logging.WithContext(ctx).Error("Failed to set staff role in SpiceDB", "error", err, "userId", user.ID)
 				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to assign role: %w", err))
 			}
 		}
 		// USER role means removing all platform roles (already done above)
 	}
 
-	slog.Info("Assigned platform role", "userId", user.ID, "role", req.Msg.Role)
+	logging.WithContext(ctx).Info("Assigned platform role", "userId", user.ID, "role", req.Msg.Role)
+
+	recordAudit(ctx, s.queries, req, "user", user.ID)
 
 	return connect.NewResponse(&usersv1.AssignPlatformRoleResponse{
 		User: s.dbUserToProto(ctx, user),
 	}), nil
 }
 
-// PreRegisterUser creates a pre-registration entry for an email
-func (s *UsersService) PreRegisterUser(ctx context.Context, req *connect.Request[usersv1.PreRegisterUserRequest]) (*connect.Response[usersv1.PreRegisterUserResponse], error) {
-	slog.Debug("PreRegisterUser", "email", req.Msg.Email, "role", req.Msg.PlatformRole)
+// GetPlatformRoleMembers lists the users holding a platform role
+func (s *UsersService) GetPlatformRoleMembers(ctx context.Context, req *connect.Request[usersv1.GetPlatformRoleMembersRequest]) (*connect.Response[usersv1.GetPlatformRoleMembersResponse], error) {
+	logging.WithContext(ctx).Debug("GetPlatformRoleMembers", "role", req.Msg.Role)
 
-	// Validate email
-	email := strings.ToLower(strings.TrimSpace(req.Msg.Email))
-	if email == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("email is required"))
+	userID := auth.GetUserID(ctx)
+	if userID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
 	}
-	if !strings.HasSuffix(email, "@astanait.edu.kz") {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("email must be an @astanait.edu.kz address"))
+
+	if err := requirePlatformPermission(ctx, s.perms, userID, "manage_system", "list platform role members"); err != nil {
+		return nil, err
 	}
 
-	// Validate role
-	if req.Msg.PlatformRole == usersv1.PlatformRole_PLATFORM_ROLE_UNSPECIFIED ||
-		req.Msg.PlatformRole == usersv1.PlatformRole_PLATFORM_ROLE_USER {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("platform_role must be STAFF or ADMIN"))
+	var subjectIDs []string
+	var err error
+	switch req.Msg.Role {
+	case usersv1.PlatformRole_PLATFORM_ROLE_ADMIN:
+		subjectIDs, err = s.perms.GetPlatformAdmins(ctx)
+	case usersv1.PlatformRole_PLATFORM_ROLE_STAFF:
+		subjectIDs, err = s.perms.GetPlatformStaff(ctx)
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("role must be STAFF or ADMIN"))
 	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to lookup role members: %w", err))
+	}
+
+	users := make([]*usersv1.User, 0, len(subjectIDs))
+	for _, subjectID := range subjectIDs {
+		user, err := s.userBySubjectID(ctx, subjectID)
+		if err != nil {
+			// Subject has a role but no local account yet (never signed in)
+			logging.WithContext(ctx).Debug("No local user for platform subject", "subjectId", subjectID, "error", err)
+			continue
+		}
+		users = append(users, s.dbUserToProto(ctx, user))
+	}
+
+	return connect.NewResponse(&usersv1.GetPlatformRoleMembersResponse{
+		Users: users,
+	}), nil
+}
+
+// userBySubjectID resolves a SpiceDB user subject to a local user.
+// Subjects are Kratos identity IDs, except for roles assigned through
+// AssignPlatformRole, which writes the local numeric user ID.
+func (s *UsersService) userBySubjectID(ctx context.Context, subjectID string) (db.User, error) {
+	if id, err := strconv.ParseInt(subjectID, 10, 32); err == nil {
+		return s.queries.GetUser(ctx, int32(id))
+	}
+	return s.queries.GetUserByKratosID(ctx, pgtype.Text{String: subjectID, Valid: true})
+}
+
+// GetKratosIdentity returns the local user merged with their Kratos identity
+func (s *UsersService) GetKratosIdentity(ctx context.Context, req *connect.Request[usersv1.GetKratosIdentityRequest]) (*connect.Response[usersv1.GetKratosIdentityResponse], error) {
+	logging.WithContext(ctx).Debug("GetKratosIdentity", "userId", req.Msg.UserId)
+
+	userID := auth.GetUserID(ctx)
+	if userID == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	if s.kratos == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("identity service is unavailable"))
+	}
+
+	user, err := s.queries.GetUser(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, dberrors.Map(err)
+	}
+
+	// Users can read their own identity, admins can read anyone's
+	if !user.KratosID.Valid || user.KratosID.String != userID {
+		if err := requirePlatformPermission(ctx, s.perms, userID, "manage_system", "view this identity"); err != nil {
+			return nil, err
+		}
+	}
+
+	if !user.KratosID.Valid {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("user has no linked identity"))
+	}
+
+	identity, err := s.kratos.GetIdentity(ctx, user.KratosID.String)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch identity: %w", err))
+	}
+
+	traits, err := json.Marshal(identity.Traits)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&usersv1.GetKratosIdentityResponse{
+		User:       s.dbUserToProto(ctx, user),
+		IdentityId: identity.Id,
+		State:      identity.GetState(),
+		Traits:     string(traits),
+	}), nil
+}
+
+// requireSystemAdmin checks that the caller has manage_system on the platform
+func (s *UsersService) requireSystemAdmin(ctx context.Context, action string) error {
+	userID := auth.GetUserID(ctx)
+	if userID == "" {
+		return connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	return requirePlatformPermission(ctx, s.perms, userID, "manage_system", action)
+}
+
+// kratosIDForUser resolves the Kratos identity of a local user
+func (s *UsersService) kratosIDForUser(ctx context.Context, userID int32) (string, error) {
+	user, err := s.queries.GetUser(ctx, userID)
+	if err != nil {
+		return "", dberrors.Map(err)
+	}
+	if !user.KratosID.Valid {
+		return "", connect.NewError(connect.CodeFailedPrecondition, errors.New("user has no linked identity"))
+	}
+	return user.KratosID.String, nil
+}
+
+// ListUserSessions lists a user's login sessions
+func (s *UsersService) ListUserSessions(ctx context.Context, req *connect.Request[usersv1.ListUserSessionsRequest]) (*connect.Response[usersv1.ListUserSessionsResponse], error) {
+	logging.WithContext(ctx).Debug("ListUserSessions", "userId", req.Msg.UserId)
+
+	if err := s.requireSystemAdmin(ctx, "manage user sessions"); err != nil {
+		return nil, err
+	}
+	if s.kratos == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("identity service is unavailable"))
+	}
+
+	kratosID, err := s.kratosIDForUser(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.kratos.ListIdentitySessions(ctx, kratosID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list sessions: %w", err))
+	}
+
+	protoSessions := make([]*usersv1.UserSession, len(sessions))
+	for i, session := range sessions {
+		protoSessions[i] = kratosSessionToProto(session)
+	}
+
+	return connect.NewResponse(&usersv1.ListUserSessionsResponse{
+		Sessions: protoSessions,
+	}), nil
+}
+
+// RevokeUserSession revokes a single session, logging its holder out
+func (s *UsersService) RevokeUserSession(ctx context.Context, req *connect.Request[usersv1.RevokeUserSessionRequest]) (*connect.Response[usersv1.RevokeUserSessionResponse], error) {
+	logging.WithContext(ctx).Debug("RevokeUserSession", "sessionId", req.Msg.SessionId)
+
+	if err := s.requireSystemAdmin(ctx, "manage user sessions"); err != nil {
+		return nil, err
+	}
+	if s.kratos == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("identity service is unavailable"))
+	}
+
+	if err := s.kratos.DisableSession(ctx, req.Msg.SessionId); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to revoke session: %w", err))
+	}
+	s.sessions.PurgeSession(req.Msg.SessionId)
+
+	logging.WithContext(ctx).Info("User session revoked", "sessionId", req.Msg.SessionId)
+
+	recordAudit(ctx, s.queries, req, "session", req.Msg.SessionId)
+
+	return connect.NewResponse(&usersv1.RevokeUserSessionResponse{
+		Success: true,
+	}), nil
+}
+
+// RevokeAllUserSessions revokes every active session of a user
+func (s *UsersService) RevokeAllUserSessions(ctx context.Context, req *connect.Request[usersv1.RevokeAllUserSessionsRequest]) (*connect.Response[usersv1.RevokeAllUserSessionsResponse], error) {
+	logging.WithContext(ctx).Debug("RevokeAllUserSessions", "userId", req.Msg.UserId)
+
+	if err := s.requireSystemAdmin(ctx, "manage user sessions"); err != nil {
+		return nil, err
+	}
+	if s.kratos == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, errors.New("identity service is unavailable"))
+	}
+
+	kratosID, err := s.kratosIDForUser(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.kratos.ListIdentitySessions(ctx, kratosID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list sessions: %w", err))
+	}
+
+	var revoked int32
+	for _, session := range sessions {
+		if !session.GetActive() {
+			continue
+		}
+		if err := s.kratos.DisableSession(ctx, session.Id); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to revoke session %s: %w", session.Id, err))
+		}
+		revoked++
+	}
+
+	s.sessions.PurgeIdentity(kratosID)
+
+	logging.WithContext(ctx).Info("All user sessions revoked", "userId", req.Msg.UserId, "count", revoked)
+
+	recordAudit(ctx, s.queries, req, "user", req.Msg.UserId)
+
+	return connect.NewResponse(&usersv1.RevokeAllUserSessionsResponse{
+		RevokedCount: revoked,
+	}), nil
+}
+
+func kratosSessionToProto(session ory.Session) *usersv1.UserSession {
+	proto := &usersv1.UserSession{
+		Id:     session.Id,
+		Active: session.GetActive(),
+	}
+	if session.IssuedAt != nil {
+		proto.CreatedAt = session.IssuedAt.Format(time.RFC3339)
+	}
+	if session.ExpiresAt != nil {
+		expiresAt := session.ExpiresAt.Format(time.RFC3339)
+		proto.ExpiresAt = &expiresAt
+	}
+	if session.AuthenticatedAt != nil {
+		authenticatedAt := session.AuthenticatedAt.Format(time.RFC3339)
+		proto.AuthenticatedAt = &authenticatedAt
+	}
+	// Devices are appended as the session is used, the last one is the most recent
+	if n := len(session.Devices); n > 0 && session.Devices[n-1].UserAgent != nil {
+		proto.Device = session.Devices[n-1].UserAgent
+	}
+	return proto
+}
+
+// PreRegisterUser creates a pre-registration entry for an email
+func (s *UsersService) PreRegisterUser(ctx context.Context, req *connect.Request[usersv1.PreRegisterUserRequest]) (*connect.Response[usersv1.PreRegisterUserResponse], error) {
+	logging.WithContext(ctx).Debug("PreRegisterUser", "email", req.Msg.Email, "role", req.Msg.PlatformRole)
+
+	email := strings.ToLower(strings.TrimSpace(req.Msg.Email))
 
 	// Convert proto role to DB role
 	var dbRole db.PlatformRole
@@ -315,7 +713,9 @@ func (s *UsersService) PreRegisterUser(ctx context.Context, req *connect.Request
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	slog.Info("Created pre-registration", "email", email, "role", dbRole)
+	logging.WithContext(ctx).Info("Created pre-registration", "email", email, "role", dbRole)
+
+	recordAudit(ctx, s.queries, req, "pre_registered_user", preReg.ID)
 
 	return connect.NewResponse(&usersv1.PreRegisterUserResponse{
 		PreRegisteredUser: dbPreRegToProto(preReg),
@@ -324,7 +724,7 @@ func (s *UsersService) PreRegisterUser(ctx context.Context, req *connect.Request
 
 // ListPreRegisteredUsers lists all pre-registration entries
 func (s *UsersService) ListPreRegisteredUsers(ctx context.Context, req *connect.Request[usersv1.ListPreRegisteredUsersRequest]) (*connect.Response[usersv1.ListPreRegisteredUsersResponse], error) {
-	slog.Debug("ListPreRegisteredUsers", "page", req.Msg.Page, "limit", req.Msg.Limit, "includeUsed", req.Msg.IncludeUsed)
+	logging.WithContext(ctx).Debug("ListPreRegisteredUsers", "page", req.Msg.Page, "limit", req.Msg.Limit, "includeUsed", req.Msg.IncludeUsed)
 
 	page := req.Msg.Page
 	if page <= 0 {
@@ -334,6 +734,7 @@ func (s *UsersService) ListPreRegisteredUsers(ctx context.Context, req *connect.
 	if limit <= 0 {
 		limit = 50
 	}
+	limit = min(limit, int32(s.cfg.MaxPageSize))
 
 	preRegs, err := s.queries.ListPreRegisteredUsers(ctx, db.ListPreRegisteredUsersParams{
 		Limit:       limit,
@@ -363,13 +764,15 @@ func (s *UsersService) ListPreRegisteredUsers(ctx context.Context, req *connect.
 
 // DeletePreRegisteredUser deletes a pre-registration entry
 func (s *UsersService) DeletePreRegisteredUser(ctx context.Context, req *connect.Request[usersv1.DeletePreRegisteredUserRequest]) (*connect.Response[usersv1.DeletePreRegisteredUserResponse], error) {
-	slog.Debug("DeletePreRegisteredUser", "id", req.Msg.Id)
+	logging.WithContext(ctx).Debug("DeletePreRegisteredUser", "id", req.Msg.Id)
 
 	err := s.queries.DeletePreRegisteredUser(ctx, req.Msg.Id)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	recordAudit(ctx, s.queries, req, "pre_registered_user", req.Msg.Id)
+
 	return connect.NewResponse(&usersv1.DeletePreRegisteredUserResponse{
 		Success: true,
 	}), nil
@@ -377,22 +780,7 @@ func (s *UsersService) DeletePreRegisteredUser(ctx context.Context, req *connect
 
 // dbUserToProto converts a database user to a proto user, including platform role lookup
 func (s *UsersService) dbUserToProto(ctx context.Context, u db.User) *usersv1.User {
-	protoUser := &usersv1.User{
-		Id:           u.ID,
-		Username:     u.Username,
-		Email:        u.Email,
-		CreatedAt:    u.CreatedAt.Time.Format(time.RFC3339),
-		UpdatedAt:    u.UpdatedAt.Time.Format(time.RFC3339),
-		PlatformRole: usersv1.PlatformRole_PLATFORM_ROLE_USER, // Default
-	}
-
-	// Add name fields if available
-	if u.FirstName.Valid {
-		protoUser.FirstName = &u.FirstName.String
-	}
-	if u.LastName.Valid {
-		protoUser.LastName = &u.LastName.String
-	}
+	protoUser := dbUserToProtoWithRole(u, usersv1.PlatformRole_PLATFORM_ROLE_USER) // Default
 
 	// Lookup platform role from SpiceDB
 	if s.perms != nil {
@@ -416,6 +804,28 @@ func (s *UsersService) dbUserToProto(ctx context.Context, u db.User) *usersv1.Us
 	return protoUser
 }
 
+// dbUserToProtoWithRole converts a database user to proto with an already known platform role
+func dbUserToProtoWithRole(u db.User, role usersv1.PlatformRole) *usersv1.User {
+	protoUser := &usersv1.User{
+		Id:           u.ID,
+		Username:     u.Username,
+		Email:        u.Email,
+		CreatedAt:    u.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt:    u.UpdatedAt.Time.Format(time.RFC3339),
+		PlatformRole: role,
+	}
+
+	// Add name fields if available
+	if u.FirstName.Valid {
+		protoUser.FirstName = &u.FirstName.String
+	}
+	if u.LastName.Valid {
+		protoUser.LastName = &u.LastName.String
+	}
+
+	return protoUser
+}
+
 // dbPreRegToProto converts a database pre-registered user to proto
 func dbPreRegToProto(p db.PreRegisteredUser) *usersv1.PreRegisteredUser {
 	proto := &usersv1.PreRegisteredUser{
@@ -0,0 +1,260 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	eventsv1 "github.com/studyverse/ems-backend/gen/eventsv1"
+	"github.com/studyverse/ems-backend/gen/eventsv1/eventsv1connect"
+	"github.com/studyverse/ems-backend/internal/auth"
+	"github.com/studyverse/ems-backend/internal/config"
+	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/dberrors"
+	"github.com/studyverse/ems-backend/internal/logging"
+	"github.com/studyverse/ems-backend/internal/perms"
+)
+
+type WebhooksService struct {
+	eventsv1connect.UnimplementedWebhooksServiceHandler
+	queries *db.Queries
+	perms   *perms.Client
+	cfg     *config.Config
+}
+
+func NewWebhooksService(queries *db.Queries, permsClient *perms.Client, cfg *config.Config) *WebhooksService {
+	return &WebhooksService{queries: queries, perms: permsClient, cfg: cfg}
+}
+
+// requireSystemAdmin checks that the caller has manage_system on the platform
+func (s *WebhooksService) requireSystemAdmin(ctx context.Context) error {
+	userID := auth.GetUserID(ctx)
+	if userID == "" {
+		return connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	return requirePlatformPermission(ctx, s.perms, userID, "manage_system", "manage webhooks")
+}
+
+func (s *WebhooksService) CreateWebhook(ctx context.Context, req *connect.Request[eventsv1.CreateWebhookRequest]) (*connect.Response[eventsv1.CreateWebhookResponse], error) {
+	logging.WithContext(ctx).Debug("CreateWebhook", "url", req.Msg.Url)
+
+	if err := s.requireSystemAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(req.Msg.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("url must be an absolute http(s) URL"))
+	}
+
+	secretBytes := make([]byte, 32)
+	if _, err := rand.Read(secretBytes); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	secret := hex.EncodeToString(secretBytes)
+
+	var createdBy pgtype.Int4
+	if user, err := s.queries.GetUserByKratosID(ctx, pgtype.Text{String: auth.GetUserID(ctx), Valid: true}); err == nil {
+		createdBy = pgtype.Int4{Int32: user.ID, Valid: true}
+	}
+
+	eventTypes := req.Msg.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+
+	hook, err := s.queries.CreateWebhook(ctx, db.CreateWebhookParams{
+		Url:        req.Msg.Url,
+		Secret:     secret,
+		EventTypes: eventTypes,
+		CreatedBy:  createdBy,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&eventsv1.CreateWebhookResponse{
+		Webhook: dbWebhookToProto(hook),
+		Secret:  secret,
+	}), nil
+}
+
+func (s *WebhooksService) ListWebhooks(ctx context.Context, req *connect.Request[eventsv1.ListWebhooksRequest]) (*connect.Response[eventsv1.ListWebhooksResponse], error) {
+	logging.WithContext(ctx).Debug("ListWebhooks", "page", req.Msg.Page, "limit", req.Msg.Limit)
+
+	if err := s.requireSystemAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	page := req.Msg.Page
+	if page <= 0 {
+		page = 1
+	}
+	limit := req.Msg.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+	limit = min(limit, int32(s.cfg.MaxPageSize))
+
+	hooks, err := s.queries.ListWebhooks(ctx, db.ListWebhooksParams{
+		Limit:  limit,
+		Offset: (page - 1) * limit,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	total, err := s.queries.CountWebhooks(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoHooks := make([]*eventsv1.Webhook, len(hooks))
+	for i, h := range hooks {
+		protoHooks[i] = dbWebhookToProto(h)
+	}
+
+	return connect.NewResponse(&eventsv1.ListWebhooksResponse{
+		Webhooks: protoHooks,
+		Total:    int32(total),
+	}), nil
+}
+
+func (s *WebhooksService) DeleteWebhook(ctx context.Context, req *connect.Request[eventsv1.DeleteWebhookRequest]) (*connect.Response[eventsv1.DeleteWebhookResponse], error) {
+	logging.WithContext(ctx).Debug("DeleteWebhook", "id", req.Msg.Id)
+
+	if err := s.requireSystemAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := s.queries.DeleteWebhook(ctx, req.Msg.Id); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&eventsv1.DeleteWebhookResponse{
+		Success: true,
+	}), nil
+}
+
+func (s *WebhooksService) GetWebhookDeliveries(ctx context.Context, req *connect.Request[eventsv1.GetWebhookDeliveriesRequest]) (*connect.Response[eventsv1.GetWebhookDeliveriesResponse], error) {
+	logging.WithContext(ctx).Debug("GetWebhookDeliveries", "webhookId", req.Msg.WebhookId, "page", req.Msg.Page, "limit", req.Msg.Limit)
+
+	if err := s.requireSystemAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	page := req.Msg.Page
+	if page <= 0 {
+		page = 1
+	}
+	limit := req.Msg.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+	limit = min(limit, int32(s.cfg.MaxPageSize))
+
+	deliveries, err := s.queries.ListWebhookDeliveries(ctx, db.ListWebhookDeliveriesParams{
+		WebhookID: req.Msg.WebhookId,
+		Limit:     limit,
+		Offset:    (page - 1) * limit,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	total, err := s.queries.CountWebhookDeliveries(ctx, req.Msg.WebhookId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoDeliveries := make([]*eventsv1.WebhookDelivery, len(deliveries))
+	for i, d := range deliveries {
+		protoDeliveries[i] = dbWebhookDeliveryToProto(d)
+	}
+
+	return connect.NewResponse(&eventsv1.GetWebhookDeliveriesResponse{
+		Deliveries: protoDeliveries,
+		Total:      int32(total),
+	}), nil
+}
+
+// RetryWebhookDelivery resets a delivery so the worker picks it up on its next poll
+func (s *WebhooksService) RetryWebhookDelivery(ctx context.Context, req *connect.Request[eventsv1.RetryWebhookDeliveryRequest]) (*connect.Response[eventsv1.RetryWebhookDeliveryResponse], error) {
+	logging.WithContext(ctx).Debug("RetryWebhookDelivery", "deliveryId", req.Msg.DeliveryId)
+
+	if err := s.requireSystemAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	delivery, err := s.queries.ResetWebhookDelivery(ctx, req.Msg.DeliveryId)
+	if err != nil {
+		return nil, dberrors.Map(err)
+	}
+
+	logging.WithContext(ctx).Info("Webhook delivery queued for retry", "deliveryId", delivery.ID, "webhookId", delivery.WebhookID)
+
+	return connect.NewResponse(&eventsv1.RetryWebhookDeliveryResponse{
+		Delivery: dbWebhookDeliveryToProto(delivery),
+	}), nil
+}
+
+func dbWebhookToProto(w db.Webhook) *eventsv1.Webhook {
+	return &eventsv1.Webhook{
+		Id:         w.ID,
+		Url:        w.Url,
+		EventTypes: w.EventTypes,
+		IsActive:   w.IsActive,
+		CreatedAt:  w.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt:  w.UpdatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+func dbWebhookDeliveryToProto(d db.WebhookDelivery) *eventsv1.WebhookDelivery {
+	delivery := &eventsv1.WebhookDelivery{
+		Id:          d.ID,
+		WebhookId:   d.WebhookID,
+		EventType:   d.EventType,
+		PayloadHash: d.PayloadHash,
+		Attempt:     d.Attempt,
+		Status:      dbWebhookDeliveryStatusToProto(d.Status),
+		Succeeded:   d.Succeeded,
+		CreatedAt:   d.CreatedAt.Time.Format(time.RFC3339),
+	}
+	if d.HttpStatus.Valid {
+		delivery.HttpStatus = &d.HttpStatus.Int32
+	}
+	if d.ResponseBody.Valid {
+		delivery.ResponseBody = &d.ResponseBody.String
+	}
+	if d.AttemptedAt.Valid {
+		attemptedAt := d.AttemptedAt.Time.Format(time.RFC3339)
+		delivery.AttemptedAt = &attemptedAt
+	}
+	if d.NextRetryAt.Valid {
+		nextRetryAt := d.NextRetryAt.Time.Format(time.RFC3339)
+		delivery.NextRetryAt = &nextRetryAt
+	}
+	return delivery
+}
+
+func dbWebhookDeliveryStatusToProto(status db.WebhookDeliveryStatus) eventsv1.WebhookDeliveryStatus {
+	switch status {
+	case db.WebhookDeliveryStatusPending:
+		return eventsv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING
+	case db.WebhookDeliveryStatusSucceeded:
+		return eventsv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED
+	case db.WebhookDeliveryStatusFailed:
+		return eventsv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED
+	case db.WebhookDeliveryStatusDead:
+		return eventsv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD
+	default:
+		return eventsv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+	}
+}
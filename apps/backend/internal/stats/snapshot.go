@@ -0,0 +1,265 @@
+// Package stats keeps pre-computed platform metrics in statistics_snapshots,
+// so the dashboards don't run a dozen COUNT queries on every load.
+package stats
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/studyverse/ems-backend/internal/db"
+	"github.com/studyverse/ems-backend/internal/metrics"
+)
+
+const (
+	// ScopePlatform is the scope of the platform-wide metrics
+	ScopePlatform = "platform"
+
+	// SnapshotInterval is how often the worker recomputes the snapshot
+	SnapshotInterval = 5 * time.Minute
+	// MaxSnapshotAge is how old a snapshot may be before readers compute live instead
+	MaxSnapshotAge = 10 * time.Minute
+)
+
+// Metric names stored in statistics_snapshots
+const (
+	MetricTotalEvents            = "total_events"
+	MetricUpcomingEvents         = "upcoming_events"
+	MetricPastEvents             = "past_events"
+	MetricEventsThisMonth        = "events_this_month"
+	MetricTotalRegistrations     = "total_registrations"
+	MetricRegistrationsThisMonth = "registrations_this_month"
+	MetricTotalAttendees         = "total_attendees"
+	MetricTotalUsers             = "total_users"
+	MetricTotalOrganizations     = "total_organizations"
+	MetricAverageAttendanceRate  = "average_attendance_rate"
+)
+
+// AverageAttendanceRateQuery averages the per-event attendance percentage over all events
+const AverageAttendanceRateQuery = `
+		SELECT COALESCE(AVG(
+			CASE WHEN reg_count > 0 THEN att_count::float / reg_count::float * 100 ELSE 0 END
+		), 0)
+		FROM (
+			SELECT e.id,
+				COUNT(DISTINCT CASE WHEN er.status = 'registered' THEN er.id END) as reg_count,
+				COUNT(DISTINCT CASE WHEN ea.status = 'attended' THEN ea.id END) as att_count
+			FROM events e
+			LEFT JOIN event_registrations er ON er.event_id = e.id
+			LEFT JOIN event_attendance ea ON ea.registration_id = er.id
+			WHERE NOT e.is_deleted
+			GROUP BY e.id
+		) stats
+	`
+
+// snapshotQuery computes every platform metric in one round trip.
+// $1 and $2 bound the current calendar month.
+const snapshotQuery = `
+	WITH event_counts AS (
+		SELECT COUNT(*) AS total,
+			COUNT(*) FILTER (WHERE start_time >= NOW()) AS upcoming,
+			COUNT(*) FILTER (WHERE start_time < NOW()) AS past,
+			COUNT(*) FILTER (WHERE start_time >= $1 AND start_time < $2) AS this_month
+		FROM events
+		WHERE NOT is_deleted
+	), registration_counts AS (
+		SELECT COUNT(*) FILTER (WHERE status = 'registered') AS total,
+			COUNT(*) FILTER (WHERE registered_at >= $1 AND registered_at < $2) AS this_month
+		FROM event_registrations
+	), attendee_counts AS (
+		SELECT COUNT(*) AS total FROM event_attendance WHERE status = 'attended'
+	), user_counts AS (
+		SELECT COUNT(*) AS total FROM users
+	), organization_counts AS (
+		SELECT COUNT(*) AS total FROM organizations
+	)
+	SELECT ec.total, ec.upcoming, ec.past, ec.this_month,
+		rc.total, rc.this_month,
+		ac.total, uc.total, oc.total,
+		(` + AverageAttendanceRateQuery + `)
+	FROM event_counts ec, registration_counts rc, attendee_counts ac, user_counts uc, organization_counts oc
+`
+
+// Snapshot is one set of platform metrics
+type Snapshot struct {
+	TotalEvents            int32
+	UpcomingEvents         int32
+	PastEvents             int32
+	EventsThisMonth        int32
+	TotalRegistrations     int32
+	RegistrationsThisMonth int32
+	TotalAttendees         int32
+	TotalUsers             int32
+	TotalOrganizations     int32
+	AverageAttendanceRate  float64
+	ComputedAt             time.Time
+}
+
+// Age is how long ago the snapshot was computed
+func (s *Snapshot) Age() time.Duration {
+	return time.Since(s.ComputedAt)
+}
+
+func (s *Snapshot) values() map[string]float64 {
+	return map[string]float64{
+		MetricTotalEvents:            float64(s.TotalEvents),
+		MetricUpcomingEvents:         float64(s.UpcomingEvents),
+		MetricPastEvents:             float64(s.PastEvents),
+		MetricEventsThisMonth:        float64(s.EventsThisMonth),
+		MetricTotalRegistrations:     float64(s.TotalRegistrations),
+		MetricRegistrationsThisMonth: float64(s.RegistrationsThisMonth),
+		MetricTotalAttendees:         float64(s.TotalAttendees),
+		MetricTotalUsers:             float64(s.TotalUsers),
+		MetricTotalOrganizations:     float64(s.TotalOrganizations),
+		MetricAverageAttendanceRate:  s.AverageAttendanceRate,
+	}
+}
+
+// SnapshotWorker refreshes the platform snapshot and serves it to the statistics service
+type SnapshotWorker struct {
+	queries *db.Queries
+	pool    *pgxpool.Pool
+}
+
+// NewSnapshotWorker creates a new snapshot worker
+func NewSnapshotWorker(queries *db.Queries, pool *pgxpool.Pool) *SnapshotWorker {
+	return &SnapshotWorker{queries: queries, pool: pool}
+}
+
+// Run refreshes the snapshot every SnapshotInterval until ctx is cancelled,
+// starting immediately so dashboards have data right after a deploy
+func (w *SnapshotWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(SnapshotInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := w.Refresh(ctx); err != nil && ctx.Err() == nil {
+			slog.Warn("Failed to refresh statistics snapshot", "error", err)
+			// Keep the age gauge moving so a stuck worker is visible
+			if snap, err := w.Latest(ctx); err == nil && snap != nil {
+				metrics.StatisticsSnapshotAgeSeconds.Set(snap.Age().Seconds())
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Get returns the stored snapshot, or a freshly computed one when forceRefresh
+// is set or the stored one is missing or older than MaxSnapshotAge
+func (w *SnapshotWorker) Get(ctx context.Context, forceRefresh bool) (*Snapshot, error) {
+	if !forceRefresh {
+		snap, err := w.Latest(ctx)
+		if err != nil {
+			slog.Warn("Failed to read statistics snapshot, computing live", "error", err)
+		} else if snap != nil && snap.Age() <= MaxSnapshotAge {
+			metrics.StatisticsSnapshotAgeSeconds.Set(snap.Age().Seconds())
+			return snap, nil
+		}
+	}
+	return w.Refresh(ctx)
+}
+
+// Refresh computes the metrics live and stores them
+func (w *SnapshotWorker) Refresh(ctx context.Context) (*Snapshot, error) {
+	snap, err := w.compute(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := w.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := w.queries.WithTx(tx)
+	computedAt := pgtype.Timestamptz{Time: snap.ComputedAt, Valid: true}
+	for name, value := range snap.values() {
+		if err := qtx.UpsertStatisticsSnapshot(ctx, db.UpsertStatisticsSnapshotParams{
+			Scope:       ScopePlatform,
+			MetricName:  name,
+			MetricValue: value,
+			ComputedAt:  computedAt,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to store metric %s: %w", name, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	metrics.StatisticsSnapshotAgeSeconds.Set(0)
+	return snap, nil
+}
+
+// Latest reads the stored snapshot. It returns nil without an error when
+// any metric is missing, e.g. before the worker has run once.
+func (w *SnapshotWorker) Latest(ctx context.Context) (*Snapshot, error) {
+	rows, err := w.queries.ListStatisticsSnapshots(ctx, ScopePlatform)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]float64, len(rows))
+	var computedAt time.Time
+	for i, row := range rows {
+		values[row.MetricName] = row.MetricValue
+		// Metrics are written together, but report the oldest to be safe
+		if i == 0 || row.ComputedAt.Time.Before(computedAt) {
+			computedAt = row.ComputedAt.Time
+		}
+	}
+
+	snap := &Snapshot{ComputedAt: computedAt}
+	for name, dest := range map[string]*int32{
+		MetricTotalEvents:            &snap.TotalEvents,
+		MetricUpcomingEvents:         &snap.UpcomingEvents,
+		MetricPastEvents:             &snap.PastEvents,
+		MetricEventsThisMonth:        &snap.EventsThisMonth,
+		MetricTotalRegistrations:     &snap.TotalRegistrations,
+		MetricRegistrationsThisMonth: &snap.RegistrationsThisMonth,
+		MetricTotalAttendees:         &snap.TotalAttendees,
+		MetricTotalUsers:             &snap.TotalUsers,
+		MetricTotalOrganizations:     &snap.TotalOrganizations,
+	} {
+		value, ok := values[name]
+		if !ok {
+			return nil, nil
+		}
+		*dest = int32(value)
+	}
+	rate, ok := values[MetricAverageAttendanceRate]
+	if !ok {
+		return nil, nil
+	}
+	snap.AverageAttendanceRate = rate
+
+	return snap, nil
+}
+
+func (w *SnapshotWorker) compute(ctx context.Context) (*Snapshot, error) {
+	now := time.Now()
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	monthEnd := monthStart.AddDate(0, 1, 0)
+
+	snap := &Snapshot{ComputedAt: now}
+	if err := w.pool.QueryRow(ctx, snapshotQuery, monthStart, monthEnd).Scan(
+		&snap.TotalEvents, &snap.UpcomingEvents, &snap.PastEvents, &snap.EventsThisMonth,
+		&snap.TotalRegistrations, &snap.RegistrationsThisMonth,
+		&snap.TotalAttendees, &snap.TotalUsers, &snap.TotalOrganizations,
+		&snap.AverageAttendanceRate,
+	); err != nil {
+		return nil, fmt.Errorf("failed to compute statistics: %w", err)
+	}
+	return snap, nil
+}
@@ -0,0 +1,121 @@
+// Package validation runs request message checks before handlers are invoked.
+//
+// Messages opt in by implementing Validator. Their Validate methods live next
+// to the generated code (gen/*/validate.go) and report problems as Errors, which
+// the interceptor turns into a google.rpc.BadRequest error detail.
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"unicode/utf8"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// Validator is implemented by request messages that can check themselves
+type Validator interface {
+	Validate() error
+}
+
+// Errors collects field violations. The zero value is ready to use.
+type Errors []*errdetails.BadRequest_FieldViolation
+
+// Add records a violation for the proto field name
+func (e *Errors) Add(field, description string) {
+	*e = append(*e, &errdetails.BadRequest_FieldViolation{Field: field, Description: description})
+}
+
+// Err returns nil when nothing was added, so Validate can end with `return errs.Err()`
+func (e Errors) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// The checks below record a violation for field when value fails and report
+// whether it passed, so dependent checks can be skipped.
+
+// NonEmpty fails for empty or whitespace-only values
+func (e *Errors) NonEmpty(field, value string) bool {
+	if strings.TrimSpace(value) == "" {
+		e.Add(field, "must not be empty")
+		return false
+	}
+	return true
+}
+
+// MaxLength fails for values longer than max characters (not bytes)
+func (e *Errors) MaxLength(field, value string, max int) bool {
+	if utf8.RuneCountInString(value) > max {
+		e.Add(field, fmt.Sprintf("must be at most %d characters", max))
+		return false
+	}
+	return true
+}
+
+// Email fails unless value is a bare address such as user@example.com,
+// without a display name or surrounding whitespace
+func (e *Errors) Email(field, value string) bool {
+	if addr, err := mail.ParseAddress(value); err != nil || addr.Address != value {
+		e.Add(field, "must be a valid email address")
+		return false
+	}
+	return true
+}
+
+// PositiveID fails for zero and negative IDs
+func (e *Errors) PositiveID(field string, value int32) bool {
+	if value <= 0 {
+		e.Add(field, "must be a positive ID")
+		return false
+	}
+	return true
+}
+
+func (e Errors) Error() string {
+	parts := make([]string, len(e))
+	for i, v := range e {
+		parts[i] = v.Field + ": " + v.Description
+	}
+	return strings.Join(parts, "; ")
+}
+
+// NewInterceptor rejects unary requests whose message fails Validate with
+// CodeInvalidArgument, without calling the handler
+func NewInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().IsClient {
+				return next(ctx, req)
+			}
+			v, ok := req.Any().(Validator)
+			if !ok {
+				return next(ctx, req)
+			}
+			if err := v.Validate(); err != nil {
+				return nil, InvalidArgument(err)
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+// InvalidArgument wraps err as CodeInvalidArgument, attaching any Errors as a
+// google.rpc.BadRequest detail. Handlers use it for checks that need the database.
+func InvalidArgument(err error) *connect.Error {
+	cerr := connect.NewError(connect.CodeInvalidArgument, err)
+
+	var violations Errors
+	if errors.As(err, &violations) {
+		if detail, detailErr := connect.NewErrorDetail(&errdetails.BadRequest{FieldViolations: violations}); detailErr == nil {
+			cerr.AddDetail(detail)
+		}
+	}
+	return cerr
+}
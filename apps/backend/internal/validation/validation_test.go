@@ -0,0 +1,95 @@
+package validation
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func TestChecks(t *testing.T) {
+	tests := []struct {
+		name  string
+		check func(*Errors) bool
+		want  bool
+	}{
+		{"NonEmpty value", func(e *Errors) bool { return e.NonEmpty("f", "x") }, true},
+		{"NonEmpty empty", func(e *Errors) bool { return e.NonEmpty("f", "") }, false},
+		{"NonEmpty whitespace", func(e *Errors) bool { return e.NonEmpty("f", " \t\n") }, false},
+		{"MaxLength under", func(e *Errors) bool { return e.MaxLength("f", "abc", 4) }, true},
+		{"MaxLength at the limit", func(e *Errors) bool { return e.MaxLength("f", "abcd", 4) }, true},
+		{"MaxLength over", func(e *Errors) bool { return e.MaxLength("f", "abcde", 4) }, false},
+		{"MaxLength counts characters", func(e *Errors) bool { return e.MaxLength("f", "сәлем", 5) }, true},
+		{"Email bare address", func(e *Errors) bool { return e.Email("f", "user@example.com") }, true},
+		{"Email empty", func(e *Errors) bool { return e.Email("f", "") }, false},
+		{"Email without a domain", func(e *Errors) bool { return e.Email("f", "user") }, false},
+		{"Email with a display name", func(e *Errors) bool { return e.Email("f", "User <user@example.com>") }, false},
+		{"Email with surrounding whitespace", func(e *Errors) bool { return e.Email("f", " user@example.com") }, false},
+		{"PositiveID one", func(e *Errors) bool { return e.PositiveID("f", 1) }, true},
+		{"PositiveID zero", func(e *Errors) bool { return e.PositiveID("f", 0) }, false},
+		{"PositiveID negative", func(e *Errors) bool { return e.PositiveID("f", -1) }, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var errs Errors
+			if got := tt.check(&errs); got != tt.want {
+				t.Errorf("check passed = %v, want %v", got, tt.want)
+			}
+			if tt.want {
+				if errs.Err() != nil {
+					t.Errorf("passing check recorded %v", errs.Err())
+				}
+				return
+			}
+			if len(errs) != 1 || errs[0].Field != "f" || errs[0].Description == "" {
+				t.Errorf("failing check recorded %v, want one violation for f", errs)
+			}
+		})
+	}
+}
+
+func TestErrors(t *testing.T) {
+	var errs Errors
+	if errs.Err() != nil {
+		t.Fatal("empty Errors isn't nil")
+	}
+
+	errs.Add("title", "must not be empty")
+	errs.Add("end_time", "must be in the future")
+	err := errs.Err()
+	if err == nil {
+		t.Fatal("Errors with violations is nil")
+	}
+	if want := "title: must not be empty; end_time: must be in the future"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestInvalidArgument(t *testing.T) {
+	var errs Errors
+	errs.PositiveID("event_id", 0)
+
+	cerr := InvalidArgument(errs.Err())
+	if cerr.Code() != connect.CodeInvalidArgument {
+		t.Errorf("code = %v, want invalid_argument", cerr.Code())
+	}
+	details := cerr.Details()
+	if len(details) != 1 {
+		t.Fatalf("got %d details, want a BadRequest", len(details))
+	}
+	value, err := details[0].Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	badRequest, ok := value.(*errdetails.BadRequest)
+	if !ok || len(badRequest.GetFieldViolations()) != 1 || badRequest.GetFieldViolations()[0].GetField() != "event_id" {
+		t.Errorf("detail = %v, want the event_id violation", value)
+	}
+
+	if plain := InvalidArgument(errors.New("bad")); len(plain.Details()) != 0 || !strings.Contains(plain.Error(), "bad") {
+		t.Errorf("plain error became %v with %d details", plain, len(plain.Details()))
+	}
+}
@@ -0,0 +1,228 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/studyverse/ems-backend/internal/db"
+)
+
+const (
+	// SignatureHeader carries "sha256=<hex HMAC-SHA256 of the body>"
+	SignatureHeader = "X-Webhook-Signature"
+	// EventHeader carries the event type of the payload
+	EventHeader = "X-Webhook-Event"
+	// DeliveryHeader carries the delivery ID, stable across retries
+	DeliveryHeader = "X-Webhook-Delivery"
+
+	maxResponseBody = 1024
+	claimBatchSize  = 20
+	pollInterval    = 10 * time.Second
+	requestTimeout  = 10 * time.Second
+	// claimLease outlasts a batch sent back to back (claimBatchSize × requestTimeout)
+	claimLease = 5 * time.Minute
+)
+
+// retryDelays is the backoff schedule after each failed attempt.
+// A delivery that still fails after the last retry is marked dead.
+var retryDelays = []time.Duration{
+	1 * time.Minute,
+	5 * time.Minute,
+	30 * time.Minute,
+	2 * time.Hour,
+	8 * time.Hour,
+}
+
+// Dispatcher queues webhook deliveries and sends them with retries
+type Dispatcher struct {
+	queries *db.Queries
+	client  *http.Client
+}
+
+// NewDispatcher creates a new webhook dispatcher
+func NewDispatcher(queries *db.Queries) *Dispatcher {
+	return &Dispatcher{
+		queries: queries,
+		client:  &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// Enqueue records a pending delivery of payload for every active webhook
+// subscribed to eventType. Deliveries are sent by the worker started with Run.
+func (d *Dispatcher) Enqueue(ctx context.Context, eventType string, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+	sum := sha256.Sum256(body)
+	hash := hex.EncodeToString(sum[:])
+
+	hooks, err := d.queries.ListActiveWebhooksForEventType(ctx, eventType)
+	if err != nil {
+		return fmt.Errorf("failed to list webhooks: %w", err)
+	}
+
+	for _, hook := range hooks {
+		if _, err := d.queries.CreateWebhookDelivery(ctx, db.CreateWebhookDeliveryParams{
+			WebhookID:   hook.ID,
+			EventType:   eventType,
+			Payload:     body,
+			PayloadHash: hash,
+		}); err != nil {
+			return fmt.Errorf("failed to queue delivery for webhook %d: %w", hook.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// Run processes due deliveries until ctx is cancelled
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.ProcessDue(ctx); err != nil && ctx.Err() == nil {
+			slog.Warn("Webhook delivery batch failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessDue claims one batch of due deliveries and attempts each of them.
+// The claim is a single statement that leases the rows, so no transaction or
+// lock is held while sending, and each attempt is recorded on its own: a
+// failed write only affects that delivery, which is retried once its lease
+// expires.
+func (d *Dispatcher) ProcessDue(ctx context.Context) error {
+	deliveries, err := d.queries.ClaimDueWebhookDeliveries(ctx, db.ClaimDueWebhookDeliveriesParams{
+		Limit:        claimBatchSize,
+		LeaseSeconds: int32(claimLease / time.Second),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to claim deliveries: %w", err)
+	}
+
+	var errs []error
+	for _, delivery := range deliveries {
+		if err := d.queries.RecordWebhookDeliveryAttempt(ctx, d.attempt(ctx, delivery)); err != nil {
+			errs = append(errs, fmt.Errorf("failed to record delivery %d: %w", delivery.ID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// attempt sends a single delivery and computes its next state
+func (d *Dispatcher) attempt(ctx context.Context, delivery db.ClaimDueWebhookDeliveriesRow) db.RecordWebhookDeliveryAttemptParams {
+	attempt := delivery.Attempt + 1
+	result := db.RecordWebhookDeliveryAttemptParams{
+		ID:      delivery.ID,
+		Attempt: attempt,
+	}
+
+	status, body, err := d.send(ctx, delivery)
+	if status != 0 {
+		result.HttpStatus = pgtype.Int4{Int32: int32(status), Valid: true}
+	}
+	switch {
+	case err != nil:
+		result.ResponseBody = pgtype.Text{String: sanitizeResponse(err.Error()), Valid: true}
+	case body != "":
+		result.ResponseBody = pgtype.Text{String: body, Valid: true}
+	}
+
+	if err == nil && status >= 200 && status < 300 {
+		result.Status = db.WebhookDeliveryStatusSucceeded
+		result.Succeeded = true
+		return result
+	}
+
+	if int(attempt) > len(retryDelays) {
+		result.Status = db.WebhookDeliveryStatusDead
+		slog.Error("Webhook delivery failed permanently",
+			"deliveryId", delivery.ID,
+			"webhookId", delivery.WebhookID,
+			"attempts", attempt,
+			"httpStatus", status,
+			"error", err,
+		)
+		return result
+	}
+
+	result.Status = db.WebhookDeliveryStatusFailed
+	result.NextRetryAt = pgtype.Timestamptz{Time: time.Now().Add(retryDelays[attempt-1]), Valid: true}
+	slog.Warn("Webhook delivery failed, will retry",
+		"deliveryId", delivery.ID,
+		"webhookId", delivery.WebhookID,
+		"attempt", attempt,
+		"httpStatus", status,
+		"nextRetryAt", result.NextRetryAt.Time,
+		"error", err,
+	)
+	return result
+}
+
+// send POSTs the signed payload and returns the status code and truncated body
+func (d *Dispatcher) send(ctx context.Context, delivery db.ClaimDueWebhookDeliveriesRow) (int, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Url, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, delivery.EventType)
+	req.Header.Set(DeliveryHeader, strconv.Itoa(int(delivery.ID)))
+	req.Header.Set(SignatureHeader, "sha256="+Sign(delivery.Secret, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	return resp.StatusCode, sanitizeResponse(string(body)), nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of body keyed with secret
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// sanitizeResponse makes a receiver's response storable as Postgres text,
+// which rejects NUL bytes and invalid UTF-8. Otherwise recording the attempt
+// fails and the delivery is retried forever without ever going dead.
+// The result is cut to maxResponseBody bytes on a character boundary.
+func sanitizeResponse(s string) string {
+	s = strings.ReplaceAll(strings.ToValidUTF8(s, "\uFFFD"), "\x00", "")
+	if len(s) <= maxResponseBody {
+		return s
+	}
+	cut := maxResponseBody
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return s[:cut]
+}
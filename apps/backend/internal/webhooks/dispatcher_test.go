@@ -0,0 +1,136 @@
+package webhooks
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/studyverse/ems-backend/internal/db"
+)
+
+func TestSign(t *testing.T) {
+	// Well-known HMAC-SHA256 test vector
+	got := Sign("key", []byte("The quick brown fox jumps over the lazy dog"))
+	want := "f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8"
+	if got != want {
+		t.Errorf("Sign() = %s, want %s", got, want)
+	}
+	if Sign("other", []byte("The quick brown fox jumps over the lazy dog")) == want {
+		t.Error("signature doesn't depend on the secret")
+	}
+}
+
+func TestSanitizeResponse(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"plain text is kept", "ok", "ok"},
+		{"NUL bytes are dropped", "a\x00b\x00", "ab"},
+		{"invalid UTF-8 is replaced", "a\xffb", "a�b"},
+		{"short multi-byte text is kept", "привет", "привет"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sanitizeResponse(tt.in); got != tt.want {
+				t.Errorf("sanitizeResponse(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSanitizeResponseCutsOnCharacterBoundary(t *testing.T) {
+	// 'a' shifts the two-byte runes so byte maxResponseBody lands mid-rune
+	got := sanitizeResponse("a" + strings.Repeat("я", maxResponseBody))
+	if len(got) > maxResponseBody {
+		t.Errorf("len = %d, want at most %d", len(got), maxResponseBody)
+	}
+	if len(got) < maxResponseBody-utf8.UTFMax {
+		t.Errorf("len = %d, cut more than one character short of %d", len(got), maxResponseBody)
+	}
+	if !utf8.ValidString(got) {
+		t.Error("cut split a character")
+	}
+}
+
+func TestAttemptSucceeds(t *testing.T) {
+	var signature string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		signature = r.Header.Get(SignatureHeader)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	d := &Dispatcher{client: server.Client()}
+	delivery := db.ClaimDueWebhookDeliveriesRow{ID: 1, Url: server.URL, Secret: "secret", Payload: []byte(`{"id":1}`)}
+	result := d.attempt(context.Background(), delivery)
+
+	if result.Status != db.WebhookDeliveryStatusSucceeded || !result.Succeeded {
+		t.Errorf("status = %s, succeeded = %v, want succeeded", result.Status, result.Succeeded)
+	}
+	if result.Attempt != 1 {
+		t.Errorf("attempt = %d, want 1", result.Attempt)
+	}
+	if want := "sha256=" + Sign("secret", delivery.Payload); signature != want {
+		t.Errorf("signature header = %q, want %q", signature, want)
+	}
+}
+
+func TestAttemptRetryScheduleAndDead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = w.Write([]byte("upstream\x00down\xff"))
+	}))
+	defer server.Close()
+	d := &Dispatcher{client: server.Client()}
+
+	for previous, delay := range retryDelays {
+		before := time.Now()
+		result := d.attempt(context.Background(), db.ClaimDueWebhookDeliveriesRow{ID: 1, Url: server.URL, Attempt: int32(previous)})
+
+		if result.Status != db.WebhookDeliveryStatusFailed {
+			t.Fatalf("attempt %d status = %s, want failed", previous+1, result.Status)
+		}
+		if result.HttpStatus.Int32 != http.StatusBadGateway {
+			t.Errorf("attempt %d http status = %d, want 502", previous+1, result.HttpStatus.Int32)
+		}
+		if got := result.ResponseBody.String; got != "upstreamdown�" {
+			t.Errorf("attempt %d response body = %q, want it sanitized", previous+1, got)
+		}
+		next := result.NextRetryAt.Time
+		if next.Before(before.Add(delay)) || next.After(time.Now().Add(delay)) {
+			t.Errorf("attempt %d next retry in %v, want %v", previous+1, next.Sub(before), delay)
+		}
+	}
+
+	result := d.attempt(context.Background(), db.ClaimDueWebhookDeliveriesRow{ID: 1, Url: server.URL, Attempt: int32(len(retryDelays))})
+	if result.Status != db.WebhookDeliveryStatusDead {
+		t.Errorf("status after the last retry = %s, want dead", result.Status)
+	}
+	if result.NextRetryAt.Valid {
+		t.Error("dead delivery scheduled for another retry")
+	}
+}
+
+func TestAttemptConnectionError(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	d := &Dispatcher{client: &http.Client{Timeout: time.Second}}
+	result := d.attempt(context.Background(), db.ClaimDueWebhookDeliveriesRow{ID: 1, Url: url})
+	if result.Status != db.WebhookDeliveryStatusFailed {
+		t.Errorf("status = %s, want failed", result.Status)
+	}
+	if result.HttpStatus.Valid {
+		t.Errorf("http status = %d, want none", result.HttpStatus.Int32)
+	}
+	if !result.ResponseBody.Valid || result.ResponseBody.String == "" {
+		t.Error("connection error not recorded")
+	}
+}
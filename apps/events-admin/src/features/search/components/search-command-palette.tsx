@@ -54,6 +54,7 @@ const resultTypeConfig = {
   [SearchResultType.ORGANIZATION]: { icon: Building2, label: 'Organization', path: '/organizations' },
   [SearchResultType.USER]: { icon: User, label: 'User', path: '/users' },
   [SearchResultType.TAG]: { icon: Tags, label: 'Tag', path: '/tags' },
+  [SearchResultType.ORGANIZATION_TYPE]: { icon: Building2, label: 'Organization type', path: '/organizations' },
   [SearchResultType.UNSPECIFIED]: { icon: SearchIcon, label: 'Unknown', path: '/' }
 }
 
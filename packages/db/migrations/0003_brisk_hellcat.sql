@@ -0,0 +1,5 @@
+CREATE INDEX "idx_event_registrations_user" ON "event_registrations" USING btree ("user_id","status");--> statement-breakpoint
+CREATE INDEX "idx_event_registrations_event_status" ON "event_registrations" USING btree ("event_id","status");--> statement-breakpoint
+CREATE INDEX "idx_event_tags_tag" ON "event_tags" USING btree ("tag_id");--> statement-breakpoint
+CREATE INDEX "idx_events_org_start" ON "events" USING btree ("organization_id","start_time");--> statement-breakpoint
+CREATE INDEX "idx_events_start_time" ON "events" USING btree ("start_time");
\ No newline at end of file
@@ -0,0 +1,13 @@
+CREATE TABLE "registration_holds" (
+	"id" serial PRIMARY KEY NOT NULL,
+	"event_id" integer NOT NULL,
+	"user_id" integer NOT NULL,
+	"expires_at" timestamp with time zone NOT NULL,
+	"created_at" timestamp with time zone DEFAULT now() NOT NULL
+);
+--> statement-breakpoint
+ALTER TABLE "events" ADD COLUMN "capacity" integer;--> statement-breakpoint
+ALTER TABLE "registration_holds" ADD CONSTRAINT "registration_holds_event_id_events_id_fk" FOREIGN KEY ("event_id") REFERENCES "public"."events"("id") ON DELETE cascade ON UPDATE no action;--> statement-breakpoint
+ALTER TABLE "registration_holds" ADD CONSTRAINT "registration_holds_user_id_users_id_fk" FOREIGN KEY ("user_id") REFERENCES "public"."users"("id") ON DELETE cascade ON UPDATE no action;--> statement-breakpoint
+CREATE INDEX "idx_registration_holds_event_expires" ON "registration_holds" USING btree ("event_id","expires_at");--> statement-breakpoint
+CREATE INDEX "idx_registration_holds_expires" ON "registration_holds" USING btree ("expires_at");
\ No newline at end of file
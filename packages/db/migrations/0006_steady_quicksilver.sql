@@ -0,0 +1 @@
+ALTER TABLE "organizations" ADD COLUMN "monthly_event_quota" integer;
\ No newline at end of file
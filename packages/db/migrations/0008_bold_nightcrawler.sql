@@ -0,0 +1 @@
+ALTER TYPE "public"."format" ADD VALUE 'hybrid';
\ No newline at end of file
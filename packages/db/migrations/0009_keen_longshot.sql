@@ -0,0 +1,2 @@
+ALTER TABLE "event_registrations" ADD COLUMN "cancel_token" varchar(64);--> statement-breakpoint
+ALTER TABLE "event_registrations" ADD COLUMN "cancel_token_expires_at" timestamp with time zone;
\ No newline at end of file
@@ -0,0 +1,15 @@
+CREATE TYPE "public"."inquiry_status" AS ENUM('open', 'in_progress', 'resolved', 'spam');--> statement-breakpoint
+CREATE TABLE "org_inquiries" (
+	"id" serial PRIMARY KEY NOT NULL,
+	"org_id" integer NOT NULL,
+	"sender_email" text NOT NULL,
+	"sender_name" text NOT NULL,
+	"subject" text NOT NULL,
+	"message" text NOT NULL,
+	"status" "inquiry_status" DEFAULT 'open' NOT NULL,
+	"created_at" timestamp with time zone DEFAULT now() NOT NULL
+);
+--> statement-breakpoint
+ALTER TABLE "organizations" ADD COLUMN "contact_email" text;--> statement-breakpoint
+ALTER TABLE "org_inquiries" ADD CONSTRAINT "org_inquiries_org_id_organizations_id_fk" FOREIGN KEY ("org_id") REFERENCES "public"."organizations"("id") ON DELETE cascade ON UPDATE no action;--> statement-breakpoint
+CREATE INDEX "idx_org_inquiries_org" ON "org_inquiries" USING btree ("org_id","created_at");
\ No newline at end of file
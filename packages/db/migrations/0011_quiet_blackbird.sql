@@ -0,0 +1,9 @@
+CREATE TABLE "statistics_snapshots" (
+	"id" serial PRIMARY KEY NOT NULL,
+	"scope" text NOT NULL,
+	"metric_name" text NOT NULL,
+	"metric_value" double precision NOT NULL,
+	"computed_at" timestamp with time zone DEFAULT now() NOT NULL
+);
+--> statement-breakpoint
+CREATE UNIQUE INDEX "idx_statistics_snapshots_metric" ON "statistics_snapshots" USING btree ("scope","metric_name");
\ No newline at end of file
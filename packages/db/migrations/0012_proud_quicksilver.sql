@@ -0,0 +1 @@
+ALTER TABLE "events" ADD COLUMN "published" boolean DEFAULT true NOT NULL;
\ No newline at end of file
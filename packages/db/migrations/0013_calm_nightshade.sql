@@ -0,0 +1,12 @@
+CREATE TYPE "public"."notification_type" AS ENUM('registration_confirmation', 'event_reminder', 'event_cancellation', 'waitlist_promoted', 'platform_announcement');--> statement-breakpoint
+CREATE TABLE "user_notification_preferences" (
+	"id" serial PRIMARY KEY NOT NULL,
+	"user_id" integer NOT NULL,
+	"notification_type" "notification_type" NOT NULL,
+	"email_enabled" boolean DEFAULT true NOT NULL,
+	"push_enabled" boolean DEFAULT true NOT NULL,
+	"updated_at" timestamp with time zone DEFAULT now() NOT NULL
+);
+--> statement-breakpoint
+ALTER TABLE "user_notification_preferences" ADD CONSTRAINT "user_notification_preferences_user_id_users_id_fk" FOREIGN KEY ("user_id") REFERENCES "public"."users"("id") ON DELETE cascade ON UPDATE no action;--> statement-breakpoint
+CREATE UNIQUE INDEX "idx_user_notification_preferences_user_type" ON "user_notification_preferences" USING btree ("user_id","notification_type");
\ No newline at end of file
@@ -0,0 +1,2 @@
+ALTER TABLE "events" ADD COLUMN "is_deleted" boolean DEFAULT false NOT NULL;--> statement-breakpoint
+ALTER TABLE "events" ADD COLUMN "deleted_at" timestamp with time zone;
\ No newline at end of file
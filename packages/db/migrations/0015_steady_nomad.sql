@@ -0,0 +1 @@
+ALTER TABLE "events" ADD COLUMN "version" integer DEFAULT 1 NOT NULL;
\ No newline at end of file
@@ -0,0 +1,30 @@
+-- Re-registering used to add a new row, so existing data can hold several
+-- registrations per user and event. Keep the active one, otherwise the
+-- newest, and move a check-in from the duplicates onto it before they go.
+WITH "ranked" AS (
+	SELECT "id", FIRST_VALUE("id") OVER (
+		PARTITION BY "event_id", "user_id"
+		ORDER BY ("status" <> 'cancelled') DESC, "registered_at" DESC, "id" DESC
+	) AS "keep_id"
+	FROM "event_registrations"
+), "moved" AS (
+	SELECT DISTINCT ON ("ranked"."keep_id") "event_attendance"."id", "ranked"."keep_id"
+	FROM "event_attendance"
+	JOIN "ranked" ON "ranked"."id" = "event_attendance"."registration_id"
+	WHERE "ranked"."id" <> "ranked"."keep_id"
+		AND NOT EXISTS (SELECT 1 FROM "event_attendance" "kept" WHERE "kept"."registration_id" = "ranked"."keep_id")
+	ORDER BY "ranked"."keep_id", "event_attendance"."checked_in_at" DESC NULLS LAST, "event_attendance"."id" DESC
+)
+UPDATE "event_attendance" SET "registration_id" = "moved"."keep_id"
+FROM "moved"
+WHERE "event_attendance"."id" = "moved"."id";--> statement-breakpoint
+DELETE FROM "event_registrations"
+USING (
+	SELECT "id", FIRST_VALUE("id") OVER (
+		PARTITION BY "event_id", "user_id"
+		ORDER BY ("status" <> 'cancelled') DESC, "registered_at" DESC, "id" DESC
+	) AS "keep_id"
+	FROM "event_registrations"
+) AS "ranked"
+WHERE "event_registrations"."id" = "ranked"."id" AND "ranked"."id" <> "ranked"."keep_id";--> statement-breakpoint
+CREATE UNIQUE INDEX "idx_event_registrations_event_user" ON "event_registrations" USING btree ("event_id","user_id");
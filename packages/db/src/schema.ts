@@ -1,4 +1,4 @@
-import { pgEnum, pgTable } from 'drizzle-orm/pg-core'
+import { index, pgEnum, pgTable, uniqueIndex } from 'drizzle-orm/pg-core'
 import { relations } from 'drizzle-orm'
 
 export const organizationStatusEnum = pgEnum('organization_status', ['active', 'archived', 'frozen'])
@@ -29,8 +29,10 @@ export const organizations = pgTable('organizations', (t) => ({
   tiktok: t.text(),
   linkedin: t.text(),
   status: organizationStatusEnum().default('active'),
+  monthlyEventQuota: t.integer(), // Max events created per calendar month, null = unlimited
   createdAt: t.timestamp({ withTimezone: true, mode: 'string' }).defaultNow(),
-  updatedAt: t.timestamp({ withTimezone: true, mode: 'string' }).defaultNow().$onUpdateFn(() => new Date().toISOString())
+  updatedAt: t.timestamp({ withTimezone: true, mode: 'string' }).defaultNow().$onUpdateFn(() => new Date().toISOString()),
+  contactEmail: t.text() // Where inquiries are forwarded, never shown publicly
 }))
 
 export const organizationTypes = pgTable('organization_types', (t) => ({
@@ -40,7 +42,7 @@ export const organizationTypes = pgTable('organization_types', (t) => ({
   updatedAt: t.timestamp({ withTimezone: true, mode: 'string' }).defaultNow().$onUpdateFn(() => new Date().toISOString())
 }))
 
-export const formatEnum = pgEnum('format', ['online', 'offline'])
+export const formatEnum = pgEnum('format', ['online', 'offline', 'hybrid'])
 export const registrationStatusEnum = pgEnum('registration_status', ['registered', 'cancelled', 'waitlist'])
 export const attendanceStatusEnum = pgEnum('attendance_status', ['attended', 'no_show', 'checked_in'])
 export const platformRoleEnum = pgEnum('platform_role', ['admin', 'staff'])
@@ -56,9 +58,17 @@ export const events = pgTable('events', (t) => ({
   startTime: t.timestamp({ withTimezone: true, mode: 'string' }).notNull(),
   endTime: t.timestamp({ withTimezone: true, mode: 'string' }).notNull(),
   format: formatEnum().default('offline'),
+  capacity: t.integer(), // Max registrations, null = unlimited
+  published: t.boolean().notNull().default(true), // Unpublished events are hidden from search and public listings
+  isDeleted: t.boolean().notNull().default(false), // Soft-deleted events keep their registration and attendance history
+  deletedAt: t.timestamp({ withTimezone: true, mode: 'string' }),
+  version: t.integer().notNull().default(1), // Bumped on every UpdateEvent, for optimistic locking
   createdAt: t.timestamp({ withTimezone: true, mode: 'string' }).defaultNow(),
   updatedAt: t.timestamp({ withTimezone: true, mode: 'string' }).defaultNow().$onUpdateFn(() => new Date().toISOString())
-}))
+}), (table) => [
+  index('idx_events_org_start').on(table.organizationId, table.startTime),
+  index('idx_events_start_time').on(table.startTime)
+])
 
 export const tags = pgTable('tags', (t) => ({
   id: t.serial('id').primaryKey(),
@@ -70,7 +80,7 @@ export const tags = pgTable('tags', (t) => ({
 export const eventTags = pgTable('event_tags', (t) => ({
   eventId: t.integer().notNull().references(() => events.id),
   tagId: t.integer().notNull().references(() => tags.id)
-}))
+}), (table) => [index('idx_event_tags_tag').on(table.tagId)])
 
 export const roles = pgTable('roles', (t) => ({
   id: t.serial('id').primaryKey(),
@@ -94,8 +104,16 @@ export const eventRegistrations = pgTable('event_registrations', (t) => ({
   registeredAt: t.timestamp({ withTimezone: true, mode: 'string' }).defaultNow().notNull(),
   cancelledAt: t.timestamp({ withTimezone: true, mode: 'string' }),
   createdAt: t.timestamp({ withTimezone: true, mode: 'string' }).defaultNow().notNull(),
-  updatedAt: t.timestamp({ withTimezone: true, mode: 'string' }).defaultNow().$onUpdateFn(() => new Date().toISOString()).notNull()
-}))
+  updatedAt: t.timestamp({ withTimezone: true, mode: 'string' }).defaultNow().$onUpdateFn(() => new Date().toISOString()).notNull(),
+  // SHA-256 of the emailed self-service cancellation token, cleared once used
+  cancelToken: t.varchar({ length: 64 }),
+  cancelTokenExpiresAt: t.timestamp({ withTimezone: true, mode: 'string' })
+}), (table) => [
+  index('idx_event_registrations_user').on(table.userId, table.status),
+  index('idx_event_registrations_event_status').on(table.eventId, table.status),
+  // One row per user and event, re-registering revives a cancelled row
+  uniqueIndex('idx_event_registrations_event_user').on(table.eventId, table.userId)
+])
 
 export const eventAttendance = pgTable('event_attendance', (t) => ({
   id: t.serial('id').primaryKey(),
@@ -108,6 +126,18 @@ export const eventAttendance = pgTable('event_attendance', (t) => ({
   updatedAt: t.timestamp({ withTimezone: true, mode: 'string' }).defaultNow().$onUpdateFn(() => new Date().toISOString()).notNull()
 }))
 
+// Temporary capacity reservations, converted to a registration on confirm or purged on expiry
+export const registrationHolds = pgTable('registration_holds', (t) => ({
+  id: t.serial('id').primaryKey(),
+  eventId: t.integer().notNull().references(() => events.id, { onDelete: 'cascade' }),
+  userId: t.integer().notNull().references(() => users.id, { onDelete: 'cascade' }),
+  expiresAt: t.timestamp({ withTimezone: true, mode: 'string' }).notNull(),
+  createdAt: t.timestamp({ withTimezone: true, mode: 'string' }).defaultNow().notNull()
+}), (table) => [
+  index('idx_registration_holds_event_expires').on(table.eventId, table.expiresAt),
+  index('idx_registration_holds_expires').on(table.expiresAt)
+])
+
 // Pre-registered users for auto-role assignment on first sign-up
 export const preRegisteredUsers = pgTable('pre_registered_users', (t) => ({
   id: t.serial('id').primaryKey(),
@@ -120,6 +150,95 @@ export const preRegisteredUsers = pgTable('pre_registered_users', (t) => ({
   updatedAt: t.timestamp({ withTimezone: true, mode: 'string' }).defaultNow().$onUpdateFn(() => new Date().toISOString()).notNull()
 }))
 
+export const inquiryStatusEnum = pgEnum('inquiry_status', ['open', 'in_progress', 'resolved', 'spam'])
+
+// Messages sent to a club through its public contact form
+export const orgInquiries = pgTable('org_inquiries', (t) => ({
+  id: t.serial('id').primaryKey(),
+  orgId: t.integer().notNull().references(() => organizations.id, { onDelete: 'cascade' }),
+  senderEmail: t.text().notNull(),
+  senderName: t.text().notNull(),
+  subject: t.text().notNull(),
+  message: t.text().notNull(), // Max 2000 characters, enforced by the API
+  status: inquiryStatusEnum().default('open').notNull(),
+  createdAt: t.timestamp({ withTimezone: true, mode: 'string' }).defaultNow().notNull()
+}), (table) => [
+  index('idx_org_inquiries_org').on(table.orgId, table.createdAt)
+])
+
+export const webhookDeliveryStatusEnum = pgEnum('webhook_delivery_status', ['pending', 'succeeded', 'failed', 'dead'])
+
+// Outgoing webhook subscriptions, deliveries are signed with HMAC-SHA256 using the secret
+export const webhooks = pgTable('webhooks', (t) => ({
+  id: t.serial('id').primaryKey(),
+  url: t.text().notNull(),
+  secret: t.text().notNull(),
+  eventTypes: t.text().array().notNull().default([]), // Empty = subscribe to all event types
+  isActive: t.boolean().notNull().default(true),
+  createdBy: t.integer().references(() => users.id),
+  createdAt: t.timestamp({ withTimezone: true, mode: 'string' }).defaultNow().notNull(),
+  updatedAt: t.timestamp({ withTimezone: true, mode: 'string' }).defaultNow().$onUpdateFn(() => new Date().toISOString()).notNull()
+}))
+
+// One row per (webhook, payload); attempt/status track the retry schedule
+export const webhookDeliveries = pgTable('webhook_deliveries', (t) => ({
+  id: t.serial('id').primaryKey(),
+  webhookId: t.integer().notNull().references(() => webhooks.id, { onDelete: 'cascade' }),
+  eventType: t.text().notNull(),
+  payload: t.jsonb().notNull(),
+  payloadHash: t.text().notNull(), // SHA-256 of the payload, hex encoded
+  attempt: t.integer().notNull().default(0),
+  status: webhookDeliveryStatusEnum().default('pending').notNull(),
+  httpStatus: t.integer(),
+  responseBody: t.text(), // Truncated to 1KB
+  attemptedAt: t.timestamp({ withTimezone: true, mode: 'string' }),
+  nextRetryAt: t.timestamp({ withTimezone: true, mode: 'string' }).defaultNow(),
+  succeeded: t.boolean().notNull().default(false),
+  createdAt: t.timestamp({ withTimezone: true, mode: 'string' }).defaultNow().notNull(),
+  updatedAt: t.timestamp({ withTimezone: true, mode: 'string' }).defaultNow().$onUpdateFn(() => new Date().toISOString()).notNull()
+}), (table) => [
+  index('idx_webhook_deliveries_webhook').on(table.webhookId, table.createdAt),
+  index('idx_webhook_deliveries_due').on(table.status, table.nextRetryAt)
+])
+
+// Pre-computed dashboard metrics, one row per (scope, metric), refreshed by the backend every 5 minutes
+export const statisticsSnapshots = pgTable('statistics_snapshots', (t) => ({
+  id: t.serial('id').primaryKey(),
+  scope: t.text().notNull(), // 'platform' for the platform-wide dashboards
+  metricName: t.text().notNull(),
+  metricValue: t.doublePrecision().notNull(),
+  computedAt: t.timestamp({ withTimezone: true, mode: 'string' }).defaultNow().notNull()
+}), (table) => [
+  uniqueIndex('idx_statistics_snapshots_metric').on(table.scope, table.metricName)
+])
+
+export const notificationTypeEnum = pgEnum('notification_type', ['registration_confirmation', 'event_reminder', 'event_cancellation', 'waitlist_promoted', 'platform_announcement'])
+
+// Opt-outs per notification type; a missing row means every channel is enabled
+export const userNotificationPreferences = pgTable('user_notification_preferences', (t) => ({
+  id: t.serial('id').primaryKey(),
+  userId: t.integer().notNull().references(() => users.id, { onDelete: 'cascade' }),
+  notificationType: notificationTypeEnum().notNull(),
+  emailEnabled: t.boolean().notNull().default(true),
+  pushEnabled: t.boolean().notNull().default(true),
+  updatedAt: t.timestamp({ withTimezone: true, mode: 'string' }).defaultNow().$onUpdateFn(() => new Date().toISOString()).notNull()
+}), (table) => [
+  uniqueIndex('idx_user_notification_preferences_user_type').on(table.userId, table.notificationType)
+])
+
+// Trail of mutating RPCs; payload is the request as protojson, with secrets removed
+export const auditLogs = pgTable('audit_logs', (t) => ({
+  id: t.bigserial({ mode: 'number' }).primaryKey(),
+  actorKratosId: t.text(), // Null when the call was not authenticated
+  action: t.text().notNull(), // RPC name, e.g. 'DeleteOrganization'
+  resourceType: t.text().notNull(),
+  resourceId: t.text().notNull(),
+  payload: t.jsonb().notNull(),
+  createdAt: t.timestamp({ withTimezone: true, mode: 'string' }).defaultNow().notNull()
+}), (table) => [
+  index('idx_audit_logs_resource').on(table.resourceType, table.resourceId, table.createdAt)
+])
+
 export const usersRelations = relations(users, ({ many }) => ({
   roles: many(userRoles),
   eventRegistrations: many(eventRegistrations)
@@ -153,6 +272,15 @@ export const eventRegistrationsRelations = relations(eventRegistrations, ({ one
   })
 }))
 
+export const orgInquiriesRelations = relations(orgInquiries, ({ one }) => ({
+  organization: one(organizations, { fields: [orgInquiries.orgId], references: [organizations.id] })
+}))
+
+export const registrationHoldsRelations = relations(registrationHolds, ({ one }) => ({
+  event: one(events, { fields: [registrationHolds.eventId], references: [events.id] }),
+  user: one(users, { fields: [registrationHolds.userId], references: [users.id] })
+}))
+
 export const eventAttendanceRelations = relations(eventAttendance, ({ one }) => ({
   registration: one(eventRegistrations, {
     fields: [eventAttendance.registrationId],
@@ -189,4 +317,12 @@ export const tagsRelations = relations(tags, ({ many }) => ({
 export const eventTagsRelations = relations(eventTags, ({ one }) => ({
   event: one(events, { fields: [eventTags.eventId], references: [events.id] }),
   tag: one(tags, { fields: [eventTags.tagId], references: [tags.id] })
+}))
+
+export const webhooksRelations = relations(webhooks, ({ many }) => ({
+  deliveries: many(webhookDeliveries)
+}))
+
+export const webhookDeliveriesRelations = relations(webhookDeliveries, ({ one }) => ({
+  webhook: one(webhooks, { fields: [webhookDeliveries.webhookId], references: [webhooks.id] })
 }))
\ No newline at end of file
@@ -45,7 +45,8 @@ export const hydraEnvSchema = z.object({
 export const spicedbEnvSchema = z.object({
   SPICEDB_ENDPOINT: z.string().default('localhost:50051'),
   SPICEDB_PRESHARED_KEY: z.string().describe('SpiceDB preshared key for authentication'),
-  SPICEDB_INSECURE: z.coerce.boolean().default(true).describe('Use insecure connection (dev only)'),
+  SPICEDB_INSECURE: z.coerce.boolean().default(false).describe('Use insecure connection (dev only)'),
+  SPICEDB_SKIP_VERIFY_CA: z.coerce.boolean().default(false).describe('Use TLS without verifying the server certificate'),
 });
 
 /**
@@ -0,0 +1,10 @@
+// @generated by protoc-gen-connect-query v2.2.0 with parameter "target=ts,import_extension=.js"
+// @generated from file eventsv1/events.proto (package events.v1, syntax proto3)
+/* eslint-disable */
+
+import { AuditService } from "./events_pb.js";
+
+/**
+ * @generated from rpc events.v1.AuditService.ListAuditLogs
+ */
+export const listAuditLogs = AuditService.method.listAuditLogs;
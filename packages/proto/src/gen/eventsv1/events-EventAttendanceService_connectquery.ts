@@ -9,6 +9,11 @@ import { EventAttendanceService } from "./events_pb.js";
  */
 export const checkInAttendee = EventAttendanceService.method.checkInAttendee;
 
+/**
+ * @generated from rpc events.v1.EventAttendanceService.BulkCheckIn
+ */
+export const bulkCheckIn = EventAttendanceService.method.bulkCheckIn;
+
 /**
  * @generated from rpc events.v1.EventAttendanceService.MarkAttendance
  */
@@ -18,3 +23,8 @@ export const markAttendance = EventAttendanceService.method.markAttendance;
  * @generated from rpc events.v1.EventAttendanceService.GetEventAttendance
  */
 export const getEventAttendance = EventAttendanceService.method.getEventAttendance;
+
+/**
+ * @generated from rpc events.v1.EventAttendanceService.GetUserAttendanceHistory
+ */
+export const getUserAttendanceHistory = EventAttendanceService.method.getUserAttendanceHistory;
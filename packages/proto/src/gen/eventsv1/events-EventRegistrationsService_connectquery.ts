@@ -23,3 +23,23 @@ export const getEventRegistrations = EventRegistrationsService.method.getEventRe
  * @generated from rpc events.v1.EventRegistrationsService.GetUserRegistrations
  */
 export const getUserRegistrations = EventRegistrationsService.method.getUserRegistrations;
+
+/**
+ * @generated from rpc events.v1.EventRegistrationsService.HoldEventRegistration
+ */
+export const holdEventRegistration = EventRegistrationsService.method.holdEventRegistration;
+
+/**
+ * @generated from rpc events.v1.EventRegistrationsService.ConfirmRegistration
+ */
+export const confirmRegistration = EventRegistrationsService.method.confirmRegistration;
+
+/**
+ * @generated from rpc events.v1.EventRegistrationsService.NotifyRegistrants
+ */
+export const notifyRegistrants = EventRegistrationsService.method.notifyRegistrants;
+
+/**
+ * @generated from rpc events.v1.EventRegistrationsService.GetEventRegistrationStatus
+ */
+export const getEventRegistrationStatus = EventRegistrationsService.method.getEventRegistrationStatus;
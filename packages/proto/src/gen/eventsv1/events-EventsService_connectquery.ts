@@ -9,6 +9,16 @@ import { EventsService } from "./events_pb.js";
  */
 export const createEvent = EventsService.method.createEvent;
 
+/**
+ * @generated from rpc events.v1.EventsService.BulkCreateEvents
+ */
+export const bulkCreateEvents = EventsService.method.bulkCreateEvents;
+
+/**
+ * @generated from rpc events.v1.EventsService.DuplicateEvent
+ */
+export const duplicateEvent = EventsService.method.duplicateEvent;
+
 /**
  * @generated from rpc events.v1.EventsService.GetEvent
  */
@@ -34,16 +44,51 @@ export const updateEvent = EventsService.method.updateEvent;
  */
 export const deleteEvent = EventsService.method.deleteEvent;
 
+/**
+ * @generated from rpc events.v1.EventsService.RestoreEvent
+ */
+export const restoreEvent = EventsService.method.restoreEvent;
+
+/**
+ * @generated from rpc events.v1.EventsService.ListDeletedEvents
+ */
+export const listDeletedEvents = EventsService.method.listDeletedEvents;
+
+/**
+ * @generated from rpc events.v1.EventsService.ToggleEventVisibility
+ */
+export const toggleEventVisibility = EventsService.method.toggleEventVisibility;
+
 /**
  * @generated from rpc events.v1.EventsService.GetEventsByTagId
  */
 export const getEventsByTagId = EventsService.method.getEventsByTagId;
 
+/**
+ * @generated from rpc events.v1.EventsService.GetEventsByAllTags
+ */
+export const getEventsByAllTags = EventsService.method.getEventsByAllTags;
+
 /**
  * @generated from rpc events.v1.EventsService.GetUserSubscribedEvents
  */
 export const getUserSubscribedEvents = EventsService.method.getUserSubscribedEvents;
 
+/**
+ * @generated from rpc events.v1.EventsService.GetManagedEvents
+ */
+export const getManagedEvents = EventsService.method.getManagedEvents;
+
+/**
+ * @generated from rpc events.v1.EventsService.GetEventFeed
+ */
+export const getEventFeed = EventsService.method.getEventFeed;
+
+/**
+ * @generated from rpc events.v1.EventsService.GetEventCalendar
+ */
+export const getEventCalendar = EventsService.method.getEventCalendar;
+
 /**
  * @generated from rpc events.v1.EventsService.GetEventImageUploadUrl
  */
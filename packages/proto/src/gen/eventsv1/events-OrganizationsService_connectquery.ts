@@ -29,6 +29,11 @@ export const updateOrganization = OrganizationsService.method.updateOrganization
  */
 export const deleteOrganization = OrganizationsService.method.deleteOrganization;
 
+/**
+ * @generated from rpc events.v1.OrganizationsService.MergeOrganizations
+ */
+export const mergeOrganizations = OrganizationsService.method.mergeOrganizations;
+
 /**
  * @generated from rpc events.v1.OrganizationsService.GetPublishableOrganizations
  */
@@ -38,3 +43,38 @@ export const getPublishableOrganizations = OrganizationsService.method.getPublis
  * @generated from rpc events.v1.OrganizationsService.GetUserOrganizations
  */
 export const getUserOrganizations = OrganizationsService.method.getUserOrganizations;
+
+/**
+ * @generated from rpc events.v1.OrganizationsService.GetOrganizationQuotaUsage
+ */
+export const getOrganizationQuotaUsage = OrganizationsService.method.getOrganizationQuotaUsage;
+
+/**
+ * @generated from rpc events.v1.OrganizationsService.GetOrganizationMembers
+ */
+export const getOrganizationMembers = OrganizationsService.method.getOrganizationMembers;
+
+/**
+ * @generated from rpc events.v1.OrganizationsService.AssignClubRole
+ */
+export const assignClubRole = OrganizationsService.method.assignClubRole;
+
+/**
+ * @generated from rpc events.v1.OrganizationsService.RemoveClubMember
+ */
+export const removeClubMember = OrganizationsService.method.removeClubMember;
+
+/**
+ * @generated from rpc events.v1.OrganizationsService.SubmitOrganizationInquiry
+ */
+export const submitOrganizationInquiry = OrganizationsService.method.submitOrganizationInquiry;
+
+/**
+ * @generated from rpc events.v1.OrganizationsService.ListOrganizationInquiries
+ */
+export const listOrganizationInquiries = OrganizationsService.method.listOrganizationInquiries;
+
+/**
+ * @generated from rpc events.v1.OrganizationsService.UpdateInquiryStatus
+ */
+export const updateInquiryStatus = OrganizationsService.method.updateInquiryStatus;
@@ -34,11 +34,21 @@ export const getOverallStatistics = StatisticsService.method.getOverallStatistic
  */
 export const getEventTrends = StatisticsService.method.getEventTrends;
 
+/**
+ * @generated from rpc events.v1.StatisticsService.GetEventCountByFormat
+ */
+export const getEventCountByFormat = StatisticsService.method.getEventCountByFormat;
+
 /**
  * @generated from rpc events.v1.StatisticsService.GetTopPerformingClubs
  */
 export const getTopPerformingClubs = StatisticsService.method.getTopPerformingClubs;
 
+/**
+ * @generated from rpc events.v1.StatisticsService.GetClubLeaderboard
+ */
+export const getClubLeaderboard = StatisticsService.method.getClubLeaderboard;
+
 /**
  * @generated from rpc events.v1.StatisticsService.GetUserEngagementLevels
  */
@@ -58,3 +68,8 @@ export const getLowRegistrationEvents = StatisticsService.method.getLowRegistrat
  * @generated from rpc events.v1.StatisticsService.GetOrganizationActivity
  */
 export const getOrganizationActivity = StatisticsService.method.getOrganizationActivity;
+
+/**
+ * @generated from rpc events.v1.StatisticsService.GetStatisticsForOrganization
+ */
+export const getStatisticsForOrganization = StatisticsService.method.getStatisticsForOrganization;
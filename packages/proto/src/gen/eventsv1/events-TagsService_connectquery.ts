@@ -28,3 +28,8 @@ export const updateTag = TagsService.method.updateTag;
  * @generated from rpc events.v1.TagsService.DeleteTag
  */
 export const deleteTag = TagsService.method.deleteTag;
+
+/**
+ * @generated from rpc events.v1.TagsService.MergeTags
+ */
+export const mergeTags = TagsService.method.mergeTags;
@@ -0,0 +1,30 @@
+// @generated by protoc-gen-connect-query v2.2.0 with parameter "target=ts,import_extension=.js"
+// @generated from file eventsv1/events.proto (package events.v1, syntax proto3)
+/* eslint-disable */
+
+import { WebhooksService } from "./events_pb.js";
+
+/**
+ * @generated from rpc events.v1.WebhooksService.CreateWebhook
+ */
+export const createWebhook = WebhooksService.method.createWebhook;
+
+/**
+ * @generated from rpc events.v1.WebhooksService.ListWebhooks
+ */
+export const listWebhooks = WebhooksService.method.listWebhooks;
+
+/**
+ * @generated from rpc events.v1.WebhooksService.DeleteWebhook
+ */
+export const deleteWebhook = WebhooksService.method.deleteWebhook;
+
+/**
+ * @generated from rpc events.v1.WebhooksService.GetWebhookDeliveries
+ */
+export const getWebhookDeliveries = WebhooksService.method.getWebhookDeliveries;
+
+/**
+ * @generated from rpc events.v1.WebhooksService.RetryWebhookDelivery
+ */
+export const retryWebhookDelivery = WebhooksService.method.retryWebhookDelivery;
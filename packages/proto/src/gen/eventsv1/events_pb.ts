@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file eventsv1/events.proto.
  */
 export const file_eventsv1_events: GenFile = /*@__PURE__*/
-  fileDesc("ChVldmVudHN2MS9ldmVudHMucHJvdG8SCWV2ZW50cy52MSJVChBPcmdhbml6YXRpb25UeXBlEgoKAmlkGAEgASgFEg0KBXRpdGxlGAIgASgJEhIKCmNyZWF0ZWRfYXQYAyABKAkSEgoKdXBkYXRlZF9hdBgEIAEoCSL+AwoMT3JnYW5pemF0aW9uEgoKAmlkGAEgASgFEg0KBXRpdGxlGAIgASgJEhYKCWltYWdlX3VybBgDIAEoCUgAiAEBEhgKC2Rlc2NyaXB0aW9uGAQgASgJSAGIAQESHAoUb3JnYW5pemF0aW9uX3R5cGVfaWQYBSABKAUSFgoJaW5zdGFncmFtGAYgASgJSAKIAQESHQoQdGVsZWdyYW1fY2hhbm5lbBgHIAEoCUgDiAEBEhoKDXRlbGVncmFtX2NoYXQYCCABKAlIBIgBARIUCgd3ZWJzaXRlGAkgASgJSAWIAQESFAoHeW91dHViZRgKIAEoCUgGiAEBEhMKBnRpa3RvaxgLIAEoCUgHiAEBEhUKCGxpbmtlZGluGAwgASgJSAiIAQESLQoGc3RhdHVzGA0gASgOMh0uZXZlbnRzLnYxLk9yZ2FuaXphdGlvblN0YXR1cxISCgpjcmVhdGVkX2F0GA4gASgJEhIKCnVwZGF0ZWRfYXQYDyABKAlCDAoKX2ltYWdlX3VybEIOCgxfZGVzY3JpcHRpb25CDAoKX2luc3RhZ3JhbUITChFfdGVsZWdyYW1fY2hhbm5lbEIQCg5fdGVsZWdyYW1fY2hhdEIKCghfd2Vic2l0ZUIKCghfeW91dHViZUIJCgdfdGlrdG9rQgsKCV9saW5rZWRpbiJHCgNUYWcSCgoCaWQYASABKAUSDAoEbmFtZRgCIAEoCRISCgpjcmVhdGVkX2F0GAMgASgJEhIKCnVwZGF0ZWRfYXQYBCABKAkiuQMKBUV2ZW50EgoKAmlkGAEgASgFEg0KBXRpdGxlGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEhYKCWltYWdlX3VybBgEIAEoCUgAiAEBEg8KB3VzZXJfaWQYBSABKAUSFwoPb3JnYW5pemF0aW9uX2lkGAYgASgFEhAKCGxvY2F0aW9uGAcgASgJEhIKCnN0YXJ0X3RpbWUYCCABKAkSEAoIZW5kX3RpbWUYCSABKAkSJgoGZm9ybWF0GAogASgOMhYuZXZlbnRzLnYxLkV2ZW50Rm9ybWF0Eg8KB3RhZ19pZHMYCyADKAUSEgoKY3JlYXRlZF9hdBgMIAEoCRISCgp1cGRhdGVkX2F0GA0gASgJEhsKE3RvdGFsX3JlZ2lzdHJhdGlvbnMYDiABKAUSFwoPdG90YWxfYXR0ZW5kZWVzGA8gASgFEjIKDG9yZ2FuaXphdGlvbhgQIAEoCzIXLmV2ZW50cy52MS5Pcmdhbml6YXRpb25IAYgBARIcCgR0YWdzGBEgAygLMg4uZXZlbnRzLnYxLlRhZ0IMCgpfaW1hZ2VfdXJsQg8KDV9vcmdhbml6YXRpb24i3AEKEUV2ZW50UmVnaXN0cmF0aW9uEgoKAmlkGAEgASgFEhAKCGV2ZW50X2lkGAIgASgFEg8KB3VzZXJfaWQYAyABKAUSLQoGc3RhdHVzGAQgASgOMh0uZXZlbnRzLnYxLlJlZ2lzdHJhdGlvblN0YXR1cxIVCg1yZWdpc3RlcmVkX2F0GAUgASgJEhkKDGNhbmNlbGxlZF9hdBgGIAEoCUgAiAEBEhIKCmNyZWF0ZWRfYXQYByABKAkSEgoKdXBkYXRlZF9hdBgIIAEoCUIPCg1fY2FuY2VsbGVkX2F0IoUCCg9FdmVudEF0dGVuZGFuY2USCgoCaWQYASABKAUSFwoPcmVnaXN0cmF0aW9uX2lkGAIgASgFEisKBnN0YXR1cxgDIAEoDjIbLmV2ZW50cy52MS5BdHRlbmRhbmNlU3RhdHVzEhoKDWNoZWNrZWRfaW5fYXQYBCABKAlIAIgBARIaCg1jaGVja2VkX2luX2J5GAUgASgFSAGIAQESEgoFbm90ZXMYBiABKAlIAogBARISCgpjcmVhdGVkX2F0GAcgASgJEhIKCnVwZGF0ZWRfYXQYCCABKAlCEAoOX2NoZWNrZWRfaW5fYXRCEAoOX2NoZWNrZWRfaW5fYnlCCAoGX25vdGVzIrkBCg9FdmVudFN0YXRpc3RpY3MSFAoMdG90YWxfZXZlbnRzGAEgASgFEhsKE3RvdGFsX3JlZ2lzdHJhdGlvbnMYAiABKAUSFwoPdG90YWxfYXR0ZW5kZWVzGAMgASgFEhcKD3VwY29taW5nX2V2ZW50cxgEIAEoBRITCgtwYXN0X2V2ZW50cxgFIAEoBRIsCg1yZWNlbnRfZXZlbnRzGAYgAygLMhUuZXZlbnRzLnYxLkV2ZW50U3RhdHMiigEKCkV2ZW50U3RhdHMSEAoIZXZlbnRfaWQYASABKAUSEwoLZXZlbnRfdGl0bGUYAiABKAkSFQoNcmVnaXN0cmF0aW9ucxgDIAEoBRIRCglhdHRlbmRlZXMYBCABKAUSFwoPYXR0ZW5kYW5jZV9yYXRlGAUgASgBEhIKCnN0YXJ0X3RpbWUYBiABKAki1wMKGUNyZWF0ZU9yZ2FuaXphdGlvblJlcXVlc3QSDQoFdGl0bGUYASABKAkSFgoJaW1hZ2VfdXJsGAIgASgJSACIAQESGAoLZGVzY3JpcHRpb24YAyABKAlIAYgBARIcChRvcmdhbml6YXRpb25fdHlwZV9pZBgEIAEoBRIWCglpbnN0YWdyYW0YBSABKAlIAogBARIdChB0ZWxlZ3JhbV9jaGFubmVsGAYgASgJSAOIAQESGgoNdGVsZWdyYW1fY2hhdBgHIAEoCUgEiAEBEhQKB3dlYnNpdGUYCCABKAlIBYgBARIUCgd5b3V0dWJlGAkgASgJSAaIAQESEwoGdGlrdG9rGAogASgJSAeIAQESFQoIbGlua2VkaW4YCyABKAlICIgBARItCgZzdGF0dXMYDCABKA4yHS5ldmVudHMudjEuT3JnYW5pemF0aW9uU3RhdHVzQgwKCl9pbWFnZV91cmxCDgoMX2Rlc2NyaXB0aW9uQgwKCl9pbnN0YWdyYW1CEwoRX3RlbGVncmFtX2NoYW5uZWxCEAoOX3RlbGVncmFtX2NoYXRCCgoIX3dlYnNpdGVCCgoIX3lvdXR1YmVCCQoHX3Rpa3Rva0ILCglfbGlua2VkaW4iSwoaQ3JlYXRlT3JnYW5pemF0aW9uUmVzcG9uc2USLQoMb3JnYW5pemF0aW9uGAEgASgLMhcuZXZlbnRzLnYxLk9yZ2FuaXphdGlvbiIkChZHZXRPcmdhbml6YXRpb25SZXF1ZXN0EgoKAmlkGAEgASgFIkgKF0dldE9yZ2FuaXphdGlvblJlc3BvbnNlEi0KDG9yZ2FuaXphdGlvbhgBIAEoCzIXLmV2ZW50cy52MS5Pcmdhbml6YXRpb24iNwoYTGlzdE9yZ2FuaXphdGlvbnNSZXF1ZXN0EgwKBHBhZ2UYASABKAUSDQoFbGltaXQYAiABKAUiWgoZTGlzdE9yZ2FuaXphdGlvbnNSZXNwb25zZRIuCg1vcmdhbml6YXRpb25zGAEgAygLMhcuZXZlbnRzLnYxLk9yZ2FuaXphdGlvbhINCgV0b3RhbBgCIAEoBSKgBAoZVXBkYXRlT3JnYW5pemF0aW9uUmVxdWVzdBIKCgJpZBgBIAEoBRISCgV0aXRsZRgCIAEoCUgAiAEBEhYKCWltYWdlX3VybBgDIAEoCUgBiAEBEhgKC2Rlc2NyaXB0aW9uGAQgASgJSAKIAQESIQoUb3JnYW5pemF0aW9uX3R5cGVfaWQYBSABKAVIA4gBARIWCglpbnN0YWdyYW0YBiABKAlIBIgBARIdChB0ZWxlZ3JhbV9jaGFubmVsGAcgASgJSAWIAQESGgoNdGVsZWdyYW1fY2hhdBgIIAEoCUgGiAEBEhQKB3dlYnNpdGUYCSABKAlIB4gBARIUCgd5b3V0dWJlGAogASgJSAiIAQESEwoGdGlrdG9rGAsgASgJSAmIAQESFQoIbGlua2VkaW4YDCABKAlICogBARIyCgZzdGF0dXMYDSABKA4yHS5ldmVudHMudjEuT3JnYW5pemF0aW9uU3RhdHVzSAuIAQFCCAoGX3RpdGxlQgwKCl9pbWFnZV91cmxCDgoMX2Rlc2NyaXB0aW9uQhcKFV9vcmdhbml6YXRpb25fdHlwZV9pZEIMCgpfaW5zdGFncmFtQhMKEV90ZWxlZ3JhbV9jaGFubmVsQhAKDl90ZWxlZ3JhbV9jaGF0QgoKCF93ZWJzaXRlQgoKCF95b3V0dWJlQgkKB190aWt0b2tCCwoJX2xpbmtlZGluQgkKB19zdGF0dXMiSwoaVXBkYXRlT3JnYW5pemF0aW9uUmVzcG9uc2USLQoMb3JnYW5pemF0aW9uGAEgASgLMhcuZXZlbnRzLnYxLk9yZ2FuaXphdGlvbiInChlEZWxldGVPcmdhbml6YXRpb25SZXF1ZXN0EgoKAmlkGAEgASgFIi0KGkRlbGV0ZU9yZ2FuaXphdGlvblJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiLgodQ3JlYXRlT3JnYW5pemF0aW9uVHlwZVJlcXVlc3QSDQoFdGl0bGUYASABKAkiWAoeQ3JlYXRlT3JnYW5pemF0aW9uVHlwZVJlc3BvbnNlEjYKEW9yZ2FuaXphdGlvbl90eXBlGAEgASgLMhsuZXZlbnRzLnYxLk9yZ2FuaXphdGlvblR5cGUiKAoaR2V0T3JnYW5pemF0aW9uVHlwZVJlcXVlc3QSCgoCaWQYASABKAUiVQobR2V0T3JnYW5pemF0aW9uVHlwZVJlc3BvbnNlEjYKEW9yZ2FuaXphdGlvbl90eXBlGAEgASgLMhsuZXZlbnRzLnYxLk9yZ2FuaXphdGlvblR5cGUiOwocTGlzdE9yZ2FuaXphdGlvblR5cGVzUmVxdWVzdBIMCgRwYWdlGAEgASgFEg0KBWxpbWl0GAIgASgFImcKHUxpc3RPcmdhbml6YXRpb25UeXBlc1Jlc3BvbnNlEjcKEm9yZ2FuaXphdGlvbl90eXBlcxgBIAMoCzIbLmV2ZW50cy52MS5Pcmdhbml6YXRpb25UeXBlEg0KBXRvdGFsGAIgASgFIkkKHVVwZGF0ZU9yZ2FuaXphdGlvblR5cGVSZXF1ZXN0EgoKAmlkGAEgASgFEhIKBXRpdGxlGAIgASgJSACIAQFCCAoGX3RpdGxlIlgKHlVwZGF0ZU9yZ2FuaXphdGlvblR5cGVSZXNwb25zZRI2ChFvcmdhbml6YXRpb25fdHlwZRgBIAEoCzIbLmV2ZW50cy52MS5Pcmdhbml6YXRpb25UeXBlIisKHURlbGV0ZU9yZ2FuaXphdGlvblR5cGVSZXF1ZXN0EgoKAmlkGAEgASgFIjEKHkRlbGV0ZU9yZ2FuaXphdGlvblR5cGVSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIvkBChJDcmVhdGVFdmVudFJlcXVlc3QSDQoFdGl0bGUYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkSFgoJaW1hZ2VfdXJsGAMgASgJSACIAQESDwoHdXNlcl9pZBgEIAEoBRIXCg9vcmdhbml6YXRpb25faWQYBSABKAUSEAoIbG9jYXRpb24YBiABKAkSEgoKc3RhcnRfdGltZRgHIAEoCRIQCghlbmRfdGltZRgIIAEoCRImCgZmb3JtYXQYCSABKA4yFi5ldmVudHMudjEuRXZlbnRGb3JtYXQSDwoHdGFnX2lkcxgKIAMoBUIMCgpfaW1hZ2VfdXJsIjYKE0NyZWF0ZUV2ZW50UmVzcG9uc2USHwoFZXZlbnQYASABKAsyEC5ldmVudHMudjEuRXZlbnQiHQoPR2V0RXZlbnRSZXF1ZXN0EgoKAmlkGAEgASgFIjMKEEdldEV2ZW50UmVzcG9uc2USHwoFZXZlbnQYASABKAsyEC5ldmVudHMudjEuRXZlbnQilQEKEUxpc3RFdmVudHNSZXF1ZXN0EgwKBHBhZ2UYASABKAUSDQoFbGltaXQYAiABKAUSFAoHdXNlcl9pZBgDIAEoBUgAiAEBEhwKD29yZ2FuaXphdGlvbl9pZBgEIAEoBUgBiAEBEg8KB3RhZ19pZHMYBSADKAVCCgoIX3VzZXJfaWRCEgoQX29yZ2FuaXphdGlvbl9pZCJFChJMaXN0RXZlbnRzUmVzcG9uc2USIAoGZXZlbnRzGAEgAygLMhAuZXZlbnRzLnYxLkV2ZW50Eg0KBXRvdGFsGAIgASgFIpsDChJVcGRhdGVFdmVudFJlcXVlc3QSCgoCaWQYASABKAUSEgoFdGl0bGUYAiABKAlIAIgBARIYCgtkZXNjcmlwdGlvbhgDIAEoCUgBiAEBEhYKCWltYWdlX3VybBgEIAEoCUgCiAEBEhQKB3VzZXJfaWQYBSABKAVIA4gBARIcCg9vcmdhbml6YXRpb25faWQYBiABKAVIBIgBARIVCghsb2NhdGlvbhgHIAEoCUgFiAEBEhcKCnN0YXJ0X3RpbWUYCCABKAlIBogBARIVCghlbmRfdGltZRgJIAEoCUgHiAEBEisKBmZvcm1hdBgKIAEoDjIWLmV2ZW50cy52MS5FdmVudEZvcm1hdEgIiAEBEg8KB3RhZ19pZHMYCyADKAVCCAoGX3RpdGxlQg4KDF9kZXNjcmlwdGlvbkIMCgpfaW1hZ2VfdXJsQgoKCF91c2VyX2lkQhIKEF9vcmdhbml6YXRpb25faWRCCwoJX2xvY2F0aW9uQg0KC19zdGFydF90aW1lQgsKCV9lbmRfdGltZUIJCgdfZm9ybWF0IjYKE1VwZGF0ZUV2ZW50UmVzcG9uc2USHwoFZXZlbnQYASABKAsyEC5ldmVudHMudjEuRXZlbnQiIAoSRGVsZXRlRXZlbnRSZXF1ZXN0EgoKAmlkGAEgASgFIiYKE0RlbGV0ZUV2ZW50UmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCIgChBDcmVhdGVUYWdSZXF1ZXN0EgwKBG5hbWUYASABKAkiMAoRQ3JlYXRlVGFnUmVzcG9uc2USGwoDdGFnGAEgASgLMg4uZXZlbnRzLnYxLlRhZyIbCg1HZXRUYWdSZXF1ZXN0EgoKAmlkGAEgASgFIi0KDkdldFRhZ1Jlc3BvbnNlEhsKA3RhZxgBIAEoCzIOLmV2ZW50cy52MS5UYWciLgoPTGlzdFRhZ3NSZXF1ZXN0EgwKBHBhZ2UYASABKAUSDQoFbGltaXQYAiABKAUiPwoQTGlzdFRhZ3NSZXNwb25zZRIcCgR0YWdzGAEgAygLMg4uZXZlbnRzLnYxLlRhZxINCgV0b3RhbBgCIAEoBSI6ChBVcGRhdGVUYWdSZXF1ZXN0EgoKAmlkGAEgASgFEhEKBG5hbWUYAiABKAlIAIgBAUIHCgVfbmFtZSIwChFVcGRhdGVUYWdSZXNwb25zZRIbCgN0YWcYASABKAsyDi5ldmVudHMudjEuVGFnIh4KEERlbGV0ZVRhZ1JlcXVlc3QSCgoCaWQYASABKAUiJAoRRGVsZXRlVGFnUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCI1CiJHZXRQdWJsaXNoYWJsZU9yZ2FuaXphdGlvbnNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAUiVQojR2V0UHVibGlzaGFibGVPcmdhbml6YXRpb25zUmVzcG9uc2USLgoNb3JnYW5pemF0aW9ucxgBIAMoCzIXLmV2ZW50cy52MS5Pcmdhbml6YXRpb24iLgobR2V0VXNlck9yZ2FuaXphdGlvbnNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAUiTgocR2V0VXNlck9yZ2FuaXphdGlvbnNSZXNwb25zZRIuCg1vcmdhbml6YXRpb25zGAEgAygLMhcuZXZlbnRzLnYxLk9yZ2FuaXphdGlvbiIpChdHZXRFdmVudHNCeVRhZ0lkUmVxdWVzdBIOCgZ0YWdfaWQYASABKAUiPAoYR2V0RXZlbnRzQnlUYWdJZFJlc3BvbnNlEiAKBmV2ZW50cxgBIAMoCzIQLmV2ZW50cy52MS5FdmVudCIxCh5HZXRVc2VyU3Vic2NyaWJlZEV2ZW50c1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoBSJDCh9HZXRVc2VyU3Vic2NyaWJlZEV2ZW50c1Jlc3BvbnNlEiAKBmV2ZW50cxgBIAMoCzIQLmV2ZW50cy52MS5FdmVudCKMAQoZTGlzdEV2ZW50c0ZvckFkbWluUmVxdWVzdBIMCgRwYWdlGAEgASgFEg0KBWxpbWl0GAIgASgFEhQKB3VzZXJfaWQYAyABKAVIAIgBARIcCg9vcmdhbml6YXRpb25faWQYBCABKAVIAYgBAUIKCghfdXNlcl9pZEISChBfb3JnYW5pemF0aW9uX2lkIk0KGkxpc3RFdmVudHNGb3JBZG1pblJlc3BvbnNlEiAKBmV2ZW50cxgBIAMoCzIQLmV2ZW50cy52MS5FdmVudBINCgV0b3RhbBgCIAEoBSI8ChdSZWdpc3RlckZvckV2ZW50UmVxdWVzdBIQCghldmVudF9pZBgBIAEoBRIPCgd1c2VyX2lkGAIgASgFIk4KGFJlZ2lzdGVyRm9yRXZlbnRSZXNwb25zZRIyCgxyZWdpc3RyYXRpb24YASABKAsyHC5ldmVudHMudjEuRXZlbnRSZWdpc3RyYXRpb24iNAoZQ2FuY2VsUmVnaXN0cmF0aW9uUmVxdWVzdBIXCg9yZWdpc3RyYXRpb25faWQYASABKAUiLQoaQ2FuY2VsUmVnaXN0cmF0aW9uUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCJqChxHZXRFdmVudFJlZ2lzdHJhdGlvbnNSZXF1ZXN0EhAKCGV2ZW50X2lkGAEgASgFEhEKBHBhZ2UYAiABKAVIAIgBARISCgVsaW1pdBgDIAEoBUgBiAEBQgcKBV9wYWdlQggKBl9saW1pdCJjCh1HZXRFdmVudFJlZ2lzdHJhdGlvbnNSZXNwb25zZRIzCg1yZWdpc3RyYXRpb25zGAEgAygLMhwuZXZlbnRzLnYxLkV2ZW50UmVnaXN0cmF0aW9uEg0KBXRvdGFsGAIgASgFIi4KG0dldFVzZXJSZWdpc3RyYXRpb25zUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgFIlMKHEdldFVzZXJSZWdpc3RyYXRpb25zUmVzcG9uc2USMwoNcmVnaXN0cmF0aW9ucxgBIAMoCzIcLmV2ZW50cy52MS5FdmVudFJlZ2lzdHJhdGlvbiJmChZDaGVja0luQXR0ZW5kZWVSZXF1ZXN0EhcKD3JlZ2lzdHJhdGlvbl9pZBgBIAEoBRIVCg1jaGVja2VkX2luX2J5GAIgASgFEhIKBW5vdGVzGAMgASgJSACIAQFCCAoGX25vdGVzIkkKF0NoZWNrSW5BdHRlbmRlZVJlc3BvbnNlEi4KCmF0dGVuZGFuY2UYASABKAsyGi5ldmVudHMudjEuRXZlbnRBdHRlbmRhbmNlInsKFU1hcmtBdHRlbmRhbmNlUmVxdWVzdBIXCg9yZWdpc3RyYXRpb25faWQYASABKAUSKwoGc3RhdHVzGAIgASgOMhsuZXZlbnRzLnYxLkF0dGVuZGFuY2VTdGF0dXMSEgoFbm90ZXMYAyABKAlIAIgBAUIICgZfbm90ZXMiSAoWTWFya0F0dGVuZGFuY2VSZXNwb25zZRIuCgphdHRlbmRhbmNlGAEgASgLMhouZXZlbnRzLnYxLkV2ZW50QXR0ZW5kYW5jZSItChlHZXRFdmVudEF0dGVuZGFuY2VSZXF1ZXN0EhAKCGV2ZW50X2lkGAEgASgFIpUBChpHZXRFdmVudEF0dGVuZGFuY2VSZXNwb25zZRIuCgphdHRlbmRhbmNlGAEgAygLMhouZXZlbnRzLnYxLkV2ZW50QXR0ZW5kYW5jZRIYChB0b3RhbF9yZWdpc3RlcmVkGAIgASgFEhYKDnRvdGFsX2F0dGVuZGVkGAMgASgFEhUKDXRvdGFsX25vX3Nob3cYBCABKAUiHwodR2V0RGFzaGJvYXJkU3RhdGlzdGljc1JlcXVlc3QiUAoeR2V0RGFzaGJvYXJkU3RhdGlzdGljc1Jlc3BvbnNlEi4KCnN0YXRpc3RpY3MYASABKAsyGi5ldmVudHMudjEuRXZlbnRTdGF0aXN0aWNzIi0KGUdldEV2ZW50U3RhdGlzdGljc1JlcXVlc3QSEAoIZXZlbnRfaWQYASABKAUikAEKGkdldEV2ZW50U3RhdGlzdGljc1Jlc3BvbnNlEhsKE3RvdGFsX3JlZ2lzdHJhdGlvbnMYASABKAUSFwoPdG90YWxfYXR0ZW5kZWVzGAIgASgFEhIKCmNoZWNrZWRfaW4YAyABKAUSDwoHbm9fc2hvdxgEIAEoBRIXCg9hdHRlbmRhbmNlX3JhdGUYBSABKAEiSAoPVGFnRGlzdHJpYnV0aW9uEg4KBnRhZ19pZBgBIAEoBRIQCgh0YWdfbmFtZRgCIAEoCRITCgtldmVudF9jb3VudBgDIAEoBSJFCiZHZXRFdmVudFRhZ3NEaXN0cmlidXRpb25CeU1vbnRoUmVxdWVzdBIMCgR5ZWFyGAEgASgFEg0KBW1vbnRoGAIgASgFImkKJ0dldEV2ZW50VGFnc0Rpc3RyaWJ1dGlvbkJ5TW9udGhSZXNwb25zZRIoCgR0YWdzGAEgAygLMhouZXZlbnRzLnYxLlRhZ0Rpc3RyaWJ1dGlvbhIUCgx0b3RhbF9ldmVudHMYAiABKAUiOwoNRXZlbnRBY3Rpdml0eRIMCgRkYXRlGAEgASgJEg0KBWNvdW50GAIgASgFEg0KBWxldmVsGAMgASgFIi0KHUdldEV2ZW50QWN0aXZpdHlCeVllYXJSZXF1ZXN0EgwKBHllYXIYASABKAUiZAoeR2V0RXZlbnRBY3Rpdml0eUJ5WWVhclJlc3BvbnNlEiwKCmFjdGl2aXRpZXMYASADKAsyGC5ldmVudHMudjEuRXZlbnRBY3Rpdml0eRIUCgx0b3RhbF9ldmVudHMYAiABKAUiXwoRRXZlbnRTdGF0c1N1bW1hcnkSFAoMdG90YWxfZXZlbnRzGAEgASgFEhsKE3RvdGFsX3JlZ2lzdHJhdGlvbnMYAiABKAUSFwoPdG90YWxfYXR0ZW5kZWVzGAMgASgFIh0KG0dldE92ZXJhbGxTdGF0aXN0aWNzUmVxdWVzdCL6AQocR2V0T3ZlcmFsbFN0YXRpc3RpY3NSZXNwb25zZRIUCgx0b3RhbF9ldmVudHMYASABKAUSEwoLdG90YWxfdXNlcnMYAiABKAUSGwoTdG90YWxfb3JnYW5pemF0aW9ucxgDIAEoBRIbChN0b3RhbF9yZWdpc3RyYXRpb25zGAQgASgFEhcKD3VwY29taW5nX2V2ZW50cxgFIAEoBRIfChdhdmVyYWdlX2F0dGVuZGFuY2VfcmF0ZRgGIAEoARIZChFldmVudHNfdGhpc19tb250aBgHIAEoBRIgChhyZWdpc3RyYXRpb25zX3RoaXNfbW9udGgYCCABKAUiSwoKRXZlbnRUcmVuZBIMCgRkYXRlGAEgASgJEhMKC2V2ZW50X2NvdW50GAIgASgFEhoKEnJlZ2lzdHJhdGlvbl9jb3VudBgDIAEoBSIlChVHZXRFdmVudFRyZW5kc1JlcXVlc3QSDAoEZGF5cxgBIAEoBSI/ChZHZXRFdmVudFRyZW5kc1Jlc3BvbnNlEiUKBnRyZW5kcxgBIAMoCzIVLmV2ZW50cy52MS5FdmVudFRyZW5kIusBCg9DbHViTGVhZGVyYm9hcmQSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgFEhoKEm9yZ2FuaXphdGlvbl90aXRsZRgCIAEoCRIfChJvcmdhbml6YXRpb25faW1hZ2UYAyABKAlIAIgBARIUCgx0b3RhbF9ldmVudHMYBCABKAUSGwoTdG90YWxfcmVnaXN0cmF0aW9ucxgFIAEoBRIXCg90b3RhbF9hdHRlbmRlZXMYBiABKAUSHwoXYXZlcmFnZV9hdHRlbmRhbmNlX3JhdGUYByABKAFCFQoTX29yZ2FuaXphdGlvbl9pbWFnZSI7ChxHZXRUb3BQZXJmb3JtaW5nQ2x1YnNSZXF1ZXN0Eg0KBWxpbWl0GAEgASgFEgwKBGRheXMYAiABKAUiSgodR2V0VG9wUGVyZm9ybWluZ0NsdWJzUmVzcG9uc2USKQoFY2x1YnMYASADKAsyGi5ldmVudHMudjEuQ2x1YkxlYWRlcmJvYXJkIiAKHkdldFVzZXJFbmdhZ2VtZW50TGV2ZWxzUmVxdWVzdCJHChNVc2VyRW5nYWdlbWVudExldmVsEg0KBWxldmVsGAEgASgJEg0KBWNvdW50GAIgASgFEhIKCnBlcmNlbnRhZ2UYAyABKAEirQEKH0dldFVzZXJFbmdhZ2VtZW50TGV2ZWxzUmVzcG9uc2USLgoGbGV2ZWxzGAEgAygLMh4uZXZlbnRzLnYxLlVzZXJFbmdhZ2VtZW50TGV2ZWwSEwoLdG90YWxfdXNlcnMYAiABKAUSFQoNdHJlbmRfbWVzc2FnZRgDIAEoCRITCgtkZXNjcmlwdGlvbhgEIAEoCRIZChFpc19wb3NpdGl2ZV90cmVuZBgFIAEoCCL9AQoSVG9wUGVyZm9ybWluZ0V2ZW50EgoKAmlkGAEgASgFEg0KBXRpdGxlGAIgASgJEhYKCWltYWdlX3VybBgDIAEoCUgAiAEBEjIKDG9yZ2FuaXphdGlvbhgEIAEoCzIXLmV2ZW50cy52MS5Pcmdhbml6YXRpb25IAYgBARISCgpzdGFydF90aW1lGAUgASgJEhsKE3RvdGFsX3JlZ2lzdHJhdGlvbnMYBiABKAUSFwoPdG90YWxfYXR0ZW5kZWVzGAcgASgFEhcKD2F0dGVuZGFuY2VfcmF0ZRgIIAEoAUIMCgpfaW1hZ2VfdXJsQg8KDV9vcmdhbml6YXRpb24iPAodR2V0VG9wUGVyZm9ybWluZ0V2ZW50c1JlcXVlc3QSDQoFbGltaXQYASABKAUSDAoEZGF5cxgCIAEoBSJPCh5HZXRUb3BQZXJmb3JtaW5nRXZlbnRzUmVzcG9uc2USLQoGZXZlbnRzGAEgAygLMh0uZXZlbnRzLnYxLlRvcFBlcmZvcm1pbmdFdmVudCKXAgoUTG93UmVnaXN0cmF0aW9uRXZlbnQSCgoCaWQYASABKAUSDQoFdGl0bGUYAiABKAkSFgoJaW1hZ2VfdXJsGAMgASgJSACIAQESMgoMb3JnYW5pemF0aW9uGAQgASgLMhcuZXZlbnRzLnYxLk9yZ2FuaXphdGlvbkgBiAEBEhIKCnN0YXJ0X3RpbWUYBSABKAkSEAoIY2FwYWNpdHkYBiABKAUSGwoTdG90YWxfcmVnaXN0cmF0aW9ucxgHIAEoBRIcChRjYXBhY2l0eV91dGlsaXphdGlvbhgIIAEoARIYChBkYXlzX3VudGlsX2V2ZW50GAkgASgFQgwKCl9pbWFnZV91cmxCDwoNX29yZ2FuaXphdGlvbiJICh9HZXRMb3dSZWdpc3RyYXRpb25FdmVudHNSZXF1ZXN0EhEKCXRocmVzaG9sZBgBIAEoBRISCgpkYXlzX2FoZWFkGAIgASgFIlMKIEdldExvd1JlZ2lzdHJhdGlvbkV2ZW50c1Jlc3BvbnNlEi8KBmV2ZW50cxgBIAMoCzIfLmV2ZW50cy52MS5Mb3dSZWdpc3RyYXRpb25FdmVudCLUAQoUT3JnYW5pemF0aW9uQWN0aXZpdHkSCgoCaWQYASABKAUSDQoFdGl0bGUYAiABKAkSFgoJaW1hZ2VfdXJsGAMgASgJSACIAQESGQoRZXZlbnRzX3RoaXNfbW9udGgYBCABKAUSGQoRZXZlbnRzX2xhc3RfbW9udGgYBSABKAUSFAoMdG90YWxfZXZlbnRzGAYgASgFEhoKEmF2ZXJhZ2VfYXR0ZW5kYW5jZRgHIAEoARITCgtncm93dGhfcmF0ZRgIIAEoAUIMCgpfaW1hZ2VfdXJsIi8KHkdldE9yZ2FuaXphdGlvbkFjdGl2aXR5UmVxdWVzdBINCgVsaW1pdBgBIAEoBSJZCh9HZXRPcmdhbml6YXRpb25BY3Rpdml0eVJlc3BvbnNlEjYKDW9yZ2FuaXphdGlvbnMYASADKAsyHy5ldmVudHMudjEuT3JnYW5pemF0aW9uQWN0aXZpdHkiRwodR2V0RXZlbnRJbWFnZVVwbG9hZFVybFJlcXVlc3QSEAoIZmlsZW5hbWUYASABKAkSFAoMY29udGVudF90eXBlGAIgASgJIlwKHkdldEV2ZW50SW1hZ2VVcGxvYWRVcmxSZXNwb25zZRISCgp1cGxvYWRfdXJsGAEgASgJEhIKCnB1YmxpY191cmwYAiABKAkSEgoKb2JqZWN0X2tleRgDIAEoCSpeCgtFdmVudEZvcm1hdBIcChhFVkVOVF9GT1JNQVRfVU5TUEVDSUZJRUQQABIXChNFVkVOVF9GT1JNQVRfT05MSU5FEAESGAoURVZFTlRfRk9STUFUX09GRkxJTkUQAiqbAQoST3JnYW5pemF0aW9uU3RhdHVzEiMKH09SR0FOSVpBVElPTl9TVEFUVVNfVU5TUEVDSUZJRUQQABIeChpPUkdBTklaQVRJT05fU1RBVFVTX0FDVElWRRABEiAKHE9SR0FOSVpBVElPTl9TVEFUVVNfQVJDSElWRUQQAhIeChpPUkdBTklaQVRJT05fU1RBVFVTX0ZST1pFThADKqIBChJSZWdpc3RyYXRpb25TdGF0dXMSIwofUkVHSVNUUkFUSU9OX1NUQVRVU19VTlNQRUNJRklFRBAAEiIKHlJFR0lTVFJBVElPTl9TVEFUVVNfUkVHSVNURVJFRBABEiEKHVJFR0lTVFJBVElPTl9TVEFUVVNfQ0FOQ0VMTEVEEAISIAocUkVHSVNUUkFUSU9OX1NUQVRVU19XQUlUTElTVBADKpYBChBBdHRlbmRhbmNlU3RhdHVzEiEKHUFUVEVOREFOQ0VfU1RBVFVTX1VOU1BFQ0lGSUVEEAASHgoaQVRURU5EQU5DRV9TVEFUVVNfQVRURU5ERUQQARIdChlBVFRFTkRBTkNFX1NUQVRVU19OT19TSE9XEAISIAocQVRURU5EQU5DRV9TVEFUVVNfQ0hFQ0tFRF9JThADMuAFChRPcmdhbml6YXRpb25zU2VydmljZRJhChJDcmVhdGVPcmdhbml6YXRpb24SJC5ldmVudHMudjEuQ3JlYXRlT3JnYW5pemF0aW9uUmVxdWVzdBolLmV2ZW50cy52MS5DcmVhdGVPcmdhbml6YXRpb25SZXNwb25zZRJYCg9HZXRPcmdhbml6YXRpb24SIS5ldmVudHMudjEuR2V0T3JnYW5pemF0aW9uUmVxdWVzdBoiLmV2ZW50cy52MS5HZXRPcmdhbml6YXRpb25SZXNwb25zZRJeChFMaXN0T3JnYW5pemF0aW9ucxIjLmV2ZW50cy52MS5MaXN0T3JnYW5pemF0aW9uc1JlcXVlc3QaJC5ldmVudHMudjEuTGlzdE9yZ2FuaXphdGlvbnNSZXNwb25zZRJhChJVcGRhdGVPcmdhbml6YXRpb24SJC5ldmVudHMudjEuVXBkYXRlT3JnYW5pemF0aW9uUmVxdWVzdBolLmV2ZW50cy52MS5VcGRhdGVPcmdhbml6YXRpb25SZXNwb25zZRJhChJEZWxldGVPcmdhbml6YXRpb24SJC5ldmVudHMudjEuRGVsZXRlT3JnYW5pemF0aW9uUmVxdWVzdBolLmV2ZW50cy52MS5EZWxldGVPcmdhbml6YXRpb25SZXNwb25zZRJ8ChtHZXRQdWJsaXNoYWJsZU9yZ2FuaXphdGlvbnMSLS5ldmVudHMudjEuR2V0UHVibGlzaGFibGVPcmdhbml6YXRpb25zUmVxdWVzdBouLmV2ZW50cy52MS5HZXRQdWJsaXNoYWJsZU9yZ2FuaXphdGlvbnNSZXNwb25zZRJnChRHZXRVc2VyT3JnYW5pemF0aW9ucxImLmV2ZW50cy52MS5HZXRVc2VyT3JnYW5pemF0aW9uc1JlcXVlc3QaJy5ldmVudHMudjEuR2V0VXNlck9yZ2FuaXphdGlvbnNSZXNwb25zZTK5BAoYT3JnYW5pemF0aW9uVHlwZXNTZXJ2aWNlEm0KFkNyZWF0ZU9yZ2FuaXphdGlvblR5cGUSKC5ldmVudHMudjEuQ3JlYXRlT3JnYW5pemF0aW9uVHlwZVJlcXVlc3QaKS5ldmVudHMudjEuQ3JlYXRlT3JnYW5pemF0aW9uVHlwZVJlc3BvbnNlEmQKE0dldE9yZ2FuaXphdGlvblR5cGUSJS5ldmVudHMudjEuR2V0T3JnYW5pemF0aW9uVHlwZVJlcXVlc3QaJi5ldmVudHMudjEuR2V0T3JnYW5pemF0aW9uVHlwZVJlc3BvbnNlEmoKFUxpc3RPcmdhbml6YXRpb25UeXBlcxInLmV2ZW50cy52MS5MaXN0T3JnYW5pemF0aW9uVHlwZXNSZXF1ZXN0GiguZXZlbnRzLnYxLkxpc3RPcmdhbml6YXRpb25UeXBlc1Jlc3BvbnNlEm0KFlVwZGF0ZU9yZ2FuaXphdGlvblR5cGUSKC5ldmVudHMudjEuVXBkYXRlT3JnYW5pemF0aW9uVHlwZVJlcXVlc3QaKS5ldmVudHMudjEuVXBkYXRlT3JnYW5pemF0aW9uVHlwZVJlc3BvbnNlEm0KFkRlbGV0ZU9yZ2FuaXphdGlvblR5cGUSKC5ldmVudHMudjEuRGVsZXRlT3JnYW5pemF0aW9uVHlwZVJlcXVlc3QaKS5ldmVudHMudjEuRGVsZXRlT3JnYW5pemF0aW9uVHlwZVJlc3BvbnNlMqoGCg1FdmVudHNTZXJ2aWNlEkwKC0NyZWF0ZUV2ZW50Eh0uZXZlbnRzLnYxLkNyZWF0ZUV2ZW50UmVxdWVzdBoeLmV2ZW50cy52MS5DcmVhdGVFdmVudFJlc3BvbnNlEkMKCEdldEV2ZW50EhouZXZlbnRzLnYxLkdldEV2ZW50UmVxdWVzdBobLmV2ZW50cy52MS5HZXRFdmVudFJlc3BvbnNlEkkKCkxpc3RFdmVudHMSHC5ldmVudHMudjEuTGlzdEV2ZW50c1JlcXVlc3QaHS5ldmVudHMudjEuTGlzdEV2ZW50c1Jlc3BvbnNlEmEKEkxpc3RFdmVudHNGb3JBZG1pbhIkLmV2ZW50cy52MS5MaXN0RXZlbnRzRm9yQWRtaW5SZXF1ZXN0GiUuZXZlbnRzLnYxLkxpc3RFdmVudHNGb3JBZG1pblJlc3BvbnNlEkwKC1VwZGF0ZUV2ZW50Eh0uZXZlbnRzLnYxLlVwZGF0ZUV2ZW50UmVxdWVzdBoeLmV2ZW50cy52MS5VcGRhdGVFdmVudFJlc3BvbnNlEkwKC0RlbGV0ZUV2ZW50Eh0uZXZlbnRzLnYxLkRlbGV0ZUV2ZW50UmVxdWVzdBoeLmV2ZW50cy52MS5EZWxldGVFdmVudFJlc3BvbnNlElsKEEdldEV2ZW50c0J5VGFnSWQSIi5ldmVudHMudjEuR2V0RXZlbnRzQnlUYWdJZFJlcXVlc3QaIy5ldmVudHMudjEuR2V0RXZlbnRzQnlUYWdJZFJlc3BvbnNlEnAKF0dldFVzZXJTdWJzY3JpYmVkRXZlbnRzEikuZXZlbnRzLnYxLkdldFVzZXJTdWJzY3JpYmVkRXZlbnRzUmVxdWVzdBoqLmV2ZW50cy52MS5HZXRVc2VyU3Vic2NyaWJlZEV2ZW50c1Jlc3BvbnNlEm0KFkdldEV2ZW50SW1hZ2VVcGxvYWRVcmwSKC5ldmVudHMudjEuR2V0RXZlbnRJbWFnZVVwbG9hZFVybFJlcXVlc3QaKS5ldmVudHMudjEuR2V0RXZlbnRJbWFnZVVwbG9hZFVybFJlc3BvbnNlMukCCgtUYWdzU2VydmljZRJGCglDcmVhdGVUYWcSGy5ldmVudHMudjEuQ3JlYXRlVGFnUmVxdWVzdBocLmV2ZW50cy52MS5DcmVhdGVUYWdSZXNwb25zZRI9CgZHZXRUYWcSGC5ldmVudHMudjEuR2V0VGFnUmVxdWVzdBoZLmV2ZW50cy52MS5HZXRUYWdSZXNwb25zZRJDCghMaXN0VGFncxIaLmV2ZW50cy52MS5MaXN0VGFnc1JlcXVlc3QaGy5ldmVudHMudjEuTGlzdFRhZ3NSZXNwb25zZRJGCglVcGRhdGVUYWcSGy5ldmVudHMudjEuVXBkYXRlVGFnUmVxdWVzdBocLmV2ZW50cy52MS5VcGRhdGVUYWdSZXNwb25zZRJGCglEZWxldGVUYWcSGy5ldmVudHMudjEuRGVsZXRlVGFnUmVxdWVzdBocLmV2ZW50cy52MS5EZWxldGVUYWdSZXNwb25zZTKwAwoZRXZlbnRSZWdpc3RyYXRpb25zU2VydmljZRJbChBSZWdpc3RlckZvckV2ZW50EiIuZXZlbnRzLnYxLlJlZ2lzdGVyRm9yRXZlbnRSZXF1ZXN0GiMuZXZlbnRzLnYxLlJlZ2lzdGVyRm9yRXZlbnRSZXNwb25zZRJhChJDYW5jZWxSZWdpc3RyYXRpb24SJC5ldmVudHMudjEuQ2FuY2VsUmVnaXN0cmF0aW9uUmVxdWVzdBolLmV2ZW50cy52MS5DYW5jZWxSZWdpc3RyYXRpb25SZXNwb25zZRJqChVHZXRFdmVudFJlZ2lzdHJhdGlvbnMSJy5ldmVudHMudjEuR2V0RXZlbnRSZWdpc3RyYXRpb25zUmVxdWVzdBooLmV2ZW50cy52MS5HZXRFdmVudFJlZ2lzdHJhdGlvbnNSZXNwb25zZRJnChRHZXRVc2VyUmVnaXN0cmF0aW9ucxImLmV2ZW50cy52MS5HZXRVc2VyUmVnaXN0cmF0aW9uc1JlcXVlc3QaJy5ldmVudHMudjEuR2V0VXNlclJlZ2lzdHJhdGlvbnNSZXNwb25zZTKsAgoWRXZlbnRBdHRlbmRhbmNlU2VydmljZRJYCg9DaGVja0luQXR0ZW5kZWUSIS5ldmVudHMudjEuQ2hlY2tJbkF0dGVuZGVlUmVxdWVzdBoiLmV2ZW50cy52MS5DaGVja0luQXR0ZW5kZWVSZXNwb25zZRJVCg5NYXJrQXR0ZW5kYW5jZRIgLmV2ZW50cy52MS5NYXJrQXR0ZW5kYW5jZVJlcXVlc3QaIS5ldmVudHMudjEuTWFya0F0dGVuZGFuY2VSZXNwb25zZRJhChJHZXRFdmVudEF0dGVuZGFuY2USJC5ldmVudHMudjEuR2V0RXZlbnRBdHRlbmRhbmNlUmVxdWVzdBolLmV2ZW50cy52MS5HZXRFdmVudEF0dGVuZGFuY2VSZXNwb25zZTLTCQoRU3RhdGlzdGljc1NlcnZpY2USbQoWR2V0RGFzaGJvYXJkU3RhdGlzdGljcxIoLmV2ZW50cy52MS5HZXREYXNoYm9hcmRTdGF0aXN0aWNzUmVxdWVzdBopLmV2ZW50cy52MS5HZXREYXNoYm9hcmRTdGF0aXN0aWNzUmVzcG9uc2USYQoSR2V0RXZlbnRTdGF0aXN0aWNzEiQuZXZlbnRzLnYxLkdldEV2ZW50U3RhdGlzdGljc1JlcXVlc3QaJS5ldmVudHMudjEuR2V0RXZlbnRTdGF0aXN0aWNzUmVzcG9uc2USiAEKH0dldEV2ZW50VGFnc0Rpc3RyaWJ1dGlvbkJ5TW9udGgSMS5ldmVudHMudjEuR2V0RXZlbnRUYWdzRGlzdHJpYnV0aW9uQnlNb250aFJlcXVlc3QaMi5ldmVudHMudjEuR2V0RXZlbnRUYWdzRGlzdHJpYnV0aW9uQnlNb250aFJlc3BvbnNlEm0KFkdldEV2ZW50QWN0aXZpdHlCeVllYXISKC5ldmVudHMudjEuR2V0RXZlbnRBY3Rpdml0eUJ5WWVhclJlcXVlc3QaKS5ldmVudHMudjEuR2V0RXZlbnRBY3Rpdml0eUJ5WWVhclJlc3BvbnNlEmcKFEdldE92ZXJhbGxTdGF0aXN0aWNzEiYuZXZlbnRzLnYxLkdldE92ZXJhbGxTdGF0aXN0aWNzUmVxdWVzdBonLmV2ZW50cy52MS5HZXRPdmVyYWxsU3RhdGlzdGljc1Jlc3BvbnNlElUKDkdldEV2ZW50VHJlbmRzEiAuZXZlbnRzLnYxLkdldEV2ZW50VHJlbmRzUmVxdWVzdBohLmV2ZW50cy52MS5HZXRFdmVudFRyZW5kc1Jlc3BvbnNlEmoKFUdldFRvcFBlcmZvcm1pbmdDbHVicxInLmV2ZW50cy52MS5HZXRUb3BQZXJmb3JtaW5nQ2x1YnNSZXF1ZXN0GiguZXZlbnRzLnYxLkdldFRvcFBlcmZvcm1pbmdDbHVic1Jlc3BvbnNlEnAKF0dldFVzZXJFbmdhZ2VtZW50TGV2ZWxzEikuZXZlbnRzLnYxLkdldFVzZXJFbmdhZ2VtZW50TGV2ZWxzUmVxdWVzdBoqLmV2ZW50cy52MS5HZXRVc2VyRW5nYWdlbWVudExldmVsc1Jlc3BvbnNlEm0KFkdldFRvcFBlcmZvcm1pbmdFdmVudHMSKC5ldmVudHMudjEuR2V0VG9wUGVyZm9ybWluZ0V2ZW50c1JlcXVlc3QaKS5ldmVudHMudjEuR2V0VG9wUGVyZm9ybWluZ0V2ZW50c1Jlc3BvbnNlEnMKGEdldExvd1JlZ2lzdHJhdGlvbkV2ZW50cxIqLmV2ZW50cy52MS5HZXRMb3dSZWdpc3RyYXRpb25FdmVudHNSZXF1ZXN0GisuZXZlbnRzLnYxLkdldExvd1JlZ2lzdHJhdGlvbkV2ZW50c1Jlc3BvbnNlEnAKF0dldE9yZ2FuaXphdGlvbkFjdGl2aXR5EikuZXZlbnRzLnYxLkdldE9yZ2FuaXphdGlvbkFjdGl2aXR5UmVxdWVzdBoqLmV2ZW50cy52MS5HZXRPcmdhbml6YXRpb25BY3Rpdml0eVJlc3BvbnNlQpoBCg1jb20uZXZlbnRzLnYxQgtFdmVudHNQcm90b1ABWjdnaXRodWIuY29tL3N0dWR5dmVyc2UvZW1zLWJhY2tlbmQvZ2VuL2V2ZW50c3YxO2V2ZW50c3YxogIDRVhYqgIJRXZlbnRzLlYxygIJRXZlbnRzXFYx4gIVRXZlbnRzXFYxXEdQQk1ldGFkYXRh6gIKRXZlbnRzOjpWMWIGcHJvdG8z");
+  fileDesc("ChVldmVudHN2MS9ldmVudHMucHJvdG8SCWV2ZW50cy52MSKHAQoQT3JnYW5pemF0aW9uVHlwZRIKCgJpZBgBIAEoBRINCgV0aXRsZRgCIAEoCRISCgpjcmVhdGVkX2F0GAMgASgJEhIKCnVwZGF0ZWRfYXQYBCABKAkSGgoSb3JnYW5pemF0aW9uX2NvdW50GAUgASgFEhQKDHRvdGFsX2V2ZW50cxgGIAEoBSK4BAoMT3JnYW5pemF0aW9uEgoKAmlkGAEgASgFEg0KBXRpdGxlGAIgASgJEhYKCWltYWdlX3VybBgDIAEoCUgAiAEBEhgKC2Rlc2NyaXB0aW9uGAQgASgJSAGIAQESHAoUb3JnYW5pemF0aW9uX3R5cGVfaWQYBSABKAUSFgoJaW5zdGFncmFtGAYgASgJSAKIAQESHQoQdGVsZWdyYW1fY2hhbm5lbBgHIAEoCUgDiAEBEhoKDXRlbGVncmFtX2NoYXQYCCABKAlIBIgBARIUCgd3ZWJzaXRlGAkgASgJSAWIAQESFAoHeW91dHViZRgKIAEoCUgGiAEBEhMKBnRpa3RvaxgLIAEoCUgHiAEBEhUKCGxpbmtlZGluGAwgASgJSAiIAQESLQoGc3RhdHVzGA0gASgOMh0uZXZlbnRzLnYxLk9yZ2FuaXphdGlvblN0YXR1cxISCgpjcmVhdGVkX2F0GA4gASgJEhIKCnVwZGF0ZWRfYXQYDyABKAkSIAoTbW9udGhseV9ldmVudF9xdW90YRgQIAEoBUgJiAEBQgwKCl9pbWFnZV91cmxCDgoMX2Rlc2NyaXB0aW9uQgwKCl9pbnN0YWdyYW1CEwoRX3RlbGVncmFtX2NoYW5uZWxCEAoOX3RlbGVncmFtX2NoYXRCCgoIX3dlYnNpdGVCCgoIX3lvdXR1YmVCCQoHX3Rpa3Rva0ILCglfbGlua2VkaW5CFgoUX21vbnRobHlfZXZlbnRfcXVvdGEieAoDVGFnEgoKAmlkGAEgASgFEgwKBG5hbWUYAiABKAkSEgoKY3JlYXRlZF9hdBgDIAEoCRISCgp1cGRhdGVkX2F0GAQgASgJEhoKEm9yZ2FuaXphdGlvbl9jb3VudBgFIAEoBRITCgtldmVudF9jb3VudBgGIAEoBSLDBAoFRXZlbnQSCgoCaWQYASABKAUSDQoFdGl0bGUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSFgoJaW1hZ2VfdXJsGAQgASgJSACIAQESDwoHdXNlcl9pZBgFIAEoBRIXCg9vcmdhbml6YXRpb25faWQYBiABKAUSEAoIbG9jYXRpb24YByABKAkSEgoKc3RhcnRfdGltZRgIIAEoCRIQCghlbmRfdGltZRgJIAEoCRImCgZmb3JtYXQYCiABKA4yFi5ldmVudHMudjEuRXZlbnRGb3JtYXQSDwoHdGFnX2lkcxgLIAMoBRISCgpjcmVhdGVkX2F0GAwgASgJEhIKCnVwZGF0ZWRfYXQYDSABKAkSGwoTdG90YWxfcmVnaXN0cmF0aW9ucxgOIAEoBRIXCg90b3RhbF9hdHRlbmRlZXMYDyABKAUSMgoMb3JnYW5pemF0aW9uGBAgASgLMhcuZXZlbnRzLnYxLk9yZ2FuaXphdGlvbkgBiAEBEhwKBHRhZ3MYESADKAsyDi5ldmVudHMudjEuVGFnEhUKCGNhcGFjaXR5GBIgASgFSAKIAQESGAoQY3JlYXRvcl91c2VybmFtZRgTIAEoCRIRCglwdWJsaXNoZWQYFCABKAgSFwoKZGVsZXRlZF9hdBgVIAEoCUgDiAEBEg8KB3ZlcnNpb24YFiABKAVCDAoKX2ltYWdlX3VybEIPCg1fb3JnYW5pemF0aW9uQgsKCV9jYXBhY2l0eUINCgtfZGVsZXRlZF9hdCKMAgoRRXZlbnRSZWdpc3RyYXRpb24SCgoCaWQYASABKAUSEAoIZXZlbnRfaWQYAiABKAUSDwoHdXNlcl9pZBgDIAEoBRItCgZzdGF0dXMYBCABKA4yHS5ldmVudHMudjEuUmVnaXN0cmF0aW9uU3RhdHVzEhUKDXJlZ2lzdGVyZWRfYXQYBSABKAkSGQoMY2FuY2VsbGVkX2F0GAYgASgJSACIAQESEgoKY3JlYXRlZF9hdBgHIAEoCRISCgp1cGRhdGVkX2F0GAggASgJEiQKBWV2ZW50GAkgASgLMhAuZXZlbnRzLnYxLkV2ZW50SAGIAQFCDwoNX2NhbmNlbGxlZF9hdEIICgZfZXZlbnQihQIKD0V2ZW50QXR0ZW5kYW5jZRIKCgJpZBgBIAEoBRIXCg9yZWdpc3RyYXRpb25faWQYAiABKAUSKwoGc3RhdHVzGAMgASgOMhsuZXZlbnRzLnYxLkF0dGVuZGFuY2VTdGF0dXMSGgoNY2hlY2tlZF9pbl9hdBgEIAEoCUgAiAEBEhoKDWNoZWNrZWRfaW5fYnkYBSABKAVIAYgBARISCgVub3RlcxgGIAEoCUgCiAEBEhIKCmNyZWF0ZWRfYXQYByABKAkSEgoKdXBkYXRlZF9hdBgIIAEoCUIQCg5fY2hlY2tlZF9pbl9hdEIQCg5fY2hlY2tlZF9pbl9ieUIICgZfbm90ZXMiuQEKD0V2ZW50U3RhdGlzdGljcxIUCgx0b3RhbF9ldmVudHMYASABKAUSGwoTdG90YWxfcmVnaXN0cmF0aW9ucxgCIAEoBRIXCg90b3RhbF9hdHRlbmRlZXMYAyABKAUSFwoPdXBjb21pbmdfZXZlbnRzGAQgASgFEhMKC3Bhc3RfZXZlbnRzGAUgASgFEiwKDXJlY2VudF9ldmVudHMYBiADKAsyFS5ldmVudHMudjEuRXZlbnRTdGF0cyKKAQoKRXZlbnRTdGF0cxIQCghldmVudF9pZBgBIAEoBRITCgtldmVudF90aXRsZRgCIAEoCRIVCg1yZWdpc3RyYXRpb25zGAMgASgFEhEKCWF0dGVuZGVlcxgEIAEoBRIXCg9hdHRlbmRhbmNlX3JhdGUYBSABKAESEgoKc3RhcnRfdGltZRgGIAEoCSLXAwoZQ3JlYXRlT3JnYW5pemF0aW9uUmVxdWVzdBINCgV0aXRsZRgBIAEoCRIWCglpbWFnZV91cmwYAiABKAlIAIgBARIYCgtkZXNjcmlwdGlvbhgDIAEoCUgBiAEBEhwKFG9yZ2FuaXphdGlvbl90eXBlX2lkGAQgASgFEhYKCWluc3RhZ3JhbRgFIAEoCUgCiAEBEh0KEHRlbGVncmFtX2NoYW5uZWwYBiABKAlIA4gBARIaCg10ZWxlZ3JhbV9jaGF0GAcgASgJSASIAQESFAoHd2Vic2l0ZRgIIAEoCUgFiAEBEhQKB3lvdXR1YmUYCSABKAlIBogBARITCgZ0aWt0b2sYCiABKAlIB4gBARIVCghsaW5rZWRpbhgLIAEoCUgIiAEBEi0KBnN0YXR1cxgMIAEoDjIdLmV2ZW50cy52MS5Pcmdhbml6YXRpb25TdGF0dXNCDAoKX2ltYWdlX3VybEIOCgxfZGVzY3JpcHRpb25CDAoKX2luc3RhZ3JhbUITChFfdGVsZWdyYW1fY2hhbm5lbEIQCg5fdGVsZWdyYW1fY2hhdEIKCghfd2Vic2l0ZUIKCghfeW91dHViZUIJCgdfdGlrdG9rQgsKCV9saW5rZWRpbiJLChpDcmVhdGVPcmdhbml6YXRpb25SZXNwb25zZRItCgxvcmdhbml6YXRpb24YASABKAsyFy5ldmVudHMudjEuT3JnYW5pemF0aW9uIiQKFkdldE9yZ2FuaXphdGlvblJlcXVlc3QSCgoCaWQYASABKAUiSAoXR2V0T3JnYW5pemF0aW9uUmVzcG9uc2USLQoMb3JnYW5pemF0aW9uGAEgASgLMhcuZXZlbnRzLnYxLk9yZ2FuaXphdGlvbiLAAQoYTGlzdE9yZ2FuaXphdGlvbnNSZXF1ZXN0EgwKBHBhZ2UYASABKAUSDQoFbGltaXQYAiABKAUSOQoNc3RhdHVzX2ZpbHRlchgDIAEoDjIdLmV2ZW50cy52MS5Pcmdhbml6YXRpb25TdGF0dXNIAIgBARIhChRvcmdhbml6YXRpb25fdHlwZV9pZBgEIAEoBUgBiAEBQhAKDl9zdGF0dXNfZmlsdGVyQhcKFV9vcmdhbml6YXRpb25fdHlwZV9pZCJaChlMaXN0T3JnYW5pemF0aW9uc1Jlc3BvbnNlEi4KDW9yZ2FuaXphdGlvbnMYASADKAsyFy5ldmVudHMudjEuT3JnYW5pemF0aW9uEg0KBXRvdGFsGAIgASgFIqsFChlVcGRhdGVPcmdhbml6YXRpb25SZXF1ZXN0EgoKAmlkGAEgASgFEhIKBXRpdGxlGAIgASgJSACIAQESFgoJaW1hZ2VfdXJsGAMgASgJSAGIAQESGAoLZGVzY3JpcHRpb24YBCABKAlIAogBARIhChRvcmdhbml6YXRpb25fdHlwZV9pZBgFIAEoBUgDiAEBEhYKCWluc3RhZ3JhbRgGIAEoCUgEiAEBEh0KEHRlbGVncmFtX2NoYW5uZWwYByABKAlIBYgBARIaCg10ZWxlZ3JhbV9jaGF0GAggASgJSAaIAQESFAoHd2Vic2l0ZRgJIAEoCUgHiAEBEhQKB3lvdXR1YmUYCiABKAlICIgBARITCgZ0aWt0b2sYCyABKAlICYgBARIVCghsaW5rZWRpbhgMIAEoCUgKiAEBEjIKBnN0YXR1cxgNIAEoDjIdLmV2ZW50cy52MS5Pcmdhbml6YXRpb25TdGF0dXNIC4gBARIgChNtb250aGx5X2V2ZW50X3F1b3RhGA4gASgFSAyIAQESGgoNY29udGFjdF9lbWFpbBgPIAEoCUgNiAEBEiEKGWNsZWFyX21vbnRobHlfZXZlbnRfcXVvdGEYECABKAhCCAoGX3RpdGxlQgwKCl9pbWFnZV91cmxCDgoMX2Rlc2NyaXB0aW9uQhcKFV9vcmdhbml6YXRpb25fdHlwZV9pZEIMCgpfaW5zdGFncmFtQhMKEV90ZWxlZ3JhbV9jaGFubmVsQhAKDl90ZWxlZ3JhbV9jaGF0QgoKCF93ZWJzaXRlQgoKCF95b3V0dWJlQgkKB190aWt0b2tCCwoJX2xpbmtlZGluQgkKB19zdGF0dXNCFgoUX21vbnRobHlfZXZlbnRfcXVvdGFCEAoOX2NvbnRhY3RfZW1haWwiSwoaVXBkYXRlT3JnYW5pemF0aW9uUmVzcG9uc2USLQoMb3JnYW5pemF0aW9uGAEgASgLMhcuZXZlbnRzLnYxLk9yZ2FuaXphdGlvbiI7CiBHZXRPcmdhbml6YXRpb25RdW90YVVzYWdlUmVxdWVzdBIXCg9vcmdhbml6YXRpb25faWQYASABKAUidQohR2V0T3JnYW5pemF0aW9uUXVvdGFVc2FnZVJlc3BvbnNlEhIKBXF1b3RhGAEgASgFSACIAQESDAoEdXNlZBgCIAEoBRIWCglyZW1haW5pbmcYAyABKAVIAYgBAUIICgZfcXVvdGFCDAoKX3JlbWFpbmluZyJUChJPcmdhbml6YXRpb25NZW1iZXISDwoHdXNlcl9pZBgBIAEoBRIQCgh1c2VybmFtZRgCIAEoCRINCgVlbWFpbBgDIAEoCRIMCgRyb2xlGAQgASgJIlUKHUdldE9yZ2FuaXphdGlvbk1lbWJlcnNSZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoBRIMCgRwYWdlGAIgASgFEg0KBWxpbWl0GAMgASgFIl8KHkdldE9yZ2FuaXphdGlvbk1lbWJlcnNSZXNwb25zZRIuCgdtZW1iZXJzGAEgAygLMh0uZXZlbnRzLnYxLk9yZ2FuaXphdGlvbk1lbWJlchINCgV0b3RhbBgCIAEoBSJkChVBc3NpZ25DbHViUm9sZVJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgFEg8KB3VzZXJfaWQYAiABKAUSIQoEcm9sZRgDIAEoDjITLmV2ZW50cy52MS5DbHViUm9sZSJHChZBc3NpZ25DbHViUm9sZVJlc3BvbnNlEi0KBm1lbWJlchgBIAEoCzIdLmV2ZW50cy52MS5Pcmdhbml6YXRpb25NZW1iZXIiQwoXUmVtb3ZlQ2x1Yk1lbWJlclJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgFEg8KB3VzZXJfaWQYAiABKAUiKwoYUmVtb3ZlQ2x1Yk1lbWJlclJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgixQEKE09yZ2FuaXphdGlvbklucXVpcnkSCgoCaWQYASABKAUSFwoPb3JnYW5pemF0aW9uX2lkGAIgASgFEhQKDHNlbmRlcl9lbWFpbBgDIAEoCRITCgtzZW5kZXJfbmFtZRgEIAEoCRIPCgdzdWJqZWN0GAUgASgJEg8KB21lc3NhZ2UYBiABKAkSKAoGc3RhdHVzGAcgASgOMhguZXZlbnRzLnYxLklucXVpcnlTdGF0dXMSEgoKY3JlYXRlZF9hdBgIIAEoCSKIAQogU3VibWl0T3JnYW5pemF0aW9uSW5xdWlyeVJlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgFEhQKDHNlbmRlcl9lbWFpbBgCIAEoCRITCgtzZW5kZXJfbmFtZRgDIAEoCRIPCgdzdWJqZWN0GAQgASgJEg8KB21lc3NhZ2UYBSABKAkiNwohU3VibWl0T3JnYW5pemF0aW9uSW5xdWlyeVJlc3BvbnNlEhIKCmlucXVpcnlfaWQYASABKAUiWAogTGlzdE9yZ2FuaXphdGlvbklucXVpcmllc1JlcXVlc3QSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgFEgwKBHBhZ2UYAiABKAUSDQoFbGltaXQYAyABKAUiZQohTGlzdE9yZ2FuaXphdGlvbklucXVpcmllc1Jlc3BvbnNlEjEKCWlucXVpcmllcxgBIAMoCzIeLmV2ZW50cy52MS5Pcmdhbml6YXRpb25JbnF1aXJ5Eg0KBXRvdGFsGAIgASgFIloKGlVwZGF0ZUlucXVpcnlTdGF0dXNSZXF1ZXN0EhIKCmlucXVpcnlfaWQYASABKAUSKAoGc3RhdHVzGAIgASgOMhguZXZlbnRzLnYxLklucXVpcnlTdGF0dXMiTgobVXBkYXRlSW5xdWlyeVN0YXR1c1Jlc3BvbnNlEi8KB2lucXVpcnkYASABKAsyHi5ldmVudHMudjEuT3JnYW5pemF0aW9uSW5xdWlyeSJBChlNZXJnZU9yZ2FuaXphdGlvbnNSZXF1ZXN0EhEKCXNvdXJjZV9pZBgBIAEoBRIRCgl0YXJnZXRfaWQYAiABKAUijgEKGk1lcmdlT3JnYW5pemF0aW9uc1Jlc3BvbnNlEi0KDGFyY2hpdmVkX29yZxgBIAEoCzIXLmV2ZW50cy52MS5Pcmdhbml6YXRpb24SKwoKdGFyZ2V0X29yZxgCIAEoCzIXLmV2ZW50cy52MS5Pcmdhbml6YXRpb24SFAoMZXZlbnRzX21vdmVkGAMgASgFIicKGURlbGV0ZU9yZ2FuaXphdGlvblJlcXVlc3QSCgoCaWQYASABKAUiLQoaRGVsZXRlT3JnYW5pemF0aW9uUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCIuCh1DcmVhdGVPcmdhbml6YXRpb25UeXBlUmVxdWVzdBINCgV0aXRsZRgBIAEoCSJYCh5DcmVhdGVPcmdhbml6YXRpb25UeXBlUmVzcG9uc2USNgoRb3JnYW5pemF0aW9uX3R5cGUYASABKAsyGy5ldmVudHMudjEuT3JnYW5pemF0aW9uVHlwZSIoChpHZXRPcmdhbml6YXRpb25UeXBlUmVxdWVzdBIKCgJpZBgBIAEoBSJVChtHZXRPcmdhbml6YXRpb25UeXBlUmVzcG9uc2USNgoRb3JnYW5pemF0aW9uX3R5cGUYASABKAsyGy5ldmVudHMudjEuT3JnYW5pemF0aW9uVHlwZSI7ChxMaXN0T3JnYW5pemF0aW9uVHlwZXNSZXF1ZXN0EgwKBHBhZ2UYASABKAUSDQoFbGltaXQYAiABKAUiZwodTGlzdE9yZ2FuaXphdGlvblR5cGVzUmVzcG9uc2USNwoSb3JnYW5pemF0aW9uX3R5cGVzGAEgAygLMhsuZXZlbnRzLnYxLk9yZ2FuaXphdGlvblR5cGUSDQoFdG90YWwYAiABKAUiSQodVXBkYXRlT3JnYW5pemF0aW9uVHlwZVJlcXVlc3QSCgoCaWQYASABKAUSEgoFdGl0bGUYAiABKAlIAIgBAUIICgZfdGl0bGUiWAoeVXBkYXRlT3JnYW5pemF0aW9uVHlwZVJlc3BvbnNlEjYKEW9yZ2FuaXphdGlvbl90eXBlGAEgASgLMhsuZXZlbnRzLnYxLk9yZ2FuaXphdGlvblR5cGUiKwodRGVsZXRlT3JnYW5pemF0aW9uVHlwZVJlcXVlc3QSCgoCaWQYASABKAUiMQoeRGVsZXRlT3JnYW5pemF0aW9uVHlwZVJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAginQIKEkNyZWF0ZUV2ZW50UmVxdWVzdBINCgV0aXRsZRgBIAEoCRITCgtkZXNjcmlwdGlvbhgCIAEoCRIWCglpbWFnZV91cmwYAyABKAlIAIgBARIPCgd1c2VyX2lkGAQgASgFEhcKD29yZ2FuaXphdGlvbl9pZBgFIAEoBRIQCghsb2NhdGlvbhgGIAEoCRISCgpzdGFydF90aW1lGAcgASgJEhAKCGVuZF90aW1lGAggASgJEiYKBmZvcm1hdBgJIAEoDjIWLmV2ZW50cy52MS5FdmVudEZvcm1hdBIPCgd0YWdfaWRzGAogAygFEhUKCGNhcGFjaXR5GAsgASgFSAGIAQFCDAoKX2ltYWdlX3VybEILCglfY2FwYWNpdHkiNgoTQ3JlYXRlRXZlbnRSZXNwb25zZRIfCgVldmVudBgBIAEoCzIQLmV2ZW50cy52MS5FdmVudCJIChdCdWxrQ3JlYXRlRXZlbnRzUmVxdWVzdBItCgZldmVudHMYASADKAsyHS5ldmVudHMudjEuQ3JlYXRlRXZlbnRSZXF1ZXN0IjwKGEJ1bGtDcmVhdGVFdmVudHNSZXNwb25zZRIgCgZldmVudHMYASADKAsyEC5ldmVudHMudjEuRXZlbnQiXgoVRHVwbGljYXRlRXZlbnRSZXF1ZXN0EhcKD3NvdXJjZV9ldmVudF9pZBgBIAEoBRIWCg5uZXdfc3RhcnRfdGltZRgCIAEoCRIUCgxuZXdfZW5kX3RpbWUYAyABKAkiOQoWRHVwbGljYXRlRXZlbnRSZXNwb25zZRIfCgVldmVudBgBIAEoCzIQLmV2ZW50cy52MS5FdmVudCIdCg9HZXRFdmVudFJlcXVlc3QSCgoCaWQYASABKAUi3QEKEEdldEV2ZW50UmVzcG9uc2USHwoFZXZlbnQYASABKAsyEC5ldmVudHMudjEuRXZlbnQSPgoTY2FsbGVyX3JlZ2lzdHJhdGlvbhgCIAEoCzIcLmV2ZW50cy52MS5FdmVudFJlZ2lzdHJhdGlvbkgAiAEBEjoKEWNhbGxlcl9hdHRlbmRhbmNlGAMgASgLMhouZXZlbnRzLnYxLkV2ZW50QXR0ZW5kYW5jZUgBiAEBQhYKFF9jYWxsZXJfcmVnaXN0cmF0aW9uQhQKEl9jYWxsZXJfYXR0ZW5kYW5jZSLSAQoRTGlzdEV2ZW50c1JlcXVlc3QSDAoEcGFnZRgBIAEoBRINCgVsaW1pdBgCIAEoBRIUCgd1c2VyX2lkGAMgASgFSACIAQESHAoPb3JnYW5pemF0aW9uX2lkGAQgASgFSAGIAQESDwoHdGFnX2lkcxgFIAMoBRIbChNpbmNsdWRlX3VucHVibGlzaGVkGAYgASgIEhMKBmN1cnNvchgHIAEoCUgCiAEBQgoKCF91c2VyX2lkQhIKEF9vcmdhbml6YXRpb25faWRCCQoHX2N1cnNvciJvChJMaXN0RXZlbnRzUmVzcG9uc2USIAoGZXZlbnRzGAEgAygLMhAuZXZlbnRzLnYxLkV2ZW50Eg0KBXRvdGFsGAIgASgFEhgKC25leHRfY3Vyc29yGAMgASgJSACIAQFCDgoMX25leHRfY3Vyc29yIvMDChJVcGRhdGVFdmVudFJlcXVlc3QSCgoCaWQYASABKAUSEgoFdGl0bGUYAiABKAlIAIgBARIYCgtkZXNjcmlwdGlvbhgDIAEoCUgBiAEBEhYKCWltYWdlX3VybBgEIAEoCUgCiAEBEhQKB3VzZXJfaWQYBSABKAVIA4gBARIcCg9vcmdhbml6YXRpb25faWQYBiABKAVIBIgBARIVCghsb2NhdGlvbhgHIAEoCUgFiAEBEhcKCnN0YXJ0X3RpbWUYCCABKAlIBogBARIVCghlbmRfdGltZRgJIAEoCUgHiAEBEisKBmZvcm1hdBgKIAEoDjIWLmV2ZW50cy52MS5FdmVudEZvcm1hdEgIiAEBEg8KB3RhZ19pZHMYCyADKAUSFQoIY2FwYWNpdHkYDCABKAVICYgBARIdChBleHBlY3RlZF92ZXJzaW9uGA0gASgFSAqIAQFCCAoGX3RpdGxlQg4KDF9kZXNjcmlwdGlvbkIMCgpfaW1hZ2VfdXJsQgoKCF91c2VyX2lkQhIKEF9vcmdhbml6YXRpb25faWRCCwoJX2xvY2F0aW9uQg0KC19zdGFydF90aW1lQgsKCV9lbmRfdGltZUIJCgdfZm9ybWF0QgsKCV9jYXBhY2l0eUITChFfZXhwZWN0ZWRfdmVyc2lvbiI2ChNVcGRhdGVFdmVudFJlc3BvbnNlEh8KBWV2ZW50GAEgASgLMhAuZXZlbnRzLnYxLkV2ZW50IiAKEkRlbGV0ZUV2ZW50UmVxdWVzdBIKCgJpZBgBIAEoBSImChNEZWxldGVFdmVudFJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiIQoTUmVzdG9yZUV2ZW50UmVxdWVzdBIKCgJpZBgBIAEoBSI3ChRSZXN0b3JlRXZlbnRSZXNwb25zZRIfCgVldmVudBgBIAEoCzIQLmV2ZW50cy52MS5FdmVudCI3ChhMaXN0RGVsZXRlZEV2ZW50c1JlcXVlc3QSDAoEcGFnZRgBIAEoBRINCgVsaW1pdBgCIAEoBSJMChlMaXN0RGVsZXRlZEV2ZW50c1Jlc3BvbnNlEiAKBmV2ZW50cxgBIAMoCzIQLmV2ZW50cy52MS5FdmVudBINCgV0b3RhbBgCIAEoBSJDChxUb2dnbGVFdmVudFZpc2liaWxpdHlSZXF1ZXN0EhAKCGV2ZW50X2lkGAEgASgFEhEKCXB1Ymxpc2hlZBgCIAEoCCJACh1Ub2dnbGVFdmVudFZpc2liaWxpdHlSZXNwb25zZRIfCgVldmVudBgBIAEoCzIQLmV2ZW50cy52MS5FdmVudCIgChBDcmVhdGVUYWdSZXF1ZXN0EgwKBG5hbWUYASABKAkiMAoRQ3JlYXRlVGFnUmVzcG9uc2USGwoDdGFnGAEgASgLMg4uZXZlbnRzLnYxLlRhZyIbCg1HZXRUYWdSZXF1ZXN0EgoKAmlkGAEgASgFIi0KDkdldFRhZ1Jlc3BvbnNlEhsKA3RhZxgBIAEoCzIOLmV2ZW50cy52MS5UYWcihwEKD0xpc3RUYWdzUmVxdWVzdBIMCgRwYWdlGAEgASgFEg0KBWxpbWl0GAIgASgFEiUKB3NvcnRfYnkYAyABKA4yFC5ldmVudHMudjEuVGFnU29ydEJ5EhwKD21pbl9ldmVudF9jb3VudBgEIAEoBUgAiAEBQhIKEF9taW5fZXZlbnRfY291bnQiPwoQTGlzdFRhZ3NSZXNwb25zZRIcCgR0YWdzGAEgAygLMg4uZXZlbnRzLnYxLlRhZxINCgV0b3RhbBgCIAEoBSI6ChBVcGRhdGVUYWdSZXF1ZXN0EgoKAmlkGAEgASgFEhEKBG5hbWUYAiABKAlIAIgBAUIHCgVfbmFtZSIwChFVcGRhdGVUYWdSZXNwb25zZRIbCgN0YWcYASABKAsyDi5ldmVudHMudjEuVGFnIh4KEERlbGV0ZVRhZ1JlcXVlc3QSCgoCaWQYASABKAUiJAoRRGVsZXRlVGFnUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCJAChBNZXJnZVRhZ3NSZXF1ZXN0EhUKDXNvdXJjZV90YWdfaWQYASABKAUSFQoNdGFyZ2V0X3RhZ19pZBgCIAEoBSJQChFNZXJnZVRhZ3NSZXNwb25zZRIiCgp0YXJnZXRfdGFnGAEgASgLMg4uZXZlbnRzLnYxLlRhZxIXCg9ldmVudHNfYWZmZWN0ZWQYAiABKAUiNQoiR2V0UHVibGlzaGFibGVPcmdhbml6YXRpb25zUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgFIlUKI0dldFB1Ymxpc2hhYmxlT3JnYW5pemF0aW9uc1Jlc3BvbnNlEi4KDW9yZ2FuaXphdGlvbnMYASADKAsyFy5ldmVudHMudjEuT3JnYW5pemF0aW9uIi4KG0dldFVzZXJPcmdhbml6YXRpb25zUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgFIk4KHEdldFVzZXJPcmdhbml6YXRpb25zUmVzcG9uc2USLgoNb3JnYW5pemF0aW9ucxgBIAMoCzIXLmV2ZW50cy52MS5Pcmdhbml6YXRpb24iKQoXR2V0RXZlbnRzQnlUYWdJZFJlcXVlc3QSDgoGdGFnX2lkGAEgASgFIjwKGEdldEV2ZW50c0J5VGFnSWRSZXNwb25zZRIgCgZldmVudHMYASADKAsyEC5ldmVudHMudjEuRXZlbnQiSQoZR2V0RXZlbnRzQnlBbGxUYWdzUmVxdWVzdBIPCgd0YWdfaWRzGAEgAygFEgwKBHBhZ2UYAiABKAUSDQoFbGltaXQYAyABKAUiTQoaR2V0RXZlbnRzQnlBbGxUYWdzUmVzcG9uc2USIAoGZXZlbnRzGAEgAygLMhAuZXZlbnRzLnYxLkV2ZW50Eg0KBXRvdGFsGAIgASgFInkKF0dldE1hbmFnZWRFdmVudHNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAUSDAoEcGFnZRgCIAEoBRINCgVsaW1pdBgDIAEoBRIwCgtyb2xlX2ZpbHRlchgEIAEoDjIbLmV2ZW50cy52MS5NYW5hZ2VkRXZlbnRSb2xlIksKGEdldE1hbmFnZWRFdmVudHNSZXNwb25zZRIgCgZldmVudHMYASADKAsyEC5ldmVudHMudjEuRXZlbnQSDQoFdG90YWwYAiABKAUiRAoTR2V0RXZlbnRGZWVkUmVxdWVzdBITCgZjdXJzb3IYASABKAlIAIgBARINCgVsaW1pdBgCIAEoBUIJCgdfY3Vyc29yIksKCUZlZWRFdmVudBIfCgVldmVudBgBIAEoCzIQLmV2ZW50cy52MS5FdmVudBIOCgZzb3VyY2UYAiABKAkSDQoFc2NvcmUYAyABKAEiZgoUR2V0RXZlbnRGZWVkUmVzcG9uc2USJAoGZXZlbnRzGAEgAygLMhQuZXZlbnRzLnYxLkZlZWRFdmVudBIYCgtuZXh0X2N1cnNvchgCIAEoCUgAiAEBQg4KDF9uZXh0X2N1cnNvciJ+CgxFdmVudFN1bW1hcnkSCgoCaWQYASABKAUSDQoFdGl0bGUYAiABKAkSEgoKc3RhcnRfdGltZRgDIAEoCRImCgZmb3JtYXQYBCABKA4yFi5ldmVudHMudjEuRXZlbnRGb3JtYXQSFwoPb3JnYW5pemF0aW9uX2lkGAUgASgFImgKF0dldEV2ZW50Q2FsZW5kYXJSZXF1ZXN0EgwKBHllYXIYASABKAUSDQoFbW9udGgYAiABKAUSHAoPb3JnYW5pemF0aW9uX2lkGAMgASgFSACIAQFCEgoQX29yZ2FuaXphdGlvbl9pZCJZCgtDYWxlbmRhckRheRIMCgRkYXRlGAEgASgJEhMKC2V2ZW50X2NvdW50GAIgASgFEicKBmV2ZW50cxgDIAMoCzIXLmV2ZW50cy52MS5FdmVudFN1bW1hcnkiQAoYR2V0RXZlbnRDYWxlbmRhclJlc3BvbnNlEiQKBGRheXMYASADKAsyFi5ldmVudHMudjEuQ2FsZW5kYXJEYXkiTgoeR2V0VXNlclN1YnNjcmliZWRFdmVudHNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAUSDAoEcGFnZRgCIAEoBRINCgVsaW1pdBgDIAEoBSJSCh9HZXRVc2VyU3Vic2NyaWJlZEV2ZW50c1Jlc3BvbnNlEiAKBmV2ZW50cxgBIAMoCzIQLmV2ZW50cy52MS5FdmVudBINCgV0b3RhbBgCIAEoBSKsAQoZTGlzdEV2ZW50c0ZvckFkbWluUmVxdWVzdBIMCgRwYWdlGAEgASgFEg0KBWxpbWl0GAIgASgFEhQKB3VzZXJfaWQYAyABKAVIAIgBARIcCg9vcmdhbml6YXRpb25faWQYBCABKAVIAYgBARITCgZjdXJzb3IYBSABKAlIAogBAUIKCghfdXNlcl9pZEISChBfb3JnYW5pemF0aW9uX2lkQgkKB19jdXJzb3IidwoaTGlzdEV2ZW50c0ZvckFkbWluUmVzcG9uc2USIAoGZXZlbnRzGAEgAygLMhAuZXZlbnRzLnYxLkV2ZW50Eg0KBXRvdGFsGAIgASgFEhgKC25leHRfY3Vyc29yGAMgASgJSACIAQFCDgoMX25leHRfY3Vyc29yIjwKF1JlZ2lzdGVyRm9yRXZlbnRSZXF1ZXN0EhAKCGV2ZW50X2lkGAEgASgFEg8KB3VzZXJfaWQYAiABKAUidgoYUmVnaXN0ZXJGb3JFdmVudFJlc3BvbnNlEjIKDHJlZ2lzdHJhdGlvbhgBIAEoCzIcLmV2ZW50cy52MS5FdmVudFJlZ2lzdHJhdGlvbhIXCgpjYW5jZWxfdXJsGAIgASgJSACIAQFCDQoLX2NhbmNlbF91cmwiNAoZQ2FuY2VsUmVnaXN0cmF0aW9uUmVxdWVzdBIXCg9yZWdpc3RyYXRpb25faWQYASABKAUiLQoaQ2FuY2VsUmVnaXN0cmF0aW9uUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCJqChxHZXRFdmVudFJlZ2lzdHJhdGlvbnNSZXF1ZXN0EhAKCGV2ZW50X2lkGAEgASgFEhEKBHBhZ2UYAiABKAVIAIgBARISCgVsaW1pdBgDIAEoBUgBiAEBQgcKBV9wYWdlQggKBl9saW1pdCJjCh1HZXRFdmVudFJlZ2lzdHJhdGlvbnNSZXNwb25zZRIzCg1yZWdpc3RyYXRpb25zGAEgAygLMhwuZXZlbnRzLnYxLkV2ZW50UmVnaXN0cmF0aW9uEg0KBXRvdGFsGAIgASgFIqEBChtHZXRVc2VyUmVnaXN0cmF0aW9uc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoBRIMCgRwYWdlGAIgASgFEg0KBWxpbWl0GAMgASgFEjIKBnN0YXR1cxgEIAEoDjIdLmV2ZW50cy52MS5SZWdpc3RyYXRpb25TdGF0dXNIAIgBARIVCg1pbmNsdWRlX2V2ZW50GAUgASgIQgkKB19zdGF0dXMiYgocR2V0VXNlclJlZ2lzdHJhdGlvbnNSZXNwb25zZRIzCg1yZWdpc3RyYXRpb25zGAEgAygLMhwuZXZlbnRzLnYxLkV2ZW50UmVnaXN0cmF0aW9uEg0KBXRvdGFsGAIgASgFImkKEFJlZ2lzdHJhdGlvbkhvbGQSCgoCaWQYASABKAUSEAoIZXZlbnRfaWQYAiABKAUSDwoHdXNlcl9pZBgDIAEoBRISCgpleHBpcmVzX2F0GAQgASgJEhIKCmNyZWF0ZWRfYXQYBSABKAkiYAocSG9sZEV2ZW50UmVnaXN0cmF0aW9uUmVxdWVzdBIQCghldmVudF9pZBgBIAEoBRIPCgd1c2VyX2lkGAIgASgFEh0KFWhvbGRfZHVyYXRpb25fc2Vjb25kcxgDIAEoBSJjCh1Ib2xkRXZlbnRSZWdpc3RyYXRpb25SZXNwb25zZRIpCgRob2xkGAEgASgLMhsuZXZlbnRzLnYxLlJlZ2lzdHJhdGlvbkhvbGQSFwoPYXZhaWxhYmxlX3Nsb3RzGAIgASgFIi0KGkNvbmZpcm1SZWdpc3RyYXRpb25SZXF1ZXN0Eg8KB2hvbGRfaWQYASABKAUieQobQ29uZmlybVJlZ2lzdHJhdGlvblJlc3BvbnNlEjIKDHJlZ2lzdHJhdGlvbhgBIAEoCzIcLmV2ZW50cy52MS5FdmVudFJlZ2lzdHJhdGlvbhIXCgpjYW5jZWxfdXJsGAIgASgJSACIAQFCDQoLX2NhbmNlbF91cmwiXAoYTm90aWZ5UmVnaXN0cmFudHNSZXF1ZXN0EhAKCGV2ZW50X2lkGAEgASgFEg8KB3N1YmplY3QYAiABKAkSDAoEYm9keRgDIAEoCRIPCgdkcnlfcnVuGAQgASgIIlQKGU5vdGlmeVJlZ2lzdHJhbnRzUmVzcG9uc2USEwoLZW1haWxzX3NlbnQYASABKAUSDgoGZXJyb3JzGAIgAygJEhIKCnJlY2lwaWVudHMYAyADKAkiRgohR2V0RXZlbnRSZWdpc3RyYXRpb25TdGF0dXNSZXF1ZXN0EhAKCGV2ZW50X2lkGAEgASgFEg8KB3VzZXJfaWQYAiABKAUinQEKIkdldEV2ZW50UmVnaXN0cmF0aW9uU3RhdHVzUmVzcG9uc2USLQoGc3RhdHVzGAEgASgOMh0uZXZlbnRzLnYxLlJlZ2lzdHJhdGlvblN0YXR1cxI3CgxyZWdpc3RyYXRpb24YAiABKAsyHC5ldmVudHMudjEuRXZlbnRSZWdpc3RyYXRpb25IAIgBAUIPCg1fcmVnaXN0cmF0aW9uImYKFkNoZWNrSW5BdHRlbmRlZVJlcXVlc3QSFwoPcmVnaXN0cmF0aW9uX2lkGAEgASgFEhUKDWNoZWNrZWRfaW5fYnkYAiABKAUSEgoFbm90ZXMYAyABKAlIAIgBAUIICgZfbm90ZXMiSQoXQ2hlY2tJbkF0dGVuZGVlUmVzcG9uc2USLgoKYXR0ZW5kYW5jZRgBIAEoCzIaLmV2ZW50cy52MS5FdmVudEF0dGVuZGFuY2UiRQoSQnVsa0NoZWNrSW5SZXF1ZXN0EhgKEHJlZ2lzdHJhdGlvbl9pZHMYASADKAUSFQoNY2hlY2tlZF9pbl9ieRgCIAEoBSI9ChJCdWxrQ2hlY2tJbkZhaWx1cmUSFwoPcmVnaXN0cmF0aW9uX2lkGAEgASgFEg4KBnJlYXNvbhgCIAEoCSJXChNCdWxrQ2hlY2tJblJlc3BvbnNlEhEKCXN1Y2NlZWRlZBgBIAMoBRItCgZmYWlsZWQYAiADKAsyHS5ldmVudHMudjEuQnVsa0NoZWNrSW5GYWlsdXJlInsKFU1hcmtBdHRlbmRhbmNlUmVxdWVzdBIXCg9yZWdpc3RyYXRpb25faWQYASABKAUSKwoGc3RhdHVzGAIgASgOMhsuZXZlbnRzLnYxLkF0dGVuZGFuY2VTdGF0dXMSEgoFbm90ZXMYAyABKAlIAIgBAUIICgZfbm90ZXMiSAoWTWFya0F0dGVuZGFuY2VSZXNwb25zZRIuCgphdHRlbmRhbmNlGAEgASgLMhouZXZlbnRzLnYxLkV2ZW50QXR0ZW5kYW5jZSItChlHZXRFdmVudEF0dGVuZGFuY2VSZXF1ZXN0EhAKCGV2ZW50X2lkGAEgASgFIpUBChpHZXRFdmVudEF0dGVuZGFuY2VSZXNwb25zZRIuCgphdHRlbmRhbmNlGAEgAygLMhouZXZlbnRzLnYxLkV2ZW50QXR0ZW5kYW5jZRIYChB0b3RhbF9yZWdpc3RlcmVkGAIgASgFEhYKDnRvdGFsX2F0dGVuZGVkGAMgASgFEhUKDXRvdGFsX25vX3Nob3cYBCABKAUiMAocU3RyZWFtRXZlbnRBdHRlbmRhbmNlUmVxdWVzdBIQCghldmVudF9pZBgBIAEoBSJPCh1TdHJlYW1FdmVudEF0dGVuZGFuY2VSZXNwb25zZRIuCgphdHRlbmRhbmNlGAEgASgLMhouZXZlbnRzLnYxLkV2ZW50QXR0ZW5kYW5jZSJvCh9HZXRVc2VyQXR0ZW5kYW5jZUhpc3RvcnlSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAUSDAoEcGFnZRgCIAEoBRINCgVsaW1pdBgDIAEoBRITCgZjdXJzb3IYBCABKAlIAIgBAUIJCgdfY3Vyc29yIoMBChRBdHRlbmRlZEV2ZW50U3VtbWFyeRIfCgVldmVudBgBIAEoCzIQLmV2ZW50cy52MS5FdmVudBIaCg1jaGVja2VkX2luX2F0GAIgASgJSACIAQESEgoFbm90ZXMYAyABKAlIAYgBAUIQCg5fY2hlY2tlZF9pbl9hdEIICgZfbm90ZXMiiQEKHVVzZXJBdHRlbmRhbmNlSGlzdG9yeVJlc3BvbnNlEi8KBmV2ZW50cxgBIAMoCzIfLmV2ZW50cy52MS5BdHRlbmRlZEV2ZW50U3VtbWFyeRINCgV0b3RhbBgCIAEoBRIYCgtuZXh0X2N1cnNvchgDIAEoCUgAiAEBQg4KDF9uZXh0X2N1cnNvciIwChxFeHBvcnRFdmVudEF0dGVuZGFuY2VSZXF1ZXN0EhAKCGV2ZW50X2lkGAEgASgFIiUKFUF0dGVuZGFuY2VFeHBvcnRDaHVuaxIMCgRkYXRhGAEgASgMIjYKHUdldERhc2hib2FyZFN0YXRpc3RpY3NSZXF1ZXN0EhUKDWZvcmNlX3JlZnJlc2gYASABKAgiUAoeR2V0RGFzaGJvYXJkU3RhdGlzdGljc1Jlc3BvbnNlEi4KCnN0YXRpc3RpY3MYASABKAsyGi5ldmVudHMudjEuRXZlbnRTdGF0aXN0aWNzIi0KGUdldEV2ZW50U3RhdGlzdGljc1JlcXVlc3QSEAoIZXZlbnRfaWQYASABKAUikAEKGkdldEV2ZW50U3RhdGlzdGljc1Jlc3BvbnNlEhsKE3RvdGFsX3JlZ2lzdHJhdGlvbnMYASABKAUSFwoPdG90YWxfYXR0ZW5kZWVzGAIgASgFEhIKCmNoZWNrZWRfaW4YAyABKAUSDwoHbm9fc2hvdxgEIAEoBRIXCg9hdHRlbmRhbmNlX3JhdGUYBSABKAEiSAoPVGFnRGlzdHJpYnV0aW9uEg4KBnRhZ19pZBgBIAEoBRIQCgh0YWdfbmFtZRgCIAEoCRITCgtldmVudF9jb3VudBgDIAEoBSJFCiZHZXRFdmVudFRhZ3NEaXN0cmlidXRpb25CeU1vbnRoUmVxdWVzdBIMCgR5ZWFyGAEgASgFEg0KBW1vbnRoGAIgASgFImkKJ0dldEV2ZW50VGFnc0Rpc3RyaWJ1dGlvbkJ5TW9udGhSZXNwb25zZRIoCgR0YWdzGAEgAygLMhouZXZlbnRzLnYxLlRhZ0Rpc3RyaWJ1dGlvbhIUCgx0b3RhbF9ldmVudHMYAiABKAUiOwoNRXZlbnRBY3Rpdml0eRIMCgRkYXRlGAEgASgJEg0KBWNvdW50GAIgASgFEg0KBWxldmVsGAMgASgFIi0KHUdldEV2ZW50QWN0aXZpdHlCeVllYXJSZXF1ZXN0EgwKBHllYXIYASABKAUiZAoeR2V0RXZlbnRBY3Rpdml0eUJ5WWVhclJlc3BvbnNlEiwKCmFjdGl2aXRpZXMYASADKAsyGC5ldmVudHMudjEuRXZlbnRBY3Rpdml0eRIUCgx0b3RhbF9ldmVudHMYAiABKAUiXwoRRXZlbnRTdGF0c1N1bW1hcnkSFAoMdG90YWxfZXZlbnRzGAEgASgFEhsKE3RvdGFsX3JlZ2lzdHJhdGlvbnMYAiABKAUSFwoPdG90YWxfYXR0ZW5kZWVzGAMgASgFIjQKG0dldE92ZXJhbGxTdGF0aXN0aWNzUmVxdWVzdBIVCg1mb3JjZV9yZWZyZXNoGAEgASgIIvoBChxHZXRPdmVyYWxsU3RhdGlzdGljc1Jlc3BvbnNlEhQKDHRvdGFsX2V2ZW50cxgBIAEoBRITCgt0b3RhbF91c2VycxgCIAEoBRIbChN0b3RhbF9vcmdhbml6YXRpb25zGAMgASgFEhsKE3RvdGFsX3JlZ2lzdHJhdGlvbnMYBCABKAUSFwoPdXBjb21pbmdfZXZlbnRzGAUgASgFEh8KF2F2ZXJhZ2VfYXR0ZW5kYW5jZV9yYXRlGAYgASgBEhkKEWV2ZW50c190aGlzX21vbnRoGAcgASgFEiAKGHJlZ2lzdHJhdGlvbnNfdGhpc19tb250aBgIIAEoBSJLCgpFdmVudFRyZW5kEgwKBGRhdGUYASABKAkSEwoLZXZlbnRfY291bnQYAiABKAUSGgoScmVnaXN0cmF0aW9uX2NvdW50GAMgASgFIiUKFUdldEV2ZW50VHJlbmRzUmVxdWVzdBIMCgRkYXlzGAEgASgFIj8KFkdldEV2ZW50VHJlbmRzUmVzcG9uc2USJQoGdHJlbmRzGAEgAygLMhUuZXZlbnRzLnYxLkV2ZW50VHJlbmQinAEKHEdldEV2ZW50Q291bnRCeUZvcm1hdFJlcXVlc3QSHAoPb3JnYW5pemF0aW9uX2lkGAEgASgFSACIAQESFwoKc3RhcnRfZGF0ZRgCIAEoCUgBiAEBEhUKCGVuZF9kYXRlGAMgASgJSAKIAQFCEgoQX29yZ2FuaXphdGlvbl9pZEINCgtfc3RhcnRfZGF0ZUILCglfZW5kX2RhdGUicQodR2V0RXZlbnRDb3VudEJ5Rm9ybWF0UmVzcG9uc2USFAoMb25saW5lX2NvdW50GAEgASgFEhUKDW9mZmxpbmVfY291bnQYAiABKAUSFAoMaHlicmlkX2NvdW50GAMgASgFEg0KBXRvdGFsGAQgASgFIusBCg9DbHViTGVhZGVyYm9hcmQSFwoPb3JnYW5pemF0aW9uX2lkGAEgASgFEhoKEm9yZ2FuaXphdGlvbl90aXRsZRgCIAEoCRIfChJvcmdhbml6YXRpb25faW1hZ2UYAyABKAlIAIgBARIUCgx0b3RhbF9ldmVudHMYBCABKAUSGwoTdG90YWxfcmVnaXN0cmF0aW9ucxgFIAEoBRIXCg90b3RhbF9hdHRlbmRlZXMYBiABKAUSHwoXYXZlcmFnZV9hdHRlbmRhbmNlX3JhdGUYByABKAFCFQoTX29yZ2FuaXphdGlvbl9pbWFnZSJ8ChxHZXRUb3BQZXJmb3JtaW5nQ2x1YnNSZXF1ZXN0Eg0KBWxpbWl0GAEgASgFEgwKBGRheXMYAiABKAUSDAoEcGFnZRgDIAEoBRIxCgdzb3J0X2J5GAQgASgOMiAuZXZlbnRzLnYxLkNsdWJMZWFkZXJib2FyZFNvcnRCeSJhCh1HZXRUb3BQZXJmb3JtaW5nQ2x1YnNSZXNwb25zZRItCgVjbHVicxgBIAMoCzIaLmV2ZW50cy52MS5DbHViTGVhZGVyYm9hcmRCAhgBEhEKBXRvdGFsGAIgASgFQgIYASJ7ChlHZXRDbHViTGVhZGVyYm9hcmRSZXF1ZXN0EgwKBGRheXMYASABKAUSDQoFbGltaXQYAiABKAUSDgoGY3Vyc29yGAMgASgJEjEKB3NvcnRfYnkYBCABKA4yIC5ldmVudHMudjEuQ2x1YkxlYWRlcmJvYXJkU29ydEJ5IoMBChdDbHViTGVhZGVyYm9hcmRSZXNwb25zZRIpCgVjbHVicxgBIAMoCzIaLmV2ZW50cy52MS5DbHViTGVhZGVyYm9hcmQSGAoLbmV4dF9jdXJzb3IYAiABKAlIAIgBARITCgt0b3RhbF9jbHVicxgDIAEoBUIOCgxfbmV4dF9jdXJzb3IiIAoeR2V0VXNlckVuZ2FnZW1lbnRMZXZlbHNSZXF1ZXN0IkcKE1VzZXJFbmdhZ2VtZW50TGV2ZWwSDQoFbGV2ZWwYASABKAkSDQoFY291bnQYAiABKAUSEgoKcGVyY2VudGFnZRgDIAEoASKtAQofR2V0VXNlckVuZ2FnZW1lbnRMZXZlbHNSZXNwb25zZRIuCgZsZXZlbHMYASADKAsyHi5ldmVudHMudjEuVXNlckVuZ2FnZW1lbnRMZXZlbBITCgt0b3RhbF91c2VycxgCIAEoBRIVCg10cmVuZF9tZXNzYWdlGAMgASgJEhMKC2Rlc2NyaXB0aW9uGAQgASgJEhkKEWlzX3Bvc2l0aXZlX3RyZW5kGAUgASgIIv0BChJUb3BQZXJmb3JtaW5nRXZlbnQSCgoCaWQYASABKAUSDQoFdGl0bGUYAiABKAkSFgoJaW1hZ2VfdXJsGAMgASgJSACIAQESMgoMb3JnYW5pemF0aW9uGAQgASgLMhcuZXZlbnRzLnYxLk9yZ2FuaXphdGlvbkgBiAEBEhIKCnN0YXJ0X3RpbWUYBSABKAkSGwoTdG90YWxfcmVnaXN0cmF0aW9ucxgGIAEoBRIXCg90b3RhbF9hdHRlbmRlZXMYByABKAUSFwoPYXR0ZW5kYW5jZV9yYXRlGAggASgBQgwKCl9pbWFnZV91cmxCDwoNX29yZ2FuaXphdGlvbiJ3Ch1HZXRUb3BQZXJmb3JtaW5nRXZlbnRzUmVxdWVzdBINCgVsaW1pdBgBIAEoBRIMCgRkYXlzGAIgASgFEgwKBHBhZ2UYAyABKAUSKwoHc29ydF9ieRgEIAEoDjIaLmV2ZW50cy52MS5Ub3BFdmVudHNTb3J0QnkiXgoeR2V0VG9wUGVyZm9ybWluZ0V2ZW50c1Jlc3BvbnNlEi0KBmV2ZW50cxgBIAMoCzIdLmV2ZW50cy52MS5Ub3BQZXJmb3JtaW5nRXZlbnQSDQoFdG90YWwYAiABKAUilwIKFExvd1JlZ2lzdHJhdGlvbkV2ZW50EgoKAmlkGAEgASgFEg0KBXRpdGxlGAIgASgJEhYKCWltYWdlX3VybBgDIAEoCUgAiAEBEjIKDG9yZ2FuaXphdGlvbhgEIAEoCzIXLmV2ZW50cy52MS5Pcmdhbml6YXRpb25IAYgBARISCgpzdGFydF90aW1lGAUgASgJEhAKCGNhcGFjaXR5GAYgASgFEhsKE3RvdGFsX3JlZ2lzdHJhdGlvbnMYByABKAUSHAoUY2FwYWNpdHlfdXRpbGl6YXRpb24YCCABKAESGAoQZGF5c191bnRpbF9ldmVudBgJIAEoBUIMCgpfaW1hZ2VfdXJsQg8KDV9vcmdhbml6YXRpb24iSAofR2V0TG93UmVnaXN0cmF0aW9uRXZlbnRzUmVxdWVzdBIRCgl0aHJlc2hvbGQYASABKAUSEgoKZGF5c19haGVhZBgCIAEoBSJTCiBHZXRMb3dSZWdpc3RyYXRpb25FdmVudHNSZXNwb25zZRIvCgZldmVudHMYASADKAsyHy5ldmVudHMudjEuTG93UmVnaXN0cmF0aW9uRXZlbnQi1AEKFE9yZ2FuaXphdGlvbkFjdGl2aXR5EgoKAmlkGAEgASgFEg0KBXRpdGxlGAIgASgJEhYKCWltYWdlX3VybBgDIAEoCUgAiAEBEhkKEWV2ZW50c190aGlzX21vbnRoGAQgASgFEhkKEWV2ZW50c19sYXN0X21vbnRoGAUgASgFEhQKDHRvdGFsX2V2ZW50cxgGIAEoBRIaChJhdmVyYWdlX2F0dGVuZGFuY2UYByABKAESEwoLZ3Jvd3RoX3JhdGUYCCABKAFCDAoKX2ltYWdlX3VybCIvCh5HZXRPcmdhbml6YXRpb25BY3Rpdml0eVJlcXVlc3QSDQoFbGltaXQYASABKAUiWQofR2V0T3JnYW5pemF0aW9uQWN0aXZpdHlSZXNwb25zZRI2Cg1vcmdhbml6YXRpb25zGAEgAygLMh8uZXZlbnRzLnYxLk9yZ2FuaXphdGlvbkFjdGl2aXR5IkwKI0dldFN0YXRpc3RpY3NGb3JPcmdhbml6YXRpb25SZXF1ZXN0EhcKD29yZ2FuaXphdGlvbl9pZBgBIAEoBRIMCgRkYXlzGAIgASgFIvMBCh5Pcmdhbml6YXRpb25TdGF0aXN0aWNzUmVzcG9uc2USFwoPb3JnYW5pemF0aW9uX2lkGAEgASgFEhQKDHRvdGFsX2V2ZW50cxgCIAEoBRIbChN0b3RhbF9yZWdpc3RyYXRpb25zGAMgASgFEhcKD3RvdGFsX2F0dGVuZGVlcxgEIAEoBRIXCg9hdHRlbmRhbmNlX3JhdGUYBSABKAESLAoIdG9wX3RhZ3MYBiADKAsyGi5ldmVudHMudjEuVGFnRGlzdHJpYnV0aW9uEiUKBnRyZW5kcxgHIAMoCzIVLmV2ZW50cy52MS5FdmVudFRyZW5kIkcKHUdldEV2ZW50SW1hZ2VVcGxvYWRVcmxSZXF1ZXN0EhAKCGZpbGVuYW1lGAEgASgJEhQKDGNvbnRlbnRfdHlwZRgCIAEoCSJcCh5HZXRFdmVudEltYWdlVXBsb2FkVXJsUmVzcG9uc2USEgoKdXBsb2FkX3VybBgBIAEoCRISCgpwdWJsaWNfdXJsGAIgASgJEhIKCm9iamVjdF9rZXkYAyABKAkicgoHV2ViaG9vaxIKCgJpZBgBIAEoBRILCgN1cmwYAiABKAkSEwoLZXZlbnRfdHlwZXMYAyADKAkSEQoJaXNfYWN0aXZlGAQgASgIEhIKCmNyZWF0ZWRfYXQYBSABKAkSEgoKdXBkYXRlZF9hdBgGIAEoCSL3AgoPV2ViaG9va0RlbGl2ZXJ5EgoKAmlkGAEgASgFEhIKCndlYmhvb2tfaWQYAiABKAUSEgoKZXZlbnRfdHlwZRgDIAEoCRIUCgxwYXlsb2FkX2hhc2gYBCABKAkSDwoHYXR0ZW1wdBgFIAEoBRIwCgZzdGF0dXMYBiABKA4yIC5ldmVudHMudjEuV2ViaG9va0RlbGl2ZXJ5U3RhdHVzEhgKC2h0dHBfc3RhdHVzGAcgASgFSACIAQESGgoNcmVzcG9uc2VfYm9keRgIIAEoCUgBiAEBEhkKDGF0dGVtcHRlZF9hdBgJIAEoCUgCiAEBEhoKDW5leHRfcmV0cnlfYXQYCiABKAlIA4gBARIRCglzdWNjZWVkZWQYCyABKAgSEgoKY3JlYXRlZF9hdBgMIAEoCUIOCgxfaHR0cF9zdGF0dXNCEAoOX3Jlc3BvbnNlX2JvZHlCDwoNX2F0dGVtcHRlZF9hdEIQCg5fbmV4dF9yZXRyeV9hdCI4ChRDcmVhdGVXZWJob29rUmVxdWVzdBILCgN1cmwYASABKAkSEwoLZXZlbnRfdHlwZXMYAiADKAkiTAoVQ3JlYXRlV2ViaG9va1Jlc3BvbnNlEiMKB3dlYmhvb2sYASABKAsyEi5ldmVudHMudjEuV2ViaG9vaxIOCgZzZWNyZXQYAiABKAkiMgoTTGlzdFdlYmhvb2tzUmVxdWVzdBIMCgRwYWdlGAEgASgFEg0KBWxpbWl0GAIgASgFIksKFExpc3RXZWJob29rc1Jlc3BvbnNlEiQKCHdlYmhvb2tzGAEgAygLMhIuZXZlbnRzLnYxLldlYmhvb2sSDQoFdG90YWwYAiABKAUiIgoURGVsZXRlV2ViaG9va1JlcXVlc3QSCgoCaWQYASABKAUiKAoVRGVsZXRlV2ViaG9va1Jlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiTgobR2V0V2ViaG9va0RlbGl2ZXJpZXNSZXF1ZXN0EhIKCndlYmhvb2tfaWQYASABKAUSDAoEcGFnZRgCIAEoBRINCgVsaW1pdBgDIAEoBSJdChxHZXRXZWJob29rRGVsaXZlcmllc1Jlc3BvbnNlEi4KCmRlbGl2ZXJpZXMYASADKAsyGi5ldmVudHMudjEuV2ViaG9va0RlbGl2ZXJ5Eg0KBXRvdGFsGAIgASgFIjIKG1JldHJ5V2ViaG9va0RlbGl2ZXJ5UmVxdWVzdBITCgtkZWxpdmVyeV9pZBgBIAEoBSJMChxSZXRyeVdlYmhvb2tEZWxpdmVyeVJlc3BvbnNlEiwKCGRlbGl2ZXJ5GAEgASgLMhouZXZlbnRzLnYxLldlYmhvb2tEZWxpdmVyeSKuAQoNQXVkaXRMb2dFbnRyeRIKCgJpZBgBIAEoAxIcCg9hY3Rvcl9rcmF0b3NfaWQYAiABKAlIAIgBARIOCgZhY3Rpb24YAyABKAkSFQoNcmVzb3VyY2VfdHlwZRgEIAEoCRITCgtyZXNvdXJjZV9pZBgFIAEoCRIPCgdwYXlsb2FkGAYgASgJEhIKCmNyZWF0ZWRfYXQYByABKAlCEgoQX2FjdG9yX2tyYXRvc19pZCJfChRMaXN0QXVkaXRMb2dzUmVxdWVzdBIVCg1yZXNvdXJjZV90eXBlGAEgASgJEhMKC3Jlc291cmNlX2lkGAIgASgJEgwKBHBhZ2UYAyABKAUSDQoFbGltaXQYBCABKAUiUQoVTGlzdEF1ZGl0TG9nc1Jlc3BvbnNlEikKB2VudHJpZXMYASADKAsyGC5ldmVudHMudjEuQXVkaXRMb2dFbnRyeRINCgV0b3RhbBgCIAEoBSp3CgtFdmVudEZvcm1hdBIcChhFVkVOVF9GT1JNQVRfVU5TUEVDSUZJRUQQABIXChNFVkVOVF9GT1JNQVRfT05MSU5FEAESGAoURVZFTlRfRk9STUFUX09GRkxJTkUQAhIXChNFVkVOVF9GT1JNQVRfSFlCUklEEAMqaQoIQ2x1YlJvbGUSGQoVQ0xVQl9ST0xFX1VOU1BFQ0lGSUVEEAASFwoTQ0xVQl9ST0xFX1BSRVNJREVOVBABEhMKD0NMVUJfUk9MRV9TVEFGRhACEhQKEENMVUJfUk9MRV9NRU1CRVIQAyqbAQoST3JnYW5pemF0aW9uU3RhdHVzEiMKH09SR0FOSVpBVElPTl9TVEFUVVNfVU5TUEVDSUZJRUQQABIeChpPUkdBTklaQVRJT05fU1RBVFVTX0FDVElWRRABEiAKHE9SR0FOSVpBVElPTl9TVEFUVVNfQVJDSElWRUQQAhIeChpPUkdBTklaQVRJT05fU1RBVFVTX0ZST1pFThADKp4BCg1JbnF1aXJ5U3RhdHVzEh4KGklOUVVJUllfU1RBVFVTX1VOU1BFQ0lGSUVEEAASFwoTSU5RVUlSWV9TVEFUVVNfT1BFThABEh4KGklOUVVJUllfU1RBVFVTX0lOX1BST0dSRVNTEAISGwoXSU5RVUlSWV9TVEFUVVNfUkVTT0xWRUQQAxIXChNJTlFVSVJZX1NUQVRVU19TUEFNEAQqygEKElJlZ2lzdHJhdGlvblN0YXR1cxIjCh9SRUdJU1RSQVRJT05fU1RBVFVTX1VOU1BFQ0lGSUVEEAASIgoeUkVHSVNUUkFUSU9OX1NUQVRVU19SRUdJU1RFUkVEEAESIQodUkVHSVNUUkFUSU9OX1NUQVRVU19DQU5DRUxMRUQQAhIgChxSRUdJU1RSQVRJT05fU1RBVFVTX1dBSVRMSVNUEAMSJgoiUkVHSVNUUkFUSU9OX1NUQVRVU19OT1RfUkVHSVNURVJFRBAEKpYBChBBdHRlbmRhbmNlU3RhdHVzEiEKHUFUVEVOREFOQ0VfU1RBVFVTX1VOU1BFQ0lGSUVEEAASHgoaQVRURU5EQU5DRV9TVEFUVVNfQVRURU5ERUQQARIdChlBVFRFTkRBTkNFX1NUQVRVU19OT19TSE9XEAISIAocQVRURU5EQU5DRV9TVEFUVVNfQ0hFQ0tFRF9JThADKtIBChVXZWJob29rRGVsaXZlcnlTdGF0dXMSJwojV0VCSE9PS19ERUxJVkVSWV9TVEFUVVNfVU5TUEVDSUZJRUQQABIjCh9XRUJIT09LX0RFTElWRVJZX1NUQVRVU19QRU5ESU5HEAESJQohV0VCSE9PS19ERUxJVkVSWV9TVEFUVVNfU1VDQ0VFREVEEAISIgoeV0VCSE9PS19ERUxJVkVSWV9TVEFUVVNfRkFJTEVEEAMSIAocV0VCSE9PS19ERUxJVkVSWV9TVEFUVVNfREVBRBAEKkoKCVRhZ1NvcnRCeRIbChdUQUdfU09SVF9CWV9VTlNQRUNJRklFRBAAEiAKHFRBR19TT1JUX0JZX0VWRU5UX0NPVU5UX0RFU0MQASpWChBNYW5hZ2VkRXZlbnRSb2xlEiIKHk1BTkFHRURfRVZFTlRfUk9MRV9VTlNQRUNJRklFRBAAEh4KGk1BTkFHRURfRVZFTlRfUk9MRV9DUkVBVE9SEAEq3wEKFUNsdWJMZWFkZXJib2FyZFNvcnRCeRIoCiRDTFVCX0xFQURFUkJPQVJEX1NPUlRfQllfVU5TUEVDSUZJRUQQABIuCipDTFVCX0xFQURFUkJPQVJEX1NPUlRfQllfVE9UQUxfRVZFTlRTX0RFU0MQARI1CjFDTFVCX0xFQURFUkJPQVJEX1NPUlRfQllfVE9UQUxfUkVHSVNUUkFUSU9OU19ERVNDEAISNQoxQ0xVQl9MRUFERVJCT0FSRF9TT1JUX0JZX0FWR19BVFRFTkRBTkNFX1JBVEVfREVTQxADKpMBCg9Ub3BFdmVudHNTb3J0QnkSIgoeVE9QX0VWRU5UU19TT1JUX0JZX1VOU1BFQ0lGSUVEEAASLworVE9QX0VWRU5UU19TT1JUX0JZX1RPVEFMX1JFR0lTVFJBVElPTlNfREVTQxABEisKJ1RPUF9FVkVOVFNfU09SVF9CWV9BVFRFTkRBTkNFX1JBVEVfREVTQxACMrQMChRPcmdhbml6YXRpb25zU2VydmljZRJhChJDcmVhdGVPcmdhbml6YXRpb24SJC5ldmVudHMudjEuQ3JlYXRlT3JnYW5pemF0aW9uUmVxdWVzdBolLmV2ZW50cy52MS5DcmVhdGVPcmdhbml6YXRpb25SZXNwb25zZRJYCg9HZXRPcmdhbml6YXRpb24SIS5ldmVudHMudjEuR2V0T3JnYW5pemF0aW9uUmVxdWVzdBoiLmV2ZW50cy52MS5HZXRPcmdhbml6YXRpb25SZXNwb25zZRJeChFMaXN0T3JnYW5pemF0aW9ucxIjLmV2ZW50cy52MS5MaXN0T3JnYW5pemF0aW9uc1JlcXVlc3QaJC5ldmVudHMudjEuTGlzdE9yZ2FuaXphdGlvbnNSZXNwb25zZRJhChJVcGRhdGVPcmdhbml6YXRpb24SJC5ldmVudHMudjEuVXBkYXRlT3JnYW5pemF0aW9uUmVxdWVzdBolLmV2ZW50cy52MS5VcGRhdGVPcmdhbml6YXRpb25SZXNwb25zZRJhChJEZWxldGVPcmdhbml6YXRpb24SJC5ldmVudHMudjEuRGVsZXRlT3JnYW5pemF0aW9uUmVxdWVzdBolLmV2ZW50cy52MS5EZWxldGVPcmdhbml6YXRpb25SZXNwb25zZRJhChJNZXJnZU9yZ2FuaXphdGlvbnMSJC5ldmVudHMudjEuTWVyZ2VPcmdhbml6YXRpb25zUmVxdWVzdBolLmV2ZW50cy52MS5NZXJnZU9yZ2FuaXphdGlvbnNSZXNwb25zZRJ8ChtHZXRQdWJsaXNoYWJsZU9yZ2FuaXphdGlvbnMSLS5ldmVudHMudjEuR2V0UHVibGlzaGFibGVPcmdhbml6YXRpb25zUmVxdWVzdBouLmV2ZW50cy52MS5HZXRQdWJsaXNoYWJsZU9yZ2FuaXphdGlvbnNSZXNwb25zZRJnChRHZXRVc2VyT3JnYW5pemF0aW9ucxImLmV2ZW50cy52MS5HZXRVc2VyT3JnYW5pemF0aW9uc1JlcXVlc3QaJy5ldmVudHMudjEuR2V0VXNlck9yZ2FuaXphdGlvbnNSZXNwb25zZRJ2ChlHZXRPcmdhbml6YXRpb25RdW90YVVzYWdlEisuZXZlbnRzLnYxLkdldE9yZ2FuaXphdGlvblF1b3RhVXNhZ2VSZXF1ZXN0GiwuZXZlbnRzLnYxLkdldE9yZ2FuaXphdGlvblF1b3RhVXNhZ2VSZXNwb25zZRJtChZHZXRPcmdhbml6YXRpb25NZW1iZXJzEiguZXZlbnRzLnYxLkdldE9yZ2FuaXphdGlvbk1lbWJlcnNSZXF1ZXN0GikuZXZlbnRzLnYxLkdldE9yZ2FuaXphdGlvbk1lbWJlcnNSZXNwb25zZRJVCg5Bc3NpZ25DbHViUm9sZRIgLmV2ZW50cy52MS5Bc3NpZ25DbHViUm9sZVJlcXVlc3QaIS5ldmVudHMudjEuQXNzaWduQ2x1YlJvbGVSZXNwb25zZRJbChBSZW1vdmVDbHViTWVtYmVyEiIuZXZlbnRzLnYxLlJlbW92ZUNsdWJNZW1iZXJSZXF1ZXN0GiMuZXZlbnRzLnYxLlJlbW92ZUNsdWJNZW1iZXJSZXNwb25zZRJ2ChlTdWJtaXRPcmdhbml6YXRpb25JbnF1aXJ5EisuZXZlbnRzLnYxLlN1Ym1pdE9yZ2FuaXphdGlvbklucXVpcnlSZXF1ZXN0GiwuZXZlbnRzLnYxLlN1Ym1pdE9yZ2FuaXphdGlvbklucXVpcnlSZXNwb25zZRJ2ChlMaXN0T3JnYW5pemF0aW9uSW5xdWlyaWVzEisuZXZlbnRzLnYxLkxpc3RPcmdhbml6YXRpb25JbnF1aXJpZXNSZXF1ZXN0GiwuZXZlbnRzLnYxLkxpc3RPcmdhbml6YXRpb25JbnF1aXJpZXNSZXNwb25zZRJkChNVcGRhdGVJbnF1aXJ5U3RhdHVzEiUuZXZlbnRzLnYxLlVwZGF0ZUlucXVpcnlTdGF0dXNSZXF1ZXN0GiYuZXZlbnRzLnYxLlVwZGF0ZUlucXVpcnlTdGF0dXNSZXNwb25zZTK5BAoYT3JnYW5pemF0aW9uVHlwZXNTZXJ2aWNlEm0KFkNyZWF0ZU9yZ2FuaXphdGlvblR5cGUSKC5ldmVudHMudjEuQ3JlYXRlT3JnYW5pemF0aW9uVHlwZVJlcXVlc3QaKS5ldmVudHMudjEuQ3JlYXRlT3JnYW5pemF0aW9uVHlwZVJlc3BvbnNlEmQKE0dldE9yZ2FuaXphdGlvblR5cGUSJS5ldmVudHMudjEuR2V0T3JnYW5pemF0aW9uVHlwZVJlcXVlc3QaJi5ldmVudHMudjEuR2V0T3JnYW5pemF0aW9uVHlwZVJlc3BvbnNlEmoKFUxpc3RPcmdhbml6YXRpb25UeXBlcxInLmV2ZW50cy52MS5MaXN0T3JnYW5pemF0aW9uVHlwZXNSZXF1ZXN0GiguZXZlbnRzLnYxLkxpc3RPcmdhbml6YXRpb25UeXBlc1Jlc3BvbnNlEm0KFlVwZGF0ZU9yZ2FuaXphdGlvblR5cGUSKC5ldmVudHMudjEuVXBkYXRlT3JnYW5pemF0aW9uVHlwZVJlcXVlc3QaKS5ldmVudHMudjEuVXBkYXRlT3JnYW5pemF0aW9uVHlwZVJlc3BvbnNlEm0KFkRlbGV0ZU9yZ2FuaXphdGlvblR5cGUSKC5ldmVudHMudjEuRGVsZXRlT3JnYW5pemF0aW9uVHlwZVJlcXVlc3QaKS5ldmVudHMudjEuRGVsZXRlT3JnYW5pemF0aW9uVHlwZVJlc3BvbnNlMukMCg1FdmVudHNTZXJ2aWNlEkwKC0NyZWF0ZUV2ZW50Eh0uZXZlbnRzLnYxLkNyZWF0ZUV2ZW50UmVxdWVzdBoeLmV2ZW50cy52MS5DcmVhdGVFdmVudFJlc3BvbnNlElsKEEJ1bGtDcmVhdGVFdmVudHMSIi5ldmVudHMudjEuQnVsa0NyZWF0ZUV2ZW50c1JlcXVlc3QaIy5ldmVudHMudjEuQnVsa0NyZWF0ZUV2ZW50c1Jlc3BvbnNlElUKDkR1cGxpY2F0ZUV2ZW50EiAuZXZlbnRzLnYxLkR1cGxpY2F0ZUV2ZW50UmVxdWVzdBohLmV2ZW50cy52MS5EdXBsaWNhdGVFdmVudFJlc3BvbnNlEkMKCEdldEV2ZW50EhouZXZlbnRzLnYxLkdldEV2ZW50UmVxdWVzdBobLmV2ZW50cy52MS5HZXRFdmVudFJlc3BvbnNlEkkKCkxpc3RFdmVudHMSHC5ldmVudHMudjEuTGlzdEV2ZW50c1JlcXVlc3QaHS5ldmVudHMudjEuTGlzdEV2ZW50c1Jlc3BvbnNlEmEKEkxpc3RFdmVudHNGb3JBZG1pbhIkLmV2ZW50cy52MS5MaXN0RXZlbnRzRm9yQWRtaW5SZXF1ZXN0GiUuZXZlbnRzLnYxLkxpc3RFdmVudHNGb3JBZG1pblJlc3BvbnNlEkwKC1VwZGF0ZUV2ZW50Eh0uZXZlbnRzLnYxLlVwZGF0ZUV2ZW50UmVxdWVzdBoeLmV2ZW50cy52MS5VcGRhdGVFdmVudFJlc3BvbnNlEkwKC0RlbGV0ZUV2ZW50Eh0uZXZlbnRzLnYxLkRlbGV0ZUV2ZW50UmVxdWVzdBoeLmV2ZW50cy52MS5EZWxldGVFdmVudFJlc3BvbnNlEk8KDFJlc3RvcmVFdmVudBIeLmV2ZW50cy52MS5SZXN0b3JlRXZlbnRSZXF1ZXN0Gh8uZXZlbnRzLnYxLlJlc3RvcmVFdmVudFJlc3BvbnNlEl4KEUxpc3REZWxldGVkRXZlbnRzEiMuZXZlbnRzLnYxLkxpc3REZWxldGVkRXZlbnRzUmVxdWVzdBokLmV2ZW50cy52MS5MaXN0RGVsZXRlZEV2ZW50c1Jlc3BvbnNlEmoKFVRvZ2dsZUV2ZW50VmlzaWJpbGl0eRInLmV2ZW50cy52MS5Ub2dnbGVFdmVudFZpc2liaWxpdHlSZXF1ZXN0GiguZXZlbnRzLnYxLlRvZ2dsZUV2ZW50VmlzaWJpbGl0eVJlc3BvbnNlElsKEEdldEV2ZW50c0J5VGFnSWQSIi5ldmVudHMudjEuR2V0RXZlbnRzQnlUYWdJZFJlcXVlc3QaIy5ldmVudHMudjEuR2V0RXZlbnRzQnlUYWdJZFJlc3BvbnNlEmEKEkdldEV2ZW50c0J5QWxsVGFncxIkLmV2ZW50cy52MS5HZXRFdmVudHNCeUFsbFRhZ3NSZXF1ZXN0GiUuZXZlbnRzLnYxLkdldEV2ZW50c0J5QWxsVGFnc1Jlc3BvbnNlEnAKF0dldFVzZXJTdWJzY3JpYmVkRXZlbnRzEikuZXZlbnRzLnYxLkdldFVzZXJTdWJzY3JpYmVkRXZlbnRzUmVxdWVzdBoqLmV2ZW50cy52MS5HZXRVc2VyU3Vic2NyaWJlZEV2ZW50c1Jlc3BvbnNlElsKEEdldE1hbmFnZWRFdmVudHMSIi5ldmVudHMudjEuR2V0TWFuYWdlZEV2ZW50c1JlcXVlc3QaIy5ldmVudHMudjEuR2V0TWFuYWdlZEV2ZW50c1Jlc3BvbnNlEk8KDEdldEV2ZW50RmVlZBIeLmV2ZW50cy52MS5HZXRFdmVudEZlZWRSZXF1ZXN0Gh8uZXZlbnRzLnYxLkdldEV2ZW50RmVlZFJlc3BvbnNlElsKEEdldEV2ZW50Q2FsZW5kYXISIi5ldmVudHMudjEuR2V0RXZlbnRDYWxlbmRhclJlcXVlc3QaIy5ldmVudHMudjEuR2V0RXZlbnRDYWxlbmRhclJlc3BvbnNlEm0KFkdldEV2ZW50SW1hZ2VVcGxvYWRVcmwSKC5ldmVudHMudjEuR2V0RXZlbnRJbWFnZVVwbG9hZFVybFJlcXVlc3QaKS5ldmVudHMudjEuR2V0RXZlbnRJbWFnZVVwbG9hZFVybFJlc3BvbnNlMrEDCgtUYWdzU2VydmljZRJGCglDcmVhdGVUYWcSGy5ldmVudHMudjEuQ3JlYXRlVGFnUmVxdWVzdBocLmV2ZW50cy52MS5DcmVhdGVUYWdSZXNwb25zZRI9CgZHZXRUYWcSGC5ldmVudHMudjEuR2V0VGFnUmVxdWVzdBoZLmV2ZW50cy52MS5HZXRUYWdSZXNwb25zZRJDCghMaXN0VGFncxIaLmV2ZW50cy52MS5MaXN0VGFnc1JlcXVlc3QaGy5ldmVudHMudjEuTGlzdFRhZ3NSZXNwb25zZRJGCglVcGRhdGVUYWcSGy5ldmVudHMudjEuVXBkYXRlVGFnUmVxdWVzdBocLmV2ZW50cy52MS5VcGRhdGVUYWdSZXNwb25zZRJGCglEZWxldGVUYWcSGy5ldmVudHMudjEuRGVsZXRlVGFnUmVxdWVzdBocLmV2ZW50cy52MS5EZWxldGVUYWdSZXNwb25zZRJGCglNZXJnZVRhZ3MSGy5ldmVudHMudjEuTWVyZ2VUYWdzUmVxdWVzdBocLmV2ZW50cy52MS5NZXJnZVRhZ3NSZXNwb25zZTLdBgoZRXZlbnRSZWdpc3RyYXRpb25zU2VydmljZRJbChBSZWdpc3RlckZvckV2ZW50EiIuZXZlbnRzLnYxLlJlZ2lzdGVyRm9yRXZlbnRSZXF1ZXN0GiMuZXZlbnRzLnYxLlJlZ2lzdGVyRm9yRXZlbnRSZXNwb25zZRJhChJDYW5jZWxSZWdpc3RyYXRpb24SJC5ldmVudHMudjEuQ2FuY2VsUmVnaXN0cmF0aW9uUmVxdWVzdBolLmV2ZW50cy52MS5DYW5jZWxSZWdpc3RyYXRpb25SZXNwb25zZRJqChVHZXRFdmVudFJlZ2lzdHJhdGlvbnMSJy5ldmVudHMudjEuR2V0RXZlbnRSZWdpc3RyYXRpb25zUmVxdWVzdBooLmV2ZW50cy52MS5HZXRFdmVudFJlZ2lzdHJhdGlvbnNSZXNwb25zZRJnChRHZXRVc2VyUmVnaXN0cmF0aW9ucxImLmV2ZW50cy52MS5HZXRVc2VyUmVnaXN0cmF0aW9uc1JlcXVlc3QaJy5ldmVudHMudjEuR2V0VXNlclJlZ2lzdHJhdGlvbnNSZXNwb25zZRJqChVIb2xkRXZlbnRSZWdpc3RyYXRpb24SJy5ldmVudHMudjEuSG9sZEV2ZW50UmVnaXN0cmF0aW9uUmVxdWVzdBooLmV2ZW50cy52MS5Ib2xkRXZlbnRSZWdpc3RyYXRpb25SZXNwb25zZRJkChNDb25maXJtUmVnaXN0cmF0aW9uEiUuZXZlbnRzLnYxLkNvbmZpcm1SZWdpc3RyYXRpb25SZXF1ZXN0GiYuZXZlbnRzLnYxLkNvbmZpcm1SZWdpc3RyYXRpb25SZXNwb25zZRJeChFOb3RpZnlSZWdpc3RyYW50cxIjLmV2ZW50cy52MS5Ob3RpZnlSZWdpc3RyYW50c1JlcXVlc3QaJC5ldmVudHMudjEuTm90aWZ5UmVnaXN0cmFudHNSZXNwb25zZRJ5ChpHZXRFdmVudFJlZ2lzdHJhdGlvblN0YXR1cxIsLmV2ZW50cy52MS5HZXRFdmVudFJlZ2lzdHJhdGlvblN0YXR1c1JlcXVlc3QaLS5ldmVudHMudjEuR2V0RXZlbnRSZWdpc3RyYXRpb25TdGF0dXNSZXNwb25zZTLABQoWRXZlbnRBdHRlbmRhbmNlU2VydmljZRJYCg9DaGVja0luQXR0ZW5kZWUSIS5ldmVudHMudjEuQ2hlY2tJbkF0dGVuZGVlUmVxdWVzdBoiLmV2ZW50cy52MS5DaGVja0luQXR0ZW5kZWVSZXNwb25zZRJMCgtCdWxrQ2hlY2tJbhIdLmV2ZW50cy52MS5CdWxrQ2hlY2tJblJlcXVlc3QaHi5ldmVudHMudjEuQnVsa0NoZWNrSW5SZXNwb25zZRJVCg5NYXJrQXR0ZW5kYW5jZRIgLmV2ZW50cy52MS5NYXJrQXR0ZW5kYW5jZVJlcXVlc3QaIS5ldmVudHMudjEuTWFya0F0dGVuZGFuY2VSZXNwb25zZRJhChJHZXRFdmVudEF0dGVuZGFuY2USJC5ldmVudHMudjEuR2V0RXZlbnRBdHRlbmRhbmNlUmVxdWVzdBolLmV2ZW50cy52MS5HZXRFdmVudEF0dGVuZGFuY2VSZXNwb25zZRJsChVTdHJlYW1FdmVudEF0dGVuZGFuY2USJy5ldmVudHMudjEuU3RyZWFtRXZlbnRBdHRlbmRhbmNlUmVxdWVzdBooLmV2ZW50cy52MS5TdHJlYW1FdmVudEF0dGVuZGFuY2VSZXNwb25zZTABEmQKFUV4cG9ydEV2ZW50QXR0ZW5kYW5jZRInLmV2ZW50cy52MS5FeHBvcnRFdmVudEF0dGVuZGFuY2VSZXF1ZXN0GiAuZXZlbnRzLnYxLkF0dGVuZGFuY2VFeHBvcnRDaHVuazABEnAKGEdldFVzZXJBdHRlbmRhbmNlSGlzdG9yeRIqLmV2ZW50cy52MS5HZXRVc2VyQXR0ZW5kYW5jZUhpc3RvcnlSZXF1ZXN0GiguZXZlbnRzLnYxLlVzZXJBdHRlbmRhbmNlSGlzdG9yeVJlc3BvbnNlMpoMChFTdGF0aXN0aWNzU2VydmljZRJtChZHZXREYXNoYm9hcmRTdGF0aXN0aWNzEiguZXZlbnRzLnYxLkdldERhc2hib2FyZFN0YXRpc3RpY3NSZXF1ZXN0GikuZXZlbnRzLnYxLkdldERhc2hib2FyZFN0YXRpc3RpY3NSZXNwb25zZRJhChJHZXRFdmVudFN0YXRpc3RpY3MSJC5ldmVudHMudjEuR2V0RXZlbnRTdGF0aXN0aWNzUmVxdWVzdBolLmV2ZW50cy52MS5HZXRFdmVudFN0YXRpc3RpY3NSZXNwb25zZRKIAQofR2V0RXZlbnRUYWdzRGlzdHJpYnV0aW9uQnlNb250aBIxLmV2ZW50cy52MS5HZXRFdmVudFRhZ3NEaXN0cmlidXRpb25CeU1vbnRoUmVxdWVzdBoyLmV2ZW50cy52MS5HZXRFdmVudFRhZ3NEaXN0cmlidXRpb25CeU1vbnRoUmVzcG9uc2USbQoWR2V0RXZlbnRBY3Rpdml0eUJ5WWVhchIoLmV2ZW50cy52MS5HZXRFdmVudEFjdGl2aXR5QnlZZWFyUmVxdWVzdBopLmV2ZW50cy52MS5HZXRFdmVudEFjdGl2aXR5QnlZZWFyUmVzcG9uc2USZwoUR2V0T3ZlcmFsbFN0YXRpc3RpY3MSJi5ldmVudHMudjEuR2V0T3ZlcmFsbFN0YXRpc3RpY3NSZXF1ZXN0GicuZXZlbnRzLnYxLkdldE92ZXJhbGxTdGF0aXN0aWNzUmVzcG9uc2USVQoOR2V0RXZlbnRUcmVuZHMSIC5ldmVudHMudjEuR2V0RXZlbnRUcmVuZHNSZXF1ZXN0GiEuZXZlbnRzLnYxLkdldEV2ZW50VHJlbmRzUmVzcG9uc2USagoVR2V0RXZlbnRDb3VudEJ5Rm9ybWF0EicuZXZlbnRzLnYxLkdldEV2ZW50Q291bnRCeUZvcm1hdFJlcXVlc3QaKC5ldmVudHMudjEuR2V0RXZlbnRDb3VudEJ5Rm9ybWF0UmVzcG9uc2USagoVR2V0VG9wUGVyZm9ybWluZ0NsdWJzEicuZXZlbnRzLnYxLkdldFRvcFBlcmZvcm1pbmdDbHVic1JlcXVlc3QaKC5ldmVudHMudjEuR2V0VG9wUGVyZm9ybWluZ0NsdWJzUmVzcG9uc2USXgoSR2V0Q2x1YkxlYWRlcmJvYXJkEiQuZXZlbnRzLnYxLkdldENsdWJMZWFkZXJib2FyZFJlcXVlc3QaIi5ldmVudHMudjEuQ2x1YkxlYWRlcmJvYXJkUmVzcG9uc2UScAoXR2V0VXNlckVuZ2FnZW1lbnRMZXZlbHMSKS5ldmVudHMudjEuR2V0VXNlckVuZ2FnZW1lbnRMZXZlbHNSZXF1ZXN0GiouZXZlbnRzLnYxLkdldFVzZXJFbmdhZ2VtZW50TGV2ZWxzUmVzcG9uc2USbQoWR2V0VG9wUGVyZm9ybWluZ0V2ZW50cxIoLmV2ZW50cy52MS5HZXRUb3BQZXJmb3JtaW5nRXZlbnRzUmVxdWVzdBopLmV2ZW50cy52MS5HZXRUb3BQZXJmb3JtaW5nRXZlbnRzUmVzcG9uc2UScwoYR2V0TG93UmVnaXN0cmF0aW9uRXZlbnRzEiouZXZlbnRzLnYxLkdldExvd1JlZ2lzdHJhdGlvbkV2ZW50c1JlcXVlc3QaKy5ldmVudHMudjEuR2V0TG93UmVnaXN0cmF0aW9uRXZlbnRzUmVzcG9uc2UScAoXR2V0T3JnYW5pemF0aW9uQWN0aXZpdHkSKS5ldmVudHMudjEuR2V0T3JnYW5pemF0aW9uQWN0aXZpdHlSZXF1ZXN0GiouZXZlbnRzLnYxLkdldE9yZ2FuaXphdGlvbkFjdGl2aXR5UmVzcG9uc2USeQocR2V0U3RhdGlzdGljc0Zvck9yZ2FuaXphdGlvbhIuLmV2ZW50cy52MS5HZXRTdGF0aXN0aWNzRm9yT3JnYW5pemF0aW9uUmVxdWVzdBopLmV2ZW50cy52MS5Pcmdhbml6YXRpb25TdGF0aXN0aWNzUmVzcG9uc2Uy3AMKD1dlYmhvb2tzU2VydmljZRJSCg1DcmVhdGVXZWJob29rEh8uZXZlbnRzLnYxLkNyZWF0ZVdlYmhvb2tSZXF1ZXN0GiAuZXZlbnRzLnYxLkNyZWF0ZVdlYmhvb2tSZXNwb25zZRJPCgxMaXN0V2ViaG9va3MSHi5ldmVudHMudjEuTGlzdFdlYmhvb2tzUmVxdWVzdBofLmV2ZW50cy52MS5MaXN0V2ViaG9va3NSZXNwb25zZRJSCg1EZWxldGVXZWJob29rEh8uZXZlbnRzLnYxLkRlbGV0ZVdlYmhvb2tSZXF1ZXN0GiAuZXZlbnRzLnYxLkRlbGV0ZVdlYmhvb2tSZXNwb25zZRJnChRHZXRXZWJob29rRGVsaXZlcmllcxImLmV2ZW50cy52MS5HZXRXZWJob29rRGVsaXZlcmllc1JlcXVlc3QaJy5ldmVudHMudjEuR2V0V2ViaG9va0RlbGl2ZXJpZXNSZXNwb25zZRJnChRSZXRyeVdlYmhvb2tEZWxpdmVyeRImLmV2ZW50cy52MS5SZXRyeVdlYmhvb2tEZWxpdmVyeVJlcXVlc3QaJy5ldmVudHMudjEuUmV0cnlXZWJob29rRGVsaXZlcnlSZXNwb25zZTJiCgxBdWRpdFNlcnZpY2USUgoNTGlzdEF1ZGl0TG9ncxIfLmV2ZW50cy52MS5MaXN0QXVkaXRMb2dzUmVxdWVzdBogLmV2ZW50cy52MS5MaXN0QXVkaXRMb2dzUmVzcG9uc2VCmgEKDWNvbS5ldmVudHMudjFCC0V2ZW50c1Byb3RvUAFaN2dpdGh1Yi5jb20vc3R1ZHl2ZXJzZS9lbXMtYmFja2VuZC9nZW4vZXZlbnRzdjE7ZXZlbnRzdjGiAgNFWFiqAglFdmVudHMuVjHKAglFdmVudHNcVjHiAhVFdmVudHNcVjFcR1BCTWV0YWRhdGHqAgpFdmVudHM6OlYxYgZwcm90bzM");
 
 /**
  * Messages
@@ -37,6 +37,18 @@ export type OrganizationType = Message<"events.v1.OrganizationType"> & {
    * @generated from field: string updated_at = 4;
    */
   updatedAt: string;
+
+  /**
+   * Set by GetOrganizationType and ListOrganizationTypes
+   *
+   * @generated from field: int32 organization_count = 5;
+   */
+  organizationCount: number;
+
+  /**
+   * @generated from field: int32 total_events = 6;
+   */
+  totalEvents: number;
 };
 
 /**
@@ -124,6 +136,13 @@ export type Organization = Message<"events.v1.Organization"> & {
    * @generated from field: string updated_at = 15;
    */
   updatedAt: string;
+
+  /**
+   * Max events per calendar month, unset = unlimited
+   *
+   * @generated from field: optional int32 monthly_event_quota = 16;
+   */
+  monthlyEventQuota?: number;
 };
 
 /**
@@ -156,6 +175,20 @@ export type Tag = Message<"events.v1.Tag"> & {
    * @generated from field: string updated_at = 4;
    */
   updatedAt: string;
+
+  /**
+   * Distinct organizations with events using this tag
+   *
+   * @generated from field: int32 organization_count = 5;
+   */
+  organizationCount: number;
+
+  /**
+   * Events using this tag
+   *
+   * @generated from field: int32 event_count = 6;
+   */
+  eventCount: number;
 };
 
 /**
@@ -253,6 +286,41 @@ export type Event = Message<"events.v1.Event"> & {
    * @generated from field: repeated events.v1.Tag tags = 17;
    */
   tags: Tag[];
+
+  /**
+   * Max registrations, unset = unlimited
+   *
+   * @generated from field: optional int32 capacity = 18;
+   */
+  capacity?: number;
+
+  /**
+   * Set by GetEvent and event listings
+   *
+   * @generated from field: string creator_username = 19;
+   */
+  creatorUsername: string;
+
+  /**
+   * Unpublished events are hidden from listings and search
+   *
+   * @generated from field: bool published = 20;
+   */
+  published: boolean;
+
+  /**
+   * Only set on soft-deleted events
+   *
+   * @generated from field: optional string deleted_at = 21;
+   */
+  deletedAt?: string;
+
+  /**
+   * Pass back as UpdateEventRequest.expected_version
+   *
+   * @generated from field: int32 version = 22;
+   */
+  version: number;
 };
 
 /**
@@ -305,6 +373,13 @@ export type EventRegistration = Message<"events.v1.EventRegistration"> & {
    * @generated from field: string updated_at = 8;
    */
   updatedAt: string;
+
+  /**
+   * Populated when requested with include_event
+   *
+   * @generated from field: optional events.v1.Event event = 9;
+   */
+  event?: Event;
 };
 
 /**
@@ -588,6 +663,16 @@ export type ListOrganizationsRequest = Message<"events.v1.ListOrganizationsReque
    * @generated from field: int32 limit = 2;
    */
   limit: number;
+
+  /**
+   * @generated from field: optional events.v1.OrganizationStatus status_filter = 3;
+   */
+  statusFilter?: OrganizationStatus;
+
+  /**
+   * @generated from field: optional int32 organization_type_id = 4;
+   */
+  organizationTypeId?: number;
 };
 
 /**
@@ -687,6 +772,27 @@ export type UpdateOrganizationRequest = Message<"events.v1.UpdateOrganizationReq
    * @generated from field: optional events.v1.OrganizationStatus status = 13;
    */
   status?: OrganizationStatus;
+
+  /**
+   * Platform admins only
+   *
+   * @generated from field: optional int32 monthly_event_quota = 14;
+   */
+  monthlyEventQuota?: number;
+
+  /**
+   * Inquiries are forwarded here
+   *
+   * @generated from field: optional string contact_email = 15;
+   */
+  contactEmail?: string;
+
+  /**
+   * Removes the quota, platform admins only
+   *
+   * @generated from field: bool clear_monthly_event_quota = 16;
+   */
+  clearMonthlyEventQuota: boolean;
 };
 
 /**
@@ -714,139 +820,124 @@ export const UpdateOrganizationResponseSchema: GenMessage<UpdateOrganizationResp
   messageDesc(file_eventsv1_events, 15);
 
 /**
- * @generated from message events.v1.DeleteOrganizationRequest
+ * @generated from message events.v1.GetOrganizationQuotaUsageRequest
  */
-export type DeleteOrganizationRequest = Message<"events.v1.DeleteOrganizationRequest"> & {
+export type GetOrganizationQuotaUsageRequest = Message<"events.v1.GetOrganizationQuotaUsageRequest"> & {
   /**
-   * @generated from field: int32 id = 1;
+   * @generated from field: int32 organization_id = 1;
    */
-  id: number;
+  organizationId: number;
 };
 
 /**
- * Describes the message events.v1.DeleteOrganizationRequest.
- * Use `create(DeleteOrganizationRequestSchema)` to create a new message.
+ * Describes the message events.v1.GetOrganizationQuotaUsageRequest.
+ * Use `create(GetOrganizationQuotaUsageRequestSchema)` to create a new message.
  */
-export const DeleteOrganizationRequestSchema: GenMessage<DeleteOrganizationRequest> = /*@__PURE__*/
+export const GetOrganizationQuotaUsageRequestSchema: GenMessage<GetOrganizationQuotaUsageRequest> = /*@__PURE__*/
   messageDesc(file_eventsv1_events, 16);
 
 /**
- * @generated from message events.v1.DeleteOrganizationResponse
+ * @generated from message events.v1.GetOrganizationQuotaUsageResponse
  */
-export type DeleteOrganizationResponse = Message<"events.v1.DeleteOrganizationResponse"> & {
+export type GetOrganizationQuotaUsageResponse = Message<"events.v1.GetOrganizationQuotaUsageResponse"> & {
   /**
-   * @generated from field: bool success = 1;
+   * Unset when the organization has no quota
+   *
+   * @generated from field: optional int32 quota = 1;
    */
-  success: boolean;
+  quota?: number;
+
+  /**
+   * Events created this calendar month
+   *
+   * @generated from field: int32 used = 2;
+   */
+  used: number;
+
+  /**
+   * Unset when the organization has no quota
+   *
+   * @generated from field: optional int32 remaining = 3;
+   */
+  remaining?: number;
 };
 
 /**
- * Describes the message events.v1.DeleteOrganizationResponse.
- * Use `create(DeleteOrganizationResponseSchema)` to create a new message.
+ * Describes the message events.v1.GetOrganizationQuotaUsageResponse.
+ * Use `create(GetOrganizationQuotaUsageResponseSchema)` to create a new message.
  */
-export const DeleteOrganizationResponseSchema: GenMessage<DeleteOrganizationResponse> = /*@__PURE__*/
+export const GetOrganizationQuotaUsageResponseSchema: GenMessage<GetOrganizationQuotaUsageResponse> = /*@__PURE__*/
   messageDesc(file_eventsv1_events, 17);
 
 /**
- * Organization Type CRUD
+ * A user's role in a club; users with several roles appear once per role
  *
- * @generated from message events.v1.CreateOrganizationTypeRequest
+ * @generated from message events.v1.OrganizationMember
  */
-export type CreateOrganizationTypeRequest = Message<"events.v1.CreateOrganizationTypeRequest"> & {
+export type OrganizationMember = Message<"events.v1.OrganizationMember"> & {
   /**
-   * @generated from field: string title = 1;
+   * @generated from field: int32 user_id = 1;
    */
-  title: string;
-};
-
-/**
- * Describes the message events.v1.CreateOrganizationTypeRequest.
- * Use `create(CreateOrganizationTypeRequestSchema)` to create a new message.
- */
-export const CreateOrganizationTypeRequestSchema: GenMessage<CreateOrganizationTypeRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 18);
+  userId: number;
 
-/**
- * @generated from message events.v1.CreateOrganizationTypeResponse
- */
-export type CreateOrganizationTypeResponse = Message<"events.v1.CreateOrganizationTypeResponse"> & {
   /**
-   * @generated from field: events.v1.OrganizationType organization_type = 1;
+   * @generated from field: string username = 2;
    */
-  organizationType?: OrganizationType;
-};
+  username: string;
 
-/**
- * Describes the message events.v1.CreateOrganizationTypeResponse.
- * Use `create(CreateOrganizationTypeResponseSchema)` to create a new message.
- */
-export const CreateOrganizationTypeResponseSchema: GenMessage<CreateOrganizationTypeResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 19);
+  /**
+   * @generated from field: string email = 3;
+   */
+  email: string;
 
-/**
- * @generated from message events.v1.GetOrganizationTypeRequest
- */
-export type GetOrganizationTypeRequest = Message<"events.v1.GetOrganizationTypeRequest"> & {
   /**
-   * @generated from field: int32 id = 1;
+   * @generated from field: string role = 4;
    */
-  id: number;
+  role: string;
 };
 
 /**
- * Describes the message events.v1.GetOrganizationTypeRequest.
- * Use `create(GetOrganizationTypeRequestSchema)` to create a new message.
+ * Describes the message events.v1.OrganizationMember.
+ * Use `create(OrganizationMemberSchema)` to create a new message.
  */
-export const GetOrganizationTypeRequestSchema: GenMessage<GetOrganizationTypeRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 20);
+export const OrganizationMemberSchema: GenMessage<OrganizationMember> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 18);
 
 /**
- * @generated from message events.v1.GetOrganizationTypeResponse
+ * @generated from message events.v1.GetOrganizationMembersRequest
  */
-export type GetOrganizationTypeResponse = Message<"events.v1.GetOrganizationTypeResponse"> & {
+export type GetOrganizationMembersRequest = Message<"events.v1.GetOrganizationMembersRequest"> & {
   /**
-   * @generated from field: events.v1.OrganizationType organization_type = 1;
+   * @generated from field: int32 organization_id = 1;
    */
-  organizationType?: OrganizationType;
-};
-
-/**
- * Describes the message events.v1.GetOrganizationTypeResponse.
- * Use `create(GetOrganizationTypeResponseSchema)` to create a new message.
- */
-export const GetOrganizationTypeResponseSchema: GenMessage<GetOrganizationTypeResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 21);
+  organizationId: number;
 
-/**
- * @generated from message events.v1.ListOrganizationTypesRequest
- */
-export type ListOrganizationTypesRequest = Message<"events.v1.ListOrganizationTypesRequest"> & {
   /**
-   * @generated from field: int32 page = 1;
+   * @generated from field: int32 page = 2;
    */
   page: number;
 
   /**
-   * @generated from field: int32 limit = 2;
+   * @generated from field: int32 limit = 3;
    */
   limit: number;
 };
 
 /**
- * Describes the message events.v1.ListOrganizationTypesRequest.
- * Use `create(ListOrganizationTypesRequestSchema)` to create a new message.
+ * Describes the message events.v1.GetOrganizationMembersRequest.
+ * Use `create(GetOrganizationMembersRequestSchema)` to create a new message.
  */
-export const ListOrganizationTypesRequestSchema: GenMessage<ListOrganizationTypesRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 22);
+export const GetOrganizationMembersRequestSchema: GenMessage<GetOrganizationMembersRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 19);
 
 /**
- * @generated from message events.v1.ListOrganizationTypesResponse
+ * @generated from message events.v1.GetOrganizationMembersResponse
  */
-export type ListOrganizationTypesResponse = Message<"events.v1.ListOrganizationTypesResponse"> & {
+export type GetOrganizationMembersResponse = Message<"events.v1.GetOrganizationMembersResponse"> & {
   /**
-   * @generated from field: repeated events.v1.OrganizationType organization_types = 1;
+   * @generated from field: repeated events.v1.OrganizationMember members = 1;
    */
-  organizationTypes: OrganizationType[];
+  members: OrganizationMember[];
 
   /**
    * @generated from field: int32 total = 2;
@@ -855,72 +946,84 @@ export type ListOrganizationTypesResponse = Message<"events.v1.ListOrganizationT
 };
 
 /**
- * Describes the message events.v1.ListOrganizationTypesResponse.
- * Use `create(ListOrganizationTypesResponseSchema)` to create a new message.
+ * Describes the message events.v1.GetOrganizationMembersResponse.
+ * Use `create(GetOrganizationMembersResponseSchema)` to create a new message.
  */
-export const ListOrganizationTypesResponseSchema: GenMessage<ListOrganizationTypesResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 23);
+export const GetOrganizationMembersResponseSchema: GenMessage<GetOrganizationMembersResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 20);
 
 /**
- * @generated from message events.v1.UpdateOrganizationTypeRequest
+ * Replaces the user's role in the club, in the database and SpiceDB
+ *
+ * @generated from message events.v1.AssignClubRoleRequest
  */
-export type UpdateOrganizationTypeRequest = Message<"events.v1.UpdateOrganizationTypeRequest"> & {
+export type AssignClubRoleRequest = Message<"events.v1.AssignClubRoleRequest"> & {
   /**
-   * @generated from field: int32 id = 1;
+   * @generated from field: int32 organization_id = 1;
    */
-  id: number;
+  organizationId: number;
 
   /**
-   * @generated from field: optional string title = 2;
+   * @generated from field: int32 user_id = 2;
    */
-  title?: string;
+  userId: number;
+
+  /**
+   * @generated from field: events.v1.ClubRole role = 3;
+   */
+  role: ClubRole;
 };
 
 /**
- * Describes the message events.v1.UpdateOrganizationTypeRequest.
- * Use `create(UpdateOrganizationTypeRequestSchema)` to create a new message.
+ * Describes the message events.v1.AssignClubRoleRequest.
+ * Use `create(AssignClubRoleRequestSchema)` to create a new message.
  */
-export const UpdateOrganizationTypeRequestSchema: GenMessage<UpdateOrganizationTypeRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 24);
+export const AssignClubRoleRequestSchema: GenMessage<AssignClubRoleRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 21);
 
 /**
- * @generated from message events.v1.UpdateOrganizationTypeResponse
+ * @generated from message events.v1.AssignClubRoleResponse
  */
-export type UpdateOrganizationTypeResponse = Message<"events.v1.UpdateOrganizationTypeResponse"> & {
+export type AssignClubRoleResponse = Message<"events.v1.AssignClubRoleResponse"> & {
   /**
-   * @generated from field: events.v1.OrganizationType organization_type = 1;
+   * @generated from field: events.v1.OrganizationMember member = 1;
    */
-  organizationType?: OrganizationType;
+  member?: OrganizationMember;
 };
 
 /**
- * Describes the message events.v1.UpdateOrganizationTypeResponse.
- * Use `create(UpdateOrganizationTypeResponseSchema)` to create a new message.
+ * Describes the message events.v1.AssignClubRoleResponse.
+ * Use `create(AssignClubRoleResponseSchema)` to create a new message.
  */
-export const UpdateOrganizationTypeResponseSchema: GenMessage<UpdateOrganizationTypeResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 25);
+export const AssignClubRoleResponseSchema: GenMessage<AssignClubRoleResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 22);
 
 /**
- * @generated from message events.v1.DeleteOrganizationTypeRequest
+ * @generated from message events.v1.RemoveClubMemberRequest
  */
-export type DeleteOrganizationTypeRequest = Message<"events.v1.DeleteOrganizationTypeRequest"> & {
+export type RemoveClubMemberRequest = Message<"events.v1.RemoveClubMemberRequest"> & {
   /**
-   * @generated from field: int32 id = 1;
+   * @generated from field: int32 organization_id = 1;
    */
-  id: number;
+  organizationId: number;
+
+  /**
+   * @generated from field: int32 user_id = 2;
+   */
+  userId: number;
 };
 
 /**
- * Describes the message events.v1.DeleteOrganizationTypeRequest.
- * Use `create(DeleteOrganizationTypeRequestSchema)` to create a new message.
+ * Describes the message events.v1.RemoveClubMemberRequest.
+ * Use `create(RemoveClubMemberRequestSchema)` to create a new message.
  */
-export const DeleteOrganizationTypeRequestSchema: GenMessage<DeleteOrganizationTypeRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 26);
+export const RemoveClubMemberRequestSchema: GenMessage<RemoveClubMemberRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 23);
 
 /**
- * @generated from message events.v1.DeleteOrganizationTypeResponse
+ * @generated from message events.v1.RemoveClubMemberResponse
  */
-export type DeleteOrganizationTypeResponse = Message<"events.v1.DeleteOrganizationTypeResponse"> & {
+export type RemoveClubMemberResponse = Message<"events.v1.RemoveClubMemberResponse"> & {
   /**
    * @generated from field: bool success = 1;
    */
@@ -928,172 +1031,157 @@ export type DeleteOrganizationTypeResponse = Message<"events.v1.DeleteOrganizati
 };
 
 /**
- * Describes the message events.v1.DeleteOrganizationTypeResponse.
- * Use `create(DeleteOrganizationTypeResponseSchema)` to create a new message.
+ * Describes the message events.v1.RemoveClubMemberResponse.
+ * Use `create(RemoveClubMemberResponseSchema)` to create a new message.
  */
-export const DeleteOrganizationTypeResponseSchema: GenMessage<DeleteOrganizationTypeResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 27);
+export const RemoveClubMemberResponseSchema: GenMessage<RemoveClubMemberResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 24);
 
 /**
- * Event CRUD
- *
- * @generated from message events.v1.CreateEventRequest
+ * @generated from message events.v1.OrganizationInquiry
  */
-export type CreateEventRequest = Message<"events.v1.CreateEventRequest"> & {
+export type OrganizationInquiry = Message<"events.v1.OrganizationInquiry"> & {
   /**
-   * @generated from field: string title = 1;
+   * @generated from field: int32 id = 1;
    */
-  title: string;
+  id: number;
 
   /**
-   * @generated from field: string description = 2;
+   * @generated from field: int32 organization_id = 2;
    */
-  description: string;
+  organizationId: number;
 
   /**
-   * @generated from field: optional string image_url = 3;
+   * @generated from field: string sender_email = 3;
    */
-  imageUrl?: string;
+  senderEmail: string;
 
   /**
-   * @generated from field: int32 user_id = 4;
+   * @generated from field: string sender_name = 4;
    */
-  userId: number;
+  senderName: string;
 
   /**
-   * @generated from field: int32 organization_id = 5;
+   * @generated from field: string subject = 5;
    */
-  organizationId: number;
+  subject: string;
 
   /**
-   * @generated from field: string location = 6;
+   * @generated from field: string message = 6;
    */
-  location: string;
+  message: string;
 
   /**
-   * @generated from field: string start_time = 7;
+   * @generated from field: events.v1.InquiryStatus status = 7;
    */
-  startTime: string;
+  status: InquiryStatus;
 
   /**
-   * @generated from field: string end_time = 8;
+   * @generated from field: string created_at = 8;
    */
-  endTime: string;
+  createdAt: string;
+};
+
+/**
+ * Describes the message events.v1.OrganizationInquiry.
+ * Use `create(OrganizationInquirySchema)` to create a new message.
+ */
+export const OrganizationInquirySchema: GenMessage<OrganizationInquiry> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 25);
 
+/**
+ * Public contact form, no authentication required
+ *
+ * @generated from message events.v1.SubmitOrganizationInquiryRequest
+ */
+export type SubmitOrganizationInquiryRequest = Message<"events.v1.SubmitOrganizationInquiryRequest"> & {
   /**
-   * @generated from field: events.v1.EventFormat format = 9;
+   * @generated from field: int32 organization_id = 1;
    */
-  format: EventFormat;
+  organizationId: number;
 
   /**
-   * @generated from field: repeated int32 tag_ids = 10;
+   * @generated from field: string sender_email = 2;
    */
-  tagIds: number[];
-};
+  senderEmail: string;
 
-/**
- * Describes the message events.v1.CreateEventRequest.
- * Use `create(CreateEventRequestSchema)` to create a new message.
- */
-export const CreateEventRequestSchema: GenMessage<CreateEventRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 28);
-
-/**
- * @generated from message events.v1.CreateEventResponse
- */
-export type CreateEventResponse = Message<"events.v1.CreateEventResponse"> & {
   /**
-   * @generated from field: events.v1.Event event = 1;
+   * @generated from field: string sender_name = 3;
    */
-  event?: Event;
-};
+  senderName: string;
 
-/**
- * Describes the message events.v1.CreateEventResponse.
- * Use `create(CreateEventResponseSchema)` to create a new message.
- */
-export const CreateEventResponseSchema: GenMessage<CreateEventResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 29);
+  /**
+   * @generated from field: string subject = 4;
+   */
+  subject: string;
 
-/**
- * @generated from message events.v1.GetEventRequest
- */
-export type GetEventRequest = Message<"events.v1.GetEventRequest"> & {
   /**
-   * @generated from field: int32 id = 1;
+   * Max 2000 characters
+   *
+   * @generated from field: string message = 5;
    */
-  id: number;
+  message: string;
 };
 
 /**
- * Describes the message events.v1.GetEventRequest.
- * Use `create(GetEventRequestSchema)` to create a new message.
+ * Describes the message events.v1.SubmitOrganizationInquiryRequest.
+ * Use `create(SubmitOrganizationInquiryRequestSchema)` to create a new message.
  */
-export const GetEventRequestSchema: GenMessage<GetEventRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 30);
+export const SubmitOrganizationInquiryRequestSchema: GenMessage<SubmitOrganizationInquiryRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 26);
 
 /**
- * @generated from message events.v1.GetEventResponse
+ * @generated from message events.v1.SubmitOrganizationInquiryResponse
  */
-export type GetEventResponse = Message<"events.v1.GetEventResponse"> & {
+export type SubmitOrganizationInquiryResponse = Message<"events.v1.SubmitOrganizationInquiryResponse"> & {
   /**
-   * @generated from field: events.v1.Event event = 1;
+   * @generated from field: int32 inquiry_id = 1;
    */
-  event?: Event;
+  inquiryId: number;
 };
 
 /**
- * Describes the message events.v1.GetEventResponse.
- * Use `create(GetEventResponseSchema)` to create a new message.
+ * Describes the message events.v1.SubmitOrganizationInquiryResponse.
+ * Use `create(SubmitOrganizationInquiryResponseSchema)` to create a new message.
  */
-export const GetEventResponseSchema: GenMessage<GetEventResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 31);
+export const SubmitOrganizationInquiryResponseSchema: GenMessage<SubmitOrganizationInquiryResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 27);
 
 /**
- * @generated from message events.v1.ListEventsRequest
+ * @generated from message events.v1.ListOrganizationInquiriesRequest
  */
-export type ListEventsRequest = Message<"events.v1.ListEventsRequest"> & {
-  /**
-   * @generated from field: int32 page = 1;
-   */
-  page: number;
-
-  /**
-   * @generated from field: int32 limit = 2;
-   */
-  limit: number;
-
+export type ListOrganizationInquiriesRequest = Message<"events.v1.ListOrganizationInquiriesRequest"> & {
   /**
-   * @generated from field: optional int32 user_id = 3;
+   * @generated from field: int32 organization_id = 1;
    */
-  userId?: number;
+  organizationId: number;
 
   /**
-   * @generated from field: optional int32 organization_id = 4;
+   * @generated from field: int32 page = 2;
    */
-  organizationId?: number;
+  page: number;
 
   /**
-   * @generated from field: repeated int32 tag_ids = 5;
+   * @generated from field: int32 limit = 3;
    */
-  tagIds: number[];
+  limit: number;
 };
 
 /**
- * Describes the message events.v1.ListEventsRequest.
- * Use `create(ListEventsRequestSchema)` to create a new message.
+ * Describes the message events.v1.ListOrganizationInquiriesRequest.
+ * Use `create(ListOrganizationInquiriesRequestSchema)` to create a new message.
  */
-export const ListEventsRequestSchema: GenMessage<ListEventsRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 32);
+export const ListOrganizationInquiriesRequestSchema: GenMessage<ListOrganizationInquiriesRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 28);
 
 /**
- * @generated from message events.v1.ListEventsResponse
+ * @generated from message events.v1.ListOrganizationInquiriesResponse
  */
-export type ListEventsResponse = Message<"events.v1.ListEventsResponse"> & {
+export type ListOrganizationInquiriesResponse = Message<"events.v1.ListOrganizationInquiriesResponse"> & {
   /**
-   * @generated from field: repeated events.v1.Event events = 1;
+   * @generated from field: repeated events.v1.OrganizationInquiry inquiries = 1;
    */
-  events: Event[];
+  inquiries: OrganizationInquiry[];
 
   /**
    * @generated from field: int32 total = 2;
@@ -1102,100 +1190,107 @@ export type ListEventsResponse = Message<"events.v1.ListEventsResponse"> & {
 };
 
 /**
- * Describes the message events.v1.ListEventsResponse.
- * Use `create(ListEventsResponseSchema)` to create a new message.
+ * Describes the message events.v1.ListOrganizationInquiriesResponse.
+ * Use `create(ListOrganizationInquiriesResponseSchema)` to create a new message.
  */
-export const ListEventsResponseSchema: GenMessage<ListEventsResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 33);
+export const ListOrganizationInquiriesResponseSchema: GenMessage<ListOrganizationInquiriesResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 29);
 
 /**
- * @generated from message events.v1.UpdateEventRequest
+ * @generated from message events.v1.UpdateInquiryStatusRequest
  */
-export type UpdateEventRequest = Message<"events.v1.UpdateEventRequest"> & {
-  /**
-   * @generated from field: int32 id = 1;
-   */
-  id: number;
-
+export type UpdateInquiryStatusRequest = Message<"events.v1.UpdateInquiryStatusRequest"> & {
   /**
-   * @generated from field: optional string title = 2;
+   * @generated from field: int32 inquiry_id = 1;
    */
-  title?: string;
+  inquiryId: number;
 
   /**
-   * @generated from field: optional string description = 3;
+   * @generated from field: events.v1.InquiryStatus status = 2;
    */
-  description?: string;
+  status: InquiryStatus;
+};
 
-  /**
-   * @generated from field: optional string image_url = 4;
-   */
-  imageUrl?: string;
+/**
+ * Describes the message events.v1.UpdateInquiryStatusRequest.
+ * Use `create(UpdateInquiryStatusRequestSchema)` to create a new message.
+ */
+export const UpdateInquiryStatusRequestSchema: GenMessage<UpdateInquiryStatusRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 30);
 
+/**
+ * @generated from message events.v1.UpdateInquiryStatusResponse
+ */
+export type UpdateInquiryStatusResponse = Message<"events.v1.UpdateInquiryStatusResponse"> & {
   /**
-   * @generated from field: optional int32 user_id = 5;
+   * @generated from field: events.v1.OrganizationInquiry inquiry = 1;
    */
-  userId?: number;
+  inquiry?: OrganizationInquiry;
+};
 
-  /**
-   * @generated from field: optional int32 organization_id = 6;
-   */
-  organizationId?: number;
+/**
+ * Describes the message events.v1.UpdateInquiryStatusResponse.
+ * Use `create(UpdateInquiryStatusResponseSchema)` to create a new message.
+ */
+export const UpdateInquiryStatusResponseSchema: GenMessage<UpdateInquiryStatusResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 31);
 
+/**
+ * Moves every event of the source organization to the target and archives
+ * the source
+ *
+ * @generated from message events.v1.MergeOrganizationsRequest
+ */
+export type MergeOrganizationsRequest = Message<"events.v1.MergeOrganizationsRequest"> & {
   /**
-   * @generated from field: optional string location = 7;
+   * @generated from field: int32 source_id = 1;
    */
-  location?: string;
+  sourceId: number;
 
   /**
-   * @generated from field: optional string start_time = 8;
+   * @generated from field: int32 target_id = 2;
    */
-  startTime?: string;
+  targetId: number;
+};
 
-  /**
-   * @generated from field: optional string end_time = 9;
-   */
-  endTime?: string;
+/**
+ * Describes the message events.v1.MergeOrganizationsRequest.
+ * Use `create(MergeOrganizationsRequestSchema)` to create a new message.
+ */
+export const MergeOrganizationsRequestSchema: GenMessage<MergeOrganizationsRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 32);
 
+/**
+ * @generated from message events.v1.MergeOrganizationsResponse
+ */
+export type MergeOrganizationsResponse = Message<"events.v1.MergeOrganizationsResponse"> & {
   /**
-   * @generated from field: optional events.v1.EventFormat format = 10;
+   * @generated from field: events.v1.Organization archived_org = 1;
    */
-  format?: EventFormat;
+  archivedOrg?: Organization;
 
   /**
-   * @generated from field: repeated int32 tag_ids = 11;
+   * @generated from field: events.v1.Organization target_org = 2;
    */
-  tagIds: number[];
-};
-
-/**
- * Describes the message events.v1.UpdateEventRequest.
- * Use `create(UpdateEventRequestSchema)` to create a new message.
- */
-export const UpdateEventRequestSchema: GenMessage<UpdateEventRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 34);
+  targetOrg?: Organization;
 
-/**
- * @generated from message events.v1.UpdateEventResponse
- */
-export type UpdateEventResponse = Message<"events.v1.UpdateEventResponse"> & {
   /**
-   * @generated from field: events.v1.Event event = 1;
+   * @generated from field: int32 events_moved = 3;
    */
-  event?: Event;
+  eventsMoved: number;
 };
 
 /**
- * Describes the message events.v1.UpdateEventResponse.
- * Use `create(UpdateEventResponseSchema)` to create a new message.
+ * Describes the message events.v1.MergeOrganizationsResponse.
+ * Use `create(MergeOrganizationsResponseSchema)` to create a new message.
  */
-export const UpdateEventResponseSchema: GenMessage<UpdateEventResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 35);
+export const MergeOrganizationsResponseSchema: GenMessage<MergeOrganizationsResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 33);
 
 /**
- * @generated from message events.v1.DeleteEventRequest
+ * @generated from message events.v1.DeleteOrganizationRequest
  */
-export type DeleteEventRequest = Message<"events.v1.DeleteEventRequest"> & {
+export type DeleteOrganizationRequest = Message<"events.v1.DeleteOrganizationRequest"> & {
   /**
    * @generated from field: int32 id = 1;
    */
@@ -1203,16 +1298,16 @@ export type DeleteEventRequest = Message<"events.v1.DeleteEventRequest"> & {
 };
 
 /**
- * Describes the message events.v1.DeleteEventRequest.
- * Use `create(DeleteEventRequestSchema)` to create a new message.
+ * Describes the message events.v1.DeleteOrganizationRequest.
+ * Use `create(DeleteOrganizationRequestSchema)` to create a new message.
  */
-export const DeleteEventRequestSchema: GenMessage<DeleteEventRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 36);
+export const DeleteOrganizationRequestSchema: GenMessage<DeleteOrganizationRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 34);
 
 /**
- * @generated from message events.v1.DeleteEventResponse
+ * @generated from message events.v1.DeleteOrganizationResponse
  */
-export type DeleteEventResponse = Message<"events.v1.DeleteEventResponse"> & {
+export type DeleteOrganizationResponse = Message<"events.v1.DeleteOrganizationResponse"> & {
   /**
    * @generated from field: bool success = 1;
    */
@@ -1220,52 +1315,52 @@ export type DeleteEventResponse = Message<"events.v1.DeleteEventResponse"> & {
 };
 
 /**
- * Describes the message events.v1.DeleteEventResponse.
- * Use `create(DeleteEventResponseSchema)` to create a new message.
+ * Describes the message events.v1.DeleteOrganizationResponse.
+ * Use `create(DeleteOrganizationResponseSchema)` to create a new message.
  */
-export const DeleteEventResponseSchema: GenMessage<DeleteEventResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 37);
+export const DeleteOrganizationResponseSchema: GenMessage<DeleteOrganizationResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 35);
 
 /**
- * Tag CRUD
+ * Organization Type CRUD
  *
- * @generated from message events.v1.CreateTagRequest
+ * @generated from message events.v1.CreateOrganizationTypeRequest
  */
-export type CreateTagRequest = Message<"events.v1.CreateTagRequest"> & {
+export type CreateOrganizationTypeRequest = Message<"events.v1.CreateOrganizationTypeRequest"> & {
   /**
-   * @generated from field: string name = 1;
+   * @generated from field: string title = 1;
    */
-  name: string;
+  title: string;
 };
 
 /**
- * Describes the message events.v1.CreateTagRequest.
- * Use `create(CreateTagRequestSchema)` to create a new message.
+ * Describes the message events.v1.CreateOrganizationTypeRequest.
+ * Use `create(CreateOrganizationTypeRequestSchema)` to create a new message.
  */
-export const CreateTagRequestSchema: GenMessage<CreateTagRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 38);
+export const CreateOrganizationTypeRequestSchema: GenMessage<CreateOrganizationTypeRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 36);
 
 /**
- * @generated from message events.v1.CreateTagResponse
+ * @generated from message events.v1.CreateOrganizationTypeResponse
  */
-export type CreateTagResponse = Message<"events.v1.CreateTagResponse"> & {
+export type CreateOrganizationTypeResponse = Message<"events.v1.CreateOrganizationTypeResponse"> & {
   /**
-   * @generated from field: events.v1.Tag tag = 1;
+   * @generated from field: events.v1.OrganizationType organization_type = 1;
    */
-  tag?: Tag;
+  organizationType?: OrganizationType;
 };
 
 /**
- * Describes the message events.v1.CreateTagResponse.
- * Use `create(CreateTagResponseSchema)` to create a new message.
+ * Describes the message events.v1.CreateOrganizationTypeResponse.
+ * Use `create(CreateOrganizationTypeResponseSchema)` to create a new message.
  */
-export const CreateTagResponseSchema: GenMessage<CreateTagResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 39);
+export const CreateOrganizationTypeResponseSchema: GenMessage<CreateOrganizationTypeResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 37);
 
 /**
- * @generated from message events.v1.GetTagRequest
+ * @generated from message events.v1.GetOrganizationTypeRequest
  */
-export type GetTagRequest = Message<"events.v1.GetTagRequest"> & {
+export type GetOrganizationTypeRequest = Message<"events.v1.GetOrganizationTypeRequest"> & {
   /**
    * @generated from field: int32 id = 1;
    */
@@ -1273,33 +1368,33 @@ export type GetTagRequest = Message<"events.v1.GetTagRequest"> & {
 };
 
 /**
- * Describes the message events.v1.GetTagRequest.
- * Use `create(GetTagRequestSchema)` to create a new message.
+ * Describes the message events.v1.GetOrganizationTypeRequest.
+ * Use `create(GetOrganizationTypeRequestSchema)` to create a new message.
  */
-export const GetTagRequestSchema: GenMessage<GetTagRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 40);
+export const GetOrganizationTypeRequestSchema: GenMessage<GetOrganizationTypeRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 38);
 
 /**
- * @generated from message events.v1.GetTagResponse
+ * @generated from message events.v1.GetOrganizationTypeResponse
  */
-export type GetTagResponse = Message<"events.v1.GetTagResponse"> & {
+export type GetOrganizationTypeResponse = Message<"events.v1.GetOrganizationTypeResponse"> & {
   /**
-   * @generated from field: events.v1.Tag tag = 1;
+   * @generated from field: events.v1.OrganizationType organization_type = 1;
    */
-  tag?: Tag;
+  organizationType?: OrganizationType;
 };
 
 /**
- * Describes the message events.v1.GetTagResponse.
- * Use `create(GetTagResponseSchema)` to create a new message.
+ * Describes the message events.v1.GetOrganizationTypeResponse.
+ * Use `create(GetOrganizationTypeResponseSchema)` to create a new message.
  */
-export const GetTagResponseSchema: GenMessage<GetTagResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 41);
+export const GetOrganizationTypeResponseSchema: GenMessage<GetOrganizationTypeResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 39);
 
 /**
- * @generated from message events.v1.ListTagsRequest
+ * @generated from message events.v1.ListOrganizationTypesRequest
  */
-export type ListTagsRequest = Message<"events.v1.ListTagsRequest"> & {
+export type ListOrganizationTypesRequest = Message<"events.v1.ListOrganizationTypesRequest"> & {
   /**
    * @generated from field: int32 page = 1;
    */
@@ -1312,20 +1407,20 @@ export type ListTagsRequest = Message<"events.v1.ListTagsRequest"> & {
 };
 
 /**
- * Describes the message events.v1.ListTagsRequest.
- * Use `create(ListTagsRequestSchema)` to create a new message.
+ * Describes the message events.v1.ListOrganizationTypesRequest.
+ * Use `create(ListOrganizationTypesRequestSchema)` to create a new message.
  */
-export const ListTagsRequestSchema: GenMessage<ListTagsRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 42);
+export const ListOrganizationTypesRequestSchema: GenMessage<ListOrganizationTypesRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 40);
 
 /**
- * @generated from message events.v1.ListTagsResponse
+ * @generated from message events.v1.ListOrganizationTypesResponse
  */
-export type ListTagsResponse = Message<"events.v1.ListTagsResponse"> & {
+export type ListOrganizationTypesResponse = Message<"events.v1.ListOrganizationTypesResponse"> & {
   /**
-   * @generated from field: repeated events.v1.Tag tags = 1;
+   * @generated from field: repeated events.v1.OrganizationType organization_types = 1;
    */
-  tags: Tag[];
+  organizationTypes: OrganizationType[];
 
   /**
    * @generated from field: int32 total = 2;
@@ -1334,55 +1429,55 @@ export type ListTagsResponse = Message<"events.v1.ListTagsResponse"> & {
 };
 
 /**
- * Describes the message events.v1.ListTagsResponse.
- * Use `create(ListTagsResponseSchema)` to create a new message.
+ * Describes the message events.v1.ListOrganizationTypesResponse.
+ * Use `create(ListOrganizationTypesResponseSchema)` to create a new message.
  */
-export const ListTagsResponseSchema: GenMessage<ListTagsResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 43);
+export const ListOrganizationTypesResponseSchema: GenMessage<ListOrganizationTypesResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 41);
 
 /**
- * @generated from message events.v1.UpdateTagRequest
+ * @generated from message events.v1.UpdateOrganizationTypeRequest
  */
-export type UpdateTagRequest = Message<"events.v1.UpdateTagRequest"> & {
+export type UpdateOrganizationTypeRequest = Message<"events.v1.UpdateOrganizationTypeRequest"> & {
   /**
    * @generated from field: int32 id = 1;
    */
   id: number;
 
   /**
-   * @generated from field: optional string name = 2;
+   * @generated from field: optional string title = 2;
    */
-  name?: string;
+  title?: string;
 };
 
 /**
- * Describes the message events.v1.UpdateTagRequest.
- * Use `create(UpdateTagRequestSchema)` to create a new message.
+ * Describes the message events.v1.UpdateOrganizationTypeRequest.
+ * Use `create(UpdateOrganizationTypeRequestSchema)` to create a new message.
  */
-export const UpdateTagRequestSchema: GenMessage<UpdateTagRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 44);
+export const UpdateOrganizationTypeRequestSchema: GenMessage<UpdateOrganizationTypeRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 42);
 
 /**
- * @generated from message events.v1.UpdateTagResponse
+ * @generated from message events.v1.UpdateOrganizationTypeResponse
  */
-export type UpdateTagResponse = Message<"events.v1.UpdateTagResponse"> & {
+export type UpdateOrganizationTypeResponse = Message<"events.v1.UpdateOrganizationTypeResponse"> & {
   /**
-   * @generated from field: events.v1.Tag tag = 1;
+   * @generated from field: events.v1.OrganizationType organization_type = 1;
    */
-  tag?: Tag;
+  organizationType?: OrganizationType;
 };
 
 /**
- * Describes the message events.v1.UpdateTagResponse.
- * Use `create(UpdateTagResponseSchema)` to create a new message.
+ * Describes the message events.v1.UpdateOrganizationTypeResponse.
+ * Use `create(UpdateOrganizationTypeResponseSchema)` to create a new message.
  */
-export const UpdateTagResponseSchema: GenMessage<UpdateTagResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 45);
+export const UpdateOrganizationTypeResponseSchema: GenMessage<UpdateOrganizationTypeResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 43);
 
 /**
- * @generated from message events.v1.DeleteTagRequest
+ * @generated from message events.v1.DeleteOrganizationTypeRequest
  */
-export type DeleteTagRequest = Message<"events.v1.DeleteTagRequest"> & {
+export type DeleteOrganizationTypeRequest = Message<"events.v1.DeleteOrganizationTypeRequest"> & {
   /**
    * @generated from field: int32 id = 1;
    */
@@ -1390,16 +1485,16 @@ export type DeleteTagRequest = Message<"events.v1.DeleteTagRequest"> & {
 };
 
 /**
- * Describes the message events.v1.DeleteTagRequest.
- * Use `create(DeleteTagRequestSchema)` to create a new message.
+ * Describes the message events.v1.DeleteOrganizationTypeRequest.
+ * Use `create(DeleteOrganizationTypeRequestSchema)` to create a new message.
  */
-export const DeleteTagRequestSchema: GenMessage<DeleteTagRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 46);
+export const DeleteOrganizationTypeRequestSchema: GenMessage<DeleteOrganizationTypeRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 44);
 
 /**
- * @generated from message events.v1.DeleteTagResponse
+ * @generated from message events.v1.DeleteOrganizationTypeResponse
  */
-export type DeleteTagResponse = Message<"events.v1.DeleteTagResponse"> & {
+export type DeleteOrganizationTypeResponse = Message<"events.v1.DeleteOrganizationTypeResponse"> & {
   /**
    * @generated from field: bool success = 1;
    */
@@ -1407,154 +1502,239 @@ export type DeleteTagResponse = Message<"events.v1.DeleteTagResponse"> & {
 };
 
 /**
- * Describes the message events.v1.DeleteTagResponse.
- * Use `create(DeleteTagResponseSchema)` to create a new message.
+ * Describes the message events.v1.DeleteOrganizationTypeResponse.
+ * Use `create(DeleteOrganizationTypeResponseSchema)` to create a new message.
  */
-export const DeleteTagResponseSchema: GenMessage<DeleteTagResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 47);
+export const DeleteOrganizationTypeResponseSchema: GenMessage<DeleteOrganizationTypeResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 45);
 
 /**
- * Additional request/response messages for custom methods
+ * Event CRUD
  *
- * @generated from message events.v1.GetPublishableOrganizationsRequest
+ * @generated from message events.v1.CreateEventRequest
  */
-export type GetPublishableOrganizationsRequest = Message<"events.v1.GetPublishableOrganizationsRequest"> & {
+export type CreateEventRequest = Message<"events.v1.CreateEventRequest"> & {
   /**
-   * @generated from field: int32 user_id = 1;
+   * @generated from field: string title = 1;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string description = 2;
+   */
+  description: string;
+
+  /**
+   * @generated from field: optional string image_url = 3;
+   */
+  imageUrl?: string;
+
+  /**
+   * @generated from field: int32 user_id = 4;
    */
   userId: number;
+
+  /**
+   * @generated from field: int32 organization_id = 5;
+   */
+  organizationId: number;
+
+  /**
+   * @generated from field: string location = 6;
+   */
+  location: string;
+
+  /**
+   * @generated from field: string start_time = 7;
+   */
+  startTime: string;
+
+  /**
+   * @generated from field: string end_time = 8;
+   */
+  endTime: string;
+
+  /**
+   * @generated from field: events.v1.EventFormat format = 9;
+   */
+  format: EventFormat;
+
+  /**
+   * @generated from field: repeated int32 tag_ids = 10;
+   */
+  tagIds: number[];
+
+  /**
+   * @generated from field: optional int32 capacity = 11;
+   */
+  capacity?: number;
 };
 
 /**
- * Describes the message events.v1.GetPublishableOrganizationsRequest.
- * Use `create(GetPublishableOrganizationsRequestSchema)` to create a new message.
+ * Describes the message events.v1.CreateEventRequest.
+ * Use `create(CreateEventRequestSchema)` to create a new message.
  */
-export const GetPublishableOrganizationsRequestSchema: GenMessage<GetPublishableOrganizationsRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 48);
+export const CreateEventRequestSchema: GenMessage<CreateEventRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 46);
 
 /**
- * @generated from message events.v1.GetPublishableOrganizationsResponse
+ * @generated from message events.v1.CreateEventResponse
  */
-export type GetPublishableOrganizationsResponse = Message<"events.v1.GetPublishableOrganizationsResponse"> & {
+export type CreateEventResponse = Message<"events.v1.CreateEventResponse"> & {
   /**
-   * @generated from field: repeated events.v1.Organization organizations = 1;
+   * @generated from field: events.v1.Event event = 1;
    */
-  organizations: Organization[];
+  event?: Event;
 };
 
 /**
- * Describes the message events.v1.GetPublishableOrganizationsResponse.
- * Use `create(GetPublishableOrganizationsResponseSchema)` to create a new message.
+ * Describes the message events.v1.CreateEventResponse.
+ * Use `create(CreateEventResponseSchema)` to create a new message.
  */
-export const GetPublishableOrganizationsResponseSchema: GenMessage<GetPublishableOrganizationsResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 49);
+export const CreateEventResponseSchema: GenMessage<CreateEventResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 47);
 
 /**
- * @generated from message events.v1.GetUserOrganizationsRequest
+ * BulkCreateEventsRequest imports up to 500 events in one transaction.
+ * Invalid rows fail the whole batch with field violations such as
+ * "events[3].start_time".
+ *
+ * @generated from message events.v1.BulkCreateEventsRequest
  */
-export type GetUserOrganizationsRequest = Message<"events.v1.GetUserOrganizationsRequest"> & {
+export type BulkCreateEventsRequest = Message<"events.v1.BulkCreateEventsRequest"> & {
   /**
-   * @generated from field: int32 user_id = 1;
+   * @generated from field: repeated events.v1.CreateEventRequest events = 1;
    */
-  userId: number;
+  events: CreateEventRequest[];
 };
 
 /**
- * Describes the message events.v1.GetUserOrganizationsRequest.
- * Use `create(GetUserOrganizationsRequestSchema)` to create a new message.
+ * Describes the message events.v1.BulkCreateEventsRequest.
+ * Use `create(BulkCreateEventsRequestSchema)` to create a new message.
  */
-export const GetUserOrganizationsRequestSchema: GenMessage<GetUserOrganizationsRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 50);
+export const BulkCreateEventsRequestSchema: GenMessage<BulkCreateEventsRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 48);
 
 /**
- * @generated from message events.v1.GetUserOrganizationsResponse
+ * @generated from message events.v1.BulkCreateEventsResponse
  */
-export type GetUserOrganizationsResponse = Message<"events.v1.GetUserOrganizationsResponse"> & {
+export type BulkCreateEventsResponse = Message<"events.v1.BulkCreateEventsResponse"> & {
   /**
-   * @generated from field: repeated events.v1.Organization organizations = 1;
+   * @generated from field: repeated events.v1.Event events = 1;
    */
-  organizations: Organization[];
+  events: Event[];
 };
 
 /**
- * Describes the message events.v1.GetUserOrganizationsResponse.
- * Use `create(GetUserOrganizationsResponseSchema)` to create a new message.
+ * Describes the message events.v1.BulkCreateEventsResponse.
+ * Use `create(BulkCreateEventsResponseSchema)` to create a new message.
  */
-export const GetUserOrganizationsResponseSchema: GenMessage<GetUserOrganizationsResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 51);
+export const BulkCreateEventsResponseSchema: GenMessage<BulkCreateEventsResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 49);
 
 /**
- * @generated from message events.v1.GetEventsByTagIdRequest
+ * Copies an event's details and tags into a new event at the given times.
+ * Registrations and attendance are not copied.
+ *
+ * @generated from message events.v1.DuplicateEventRequest
  */
-export type GetEventsByTagIdRequest = Message<"events.v1.GetEventsByTagIdRequest"> & {
+export type DuplicateEventRequest = Message<"events.v1.DuplicateEventRequest"> & {
   /**
-   * @generated from field: int32 tag_id = 1;
+   * @generated from field: int32 source_event_id = 1;
    */
-  tagId: number;
+  sourceEventId: number;
+
+  /**
+   * RFC3339
+   *
+   * @generated from field: string new_start_time = 2;
+   */
+  newStartTime: string;
+
+  /**
+   * RFC3339
+   *
+   * @generated from field: string new_end_time = 3;
+   */
+  newEndTime: string;
 };
 
 /**
- * Describes the message events.v1.GetEventsByTagIdRequest.
- * Use `create(GetEventsByTagIdRequestSchema)` to create a new message.
+ * Describes the message events.v1.DuplicateEventRequest.
+ * Use `create(DuplicateEventRequestSchema)` to create a new message.
  */
-export const GetEventsByTagIdRequestSchema: GenMessage<GetEventsByTagIdRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 52);
+export const DuplicateEventRequestSchema: GenMessage<DuplicateEventRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 50);
 
 /**
- * @generated from message events.v1.GetEventsByTagIdResponse
+ * @generated from message events.v1.DuplicateEventResponse
  */
-export type GetEventsByTagIdResponse = Message<"events.v1.GetEventsByTagIdResponse"> & {
+export type DuplicateEventResponse = Message<"events.v1.DuplicateEventResponse"> & {
   /**
-   * @generated from field: repeated events.v1.Event events = 1;
+   * @generated from field: events.v1.Event event = 1;
    */
-  events: Event[];
+  event?: Event;
 };
 
 /**
- * Describes the message events.v1.GetEventsByTagIdResponse.
- * Use `create(GetEventsByTagIdResponseSchema)` to create a new message.
+ * Describes the message events.v1.DuplicateEventResponse.
+ * Use `create(DuplicateEventResponseSchema)` to create a new message.
  */
-export const GetEventsByTagIdResponseSchema: GenMessage<GetEventsByTagIdResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 53);
+export const DuplicateEventResponseSchema: GenMessage<DuplicateEventResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 51);
 
 /**
- * @generated from message events.v1.GetUserSubscribedEventsRequest
+ * @generated from message events.v1.GetEventRequest
  */
-export type GetUserSubscribedEventsRequest = Message<"events.v1.GetUserSubscribedEventsRequest"> & {
+export type GetEventRequest = Message<"events.v1.GetEventRequest"> & {
   /**
-   * @generated from field: int32 user_id = 1;
+   * @generated from field: int32 id = 1;
    */
-  userId: number;
+  id: number;
 };
 
 /**
- * Describes the message events.v1.GetUserSubscribedEventsRequest.
- * Use `create(GetUserSubscribedEventsRequestSchema)` to create a new message.
+ * Describes the message events.v1.GetEventRequest.
+ * Use `create(GetEventRequestSchema)` to create a new message.
  */
-export const GetUserSubscribedEventsRequestSchema: GenMessage<GetUserSubscribedEventsRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 54);
+export const GetEventRequestSchema: GenMessage<GetEventRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 52);
 
 /**
- * @generated from message events.v1.GetUserSubscribedEventsResponse
+ * @generated from message events.v1.GetEventResponse
  */
-export type GetUserSubscribedEventsResponse = Message<"events.v1.GetUserSubscribedEventsResponse"> & {
+export type GetEventResponse = Message<"events.v1.GetEventResponse"> & {
   /**
-   * @generated from field: repeated events.v1.Event events = 1;
+   * @generated from field: events.v1.Event event = 1;
    */
-  events: Event[];
+  event?: Event;
+
+  /**
+   * Unset when not logged in or not registered
+   *
+   * @generated from field: optional events.v1.EventRegistration caller_registration = 2;
+   */
+  callerRegistration?: EventRegistration;
+
+  /**
+   * Unset when not checked in
+   *
+   * @generated from field: optional events.v1.EventAttendance caller_attendance = 3;
+   */
+  callerAttendance?: EventAttendance;
 };
 
 /**
- * Describes the message events.v1.GetUserSubscribedEventsResponse.
- * Use `create(GetUserSubscribedEventsResponseSchema)` to create a new message.
+ * Describes the message events.v1.GetEventResponse.
+ * Use `create(GetEventResponseSchema)` to create a new message.
  */
-export const GetUserSubscribedEventsResponseSchema: GenMessage<GetUserSubscribedEventsResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 55);
+export const GetEventResponseSchema: GenMessage<GetEventResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 53);
 
 /**
- * @generated from message events.v1.ListEventsForAdminRequest
+ * @generated from message events.v1.ListEventsRequest
  */
-export type ListEventsForAdminRequest = Message<"events.v1.ListEventsForAdminRequest"> & {
+export type ListEventsRequest = Message<"events.v1.ListEventsRequest"> & {
   /**
    * @generated from field: int32 page = 1;
    */
@@ -1574,99 +1754,181 @@ export type ListEventsForAdminRequest = Message<"events.v1.ListEventsForAdminReq
    * @generated from field: optional int32 organization_id = 4;
    */
   organizationId?: number;
-};
-
-/**
- * Describes the message events.v1.ListEventsForAdminRequest.
- * Use `create(ListEventsForAdminRequestSchema)` to create a new message.
- */
-export const ListEventsForAdminRequestSchema: GenMessage<ListEventsForAdminRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 56);
 
-/**
- * @generated from message events.v1.ListEventsForAdminResponse
- */
-export type ListEventsForAdminResponse = Message<"events.v1.ListEventsForAdminResponse"> & {
   /**
-   * @generated from field: repeated events.v1.Event events = 1;
+   * @generated from field: repeated int32 tag_ids = 5;
    */
-  events: Event[];
+  tagIds: number[];
 
   /**
-   * @generated from field: int32 total = 2;
+   * Requires manage_clubs on the platform
+   *
+   * @generated from field: bool include_unpublished = 6;
+   */
+  includeUnpublished: boolean;
+
+  /**
+   * Set to page by start time instead of page: empty for the first page, then next_cursor
+   *
+   * @generated from field: optional string cursor = 7;
+   */
+  cursor?: string;
+};
+
+/**
+ * Describes the message events.v1.ListEventsRequest.
+ * Use `create(ListEventsRequestSchema)` to create a new message.
+ */
+export const ListEventsRequestSchema: GenMessage<ListEventsRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 54);
+
+/**
+ * @generated from message events.v1.ListEventsResponse
+ */
+export type ListEventsResponse = Message<"events.v1.ListEventsResponse"> & {
+  /**
+   * @generated from field: repeated events.v1.Event events = 1;
+   */
+  events: Event[];
+
+  /**
+   * @generated from field: int32 total = 2;
    */
   total: number;
+
+  /**
+   * Only in cursor mode, unset on the last page
+   *
+   * @generated from field: optional string next_cursor = 3;
+   */
+  nextCursor?: string;
 };
 
 /**
- * Describes the message events.v1.ListEventsForAdminResponse.
- * Use `create(ListEventsForAdminResponseSchema)` to create a new message.
+ * Describes the message events.v1.ListEventsResponse.
+ * Use `create(ListEventsResponseSchema)` to create a new message.
  */
-export const ListEventsForAdminResponseSchema: GenMessage<ListEventsForAdminResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 57);
+export const ListEventsResponseSchema: GenMessage<ListEventsResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 55);
 
 /**
- * Event Registration messages
- *
- * @generated from message events.v1.RegisterForEventRequest
+ * @generated from message events.v1.UpdateEventRequest
  */
-export type RegisterForEventRequest = Message<"events.v1.RegisterForEventRequest"> & {
+export type UpdateEventRequest = Message<"events.v1.UpdateEventRequest"> & {
   /**
-   * @generated from field: int32 event_id = 1;
+   * @generated from field: int32 id = 1;
    */
-  eventId: number;
+  id: number;
 
   /**
-   * @generated from field: int32 user_id = 2;
+   * @generated from field: optional string title = 2;
    */
-  userId: number;
+  title?: string;
+
+  /**
+   * @generated from field: optional string description = 3;
+   */
+  description?: string;
+
+  /**
+   * @generated from field: optional string image_url = 4;
+   */
+  imageUrl?: string;
+
+  /**
+   * @generated from field: optional int32 user_id = 5;
+   */
+  userId?: number;
+
+  /**
+   * @generated from field: optional int32 organization_id = 6;
+   */
+  organizationId?: number;
+
+  /**
+   * @generated from field: optional string location = 7;
+   */
+  location?: string;
+
+  /**
+   * @generated from field: optional string start_time = 8;
+   */
+  startTime?: string;
+
+  /**
+   * @generated from field: optional string end_time = 9;
+   */
+  endTime?: string;
+
+  /**
+   * @generated from field: optional events.v1.EventFormat format = 10;
+   */
+  format?: EventFormat;
+
+  /**
+   * @generated from field: repeated int32 tag_ids = 11;
+   */
+  tagIds: number[];
+
+  /**
+   * @generated from field: optional int32 capacity = 12;
+   */
+  capacity?: number;
+
+  /**
+   * Event.version the edit is based on. When set and the event has changed
+   * since, the update fails with ABORTED.
+   *
+   * @generated from field: optional int32 expected_version = 13;
+   */
+  expectedVersion?: number;
 };
 
 /**
- * Describes the message events.v1.RegisterForEventRequest.
- * Use `create(RegisterForEventRequestSchema)` to create a new message.
+ * Describes the message events.v1.UpdateEventRequest.
+ * Use `create(UpdateEventRequestSchema)` to create a new message.
  */
-export const RegisterForEventRequestSchema: GenMessage<RegisterForEventRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 58);
+export const UpdateEventRequestSchema: GenMessage<UpdateEventRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 56);
 
 /**
- * @generated from message events.v1.RegisterForEventResponse
+ * @generated from message events.v1.UpdateEventResponse
  */
-export type RegisterForEventResponse = Message<"events.v1.RegisterForEventResponse"> & {
+export type UpdateEventResponse = Message<"events.v1.UpdateEventResponse"> & {
   /**
-   * @generated from field: events.v1.EventRegistration registration = 1;
+   * @generated from field: events.v1.Event event = 1;
    */
-  registration?: EventRegistration;
+  event?: Event;
 };
 
 /**
- * Describes the message events.v1.RegisterForEventResponse.
- * Use `create(RegisterForEventResponseSchema)` to create a new message.
+ * Describes the message events.v1.UpdateEventResponse.
+ * Use `create(UpdateEventResponseSchema)` to create a new message.
  */
-export const RegisterForEventResponseSchema: GenMessage<RegisterForEventResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 59);
+export const UpdateEventResponseSchema: GenMessage<UpdateEventResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 57);
 
 /**
- * @generated from message events.v1.CancelRegistrationRequest
+ * @generated from message events.v1.DeleteEventRequest
  */
-export type CancelRegistrationRequest = Message<"events.v1.CancelRegistrationRequest"> & {
+export type DeleteEventRequest = Message<"events.v1.DeleteEventRequest"> & {
   /**
-   * @generated from field: int32 registration_id = 1;
+   * @generated from field: int32 id = 1;
    */
-  registrationId: number;
+  id: number;
 };
 
 /**
- * Describes the message events.v1.CancelRegistrationRequest.
- * Use `create(CancelRegistrationRequestSchema)` to create a new message.
+ * Describes the message events.v1.DeleteEventRequest.
+ * Use `create(DeleteEventRequestSchema)` to create a new message.
  */
-export const CancelRegistrationRequestSchema: GenMessage<CancelRegistrationRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 60);
+export const DeleteEventRequestSchema: GenMessage<DeleteEventRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 58);
 
 /**
- * @generated from message events.v1.CancelRegistrationResponse
+ * @generated from message events.v1.DeleteEventResponse
  */
-export type CancelRegistrationResponse = Message<"events.v1.CancelRegistrationResponse"> & {
+export type DeleteEventResponse = Message<"events.v1.DeleteEventResponse"> & {
   /**
    * @generated from field: bool success = 1;
    */
@@ -1674,47 +1936,80 @@ export type CancelRegistrationResponse = Message<"events.v1.CancelRegistrationRe
 };
 
 /**
- * Describes the message events.v1.CancelRegistrationResponse.
- * Use `create(CancelRegistrationResponseSchema)` to create a new message.
+ * Describes the message events.v1.DeleteEventResponse.
+ * Use `create(DeleteEventResponseSchema)` to create a new message.
  */
-export const CancelRegistrationResponseSchema: GenMessage<CancelRegistrationResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 61);
+export const DeleteEventResponseSchema: GenMessage<DeleteEventResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 59);
 
 /**
- * @generated from message events.v1.GetEventRegistrationsRequest
+ * Restore a soft-deleted event (platform admins)
+ *
+ * @generated from message events.v1.RestoreEventRequest
  */
-export type GetEventRegistrationsRequest = Message<"events.v1.GetEventRegistrationsRequest"> & {
+export type RestoreEventRequest = Message<"events.v1.RestoreEventRequest"> & {
   /**
-   * @generated from field: int32 event_id = 1;
+   * @generated from field: int32 id = 1;
    */
-  eventId: number;
+  id: number;
+};
+
+/**
+ * Describes the message events.v1.RestoreEventRequest.
+ * Use `create(RestoreEventRequestSchema)` to create a new message.
+ */
+export const RestoreEventRequestSchema: GenMessage<RestoreEventRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 60);
 
+/**
+ * @generated from message events.v1.RestoreEventResponse
+ */
+export type RestoreEventResponse = Message<"events.v1.RestoreEventResponse"> & {
   /**
-   * @generated from field: optional int32 page = 2;
+   * @generated from field: events.v1.Event event = 1;
    */
-  page?: number;
+  event?: Event;
+};
+
+/**
+ * Describes the message events.v1.RestoreEventResponse.
+ * Use `create(RestoreEventResponseSchema)` to create a new message.
+ */
+export const RestoreEventResponseSchema: GenMessage<RestoreEventResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 61);
 
+/**
+ * Soft-deleted events, most recently deleted first (platform admins)
+ *
+ * @generated from message events.v1.ListDeletedEventsRequest
+ */
+export type ListDeletedEventsRequest = Message<"events.v1.ListDeletedEventsRequest"> & {
   /**
-   * @generated from field: optional int32 limit = 3;
+   * @generated from field: int32 page = 1;
    */
-  limit?: number;
+  page: number;
+
+  /**
+   * @generated from field: int32 limit = 2;
+   */
+  limit: number;
 };
 
 /**
- * Describes the message events.v1.GetEventRegistrationsRequest.
- * Use `create(GetEventRegistrationsRequestSchema)` to create a new message.
+ * Describes the message events.v1.ListDeletedEventsRequest.
+ * Use `create(ListDeletedEventsRequestSchema)` to create a new message.
  */
-export const GetEventRegistrationsRequestSchema: GenMessage<GetEventRegistrationsRequest> = /*@__PURE__*/
+export const ListDeletedEventsRequestSchema: GenMessage<ListDeletedEventsRequest> = /*@__PURE__*/
   messageDesc(file_eventsv1_events, 62);
 
 /**
- * @generated from message events.v1.GetEventRegistrationsResponse
+ * @generated from message events.v1.ListDeletedEventsResponse
  */
-export type GetEventRegistrationsResponse = Message<"events.v1.GetEventRegistrationsResponse"> & {
+export type ListDeletedEventsResponse = Message<"events.v1.ListDeletedEventsResponse"> & {
   /**
-   * @generated from field: repeated events.v1.EventRegistration registrations = 1;
+   * @generated from field: repeated events.v1.Event events = 1;
    */
-  registrations: EventRegistration[];
+  events: Event[];
 
   /**
    * @generated from field: int32 total = 2;
@@ -1723,1198 +2018,3607 @@ export type GetEventRegistrationsResponse = Message<"events.v1.GetEventRegistrat
 };
 
 /**
- * Describes the message events.v1.GetEventRegistrationsResponse.
- * Use `create(GetEventRegistrationsResponseSchema)` to create a new message.
+ * Describes the message events.v1.ListDeletedEventsResponse.
+ * Use `create(ListDeletedEventsResponseSchema)` to create a new message.
  */
-export const GetEventRegistrationsResponseSchema: GenMessage<GetEventRegistrationsResponse> = /*@__PURE__*/
+export const ListDeletedEventsResponseSchema: GenMessage<ListDeletedEventsResponse> = /*@__PURE__*/
   messageDesc(file_eventsv1_events, 63);
 
 /**
- * @generated from message events.v1.GetUserRegistrationsRequest
+ * @generated from message events.v1.ToggleEventVisibilityRequest
  */
-export type GetUserRegistrationsRequest = Message<"events.v1.GetUserRegistrationsRequest"> & {
+export type ToggleEventVisibilityRequest = Message<"events.v1.ToggleEventVisibilityRequest"> & {
   /**
-   * @generated from field: int32 user_id = 1;
+   * @generated from field: int32 event_id = 1;
    */
-  userId: number;
+  eventId: number;
+
+  /**
+   * @generated from field: bool published = 2;
+   */
+  published: boolean;
 };
 
 /**
- * Describes the message events.v1.GetUserRegistrationsRequest.
- * Use `create(GetUserRegistrationsRequestSchema)` to create a new message.
+ * Describes the message events.v1.ToggleEventVisibilityRequest.
+ * Use `create(ToggleEventVisibilityRequestSchema)` to create a new message.
  */
-export const GetUserRegistrationsRequestSchema: GenMessage<GetUserRegistrationsRequest> = /*@__PURE__*/
+export const ToggleEventVisibilityRequestSchema: GenMessage<ToggleEventVisibilityRequest> = /*@__PURE__*/
   messageDesc(file_eventsv1_events, 64);
 
 /**
- * @generated from message events.v1.GetUserRegistrationsResponse
+ * @generated from message events.v1.ToggleEventVisibilityResponse
  */
-export type GetUserRegistrationsResponse = Message<"events.v1.GetUserRegistrationsResponse"> & {
+export type ToggleEventVisibilityResponse = Message<"events.v1.ToggleEventVisibilityResponse"> & {
   /**
-   * @generated from field: repeated events.v1.EventRegistration registrations = 1;
+   * @generated from field: events.v1.Event event = 1;
    */
-  registrations: EventRegistration[];
+  event?: Event;
 };
 
 /**
- * Describes the message events.v1.GetUserRegistrationsResponse.
- * Use `create(GetUserRegistrationsResponseSchema)` to create a new message.
+ * Describes the message events.v1.ToggleEventVisibilityResponse.
+ * Use `create(ToggleEventVisibilityResponseSchema)` to create a new message.
  */
-export const GetUserRegistrationsResponseSchema: GenMessage<GetUserRegistrationsResponse> = /*@__PURE__*/
+export const ToggleEventVisibilityResponseSchema: GenMessage<ToggleEventVisibilityResponse> = /*@__PURE__*/
   messageDesc(file_eventsv1_events, 65);
 
 /**
- * Attendance messages
+ * Tag CRUD
  *
- * @generated from message events.v1.CheckInAttendeeRequest
+ * @generated from message events.v1.CreateTagRequest
  */
-export type CheckInAttendeeRequest = Message<"events.v1.CheckInAttendeeRequest"> & {
-  /**
-   * @generated from field: int32 registration_id = 1;
-   */
-  registrationId: number;
-
-  /**
-   * @generated from field: int32 checked_in_by = 2;
-   */
-  checkedInBy: number;
-
+export type CreateTagRequest = Message<"events.v1.CreateTagRequest"> & {
   /**
-   * @generated from field: optional string notes = 3;
+   * @generated from field: string name = 1;
    */
-  notes?: string;
+  name: string;
 };
 
 /**
- * Describes the message events.v1.CheckInAttendeeRequest.
- * Use `create(CheckInAttendeeRequestSchema)` to create a new message.
+ * Describes the message events.v1.CreateTagRequest.
+ * Use `create(CreateTagRequestSchema)` to create a new message.
  */
-export const CheckInAttendeeRequestSchema: GenMessage<CheckInAttendeeRequest> = /*@__PURE__*/
+export const CreateTagRequestSchema: GenMessage<CreateTagRequest> = /*@__PURE__*/
   messageDesc(file_eventsv1_events, 66);
 
 /**
- * @generated from message events.v1.CheckInAttendeeResponse
+ * @generated from message events.v1.CreateTagResponse
  */
-export type CheckInAttendeeResponse = Message<"events.v1.CheckInAttendeeResponse"> & {
+export type CreateTagResponse = Message<"events.v1.CreateTagResponse"> & {
   /**
-   * @generated from field: events.v1.EventAttendance attendance = 1;
+   * @generated from field: events.v1.Tag tag = 1;
    */
-  attendance?: EventAttendance;
+  tag?: Tag;
 };
 
 /**
- * Describes the message events.v1.CheckInAttendeeResponse.
- * Use `create(CheckInAttendeeResponseSchema)` to create a new message.
+ * Describes the message events.v1.CreateTagResponse.
+ * Use `create(CreateTagResponseSchema)` to create a new message.
  */
-export const CheckInAttendeeResponseSchema: GenMessage<CheckInAttendeeResponse> = /*@__PURE__*/
+export const CreateTagResponseSchema: GenMessage<CreateTagResponse> = /*@__PURE__*/
   messageDesc(file_eventsv1_events, 67);
 
 /**
- * @generated from message events.v1.MarkAttendanceRequest
+ * @generated from message events.v1.GetTagRequest
  */
-export type MarkAttendanceRequest = Message<"events.v1.MarkAttendanceRequest"> & {
+export type GetTagRequest = Message<"events.v1.GetTagRequest"> & {
   /**
-   * @generated from field: int32 registration_id = 1;
+   * @generated from field: int32 id = 1;
    */
-  registrationId: number;
+  id: number;
+};
 
+/**
+ * Describes the message events.v1.GetTagRequest.
+ * Use `create(GetTagRequestSchema)` to create a new message.
+ */
+export const GetTagRequestSchema: GenMessage<GetTagRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 68);
+
+/**
+ * @generated from message events.v1.GetTagResponse
+ */
+export type GetTagResponse = Message<"events.v1.GetTagResponse"> & {
   /**
-   * @generated from field: events.v1.AttendanceStatus status = 2;
+   * @generated from field: events.v1.Tag tag = 1;
    */
-  status: AttendanceStatus;
+  tag?: Tag;
+};
 
+/**
+ * Describes the message events.v1.GetTagResponse.
+ * Use `create(GetTagResponseSchema)` to create a new message.
+ */
+export const GetTagResponseSchema: GenMessage<GetTagResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 69);
+
+/**
+ * @generated from message events.v1.ListTagsRequest
+ */
+export type ListTagsRequest = Message<"events.v1.ListTagsRequest"> & {
   /**
-   * @generated from field: optional string notes = 3;
+   * @generated from field: int32 page = 1;
    */
-  notes?: string;
+  page: number;
+
+  /**
+   * @generated from field: int32 limit = 2;
+   */
+  limit: number;
+
+  /**
+   * @generated from field: events.v1.TagSortBy sort_by = 3;
+   */
+  sortBy: TagSortBy;
+
+  /**
+   * Only tags used by at least this many events
+   *
+   * @generated from field: optional int32 min_event_count = 4;
+   */
+  minEventCount?: number;
 };
 
 /**
- * Describes the message events.v1.MarkAttendanceRequest.
- * Use `create(MarkAttendanceRequestSchema)` to create a new message.
+ * Describes the message events.v1.ListTagsRequest.
+ * Use `create(ListTagsRequestSchema)` to create a new message.
  */
-export const MarkAttendanceRequestSchema: GenMessage<MarkAttendanceRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 68);
+export const ListTagsRequestSchema: GenMessage<ListTagsRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 70);
 
 /**
- * @generated from message events.v1.MarkAttendanceResponse
+ * @generated from message events.v1.ListTagsResponse
  */
-export type MarkAttendanceResponse = Message<"events.v1.MarkAttendanceResponse"> & {
+export type ListTagsResponse = Message<"events.v1.ListTagsResponse"> & {
   /**
-   * @generated from field: events.v1.EventAttendance attendance = 1;
+   * @generated from field: repeated events.v1.Tag tags = 1;
    */
-  attendance?: EventAttendance;
+  tags: Tag[];
+
+  /**
+   * @generated from field: int32 total = 2;
+   */
+  total: number;
 };
 
 /**
- * Describes the message events.v1.MarkAttendanceResponse.
- * Use `create(MarkAttendanceResponseSchema)` to create a new message.
+ * Describes the message events.v1.ListTagsResponse.
+ * Use `create(ListTagsResponseSchema)` to create a new message.
  */
-export const MarkAttendanceResponseSchema: GenMessage<MarkAttendanceResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 69);
+export const ListTagsResponseSchema: GenMessage<ListTagsResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 71);
 
 /**
- * @generated from message events.v1.GetEventAttendanceRequest
+ * @generated from message events.v1.UpdateTagRequest
  */
-export type GetEventAttendanceRequest = Message<"events.v1.GetEventAttendanceRequest"> & {
+export type UpdateTagRequest = Message<"events.v1.UpdateTagRequest"> & {
   /**
-   * @generated from field: int32 event_id = 1;
+   * @generated from field: int32 id = 1;
    */
-  eventId: number;
+  id: number;
+
+  /**
+   * @generated from field: optional string name = 2;
+   */
+  name?: string;
 };
 
 /**
- * Describes the message events.v1.GetEventAttendanceRequest.
- * Use `create(GetEventAttendanceRequestSchema)` to create a new message.
+ * Describes the message events.v1.UpdateTagRequest.
+ * Use `create(UpdateTagRequestSchema)` to create a new message.
  */
-export const GetEventAttendanceRequestSchema: GenMessage<GetEventAttendanceRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 70);
+export const UpdateTagRequestSchema: GenMessage<UpdateTagRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 72);
 
 /**
- * @generated from message events.v1.GetEventAttendanceResponse
+ * @generated from message events.v1.UpdateTagResponse
  */
-export type GetEventAttendanceResponse = Message<"events.v1.GetEventAttendanceResponse"> & {
+export type UpdateTagResponse = Message<"events.v1.UpdateTagResponse"> & {
   /**
-   * @generated from field: repeated events.v1.EventAttendance attendance = 1;
+   * @generated from field: events.v1.Tag tag = 1;
    */
-  attendance: EventAttendance[];
+  tag?: Tag;
+};
+
+/**
+ * Describes the message events.v1.UpdateTagResponse.
+ * Use `create(UpdateTagResponseSchema)` to create a new message.
+ */
+export const UpdateTagResponseSchema: GenMessage<UpdateTagResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 73);
+
+/**
+ * @generated from message events.v1.DeleteTagRequest
+ */
+export type DeleteTagRequest = Message<"events.v1.DeleteTagRequest"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+};
+
+/**
+ * Describes the message events.v1.DeleteTagRequest.
+ * Use `create(DeleteTagRequestSchema)` to create a new message.
+ */
+export const DeleteTagRequestSchema: GenMessage<DeleteTagRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 74);
 
+/**
+ * @generated from message events.v1.DeleteTagResponse
+ */
+export type DeleteTagResponse = Message<"events.v1.DeleteTagResponse"> & {
   /**
-   * @generated from field: int32 total_registered = 2;
+   * @generated from field: bool success = 1;
    */
-  totalRegistered: number;
+  success: boolean;
+};
+
+/**
+ * Describes the message events.v1.DeleteTagResponse.
+ * Use `create(DeleteTagResponseSchema)` to create a new message.
+ */
+export const DeleteTagResponseSchema: GenMessage<DeleteTagResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 75);
+
+/**
+ * Moves every event from the source tag to the target tag, then deletes the source
+ *
+ * @generated from message events.v1.MergeTagsRequest
+ */
+export type MergeTagsRequest = Message<"events.v1.MergeTagsRequest"> & {
+  /**
+   * @generated from field: int32 source_tag_id = 1;
+   */
+  sourceTagId: number;
 
   /**
-   * @generated from field: int32 total_attended = 3;
+   * @generated from field: int32 target_tag_id = 2;
    */
-  totalAttended: number;
+  targetTagId: number;
+};
+
+/**
+ * Describes the message events.v1.MergeTagsRequest.
+ * Use `create(MergeTagsRequestSchema)` to create a new message.
+ */
+export const MergeTagsRequestSchema: GenMessage<MergeTagsRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 76);
 
+/**
+ * @generated from message events.v1.MergeTagsResponse
+ */
+export type MergeTagsResponse = Message<"events.v1.MergeTagsResponse"> & {
   /**
-   * @generated from field: int32 total_no_show = 4;
+   * @generated from field: events.v1.Tag target_tag = 1;
    */
-  totalNoShow: number;
+  targetTag?: Tag;
+
+  /**
+   * Events that had the source tag
+   *
+   * @generated from field: int32 events_affected = 2;
+   */
+  eventsAffected: number;
 };
 
 /**
- * Describes the message events.v1.GetEventAttendanceResponse.
- * Use `create(GetEventAttendanceResponseSchema)` to create a new message.
+ * Describes the message events.v1.MergeTagsResponse.
+ * Use `create(MergeTagsResponseSchema)` to create a new message.
  */
-export const GetEventAttendanceResponseSchema: GenMessage<GetEventAttendanceResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 71);
+export const MergeTagsResponseSchema: GenMessage<MergeTagsResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 77);
 
 /**
- * Statistics messages
+ * Additional request/response messages for custom methods
  *
- * @generated from message events.v1.GetDashboardStatisticsRequest
+ * @generated from message events.v1.GetPublishableOrganizationsRequest
  */
-export type GetDashboardStatisticsRequest = Message<"events.v1.GetDashboardStatisticsRequest"> & {
+export type GetPublishableOrganizationsRequest = Message<"events.v1.GetPublishableOrganizationsRequest"> & {
+  /**
+   * @generated from field: int32 user_id = 1;
+   */
+  userId: number;
 };
 
 /**
- * Describes the message events.v1.GetDashboardStatisticsRequest.
- * Use `create(GetDashboardStatisticsRequestSchema)` to create a new message.
+ * Describes the message events.v1.GetPublishableOrganizationsRequest.
+ * Use `create(GetPublishableOrganizationsRequestSchema)` to create a new message.
  */
-export const GetDashboardStatisticsRequestSchema: GenMessage<GetDashboardStatisticsRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 72);
+export const GetPublishableOrganizationsRequestSchema: GenMessage<GetPublishableOrganizationsRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 78);
+
+/**
+ * @generated from message events.v1.GetPublishableOrganizationsResponse
+ */
+export type GetPublishableOrganizationsResponse = Message<"events.v1.GetPublishableOrganizationsResponse"> & {
+  /**
+   * @generated from field: repeated events.v1.Organization organizations = 1;
+   */
+  organizations: Organization[];
+};
+
+/**
+ * Describes the message events.v1.GetPublishableOrganizationsResponse.
+ * Use `create(GetPublishableOrganizationsResponseSchema)` to create a new message.
+ */
+export const GetPublishableOrganizationsResponseSchema: GenMessage<GetPublishableOrganizationsResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 79);
+
+/**
+ * @generated from message events.v1.GetUserOrganizationsRequest
+ */
+export type GetUserOrganizationsRequest = Message<"events.v1.GetUserOrganizationsRequest"> & {
+  /**
+   * @generated from field: int32 user_id = 1;
+   */
+  userId: number;
+};
+
+/**
+ * Describes the message events.v1.GetUserOrganizationsRequest.
+ * Use `create(GetUserOrganizationsRequestSchema)` to create a new message.
+ */
+export const GetUserOrganizationsRequestSchema: GenMessage<GetUserOrganizationsRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 80);
+
+/**
+ * @generated from message events.v1.GetUserOrganizationsResponse
+ */
+export type GetUserOrganizationsResponse = Message<"events.v1.GetUserOrganizationsResponse"> & {
+  /**
+   * @generated from field: repeated events.v1.Organization organizations = 1;
+   */
+  organizations: Organization[];
+};
+
+/**
+ * Describes the message events.v1.GetUserOrganizationsResponse.
+ * Use `create(GetUserOrganizationsResponseSchema)` to create a new message.
+ */
+export const GetUserOrganizationsResponseSchema: GenMessage<GetUserOrganizationsResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 81);
+
+/**
+ * @generated from message events.v1.GetEventsByTagIdRequest
+ */
+export type GetEventsByTagIdRequest = Message<"events.v1.GetEventsByTagIdRequest"> & {
+  /**
+   * @generated from field: int32 tag_id = 1;
+   */
+  tagId: number;
+};
+
+/**
+ * Describes the message events.v1.GetEventsByTagIdRequest.
+ * Use `create(GetEventsByTagIdRequestSchema)` to create a new message.
+ */
+export const GetEventsByTagIdRequestSchema: GenMessage<GetEventsByTagIdRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 82);
+
+/**
+ * @generated from message events.v1.GetEventsByTagIdResponse
+ */
+export type GetEventsByTagIdResponse = Message<"events.v1.GetEventsByTagIdResponse"> & {
+  /**
+   * @generated from field: repeated events.v1.Event events = 1;
+   */
+  events: Event[];
+};
+
+/**
+ * Describes the message events.v1.GetEventsByTagIdResponse.
+ * Use `create(GetEventsByTagIdResponseSchema)` to create a new message.
+ */
+export const GetEventsByTagIdResponseSchema: GenMessage<GetEventsByTagIdResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 83);
+
+/**
+ * Returns events that carry every one of the given tags
+ *
+ * @generated from message events.v1.GetEventsByAllTagsRequest
+ */
+export type GetEventsByAllTagsRequest = Message<"events.v1.GetEventsByAllTagsRequest"> & {
+  /**
+   * 1 to 10 tag IDs
+   *
+   * @generated from field: repeated int32 tag_ids = 1;
+   */
+  tagIds: number[];
+
+  /**
+   * @generated from field: int32 page = 2;
+   */
+  page: number;
+
+  /**
+   * @generated from field: int32 limit = 3;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message events.v1.GetEventsByAllTagsRequest.
+ * Use `create(GetEventsByAllTagsRequestSchema)` to create a new message.
+ */
+export const GetEventsByAllTagsRequestSchema: GenMessage<GetEventsByAllTagsRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 84);
+
+/**
+ * @generated from message events.v1.GetEventsByAllTagsResponse
+ */
+export type GetEventsByAllTagsResponse = Message<"events.v1.GetEventsByAllTagsResponse"> & {
+  /**
+   * @generated from field: repeated events.v1.Event events = 1;
+   */
+  events: Event[];
+
+  /**
+   * @generated from field: int32 total = 2;
+   */
+  total: number;
+};
+
+/**
+ * Describes the message events.v1.GetEventsByAllTagsResponse.
+ * Use `create(GetEventsByAllTagsResponseSchema)` to create a new message.
+ */
+export const GetEventsByAllTagsResponseSchema: GenMessage<GetEventsByAllTagsResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 85);
+
+/**
+ * @generated from message events.v1.GetManagedEventsRequest
+ */
+export type GetManagedEventsRequest = Message<"events.v1.GetManagedEventsRequest"> & {
+  /**
+   * Local user ID, 0 = the caller
+   *
+   * @generated from field: int32 user_id = 1;
+   */
+  userId: number;
+
+  /**
+   * @generated from field: int32 page = 2;
+   */
+  page: number;
+
+  /**
+   * @generated from field: int32 limit = 3;
+   */
+  limit: number;
+
+  /**
+   * @generated from field: events.v1.ManagedEventRole role_filter = 4;
+   */
+  roleFilter: ManagedEventRole;
+};
+
+/**
+ * Describes the message events.v1.GetManagedEventsRequest.
+ * Use `create(GetManagedEventsRequestSchema)` to create a new message.
+ */
+export const GetManagedEventsRequestSchema: GenMessage<GetManagedEventsRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 86);
+
+/**
+ * @generated from message events.v1.GetManagedEventsResponse
+ */
+export type GetManagedEventsResponse = Message<"events.v1.GetManagedEventsResponse"> & {
+  /**
+   * @generated from field: repeated events.v1.Event events = 1;
+   */
+  events: Event[];
+
+  /**
+   * @generated from field: int32 total = 2;
+   */
+  total: number;
+};
+
+/**
+ * Describes the message events.v1.GetManagedEventsResponse.
+ * Use `create(GetManagedEventsResponseSchema)` to create a new message.
+ */
+export const GetManagedEventsResponseSchema: GenMessage<GetManagedEventsResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 87);
+
+/**
+ * @generated from message events.v1.GetEventFeedRequest
+ */
+export type GetEventFeedRequest = Message<"events.v1.GetEventFeedRequest"> & {
+  /**
+   * next_cursor of the previous page, unset for the first page
+   *
+   * @generated from field: optional string cursor = 1;
+   */
+  cursor?: string;
+
+  /**
+   * @generated from field: int32 limit = 2;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message events.v1.GetEventFeedRequest.
+ * Use `create(GetEventFeedRequestSchema)` to create a new message.
+ */
+export const GetEventFeedRequestSchema: GenMessage<GetEventFeedRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 88);
+
+/**
+ * @generated from message events.v1.FeedEvent
+ */
+export type FeedEvent = Message<"events.v1.FeedEvent"> & {
+  /**
+   * @generated from field: events.v1.Event event = 1;
+   */
+  event?: Event;
+
+  /**
+   * "followed_org" (one of the user's clubs) or "recommended" (matches the user's top tags)
+   *
+   * @generated from field: string source = 2;
+   */
+  source: string;
+
+  /**
+   * @generated from field: double score = 3;
+   */
+  score: number;
+};
+
+/**
+ * Describes the message events.v1.FeedEvent.
+ * Use `create(FeedEventSchema)` to create a new message.
+ */
+export const FeedEventSchema: GenMessage<FeedEvent> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 89);
+
+/**
+ * @generated from message events.v1.GetEventFeedResponse
+ */
+export type GetEventFeedResponse = Message<"events.v1.GetEventFeedResponse"> & {
+  /**
+   * @generated from field: repeated events.v1.FeedEvent events = 1;
+   */
+  events: FeedEvent[];
+
+  /**
+   * Unset on the last page
+   *
+   * @generated from field: optional string next_cursor = 2;
+   */
+  nextCursor?: string;
+};
+
+/**
+ * Describes the message events.v1.GetEventFeedResponse.
+ * Use `create(GetEventFeedResponseSchema)` to create a new message.
+ */
+export const GetEventFeedResponseSchema: GenMessage<GetEventFeedResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 90);
+
+/**
+ * Just enough of an event to render it in a calendar cell
+ *
+ * @generated from message events.v1.EventSummary
+ */
+export type EventSummary = Message<"events.v1.EventSummary"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string start_time = 3;
+   */
+  startTime: string;
+
+  /**
+   * @generated from field: events.v1.EventFormat format = 4;
+   */
+  format: EventFormat;
+
+  /**
+   * @generated from field: int32 organization_id = 5;
+   */
+  organizationId: number;
+};
+
+/**
+ * Describes the message events.v1.EventSummary.
+ * Use `create(EventSummarySchema)` to create a new message.
+ */
+export const EventSummarySchema: GenMessage<EventSummary> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 91);
+
+/**
+ * @generated from message events.v1.GetEventCalendarRequest
+ */
+export type GetEventCalendarRequest = Message<"events.v1.GetEventCalendarRequest"> & {
+  /**
+   * @generated from field: int32 year = 1;
+   */
+  year: number;
+
+  /**
+   * 1-12
+   *
+   * @generated from field: int32 month = 2;
+   */
+  month: number;
+
+  /**
+   * Only this club's events
+   *
+   * @generated from field: optional int32 organization_id = 3;
+   */
+  organizationId?: number;
+};
+
+/**
+ * Describes the message events.v1.GetEventCalendarRequest.
+ * Use `create(GetEventCalendarRequestSchema)` to create a new message.
+ */
+export const GetEventCalendarRequestSchema: GenMessage<GetEventCalendarRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 92);
+
+/**
+ * @generated from message events.v1.CalendarDay
+ */
+export type CalendarDay = Message<"events.v1.CalendarDay"> & {
+  /**
+   * YYYY-MM-DD
+   *
+   * @generated from field: string date = 1;
+   */
+  date: string;
+
+  /**
+   * @generated from field: int32 event_count = 2;
+   */
+  eventCount: number;
+
+  /**
+   * The first 5 by start time
+   *
+   * @generated from field: repeated events.v1.EventSummary events = 3;
+   */
+  events: EventSummary[];
+};
+
+/**
+ * Describes the message events.v1.CalendarDay.
+ * Use `create(CalendarDaySchema)` to create a new message.
+ */
+export const CalendarDaySchema: GenMessage<CalendarDay> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 93);
+
+/**
+ * Only days with at least one published event starting on them, in order
+ *
+ * @generated from message events.v1.GetEventCalendarResponse
+ */
+export type GetEventCalendarResponse = Message<"events.v1.GetEventCalendarResponse"> & {
+  /**
+   * @generated from field: repeated events.v1.CalendarDay days = 1;
+   */
+  days: CalendarDay[];
+};
+
+/**
+ * Describes the message events.v1.GetEventCalendarResponse.
+ * Use `create(GetEventCalendarResponseSchema)` to create a new message.
+ */
+export const GetEventCalendarResponseSchema: GenMessage<GetEventCalendarResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 94);
+
+/**
+ * @generated from message events.v1.GetUserSubscribedEventsRequest
+ */
+export type GetUserSubscribedEventsRequest = Message<"events.v1.GetUserSubscribedEventsRequest"> & {
+  /**
+   * @generated from field: int32 user_id = 1;
+   */
+  userId: number;
+
+  /**
+   * @generated from field: int32 page = 2;
+   */
+  page: number;
+
+  /**
+   * 0 returns all events
+   *
+   * @generated from field: int32 limit = 3;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message events.v1.GetUserSubscribedEventsRequest.
+ * Use `create(GetUserSubscribedEventsRequestSchema)` to create a new message.
+ */
+export const GetUserSubscribedEventsRequestSchema: GenMessage<GetUserSubscribedEventsRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 95);
+
+/**
+ * @generated from message events.v1.GetUserSubscribedEventsResponse
+ */
+export type GetUserSubscribedEventsResponse = Message<"events.v1.GetUserSubscribedEventsResponse"> & {
+  /**
+   * @generated from field: repeated events.v1.Event events = 1;
+   */
+  events: Event[];
+
+  /**
+   * @generated from field: int32 total = 2;
+   */
+  total: number;
+};
+
+/**
+ * Describes the message events.v1.GetUserSubscribedEventsResponse.
+ * Use `create(GetUserSubscribedEventsResponseSchema)` to create a new message.
+ */
+export const GetUserSubscribedEventsResponseSchema: GenMessage<GetUserSubscribedEventsResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 96);
+
+/**
+ * @generated from message events.v1.ListEventsForAdminRequest
+ */
+export type ListEventsForAdminRequest = Message<"events.v1.ListEventsForAdminRequest"> & {
+  /**
+   * @generated from field: int32 page = 1;
+   */
+  page: number;
+
+  /**
+   * @generated from field: int32 limit = 2;
+   */
+  limit: number;
+
+  /**
+   * @generated from field: optional int32 user_id = 3;
+   */
+  userId?: number;
+
+  /**
+   * @generated from field: optional int32 organization_id = 4;
+   */
+  organizationId?: number;
+
+  /**
+   * Same as ListEventsRequest.cursor
+   *
+   * @generated from field: optional string cursor = 5;
+   */
+  cursor?: string;
+};
+
+/**
+ * Describes the message events.v1.ListEventsForAdminRequest.
+ * Use `create(ListEventsForAdminRequestSchema)` to create a new message.
+ */
+export const ListEventsForAdminRequestSchema: GenMessage<ListEventsForAdminRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 97);
+
+/**
+ * @generated from message events.v1.ListEventsForAdminResponse
+ */
+export type ListEventsForAdminResponse = Message<"events.v1.ListEventsForAdminResponse"> & {
+  /**
+   * @generated from field: repeated events.v1.Event events = 1;
+   */
+  events: Event[];
+
+  /**
+   * @generated from field: int32 total = 2;
+   */
+  total: number;
+
+  /**
+   * @generated from field: optional string next_cursor = 3;
+   */
+  nextCursor?: string;
+};
+
+/**
+ * Describes the message events.v1.ListEventsForAdminResponse.
+ * Use `create(ListEventsForAdminResponseSchema)` to create a new message.
+ */
+export const ListEventsForAdminResponseSchema: GenMessage<ListEventsForAdminResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 98);
+
+/**
+ * Event Registration messages
+ *
+ * @generated from message events.v1.RegisterForEventRequest
+ */
+export type RegisterForEventRequest = Message<"events.v1.RegisterForEventRequest"> & {
+  /**
+   * @generated from field: int32 event_id = 1;
+   */
+  eventId: number;
+
+  /**
+   * @generated from field: int32 user_id = 2;
+   */
+  userId: number;
+};
+
+/**
+ * Describes the message events.v1.RegisterForEventRequest.
+ * Use `create(RegisterForEventRequestSchema)` to create a new message.
+ */
+export const RegisterForEventRequestSchema: GenMessage<RegisterForEventRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 99);
+
+/**
+ * @generated from message events.v1.RegisterForEventResponse
+ */
+export type RegisterForEventResponse = Message<"events.v1.RegisterForEventResponse"> & {
+  /**
+   * Status WAITLIST when the event is full
+   *
+   * @generated from field: events.v1.EventRegistration registration = 1;
+   */
+  registration?: EventRegistration;
+
+  /**
+   * Single-use self-service cancellation link for the confirmation email
+   *
+   * @generated from field: optional string cancel_url = 2;
+   */
+  cancelUrl?: string;
+};
+
+/**
+ * Describes the message events.v1.RegisterForEventResponse.
+ * Use `create(RegisterForEventResponseSchema)` to create a new message.
+ */
+export const RegisterForEventResponseSchema: GenMessage<RegisterForEventResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 100);
+
+/**
+ * @generated from message events.v1.CancelRegistrationRequest
+ */
+export type CancelRegistrationRequest = Message<"events.v1.CancelRegistrationRequest"> & {
+  /**
+   * @generated from field: int32 registration_id = 1;
+   */
+  registrationId: number;
+};
+
+/**
+ * Describes the message events.v1.CancelRegistrationRequest.
+ * Use `create(CancelRegistrationRequestSchema)` to create a new message.
+ */
+export const CancelRegistrationRequestSchema: GenMessage<CancelRegistrationRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 101);
+
+/**
+ * @generated from message events.v1.CancelRegistrationResponse
+ */
+export type CancelRegistrationResponse = Message<"events.v1.CancelRegistrationResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+};
+
+/**
+ * Describes the message events.v1.CancelRegistrationResponse.
+ * Use `create(CancelRegistrationResponseSchema)` to create a new message.
+ */
+export const CancelRegistrationResponseSchema: GenMessage<CancelRegistrationResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 102);
+
+/**
+ * @generated from message events.v1.GetEventRegistrationsRequest
+ */
+export type GetEventRegistrationsRequest = Message<"events.v1.GetEventRegistrationsRequest"> & {
+  /**
+   * @generated from field: int32 event_id = 1;
+   */
+  eventId: number;
+
+  /**
+   * @generated from field: optional int32 page = 2;
+   */
+  page?: number;
+
+  /**
+   * @generated from field: optional int32 limit = 3;
+   */
+  limit?: number;
+};
+
+/**
+ * Describes the message events.v1.GetEventRegistrationsRequest.
+ * Use `create(GetEventRegistrationsRequestSchema)` to create a new message.
+ */
+export const GetEventRegistrationsRequestSchema: GenMessage<GetEventRegistrationsRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 103);
+
+/**
+ * @generated from message events.v1.GetEventRegistrationsResponse
+ */
+export type GetEventRegistrationsResponse = Message<"events.v1.GetEventRegistrationsResponse"> & {
+  /**
+   * @generated from field: repeated events.v1.EventRegistration registrations = 1;
+   */
+  registrations: EventRegistration[];
+
+  /**
+   * @generated from field: int32 total = 2;
+   */
+  total: number;
+};
+
+/**
+ * Describes the message events.v1.GetEventRegistrationsResponse.
+ * Use `create(GetEventRegistrationsResponseSchema)` to create a new message.
+ */
+export const GetEventRegistrationsResponseSchema: GenMessage<GetEventRegistrationsResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 104);
+
+/**
+ * @generated from message events.v1.GetUserRegistrationsRequest
+ */
+export type GetUserRegistrationsRequest = Message<"events.v1.GetUserRegistrationsRequest"> & {
+  /**
+   * @generated from field: int32 user_id = 1;
+   */
+  userId: number;
+
+  /**
+   * @generated from field: int32 page = 2;
+   */
+  page: number;
+
+  /**
+   * 0 returns all registrations
+   *
+   * @generated from field: int32 limit = 3;
+   */
+  limit: number;
+
+  /**
+   * Registered, cancelled or waitlist only
+   *
+   * @generated from field: optional events.v1.RegistrationStatus status = 4;
+   */
+  status?: RegistrationStatus;
+
+  /**
+   * Populate registrations[].event
+   *
+   * @generated from field: bool include_event = 5;
+   */
+  includeEvent: boolean;
+};
+
+/**
+ * Describes the message events.v1.GetUserRegistrationsRequest.
+ * Use `create(GetUserRegistrationsRequestSchema)` to create a new message.
+ */
+export const GetUserRegistrationsRequestSchema: GenMessage<GetUserRegistrationsRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 105);
+
+/**
+ * @generated from message events.v1.GetUserRegistrationsResponse
+ */
+export type GetUserRegistrationsResponse = Message<"events.v1.GetUserRegistrationsResponse"> & {
+  /**
+   * @generated from field: repeated events.v1.EventRegistration registrations = 1;
+   */
+  registrations: EventRegistration[];
+
+  /**
+   * @generated from field: int32 total = 2;
+   */
+  total: number;
+};
+
+/**
+ * Describes the message events.v1.GetUserRegistrationsResponse.
+ * Use `create(GetUserRegistrationsResponseSchema)` to create a new message.
+ */
+export const GetUserRegistrationsResponseSchema: GenMessage<GetUserRegistrationsResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 106);
+
+/**
+ * A temporary reservation of one seat, released when it expires
+ *
+ * @generated from message events.v1.RegistrationHold
+ */
+export type RegistrationHold = Message<"events.v1.RegistrationHold"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: int32 event_id = 2;
+   */
+  eventId: number;
+
+  /**
+   * @generated from field: int32 user_id = 3;
+   */
+  userId: number;
+
+  /**
+   * @generated from field: string expires_at = 4;
+   */
+  expiresAt: string;
+
+  /**
+   * @generated from field: string created_at = 5;
+   */
+  createdAt: string;
+};
+
+/**
+ * Describes the message events.v1.RegistrationHold.
+ * Use `create(RegistrationHoldSchema)` to create a new message.
+ */
+export const RegistrationHoldSchema: GenMessage<RegistrationHold> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 107);
+
+/**
+ * @generated from message events.v1.HoldEventRegistrationRequest
+ */
+export type HoldEventRegistrationRequest = Message<"events.v1.HoldEventRegistrationRequest"> & {
+  /**
+   * @generated from field: int32 event_id = 1;
+   */
+  eventId: number;
+
+  /**
+   * Optional, holds are always for the caller
+   *
+   * @generated from field: int32 user_id = 2;
+   */
+  userId: number;
+
+  /**
+   * Defaults to 300, capped at 3600
+   *
+   * @generated from field: int32 hold_duration_seconds = 3;
+   */
+  holdDurationSeconds: number;
+};
+
+/**
+ * Describes the message events.v1.HoldEventRegistrationRequest.
+ * Use `create(HoldEventRegistrationRequestSchema)` to create a new message.
+ */
+export const HoldEventRegistrationRequestSchema: GenMessage<HoldEventRegistrationRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 108);
+
+/**
+ * @generated from message events.v1.HoldEventRegistrationResponse
+ */
+export type HoldEventRegistrationResponse = Message<"events.v1.HoldEventRegistrationResponse"> & {
+  /**
+   * @generated from field: events.v1.RegistrationHold hold = 1;
+   */
+  hold?: RegistrationHold;
+
+  /**
+   * Remaining after this hold, -1 when unlimited
+   *
+   * @generated from field: int32 available_slots = 2;
+   */
+  availableSlots: number;
+};
+
+/**
+ * Describes the message events.v1.HoldEventRegistrationResponse.
+ * Use `create(HoldEventRegistrationResponseSchema)` to create a new message.
+ */
+export const HoldEventRegistrationResponseSchema: GenMessage<HoldEventRegistrationResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 109);
+
+/**
+ * @generated from message events.v1.ConfirmRegistrationRequest
+ */
+export type ConfirmRegistrationRequest = Message<"events.v1.ConfirmRegistrationRequest"> & {
+  /**
+   * @generated from field: int32 hold_id = 1;
+   */
+  holdId: number;
+};
+
+/**
+ * Describes the message events.v1.ConfirmRegistrationRequest.
+ * Use `create(ConfirmRegistrationRequestSchema)` to create a new message.
+ */
+export const ConfirmRegistrationRequestSchema: GenMessage<ConfirmRegistrationRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 110);
+
+/**
+ * @generated from message events.v1.ConfirmRegistrationResponse
+ */
+export type ConfirmRegistrationResponse = Message<"events.v1.ConfirmRegistrationResponse"> & {
+  /**
+   * @generated from field: events.v1.EventRegistration registration = 1;
+   */
+  registration?: EventRegistration;
+
+  /**
+   * Single-use self-service cancellation link for the confirmation email
+   *
+   * @generated from field: optional string cancel_url = 2;
+   */
+  cancelUrl?: string;
+};
+
+/**
+ * Describes the message events.v1.ConfirmRegistrationResponse.
+ * Use `create(ConfirmRegistrationResponseSchema)` to create a new message.
+ */
+export const ConfirmRegistrationResponseSchema: GenMessage<ConfirmRegistrationResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 111);
+
+/**
+ * Emails an announcement to everyone registered for an event
+ *
+ * @generated from message events.v1.NotifyRegistrantsRequest
+ */
+export type NotifyRegistrantsRequest = Message<"events.v1.NotifyRegistrantsRequest"> & {
+  /**
+   * @generated from field: int32 event_id = 1;
+   */
+  eventId: number;
+
+  /**
+   * @generated from field: string subject = 2;
+   */
+  subject: string;
+
+  /**
+   * @generated from field: string body = 3;
+   */
+  body: string;
+
+  /**
+   * Only list the recipients, send nothing
+   *
+   * @generated from field: bool dry_run = 4;
+   */
+  dryRun: boolean;
+};
+
+/**
+ * Describes the message events.v1.NotifyRegistrantsRequest.
+ * Use `create(NotifyRegistrantsRequestSchema)` to create a new message.
+ */
+export const NotifyRegistrantsRequestSchema: GenMessage<NotifyRegistrantsRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 112);
+
+/**
+ * @generated from message events.v1.NotifyRegistrantsResponse
+ */
+export type NotifyRegistrantsResponse = Message<"events.v1.NotifyRegistrantsResponse"> & {
+  /**
+   * On dry_run, the number of recipients
+   *
+   * @generated from field: int32 emails_sent = 1;
+   */
+  emailsSent: number;
+
+  /**
+   * One entry per batch the relay rejected
+   *
+   * @generated from field: repeated string errors = 2;
+   */
+  errors: string[];
+
+  /**
+   * Set on dry_run only
+   *
+   * @generated from field: repeated string recipients = 3;
+   */
+  recipients: string[];
+};
+
+/**
+ * Describes the message events.v1.NotifyRegistrantsResponse.
+ * Use `create(NotifyRegistrantsResponseSchema)` to create a new message.
+ */
+export const NotifyRegistrantsResponseSchema: GenMessage<NotifyRegistrantsResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 113);
+
+/**
+ * @generated from message events.v1.GetEventRegistrationStatusRequest
+ */
+export type GetEventRegistrationStatusRequest = Message<"events.v1.GetEventRegistrationStatusRequest"> & {
+  /**
+   * @generated from field: int32 event_id = 1;
+   */
+  eventId: number;
+
+  /**
+   * @generated from field: int32 user_id = 2;
+   */
+  userId: number;
+};
+
+/**
+ * Describes the message events.v1.GetEventRegistrationStatusRequest.
+ * Use `create(GetEventRegistrationStatusRequestSchema)` to create a new message.
+ */
+export const GetEventRegistrationStatusRequestSchema: GenMessage<GetEventRegistrationStatusRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 114);
+
+/**
+ * @generated from message events.v1.GetEventRegistrationStatusResponse
+ */
+export type GetEventRegistrationStatusResponse = Message<"events.v1.GetEventRegistrationStatusResponse"> & {
+  /**
+   * @generated from field: events.v1.RegistrationStatus status = 1;
+   */
+  status: RegistrationStatus;
+
+  /**
+   * Unset when NOT_REGISTERED
+   *
+   * @generated from field: optional events.v1.EventRegistration registration = 2;
+   */
+  registration?: EventRegistration;
+};
+
+/**
+ * Describes the message events.v1.GetEventRegistrationStatusResponse.
+ * Use `create(GetEventRegistrationStatusResponseSchema)` to create a new message.
+ */
+export const GetEventRegistrationStatusResponseSchema: GenMessage<GetEventRegistrationStatusResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 115);
+
+/**
+ * Attendance messages
+ *
+ * @generated from message events.v1.CheckInAttendeeRequest
+ */
+export type CheckInAttendeeRequest = Message<"events.v1.CheckInAttendeeRequest"> & {
+  /**
+   * @generated from field: int32 registration_id = 1;
+   */
+  registrationId: number;
+
+  /**
+   * @generated from field: int32 checked_in_by = 2;
+   */
+  checkedInBy: number;
+
+  /**
+   * @generated from field: optional string notes = 3;
+   */
+  notes?: string;
+};
+
+/**
+ * Describes the message events.v1.CheckInAttendeeRequest.
+ * Use `create(CheckInAttendeeRequestSchema)` to create a new message.
+ */
+export const CheckInAttendeeRequestSchema: GenMessage<CheckInAttendeeRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 116);
+
+/**
+ * @generated from message events.v1.CheckInAttendeeResponse
+ */
+export type CheckInAttendeeResponse = Message<"events.v1.CheckInAttendeeResponse"> & {
+  /**
+   * @generated from field: events.v1.EventAttendance attendance = 1;
+   */
+  attendance?: EventAttendance;
+};
+
+/**
+ * Describes the message events.v1.CheckInAttendeeResponse.
+ * Use `create(CheckInAttendeeResponseSchema)` to create a new message.
+ */
+export const CheckInAttendeeResponseSchema: GenMessage<CheckInAttendeeResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 117);
+
+/**
+ * @generated from message events.v1.BulkCheckInRequest
+ */
+export type BulkCheckInRequest = Message<"events.v1.BulkCheckInRequest"> & {
+  /**
+   * @generated from field: repeated int32 registration_ids = 1;
+   */
+  registrationIds: number[];
+
+  /**
+   * @generated from field: int32 checked_in_by = 2;
+   */
+  checkedInBy: number;
+};
+
+/**
+ * Describes the message events.v1.BulkCheckInRequest.
+ * Use `create(BulkCheckInRequestSchema)` to create a new message.
+ */
+export const BulkCheckInRequestSchema: GenMessage<BulkCheckInRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 118);
+
+/**
+ * @generated from message events.v1.BulkCheckInFailure
+ */
+export type BulkCheckInFailure = Message<"events.v1.BulkCheckInFailure"> & {
+  /**
+   * @generated from field: int32 registration_id = 1;
+   */
+  registrationId: number;
+
+  /**
+   * @generated from field: string reason = 2;
+   */
+  reason: string;
+};
+
+/**
+ * Describes the message events.v1.BulkCheckInFailure.
+ * Use `create(BulkCheckInFailureSchema)` to create a new message.
+ */
+export const BulkCheckInFailureSchema: GenMessage<BulkCheckInFailure> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 119);
+
+/**
+ * @generated from message events.v1.BulkCheckInResponse
+ */
+export type BulkCheckInResponse = Message<"events.v1.BulkCheckInResponse"> & {
+  /**
+   * @generated from field: repeated int32 succeeded = 1;
+   */
+  succeeded: number[];
+
+  /**
+   * @generated from field: repeated events.v1.BulkCheckInFailure failed = 2;
+   */
+  failed: BulkCheckInFailure[];
+};
+
+/**
+ * Describes the message events.v1.BulkCheckInResponse.
+ * Use `create(BulkCheckInResponseSchema)` to create a new message.
+ */
+export const BulkCheckInResponseSchema: GenMessage<BulkCheckInResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 120);
+
+/**
+ * @generated from message events.v1.MarkAttendanceRequest
+ */
+export type MarkAttendanceRequest = Message<"events.v1.MarkAttendanceRequest"> & {
+  /**
+   * @generated from field: int32 registration_id = 1;
+   */
+  registrationId: number;
+
+  /**
+   * @generated from field: events.v1.AttendanceStatus status = 2;
+   */
+  status: AttendanceStatus;
+
+  /**
+   * @generated from field: optional string notes = 3;
+   */
+  notes?: string;
+};
+
+/**
+ * Describes the message events.v1.MarkAttendanceRequest.
+ * Use `create(MarkAttendanceRequestSchema)` to create a new message.
+ */
+export const MarkAttendanceRequestSchema: GenMessage<MarkAttendanceRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 121);
+
+/**
+ * @generated from message events.v1.MarkAttendanceResponse
+ */
+export type MarkAttendanceResponse = Message<"events.v1.MarkAttendanceResponse"> & {
+  /**
+   * @generated from field: events.v1.EventAttendance attendance = 1;
+   */
+  attendance?: EventAttendance;
+};
+
+/**
+ * Describes the message events.v1.MarkAttendanceResponse.
+ * Use `create(MarkAttendanceResponseSchema)` to create a new message.
+ */
+export const MarkAttendanceResponseSchema: GenMessage<MarkAttendanceResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 122);
+
+/**
+ * @generated from message events.v1.GetEventAttendanceRequest
+ */
+export type GetEventAttendanceRequest = Message<"events.v1.GetEventAttendanceRequest"> & {
+  /**
+   * @generated from field: int32 event_id = 1;
+   */
+  eventId: number;
+};
+
+/**
+ * Describes the message events.v1.GetEventAttendanceRequest.
+ * Use `create(GetEventAttendanceRequestSchema)` to create a new message.
+ */
+export const GetEventAttendanceRequestSchema: GenMessage<GetEventAttendanceRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 123);
+
+/**
+ * @generated from message events.v1.GetEventAttendanceResponse
+ */
+export type GetEventAttendanceResponse = Message<"events.v1.GetEventAttendanceResponse"> & {
+  /**
+   * @generated from field: repeated events.v1.EventAttendance attendance = 1;
+   */
+  attendance: EventAttendance[];
+
+  /**
+   * @generated from field: int32 total_registered = 2;
+   */
+  totalRegistered: number;
+
+  /**
+   * @generated from field: int32 total_attended = 3;
+   */
+  totalAttended: number;
+
+  /**
+   * @generated from field: int32 total_no_show = 4;
+   */
+  totalNoShow: number;
+};
+
+/**
+ * Describes the message events.v1.GetEventAttendanceResponse.
+ * Use `create(GetEventAttendanceResponseSchema)` to create a new message.
+ */
+export const GetEventAttendanceResponseSchema: GenMessage<GetEventAttendanceResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 124);
+
+/**
+ * Live feed of an event's check-ins and attendance changes, starting from
+ * when the stream opens. Use GetEventAttendance for the current state.
+ *
+ * @generated from message events.v1.StreamEventAttendanceRequest
+ */
+export type StreamEventAttendanceRequest = Message<"events.v1.StreamEventAttendanceRequest"> & {
+  /**
+   * @generated from field: int32 event_id = 1;
+   */
+  eventId: number;
+};
+
+/**
+ * Describes the message events.v1.StreamEventAttendanceRequest.
+ * Use `create(StreamEventAttendanceRequestSchema)` to create a new message.
+ */
+export const StreamEventAttendanceRequestSchema: GenMessage<StreamEventAttendanceRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 125);
+
+/**
+ * @generated from message events.v1.StreamEventAttendanceResponse
+ */
+export type StreamEventAttendanceResponse = Message<"events.v1.StreamEventAttendanceResponse"> & {
+  /**
+   * @generated from field: events.v1.EventAttendance attendance = 1;
+   */
+  attendance?: EventAttendance;
+};
+
+/**
+ * Describes the message events.v1.StreamEventAttendanceResponse.
+ * Use `create(StreamEventAttendanceResponseSchema)` to create a new message.
+ */
+export const StreamEventAttendanceResponseSchema: GenMessage<StreamEventAttendanceResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 126);
+
+/**
+ * @generated from message events.v1.GetUserAttendanceHistoryRequest
+ */
+export type GetUserAttendanceHistoryRequest = Message<"events.v1.GetUserAttendanceHistoryRequest"> & {
+  /**
+   * @generated from field: int32 user_id = 1;
+   */
+  userId: number;
+
+  /**
+   * @generated from field: int32 page = 2;
+   */
+  page: number;
+
+  /**
+   * @generated from field: int32 limit = 3;
+   */
+  limit: number;
+
+  /**
+   * Set to page by cursor instead of page: empty for the first page, then next_cursor
+   *
+   * @generated from field: optional string cursor = 4;
+   */
+  cursor?: string;
+};
+
+/**
+ * Describes the message events.v1.GetUserAttendanceHistoryRequest.
+ * Use `create(GetUserAttendanceHistoryRequestSchema)` to create a new message.
+ */
+export const GetUserAttendanceHistoryRequestSchema: GenMessage<GetUserAttendanceHistoryRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 127);
+
+/**
+ * An event the user attended, with their check-in details
+ *
+ * @generated from message events.v1.AttendedEventSummary
+ */
+export type AttendedEventSummary = Message<"events.v1.AttendedEventSummary"> & {
+  /**
+   * @generated from field: events.v1.Event event = 1;
+   */
+  event?: Event;
+
+  /**
+   * Unset when marked attended without a check-in
+   *
+   * @generated from field: optional string checked_in_at = 2;
+   */
+  checkedInAt?: string;
+
+  /**
+   * @generated from field: optional string notes = 3;
+   */
+  notes?: string;
+};
+
+/**
+ * Describes the message events.v1.AttendedEventSummary.
+ * Use `create(AttendedEventSummarySchema)` to create a new message.
+ */
+export const AttendedEventSummarySchema: GenMessage<AttendedEventSummary> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 128);
+
+/**
+ * @generated from message events.v1.UserAttendanceHistoryResponse
+ */
+export type UserAttendanceHistoryResponse = Message<"events.v1.UserAttendanceHistoryResponse"> & {
+  /**
+   * Newest event first
+   *
+   * @generated from field: repeated events.v1.AttendedEventSummary events = 1;
+   */
+  events: AttendedEventSummary[];
+
+  /**
+   * @generated from field: int32 total = 2;
+   */
+  total: number;
+
+  /**
+   * Only in cursor mode, unset on the last page
+   *
+   * @generated from field: optional string next_cursor = 3;
+   */
+  nextCursor?: string;
+};
+
+/**
+ * Describes the message events.v1.UserAttendanceHistoryResponse.
+ * Use `create(UserAttendanceHistoryResponseSchema)` to create a new message.
+ */
+export const UserAttendanceHistoryResponseSchema: GenMessage<UserAttendanceHistoryResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 129);
+
+/**
+ * @generated from message events.v1.ExportEventAttendanceRequest
+ */
+export type ExportEventAttendanceRequest = Message<"events.v1.ExportEventAttendanceRequest"> & {
+  /**
+   * @generated from field: int32 event_id = 1;
+   */
+  eventId: number;
+};
+
+/**
+ * Describes the message events.v1.ExportEventAttendanceRequest.
+ * Use `create(ExportEventAttendanceRequestSchema)` to create a new message.
+ */
+export const ExportEventAttendanceRequestSchema: GenMessage<ExportEventAttendanceRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 130);
+
+/**
+ * Part of a CSV attendance export. Chunks split at arbitrary byte offsets,
+ * so concatenate them in order before parsing.
+ *
+ * @generated from message events.v1.AttendanceExportChunk
+ */
+export type AttendanceExportChunk = Message<"events.v1.AttendanceExportChunk"> & {
+  /**
+   * At most 64 KiB
+   *
+   * @generated from field: bytes data = 1;
+   */
+  data: Uint8Array;
+};
+
+/**
+ * Describes the message events.v1.AttendanceExportChunk.
+ * Use `create(AttendanceExportChunkSchema)` to create a new message.
+ */
+export const AttendanceExportChunkSchema: GenMessage<AttendanceExportChunk> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 131);
+
+/**
+ * Statistics messages
+ *
+ * @generated from message events.v1.GetDashboardStatisticsRequest
+ */
+export type GetDashboardStatisticsRequest = Message<"events.v1.GetDashboardStatisticsRequest"> & {
+  /**
+   * Recompute instead of reading the snapshot
+   *
+   * @generated from field: bool force_refresh = 1;
+   */
+  forceRefresh: boolean;
+};
+
+/**
+ * Describes the message events.v1.GetDashboardStatisticsRequest.
+ * Use `create(GetDashboardStatisticsRequestSchema)` to create a new message.
+ */
+export const GetDashboardStatisticsRequestSchema: GenMessage<GetDashboardStatisticsRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 132);
+
+/**
+ * @generated from message events.v1.GetDashboardStatisticsResponse
+ */
+export type GetDashboardStatisticsResponse = Message<"events.v1.GetDashboardStatisticsResponse"> & {
+  /**
+   * @generated from field: events.v1.EventStatistics statistics = 1;
+   */
+  statistics?: EventStatistics;
+};
+
+/**
+ * Describes the message events.v1.GetDashboardStatisticsResponse.
+ * Use `create(GetDashboardStatisticsResponseSchema)` to create a new message.
+ */
+export const GetDashboardStatisticsResponseSchema: GenMessage<GetDashboardStatisticsResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 133);
+
+/**
+ * @generated from message events.v1.GetEventStatisticsRequest
+ */
+export type GetEventStatisticsRequest = Message<"events.v1.GetEventStatisticsRequest"> & {
+  /**
+   * @generated from field: int32 event_id = 1;
+   */
+  eventId: number;
+};
+
+/**
+ * Describes the message events.v1.GetEventStatisticsRequest.
+ * Use `create(GetEventStatisticsRequestSchema)` to create a new message.
+ */
+export const GetEventStatisticsRequestSchema: GenMessage<GetEventStatisticsRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 134);
+
+/**
+ * @generated from message events.v1.GetEventStatisticsResponse
+ */
+export type GetEventStatisticsResponse = Message<"events.v1.GetEventStatisticsResponse"> & {
+  /**
+   * @generated from field: int32 total_registrations = 1;
+   */
+  totalRegistrations: number;
+
+  /**
+   * @generated from field: int32 total_attendees = 2;
+   */
+  totalAttendees: number;
+
+  /**
+   * @generated from field: int32 checked_in = 3;
+   */
+  checkedIn: number;
+
+  /**
+   * @generated from field: int32 no_show = 4;
+   */
+  noShow: number;
+
+  /**
+   * @generated from field: double attendance_rate = 5;
+   */
+  attendanceRate: number;
+};
+
+/**
+ * Describes the message events.v1.GetEventStatisticsResponse.
+ * Use `create(GetEventStatisticsResponseSchema)` to create a new message.
+ */
+export const GetEventStatisticsResponseSchema: GenMessage<GetEventStatisticsResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 135);
+
+/**
+ * @generated from message events.v1.TagDistribution
+ */
+export type TagDistribution = Message<"events.v1.TagDistribution"> & {
+  /**
+   * @generated from field: int32 tag_id = 1;
+   */
+  tagId: number;
+
+  /**
+   * @generated from field: string tag_name = 2;
+   */
+  tagName: string;
+
+  /**
+   * @generated from field: int32 event_count = 3;
+   */
+  eventCount: number;
+};
+
+/**
+ * Describes the message events.v1.TagDistribution.
+ * Use `create(TagDistributionSchema)` to create a new message.
+ */
+export const TagDistributionSchema: GenMessage<TagDistribution> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 136);
+
+/**
+ * @generated from message events.v1.GetEventTagsDistributionByMonthRequest
+ */
+export type GetEventTagsDistributionByMonthRequest = Message<"events.v1.GetEventTagsDistributionByMonthRequest"> & {
+  /**
+   * @generated from field: int32 year = 1;
+   */
+  year: number;
+
+  /**
+   * @generated from field: int32 month = 2;
+   */
+  month: number;
+};
+
+/**
+ * Describes the message events.v1.GetEventTagsDistributionByMonthRequest.
+ * Use `create(GetEventTagsDistributionByMonthRequestSchema)` to create a new message.
+ */
+export const GetEventTagsDistributionByMonthRequestSchema: GenMessage<GetEventTagsDistributionByMonthRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 137);
+
+/**
+ * @generated from message events.v1.GetEventTagsDistributionByMonthResponse
+ */
+export type GetEventTagsDistributionByMonthResponse = Message<"events.v1.GetEventTagsDistributionByMonthResponse"> & {
+  /**
+   * @generated from field: repeated events.v1.TagDistribution tags = 1;
+   */
+  tags: TagDistribution[];
+
+  /**
+   * @generated from field: int32 total_events = 2;
+   */
+  totalEvents: number;
+};
+
+/**
+ * Describes the message events.v1.GetEventTagsDistributionByMonthResponse.
+ * Use `create(GetEventTagsDistributionByMonthResponseSchema)` to create a new message.
+ */
+export const GetEventTagsDistributionByMonthResponseSchema: GenMessage<GetEventTagsDistributionByMonthResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 138);
+
+/**
+ * @generated from message events.v1.EventActivity
+ */
+export type EventActivity = Message<"events.v1.EventActivity"> & {
+  /**
+   * @generated from field: string date = 1;
+   */
+  date: string;
+
+  /**
+   * @generated from field: int32 count = 2;
+   */
+  count: number;
+
+  /**
+   * @generated from field: int32 level = 3;
+   */
+  level: number;
+};
+
+/**
+ * Describes the message events.v1.EventActivity.
+ * Use `create(EventActivitySchema)` to create a new message.
+ */
+export const EventActivitySchema: GenMessage<EventActivity> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 139);
+
+/**
+ * @generated from message events.v1.GetEventActivityByYearRequest
+ */
+export type GetEventActivityByYearRequest = Message<"events.v1.GetEventActivityByYearRequest"> & {
+  /**
+   * @generated from field: int32 year = 1;
+   */
+  year: number;
+};
+
+/**
+ * Describes the message events.v1.GetEventActivityByYearRequest.
+ * Use `create(GetEventActivityByYearRequestSchema)` to create a new message.
+ */
+export const GetEventActivityByYearRequestSchema: GenMessage<GetEventActivityByYearRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 140);
+
+/**
+ * @generated from message events.v1.GetEventActivityByYearResponse
+ */
+export type GetEventActivityByYearResponse = Message<"events.v1.GetEventActivityByYearResponse"> & {
+  /**
+   * @generated from field: repeated events.v1.EventActivity activities = 1;
+   */
+  activities: EventActivity[];
+
+  /**
+   * @generated from field: int32 total_events = 2;
+   */
+  totalEvents: number;
+};
+
+/**
+ * Describes the message events.v1.GetEventActivityByYearResponse.
+ * Use `create(GetEventActivityByYearResponseSchema)` to create a new message.
+ */
+export const GetEventActivityByYearResponseSchema: GenMessage<GetEventActivityByYearResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 141);
+
+/**
+ * @generated from message events.v1.EventStatsSummary
+ */
+export type EventStatsSummary = Message<"events.v1.EventStatsSummary"> & {
+  /**
+   * @generated from field: int32 total_events = 1;
+   */
+  totalEvents: number;
+
+  /**
+   * @generated from field: int32 total_registrations = 2;
+   */
+  totalRegistrations: number;
+
+  /**
+   * @generated from field: int32 total_attendees = 3;
+   */
+  totalAttendees: number;
+};
+
+/**
+ * Describes the message events.v1.EventStatsSummary.
+ * Use `create(EventStatsSummarySchema)` to create a new message.
+ */
+export const EventStatsSummarySchema: GenMessage<EventStatsSummary> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 142);
+
+/**
+ * @generated from message events.v1.GetOverallStatisticsRequest
+ */
+export type GetOverallStatisticsRequest = Message<"events.v1.GetOverallStatisticsRequest"> & {
+  /**
+   * Recompute instead of reading the snapshot
+   *
+   * @generated from field: bool force_refresh = 1;
+   */
+  forceRefresh: boolean;
+};
+
+/**
+ * Describes the message events.v1.GetOverallStatisticsRequest.
+ * Use `create(GetOverallStatisticsRequestSchema)` to create a new message.
+ */
+export const GetOverallStatisticsRequestSchema: GenMessage<GetOverallStatisticsRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 143);
+
+/**
+ * @generated from message events.v1.GetOverallStatisticsResponse
+ */
+export type GetOverallStatisticsResponse = Message<"events.v1.GetOverallStatisticsResponse"> & {
+  /**
+   * @generated from field: int32 total_events = 1;
+   */
+  totalEvents: number;
+
+  /**
+   * @generated from field: int32 total_users = 2;
+   */
+  totalUsers: number;
+
+  /**
+   * @generated from field: int32 total_organizations = 3;
+   */
+  totalOrganizations: number;
+
+  /**
+   * @generated from field: int32 total_registrations = 4;
+   */
+  totalRegistrations: number;
+
+  /**
+   * @generated from field: int32 upcoming_events = 5;
+   */
+  upcomingEvents: number;
+
+  /**
+   * @generated from field: double average_attendance_rate = 6;
+   */
+  averageAttendanceRate: number;
+
+  /**
+   * @generated from field: int32 events_this_month = 7;
+   */
+  eventsThisMonth: number;
+
+  /**
+   * @generated from field: int32 registrations_this_month = 8;
+   */
+  registrationsThisMonth: number;
+};
+
+/**
+ * Describes the message events.v1.GetOverallStatisticsResponse.
+ * Use `create(GetOverallStatisticsResponseSchema)` to create a new message.
+ */
+export const GetOverallStatisticsResponseSchema: GenMessage<GetOverallStatisticsResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 144);
+
+/**
+ * @generated from message events.v1.EventTrend
+ */
+export type EventTrend = Message<"events.v1.EventTrend"> & {
+  /**
+   * @generated from field: string date = 1;
+   */
+  date: string;
+
+  /**
+   * @generated from field: int32 event_count = 2;
+   */
+  eventCount: number;
+
+  /**
+   * @generated from field: int32 registration_count = 3;
+   */
+  registrationCount: number;
+};
+
+/**
+ * Describes the message events.v1.EventTrend.
+ * Use `create(EventTrendSchema)` to create a new message.
+ */
+export const EventTrendSchema: GenMessage<EventTrend> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 145);
+
+/**
+ * @generated from message events.v1.GetEventTrendsRequest
+ */
+export type GetEventTrendsRequest = Message<"events.v1.GetEventTrendsRequest"> & {
+  /**
+   * Number of days to look back (default 90)
+   *
+   * @generated from field: int32 days = 1;
+   */
+  days: number;
+};
+
+/**
+ * Describes the message events.v1.GetEventTrendsRequest.
+ * Use `create(GetEventTrendsRequestSchema)` to create a new message.
+ */
+export const GetEventTrendsRequestSchema: GenMessage<GetEventTrendsRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 146);
+
+/**
+ * @generated from message events.v1.GetEventTrendsResponse
+ */
+export type GetEventTrendsResponse = Message<"events.v1.GetEventTrendsResponse"> & {
+  /**
+   * @generated from field: repeated events.v1.EventTrend trends = 1;
+   */
+  trends: EventTrend[];
+};
+
+/**
+ * Describes the message events.v1.GetEventTrendsResponse.
+ * Use `create(GetEventTrendsResponseSchema)` to create a new message.
+ */
+export const GetEventTrendsResponseSchema: GenMessage<GetEventTrendsResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 147);
+
+/**
+ * @generated from message events.v1.GetEventCountByFormatRequest
+ */
+export type GetEventCountByFormatRequest = Message<"events.v1.GetEventCountByFormatRequest"> & {
+  /**
+   * @generated from field: optional int32 organization_id = 1;
+   */
+  organizationId?: number;
+
+  /**
+   * RFC3339, events starting at or after
+   *
+   * @generated from field: optional string start_date = 2;
+   */
+  startDate?: string;
+
+  /**
+   * RFC3339, events starting at or before
+   *
+   * @generated from field: optional string end_date = 3;
+   */
+  endDate?: string;
+};
+
+/**
+ * Describes the message events.v1.GetEventCountByFormatRequest.
+ * Use `create(GetEventCountByFormatRequestSchema)` to create a new message.
+ */
+export const GetEventCountByFormatRequestSchema: GenMessage<GetEventCountByFormatRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 148);
+
+/**
+ * @generated from message events.v1.GetEventCountByFormatResponse
+ */
+export type GetEventCountByFormatResponse = Message<"events.v1.GetEventCountByFormatResponse"> & {
+  /**
+   * @generated from field: int32 online_count = 1;
+   */
+  onlineCount: number;
+
+  /**
+   * @generated from field: int32 offline_count = 2;
+   */
+  offlineCount: number;
+
+  /**
+   * @generated from field: int32 hybrid_count = 3;
+   */
+  hybridCount: number;
+
+  /**
+   * @generated from field: int32 total = 4;
+   */
+  total: number;
+};
+
+/**
+ * Describes the message events.v1.GetEventCountByFormatResponse.
+ * Use `create(GetEventCountByFormatResponseSchema)` to create a new message.
+ */
+export const GetEventCountByFormatResponseSchema: GenMessage<GetEventCountByFormatResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 149);
+
+/**
+ * @generated from message events.v1.ClubLeaderboard
+ */
+export type ClubLeaderboard = Message<"events.v1.ClubLeaderboard"> & {
+  /**
+   * @generated from field: int32 organization_id = 1;
+   */
+  organizationId: number;
+
+  /**
+   * @generated from field: string organization_title = 2;
+   */
+  organizationTitle: string;
+
+  /**
+   * @generated from field: optional string organization_image = 3;
+   */
+  organizationImage?: string;
+
+  /**
+   * @generated from field: int32 total_events = 4;
+   */
+  totalEvents: number;
+
+  /**
+   * @generated from field: int32 total_registrations = 5;
+   */
+  totalRegistrations: number;
+
+  /**
+   * @generated from field: int32 total_attendees = 6;
+   */
+  totalAttendees: number;
+
+  /**
+   * @generated from field: double average_attendance_rate = 7;
+   */
+  averageAttendanceRate: number;
+};
+
+/**
+ * Describes the message events.v1.ClubLeaderboard.
+ * Use `create(ClubLeaderboardSchema)` to create a new message.
+ */
+export const ClubLeaderboardSchema: GenMessage<ClubLeaderboard> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 150);
+
+/**
+ * @generated from message events.v1.GetTopPerformingClubsRequest
+ */
+export type GetTopPerformingClubsRequest = Message<"events.v1.GetTopPerformingClubsRequest"> & {
+  /**
+   * Number of clubs to return (default 10)
+   *
+   * @generated from field: int32 limit = 1;
+   */
+  limit: number;
+
+  /**
+   * Time period to consider (default 90)
+   *
+   * @generated from field: int32 days = 2;
+   */
+  days: number;
+
+  /**
+   * Page number (default 1)
+   *
+   * @generated from field: int32 page = 3;
+   */
+  page: number;
+
+  /**
+   * @generated from field: events.v1.ClubLeaderboardSortBy sort_by = 4;
+   */
+  sortBy: ClubLeaderboardSortBy;
+};
+
+/**
+ * Describes the message events.v1.GetTopPerformingClubsRequest.
+ * Use `create(GetTopPerformingClubsRequestSchema)` to create a new message.
+ */
+export const GetTopPerformingClubsRequestSchema: GenMessage<GetTopPerformingClubsRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 151);
+
+/**
+ * Deprecated: use GetClubLeaderboard, which pages by cursor
+ *
+ * @generated from message events.v1.GetTopPerformingClubsResponse
+ */
+export type GetTopPerformingClubsResponse = Message<"events.v1.GetTopPerformingClubsResponse"> & {
+  /**
+   * @generated from field: repeated events.v1.ClubLeaderboard clubs = 1 [deprecated = true];
+   * @deprecated
+   */
+  clubs: ClubLeaderboard[];
+
+  /**
+   * Clubs with events in the period
+   *
+   * @generated from field: int32 total = 2 [deprecated = true];
+   * @deprecated
+   */
+  total: number;
+};
+
+/**
+ * Describes the message events.v1.GetTopPerformingClubsResponse.
+ * Use `create(GetTopPerformingClubsResponseSchema)` to create a new message.
+ */
+export const GetTopPerformingClubsResponseSchema: GenMessage<GetTopPerformingClubsResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 152);
+
+/**
+ * @generated from message events.v1.GetClubLeaderboardRequest
+ */
+export type GetClubLeaderboardRequest = Message<"events.v1.GetClubLeaderboardRequest"> & {
+  /**
+   * Time period to consider (default 90)
+   *
+   * @generated from field: int32 days = 1;
+   */
+  days: number;
+
+  /**
+   * Clubs per page (default 10)
+   *
+   * @generated from field: int32 limit = 2;
+   */
+  limit: number;
+
+  /**
+   * next_cursor of the previous page, empty for the first
+   *
+   * @generated from field: string cursor = 3;
+   */
+  cursor: string;
+
+  /**
+   * @generated from field: events.v1.ClubLeaderboardSortBy sort_by = 4;
+   */
+  sortBy: ClubLeaderboardSortBy;
+};
+
+/**
+ * Describes the message events.v1.GetClubLeaderboardRequest.
+ * Use `create(GetClubLeaderboardRequestSchema)` to create a new message.
+ */
+export const GetClubLeaderboardRequestSchema: GenMessage<GetClubLeaderboardRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 153);
+
+/**
+ * @generated from message events.v1.ClubLeaderboardResponse
+ */
+export type ClubLeaderboardResponse = Message<"events.v1.ClubLeaderboardResponse"> & {
+  /**
+   * @generated from field: repeated events.v1.ClubLeaderboard clubs = 1;
+   */
+  clubs: ClubLeaderboard[];
+
+  /**
+   * Unset on the last page
+   *
+   * @generated from field: optional string next_cursor = 2;
+   */
+  nextCursor?: string;
+
+  /**
+   * Clubs with events in the period
+   *
+   * @generated from field: int32 total_clubs = 3;
+   */
+  totalClubs: number;
+};
+
+/**
+ * Describes the message events.v1.ClubLeaderboardResponse.
+ * Use `create(ClubLeaderboardResponseSchema)` to create a new message.
+ */
+export const ClubLeaderboardResponseSchema: GenMessage<ClubLeaderboardResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 154);
+
+/**
+ * @generated from message events.v1.GetUserEngagementLevelsRequest
+ */
+export type GetUserEngagementLevelsRequest = Message<"events.v1.GetUserEngagementLevelsRequest"> & {
+};
+
+/**
+ * Describes the message events.v1.GetUserEngagementLevelsRequest.
+ * Use `create(GetUserEngagementLevelsRequestSchema)` to create a new message.
+ */
+export const GetUserEngagementLevelsRequestSchema: GenMessage<GetUserEngagementLevelsRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 155);
+
+/**
+ * @generated from message events.v1.UserEngagementLevel
+ */
+export type UserEngagementLevel = Message<"events.v1.UserEngagementLevel"> & {
+  /**
+   * "active_users", "registered_for_events", "attended_events", "repeat_attendees"
+   *
+   * @generated from field: string level = 1;
+   */
+  level: string;
+
+  /**
+   * @generated from field: int32 count = 2;
+   */
+  count: number;
+
+  /**
+   * Percentage relative to active users
+   *
+   * @generated from field: double percentage = 3;
+   */
+  percentage: number;
+};
+
+/**
+ * Describes the message events.v1.UserEngagementLevel.
+ * Use `create(UserEngagementLevelSchema)` to create a new message.
+ */
+export const UserEngagementLevelSchema: GenMessage<UserEngagementLevel> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 156);
+
+/**
+ * @generated from message events.v1.GetUserEngagementLevelsResponse
+ */
+export type GetUserEngagementLevelsResponse = Message<"events.v1.GetUserEngagementLevelsResponse"> & {
+  /**
+   * @generated from field: repeated events.v1.UserEngagementLevel levels = 1;
+   */
+  levels: UserEngagementLevel[];
+
+  /**
+   * @generated from field: int32 total_users = 2;
+   */
+  totalUsers: number;
+
+  /**
+   * e.g., "Trending up by 5.2% this month"
+   *
+   * @generated from field: string trend_message = 3;
+   */
+  trendMessage: string;
+
+  /**
+   * e.g., "Showing engagement metrics for active users"
+   *
+   * @generated from field: string description = 4;
+   */
+  description: string;
+
+  /**
+   * Whether the trend is positive or negative
+   *
+   * @generated from field: bool is_positive_trend = 5;
+   */
+  isPositiveTrend: boolean;
+};
+
+/**
+ * Describes the message events.v1.GetUserEngagementLevelsResponse.
+ * Use `create(GetUserEngagementLevelsResponseSchema)` to create a new message.
+ */
+export const GetUserEngagementLevelsResponseSchema: GenMessage<GetUserEngagementLevelsResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 157);
+
+/**
+ * Top Performing Events
+ *
+ * @generated from message events.v1.TopPerformingEvent
+ */
+export type TopPerformingEvent = Message<"events.v1.TopPerformingEvent"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * @generated from field: optional string image_url = 3;
+   */
+  imageUrl?: string;
+
+  /**
+   * @generated from field: optional events.v1.Organization organization = 4;
+   */
+  organization?: Organization;
+
+  /**
+   * @generated from field: string start_time = 5;
+   */
+  startTime: string;
+
+  /**
+   * @generated from field: int32 total_registrations = 6;
+   */
+  totalRegistrations: number;
+
+  /**
+   * @generated from field: int32 total_attendees = 7;
+   */
+  totalAttendees: number;
+
+  /**
+   * @generated from field: double attendance_rate = 8;
+   */
+  attendanceRate: number;
+};
+
+/**
+ * Describes the message events.v1.TopPerformingEvent.
+ * Use `create(TopPerformingEventSchema)` to create a new message.
+ */
+export const TopPerformingEventSchema: GenMessage<TopPerformingEvent> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 158);
+
+/**
+ * @generated from message events.v1.GetTopPerformingEventsRequest
+ */
+export type GetTopPerformingEventsRequest = Message<"events.v1.GetTopPerformingEventsRequest"> & {
+  /**
+   * Number of events to return (default 10)
+   *
+   * @generated from field: int32 limit = 1;
+   */
+  limit: number;
+
+  /**
+   * Time period to consider (default 90)
+   *
+   * @generated from field: int32 days = 2;
+   */
+  days: number;
+
+  /**
+   * Page number (default 1)
+   *
+   * @generated from field: int32 page = 3;
+   */
+  page: number;
 
-/**
- * @generated from message events.v1.GetDashboardStatisticsResponse
- */
-export type GetDashboardStatisticsResponse = Message<"events.v1.GetDashboardStatisticsResponse"> & {
   /**
-   * @generated from field: events.v1.EventStatistics statistics = 1;
+   * @generated from field: events.v1.TopEventsSortBy sort_by = 4;
    */
-  statistics?: EventStatistics;
+  sortBy: TopEventsSortBy;
 };
 
 /**
- * Describes the message events.v1.GetDashboardStatisticsResponse.
- * Use `create(GetDashboardStatisticsResponseSchema)` to create a new message.
+ * Describes the message events.v1.GetTopPerformingEventsRequest.
+ * Use `create(GetTopPerformingEventsRequestSchema)` to create a new message.
  */
-export const GetDashboardStatisticsResponseSchema: GenMessage<GetDashboardStatisticsResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 73);
+export const GetTopPerformingEventsRequestSchema: GenMessage<GetTopPerformingEventsRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 159);
 
 /**
- * @generated from message events.v1.GetEventStatisticsRequest
+ * @generated from message events.v1.GetTopPerformingEventsResponse
  */
-export type GetEventStatisticsRequest = Message<"events.v1.GetEventStatisticsRequest"> & {
+export type GetTopPerformingEventsResponse = Message<"events.v1.GetTopPerformingEventsResponse"> & {
   /**
-   * @generated from field: int32 event_id = 1;
+   * @generated from field: repeated events.v1.TopPerformingEvent events = 1;
    */
-  eventId: number;
+  events: TopPerformingEvent[];
+
+  /**
+   * Events in the period
+   *
+   * @generated from field: int32 total = 2;
+   */
+  total: number;
 };
 
 /**
- * Describes the message events.v1.GetEventStatisticsRequest.
- * Use `create(GetEventStatisticsRequestSchema)` to create a new message.
+ * Describes the message events.v1.GetTopPerformingEventsResponse.
+ * Use `create(GetTopPerformingEventsResponseSchema)` to create a new message.
  */
-export const GetEventStatisticsRequestSchema: GenMessage<GetEventStatisticsRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 74);
+export const GetTopPerformingEventsResponseSchema: GenMessage<GetTopPerformingEventsResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 160);
 
 /**
- * @generated from message events.v1.GetEventStatisticsResponse
+ * Low Registration Events
+ *
+ * @generated from message events.v1.LowRegistrationEvent
  */
-export type GetEventStatisticsResponse = Message<"events.v1.GetEventStatisticsResponse"> & {
+export type LowRegistrationEvent = Message<"events.v1.LowRegistrationEvent"> & {
   /**
-   * @generated from field: int32 total_registrations = 1;
+   * @generated from field: int32 id = 1;
    */
-  totalRegistrations: number;
+  id: number;
 
   /**
-   * @generated from field: int32 total_attendees = 2;
+   * @generated from field: string title = 2;
    */
-  totalAttendees: number;
+  title: string;
 
   /**
-   * @generated from field: int32 checked_in = 3;
+   * @generated from field: optional string image_url = 3;
    */
-  checkedIn: number;
+  imageUrl?: string;
 
   /**
-   * @generated from field: int32 no_show = 4;
+   * @generated from field: optional events.v1.Organization organization = 4;
    */
-  noShow: number;
+  organization?: Organization;
 
   /**
-   * @generated from field: double attendance_rate = 5;
+   * @generated from field: string start_time = 5;
    */
-  attendanceRate: number;
-};
+  startTime: string;
 
-/**
- * Describes the message events.v1.GetEventStatisticsResponse.
- * Use `create(GetEventStatisticsResponseSchema)` to create a new message.
- */
-export const GetEventStatisticsResponseSchema: GenMessage<GetEventStatisticsResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 75);
+  /**
+   * @generated from field: int32 capacity = 6;
+   */
+  capacity: number;
 
-/**
- * @generated from message events.v1.TagDistribution
- */
-export type TagDistribution = Message<"events.v1.TagDistribution"> & {
   /**
-   * @generated from field: int32 tag_id = 1;
+   * @generated from field: int32 total_registrations = 7;
    */
-  tagId: number;
+  totalRegistrations: number;
 
   /**
-   * @generated from field: string tag_name = 2;
+   * @generated from field: double capacity_utilization = 8;
    */
-  tagName: string;
+  capacityUtilization: number;
 
   /**
-   * @generated from field: int32 event_count = 3;
+   * @generated from field: int32 days_until_event = 9;
    */
-  eventCount: number;
+  daysUntilEvent: number;
 };
 
 /**
- * Describes the message events.v1.TagDistribution.
- * Use `create(TagDistributionSchema)` to create a new message.
+ * Describes the message events.v1.LowRegistrationEvent.
+ * Use `create(LowRegistrationEventSchema)` to create a new message.
  */
-export const TagDistributionSchema: GenMessage<TagDistribution> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 76);
+export const LowRegistrationEventSchema: GenMessage<LowRegistrationEvent> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 161);
 
 /**
- * @generated from message events.v1.GetEventTagsDistributionByMonthRequest
+ * @generated from message events.v1.GetLowRegistrationEventsRequest
  */
-export type GetEventTagsDistributionByMonthRequest = Message<"events.v1.GetEventTagsDistributionByMonthRequest"> & {
+export type GetLowRegistrationEventsRequest = Message<"events.v1.GetLowRegistrationEventsRequest"> & {
   /**
-   * @generated from field: int32 year = 1;
+   * Capacity utilization threshold percentage (default 50)
+   *
+   * @generated from field: int32 threshold = 1;
    */
-  year: number;
+  threshold: number;
 
   /**
-   * @generated from field: int32 month = 2;
+   * Only include events within this many days (default 30)
+   *
+   * @generated from field: int32 days_ahead = 2;
    */
-  month: number;
+  daysAhead: number;
 };
 
 /**
- * Describes the message events.v1.GetEventTagsDistributionByMonthRequest.
- * Use `create(GetEventTagsDistributionByMonthRequestSchema)` to create a new message.
+ * Describes the message events.v1.GetLowRegistrationEventsRequest.
+ * Use `create(GetLowRegistrationEventsRequestSchema)` to create a new message.
  */
-export const GetEventTagsDistributionByMonthRequestSchema: GenMessage<GetEventTagsDistributionByMonthRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 77);
+export const GetLowRegistrationEventsRequestSchema: GenMessage<GetLowRegistrationEventsRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 162);
 
 /**
- * @generated from message events.v1.GetEventTagsDistributionByMonthResponse
+ * @generated from message events.v1.GetLowRegistrationEventsResponse
  */
-export type GetEventTagsDistributionByMonthResponse = Message<"events.v1.GetEventTagsDistributionByMonthResponse"> & {
-  /**
-   * @generated from field: repeated events.v1.TagDistribution tags = 1;
-   */
-  tags: TagDistribution[];
-
+export type GetLowRegistrationEventsResponse = Message<"events.v1.GetLowRegistrationEventsResponse"> & {
   /**
-   * @generated from field: int32 total_events = 2;
+   * @generated from field: repeated events.v1.LowRegistrationEvent events = 1;
    */
-  totalEvents: number;
+  events: LowRegistrationEvent[];
 };
 
 /**
- * Describes the message events.v1.GetEventTagsDistributionByMonthResponse.
- * Use `create(GetEventTagsDistributionByMonthResponseSchema)` to create a new message.
+ * Describes the message events.v1.GetLowRegistrationEventsResponse.
+ * Use `create(GetLowRegistrationEventsResponseSchema)` to create a new message.
  */
-export const GetEventTagsDistributionByMonthResponseSchema: GenMessage<GetEventTagsDistributionByMonthResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 78);
+export const GetLowRegistrationEventsResponseSchema: GenMessage<GetLowRegistrationEventsResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 163);
 
 /**
- * @generated from message events.v1.EventActivity
+ * Organization Activity
+ *
+ * @generated from message events.v1.OrganizationActivity
  */
-export type EventActivity = Message<"events.v1.EventActivity"> & {
+export type OrganizationActivity = Message<"events.v1.OrganizationActivity"> & {
   /**
-   * @generated from field: string date = 1;
+   * @generated from field: int32 id = 1;
    */
-  date: string;
+  id: number;
 
   /**
-   * @generated from field: int32 count = 2;
+   * @generated from field: string title = 2;
    */
-  count: number;
+  title: string;
 
   /**
-   * @generated from field: int32 level = 3;
+   * @generated from field: optional string image_url = 3;
    */
-  level: number;
-};
+  imageUrl?: string;
 
-/**
- * Describes the message events.v1.EventActivity.
- * Use `create(EventActivitySchema)` to create a new message.
- */
-export const EventActivitySchema: GenMessage<EventActivity> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 79);
+  /**
+   * @generated from field: int32 events_this_month = 4;
+   */
+  eventsThisMonth: number;
 
-/**
- * @generated from message events.v1.GetEventActivityByYearRequest
- */
-export type GetEventActivityByYearRequest = Message<"events.v1.GetEventActivityByYearRequest"> & {
   /**
-   * @generated from field: int32 year = 1;
+   * @generated from field: int32 events_last_month = 5;
    */
-  year: number;
-};
+  eventsLastMonth: number;
 
-/**
- * Describes the message events.v1.GetEventActivityByYearRequest.
- * Use `create(GetEventActivityByYearRequestSchema)` to create a new message.
- */
-export const GetEventActivityByYearRequestSchema: GenMessage<GetEventActivityByYearRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 80);
+  /**
+   * @generated from field: int32 total_events = 6;
+   */
+  totalEvents: number;
 
-/**
- * @generated from message events.v1.GetEventActivityByYearResponse
- */
-export type GetEventActivityByYearResponse = Message<"events.v1.GetEventActivityByYearResponse"> & {
   /**
-   * @generated from field: repeated events.v1.EventActivity activities = 1;
+   * @generated from field: double average_attendance = 7;
    */
-  activities: EventActivity[];
+  averageAttendance: number;
 
   /**
-   * @generated from field: int32 total_events = 2;
+   * @generated from field: double growth_rate = 8;
    */
-  totalEvents: number;
+  growthRate: number;
 };
 
 /**
- * Describes the message events.v1.GetEventActivityByYearResponse.
- * Use `create(GetEventActivityByYearResponseSchema)` to create a new message.
+ * Describes the message events.v1.OrganizationActivity.
+ * Use `create(OrganizationActivitySchema)` to create a new message.
  */
-export const GetEventActivityByYearResponseSchema: GenMessage<GetEventActivityByYearResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 81);
+export const OrganizationActivitySchema: GenMessage<OrganizationActivity> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 164);
 
 /**
- * @generated from message events.v1.EventStatsSummary
+ * @generated from message events.v1.GetOrganizationActivityRequest
  */
-export type EventStatsSummary = Message<"events.v1.EventStatsSummary"> & {
+export type GetOrganizationActivityRequest = Message<"events.v1.GetOrganizationActivityRequest"> & {
   /**
-   * @generated from field: int32 total_events = 1;
+   * Number of organizations to return (default 20)
+   *
+   * @generated from field: int32 limit = 1;
    */
-  totalEvents: number;
+  limit: number;
+};
 
-  /**
-   * @generated from field: int32 total_registrations = 2;
-   */
-  totalRegistrations: number;
+/**
+ * Describes the message events.v1.GetOrganizationActivityRequest.
+ * Use `create(GetOrganizationActivityRequestSchema)` to create a new message.
+ */
+export const GetOrganizationActivityRequestSchema: GenMessage<GetOrganizationActivityRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 165);
 
+/**
+ * @generated from message events.v1.GetOrganizationActivityResponse
+ */
+export type GetOrganizationActivityResponse = Message<"events.v1.GetOrganizationActivityResponse"> & {
   /**
-   * @generated from field: int32 total_attendees = 3;
+   * @generated from field: repeated events.v1.OrganizationActivity organizations = 1;
    */
-  totalAttendees: number;
+  organizations: OrganizationActivity[];
 };
 
 /**
- * Describes the message events.v1.EventStatsSummary.
- * Use `create(EventStatsSummarySchema)` to create a new message.
+ * Describes the message events.v1.GetOrganizationActivityResponse.
+ * Use `create(GetOrganizationActivityResponseSchema)` to create a new message.
  */
-export const EventStatsSummarySchema: GenMessage<EventStatsSummary> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 82);
+export const GetOrganizationActivityResponseSchema: GenMessage<GetOrganizationActivityResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 166);
 
 /**
- * @generated from message events.v1.GetOverallStatisticsRequest
+ * @generated from message events.v1.GetStatisticsForOrganizationRequest
  */
-export type GetOverallStatisticsRequest = Message<"events.v1.GetOverallStatisticsRequest"> & {
+export type GetStatisticsForOrganizationRequest = Message<"events.v1.GetStatisticsForOrganizationRequest"> & {
+  /**
+   * @generated from field: int32 organization_id = 1;
+   */
+  organizationId: number;
+
+  /**
+   * Number of days to look back (default 90)
+   *
+   * @generated from field: int32 days = 2;
+   */
+  days: number;
 };
 
 /**
- * Describes the message events.v1.GetOverallStatisticsRequest.
- * Use `create(GetOverallStatisticsRequestSchema)` to create a new message.
+ * Describes the message events.v1.GetStatisticsForOrganizationRequest.
+ * Use `create(GetStatisticsForOrganizationRequestSchema)` to create a new message.
  */
-export const GetOverallStatisticsRequestSchema: GenMessage<GetOverallStatisticsRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 83);
+export const GetStatisticsForOrganizationRequestSchema: GenMessage<GetStatisticsForOrganizationRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 167);
 
 /**
- * @generated from message events.v1.GetOverallStatisticsResponse
+ * @generated from message events.v1.OrganizationStatisticsResponse
  */
-export type GetOverallStatisticsResponse = Message<"events.v1.GetOverallStatisticsResponse"> & {
+export type OrganizationStatisticsResponse = Message<"events.v1.OrganizationStatisticsResponse"> & {
   /**
-   * @generated from field: int32 total_events = 1;
+   * @generated from field: int32 organization_id = 1;
+   */
+  organizationId: number;
+
+  /**
+   * Events starting within the window
+   *
+   * @generated from field: int32 total_events = 2;
    */
   totalEvents: number;
 
   /**
-   * @generated from field: int32 total_users = 2;
+   * @generated from field: int32 total_registrations = 3;
    */
-  totalUsers: number;
+  totalRegistrations: number;
 
   /**
-   * @generated from field: int32 total_organizations = 3;
+   * @generated from field: int32 total_attendees = 4;
    */
-  totalOrganizations: number;
+  totalAttendees: number;
 
   /**
-   * @generated from field: int32 total_registrations = 4;
+   * Attendees per registration, in percent
+   *
+   * @generated from field: double attendance_rate = 5;
    */
-  totalRegistrations: number;
+  attendanceRate: number;
 
   /**
-   * @generated from field: int32 upcoming_events = 5;
+   * Most used tags, at most 5
+   *
+   * @generated from field: repeated events.v1.TagDistribution top_tags = 6;
    */
-  upcomingEvents: number;
+  topTags: TagDistribution[];
 
   /**
-   * @generated from field: double average_attendance_rate = 6;
+   * Per day, days without events are omitted
+   *
+   * @generated from field: repeated events.v1.EventTrend trends = 7;
    */
-  averageAttendanceRate: number;
+  trends: EventTrend[];
+};
+
+/**
+ * Describes the message events.v1.OrganizationStatisticsResponse.
+ * Use `create(OrganizationStatisticsResponseSchema)` to create a new message.
+ */
+export const OrganizationStatisticsResponseSchema: GenMessage<OrganizationStatisticsResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 168);
 
+/**
+ * @generated from message events.v1.GetEventImageUploadUrlRequest
+ */
+export type GetEventImageUploadUrlRequest = Message<"events.v1.GetEventImageUploadUrlRequest"> & {
   /**
-   * @generated from field: int32 events_this_month = 7;
+   * @generated from field: string filename = 1;
    */
-  eventsThisMonth: number;
+  filename: string;
 
   /**
-   * @generated from field: int32 registrations_this_month = 8;
+   * @generated from field: string content_type = 2;
    */
-  registrationsThisMonth: number;
+  contentType: string;
 };
 
 /**
- * Describes the message events.v1.GetOverallStatisticsResponse.
- * Use `create(GetOverallStatisticsResponseSchema)` to create a new message.
+ * Describes the message events.v1.GetEventImageUploadUrlRequest.
+ * Use `create(GetEventImageUploadUrlRequestSchema)` to create a new message.
  */
-export const GetOverallStatisticsResponseSchema: GenMessage<GetOverallStatisticsResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 84);
+export const GetEventImageUploadUrlRequestSchema: GenMessage<GetEventImageUploadUrlRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 169);
 
 /**
- * @generated from message events.v1.EventTrend
+ * @generated from message events.v1.GetEventImageUploadUrlResponse
  */
-export type EventTrend = Message<"events.v1.EventTrend"> & {
+export type GetEventImageUploadUrlResponse = Message<"events.v1.GetEventImageUploadUrlResponse"> & {
   /**
-   * @generated from field: string date = 1;
+   * @generated from field: string upload_url = 1;
    */
-  date: string;
+  uploadUrl: string;
 
   /**
-   * @generated from field: int32 event_count = 2;
+   * @generated from field: string public_url = 2;
    */
-  eventCount: number;
+  publicUrl: string;
 
   /**
-   * @generated from field: int32 registration_count = 3;
+   * @generated from field: string object_key = 3;
    */
-  registrationCount: number;
+  objectKey: string;
 };
 
 /**
- * Describes the message events.v1.EventTrend.
- * Use `create(EventTrendSchema)` to create a new message.
+ * Describes the message events.v1.GetEventImageUploadUrlResponse.
+ * Use `create(GetEventImageUploadUrlResponseSchema)` to create a new message.
  */
-export const EventTrendSchema: GenMessage<EventTrend> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 85);
+export const GetEventImageUploadUrlResponseSchema: GenMessage<GetEventImageUploadUrlResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 170);
 
 /**
- * @generated from message events.v1.GetEventTrendsRequest
+ * Webhook messages
+ *
+ * @generated from message events.v1.Webhook
  */
-export type GetEventTrendsRequest = Message<"events.v1.GetEventTrendsRequest"> & {
+export type Webhook = Message<"events.v1.Webhook"> & {
   /**
-   * Number of days to look back (default 90)
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: string url = 2;
+   */
+  url: string;
+
+  /**
+   * Empty = all event types
    *
-   * @generated from field: int32 days = 1;
+   * @generated from field: repeated string event_types = 3;
    */
-  days: number;
-};
+  eventTypes: string[];
 
-/**
- * Describes the message events.v1.GetEventTrendsRequest.
- * Use `create(GetEventTrendsRequestSchema)` to create a new message.
- */
-export const GetEventTrendsRequestSchema: GenMessage<GetEventTrendsRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 86);
+  /**
+   * @generated from field: bool is_active = 4;
+   */
+  isActive: boolean;
 
-/**
- * @generated from message events.v1.GetEventTrendsResponse
- */
-export type GetEventTrendsResponse = Message<"events.v1.GetEventTrendsResponse"> & {
   /**
-   * @generated from field: repeated events.v1.EventTrend trends = 1;
+   * @generated from field: string created_at = 5;
    */
-  trends: EventTrend[];
+  createdAt: string;
+
+  /**
+   * @generated from field: string updated_at = 6;
+   */
+  updatedAt: string;
 };
 
 /**
- * Describes the message events.v1.GetEventTrendsResponse.
- * Use `create(GetEventTrendsResponseSchema)` to create a new message.
+ * Describes the message events.v1.Webhook.
+ * Use `create(WebhookSchema)` to create a new message.
  */
-export const GetEventTrendsResponseSchema: GenMessage<GetEventTrendsResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 87);
+export const WebhookSchema: GenMessage<Webhook> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 171);
 
 /**
- * @generated from message events.v1.ClubLeaderboard
+ * @generated from message events.v1.WebhookDelivery
  */
-export type ClubLeaderboard = Message<"events.v1.ClubLeaderboard"> & {
+export type WebhookDelivery = Message<"events.v1.WebhookDelivery"> & {
   /**
-   * @generated from field: int32 organization_id = 1;
+   * @generated from field: int32 id = 1;
    */
-  organizationId: number;
+  id: number;
 
   /**
-   * @generated from field: string organization_title = 2;
+   * @generated from field: int32 webhook_id = 2;
    */
-  organizationTitle: string;
+  webhookId: number;
 
   /**
-   * @generated from field: optional string organization_image = 3;
+   * @generated from field: string event_type = 3;
    */
-  organizationImage?: string;
+  eventType: string;
 
   /**
-   * @generated from field: int32 total_events = 4;
+   * @generated from field: string payload_hash = 4;
    */
-  totalEvents: number;
+  payloadHash: string;
 
   /**
-   * @generated from field: int32 total_registrations = 5;
+   * @generated from field: int32 attempt = 5;
    */
-  totalRegistrations: number;
+  attempt: number;
 
   /**
-   * @generated from field: int32 total_attendees = 6;
+   * @generated from field: events.v1.WebhookDeliveryStatus status = 6;
    */
-  totalAttendees: number;
+  status: WebhookDeliveryStatus;
 
   /**
-   * @generated from field: double average_attendance_rate = 7;
+   * @generated from field: optional int32 http_status = 7;
    */
-  averageAttendanceRate: number;
-};
-
-/**
- * Describes the message events.v1.ClubLeaderboard.
- * Use `create(ClubLeaderboardSchema)` to create a new message.
- */
-export const ClubLeaderboardSchema: GenMessage<ClubLeaderboard> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 88);
+  httpStatus?: number;
 
-/**
- * @generated from message events.v1.GetTopPerformingClubsRequest
- */
-export type GetTopPerformingClubsRequest = Message<"events.v1.GetTopPerformingClubsRequest"> & {
   /**
-   * Number of clubs to return (default 10)
+   * Truncated to 1KB
    *
-   * @generated from field: int32 limit = 1;
+   * @generated from field: optional string response_body = 8;
    */
-  limit: number;
+  responseBody?: string;
 
   /**
-   * Time period to consider (default 90)
-   *
-   * @generated from field: int32 days = 2;
+   * @generated from field: optional string attempted_at = 9;
    */
-  days: number;
-};
+  attemptedAt?: string;
 
-/**
- * Describes the message events.v1.GetTopPerformingClubsRequest.
- * Use `create(GetTopPerformingClubsRequestSchema)` to create a new message.
- */
-export const GetTopPerformingClubsRequestSchema: GenMessage<GetTopPerformingClubsRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 89);
+  /**
+   * @generated from field: optional string next_retry_at = 10;
+   */
+  nextRetryAt?: string;
 
-/**
- * @generated from message events.v1.GetTopPerformingClubsResponse
- */
-export type GetTopPerformingClubsResponse = Message<"events.v1.GetTopPerformingClubsResponse"> & {
   /**
-   * @generated from field: repeated events.v1.ClubLeaderboard clubs = 1;
+   * @generated from field: bool succeeded = 11;
    */
-  clubs: ClubLeaderboard[];
+  succeeded: boolean;
+
+  /**
+   * @generated from field: string created_at = 12;
+   */
+  createdAt: string;
 };
 
 /**
- * Describes the message events.v1.GetTopPerformingClubsResponse.
- * Use `create(GetTopPerformingClubsResponseSchema)` to create a new message.
+ * Describes the message events.v1.WebhookDelivery.
+ * Use `create(WebhookDeliverySchema)` to create a new message.
  */
-export const GetTopPerformingClubsResponseSchema: GenMessage<GetTopPerformingClubsResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 90);
+export const WebhookDeliverySchema: GenMessage<WebhookDelivery> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 172);
 
 /**
- * @generated from message events.v1.GetUserEngagementLevelsRequest
+ * @generated from message events.v1.CreateWebhookRequest
  */
-export type GetUserEngagementLevelsRequest = Message<"events.v1.GetUserEngagementLevelsRequest"> & {
+export type CreateWebhookRequest = Message<"events.v1.CreateWebhookRequest"> & {
+  /**
+   * @generated from field: string url = 1;
+   */
+  url: string;
+
+  /**
+   * @generated from field: repeated string event_types = 2;
+   */
+  eventTypes: string[];
 };
 
 /**
- * Describes the message events.v1.GetUserEngagementLevelsRequest.
- * Use `create(GetUserEngagementLevelsRequestSchema)` to create a new message.
+ * Describes the message events.v1.CreateWebhookRequest.
+ * Use `create(CreateWebhookRequestSchema)` to create a new message.
  */
-export const GetUserEngagementLevelsRequestSchema: GenMessage<GetUserEngagementLevelsRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 91);
+export const CreateWebhookRequestSchema: GenMessage<CreateWebhookRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 173);
 
 /**
- * @generated from message events.v1.UserEngagementLevel
+ * @generated from message events.v1.CreateWebhookResponse
  */
-export type UserEngagementLevel = Message<"events.v1.UserEngagementLevel"> & {
-  /**
-   * "active_users", "registered_for_events", "attended_events", "repeat_attendees"
-   *
-   * @generated from field: string level = 1;
-   */
-  level: string;
-
+export type CreateWebhookResponse = Message<"events.v1.CreateWebhookResponse"> & {
   /**
-   * @generated from field: int32 count = 2;
+   * @generated from field: events.v1.Webhook webhook = 1;
    */
-  count: number;
+  webhook?: Webhook;
 
   /**
-   * Percentage relative to active users
+   * HMAC signing secret, only returned once
    *
-   * @generated from field: double percentage = 3;
+   * @generated from field: string secret = 2;
    */
-  percentage: number;
+  secret: string;
 };
 
 /**
- * Describes the message events.v1.UserEngagementLevel.
- * Use `create(UserEngagementLevelSchema)` to create a new message.
+ * Describes the message events.v1.CreateWebhookResponse.
+ * Use `create(CreateWebhookResponseSchema)` to create a new message.
  */
-export const UserEngagementLevelSchema: GenMessage<UserEngagementLevel> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 92);
+export const CreateWebhookResponseSchema: GenMessage<CreateWebhookResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 174);
 
 /**
- * @generated from message events.v1.GetUserEngagementLevelsResponse
+ * @generated from message events.v1.ListWebhooksRequest
  */
-export type GetUserEngagementLevelsResponse = Message<"events.v1.GetUserEngagementLevelsResponse"> & {
+export type ListWebhooksRequest = Message<"events.v1.ListWebhooksRequest"> & {
   /**
-   * @generated from field: repeated events.v1.UserEngagementLevel levels = 1;
+   * @generated from field: int32 page = 1;
    */
-  levels: UserEngagementLevel[];
+  page: number;
 
   /**
-   * @generated from field: int32 total_users = 2;
+   * @generated from field: int32 limit = 2;
    */
-  totalUsers: number;
+  limit: number;
+};
 
-  /**
-   * e.g., "Trending up by 5.2% this month"
-   *
-   * @generated from field: string trend_message = 3;
-   */
-  trendMessage: string;
+/**
+ * Describes the message events.v1.ListWebhooksRequest.
+ * Use `create(ListWebhooksRequestSchema)` to create a new message.
+ */
+export const ListWebhooksRequestSchema: GenMessage<ListWebhooksRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 175);
 
+/**
+ * @generated from message events.v1.ListWebhooksResponse
+ */
+export type ListWebhooksResponse = Message<"events.v1.ListWebhooksResponse"> & {
   /**
-   * e.g., "Showing engagement metrics for active users"
-   *
-   * @generated from field: string description = 4;
+   * @generated from field: repeated events.v1.Webhook webhooks = 1;
    */
-  description: string;
+  webhooks: Webhook[];
 
   /**
-   * Whether the trend is positive or negative
-   *
-   * @generated from field: bool is_positive_trend = 5;
+   * @generated from field: int32 total = 2;
    */
-  isPositiveTrend: boolean;
+  total: number;
 };
 
 /**
- * Describes the message events.v1.GetUserEngagementLevelsResponse.
- * Use `create(GetUserEngagementLevelsResponseSchema)` to create a new message.
+ * Describes the message events.v1.ListWebhooksResponse.
+ * Use `create(ListWebhooksResponseSchema)` to create a new message.
  */
-export const GetUserEngagementLevelsResponseSchema: GenMessage<GetUserEngagementLevelsResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 93);
+export const ListWebhooksResponseSchema: GenMessage<ListWebhooksResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 176);
 
 /**
- * Top Performing Events
- *
- * @generated from message events.v1.TopPerformingEvent
+ * @generated from message events.v1.DeleteWebhookRequest
  */
-export type TopPerformingEvent = Message<"events.v1.TopPerformingEvent"> & {
+export type DeleteWebhookRequest = Message<"events.v1.DeleteWebhookRequest"> & {
   /**
    * @generated from field: int32 id = 1;
    */
   id: number;
+};
 
-  /**
-   * @generated from field: string title = 2;
-   */
-  title: string;
-
-  /**
-   * @generated from field: optional string image_url = 3;
-   */
-  imageUrl?: string;
-
-  /**
-   * @generated from field: optional events.v1.Organization organization = 4;
-   */
-  organization?: Organization;
+/**
+ * Describes the message events.v1.DeleteWebhookRequest.
+ * Use `create(DeleteWebhookRequestSchema)` to create a new message.
+ */
+export const DeleteWebhookRequestSchema: GenMessage<DeleteWebhookRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 177);
 
+/**
+ * @generated from message events.v1.DeleteWebhookResponse
+ */
+export type DeleteWebhookResponse = Message<"events.v1.DeleteWebhookResponse"> & {
   /**
-   * @generated from field: string start_time = 5;
+   * @generated from field: bool success = 1;
    */
-  startTime: string;
+  success: boolean;
+};
+
+/**
+ * Describes the message events.v1.DeleteWebhookResponse.
+ * Use `create(DeleteWebhookResponseSchema)` to create a new message.
+ */
+export const DeleteWebhookResponseSchema: GenMessage<DeleteWebhookResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 178);
 
+/**
+ * @generated from message events.v1.GetWebhookDeliveriesRequest
+ */
+export type GetWebhookDeliveriesRequest = Message<"events.v1.GetWebhookDeliveriesRequest"> & {
   /**
-   * @generated from field: int32 total_registrations = 6;
+   * @generated from field: int32 webhook_id = 1;
    */
-  totalRegistrations: number;
+  webhookId: number;
 
   /**
-   * @generated from field: int32 total_attendees = 7;
+   * @generated from field: int32 page = 2;
    */
-  totalAttendees: number;
+  page: number;
 
   /**
-   * @generated from field: double attendance_rate = 8;
+   * @generated from field: int32 limit = 3;
    */
-  attendanceRate: number;
+  limit: number;
 };
 
 /**
- * Describes the message events.v1.TopPerformingEvent.
- * Use `create(TopPerformingEventSchema)` to create a new message.
+ * Describes the message events.v1.GetWebhookDeliveriesRequest.
+ * Use `create(GetWebhookDeliveriesRequestSchema)` to create a new message.
  */
-export const TopPerformingEventSchema: GenMessage<TopPerformingEvent> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 94);
+export const GetWebhookDeliveriesRequestSchema: GenMessage<GetWebhookDeliveriesRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 179);
 
 /**
- * @generated from message events.v1.GetTopPerformingEventsRequest
+ * @generated from message events.v1.GetWebhookDeliveriesResponse
  */
-export type GetTopPerformingEventsRequest = Message<"events.v1.GetTopPerformingEventsRequest"> & {
+export type GetWebhookDeliveriesResponse = Message<"events.v1.GetWebhookDeliveriesResponse"> & {
   /**
-   * Number of events to return (default 10)
-   *
-   * @generated from field: int32 limit = 1;
+   * @generated from field: repeated events.v1.WebhookDelivery deliveries = 1;
    */
-  limit: number;
+  deliveries: WebhookDelivery[];
 
   /**
-   * Time period to consider (default 90)
-   *
-   * @generated from field: int32 days = 2;
+   * @generated from field: int32 total = 2;
    */
-  days: number;
+  total: number;
 };
 
 /**
- * Describes the message events.v1.GetTopPerformingEventsRequest.
- * Use `create(GetTopPerformingEventsRequestSchema)` to create a new message.
+ * Describes the message events.v1.GetWebhookDeliveriesResponse.
+ * Use `create(GetWebhookDeliveriesResponseSchema)` to create a new message.
  */
-export const GetTopPerformingEventsRequestSchema: GenMessage<GetTopPerformingEventsRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 95);
+export const GetWebhookDeliveriesResponseSchema: GenMessage<GetWebhookDeliveriesResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 180);
 
 /**
- * @generated from message events.v1.GetTopPerformingEventsResponse
+ * @generated from message events.v1.RetryWebhookDeliveryRequest
  */
-export type GetTopPerformingEventsResponse = Message<"events.v1.GetTopPerformingEventsResponse"> & {
+export type RetryWebhookDeliveryRequest = Message<"events.v1.RetryWebhookDeliveryRequest"> & {
   /**
-   * @generated from field: repeated events.v1.TopPerformingEvent events = 1;
+   * @generated from field: int32 delivery_id = 1;
    */
-  events: TopPerformingEvent[];
+  deliveryId: number;
 };
 
 /**
- * Describes the message events.v1.GetTopPerformingEventsResponse.
- * Use `create(GetTopPerformingEventsResponseSchema)` to create a new message.
+ * Describes the message events.v1.RetryWebhookDeliveryRequest.
+ * Use `create(RetryWebhookDeliveryRequestSchema)` to create a new message.
  */
-export const GetTopPerformingEventsResponseSchema: GenMessage<GetTopPerformingEventsResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 96);
+export const RetryWebhookDeliveryRequestSchema: GenMessage<RetryWebhookDeliveryRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 181);
 
 /**
- * Low Registration Events
- *
- * @generated from message events.v1.LowRegistrationEvent
+ * @generated from message events.v1.RetryWebhookDeliveryResponse
  */
-export type LowRegistrationEvent = Message<"events.v1.LowRegistrationEvent"> & {
+export type RetryWebhookDeliveryResponse = Message<"events.v1.RetryWebhookDeliveryResponse"> & {
   /**
-   * @generated from field: int32 id = 1;
+   * @generated from field: events.v1.WebhookDelivery delivery = 1;
    */
-  id: number;
+  delivery?: WebhookDelivery;
+};
 
-  /**
-   * @generated from field: string title = 2;
-   */
-  title: string;
+/**
+ * Describes the message events.v1.RetryWebhookDeliveryResponse.
+ * Use `create(RetryWebhookDeliveryResponseSchema)` to create a new message.
+ */
+export const RetryWebhookDeliveryResponseSchema: GenMessage<RetryWebhookDeliveryResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 182);
 
+/**
+ * Audit messages
+ *
+ * @generated from message events.v1.AuditLogEntry
+ */
+export type AuditLogEntry = Message<"events.v1.AuditLogEntry"> & {
   /**
-   * @generated from field: optional string image_url = 3;
+   * @generated from field: int64 id = 1;
    */
-  imageUrl?: string;
+  id: bigint;
 
   /**
-   * @generated from field: optional events.v1.Organization organization = 4;
+   * Unset when the call was not authenticated
+   *
+   * @generated from field: optional string actor_kratos_id = 2;
    */
-  organization?: Organization;
+  actorKratosId?: string;
 
   /**
-   * @generated from field: string start_time = 5;
+   * RPC name, e.g. "DeleteOrganization"
+   *
+   * @generated from field: string action = 3;
    */
-  startTime: string;
+  action: string;
 
   /**
-   * @generated from field: int32 capacity = 6;
+   * @generated from field: string resource_type = 4;
    */
-  capacity: number;
+  resourceType: string;
 
   /**
-   * @generated from field: int32 total_registrations = 7;
+   * @generated from field: string resource_id = 5;
    */
-  totalRegistrations: number;
+  resourceId: string;
 
   /**
-   * @generated from field: double capacity_utilization = 8;
+   * The request as protojson, with secrets removed
+   *
+   * @generated from field: string payload = 6;
    */
-  capacityUtilization: number;
+  payload: string;
 
   /**
-   * @generated from field: int32 days_until_event = 9;
+   * @generated from field: string created_at = 7;
    */
-  daysUntilEvent: number;
+  createdAt: string;
 };
 
 /**
- * Describes the message events.v1.LowRegistrationEvent.
- * Use `create(LowRegistrationEventSchema)` to create a new message.
+ * Describes the message events.v1.AuditLogEntry.
+ * Use `create(AuditLogEntrySchema)` to create a new message.
  */
-export const LowRegistrationEventSchema: GenMessage<LowRegistrationEvent> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 97);
+export const AuditLogEntrySchema: GenMessage<AuditLogEntry> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 183);
 
 /**
- * @generated from message events.v1.GetLowRegistrationEventsRequest
+ * @generated from message events.v1.ListAuditLogsRequest
  */
-export type GetLowRegistrationEventsRequest = Message<"events.v1.GetLowRegistrationEventsRequest"> & {
+export type ListAuditLogsRequest = Message<"events.v1.ListAuditLogsRequest"> & {
   /**
-   * Capacity utilization threshold percentage (default 50)
+   * Empty = all resource types
    *
-   * @generated from field: int32 threshold = 1;
+   * @generated from field: string resource_type = 1;
    */
-  threshold: number;
+  resourceType: string;
 
   /**
-   * Only include events within this many days (default 30)
+   * Empty = all resources
    *
-   * @generated from field: int32 days_ahead = 2;
+   * @generated from field: string resource_id = 2;
    */
-  daysAhead: number;
+  resourceId: string;
+
+  /**
+   * @generated from field: int32 page = 3;
+   */
+  page: number;
+
+  /**
+   * @generated from field: int32 limit = 4;
+   */
+  limit: number;
 };
 
 /**
- * Describes the message events.v1.GetLowRegistrationEventsRequest.
- * Use `create(GetLowRegistrationEventsRequestSchema)` to create a new message.
+ * Describes the message events.v1.ListAuditLogsRequest.
+ * Use `create(ListAuditLogsRequestSchema)` to create a new message.
  */
-export const GetLowRegistrationEventsRequestSchema: GenMessage<GetLowRegistrationEventsRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 98);
+export const ListAuditLogsRequestSchema: GenMessage<ListAuditLogsRequest> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 184);
 
 /**
- * @generated from message events.v1.GetLowRegistrationEventsResponse
+ * @generated from message events.v1.ListAuditLogsResponse
  */
-export type GetLowRegistrationEventsResponse = Message<"events.v1.GetLowRegistrationEventsResponse"> & {
+export type ListAuditLogsResponse = Message<"events.v1.ListAuditLogsResponse"> & {
   /**
-   * @generated from field: repeated events.v1.LowRegistrationEvent events = 1;
+   * @generated from field: repeated events.v1.AuditLogEntry entries = 1;
    */
-  events: LowRegistrationEvent[];
+  entries: AuditLogEntry[];
+
+  /**
+   * @generated from field: int32 total = 2;
+   */
+  total: number;
 };
 
 /**
- * Describes the message events.v1.GetLowRegistrationEventsResponse.
- * Use `create(GetLowRegistrationEventsResponseSchema)` to create a new message.
+ * Describes the message events.v1.ListAuditLogsResponse.
+ * Use `create(ListAuditLogsResponseSchema)` to create a new message.
  */
-export const GetLowRegistrationEventsResponseSchema: GenMessage<GetLowRegistrationEventsResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 99);
+export const ListAuditLogsResponseSchema: GenMessage<ListAuditLogsResponse> = /*@__PURE__*/
+  messageDesc(file_eventsv1_events, 185);
 
 /**
- * Organization Activity
+ * Enums
  *
- * @generated from message events.v1.OrganizationActivity
+ * @generated from enum events.v1.EventFormat
  */
-export type OrganizationActivity = Message<"events.v1.OrganizationActivity"> & {
+export enum EventFormat {
   /**
-   * @generated from field: int32 id = 1;
+   * @generated from enum value: EVENT_FORMAT_UNSPECIFIED = 0;
    */
-  id: number;
+  UNSPECIFIED = 0,
 
   /**
-   * @generated from field: string title = 2;
+   * @generated from enum value: EVENT_FORMAT_ONLINE = 1;
    */
-  title: string;
+  ONLINE = 1,
 
   /**
-   * @generated from field: optional string image_url = 3;
+   * @generated from enum value: EVENT_FORMAT_OFFLINE = 2;
    */
-  imageUrl?: string;
+  OFFLINE = 2,
 
   /**
-   * @generated from field: int32 events_this_month = 4;
+   * @generated from enum value: EVENT_FORMAT_HYBRID = 3;
    */
-  eventsThisMonth: number;
+  HYBRID = 3,
+}
+
+/**
+ * Describes the enum events.v1.EventFormat.
+ */
+export const EventFormatSchema: GenEnum<EventFormat> = /*@__PURE__*/
+  enumDesc(file_eventsv1_events, 0);
 
+/**
+ * @generated from enum events.v1.ClubRole
+ */
+export enum ClubRole {
   /**
-   * @generated from field: int32 events_last_month = 5;
+   * @generated from enum value: CLUB_ROLE_UNSPECIFIED = 0;
    */
-  eventsLastMonth: number;
+  UNSPECIFIED = 0,
 
   /**
-   * @generated from field: int32 total_events = 6;
+   * @generated from enum value: CLUB_ROLE_PRESIDENT = 1;
    */
-  totalEvents: number;
+  PRESIDENT = 1,
 
   /**
-   * @generated from field: double average_attendance = 7;
+   * @generated from enum value: CLUB_ROLE_STAFF = 2;
    */
-  averageAttendance: number;
+  STAFF = 2,
 
   /**
-   * @generated from field: double growth_rate = 8;
+   * @generated from enum value: CLUB_ROLE_MEMBER = 3;
    */
-  growthRate: number;
-};
+  MEMBER = 3,
+}
 
 /**
- * Describes the message events.v1.OrganizationActivity.
- * Use `create(OrganizationActivitySchema)` to create a new message.
+ * Describes the enum events.v1.ClubRole.
  */
-export const OrganizationActivitySchema: GenMessage<OrganizationActivity> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 100);
+export const ClubRoleSchema: GenEnum<ClubRole> = /*@__PURE__*/
+  enumDesc(file_eventsv1_events, 1);
 
 /**
- * @generated from message events.v1.GetOrganizationActivityRequest
+ * @generated from enum events.v1.OrganizationStatus
  */
-export type GetOrganizationActivityRequest = Message<"events.v1.GetOrganizationActivityRequest"> & {
+export enum OrganizationStatus {
   /**
-   * Number of organizations to return (default 20)
-   *
-   * @generated from field: int32 limit = 1;
+   * @generated from enum value: ORGANIZATION_STATUS_UNSPECIFIED = 0;
    */
-  limit: number;
-};
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ORGANIZATION_STATUS_ACTIVE = 1;
+   */
+  ACTIVE = 1,
+
+  /**
+   * @generated from enum value: ORGANIZATION_STATUS_ARCHIVED = 2;
+   */
+  ARCHIVED = 2,
+
+  /**
+   * @generated from enum value: ORGANIZATION_STATUS_FROZEN = 3;
+   */
+  FROZEN = 3,
+}
 
 /**
- * Describes the message events.v1.GetOrganizationActivityRequest.
- * Use `create(GetOrganizationActivityRequestSchema)` to create a new message.
+ * Describes the enum events.v1.OrganizationStatus.
  */
-export const GetOrganizationActivityRequestSchema: GenMessage<GetOrganizationActivityRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 101);
+export const OrganizationStatusSchema: GenEnum<OrganizationStatus> = /*@__PURE__*/
+  enumDesc(file_eventsv1_events, 2);
 
 /**
- * @generated from message events.v1.GetOrganizationActivityResponse
+ * @generated from enum events.v1.InquiryStatus
  */
-export type GetOrganizationActivityResponse = Message<"events.v1.GetOrganizationActivityResponse"> & {
+export enum InquiryStatus {
   /**
-   * @generated from field: repeated events.v1.OrganizationActivity organizations = 1;
+   * @generated from enum value: INQUIRY_STATUS_UNSPECIFIED = 0;
    */
-  organizations: OrganizationActivity[];
-};
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: INQUIRY_STATUS_OPEN = 1;
+   */
+  OPEN = 1,
+
+  /**
+   * @generated from enum value: INQUIRY_STATUS_IN_PROGRESS = 2;
+   */
+  IN_PROGRESS = 2,
+
+  /**
+   * @generated from enum value: INQUIRY_STATUS_RESOLVED = 3;
+   */
+  RESOLVED = 3,
+
+  /**
+   * @generated from enum value: INQUIRY_STATUS_SPAM = 4;
+   */
+  SPAM = 4,
+}
 
 /**
- * Describes the message events.v1.GetOrganizationActivityResponse.
- * Use `create(GetOrganizationActivityResponseSchema)` to create a new message.
+ * Describes the enum events.v1.InquiryStatus.
  */
-export const GetOrganizationActivityResponseSchema: GenMessage<GetOrganizationActivityResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 102);
+export const InquiryStatusSchema: GenEnum<InquiryStatus> = /*@__PURE__*/
+  enumDesc(file_eventsv1_events, 3);
 
 /**
- * @generated from message events.v1.GetEventImageUploadUrlRequest
+ * @generated from enum events.v1.RegistrationStatus
  */
-export type GetEventImageUploadUrlRequest = Message<"events.v1.GetEventImageUploadUrlRequest"> & {
+export enum RegistrationStatus {
   /**
-   * @generated from field: string filename = 1;
+   * @generated from enum value: REGISTRATION_STATUS_UNSPECIFIED = 0;
    */
-  filename: string;
+  UNSPECIFIED = 0,
 
   /**
-   * @generated from field: string content_type = 2;
+   * @generated from enum value: REGISTRATION_STATUS_REGISTERED = 1;
    */
-  contentType: string;
-};
+  REGISTERED = 1,
 
-/**
- * Describes the message events.v1.GetEventImageUploadUrlRequest.
- * Use `create(GetEventImageUploadUrlRequestSchema)` to create a new message.
+  /**
+   * @generated from enum value: REGISTRATION_STATUS_CANCELLED = 2;
+   */
+  CANCELLED = 2,
+
+  /**
+   * @generated from enum value: REGISTRATION_STATUS_WAITLIST = 3;
+   */
+  WAITLIST = 3,
+
+  /**
+   * Only reported by GetEventRegistrationStatus
+   *
+   * @generated from enum value: REGISTRATION_STATUS_NOT_REGISTERED = 4;
+   */
+  NOT_REGISTERED = 4,
+}
+
+/**
+ * Describes the enum events.v1.RegistrationStatus.
  */
-export const GetEventImageUploadUrlRequestSchema: GenMessage<GetEventImageUploadUrlRequest> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 103);
+export const RegistrationStatusSchema: GenEnum<RegistrationStatus> = /*@__PURE__*/
+  enumDesc(file_eventsv1_events, 4);
 
 /**
- * @generated from message events.v1.GetEventImageUploadUrlResponse
+ * @generated from enum events.v1.AttendanceStatus
  */
-export type GetEventImageUploadUrlResponse = Message<"events.v1.GetEventImageUploadUrlResponse"> & {
+export enum AttendanceStatus {
   /**
-   * @generated from field: string upload_url = 1;
+   * @generated from enum value: ATTENDANCE_STATUS_UNSPECIFIED = 0;
    */
-  uploadUrl: string;
+  UNSPECIFIED = 0,
 
   /**
-   * @generated from field: string public_url = 2;
+   * @generated from enum value: ATTENDANCE_STATUS_ATTENDED = 1;
    */
-  publicUrl: string;
+  ATTENDED = 1,
 
   /**
-   * @generated from field: string object_key = 3;
+   * @generated from enum value: ATTENDANCE_STATUS_NO_SHOW = 2;
    */
-  objectKey: string;
-};
+  NO_SHOW = 2,
+
+  /**
+   * @generated from enum value: ATTENDANCE_STATUS_CHECKED_IN = 3;
+   */
+  CHECKED_IN = 3,
+}
 
 /**
- * Describes the message events.v1.GetEventImageUploadUrlResponse.
- * Use `create(GetEventImageUploadUrlResponseSchema)` to create a new message.
+ * Describes the enum events.v1.AttendanceStatus.
  */
-export const GetEventImageUploadUrlResponseSchema: GenMessage<GetEventImageUploadUrlResponse> = /*@__PURE__*/
-  messageDesc(file_eventsv1_events, 104);
+export const AttendanceStatusSchema: GenEnum<AttendanceStatus> = /*@__PURE__*/
+  enumDesc(file_eventsv1_events, 5);
 
 /**
- * Enums
- *
- * @generated from enum events.v1.EventFormat
+ * @generated from enum events.v1.WebhookDeliveryStatus
  */
-export enum EventFormat {
+export enum WebhookDeliveryStatus {
   /**
-   * @generated from enum value: EVENT_FORMAT_UNSPECIFIED = 0;
+   * @generated from enum value: WEBHOOK_DELIVERY_STATUS_UNSPECIFIED = 0;
    */
   UNSPECIFIED = 0,
 
   /**
-   * @generated from enum value: EVENT_FORMAT_ONLINE = 1;
+   * @generated from enum value: WEBHOOK_DELIVERY_STATUS_PENDING = 1;
    */
-  ONLINE = 1,
+  PENDING = 1,
 
   /**
-   * @generated from enum value: EVENT_FORMAT_OFFLINE = 2;
+   * @generated from enum value: WEBHOOK_DELIVERY_STATUS_SUCCEEDED = 2;
    */
-  OFFLINE = 2,
+  SUCCEEDED = 2,
+
+  /**
+   * Failed, waiting for retry
+   *
+   * @generated from enum value: WEBHOOK_DELIVERY_STATUS_FAILED = 3;
+   */
+  FAILED = 3,
+
+  /**
+   * Retries exhausted
+   *
+   * @generated from enum value: WEBHOOK_DELIVERY_STATUS_DEAD = 4;
+   */
+  DEAD = 4,
 }
 
 /**
- * Describes the enum events.v1.EventFormat.
+ * Describes the enum events.v1.WebhookDeliveryStatus.
  */
-export const EventFormatSchema: GenEnum<EventFormat> = /*@__PURE__*/
-  enumDesc(file_eventsv1_events, 0);
+export const WebhookDeliveryStatusSchema: GenEnum<WebhookDeliveryStatus> = /*@__PURE__*/
+  enumDesc(file_eventsv1_events, 6);
 
 /**
- * @generated from enum events.v1.OrganizationStatus
+ * TagSortBy orders ListTags results
+ *
+ * @generated from enum events.v1.TagSortBy
  */
-export enum OrganizationStatus {
+export enum TagSortBy {
   /**
-   * @generated from enum value: ORGANIZATION_STATUS_UNSPECIFIED = 0;
+   * By ID
+   *
+   * @generated from enum value: TAG_SORT_BY_UNSPECIFIED = 0;
    */
   UNSPECIFIED = 0,
 
   /**
-   * @generated from enum value: ORGANIZATION_STATUS_ACTIVE = 1;
+   * Most used first
+   *
+   * @generated from enum value: TAG_SORT_BY_EVENT_COUNT_DESC = 1;
    */
-  ACTIVE = 1,
+  EVENT_COUNT_DESC = 1,
+}
+
+/**
+ * Describes the enum events.v1.TagSortBy.
+ */
+export const TagSortBySchema: GenEnum<TagSortBy> = /*@__PURE__*/
+  enumDesc(file_eventsv1_events, 7);
 
+/**
+ * Events the user can edit, including club events they didn't create
+ * Which of a user's managed events GetManagedEvents returns
+ *
+ * @generated from enum events.v1.ManagedEventRole
+ */
+export enum ManagedEventRole {
   /**
-   * @generated from enum value: ORGANIZATION_STATUS_ARCHIVED = 2;
+   * Every event the user can edit
+   *
+   * @generated from enum value: MANAGED_EVENT_ROLE_UNSPECIFIED = 0;
    */
-  ARCHIVED = 2,
+  UNSPECIFIED = 0,
 
   /**
-   * @generated from enum value: ORGANIZATION_STATUS_FROZEN = 3;
+   * Only events the user created
+   *
+   * @generated from enum value: MANAGED_EVENT_ROLE_CREATOR = 1;
    */
-  FROZEN = 3,
+  CREATOR = 1,
 }
 
 /**
- * Describes the enum events.v1.OrganizationStatus.
+ * Describes the enum events.v1.ManagedEventRole.
  */
-export const OrganizationStatusSchema: GenEnum<OrganizationStatus> = /*@__PURE__*/
-  enumDesc(file_eventsv1_events, 1);
+export const ManagedEventRoleSchema: GenEnum<ManagedEventRole> = /*@__PURE__*/
+  enumDesc(file_eventsv1_events, 8);
 
 /**
- * @generated from enum events.v1.RegistrationStatus
+ * ClubLeaderboardSortBy orders GetTopPerformingClubs and GetClubLeaderboard results
+ *
+ * @generated from enum events.v1.ClubLeaderboardSortBy
  */
-export enum RegistrationStatus {
+export enum ClubLeaderboardSortBy {
   /**
-   * @generated from enum value: REGISTRATION_STATUS_UNSPECIFIED = 0;
+   * Same as TOTAL_EVENTS_DESC
+   *
+   * @generated from enum value: CLUB_LEADERBOARD_SORT_BY_UNSPECIFIED = 0;
    */
   UNSPECIFIED = 0,
 
   /**
-   * @generated from enum value: REGISTRATION_STATUS_REGISTERED = 1;
+   * @generated from enum value: CLUB_LEADERBOARD_SORT_BY_TOTAL_EVENTS_DESC = 1;
    */
-  REGISTERED = 1,
+  TOTAL_EVENTS_DESC = 1,
 
   /**
-   * @generated from enum value: REGISTRATION_STATUS_CANCELLED = 2;
+   * @generated from enum value: CLUB_LEADERBOARD_SORT_BY_TOTAL_REGISTRATIONS_DESC = 2;
    */
-  CANCELLED = 2,
+  TOTAL_REGISTRATIONS_DESC = 2,
 
   /**
-   * @generated from enum value: REGISTRATION_STATUS_WAITLIST = 3;
+   * @generated from enum value: CLUB_LEADERBOARD_SORT_BY_AVG_ATTENDANCE_RATE_DESC = 3;
    */
-  WAITLIST = 3,
+  AVG_ATTENDANCE_RATE_DESC = 3,
 }
 
 /**
- * Describes the enum events.v1.RegistrationStatus.
+ * Describes the enum events.v1.ClubLeaderboardSortBy.
  */
-export const RegistrationStatusSchema: GenEnum<RegistrationStatus> = /*@__PURE__*/
-  enumDesc(file_eventsv1_events, 2);
+export const ClubLeaderboardSortBySchema: GenEnum<ClubLeaderboardSortBy> = /*@__PURE__*/
+  enumDesc(file_eventsv1_events, 9);
 
 /**
- * @generated from enum events.v1.AttendanceStatus
+ * TopEventsSortBy orders GetTopPerformingEvents results
+ *
+ * @generated from enum events.v1.TopEventsSortBy
  */
-export enum AttendanceStatus {
+export enum TopEventsSortBy {
   /**
-   * @generated from enum value: ATTENDANCE_STATUS_UNSPECIFIED = 0;
+   * Same as TOTAL_REGISTRATIONS_DESC
+   *
+   * @generated from enum value: TOP_EVENTS_SORT_BY_UNSPECIFIED = 0;
    */
   UNSPECIFIED = 0,
 
   /**
-   * @generated from enum value: ATTENDANCE_STATUS_ATTENDED = 1;
-   */
-  ATTENDED = 1,
-
-  /**
-   * @generated from enum value: ATTENDANCE_STATUS_NO_SHOW = 2;
+   * @generated from enum value: TOP_EVENTS_SORT_BY_TOTAL_REGISTRATIONS_DESC = 1;
    */
-  NO_SHOW = 2,
+  TOTAL_REGISTRATIONS_DESC = 1,
 
   /**
-   * @generated from enum value: ATTENDANCE_STATUS_CHECKED_IN = 3;
+   * @generated from enum value: TOP_EVENTS_SORT_BY_ATTENDANCE_RATE_DESC = 2;
    */
-  CHECKED_IN = 3,
+  ATTENDANCE_RATE_DESC = 2,
 }
 
 /**
- * Describes the enum events.v1.AttendanceStatus.
+ * Describes the enum events.v1.TopEventsSortBy.
  */
-export const AttendanceStatusSchema: GenEnum<AttendanceStatus> = /*@__PURE__*/
-  enumDesc(file_eventsv1_events, 3);
+export const TopEventsSortBySchema: GenEnum<TopEventsSortBy> = /*@__PURE__*/
+  enumDesc(file_eventsv1_events, 10);
 
 /**
  * Services
@@ -2962,6 +5666,14 @@ export const OrganizationsService: GenService<{
     input: typeof DeleteOrganizationRequestSchema;
     output: typeof DeleteOrganizationResponseSchema;
   },
+  /**
+   * @generated from rpc events.v1.OrganizationsService.MergeOrganizations
+   */
+  mergeOrganizations: {
+    methodKind: "unary";
+    input: typeof MergeOrganizationsRequestSchema;
+    output: typeof MergeOrganizationsResponseSchema;
+  },
   /**
    * @generated from rpc events.v1.OrganizationsService.GetPublishableOrganizations
    */
@@ -2978,6 +5690,62 @@ export const OrganizationsService: GenService<{
     input: typeof GetUserOrganizationsRequestSchema;
     output: typeof GetUserOrganizationsResponseSchema;
   },
+  /**
+   * @generated from rpc events.v1.OrganizationsService.GetOrganizationQuotaUsage
+   */
+  getOrganizationQuotaUsage: {
+    methodKind: "unary";
+    input: typeof GetOrganizationQuotaUsageRequestSchema;
+    output: typeof GetOrganizationQuotaUsageResponseSchema;
+  },
+  /**
+   * @generated from rpc events.v1.OrganizationsService.GetOrganizationMembers
+   */
+  getOrganizationMembers: {
+    methodKind: "unary";
+    input: typeof GetOrganizationMembersRequestSchema;
+    output: typeof GetOrganizationMembersResponseSchema;
+  },
+  /**
+   * @generated from rpc events.v1.OrganizationsService.AssignClubRole
+   */
+  assignClubRole: {
+    methodKind: "unary";
+    input: typeof AssignClubRoleRequestSchema;
+    output: typeof AssignClubRoleResponseSchema;
+  },
+  /**
+   * @generated from rpc events.v1.OrganizationsService.RemoveClubMember
+   */
+  removeClubMember: {
+    methodKind: "unary";
+    input: typeof RemoveClubMemberRequestSchema;
+    output: typeof RemoveClubMemberResponseSchema;
+  },
+  /**
+   * @generated from rpc events.v1.OrganizationsService.SubmitOrganizationInquiry
+   */
+  submitOrganizationInquiry: {
+    methodKind: "unary";
+    input: typeof SubmitOrganizationInquiryRequestSchema;
+    output: typeof SubmitOrganizationInquiryResponseSchema;
+  },
+  /**
+   * @generated from rpc events.v1.OrganizationsService.ListOrganizationInquiries
+   */
+  listOrganizationInquiries: {
+    methodKind: "unary";
+    input: typeof ListOrganizationInquiriesRequestSchema;
+    output: typeof ListOrganizationInquiriesResponseSchema;
+  },
+  /**
+   * @generated from rpc events.v1.OrganizationsService.UpdateInquiryStatus
+   */
+  updateInquiryStatus: {
+    methodKind: "unary";
+    input: typeof UpdateInquiryStatusRequestSchema;
+    output: typeof UpdateInquiryStatusResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_eventsv1_events, 0);
 
@@ -3040,6 +5808,22 @@ export const EventsService: GenService<{
     input: typeof CreateEventRequestSchema;
     output: typeof CreateEventResponseSchema;
   },
+  /**
+   * @generated from rpc events.v1.EventsService.BulkCreateEvents
+   */
+  bulkCreateEvents: {
+    methodKind: "unary";
+    input: typeof BulkCreateEventsRequestSchema;
+    output: typeof BulkCreateEventsResponseSchema;
+  },
+  /**
+   * @generated from rpc events.v1.EventsService.DuplicateEvent
+   */
+  duplicateEvent: {
+    methodKind: "unary";
+    input: typeof DuplicateEventRequestSchema;
+    output: typeof DuplicateEventResponseSchema;
+  },
   /**
    * @generated from rpc events.v1.EventsService.GetEvent
    */
@@ -3080,6 +5864,30 @@ export const EventsService: GenService<{
     input: typeof DeleteEventRequestSchema;
     output: typeof DeleteEventResponseSchema;
   },
+  /**
+   * @generated from rpc events.v1.EventsService.RestoreEvent
+   */
+  restoreEvent: {
+    methodKind: "unary";
+    input: typeof RestoreEventRequestSchema;
+    output: typeof RestoreEventResponseSchema;
+  },
+  /**
+   * @generated from rpc events.v1.EventsService.ListDeletedEvents
+   */
+  listDeletedEvents: {
+    methodKind: "unary";
+    input: typeof ListDeletedEventsRequestSchema;
+    output: typeof ListDeletedEventsResponseSchema;
+  },
+  /**
+   * @generated from rpc events.v1.EventsService.ToggleEventVisibility
+   */
+  toggleEventVisibility: {
+    methodKind: "unary";
+    input: typeof ToggleEventVisibilityRequestSchema;
+    output: typeof ToggleEventVisibilityResponseSchema;
+  },
   /**
    * @generated from rpc events.v1.EventsService.GetEventsByTagId
    */
@@ -3088,6 +5896,14 @@ export const EventsService: GenService<{
     input: typeof GetEventsByTagIdRequestSchema;
     output: typeof GetEventsByTagIdResponseSchema;
   },
+  /**
+   * @generated from rpc events.v1.EventsService.GetEventsByAllTags
+   */
+  getEventsByAllTags: {
+    methodKind: "unary";
+    input: typeof GetEventsByAllTagsRequestSchema;
+    output: typeof GetEventsByAllTagsResponseSchema;
+  },
   /**
    * @generated from rpc events.v1.EventsService.GetUserSubscribedEvents
    */
@@ -3096,6 +5912,30 @@ export const EventsService: GenService<{
     input: typeof GetUserSubscribedEventsRequestSchema;
     output: typeof GetUserSubscribedEventsResponseSchema;
   },
+  /**
+   * @generated from rpc events.v1.EventsService.GetManagedEvents
+   */
+  getManagedEvents: {
+    methodKind: "unary";
+    input: typeof GetManagedEventsRequestSchema;
+    output: typeof GetManagedEventsResponseSchema;
+  },
+  /**
+   * @generated from rpc events.v1.EventsService.GetEventFeed
+   */
+  getEventFeed: {
+    methodKind: "unary";
+    input: typeof GetEventFeedRequestSchema;
+    output: typeof GetEventFeedResponseSchema;
+  },
+  /**
+   * @generated from rpc events.v1.EventsService.GetEventCalendar
+   */
+  getEventCalendar: {
+    methodKind: "unary";
+    input: typeof GetEventCalendarRequestSchema;
+    output: typeof GetEventCalendarResponseSchema;
+  },
   /**
    * @generated from rpc events.v1.EventsService.GetEventImageUploadUrl
    */
@@ -3151,6 +5991,14 @@ export const TagsService: GenService<{
     input: typeof DeleteTagRequestSchema;
     output: typeof DeleteTagResponseSchema;
   },
+  /**
+   * @generated from rpc events.v1.TagsService.MergeTags
+   */
+  mergeTags: {
+    methodKind: "unary";
+    input: typeof MergeTagsRequestSchema;
+    output: typeof MergeTagsResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_eventsv1_events, 3);
 
@@ -3190,6 +6038,38 @@ export const EventRegistrationsService: GenService<{
     input: typeof GetUserRegistrationsRequestSchema;
     output: typeof GetUserRegistrationsResponseSchema;
   },
+  /**
+   * @generated from rpc events.v1.EventRegistrationsService.HoldEventRegistration
+   */
+  holdEventRegistration: {
+    methodKind: "unary";
+    input: typeof HoldEventRegistrationRequestSchema;
+    output: typeof HoldEventRegistrationResponseSchema;
+  },
+  /**
+   * @generated from rpc events.v1.EventRegistrationsService.ConfirmRegistration
+   */
+  confirmRegistration: {
+    methodKind: "unary";
+    input: typeof ConfirmRegistrationRequestSchema;
+    output: typeof ConfirmRegistrationResponseSchema;
+  },
+  /**
+   * @generated from rpc events.v1.EventRegistrationsService.NotifyRegistrants
+   */
+  notifyRegistrants: {
+    methodKind: "unary";
+    input: typeof NotifyRegistrantsRequestSchema;
+    output: typeof NotifyRegistrantsResponseSchema;
+  },
+  /**
+   * @generated from rpc events.v1.EventRegistrationsService.GetEventRegistrationStatus
+   */
+  getEventRegistrationStatus: {
+    methodKind: "unary";
+    input: typeof GetEventRegistrationStatusRequestSchema;
+    output: typeof GetEventRegistrationStatusResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_eventsv1_events, 4);
 
@@ -3205,6 +6085,14 @@ export const EventAttendanceService: GenService<{
     input: typeof CheckInAttendeeRequestSchema;
     output: typeof CheckInAttendeeResponseSchema;
   },
+  /**
+   * @generated from rpc events.v1.EventAttendanceService.BulkCheckIn
+   */
+  bulkCheckIn: {
+    methodKind: "unary";
+    input: typeof BulkCheckInRequestSchema;
+    output: typeof BulkCheckInResponseSchema;
+  },
   /**
    * @generated from rpc events.v1.EventAttendanceService.MarkAttendance
    */
@@ -3221,6 +6109,30 @@ export const EventAttendanceService: GenService<{
     input: typeof GetEventAttendanceRequestSchema;
     output: typeof GetEventAttendanceResponseSchema;
   },
+  /**
+   * @generated from rpc events.v1.EventAttendanceService.StreamEventAttendance
+   */
+  streamEventAttendance: {
+    methodKind: "server_streaming";
+    input: typeof StreamEventAttendanceRequestSchema;
+    output: typeof StreamEventAttendanceResponseSchema;
+  },
+  /**
+   * @generated from rpc events.v1.EventAttendanceService.ExportEventAttendance
+   */
+  exportEventAttendance: {
+    methodKind: "server_streaming";
+    input: typeof ExportEventAttendanceRequestSchema;
+    output: typeof AttendanceExportChunkSchema;
+  },
+  /**
+   * @generated from rpc events.v1.EventAttendanceService.GetUserAttendanceHistory
+   */
+  getUserAttendanceHistory: {
+    methodKind: "unary";
+    input: typeof GetUserAttendanceHistoryRequestSchema;
+    output: typeof UserAttendanceHistoryResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_eventsv1_events, 5);
 
@@ -3276,6 +6188,14 @@ export const StatisticsService: GenService<{
     input: typeof GetEventTrendsRequestSchema;
     output: typeof GetEventTrendsResponseSchema;
   },
+  /**
+   * @generated from rpc events.v1.StatisticsService.GetEventCountByFormat
+   */
+  getEventCountByFormat: {
+    methodKind: "unary";
+    input: typeof GetEventCountByFormatRequestSchema;
+    output: typeof GetEventCountByFormatResponseSchema;
+  },
   /**
    * @generated from rpc events.v1.StatisticsService.GetTopPerformingClubs
    */
@@ -3284,6 +6204,14 @@ export const StatisticsService: GenService<{
     input: typeof GetTopPerformingClubsRequestSchema;
     output: typeof GetTopPerformingClubsResponseSchema;
   },
+  /**
+   * @generated from rpc events.v1.StatisticsService.GetClubLeaderboard
+   */
+  getClubLeaderboard: {
+    methodKind: "unary";
+    input: typeof GetClubLeaderboardRequestSchema;
+    output: typeof ClubLeaderboardResponseSchema;
+  },
   /**
    * @generated from rpc events.v1.StatisticsService.GetUserEngagementLevels
    */
@@ -3316,6 +6244,76 @@ export const StatisticsService: GenService<{
     input: typeof GetOrganizationActivityRequestSchema;
     output: typeof GetOrganizationActivityResponseSchema;
   },
+  /**
+   * @generated from rpc events.v1.StatisticsService.GetStatisticsForOrganization
+   */
+  getStatisticsForOrganization: {
+    methodKind: "unary";
+    input: typeof GetStatisticsForOrganizationRequestSchema;
+    output: typeof OrganizationStatisticsResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_eventsv1_events, 6);
 
+/**
+ * @generated from service events.v1.WebhooksService
+ */
+export const WebhooksService: GenService<{
+  /**
+   * @generated from rpc events.v1.WebhooksService.CreateWebhook
+   */
+  createWebhook: {
+    methodKind: "unary";
+    input: typeof CreateWebhookRequestSchema;
+    output: typeof CreateWebhookResponseSchema;
+  },
+  /**
+   * @generated from rpc events.v1.WebhooksService.ListWebhooks
+   */
+  listWebhooks: {
+    methodKind: "unary";
+    input: typeof ListWebhooksRequestSchema;
+    output: typeof ListWebhooksResponseSchema;
+  },
+  /**
+   * @generated from rpc events.v1.WebhooksService.DeleteWebhook
+   */
+  deleteWebhook: {
+    methodKind: "unary";
+    input: typeof DeleteWebhookRequestSchema;
+    output: typeof DeleteWebhookResponseSchema;
+  },
+  /**
+   * @generated from rpc events.v1.WebhooksService.GetWebhookDeliveries
+   */
+  getWebhookDeliveries: {
+    methodKind: "unary";
+    input: typeof GetWebhookDeliveriesRequestSchema;
+    output: typeof GetWebhookDeliveriesResponseSchema;
+  },
+  /**
+   * @generated from rpc events.v1.WebhooksService.RetryWebhookDelivery
+   */
+  retryWebhookDelivery: {
+    methodKind: "unary";
+    input: typeof RetryWebhookDeliveryRequestSchema;
+    output: typeof RetryWebhookDeliveryResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_eventsv1_events, 7);
+
+/**
+ * @generated from service events.v1.AuditService
+ */
+export const AuditService: GenService<{
+  /**
+   * @generated from rpc events.v1.AuditService.ListAuditLogs
+   */
+  listAuditLogs: {
+    methodKind: "unary";
+    input: typeof ListAuditLogsRequestSchema;
+    output: typeof ListAuditLogsResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_eventsv1_events, 8);
+
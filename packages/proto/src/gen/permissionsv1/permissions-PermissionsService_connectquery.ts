@@ -0,0 +1,15 @@
+// @generated by protoc-gen-connect-query v2.2.0 with parameter "target=ts,import_extension=.js"
+// @generated from file permissionsv1/permissions.proto (package permissions.v1, syntax proto3)
+/* eslint-disable */
+
+import { PermissionsService } from "./permissions_pb.js";
+
+/**
+ * @generated from rpc permissions.v1.PermissionsService.GetUserPermissions
+ */
+export const getUserPermissions = PermissionsService.method.getUserPermissions;
+
+/**
+ * @generated from rpc permissions.v1.PermissionsService.CheckPermission
+ */
+export const checkPermission = PermissionsService.method.checkPermission;
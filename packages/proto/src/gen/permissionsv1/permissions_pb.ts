@@ -0,0 +1,164 @@
+// @generated by protoc-gen-es v2.10.1 with parameter "target=ts,import_extension=.js"
+// @generated from file permissionsv1/permissions.proto (package permissions.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file permissionsv1/permissions.proto.
+ */
+export const file_permissionsv1_permissions: GenFile = /*@__PURE__*/
+  fileDesc("Ch9wZXJtaXNzaW9uc3YxL3Blcm1pc3Npb25zLnByb3RvEg5wZXJtaXNzaW9ucy52MSJnCg9Vc2VyUGVybWlzc2lvbnMSDwoHdXNlcl9pZBgBIAEoBRIQCghpc19hZG1pbhgCIAEoCBIXCg9pc19nbG9iYWxfc3RhZmYYAyABKAgSGAoQbWFuYWdlZF9jbHViX2lkcxgEIAMoBSI9ChlHZXRVc2VyUGVybWlzc2lvbnNSZXF1ZXN0EhQKB3VzZXJfaWQYASABKAVIAIgBAUIKCghfdXNlcl9pZCJSChpHZXRVc2VyUGVybWlzc2lvbnNSZXNwb25zZRI0CgtwZXJtaXNzaW9ucxgBIAEoCzIfLnBlcm1pc3Npb25zLnYxLlVzZXJQZXJtaXNzaW9ucyJYChZDaGVja1Blcm1pc3Npb25SZXF1ZXN0EhUKDXJlc291cmNlX3R5cGUYASABKAkSEwoLcmVzb3VyY2VfaWQYAiABKAkSEgoKcGVybWlzc2lvbhgDIAEoCSIqChdDaGVja1Blcm1pc3Npb25SZXNwb25zZRIPCgdhbGxvd2VkGAEgASgIMuUBChJQZXJtaXNzaW9uc1NlcnZpY2USawoSR2V0VXNlclBlcm1pc3Npb25zEikucGVybWlzc2lvbnMudjEuR2V0VXNlclBlcm1pc3Npb25zUmVxdWVzdBoqLnBlcm1pc3Npb25zLnYxLkdldFVzZXJQZXJtaXNzaW9uc1Jlc3BvbnNlEmIKD0NoZWNrUGVybWlzc2lvbhImLnBlcm1pc3Npb25zLnYxLkNoZWNrUGVybWlzc2lvblJlcXVlc3QaJy5wZXJtaXNzaW9ucy52MS5DaGVja1Blcm1pc3Npb25SZXNwb25zZULCAQoSY29tLnBlcm1pc3Npb25zLnYxQhBQZXJtaXNzaW9uc1Byb3RvUAFaQWdpdGh1Yi5jb20vc3R1ZHl2ZXJzZS9lbXMtYmFja2VuZC9nZW4vcGVybWlzc2lvbnN2MTtwZXJtaXNzaW9uc3YxogIDUFhYqgIOUGVybWlzc2lvbnMuVjHKAg5QZXJtaXNzaW9uc1xWMeICGlBlcm1pc3Npb25zXFYxXEdQQk1ldGFkYXRh6gIPUGVybWlzc2lvbnM6OlYxYgZwcm90bzM");
+
+/**
+ * Computed permissions of a user, for showing or hiding UI
+ *
+ * @generated from message permissions.v1.UserPermissions
+ */
+export type UserPermissions = Message<"permissions.v1.UserPermissions"> & {
+  /**
+   * @generated from field: int32 user_id = 1;
+   */
+  userId: number;
+
+  /**
+   * manage_system on the platform
+   *
+   * @generated from field: bool is_admin = 2;
+   */
+  isAdmin: boolean;
+
+  /**
+   * manage_clubs on the platform (includes admins)
+   *
+   * @generated from field: bool is_global_staff = 3;
+   */
+  isGlobalStaff: boolean;
+
+  /**
+   * Clubs the user has manage_settings on
+   *
+   * @generated from field: repeated int32 managed_club_ids = 4;
+   */
+  managedClubIds: number[];
+};
+
+/**
+ * Describes the message permissions.v1.UserPermissions.
+ * Use `create(UserPermissionsSchema)` to create a new message.
+ */
+export const UserPermissionsSchema: GenMessage<UserPermissions> = /*@__PURE__*/
+  messageDesc(file_permissionsv1_permissions, 0);
+
+/**
+ * Defaults to the authenticated user. Other users' permissions need manage_system.
+ *
+ * @generated from message permissions.v1.GetUserPermissionsRequest
+ */
+export type GetUserPermissionsRequest = Message<"permissions.v1.GetUserPermissionsRequest"> & {
+  /**
+   * @generated from field: optional int32 user_id = 1;
+   */
+  userId?: number;
+};
+
+/**
+ * Describes the message permissions.v1.GetUserPermissionsRequest.
+ * Use `create(GetUserPermissionsRequestSchema)` to create a new message.
+ */
+export const GetUserPermissionsRequestSchema: GenMessage<GetUserPermissionsRequest> = /*@__PURE__*/
+  messageDesc(file_permissionsv1_permissions, 1);
+
+/**
+ * @generated from message permissions.v1.GetUserPermissionsResponse
+ */
+export type GetUserPermissionsResponse = Message<"permissions.v1.GetUserPermissionsResponse"> & {
+  /**
+   * @generated from field: permissions.v1.UserPermissions permissions = 1;
+   */
+  permissions?: UserPermissions;
+};
+
+/**
+ * Describes the message permissions.v1.GetUserPermissionsResponse.
+ * Use `create(GetUserPermissionsResponseSchema)` to create a new message.
+ */
+export const GetUserPermissionsResponseSchema: GenMessage<GetUserPermissionsResponse> = /*@__PURE__*/
+  messageDesc(file_permissionsv1_permissions, 2);
+
+/**
+ * Checks a single SpiceDB permission for the authenticated user,
+ * e.g. resource_type "club", resource_id "12", permission "create_event"
+ *
+ * @generated from message permissions.v1.CheckPermissionRequest
+ */
+export type CheckPermissionRequest = Message<"permissions.v1.CheckPermissionRequest"> & {
+  /**
+   * @generated from field: string resource_type = 1;
+   */
+  resourceType: string;
+
+  /**
+   * @generated from field: string resource_id = 2;
+   */
+  resourceId: string;
+
+  /**
+   * @generated from field: string permission = 3;
+   */
+  permission: string;
+};
+
+/**
+ * Describes the message permissions.v1.CheckPermissionRequest.
+ * Use `create(CheckPermissionRequestSchema)` to create a new message.
+ */
+export const CheckPermissionRequestSchema: GenMessage<CheckPermissionRequest> = /*@__PURE__*/
+  messageDesc(file_permissionsv1_permissions, 3);
+
+/**
+ * @generated from message permissions.v1.CheckPermissionResponse
+ */
+export type CheckPermissionResponse = Message<"permissions.v1.CheckPermissionResponse"> & {
+  /**
+   * Always false for anonymous requests
+   *
+   * @generated from field: bool allowed = 1;
+   */
+  allowed: boolean;
+};
+
+/**
+ * Describes the message permissions.v1.CheckPermissionResponse.
+ * Use `create(CheckPermissionResponseSchema)` to create a new message.
+ */
+export const CheckPermissionResponseSchema: GenMessage<CheckPermissionResponse> = /*@__PURE__*/
+  messageDesc(file_permissionsv1_permissions, 4);
+
+/**
+ * Services
+ *
+ * @generated from service permissions.v1.PermissionsService
+ */
+export const PermissionsService: GenService<{
+  /**
+   * @generated from rpc permissions.v1.PermissionsService.GetUserPermissions
+   */
+  getUserPermissions: {
+    methodKind: "unary";
+    input: typeof GetUserPermissionsRequestSchema;
+    output: typeof GetUserPermissionsResponseSchema;
+  },
+  /**
+   * @generated from rpc permissions.v1.PermissionsService.CheckPermission
+   */
+  checkPermission: {
+    methodKind: "unary";
+    input: typeof CheckPermissionRequestSchema;
+    output: typeof CheckPermissionResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_permissionsv1_permissions, 0);
+
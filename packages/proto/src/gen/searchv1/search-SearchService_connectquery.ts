@@ -18,6 +18,41 @@ export const globalSearch = SearchService.method.globalSearch;
  */
 export const searchEvents = SearchService.method.searchEvents;
 
+/**
+ * SearchOrganizations searches only organizations with type and status filters
+ *
+ * @generated from rpc search.v1.SearchService.SearchOrganizations
+ */
+export const searchOrganizations = SearchService.method.searchOrganizations;
+
+/**
+ * SearchOrganizationTypes searches only organization types
+ *
+ * @generated from rpc search.v1.SearchService.SearchOrganizationTypes
+ */
+export const searchOrganizationTypes = SearchService.method.searchOrganizationTypes;
+
+/**
+ * SearchMemberEvents searches events of the clubs the caller belongs to
+ *
+ * @generated from rpc search.v1.SearchService.SearchMemberEvents
+ */
+export const searchMemberEvents = SearchService.method.searchMemberEvents;
+
+/**
+ * SearchUsers searches users with role and club filters (admin only)
+ *
+ * @generated from rpc search.v1.SearchService.SearchUsers
+ */
+export const searchUsers = SearchService.method.searchUsers;
+
+/**
+ * SuggestTagsForEvent suggests tags used by events with similar titles
+ *
+ * @generated from rpc search.v1.SearchService.SuggestTagsForEvent
+ */
+export const suggestTagsForEvent = SearchService.method.suggestTagsForEvent;
+
 /**
  * Reindex triggers a full reindex of the search engine
  *
@@ -4,13 +4,17 @@
 
 import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { EventFormat, OrganizationStatus } from "../eventsv1/events_pb.js";
+import { file_eventsv1_events } from "../eventsv1/events_pb.js";
+import type { PlatformRole, User } from "../usersv1/users_pb.js";
+import { file_usersv1_users } from "../usersv1/users_pb.js";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file searchv1/search.proto.
  */
 export const file_searchv1_search: GenFile = /*@__PURE__*/
-  fileDesc("ChVzZWFyY2h2MS9zZWFyY2gucHJvdG8SCXNlYXJjaC52MSKkAQoMU2VhcmNoUmVzdWx0EikKBHR5cGUYASABKA4yGy5zZWFyY2gudjEuU2VhcmNoUmVzdWx0VHlwZRIKCgJpZBgCIAEoBRINCgV0aXRsZRgDIAEoCRIYCgtkZXNjcmlwdGlvbhgEIAEoCUgAiAEBEhYKCWltYWdlX3VybBgFIAEoCUgBiAEBQg4KDF9kZXNjcmlwdGlvbkIMCgpfaW1hZ2VfdXJsIl8KE0dsb2JhbFNlYXJjaFJlcXVlc3QSDQoFcXVlcnkYASABKAkSDQoFbGltaXQYAiABKAUSKgoFdHlwZXMYAyADKA4yGy5zZWFyY2gudjEuU2VhcmNoUmVzdWx0VHlwZSJ/ChRHbG9iYWxTZWFyY2hSZXNwb25zZRIoCgdyZXN1bHRzGAEgAygLMhcuc2VhcmNoLnYxLlNlYXJjaFJlc3VsdBISCgp0b3RhbF9oaXRzGAIgASgDEhoKEnByb2Nlc3NpbmdfdGltZV9tcxgDIAEoAxINCgVxdWVyeRgEIAEoCSJ2ChNTZWFyY2hFdmVudHNSZXF1ZXN0Eg0KBXF1ZXJ5GAEgASgJEg0KBWxpbWl0GAIgASgFEhwKD29yZ2FuaXphdGlvbl9pZBgDIAEoBUgAiAEBEg8KB3RhZ19pZHMYBCADKAVCEgoQX29yZ2FuaXphdGlvbl9pZCJUChRTZWFyY2hFdmVudHNSZXNwb25zZRIoCgdyZXN1bHRzGAEgAygLMhcuc2VhcmNoLnYxLlNlYXJjaFJlc3VsdBISCgp0b3RhbF9oaXRzGAIgASgDIiEKDlJlaW5kZXhSZXF1ZXN0Eg8KB2luZGV4ZXMYASADKAkilwEKD1JlaW5kZXhSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkSFgoOZXZlbnRzX2luZGV4ZWQYAyABKAUSHQoVb3JnYW5pemF0aW9uc19pbmRleGVkGAQgASgFEhUKDXVzZXJzX2luZGV4ZWQYBSABKAUSFAoMdGFnc19pbmRleGVkGAYgASgFKrIBChBTZWFyY2hSZXN1bHRUeXBlEiIKHlNFQVJDSF9SRVNVTFRfVFlQRV9VTlNQRUNJRklFRBAAEhwKGFNFQVJDSF9SRVNVTFRfVFlQRV9FVkVOVBABEiMKH1NFQVJDSF9SRVNVTFRfVFlQRV9PUkdBTklaQVRJT04QAhIbChdTRUFSQ0hfUkVTVUxUX1RZUEVfVVNFUhADEhoKFlNFQVJDSF9SRVNVTFRfVFlQRV9UQUcQBDLzAQoNU2VhcmNoU2VydmljZRJPCgxHbG9iYWxTZWFyY2gSHi5zZWFyY2gudjEuR2xvYmFsU2VhcmNoUmVxdWVzdBofLnNlYXJjaC52MS5HbG9iYWxTZWFyY2hSZXNwb25zZRJPCgxTZWFyY2hFdmVudHMSHi5zZWFyY2gudjEuU2VhcmNoRXZlbnRzUmVxdWVzdBofLnNlYXJjaC52MS5TZWFyY2hFdmVudHNSZXNwb25zZRJACgdSZWluZGV4Ehkuc2VhcmNoLnYxLlJlaW5kZXhSZXF1ZXN0Ghouc2VhcmNoLnYxLlJlaW5kZXhSZXNwb25zZUKaAQoNY29tLnNlYXJjaC52MUILU2VhcmNoUHJvdG9QAVo3Z2l0aHViLmNvbS9zdHVkeXZlcnNlL2Vtcy1iYWNrZW5kL2dlbi9zZWFyY2h2MTtzZWFyY2h2MaICA1NYWKoCCVNlYXJjaC5WMcoCCVNlYXJjaFxWMeICFVNlYXJjaFxWMVxHUEJNZXRhZGF0YeoCClNlYXJjaDo6VjFiBnByb3RvMw");
+  fileDesc("ChVzZWFyY2h2MS9zZWFyY2gucHJvdG8SCXNlYXJjaC52MRoVZXZlbnRzdjEvZXZlbnRzLnByb3RvGhN1c2Vyc3YxL3VzZXJzLnByb3RvIqQBCgxTZWFyY2hSZXN1bHQSKQoEdHlwZRgBIAEoDjIbLnNlYXJjaC52MS5TZWFyY2hSZXN1bHRUeXBlEgoKAmlkGAIgASgFEg0KBXRpdGxlGAMgASgJEhgKC2Rlc2NyaXB0aW9uGAQgASgJSACIAQESFgoJaW1hZ2VfdXJsGAUgASgJSAGIAQFCDgoMX2Rlc2NyaXB0aW9uQgwKCl9pbWFnZV91cmwiXgoLSW5kZXhSZXN1bHQSEgoKaW5kZXhfbmFtZRgBIAEoCRIRCgloaXRfY291bnQYAiABKAMSKAoHcmVzdWx0cxgDIAMoCzIXLnNlYXJjaC52MS5TZWFyY2hSZXN1bHQieAoTR2xvYmFsU2VhcmNoUmVxdWVzdBINCgVxdWVyeRgBIAEoCRINCgVsaW1pdBgCIAEoBRIqCgV0eXBlcxgDIAMoDjIbLnNlYXJjaC52MS5TZWFyY2hSZXN1bHRUeXBlEhcKD2xpbWl0X3Blcl9pbmRleBgEIAEoBSKyAQoUR2xvYmFsU2VhcmNoUmVzcG9uc2USLAoHcmVzdWx0cxgBIAMoCzIXLnNlYXJjaC52MS5TZWFyY2hSZXN1bHRCAhgBEhIKCnRvdGFsX2hpdHMYAiABKAMSGgoScHJvY2Vzc2luZ190aW1lX21zGAMgASgDEg0KBXF1ZXJ5GAQgASgJEi0KDWluZGV4X3Jlc3VsdHMYBSADKAsyFi5zZWFyY2gudjEuSW5kZXhSZXN1bHQimAMKE1NlYXJjaEV2ZW50c1JlcXVlc3QSDQoFcXVlcnkYASABKAkSDQoFbGltaXQYAiABKAUSHAoPb3JnYW5pemF0aW9uX2lkGAMgASgFSACIAQESDwoHdGFnX2lkcxgEIAMoBRIhChRvcmdhbml6YXRpb25fdHlwZV9pZBgFIAEoBUgBiAEBEjEKD3RhZ19maWx0ZXJfbW9kZRgGIAEoDjIYLnNlYXJjaC52MS5UYWdGaWx0ZXJNb2RlEicKB3NvcnRfYnkYByABKA4yFi5zZWFyY2gudjEuRXZlbnRTb3J0QnkSGAoLc3RhcnRfYWZ0ZXIYCCABKAlIAogBARIXCgplbmRfYmVmb3JlGAkgASgJSAOIAQESKwoGZm9ybWF0GAogASgOMhYuZXZlbnRzLnYxLkV2ZW50Rm9ybWF0SASIAQFCEgoQX29yZ2FuaXphdGlvbl9pZEIXChVfb3JnYW5pemF0aW9uX3R5cGVfaWRCDgoMX3N0YXJ0X2FmdGVyQg0KC19lbmRfYmVmb3JlQgkKB19mb3JtYXQiVAoUU2VhcmNoRXZlbnRzUmVzcG9uc2USKAoHcmVzdWx0cxgBIAMoCzIXLnNlYXJjaC52MS5TZWFyY2hSZXN1bHQSEgoKdG90YWxfaGl0cxgCIAEoAyK1AQoaU2VhcmNoT3JnYW5pemF0aW9uc1JlcXVlc3QSDQoFcXVlcnkYASABKAkSDQoFbGltaXQYAiABKAUSIQoUb3JnYW5pemF0aW9uX3R5cGVfaWQYAyABKAVIAIgBARIyCgZzdGF0dXMYBCABKA4yHS5ldmVudHMudjEuT3JnYW5pemF0aW9uU3RhdHVzSAGIAQFCFwoVX29yZ2FuaXphdGlvbl90eXBlX2lkQgkKB19zdGF0dXMidwobU2VhcmNoT3JnYW5pemF0aW9uc1Jlc3BvbnNlEigKB3Jlc3VsdHMYASADKAsyFy5zZWFyY2gudjEuU2VhcmNoUmVzdWx0EhIKCnRvdGFsX2hpdHMYAiABKAMSGgoScHJvY2Vzc2luZ190aW1lX21zGAMgASgDIj4KHlNlYXJjaE9yZ2FuaXphdGlvblR5cGVzUmVxdWVzdBINCgVxdWVyeRgBIAEoCRINCgVsaW1pdBgCIAEoBSJfCh9TZWFyY2hPcmdhbml6YXRpb25UeXBlc1Jlc3BvbnNlEigKB3Jlc3VsdHMYASADKAsyFy5zZWFyY2gudjEuU2VhcmNoUmVzdWx0EhIKCnRvdGFsX2hpdHMYAiABKAMiOQoZU2VhcmNoTWVtYmVyRXZlbnRzUmVxdWVzdBINCgVxdWVyeRgBIAEoCRINCgVsaW1pdBgCIAEoBSJaChpTZWFyY2hNZW1iZXJFdmVudHNSZXNwb25zZRIoCgdyZXN1bHRzGAEgAygLMhcuc2VhcmNoLnYxLlNlYXJjaFJlc3VsdBISCgp0b3RhbF9oaXRzGAIgASgDIsIBChJTZWFyY2hVc2Vyc1JlcXVlc3QSDQoFcXVlcnkYASABKAkSOQoUcGxhdGZvcm1fcm9sZV9maWx0ZXIYAiABKA4yFi51c2Vycy52MS5QbGF0Zm9ybVJvbGVIAIgBARIaCg1vcmdfaWRfZmlsdGVyGAMgASgFSAGIAQESDAoEcGFnZRgEIAEoBRINCgVsaW1pdBgFIAEoBUIXChVfcGxhdGZvcm1fcm9sZV9maWx0ZXJCEAoOX29yZ19pZF9maWx0ZXIiQwoTU2VhcmNoVXNlcnNSZXNwb25zZRIdCgV1c2VycxgBIAMoCzIOLnVzZXJzLnYxLlVzZXISDQoFdG90YWwYAiABKAUiOgoaU3VnZ2VzdFRhZ3NGb3JFdmVudFJlcXVlc3QSDQoFdGl0bGUYASABKAkSDQoFbGltaXQYAiABKAUiRwoNVGFnU3VnZ2VzdGlvbhIOCgZ0YWdfaWQYASABKAUSDAoEbmFtZRgCIAEoCRIYChBjb25maWRlbmNlX3Njb3JlGAMgASgBIkwKG1N1Z2dlc3RUYWdzRm9yRXZlbnRSZXNwb25zZRItCgtzdWdnZXN0aW9ucxgBIAMoCzIYLnNlYXJjaC52MS5UYWdTdWdnZXN0aW9uIiEKDlJlaW5kZXhSZXF1ZXN0Eg8KB2luZGV4ZXMYASADKAkiuwEKD1JlaW5kZXhSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkSFgoOZXZlbnRzX2luZGV4ZWQYAyABKAUSHQoVb3JnYW5pemF0aW9uc19pbmRleGVkGAQgASgFEhUKDXVzZXJzX2luZGV4ZWQYBSABKAUSFAoMdGFnc19pbmRleGVkGAYgASgFEiIKGm9yZ2FuaXphdGlvbl90eXBlc19pbmRleGVkGAcgASgFKtwBChBTZWFyY2hSZXN1bHRUeXBlEiIKHlNFQVJDSF9SRVNVTFRfVFlQRV9VTlNQRUNJRklFRBAAEhwKGFNFQVJDSF9SRVNVTFRfVFlQRV9FVkVOVBABEiMKH1NFQVJDSF9SRVNVTFRfVFlQRV9PUkdBTklaQVRJT04QAhIbChdTRUFSQ0hfUkVTVUxUX1RZUEVfVVNFUhADEhoKFlNFQVJDSF9SRVNVTFRfVFlQRV9UQUcQBBIoCiRTRUFSQ0hfUkVTVUxUX1RZUEVfT1JHQU5JWkFUSU9OX1RZUEUQBSpiCg1UYWdGaWx0ZXJNb2RlEh8KG1RBR19GSUxURVJfTU9ERV9VTlNQRUNJRklFRBAAEhcKE1RBR19GSUxURVJfTU9ERV9BTlkQARIXChNUQUdfRklMVEVSX01PREVfQUxMEAIqVAoLRXZlbnRTb3J0QnkSHQoZRVZFTlRfU09SVF9CWV9VTlNQRUNJRklFRBAAEiYKIkVWRU5UX1NPUlRfQllfQVRURU5EQU5DRV9SQVRFX0RFU0MQATLiBQoNU2VhcmNoU2VydmljZRJPCgxHbG9iYWxTZWFyY2gSHi5zZWFyY2gudjEuR2xvYmFsU2VhcmNoUmVxdWVzdBofLnNlYXJjaC52MS5HbG9iYWxTZWFyY2hSZXNwb25zZRJPCgxTZWFyY2hFdmVudHMSHi5zZWFyY2gudjEuU2VhcmNoRXZlbnRzUmVxdWVzdBofLnNlYXJjaC52MS5TZWFyY2hFdmVudHNSZXNwb25zZRJkChNTZWFyY2hPcmdhbml6YXRpb25zEiUuc2VhcmNoLnYxLlNlYXJjaE9yZ2FuaXphdGlvbnNSZXF1ZXN0GiYuc2VhcmNoLnYxLlNlYXJjaE9yZ2FuaXphdGlvbnNSZXNwb25zZRJwChdTZWFyY2hPcmdhbml6YXRpb25UeXBlcxIpLnNlYXJjaC52MS5TZWFyY2hPcmdhbml6YXRpb25UeXBlc1JlcXVlc3QaKi5zZWFyY2gudjEuU2VhcmNoT3JnYW5pemF0aW9uVHlwZXNSZXNwb25zZRJhChJTZWFyY2hNZW1iZXJFdmVudHMSJC5zZWFyY2gudjEuU2VhcmNoTWVtYmVyRXZlbnRzUmVxdWVzdBolLnNlYXJjaC52MS5TZWFyY2hNZW1iZXJFdmVudHNSZXNwb25zZRJMCgtTZWFyY2hVc2VycxIdLnNlYXJjaC52MS5TZWFyY2hVc2Vyc1JlcXVlc3QaHi5zZWFyY2gudjEuU2VhcmNoVXNlcnNSZXNwb25zZRJkChNTdWdnZXN0VGFnc0ZvckV2ZW50EiUuc2VhcmNoLnYxLlN1Z2dlc3RUYWdzRm9yRXZlbnRSZXF1ZXN0GiYuc2VhcmNoLnYxLlN1Z2dlc3RUYWdzRm9yRXZlbnRSZXNwb25zZRJACgdSZWluZGV4Ehkuc2VhcmNoLnYxLlJlaW5kZXhSZXF1ZXN0Ghouc2VhcmNoLnYxLlJlaW5kZXhSZXNwb25zZUKaAQoNY29tLnNlYXJjaC52MUILU2VhcmNoUHJvdG9QAVo3Z2l0aHViLmNvbS9zdHVkeXZlcnNlL2Vtcy1iYWNrZW5kL2dlbi9zZWFyY2h2MTtzZWFyY2h2MaICA1NYWKoCCVNlYXJjaC5WMcoCCVNlYXJjaFxWMeICFVNlYXJjaFxWMVxHUEJNZXRhZGF0YeoCClNlYXJjaDo6VjFiBnByb3RvMw", [file_eventsv1_events, file_usersv1_users]);
 
 /**
  * SearchResult represents a single search result item
@@ -51,6 +55,39 @@ export type SearchResult = Message<"search.v1.SearchResult"> & {
 export const SearchResultSchema: GenMessage<SearchResult> = /*@__PURE__*/
   messageDesc(file_searchv1_search, 0);
 
+/**
+ * IndexResult groups the hits of a single index in a global search
+ *
+ * @generated from message search.v1.IndexResult
+ */
+export type IndexResult = Message<"search.v1.IndexResult"> & {
+  /**
+   * events, organizations, users or tags
+   *
+   * @generated from field: string index_name = 1;
+   */
+  indexName: string;
+
+  /**
+   * Estimated total hits in this index
+   *
+   * @generated from field: int64 hit_count = 2;
+   */
+  hitCount: bigint;
+
+  /**
+   * @generated from field: repeated search.v1.SearchResult results = 3;
+   */
+  results: SearchResult[];
+};
+
+/**
+ * Describes the message search.v1.IndexResult.
+ * Use `create(IndexResultSchema)` to create a new message.
+ */
+export const IndexResultSchema: GenMessage<IndexResult> = /*@__PURE__*/
+  messageDesc(file_searchv1_search, 1);
+
 /**
  * GlobalSearchRequest is the request for global search across all entities
  *
@@ -63,7 +100,7 @@ export type GlobalSearchRequest = Message<"search.v1.GlobalSearchRequest"> & {
   query: string;
 
   /**
-   * Maximum results per type (default 10)
+   * Legacy: superseded by limit_per_index
    *
    * @generated from field: int32 limit = 2;
    */
@@ -75,6 +112,13 @@ export type GlobalSearchRequest = Message<"search.v1.GlobalSearchRequest"> & {
    * @generated from field: repeated search.v1.SearchResultType types = 3;
    */
   types: SearchResultType[];
+
+  /**
+   * Maximum results per index (default 3)
+   *
+   * @generated from field: int32 limit_per_index = 4;
+   */
+  limitPerIndex: number;
 };
 
 /**
@@ -82,7 +126,7 @@ export type GlobalSearchRequest = Message<"search.v1.GlobalSearchRequest"> & {
  * Use `create(GlobalSearchRequestSchema)` to create a new message.
  */
 export const GlobalSearchRequestSchema: GenMessage<GlobalSearchRequest> = /*@__PURE__*/
-  messageDesc(file_searchv1_search, 1);
+  messageDesc(file_searchv1_search, 2);
 
 /**
  * GlobalSearchResponse contains the search results
@@ -91,7 +135,10 @@ export const GlobalSearchRequestSchema: GenMessage<GlobalSearchRequest> = /*@__P
  */
 export type GlobalSearchResponse = Message<"search.v1.GlobalSearchResponse"> & {
   /**
-   * @generated from field: repeated search.v1.SearchResult results = 1;
+   * Use index_results
+   *
+   * @generated from field: repeated search.v1.SearchResult results = 1 [deprecated = true];
+   * @deprecated
    */
   results: SearchResult[];
 
@@ -109,6 +156,11 @@ export type GlobalSearchResponse = Message<"search.v1.GlobalSearchResponse"> & {
    * @generated from field: string query = 4;
    */
   query: string;
+
+  /**
+   * @generated from field: repeated search.v1.IndexResult index_results = 5;
+   */
+  indexResults: IndexResult[];
 };
 
 /**
@@ -116,7 +168,7 @@ export type GlobalSearchResponse = Message<"search.v1.GlobalSearchResponse"> & {
  * Use `create(GlobalSearchResponseSchema)` to create a new message.
  */
 export const GlobalSearchResponseSchema: GenMessage<GlobalSearchResponse> = /*@__PURE__*/
-  messageDesc(file_searchv1_search, 2);
+  messageDesc(file_searchv1_search, 3);
 
 /**
  * SearchEventsRequest is for searching only events
@@ -143,6 +195,40 @@ export type SearchEventsRequest = Message<"search.v1.SearchEventsRequest"> & {
    * @generated from field: repeated int32 tag_ids = 4;
    */
   tagIds: number[];
+
+  /**
+   * @generated from field: optional int32 organization_type_id = 5;
+   */
+  organizationTypeId?: number;
+
+  /**
+   * @generated from field: search.v1.TagFilterMode tag_filter_mode = 6;
+   */
+  tagFilterMode: TagFilterMode;
+
+  /**
+   * @generated from field: search.v1.EventSortBy sort_by = 7;
+   */
+  sortBy: EventSortBy;
+
+  /**
+   * RFC3339, events starting at or after
+   *
+   * @generated from field: optional string start_after = 8;
+   */
+  startAfter?: string;
+
+  /**
+   * RFC3339, events ending at or before
+   *
+   * @generated from field: optional string end_before = 9;
+   */
+  endBefore?: string;
+
+  /**
+   * @generated from field: optional events.v1.EventFormat format = 10;
+   */
+  format?: EventFormat;
 };
 
 /**
@@ -150,7 +236,7 @@ export type SearchEventsRequest = Message<"search.v1.SearchEventsRequest"> & {
  * Use `create(SearchEventsRequestSchema)` to create a new message.
  */
 export const SearchEventsRequestSchema: GenMessage<SearchEventsRequest> = /*@__PURE__*/
-  messageDesc(file_searchv1_search, 3);
+  messageDesc(file_searchv1_search, 4);
 
 /**
  * SearchEventsResponse contains event search results
@@ -174,7 +260,311 @@ export type SearchEventsResponse = Message<"search.v1.SearchEventsResponse"> & {
  * Use `create(SearchEventsResponseSchema)` to create a new message.
  */
 export const SearchEventsResponseSchema: GenMessage<SearchEventsResponse> = /*@__PURE__*/
-  messageDesc(file_searchv1_search, 4);
+  messageDesc(file_searchv1_search, 5);
+
+/**
+ * SearchOrganizationsRequest is for searching only organizations
+ *
+ * @generated from message search.v1.SearchOrganizationsRequest
+ */
+export type SearchOrganizationsRequest = Message<"search.v1.SearchOrganizationsRequest"> & {
+  /**
+   * @generated from field: string query = 1;
+   */
+  query: string;
+
+  /**
+   * @generated from field: int32 limit = 2;
+   */
+  limit: number;
+
+  /**
+   * @generated from field: optional int32 organization_type_id = 3;
+   */
+  organizationTypeId?: number;
+
+  /**
+   * @generated from field: optional events.v1.OrganizationStatus status = 4;
+   */
+  status?: OrganizationStatus;
+};
+
+/**
+ * Describes the message search.v1.SearchOrganizationsRequest.
+ * Use `create(SearchOrganizationsRequestSchema)` to create a new message.
+ */
+export const SearchOrganizationsRequestSchema: GenMessage<SearchOrganizationsRequest> = /*@__PURE__*/
+  messageDesc(file_searchv1_search, 6);
+
+/**
+ * SearchOrganizationsResponse contains organization search results
+ *
+ * @generated from message search.v1.SearchOrganizationsResponse
+ */
+export type SearchOrganizationsResponse = Message<"search.v1.SearchOrganizationsResponse"> & {
+  /**
+   * @generated from field: repeated search.v1.SearchResult results = 1;
+   */
+  results: SearchResult[];
+
+  /**
+   * @generated from field: int64 total_hits = 2;
+   */
+  totalHits: bigint;
+
+  /**
+   * @generated from field: int64 processing_time_ms = 3;
+   */
+  processingTimeMs: bigint;
+};
+
+/**
+ * Describes the message search.v1.SearchOrganizationsResponse.
+ * Use `create(SearchOrganizationsResponseSchema)` to create a new message.
+ */
+export const SearchOrganizationsResponseSchema: GenMessage<SearchOrganizationsResponse> = /*@__PURE__*/
+  messageDesc(file_searchv1_search, 7);
+
+/**
+ * SearchOrganizationTypesRequest is for searching only organization types
+ *
+ * @generated from message search.v1.SearchOrganizationTypesRequest
+ */
+export type SearchOrganizationTypesRequest = Message<"search.v1.SearchOrganizationTypesRequest"> & {
+  /**
+   * @generated from field: string query = 1;
+   */
+  query: string;
+
+  /**
+   * @generated from field: int32 limit = 2;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message search.v1.SearchOrganizationTypesRequest.
+ * Use `create(SearchOrganizationTypesRequestSchema)` to create a new message.
+ */
+export const SearchOrganizationTypesRequestSchema: GenMessage<SearchOrganizationTypesRequest> = /*@__PURE__*/
+  messageDesc(file_searchv1_search, 8);
+
+/**
+ * SearchOrganizationTypesResponse contains organization type search results
+ *
+ * @generated from message search.v1.SearchOrganizationTypesResponse
+ */
+export type SearchOrganizationTypesResponse = Message<"search.v1.SearchOrganizationTypesResponse"> & {
+  /**
+   * @generated from field: repeated search.v1.SearchResult results = 1;
+   */
+  results: SearchResult[];
+
+  /**
+   * @generated from field: int64 total_hits = 2;
+   */
+  totalHits: bigint;
+};
+
+/**
+ * Describes the message search.v1.SearchOrganizationTypesResponse.
+ * Use `create(SearchOrganizationTypesResponseSchema)` to create a new message.
+ */
+export const SearchOrganizationTypesResponseSchema: GenMessage<SearchOrganizationTypesResponse> = /*@__PURE__*/
+  messageDesc(file_searchv1_search, 9);
+
+/**
+ * SearchMemberEventsRequest is for searching events of the caller's clubs.
+ * An empty query matches every event of those clubs.
+ *
+ * @generated from message search.v1.SearchMemberEventsRequest
+ */
+export type SearchMemberEventsRequest = Message<"search.v1.SearchMemberEventsRequest"> & {
+  /**
+   * @generated from field: string query = 1;
+   */
+  query: string;
+
+  /**
+   * @generated from field: int32 limit = 2;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message search.v1.SearchMemberEventsRequest.
+ * Use `create(SearchMemberEventsRequestSchema)` to create a new message.
+ */
+export const SearchMemberEventsRequestSchema: GenMessage<SearchMemberEventsRequest> = /*@__PURE__*/
+  messageDesc(file_searchv1_search, 10);
+
+/**
+ * SearchMemberEventsResponse contains event search results
+ *
+ * @generated from message search.v1.SearchMemberEventsResponse
+ */
+export type SearchMemberEventsResponse = Message<"search.v1.SearchMemberEventsResponse"> & {
+  /**
+   * @generated from field: repeated search.v1.SearchResult results = 1;
+   */
+  results: SearchResult[];
+
+  /**
+   * @generated from field: int64 total_hits = 2;
+   */
+  totalHits: bigint;
+};
+
+/**
+ * Describes the message search.v1.SearchMemberEventsResponse.
+ * Use `create(SearchMemberEventsResponseSchema)` to create a new message.
+ */
+export const SearchMemberEventsResponseSchema: GenMessage<SearchMemberEventsResponse> = /*@__PURE__*/
+  messageDesc(file_searchv1_search, 11);
+
+/**
+ * SearchUsersRequest searches users, optionally narrowed to a platform role
+ * or the members of a club. Only the best 200 matches are considered.
+ *
+ * @generated from message search.v1.SearchUsersRequest
+ */
+export type SearchUsersRequest = Message<"search.v1.SearchUsersRequest"> & {
+  /**
+   * @generated from field: string query = 1;
+   */
+  query: string;
+
+  /**
+   * STAFF or ADMIN
+   *
+   * @generated from field: optional users.v1.PlatformRole platform_role_filter = 2;
+   */
+  platformRoleFilter?: PlatformRole;
+
+  /**
+   * @generated from field: optional int32 org_id_filter = 3;
+   */
+  orgIdFilter?: number;
+
+  /**
+   * @generated from field: int32 page = 4;
+   */
+  page: number;
+
+  /**
+   * @generated from field: int32 limit = 5;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message search.v1.SearchUsersRequest.
+ * Use `create(SearchUsersRequestSchema)` to create a new message.
+ */
+export const SearchUsersRequestSchema: GenMessage<SearchUsersRequest> = /*@__PURE__*/
+  messageDesc(file_searchv1_search, 12);
+
+/**
+ * SearchUsersResponse contains matching users with their platform role
+ *
+ * @generated from message search.v1.SearchUsersResponse
+ */
+export type SearchUsersResponse = Message<"search.v1.SearchUsersResponse"> & {
+  /**
+   * @generated from field: repeated users.v1.User users = 1;
+   */
+  users: User[];
+
+  /**
+   * Matches after filtering, at most 200
+   *
+   * @generated from field: int32 total = 2;
+   */
+  total: number;
+};
+
+/**
+ * Describes the message search.v1.SearchUsersResponse.
+ * Use `create(SearchUsersResponseSchema)` to create a new message.
+ */
+export const SearchUsersResponseSchema: GenMessage<SearchUsersResponse> = /*@__PURE__*/
+  messageDesc(file_searchv1_search, 13);
+
+/**
+ * SuggestTagsForEventRequest asks for tags that fit an event title
+ *
+ * @generated from message search.v1.SuggestTagsForEventRequest
+ */
+export type SuggestTagsForEventRequest = Message<"search.v1.SuggestTagsForEventRequest"> & {
+  /**
+   * @generated from field: string title = 1;
+   */
+  title: string;
+
+  /**
+   * Defaults to 5
+   *
+   * @generated from field: int32 limit = 2;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message search.v1.SuggestTagsForEventRequest.
+ * Use `create(SuggestTagsForEventRequestSchema)` to create a new message.
+ */
+export const SuggestTagsForEventRequestSchema: GenMessage<SuggestTagsForEventRequest> = /*@__PURE__*/
+  messageDesc(file_searchv1_search, 14);
+
+/**
+ * TagSuggestion is a tag ranked by how well it fits the title
+ *
+ * @generated from message search.v1.TagSuggestion
+ */
+export type TagSuggestion = Message<"search.v1.TagSuggestion"> & {
+  /**
+   * @generated from field: int32 tag_id = 1;
+   */
+  tagId: number;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * 0-1
+   *
+   * @generated from field: double confidence_score = 3;
+   */
+  confidenceScore: number;
+};
+
+/**
+ * Describes the message search.v1.TagSuggestion.
+ * Use `create(TagSuggestionSchema)` to create a new message.
+ */
+export const TagSuggestionSchema: GenMessage<TagSuggestion> = /*@__PURE__*/
+  messageDesc(file_searchv1_search, 15);
+
+/**
+ * SuggestTagsForEventResponse contains suggestions, best first
+ *
+ * @generated from message search.v1.SuggestTagsForEventResponse
+ */
+export type SuggestTagsForEventResponse = Message<"search.v1.SuggestTagsForEventResponse"> & {
+  /**
+   * @generated from field: repeated search.v1.TagSuggestion suggestions = 1;
+   */
+  suggestions: TagSuggestion[];
+};
+
+/**
+ * Describes the message search.v1.SuggestTagsForEventResponse.
+ * Use `create(SuggestTagsForEventResponseSchema)` to create a new message.
+ */
+export const SuggestTagsForEventResponseSchema: GenMessage<SuggestTagsForEventResponse> = /*@__PURE__*/
+  messageDesc(file_searchv1_search, 16);
 
 /**
  * ReindexRequest triggers a full reindex of all data
@@ -195,7 +585,7 @@ export type ReindexRequest = Message<"search.v1.ReindexRequest"> & {
  * Use `create(ReindexRequestSchema)` to create a new message.
  */
 export const ReindexRequestSchema: GenMessage<ReindexRequest> = /*@__PURE__*/
-  messageDesc(file_searchv1_search, 5);
+  messageDesc(file_searchv1_search, 17);
 
 /**
  * ReindexResponse contains the reindex status
@@ -232,6 +622,11 @@ export type ReindexResponse = Message<"search.v1.ReindexResponse"> & {
    * @generated from field: int32 tags_indexed = 6;
    */
   tagsIndexed: number;
+
+  /**
+   * @generated from field: int32 organization_types_indexed = 7;
+   */
+  organizationTypesIndexed: number;
 };
 
 /**
@@ -239,7 +634,7 @@ export type ReindexResponse = Message<"search.v1.ReindexResponse"> & {
  * Use `create(ReindexResponseSchema)` to create a new message.
  */
 export const ReindexResponseSchema: GenMessage<ReindexResponse> = /*@__PURE__*/
-  messageDesc(file_searchv1_search, 6);
+  messageDesc(file_searchv1_search, 18);
 
 /**
  * SearchResultType represents the type of entity in the search result
@@ -271,6 +666,11 @@ export enum SearchResultType {
    * @generated from enum value: SEARCH_RESULT_TYPE_TAG = 4;
    */
   TAG = 4,
+
+  /**
+   * @generated from enum value: SEARCH_RESULT_TYPE_ORGANIZATION_TYPE = 5;
+   */
+  ORGANIZATION_TYPE = 5,
 }
 
 /**
@@ -279,6 +679,67 @@ export enum SearchResultType {
 export const SearchResultTypeSchema: GenEnum<SearchResultType> = /*@__PURE__*/
   enumDesc(file_searchv1_search, 0);
 
+/**
+ * TagFilterMode controls how multiple tag_ids are combined
+ *
+ * @generated from enum search.v1.TagFilterMode
+ */
+export enum TagFilterMode {
+  /**
+   * Same as ANY
+   *
+   * @generated from enum value: TAG_FILTER_MODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Event has at least one of the tags
+   *
+   * @generated from enum value: TAG_FILTER_MODE_ANY = 1;
+   */
+  ANY = 1,
+
+  /**
+   * Event has every tag
+   *
+   * @generated from enum value: TAG_FILTER_MODE_ALL = 2;
+   */
+  ALL = 2,
+}
+
+/**
+ * Describes the enum search.v1.TagFilterMode.
+ */
+export const TagFilterModeSchema: GenEnum<TagFilterMode> = /*@__PURE__*/
+  enumDesc(file_searchv1_search, 1);
+
+/**
+ * EventSortBy orders SearchEvents results
+ *
+ * @generated from enum search.v1.EventSortBy
+ */
+export enum EventSortBy {
+  /**
+   * Relevance
+   *
+   * @generated from enum value: EVENT_SORT_BY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Highest attendance rate first
+   *
+   * @generated from enum value: EVENT_SORT_BY_ATTENDANCE_RATE_DESC = 1;
+   */
+  ATTENDANCE_RATE_DESC = 1,
+}
+
+/**
+ * Describes the enum search.v1.EventSortBy.
+ */
+export const EventSortBySchema: GenEnum<EventSortBy> = /*@__PURE__*/
+  enumDesc(file_searchv1_search, 2);
+
 /**
  * SearchService provides search functionality across all entities
  *
@@ -305,6 +766,56 @@ export const SearchService: GenService<{
     input: typeof SearchEventsRequestSchema;
     output: typeof SearchEventsResponseSchema;
   },
+  /**
+   * SearchOrganizations searches only organizations with type and status filters
+   *
+   * @generated from rpc search.v1.SearchService.SearchOrganizations
+   */
+  searchOrganizations: {
+    methodKind: "unary";
+    input: typeof SearchOrganizationsRequestSchema;
+    output: typeof SearchOrganizationsResponseSchema;
+  },
+  /**
+   * SearchOrganizationTypes searches only organization types
+   *
+   * @generated from rpc search.v1.SearchService.SearchOrganizationTypes
+   */
+  searchOrganizationTypes: {
+    methodKind: "unary";
+    input: typeof SearchOrganizationTypesRequestSchema;
+    output: typeof SearchOrganizationTypesResponseSchema;
+  },
+  /**
+   * SearchMemberEvents searches events of the clubs the caller belongs to
+   *
+   * @generated from rpc search.v1.SearchService.SearchMemberEvents
+   */
+  searchMemberEvents: {
+    methodKind: "unary";
+    input: typeof SearchMemberEventsRequestSchema;
+    output: typeof SearchMemberEventsResponseSchema;
+  },
+  /**
+   * SearchUsers searches users with role and club filters (admin only)
+   *
+   * @generated from rpc search.v1.SearchService.SearchUsers
+   */
+  searchUsers: {
+    methodKind: "unary";
+    input: typeof SearchUsersRequestSchema;
+    output: typeof SearchUsersResponseSchema;
+  },
+  /**
+   * SuggestTagsForEvent suggests tags used by events with similar titles
+   *
+   * @generated from rpc search.v1.SearchService.SuggestTagsForEvent
+   */
+  suggestTagsForEvent: {
+    methodKind: "unary";
+    input: typeof SuggestTagsForEventRequestSchema;
+    output: typeof SuggestTagsForEventResponseSchema;
+  },
   /**
    * Reindex triggers a full reindex of the search engine
    *
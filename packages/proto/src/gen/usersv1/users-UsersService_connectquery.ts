@@ -14,6 +14,11 @@ export const createUser = UsersService.method.createUser;
  */
 export const getUser = UsersService.method.getUser;
 
+/**
+ * @generated from rpc users.v1.UsersService.WhoAmI
+ */
+export const whoAmI = UsersService.method.whoAmI;
+
 /**
  * @generated from rpc users.v1.UsersService.GetUserByEmail
  */
@@ -39,6 +44,11 @@ export const updateUser = UsersService.method.updateUser;
  */
 export const deleteUser = UsersService.method.deleteUser;
 
+/**
+ * @generated from rpc users.v1.UsersService.DeleteUserAndCleanup
+ */
+export const deleteUserAndCleanup = UsersService.method.deleteUserAndCleanup;
+
 /**
  * @generated from rpc users.v1.UsersService.UpdatePassword
  */
@@ -51,6 +61,33 @@ export const updatePassword = UsersService.method.updatePassword;
  */
 export const assignPlatformRole = UsersService.method.assignPlatformRole;
 
+/**
+ * @generated from rpc users.v1.UsersService.GetPlatformRoleMembers
+ */
+export const getPlatformRoleMembers = UsersService.method.getPlatformRoleMembers;
+
+/**
+ * @generated from rpc users.v1.UsersService.GetKratosIdentity
+ */
+export const getKratosIdentity = UsersService.method.getKratosIdentity;
+
+/**
+ * Session management (platform admins)
+ *
+ * @generated from rpc users.v1.UsersService.ListUserSessions
+ */
+export const listUserSessions = UsersService.method.listUserSessions;
+
+/**
+ * @generated from rpc users.v1.UsersService.RevokeUserSession
+ */
+export const revokeUserSession = UsersService.method.revokeUserSession;
+
+/**
+ * @generated from rpc users.v1.UsersService.RevokeAllUserSessions
+ */
+export const revokeAllUserSessions = UsersService.method.revokeAllUserSessions;
+
 /**
  * Pre-registration management
  *
@@ -67,3 +104,15 @@ export const listPreRegisteredUsers = UsersService.method.listPreRegisteredUsers
  * @generated from rpc users.v1.UsersService.DeletePreRegisteredUser
  */
 export const deletePreRegisteredUser = UsersService.method.deletePreRegisteredUser;
+
+/**
+ * Notification preferences
+ *
+ * @generated from rpc users.v1.UsersService.GetNotificationPreferences
+ */
+export const getNotificationPreferences = UsersService.method.getNotificationPreferences;
+
+/**
+ * @generated from rpc users.v1.UsersService.UpdateNotificationPreferences
+ */
+export const updateNotificationPreferences = UsersService.method.updateNotificationPreferences;
@@ -10,11 +10,9 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file usersv1/users.proto.
  */
 export const file_usersv1_users: GenFile = /*@__PURE__*/
-  fileDesc("ChN1c2Vyc3YxL3VzZXJzLnByb3RvEgh1c2Vycy52MSLYAQoEVXNlchIKCgJpZBgBIAEoBRIQCgh1c2VybmFtZRgCIAEoCRINCgVlbWFpbBgDIAEoCRISCgpjcmVhdGVkX2F0GAQgASgJEhIKCnVwZGF0ZWRfYXQYBSABKAkSLQoNcGxhdGZvcm1fcm9sZRgGIAEoDjIWLnVzZXJzLnYxLlBsYXRmb3JtUm9sZRIXCgpmaXJzdF9uYW1lGAcgASgJSACIAQESFgoJbGFzdF9uYW1lGAggASgJSAGIAQFCDQoLX2ZpcnN0X25hbWVCDAoKX2xhc3RfbmFtZSKBAgoRUHJlUmVnaXN0ZXJlZFVzZXISCgoCaWQYASABKAUSDQoFZW1haWwYAiABKAkSLQoNcGxhdGZvcm1fcm9sZRgDIAEoDjIWLnVzZXJzLnYxLlBsYXRmb3JtUm9sZRIXCgpjcmVhdGVkX2J5GAQgASgFSACIAQESFAoHdXNlZF9hdBgFIAEoCUgBiAEBEhwKD3VzZWRfYnlfdXNlcl9pZBgGIAEoBUgCiAEBEhIKCmNyZWF0ZWRfYXQYByABKAkSEgoKdXBkYXRlZF9hdBgIIAEoCUINCgtfY3JlYXRlZF9ieUIKCghfdXNlZF9hdEISChBfdXNlZF9ieV91c2VyX2lkIkYKEUNyZWF0ZVVzZXJSZXF1ZXN0EhAKCHVzZXJuYW1lGAEgASgJEg0KBWVtYWlsGAIgASgJEhAKCHBhc3N3b3JkGAMgASgJIjIKEkNyZWF0ZVVzZXJSZXNwb25zZRIcCgR1c2VyGAEgASgLMg4udXNlcnMudjEuVXNlciIcCg5HZXRVc2VyUmVxdWVzdBIKCgJpZBgBIAEoBSIvCg9HZXRVc2VyUmVzcG9uc2USHAoEdXNlchgBIAEoCzIOLnVzZXJzLnYxLlVzZXIiJgoVR2V0VXNlckJ5RW1haWxSZXF1ZXN0Eg0KBWVtYWlsGAEgASgJIjYKFkdldFVzZXJCeUVtYWlsUmVzcG9uc2USHAoEdXNlchgBIAEoCzIOLnVzZXJzLnYxLlVzZXIiLAoYR2V0VXNlckJ5VXNlcm5hbWVSZXF1ZXN0EhAKCHVzZXJuYW1lGAEgASgJIjkKGUdldFVzZXJCeVVzZXJuYW1lUmVzcG9uc2USHAoEdXNlchgBIAEoCzIOLnVzZXJzLnYxLlVzZXIiLwoQTGlzdFVzZXJzUmVxdWVzdBIMCgRwYWdlGAEgASgFEg0KBWxpbWl0GAIgASgFIkEKEUxpc3RVc2Vyc1Jlc3BvbnNlEh0KBXVzZXJzGAEgAygLMg4udXNlcnMudjEuVXNlchINCgV0b3RhbBgCIAEoBSJhChFVcGRhdGVVc2VyUmVxdWVzdBIKCgJpZBgBIAEoBRIVCgh1c2VybmFtZRgCIAEoCUgAiAEBEhIKBWVtYWlsGAMgASgJSAGIAQFCCwoJX3VzZXJuYW1lQggKBl9lbWFpbCIyChJVcGRhdGVVc2VyUmVzcG9uc2USHAoEdXNlchgBIAEoCzIOLnVzZXJzLnYxLlVzZXIiHwoRRGVsZXRlVXNlclJlcXVlc3QSCgoCaWQYASABKAUiJQoSRGVsZXRlVXNlclJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiTwoVVXBkYXRlUGFzc3dvcmRSZXF1ZXN0EgoKAmlkGAEgASgFEhQKDG9sZF9wYXNzd29yZBgCIAEoCRIUCgxuZXdfcGFzc3dvcmQYAyABKAkiKQoWVXBkYXRlUGFzc3dvcmRSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIlIKGUFzc2lnblBsYXRmb3JtUm9sZVJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoBRIkCgRyb2xlGAIgASgOMhYudXNlcnMudjEuUGxhdGZvcm1Sb2xlIjoKGkFzc2lnblBsYXRmb3JtUm9sZVJlc3BvbnNlEhwKBHVzZXIYASABKAsyDi51c2Vycy52MS5Vc2VyIlYKFlByZVJlZ2lzdGVyVXNlclJlcXVlc3QSDQoFZW1haWwYASABKAkSLQoNcGxhdGZvcm1fcm9sZRgCIAEoDjIWLnVzZXJzLnYxLlBsYXRmb3JtUm9sZSJTChdQcmVSZWdpc3RlclVzZXJSZXNwb25zZRI4ChNwcmVfcmVnaXN0ZXJlZF91c2VyGAEgASgLMhsudXNlcnMudjEuUHJlUmVnaXN0ZXJlZFVzZXIiUgodTGlzdFByZVJlZ2lzdGVyZWRVc2Vyc1JlcXVlc3QSDAoEcGFnZRgBIAEoBRINCgVsaW1pdBgCIAEoBRIUCgxpbmNsdWRlX3VzZWQYAyABKAgiagoeTGlzdFByZVJlZ2lzdGVyZWRVc2Vyc1Jlc3BvbnNlEjkKFHByZV9yZWdpc3RlcmVkX3VzZXJzGAEgAygLMhsudXNlcnMudjEuUHJlUmVnaXN0ZXJlZFVzZXISDQoFdG90YWwYAiABKAUiLAoeRGVsZXRlUHJlUmVnaXN0ZXJlZFVzZXJSZXF1ZXN0EgoKAmlkGAEgASgFIjIKH0RlbGV0ZVByZVJlZ2lzdGVyZWRVc2VyUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCp3CgxQbGF0Zm9ybVJvbGUSHQoZUExBVEZPUk1fUk9MRV9VTlNQRUNJRklFRBAAEhYKElBMQVRGT1JNX1JPTEVfVVNFUhABEhcKE1BMQVRGT1JNX1JPTEVfU1RBRkYQAhIXChNQTEFURk9STV9ST0xFX0FETUlOEAMyjQgKDFVzZXJzU2VydmljZRJHCgpDcmVhdGVVc2VyEhsudXNlcnMudjEuQ3JlYXRlVXNlclJlcXVlc3QaHC51c2Vycy52MS5DcmVhdGVVc2VyUmVzcG9uc2USPgoHR2V0VXNlchIYLnVzZXJzLnYxLkdldFVzZXJSZXF1ZXN0GhkudXNlcnMudjEuR2V0VXNlclJlc3BvbnNlElMKDkdldFVzZXJCeUVtYWlsEh8udXNlcnMudjEuR2V0VXNlckJ5RW1haWxSZXF1ZXN0GiAudXNlcnMudjEuR2V0VXNlckJ5RW1haWxSZXNwb25zZRJcChFHZXRVc2VyQnlVc2VybmFtZRIiLnVzZXJzLnYxLkdldFVzZXJCeVVzZXJuYW1lUmVxdWVzdBojLnVzZXJzLnYxLkdldFVzZXJCeVVzZXJuYW1lUmVzcG9uc2USRAoJTGlzdFVzZXJzEhoudXNlcnMudjEuTGlzdFVzZXJzUmVxdWVzdBobLnVzZXJzLnYxLkxpc3RVc2Vyc1Jlc3BvbnNlEkcKClVwZGF0ZVVzZXISGy51c2Vycy52MS5VcGRhdGVVc2VyUmVxdWVzdBocLnVzZXJzLnYxLlVwZGF0ZVVzZXJSZXNwb25zZRJHCgpEZWxldGVVc2VyEhsudXNlcnMudjEuRGVsZXRlVXNlclJlcXVlc3QaHC51c2Vycy52MS5EZWxldGVVc2VyUmVzcG9uc2USUwoOVXBkYXRlUGFzc3dvcmQSHy51c2Vycy52MS5VcGRhdGVQYXNzd29yZFJlcXVlc3QaIC51c2Vycy52MS5VcGRhdGVQYXNzd29yZFJlc3BvbnNlEl8KEkFzc2lnblBsYXRmb3JtUm9sZRIjLnVzZXJzLnYxLkFzc2lnblBsYXRmb3JtUm9sZVJlcXVlc3QaJC51c2Vycy52MS5Bc3NpZ25QbGF0Zm9ybVJvbGVSZXNwb25zZRJWCg9QcmVSZWdpc3RlclVzZXISIC51c2Vycy52MS5QcmVSZWdpc3RlclVzZXJSZXF1ZXN0GiEudXNlcnMudjEuUHJlUmVnaXN0ZXJVc2VyUmVzcG9uc2USawoWTGlzdFByZVJlZ2lzdGVyZWRVc2VycxInLnVzZXJzLnYxLkxpc3RQcmVSZWdpc3RlcmVkVXNlcnNSZXF1ZXN0GigudXNlcnMudjEuTGlzdFByZVJlZ2lzdGVyZWRVc2Vyc1Jlc3BvbnNlEm4KF0RlbGV0ZVByZVJlZ2lzdGVyZWRVc2VyEigudXNlcnMudjEuRGVsZXRlUHJlUmVnaXN0ZXJlZFVzZXJSZXF1ZXN0GikudXNlcnMudjEuRGVsZXRlUHJlUmVnaXN0ZXJlZFVzZXJSZXNwb25zZUKSAQoMY29tLnVzZXJzLnYxQgpVc2Vyc1Byb3RvUAFaNWdpdGh1Yi5jb20vc3R1ZHl2ZXJzZS9lbXMtYmFja2VuZC9nZW4vdXNlcnN2MTt1c2Vyc3YxogIDVVhYqgIIVXNlcnMuVjHKAghVc2Vyc1xWMeICFFVzZXJzXFYxXEdQQk1ldGFkYXRh6gIJVXNlcnM6OlYxYgZwcm90bzM");
+  fileDesc("ChN1c2Vyc3YxL3VzZXJzLnByb3RvEgh1c2Vycy52MSLYAQoEVXNlchIKCgJpZBgBIAEoBRIQCgh1c2VybmFtZRgCIAEoCRINCgVlbWFpbBgDIAEoCRISCgpjcmVhdGVkX2F0GAQgASgJEhIKCnVwZGF0ZWRfYXQYBSABKAkSLQoNcGxhdGZvcm1fcm9sZRgGIAEoDjIWLnVzZXJzLnYxLlBsYXRmb3JtUm9sZRIXCgpmaXJzdF9uYW1lGAcgASgJSACIAQESFgoJbGFzdF9uYW1lGAggASgJSAGIAQFCDQoLX2ZpcnN0X25hbWVCDAoKX2xhc3RfbmFtZSKBAgoRUHJlUmVnaXN0ZXJlZFVzZXISCgoCaWQYASABKAUSDQoFZW1haWwYAiABKAkSLQoNcGxhdGZvcm1fcm9sZRgDIAEoDjIWLnVzZXJzLnYxLlBsYXRmb3JtUm9sZRIXCgpjcmVhdGVkX2J5GAQgASgFSACIAQESFAoHdXNlZF9hdBgFIAEoCUgBiAEBEhwKD3VzZWRfYnlfdXNlcl9pZBgGIAEoBUgCiAEBEhIKCmNyZWF0ZWRfYXQYByABKAkSEgoKdXBkYXRlZF9hdBgIIAEoCUINCgtfY3JlYXRlZF9ieUIKCghfdXNlZF9hdEISChBfdXNlZF9ieV91c2VyX2lkIkYKEUNyZWF0ZVVzZXJSZXF1ZXN0EhAKCHVzZXJuYW1lGAEgASgJEg0KBWVtYWlsGAIgASgJEhAKCHBhc3N3b3JkGAMgASgJIjIKEkNyZWF0ZVVzZXJSZXNwb25zZRIcCgR1c2VyGAEgASgLMg4udXNlcnMudjEuVXNlciIcCg5HZXRVc2VyUmVxdWVzdBIKCgJpZBgBIAEoBSIvCg9HZXRVc2VyUmVzcG9uc2USHAoEdXNlchgBIAEoCzIOLnVzZXJzLnYxLlVzZXIiJgoVR2V0VXNlckJ5RW1haWxSZXF1ZXN0Eg0KBWVtYWlsGAEgASgJIjYKFkdldFVzZXJCeUVtYWlsUmVzcG9uc2USHAoEdXNlchgBIAEoCzIOLnVzZXJzLnYxLlVzZXIiLAoYR2V0VXNlckJ5VXNlcm5hbWVSZXF1ZXN0EhAKCHVzZXJuYW1lGAEgASgJIjkKGUdldFVzZXJCeVVzZXJuYW1lUmVzcG9uc2USHAoEdXNlchgBIAEoCzIOLnVzZXJzLnYxLlVzZXIiLwoQTGlzdFVzZXJzUmVxdWVzdBIMCgRwYWdlGAEgASgFEg0KBWxpbWl0GAIgASgFIkEKEUxpc3RVc2Vyc1Jlc3BvbnNlEh0KBXVzZXJzGAEgAygLMg4udXNlcnMudjEuVXNlchINCgV0b3RhbBgCIAEoBSJhChFVcGRhdGVVc2VyUmVxdWVzdBIKCgJpZBgBIAEoBRIVCgh1c2VybmFtZRgCIAEoCUgAiAEBEhIKBWVtYWlsGAMgASgJSAGIAQFCCwoJX3VzZXJuYW1lQggKBl9lbWFpbCIyChJVcGRhdGVVc2VyUmVzcG9uc2USHAoEdXNlchgBIAEoCzIOLnVzZXJzLnYxLlVzZXIiHwoRRGVsZXRlVXNlclJlcXVlc3QSCgoCaWQYASABKAUiJQoSRGVsZXRlVXNlclJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgiDwoNV2hvQW1JUmVxdWVzdCJ0Cg5XaG9BbUlSZXNwb25zZRIcCgR1c2VyGAEgASgLMg4udXNlcnMudjEuVXNlchItCg1wbGF0Zm9ybV9yb2xlGAIgASgOMhYudXNlcnMudjEuUGxhdGZvcm1Sb2xlEhUKDW1hbmFnZWRfY2x1YnMYAyADKAUiLgobRGVsZXRlVXNlckFuZENsZWFudXBSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAUiLwocRGVsZXRlVXNlckFuZENsZWFudXBSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIk8KFVVwZGF0ZVBhc3N3b3JkUmVxdWVzdBIKCgJpZBgBIAEoBRIUCgxvbGRfcGFzc3dvcmQYAiABKAkSFAoMbmV3X3Bhc3N3b3JkGAMgASgJIikKFlVwZGF0ZVBhc3N3b3JkUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCJSChlBc3NpZ25QbGF0Zm9ybVJvbGVSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAUSJAoEcm9sZRgCIAEoDjIWLnVzZXJzLnYxLlBsYXRmb3JtUm9sZSI6ChpBc3NpZ25QbGF0Zm9ybVJvbGVSZXNwb25zZRIcCgR1c2VyGAEgASgLMg4udXNlcnMudjEuVXNlciJFCh1HZXRQbGF0Zm9ybVJvbGVNZW1iZXJzUmVxdWVzdBIkCgRyb2xlGAEgASgOMhYudXNlcnMudjEuUGxhdGZvcm1Sb2xlIj8KHkdldFBsYXRmb3JtUm9sZU1lbWJlcnNSZXNwb25zZRIdCgV1c2VycxgBIAMoCzIOLnVzZXJzLnYxLlVzZXIiKwoYR2V0S3JhdG9zSWRlbnRpdHlSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAUibQoZR2V0S3JhdG9zSWRlbnRpdHlSZXNwb25zZRIcCgR1c2VyGAEgASgLMg4udXNlcnMudjEuVXNlchITCgtpZGVudGl0eV9pZBgCIAEoCRINCgVzdGF0ZRgDIAEoCRIOCgZ0cmFpdHMYBCABKAkiuQEKC1VzZXJTZXNzaW9uEgoKAmlkGAEgASgJEg4KBmFjdGl2ZRgCIAEoCBISCgpjcmVhdGVkX2F0GAMgASgJEhcKCmV4cGlyZXNfYXQYBCABKAlIAIgBARIdChBhdXRoZW50aWNhdGVkX2F0GAUgASgJSAGIAQESEwoGZGV2aWNlGAYgASgJSAKIAQFCDQoLX2V4cGlyZXNfYXRCEwoRX2F1dGhlbnRpY2F0ZWRfYXRCCQoHX2RldmljZSIqChdMaXN0VXNlclNlc3Npb25zUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgFIkMKGExpc3RVc2VyU2Vzc2lvbnNSZXNwb25zZRInCghzZXNzaW9ucxgBIAMoCzIVLnVzZXJzLnYxLlVzZXJTZXNzaW9uIi4KGFJldm9rZVVzZXJTZXNzaW9uUmVxdWVzdBISCgpzZXNzaW9uX2lkGAEgASgJIiwKGVJldm9rZVVzZXJTZXNzaW9uUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCIvChxSZXZva2VBbGxVc2VyU2Vzc2lvbnNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAUiNgodUmV2b2tlQWxsVXNlclNlc3Npb25zUmVzcG9uc2USFQoNcmV2b2tlZF9jb3VudBgBIAEoBSJWChZQcmVSZWdpc3RlclVzZXJSZXF1ZXN0Eg0KBWVtYWlsGAEgASgJEi0KDXBsYXRmb3JtX3JvbGUYAiABKA4yFi51c2Vycy52MS5QbGF0Zm9ybVJvbGUiUwoXUHJlUmVnaXN0ZXJVc2VyUmVzcG9uc2USOAoTcHJlX3JlZ2lzdGVyZWRfdXNlchgBIAEoCzIbLnVzZXJzLnYxLlByZVJlZ2lzdGVyZWRVc2VyIlIKHUxpc3RQcmVSZWdpc3RlcmVkVXNlcnNSZXF1ZXN0EgwKBHBhZ2UYASABKAUSDQoFbGltaXQYAiABKAUSFAoMaW5jbHVkZV91c2VkGAMgASgIImoKHkxpc3RQcmVSZWdpc3RlcmVkVXNlcnNSZXNwb25zZRI5ChRwcmVfcmVnaXN0ZXJlZF91c2VycxgBIAMoCzIbLnVzZXJzLnYxLlByZVJlZ2lzdGVyZWRVc2VyEg0KBXRvdGFsGAIgASgFIiwKHkRlbGV0ZVByZVJlZ2lzdGVyZWRVc2VyUmVxdWVzdBIKCgJpZBgBIAEoBSIyCh9EZWxldGVQcmVSZWdpc3RlcmVkVXNlclJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgifAoWTm90aWZpY2F0aW9uUHJlZmVyZW5jZRI1ChFub3RpZmljYXRpb25fdHlwZRgBIAEoDjIaLnVzZXJzLnYxLk5vdGlmaWNhdGlvblR5cGUSFQoNZW1haWxfZW5hYmxlZBgCIAEoCBIUCgxwdXNoX2VuYWJsZWQYAyABKAgiNAohR2V0Tm90aWZpY2F0aW9uUHJlZmVyZW5jZXNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAUiWwoiR2V0Tm90aWZpY2F0aW9uUHJlZmVyZW5jZXNSZXNwb25zZRI1CgtwcmVmZXJlbmNlcxgBIAMoCzIgLnVzZXJzLnYxLk5vdGlmaWNhdGlvblByZWZlcmVuY2UibgokVXBkYXRlTm90aWZpY2F0aW9uUHJlZmVyZW5jZXNSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAUSNQoLcHJlZmVyZW5jZXMYAiADKAsyIC51c2Vycy52MS5Ob3RpZmljYXRpb25QcmVmZXJlbmNlIl4KJVVwZGF0ZU5vdGlmaWNhdGlvblByZWZlcmVuY2VzUmVzcG9uc2USNQoLcHJlZmVyZW5jZXMYASADKAsyIC51c2Vycy52MS5Ob3RpZmljYXRpb25QcmVmZXJlbmNlKncKDFBsYXRmb3JtUm9sZRIdChlQTEFURk9STV9ST0xFX1VOU1BFQ0lGSUVEEAASFgoSUExBVEZPUk1fUk9MRV9VU0VSEAESFwoTUExBVEZPUk1fUk9MRV9TVEFGRhACEhcKE1BMQVRGT1JNX1JPTEVfQURNSU4QAyqMAgoQTm90aWZpY2F0aW9uVHlwZRIhCh1OT1RJRklDQVRJT05fVFlQRV9VTlNQRUNJRklFRBAAEi8KK05PVElGSUNBVElPTl9UWVBFX1JFR0lTVFJBVElPTl9DT05GSVJNQVRJT04QARIkCiBOT1RJRklDQVRJT05fVFlQRV9FVkVOVF9SRU1JTkRFUhACEigKJE5PVElGSUNBVElPTl9UWVBFX0VWRU5UX0NBTkNFTExBVElPThADEicKI05PVElGSUNBVElPTl9UWVBFX1dBSVRMSVNUX1BST01PVEVEEAQSKwonTk9USUZJQ0FUSU9OX1RZUEVfUExBVEZPUk1fQU5OT1VOQ0VNRU5UEAUymw8KDFVzZXJzU2VydmljZRJHCgpDcmVhdGVVc2VyEhsudXNlcnMudjEuQ3JlYXRlVXNlclJlcXVlc3QaHC51c2Vycy52MS5DcmVhdGVVc2VyUmVzcG9uc2USPgoHR2V0VXNlchIYLnVzZXJzLnYxLkdldFVzZXJSZXF1ZXN0GhkudXNlcnMudjEuR2V0VXNlclJlc3BvbnNlEjsKBldob0FtSRIXLnVzZXJzLnYxLldob0FtSVJlcXVlc3QaGC51c2Vycy52MS5XaG9BbUlSZXNwb25zZRJTCg5HZXRVc2VyQnlFbWFpbBIfLnVzZXJzLnYxLkdldFVzZXJCeUVtYWlsUmVxdWVzdBogLnVzZXJzLnYxLkdldFVzZXJCeUVtYWlsUmVzcG9uc2USXAoRR2V0VXNlckJ5VXNlcm5hbWUSIi51c2Vycy52MS5HZXRVc2VyQnlVc2VybmFtZVJlcXVlc3QaIy51c2Vycy52MS5HZXRVc2VyQnlVc2VybmFtZVJlc3BvbnNlEkQKCUxpc3RVc2VycxIaLnVzZXJzLnYxLkxpc3RVc2Vyc1JlcXVlc3QaGy51c2Vycy52MS5MaXN0VXNlcnNSZXNwb25zZRJHCgpVcGRhdGVVc2VyEhsudXNlcnMudjEuVXBkYXRlVXNlclJlcXVlc3QaHC51c2Vycy52MS5VcGRhdGVVc2VyUmVzcG9uc2USRwoKRGVsZXRlVXNlchIbLnVzZXJzLnYxLkRlbGV0ZVVzZXJSZXF1ZXN0GhwudXNlcnMudjEuRGVsZXRlVXNlclJlc3BvbnNlEmUKFERlbGV0ZVVzZXJBbmRDbGVhbnVwEiUudXNlcnMudjEuRGVsZXRlVXNlckFuZENsZWFudXBSZXF1ZXN0GiYudXNlcnMudjEuRGVsZXRlVXNlckFuZENsZWFudXBSZXNwb25zZRJTCg5VcGRhdGVQYXNzd29yZBIfLnVzZXJzLnYxLlVwZGF0ZVBhc3N3b3JkUmVxdWVzdBogLnVzZXJzLnYxLlVwZGF0ZVBhc3N3b3JkUmVzcG9uc2USXwoSQXNzaWduUGxhdGZvcm1Sb2xlEiMudXNlcnMudjEuQXNzaWduUGxhdGZvcm1Sb2xlUmVxdWVzdBokLnVzZXJzLnYxLkFzc2lnblBsYXRmb3JtUm9sZVJlc3BvbnNlEmsKFkdldFBsYXRmb3JtUm9sZU1lbWJlcnMSJy51c2Vycy52MS5HZXRQbGF0Zm9ybVJvbGVNZW1iZXJzUmVxdWVzdBooLnVzZXJzLnYxLkdldFBsYXRmb3JtUm9sZU1lbWJlcnNSZXNwb25zZRJcChFHZXRLcmF0b3NJZGVudGl0eRIiLnVzZXJzLnYxLkdldEtyYXRvc0lkZW50aXR5UmVxdWVzdBojLnVzZXJzLnYxLkdldEtyYXRvc0lkZW50aXR5UmVzcG9uc2USWQoQTGlzdFVzZXJTZXNzaW9ucxIhLnVzZXJzLnYxLkxpc3RVc2VyU2Vzc2lvbnNSZXF1ZXN0GiIudXNlcnMudjEuTGlzdFVzZXJTZXNzaW9uc1Jlc3BvbnNlElwKEVJldm9rZVVzZXJTZXNzaW9uEiIudXNlcnMudjEuUmV2b2tlVXNlclNlc3Npb25SZXF1ZXN0GiMudXNlcnMudjEuUmV2b2tlVXNlclNlc3Npb25SZXNwb25zZRJoChVSZXZva2VBbGxVc2VyU2Vzc2lvbnMSJi51c2Vycy52MS5SZXZva2VBbGxVc2VyU2Vzc2lvbnNSZXF1ZXN0GicudXNlcnMudjEuUmV2b2tlQWxsVXNlclNlc3Npb25zUmVzcG9uc2USVgoPUHJlUmVnaXN0ZXJVc2VyEiAudXNlcnMudjEuUHJlUmVnaXN0ZXJVc2VyUmVxdWVzdBohLnVzZXJzLnYxLlByZVJlZ2lzdGVyVXNlclJlc3BvbnNlEmsKFkxpc3RQcmVSZWdpc3RlcmVkVXNlcnMSJy51c2Vycy52MS5MaXN0UHJlUmVnaXN0ZXJlZFVzZXJzUmVxdWVzdBooLnVzZXJzLnYxLkxpc3RQcmVSZWdpc3RlcmVkVXNlcnNSZXNwb25zZRJuChdEZWxldGVQcmVSZWdpc3RlcmVkVXNlchIoLnVzZXJzLnYxLkRlbGV0ZVByZVJlZ2lzdGVyZWRVc2VyUmVxdWVzdBopLnVzZXJzLnYxLkRlbGV0ZVByZVJlZ2lzdGVyZWRVc2VyUmVzcG9uc2USdwoaR2V0Tm90aWZpY2F0aW9uUHJlZmVyZW5jZXMSKy51c2Vycy52MS5HZXROb3RpZmljYXRpb25QcmVmZXJlbmNlc1JlcXVlc3QaLC51c2Vycy52MS5HZXROb3RpZmljYXRpb25QcmVmZXJlbmNlc1Jlc3BvbnNlEoABCh1VcGRhdGVOb3RpZmljYXRpb25QcmVmZXJlbmNlcxIuLnVzZXJzLnYxLlVwZGF0ZU5vdGlmaWNhdGlvblByZWZlcmVuY2VzUmVxdWVzdBovLnVzZXJzLnYxLlVwZGF0ZU5vdGlmaWNhdGlvblByZWZlcmVuY2VzUmVzcG9uc2VCkgEKDGNvbS51c2Vycy52MUIKVXNlcnNQcm90b1ABWjVnaXRodWIuY29tL3N0dWR5dmVyc2UvZW1zLWJhY2tlbmQvZ2VuL3VzZXJzdjE7dXNlcnN2MaICA1VYWKoCCFVzZXJzLlYxygIIVXNlcnNcVjHiAhRVc2Vyc1xWMVxHUEJNZXRhZGF0YeoCCVVzZXJzOjpWMWIGcHJvdG8z");
 
 /**
- * Messages
- *
  * @generated from message users.v1.User
  */
 export type User = Message<"users.v1.User"> & {
@@ -396,6 +394,86 @@ export type DeleteUserResponse = Message<"users.v1.DeleteUserResponse"> & {
 export const DeleteUserResponseSchema: GenMessage<DeleteUserResponse> = /*@__PURE__*/
   messageDesc(file_usersv1_users, 15);
 
+/**
+ * The authenticated user's profile and permissions in one call
+ *
+ * @generated from message users.v1.WhoAmIRequest
+ */
+export type WhoAmIRequest = Message<"users.v1.WhoAmIRequest"> & {
+};
+
+/**
+ * Describes the message users.v1.WhoAmIRequest.
+ * Use `create(WhoAmIRequestSchema)` to create a new message.
+ */
+export const WhoAmIRequestSchema: GenMessage<WhoAmIRequest> = /*@__PURE__*/
+  messageDesc(file_usersv1_users, 16);
+
+/**
+ * @generated from message users.v1.WhoAmIResponse
+ */
+export type WhoAmIResponse = Message<"users.v1.WhoAmIResponse"> & {
+  /**
+   * @generated from field: users.v1.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: users.v1.PlatformRole platform_role = 2;
+   */
+  platformRole: PlatformRole;
+
+  /**
+   * Clubs the user has manage_settings on
+   *
+   * @generated from field: repeated int32 managed_clubs = 3;
+   */
+  managedClubs: number[];
+};
+
+/**
+ * Describes the message users.v1.WhoAmIResponse.
+ * Use `create(WhoAmIResponseSchema)` to create a new message.
+ */
+export const WhoAmIResponseSchema: GenMessage<WhoAmIResponse> = /*@__PURE__*/
+  messageDesc(file_usersv1_users, 17);
+
+/**
+ * Delete a user along with all of their SpiceDB relationships (platform admins)
+ *
+ * @generated from message users.v1.DeleteUserAndCleanupRequest
+ */
+export type DeleteUserAndCleanupRequest = Message<"users.v1.DeleteUserAndCleanupRequest"> & {
+  /**
+   * @generated from field: int32 user_id = 1;
+   */
+  userId: number;
+};
+
+/**
+ * Describes the message users.v1.DeleteUserAndCleanupRequest.
+ * Use `create(DeleteUserAndCleanupRequestSchema)` to create a new message.
+ */
+export const DeleteUserAndCleanupRequestSchema: GenMessage<DeleteUserAndCleanupRequest> = /*@__PURE__*/
+  messageDesc(file_usersv1_users, 18);
+
+/**
+ * @generated from message users.v1.DeleteUserAndCleanupResponse
+ */
+export type DeleteUserAndCleanupResponse = Message<"users.v1.DeleteUserAndCleanupResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+};
+
+/**
+ * Describes the message users.v1.DeleteUserAndCleanupResponse.
+ * Use `create(DeleteUserAndCleanupResponseSchema)` to create a new message.
+ */
+export const DeleteUserAndCleanupResponseSchema: GenMessage<DeleteUserAndCleanupResponse> = /*@__PURE__*/
+  messageDesc(file_usersv1_users, 19);
+
 /**
  * @generated from message users.v1.UpdatePasswordRequest
  */
@@ -421,7 +499,7 @@ export type UpdatePasswordRequest = Message<"users.v1.UpdatePasswordRequest"> &
  * Use `create(UpdatePasswordRequestSchema)` to create a new message.
  */
 export const UpdatePasswordRequestSchema: GenMessage<UpdatePasswordRequest> = /*@__PURE__*/
-  messageDesc(file_usersv1_users, 16);
+  messageDesc(file_usersv1_users, 20);
 
 /**
  * @generated from message users.v1.UpdatePasswordResponse
@@ -438,7 +516,7 @@ export type UpdatePasswordResponse = Message<"users.v1.UpdatePasswordResponse">
  * Use `create(UpdatePasswordResponseSchema)` to create a new message.
  */
 export const UpdatePasswordResponseSchema: GenMessage<UpdatePasswordResponse> = /*@__PURE__*/
-  messageDesc(file_usersv1_users, 17);
+  messageDesc(file_usersv1_users, 21);
 
 /**
  * Assign/remove platform role for a user
@@ -462,7 +540,7 @@ export type AssignPlatformRoleRequest = Message<"users.v1.AssignPlatformRoleRequ
  * Use `create(AssignPlatformRoleRequestSchema)` to create a new message.
  */
 export const AssignPlatformRoleRequestSchema: GenMessage<AssignPlatformRoleRequest> = /*@__PURE__*/
-  messageDesc(file_usersv1_users, 18);
+  messageDesc(file_usersv1_users, 22);
 
 /**
  * @generated from message users.v1.AssignPlatformRoleResponse
@@ -479,7 +557,248 @@ export type AssignPlatformRoleResponse = Message<"users.v1.AssignPlatformRoleRes
  * Use `create(AssignPlatformRoleResponseSchema)` to create a new message.
  */
 export const AssignPlatformRoleResponseSchema: GenMessage<AssignPlatformRoleResponse> = /*@__PURE__*/
-  messageDesc(file_usersv1_users, 19);
+  messageDesc(file_usersv1_users, 23);
+
+/**
+ * List users holding a platform role
+ *
+ * @generated from message users.v1.GetPlatformRoleMembersRequest
+ */
+export type GetPlatformRoleMembersRequest = Message<"users.v1.GetPlatformRoleMembersRequest"> & {
+  /**
+   * STAFF or ADMIN
+   *
+   * @generated from field: users.v1.PlatformRole role = 1;
+   */
+  role: PlatformRole;
+};
+
+/**
+ * Describes the message users.v1.GetPlatformRoleMembersRequest.
+ * Use `create(GetPlatformRoleMembersRequestSchema)` to create a new message.
+ */
+export const GetPlatformRoleMembersRequestSchema: GenMessage<GetPlatformRoleMembersRequest> = /*@__PURE__*/
+  messageDesc(file_usersv1_users, 24);
+
+/**
+ * @generated from message users.v1.GetPlatformRoleMembersResponse
+ */
+export type GetPlatformRoleMembersResponse = Message<"users.v1.GetPlatformRoleMembersResponse"> & {
+  /**
+   * @generated from field: repeated users.v1.User users = 1;
+   */
+  users: User[];
+};
+
+/**
+ * Describes the message users.v1.GetPlatformRoleMembersResponse.
+ * Use `create(GetPlatformRoleMembersResponseSchema)` to create a new message.
+ */
+export const GetPlatformRoleMembersResponseSchema: GenMessage<GetPlatformRoleMembersResponse> = /*@__PURE__*/
+  messageDesc(file_usersv1_users, 25);
+
+/**
+ * Fetch a user's identity from the authentication provider
+ *
+ * @generated from message users.v1.GetKratosIdentityRequest
+ */
+export type GetKratosIdentityRequest = Message<"users.v1.GetKratosIdentityRequest"> & {
+  /**
+   * @generated from field: int32 user_id = 1;
+   */
+  userId: number;
+};
+
+/**
+ * Describes the message users.v1.GetKratosIdentityRequest.
+ * Use `create(GetKratosIdentityRequestSchema)` to create a new message.
+ */
+export const GetKratosIdentityRequestSchema: GenMessage<GetKratosIdentityRequest> = /*@__PURE__*/
+  messageDesc(file_usersv1_users, 26);
+
+/**
+ * @generated from message users.v1.GetKratosIdentityResponse
+ */
+export type GetKratosIdentityResponse = Message<"users.v1.GetKratosIdentityResponse"> & {
+  /**
+   * @generated from field: users.v1.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: string identity_id = 2;
+   */
+  identityId: string;
+
+  /**
+   * active or inactive
+   *
+   * @generated from field: string state = 3;
+   */
+  state: string;
+
+  /**
+   * Identity traits as a JSON object
+   *
+   * @generated from field: string traits = 4;
+   */
+  traits: string;
+};
+
+/**
+ * Describes the message users.v1.GetKratosIdentityResponse.
+ * Use `create(GetKratosIdentityResponseSchema)` to create a new message.
+ */
+export const GetKratosIdentityResponseSchema: GenMessage<GetKratosIdentityResponse> = /*@__PURE__*/
+  messageDesc(file_usersv1_users, 27);
+
+/**
+ * A login session held by the authentication provider
+ *
+ * @generated from message users.v1.UserSession
+ */
+export type UserSession = Message<"users.v1.UserSession"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: bool active = 2;
+   */
+  active: boolean;
+
+  /**
+   * @generated from field: string created_at = 3;
+   */
+  createdAt: string;
+
+  /**
+   * @generated from field: optional string expires_at = 4;
+   */
+  expiresAt?: string;
+
+  /**
+   * @generated from field: optional string authenticated_at = 5;
+   */
+  authenticatedAt?: string;
+
+  /**
+   * User agent of the most recent device
+   *
+   * @generated from field: optional string device = 6;
+   */
+  device?: string;
+};
+
+/**
+ * Describes the message users.v1.UserSession.
+ * Use `create(UserSessionSchema)` to create a new message.
+ */
+export const UserSessionSchema: GenMessage<UserSession> = /*@__PURE__*/
+  messageDesc(file_usersv1_users, 28);
+
+/**
+ * @generated from message users.v1.ListUserSessionsRequest
+ */
+export type ListUserSessionsRequest = Message<"users.v1.ListUserSessionsRequest"> & {
+  /**
+   * @generated from field: int32 user_id = 1;
+   */
+  userId: number;
+};
+
+/**
+ * Describes the message users.v1.ListUserSessionsRequest.
+ * Use `create(ListUserSessionsRequestSchema)` to create a new message.
+ */
+export const ListUserSessionsRequestSchema: GenMessage<ListUserSessionsRequest> = /*@__PURE__*/
+  messageDesc(file_usersv1_users, 29);
+
+/**
+ * @generated from message users.v1.ListUserSessionsResponse
+ */
+export type ListUserSessionsResponse = Message<"users.v1.ListUserSessionsResponse"> & {
+  /**
+   * @generated from field: repeated users.v1.UserSession sessions = 1;
+   */
+  sessions: UserSession[];
+};
+
+/**
+ * Describes the message users.v1.ListUserSessionsResponse.
+ * Use `create(ListUserSessionsResponseSchema)` to create a new message.
+ */
+export const ListUserSessionsResponseSchema: GenMessage<ListUserSessionsResponse> = /*@__PURE__*/
+  messageDesc(file_usersv1_users, 30);
+
+/**
+ * @generated from message users.v1.RevokeUserSessionRequest
+ */
+export type RevokeUserSessionRequest = Message<"users.v1.RevokeUserSessionRequest"> & {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId: string;
+};
+
+/**
+ * Describes the message users.v1.RevokeUserSessionRequest.
+ * Use `create(RevokeUserSessionRequestSchema)` to create a new message.
+ */
+export const RevokeUserSessionRequestSchema: GenMessage<RevokeUserSessionRequest> = /*@__PURE__*/
+  messageDesc(file_usersv1_users, 31);
+
+/**
+ * @generated from message users.v1.RevokeUserSessionResponse
+ */
+export type RevokeUserSessionResponse = Message<"users.v1.RevokeUserSessionResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+};
+
+/**
+ * Describes the message users.v1.RevokeUserSessionResponse.
+ * Use `create(RevokeUserSessionResponseSchema)` to create a new message.
+ */
+export const RevokeUserSessionResponseSchema: GenMessage<RevokeUserSessionResponse> = /*@__PURE__*/
+  messageDesc(file_usersv1_users, 32);
+
+/**
+ * @generated from message users.v1.RevokeAllUserSessionsRequest
+ */
+export type RevokeAllUserSessionsRequest = Message<"users.v1.RevokeAllUserSessionsRequest"> & {
+  /**
+   * @generated from field: int32 user_id = 1;
+   */
+  userId: number;
+};
+
+/**
+ * Describes the message users.v1.RevokeAllUserSessionsRequest.
+ * Use `create(RevokeAllUserSessionsRequestSchema)` to create a new message.
+ */
+export const RevokeAllUserSessionsRequestSchema: GenMessage<RevokeAllUserSessionsRequest> = /*@__PURE__*/
+  messageDesc(file_usersv1_users, 33);
+
+/**
+ * @generated from message users.v1.RevokeAllUserSessionsResponse
+ */
+export type RevokeAllUserSessionsResponse = Message<"users.v1.RevokeAllUserSessionsResponse"> & {
+  /**
+   * @generated from field: int32 revoked_count = 1;
+   */
+  revokedCount: number;
+};
+
+/**
+ * Describes the message users.v1.RevokeAllUserSessionsResponse.
+ * Use `create(RevokeAllUserSessionsResponseSchema)` to create a new message.
+ */
+export const RevokeAllUserSessionsResponseSchema: GenMessage<RevokeAllUserSessionsResponse> = /*@__PURE__*/
+  messageDesc(file_usersv1_users, 34);
 
 /**
  * Pre-register a user by email with a role (role applied on first sign-up)
@@ -503,7 +822,7 @@ export type PreRegisterUserRequest = Message<"users.v1.PreRegisterUserRequest">
  * Use `create(PreRegisterUserRequestSchema)` to create a new message.
  */
 export const PreRegisterUserRequestSchema: GenMessage<PreRegisterUserRequest> = /*@__PURE__*/
-  messageDesc(file_usersv1_users, 20);
+  messageDesc(file_usersv1_users, 35);
 
 /**
  * @generated from message users.v1.PreRegisterUserResponse
@@ -520,7 +839,7 @@ export type PreRegisterUserResponse = Message<"users.v1.PreRegisterUserResponse"
  * Use `create(PreRegisterUserResponseSchema)` to create a new message.
  */
 export const PreRegisterUserResponseSchema: GenMessage<PreRegisterUserResponse> = /*@__PURE__*/
-  messageDesc(file_usersv1_users, 21);
+  messageDesc(file_usersv1_users, 36);
 
 /**
  * List pre-registered users
@@ -551,7 +870,7 @@ export type ListPreRegisteredUsersRequest = Message<"users.v1.ListPreRegisteredU
  * Use `create(ListPreRegisteredUsersRequestSchema)` to create a new message.
  */
 export const ListPreRegisteredUsersRequestSchema: GenMessage<ListPreRegisteredUsersRequest> = /*@__PURE__*/
-  messageDesc(file_usersv1_users, 22);
+  messageDesc(file_usersv1_users, 37);
 
 /**
  * @generated from message users.v1.ListPreRegisteredUsersResponse
@@ -573,7 +892,7 @@ export type ListPreRegisteredUsersResponse = Message<"users.v1.ListPreRegistered
  * Use `create(ListPreRegisteredUsersResponseSchema)` to create a new message.
  */
 export const ListPreRegisteredUsersResponseSchema: GenMessage<ListPreRegisteredUsersResponse> = /*@__PURE__*/
-  messageDesc(file_usersv1_users, 23);
+  messageDesc(file_usersv1_users, 38);
 
 /**
  * Delete a pre-registration entry
@@ -592,7 +911,7 @@ export type DeletePreRegisteredUserRequest = Message<"users.v1.DeletePreRegister
  * Use `create(DeletePreRegisteredUserRequestSchema)` to create a new message.
  */
 export const DeletePreRegisteredUserRequestSchema: GenMessage<DeletePreRegisteredUserRequest> = /*@__PURE__*/
-  messageDesc(file_usersv1_users, 24);
+  messageDesc(file_usersv1_users, 39);
 
 /**
  * @generated from message users.v1.DeletePreRegisteredUserResponse
@@ -609,7 +928,113 @@ export type DeletePreRegisteredUserResponse = Message<"users.v1.DeletePreRegiste
  * Use `create(DeletePreRegisteredUserResponseSchema)` to create a new message.
  */
 export const DeletePreRegisteredUserResponseSchema: GenMessage<DeletePreRegisteredUserResponse> = /*@__PURE__*/
-  messageDesc(file_usersv1_users, 25);
+  messageDesc(file_usersv1_users, 40);
+
+/**
+ * Delivery channels enabled for one notification type
+ *
+ * @generated from message users.v1.NotificationPreference
+ */
+export type NotificationPreference = Message<"users.v1.NotificationPreference"> & {
+  /**
+   * @generated from field: users.v1.NotificationType notification_type = 1;
+   */
+  notificationType: NotificationType;
+
+  /**
+   * @generated from field: bool email_enabled = 2;
+   */
+  emailEnabled: boolean;
+
+  /**
+   * @generated from field: bool push_enabled = 3;
+   */
+  pushEnabled: boolean;
+};
+
+/**
+ * Describes the message users.v1.NotificationPreference.
+ * Use `create(NotificationPreferenceSchema)` to create a new message.
+ */
+export const NotificationPreferenceSchema: GenMessage<NotificationPreference> = /*@__PURE__*/
+  messageDesc(file_usersv1_users, 41);
+
+/**
+ * @generated from message users.v1.GetNotificationPreferencesRequest
+ */
+export type GetNotificationPreferencesRequest = Message<"users.v1.GetNotificationPreferencesRequest"> & {
+  /**
+   * @generated from field: int32 user_id = 1;
+   */
+  userId: number;
+};
+
+/**
+ * Describes the message users.v1.GetNotificationPreferencesRequest.
+ * Use `create(GetNotificationPreferencesRequestSchema)` to create a new message.
+ */
+export const GetNotificationPreferencesRequestSchema: GenMessage<GetNotificationPreferencesRequest> = /*@__PURE__*/
+  messageDesc(file_usersv1_users, 42);
+
+/**
+ * @generated from message users.v1.GetNotificationPreferencesResponse
+ */
+export type GetNotificationPreferencesResponse = Message<"users.v1.GetNotificationPreferencesResponse"> & {
+  /**
+   * One per type, enabled unless changed
+   *
+   * @generated from field: repeated users.v1.NotificationPreference preferences = 1;
+   */
+  preferences: NotificationPreference[];
+};
+
+/**
+ * Describes the message users.v1.GetNotificationPreferencesResponse.
+ * Use `create(GetNotificationPreferencesResponseSchema)` to create a new message.
+ */
+export const GetNotificationPreferencesResponseSchema: GenMessage<GetNotificationPreferencesResponse> = /*@__PURE__*/
+  messageDesc(file_usersv1_users, 43);
+
+/**
+ * @generated from message users.v1.UpdateNotificationPreferencesRequest
+ */
+export type UpdateNotificationPreferencesRequest = Message<"users.v1.UpdateNotificationPreferencesRequest"> & {
+  /**
+   * @generated from field: int32 user_id = 1;
+   */
+  userId: number;
+
+  /**
+   * Types not listed are left unchanged
+   *
+   * @generated from field: repeated users.v1.NotificationPreference preferences = 2;
+   */
+  preferences: NotificationPreference[];
+};
+
+/**
+ * Describes the message users.v1.UpdateNotificationPreferencesRequest.
+ * Use `create(UpdateNotificationPreferencesRequestSchema)` to create a new message.
+ */
+export const UpdateNotificationPreferencesRequestSchema: GenMessage<UpdateNotificationPreferencesRequest> = /*@__PURE__*/
+  messageDesc(file_usersv1_users, 44);
+
+/**
+ * @generated from message users.v1.UpdateNotificationPreferencesResponse
+ */
+export type UpdateNotificationPreferencesResponse = Message<"users.v1.UpdateNotificationPreferencesResponse"> & {
+  /**
+   * @generated from field: repeated users.v1.NotificationPreference preferences = 1;
+   */
+  preferences: NotificationPreference[];
+};
+
+/**
+ * Describes the message users.v1.UpdateNotificationPreferencesResponse.
+ * Use `create(UpdateNotificationPreferencesResponseSchema)` to create a new message.
+ */
+export const UpdateNotificationPreferencesResponseSchema: GenMessage<UpdateNotificationPreferencesResponse> = /*@__PURE__*/
+  messageDesc(file_usersv1_users, 45);
 
 /**
  * Platform role enum
@@ -650,6 +1075,50 @@ export enum PlatformRole {
 export const PlatformRoleSchema: GenEnum<PlatformRole> = /*@__PURE__*/
   enumDesc(file_usersv1_users, 0);
 
+/**
+ * Messages
+ * Kinds of notifications a user can opt out of
+ *
+ * @generated from enum users.v1.NotificationType
+ */
+export enum NotificationType {
+  /**
+   * @generated from enum value: NOTIFICATION_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: NOTIFICATION_TYPE_REGISTRATION_CONFIRMATION = 1;
+   */
+  REGISTRATION_CONFIRMATION = 1,
+
+  /**
+   * @generated from enum value: NOTIFICATION_TYPE_EVENT_REMINDER = 2;
+   */
+  EVENT_REMINDER = 2,
+
+  /**
+   * @generated from enum value: NOTIFICATION_TYPE_EVENT_CANCELLATION = 3;
+   */
+  EVENT_CANCELLATION = 3,
+
+  /**
+   * @generated from enum value: NOTIFICATION_TYPE_WAITLIST_PROMOTED = 4;
+   */
+  WAITLIST_PROMOTED = 4,
+
+  /**
+   * @generated from enum value: NOTIFICATION_TYPE_PLATFORM_ANNOUNCEMENT = 5;
+   */
+  PLATFORM_ANNOUNCEMENT = 5,
+}
+
+/**
+ * Describes the enum users.v1.NotificationType.
+ */
+export const NotificationTypeSchema: GenEnum<NotificationType> = /*@__PURE__*/
+  enumDesc(file_usersv1_users, 1);
+
 /**
  * Services
  *
@@ -672,6 +1141,14 @@ export const UsersService: GenService<{
     input: typeof GetUserRequestSchema;
     output: typeof GetUserResponseSchema;
   },
+  /**
+   * @generated from rpc users.v1.UsersService.WhoAmI
+   */
+  whoAmI: {
+    methodKind: "unary";
+    input: typeof WhoAmIRequestSchema;
+    output: typeof WhoAmIResponseSchema;
+  },
   /**
    * @generated from rpc users.v1.UsersService.GetUserByEmail
    */
@@ -712,6 +1189,14 @@ export const UsersService: GenService<{
     input: typeof DeleteUserRequestSchema;
     output: typeof DeleteUserResponseSchema;
   },
+  /**
+   * @generated from rpc users.v1.UsersService.DeleteUserAndCleanup
+   */
+  deleteUserAndCleanup: {
+    methodKind: "unary";
+    input: typeof DeleteUserAndCleanupRequestSchema;
+    output: typeof DeleteUserAndCleanupResponseSchema;
+  },
   /**
    * @generated from rpc users.v1.UsersService.UpdatePassword
    */
@@ -730,6 +1215,48 @@ export const UsersService: GenService<{
     input: typeof AssignPlatformRoleRequestSchema;
     output: typeof AssignPlatformRoleResponseSchema;
   },
+  /**
+   * @generated from rpc users.v1.UsersService.GetPlatformRoleMembers
+   */
+  getPlatformRoleMembers: {
+    methodKind: "unary";
+    input: typeof GetPlatformRoleMembersRequestSchema;
+    output: typeof GetPlatformRoleMembersResponseSchema;
+  },
+  /**
+   * @generated from rpc users.v1.UsersService.GetKratosIdentity
+   */
+  getKratosIdentity: {
+    methodKind: "unary";
+    input: typeof GetKratosIdentityRequestSchema;
+    output: typeof GetKratosIdentityResponseSchema;
+  },
+  /**
+   * Session management (platform admins)
+   *
+   * @generated from rpc users.v1.UsersService.ListUserSessions
+   */
+  listUserSessions: {
+    methodKind: "unary";
+    input: typeof ListUserSessionsRequestSchema;
+    output: typeof ListUserSessionsResponseSchema;
+  },
+  /**
+   * @generated from rpc users.v1.UsersService.RevokeUserSession
+   */
+  revokeUserSession: {
+    methodKind: "unary";
+    input: typeof RevokeUserSessionRequestSchema;
+    output: typeof RevokeUserSessionResponseSchema;
+  },
+  /**
+   * @generated from rpc users.v1.UsersService.RevokeAllUserSessions
+   */
+  revokeAllUserSessions: {
+    methodKind: "unary";
+    input: typeof RevokeAllUserSessionsRequestSchema;
+    output: typeof RevokeAllUserSessionsResponseSchema;
+  },
   /**
    * Pre-registration management
    *
@@ -756,6 +1283,24 @@ export const UsersService: GenService<{
     input: typeof DeletePreRegisteredUserRequestSchema;
     output: typeof DeletePreRegisteredUserResponseSchema;
   },
+  /**
+   * Notification preferences
+   *
+   * @generated from rpc users.v1.UsersService.GetNotificationPreferences
+   */
+  getNotificationPreferences: {
+    methodKind: "unary";
+    input: typeof GetNotificationPreferencesRequestSchema;
+    output: typeof GetNotificationPreferencesResponseSchema;
+  },
+  /**
+   * @generated from rpc users.v1.UsersService.UpdateNotificationPreferences
+   */
+  updateNotificationPreferences: {
+    methodKind: "unary";
+    input: typeof UpdateNotificationPreferencesRequestSchema;
+    output: typeof UpdateNotificationPreferencesResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_usersv1_users, 0);
 
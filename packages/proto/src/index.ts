@@ -7,7 +7,11 @@ export * from './gen/eventsv1/events-OrganizationsService_connectquery.js'
 export * from './gen/eventsv1/events-OrganizationTypesService_connectquery.js'
 export * from './gen/eventsv1/events-StatisticsService_connectquery.js'
 export * from './gen/eventsv1/events-TagsService_connectquery.js'
+export * from './gen/eventsv1/events-WebhooksService_connectquery.js'
+export * from './gen/eventsv1/events-AuditService_connectquery.js'
 export * from './gen/usersv1/users_pb.js'
 export * from './gen/usersv1/users-UsersService_connectquery.js'
 export * from './gen/searchv1/search_pb.js'
 export * from './gen/searchv1/search-SearchService_connectquery.js'
+export * from './gen/permissionsv1/permissions_pb.js'
+export * from './gen/permissionsv1/permissions-PermissionsService_connectquery.js'